@@ -9,7 +9,7 @@ import (
 
 // @title           Normark Trading Journal API
 // @version         1.0
-// @description     A comprehensive trading journal API for tracking and analyzing trading performance
+// @description     A comprehensive trading journal API for tracking and analyzing trading performance. Send Accept: application/json; profile="envelope" to get successful responses wrapped as {"data": ..., "meta": ...}, with list pagination in meta.
 // @termsOfService  http://swagger.io/terms/
 
 // @contact.name   API Support
@@ -23,9 +23,9 @@ const docTemplate = `{
     "host": "{{.Host}}",
     "basePath": "{{.BasePath}}",
     "paths": {
-        "/api/v1/auth/sign-in": {
+        "/api/v1/auth/logout": {
             "post": {
-                "description": "Authenticate user with email and password",
+                "description": "Revoke the session the access token was issued for, so its refresh token stops working. The access token itself stays valid until it expires.",
                 "consumes": [
                     "application/json"
                 ],
@@ -35,43 +35,40 @@ const docTemplate = `{
                 "tags": [
                     "Authentication"
                 ],
-                "summary": "User login",
-                "parameters": [
-                    {
-                        "description": "User login credentials",
-                        "name": "request",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/dto.SignInRequest"
-                        }
-                    }
-                ],
+                "summary": "Sign out",
                 "responses": {
                     "200": {
-                        "description": "Successfully authenticated with access and refresh tokens",
+                        "description": "Successfully signed out",
                         "schema": {
-                            "$ref": "#/definitions/dto.AuthResponse"
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
                         }
                     },
-                    "400": {
-                        "description": "Invalid request body or validation failed",
+                    "401": {
+                        "description": "Unauthorized - missing or invalid token",
                         "schema": {
                             "$ref": "#/definitions/v1.ErrorResponse"
                         }
                     },
-                    "401": {
-                        "description": "Invalid credentials",
+                    "500": {
+                        "description": "Internal server error",
                         "schema": {
                             "$ref": "#/definitions/v1.ErrorResponse"
                         }
                     }
-                }
+                },
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ]
             }
         },
-        "/api/v1/auth/sign-up": {
+        "/api/v1/auth/oauth/google": {
             "post": {
-                "description": "Create a new user account with email, username and password",
+                "description": "Authenticate with a Google ID token issued to this app's client ID. The first sign-in creates a passwordless account with a username derived from the email; an existing account with the same email is signed in. Accounts created this way cannot use password sign-in.",
                 "consumes": [
                     "application/json"
                 ],
@@ -81,21 +78,21 @@ const docTemplate = `{
                 "tags": [
                     "Authentication"
                 ],
-                "summary": "Register a new user",
+                "summary": "Sign in with Google",
                 "parameters": [
                     {
-                        "description": "User registration details",
+                        "description": "Google ID token",
                         "name": "request",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/dto.SignUpRequest"
+                            "$ref": "#/definitions/dto.GoogleSignInRequest"
                         }
                     }
                 ],
                 "responses": {
-                    "201": {
-                        "description": "Successfully registered user with access and refresh tokens",
+                    "200": {
+                        "description": "Successfully authenticated with access and refresh tokens",
                         "schema": {
                             "$ref": "#/definitions/dto.AuthResponse"
                         }
@@ -106,23 +103,30 @@ const docTemplate = `{
                             "$ref": "#/definitions/v1.ErrorResponse"
                         }
                     },
+                    "401": {
+                        "description": "Invalid Google ID token or unverified email",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponse"
+                        }
+                    },
                     "500": {
                         "description": "Internal server error",
                         "schema": {
                             "$ref": "#/definitions/v1.ErrorResponse"
                         }
+                    },
+                    "503": {
+                        "description": "Google sign-in is not configured",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponse"
+                        }
                     }
                 }
             }
         },
-        "/api/v1/journals": {
-            "get": {
-                "security": [
-                    {
-                        "BearerAuth": []
-                    }
-                ],
-                "description": "Get a paginated list of all trading journals for the authenticated user",
+        "/api/v1/auth/refresh": {
+            "post": {
+                "description": "Exchange a refresh token for a new access token. Fails if the refresh token's session has been revoked.",
                 "consumes": [
                     "application/json"
                 ],
@@ -130,32 +134,35 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Trading Journals"
+                    "Authentication"
                 ],
-                "summary": "List user's trading journals",
+                "summary": "Refresh access token",
                 "parameters": [
                     {
-                        "type": "integer",
-                        "description": "Maximum number of journals to return (default: 20, max: 100)",
-                        "name": "limit",
-                        "in": "query"
-                    },
-                    {
-                        "type": "integer",
-                        "description": "Number of journals to skip (default: 0)",
-                        "name": "offset",
-                        "in": "query"
+                        "description": "Refresh token",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dto.RefreshTokenRequest"
+                        }
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "Successfully retrieved journals list",
+                        "description": "New access token",
                         "schema": {
-                            "$ref": "#/definitions/dto.TradingJournalListResponse"
+                            "$ref": "#/definitions/dto.RefreshTokenResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid request body or validation failed",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponse"
                         }
                     },
                     "401": {
-                        "description": "Unauthorized - missing or invalid token",
+                        "description": "Invalid, expired or revoked refresh token",
                         "schema": {
                             "$ref": "#/definitions/v1.ErrorResponse"
                         }
@@ -167,14 +174,11 @@ const docTemplate = `{
                         }
                     }
                 }
-            },
+            }
+        },
+        "/api/v1/auth/sign-in": {
             "post": {
-                "security": [
-                    {
-                        "BearerAuth": []
-                    }
-                ],
-                "description": "Create a new trading journal for the authenticated user",
+                "description": "Authenticate user with email and password",
                 "consumes": [
                     "application/json"
                 ],
@@ -182,25 +186,25 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Trading Journals"
+                    "Authentication"
                 ],
-                "summary": "Create a new trading journal",
+                "summary": "User login",
                 "parameters": [
                     {
-                        "description": "Trading journal details",
+                        "description": "User login credentials",
                         "name": "request",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/dto.CreateTradingJournalRequest"
+                            "$ref": "#/definitions/dto.SignInRequest"
                         }
                     }
                 ],
                 "responses": {
-                    "201": {
-                        "description": "Successfully created trading journal",
+                    "200": {
+                        "description": "Successfully authenticated with access and refresh tokens",
                         "schema": {
-                            "$ref": "#/definitions/dto.TradingJournalResponse"
+                            "$ref": "#/definitions/dto.AuthResponse"
                         }
                     },
                     "400": {
@@ -210,7 +214,7 @@ const docTemplate = `{
                         }
                     },
                     "401": {
-                        "description": "Unauthorized - missing or invalid token",
+                        "description": "Invalid credentials",
                         "schema": {
                             "$ref": "#/definitions/v1.ErrorResponse"
                         }
@@ -224,14 +228,9 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/v1/journals/{id}": {
-            "get": {
-                "security": [
-                    {
-                        "BearerAuth": []
-                    }
-                ],
-                "description": "Retrieve a specific trading journal by its ID",
+        "/api/v1/auth/sign-up": {
+            "post": {
+                "description": "Create a new user account with email, username and password",
                 "consumes": [
                     "application/json"
                 ],
@@ -239,52 +238,51 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Trading Journals"
+                    "Authentication"
                 ],
-                "summary": "Get trading journal by ID",
+                "summary": "Register a new user",
                 "parameters": [
                     {
-                        "type": "string",
-                        "description": "Trading Journal ID (UUID)",
-                        "name": "id",
-                        "in": "path",
-                        "required": true
+                        "description": "User registration details",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dto.SignUpRequest"
+                        }
                     }
                 ],
                 "responses": {
-                    "200": {
-                        "description": "Successfully retrieved trading journal",
+                    "201": {
+                        "description": "Successfully registered user with access and refresh tokens",
                         "schema": {
-                            "$ref": "#/definitions/dto.TradingJournalResponse"
+                            "$ref": "#/definitions/dto.AuthResponse"
                         }
                     },
                     "400": {
-                        "description": "Invalid journal ID",
+                        "description": "Invalid request body or validation failed",
                         "schema": {
                             "$ref": "#/definitions/v1.ErrorResponse"
                         }
                     },
-                    "401": {
-                        "description": "Unauthorized - missing or invalid token",
+                    "409": {
+                        "description": "User with this email or username already exists",
                         "schema": {
                             "$ref": "#/definitions/v1.ErrorResponse"
                         }
                     },
-                    "404": {
-                        "description": "Journal not found",
+                    "500": {
+                        "description": "Internal server error",
                         "schema": {
                             "$ref": "#/definitions/v1.ErrorResponse"
                         }
                     }
                 }
-            },
-            "put": {
-                "security": [
-                    {
-                        "BearerAuth": []
-                    }
-                ],
-                "description": "Update an existing trading journal's name and description",
+            }
+        },
+        "/api/v1/exports/{jobId}": {
+            "get": {
+                "description": "Get the status of an asynchronous export. download_url is set once the status is ready.",
                 "consumes": [
                     "application/json"
                 ],
@@ -294,34 +292,25 @@ const docTemplate = `{
                 "tags": [
                     "Trading Journals"
                 ],
-                "summary": "Update trading journal",
+                "summary": "Get an export job",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Trading Journal ID (UUID)",
-                        "name": "id",
+                        "description": "Export Job ID (UUID)",
+                        "name": "jobId",
                         "in": "path",
                         "required": true
-                    },
-                    {
-                        "description": "Updated journal details",
-                        "name": "request",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/dto.UpdateTradingJournalRequest"
-                        }
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "Successfully updated trading journal",
+                        "description": "Successfully retrieved export job",
                         "schema": {
-                            "$ref": "#/definitions/dto.TradingJournalResponse"
+                            "$ref": "#/definitions/dto.ExportJobResponse"
                         }
                     },
                     "400": {
-                        "description": "Invalid request body, validation failed, or invalid journal ID",
+                        "description": "Invalid job ID",
                         "schema": {
                             "$ref": "#/definitions/v1.ErrorResponse"
                         }
@@ -333,7 +322,7 @@ const docTemplate = `{
                         }
                     },
                     "404": {
-                        "description": "Journal not found",
+                        "description": "Export job not found or expired",
                         "schema": {
                             "$ref": "#/definitions/v1.ErrorResponse"
                         }
@@ -343,16 +332,24 @@ const docTemplate = `{
                         "schema": {
                             "$ref": "#/definitions/v1.ErrorResponse"
                         }
+                    },
+                    "503": {
+                        "description": "Asynchronous export is unavailable",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponse"
+                        }
                     }
-                }
-            },
-            "delete": {
+                },
                 "security": [
                     {
                         "BearerAuth": []
                     }
-                ],
-                "description": "Delete a trading journal and all its associated entries",
+                ]
+            }
+        },
+        "/api/v1/exports/{jobId}/download": {
+            "get": {
+                "description": "Download the export document of a ready job. It has the same format as GET /api/v1/journals/{id}/export.",
                 "consumes": [
                     "application/json"
                 ],
@@ -362,28 +359,25 @@ const docTemplate = `{
                 "tags": [
                     "Trading Journals"
                 ],
-                "summary": "Delete trading journal",
+                "summary": "Download an export",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Trading Journal ID (UUID)",
-                        "name": "id",
+                        "description": "Export Job ID (UUID)",
+                        "name": "jobId",
                         "in": "path",
                         "required": true
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "Successfully deleted journal",
+                        "description": "Journal export document",
                         "schema": {
-                            "type": "object",
-                            "additionalProperties": {
-                                "type": "string"
-                            }
+                            "$ref": "#/definitions/dto.JournalExportDocument"
                         }
                     },
                     "400": {
-                        "description": "Invalid journal ID",
+                        "description": "Invalid job ID",
                         "schema": {
                             "$ref": "#/definitions/v1.ErrorResponse"
                         }
@@ -394,23 +388,41 @@ const docTemplate = `{
                             "$ref": "#/definitions/v1.ErrorResponse"
                         }
                     },
+                    "404": {
+                        "description": "Export job not found or expired",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Export job is not ready",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponse"
+                        }
+                    },
                     "500": {
-                        "description": "Internal server error or access denied",
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponse"
+                        }
+                    },
+                    "503": {
+                        "description": "Asynchronous export is unavailable",
                         "schema": {
                             "$ref": "#/definitions/v1.ErrorResponse"
                         }
                     }
-                }
-            }
-        },
-        "/api/v1/journals/{id}/entries": {
-            "get": {
+                },
                 "security": [
                     {
                         "BearerAuth": []
                     }
-                ],
-                "description": "Get a paginated list of all entries for a specific trading journal",
+                ]
+            }
+        },
+        "/api/v1/journal-templates": {
+            "get": {
+                "description": "Get a paginated list of journal templates for the authenticated user",
                 "consumes": [
                     "application/json"
                 ],
@@ -418,39 +430,32 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Trading Journal Entries"
+                    "Journal Templates"
                 ],
-                "summary": "List trading journal entries",
+                "summary": "List user's journal templates",
                 "parameters": [
-                    {
-                        "type": "string",
-                        "description": "Trading Journal ID (UUID)",
-                        "name": "id",
-                        "in": "path",
-                        "required": true
-                    },
                     {
                         "type": "integer",
-                        "description": "Maximum number of entries to return (default: 20, max: 100)",
+                        "description": "Maximum number of templates to return (default: 20, max: 100)",
                         "name": "limit",
                         "in": "query"
                     },
                     {
                         "type": "integer",
-                        "description": "Number of entries to skip (default: 0)",
+                        "description": "Number of templates to skip (default: 0)",
                         "name": "offset",
                         "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "Successfully retrieved entries list",
+                        "description": "Successfully retrieved templates list",
                         "schema": {
-                            "$ref": "#/definitions/dto.TradingJournalEntryListResponse"
+                            "$ref": "#/definitions/dto.JournalTemplateListResponse"
                         }
                     },
                     "400": {
-                        "description": "Invalid journal ID",
+                        "description": "Invalid limit or offset (only when strict query validation is enabled)",
                         "schema": {
                             "$ref": "#/definitions/v1.ErrorResponse"
                         }
@@ -467,15 +472,15 @@ const docTemplate = `{
                             "$ref": "#/definitions/v1.ErrorResponse"
                         }
                     }
-                }
-            },
-            "post": {
+                },
                 "security": [
                     {
                         "BearerAuth": []
                     }
-                ],
-                "description": "Create a new trade entry in a specific trading journal",
+                ]
+            },
+            "post": {
+                "description": "Save a reusable set of journal defaults (description, default asset, default session, tags). Pass its ID as from_template when creating a journal.",
                 "consumes": [
                     "application/json"
                 ],
@@ -483,36 +488,29 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Trading Journal Entries"
+                    "Journal Templates"
                 ],
-                "summary": "Create a new trading journal entry",
+                "summary": "Create a journal template",
                 "parameters": [
                     {
-                        "type": "string",
-                        "description": "Trading Journal ID (UUID)",
-                        "name": "id",
-                        "in": "path",
-                        "required": true
-                    },
-                    {
-                        "description": "Trading entry details",
+                        "description": "Journal template details",
                         "name": "request",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/dto.CreateTradingJournalEntryRequest"
+                            "$ref": "#/definitions/dto.CreateJournalTemplateRequest"
                         }
                     }
                 ],
                 "responses": {
                     "201": {
-                        "description": "Successfully created trading entry",
+                        "description": "Successfully created journal template",
                         "schema": {
-                            "$ref": "#/definitions/dto.TradingJournalEntryResponse"
+                            "$ref": "#/definitions/dto.JournalTemplateResponse"
                         }
                     },
                     "400": {
-                        "description": "Invalid request body, validation failed, or invalid journal ID",
+                        "description": "Invalid request body or validation failed",
                         "schema": {
                             "$ref": "#/definitions/v1.ErrorResponse"
                         }
@@ -529,17 +527,17 @@ const docTemplate = `{
                             "$ref": "#/definitions/v1.ErrorResponse"
                         }
                     }
-                }
-            }
-        },
-        "/api/v1/journals/{id}/entries/statistics": {
-            "get": {
+                },
                 "security": [
                     {
                         "BearerAuth": []
                     }
-                ],
-                "description": "Retrieve statistical data for a specific trading journal including win rate, total trades, and performance metrics",
+                ]
+            }
+        },
+        "/api/v1/journal-templates/{id}": {
+            "delete": {
+                "description": "Delete a journal template. Journals already created from it are not affected.",
                 "consumes": [
                     "application/json"
                 ],
@@ -547,13 +545,13 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Trading Journal Entries"
+                    "Journal Templates"
                 ],
-                "summary": "Get trading journal statistics",
+                "summary": "Delete journal template",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Trading Journal ID (UUID)",
+                        "description": "Journal Template ID (UUID)",
                         "name": "id",
                         "in": "path",
                         "required": true
@@ -561,13 +559,16 @@ const docTemplate = `{
                 ],
                 "responses": {
                     "200": {
-                        "description": "Successfully retrieved journal statistics",
+                        "description": "Successfully deleted template",
                         "schema": {
-                            "$ref": "#/definitions/dto.TradingJournalStatisticsResponse"
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
                         }
                     },
                     "400": {
-                        "description": "Invalid journal ID",
+                        "description": "Invalid template ID",
                         "schema": {
                             "$ref": "#/definitions/v1.ErrorResponse"
                         }
@@ -578,23 +579,99 @@ const docTemplate = `{
                             "$ref": "#/definitions/v1.ErrorResponse"
                         }
                     },
+                    "404": {
+                        "description": "Journal template not found",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponse"
+                        }
+                    },
                     "500": {
                         "description": "Internal server error",
                         "schema": {
                             "$ref": "#/definitions/v1.ErrorResponse"
                         }
                     }
-                }
+                },
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ]
             }
         },
-        "/api/v1/journals/{id}/entries/{entryId}": {
+        "/api/v1/journals": {
             "get": {
+                "description": "Get a paginated list of all trading journals for the authenticated user",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Trading Journals"
+                ],
+                "summary": "List user's trading journals",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Maximum number of journals to return (default: 20, max: 100)",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Number of journals to skip (default: 0)",
+                        "name": "offset",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Include archived journals (default: false)",
+                        "name": "include_archived",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Only return journals of this broker (case-insensitive)",
+                        "name": "broker",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Successfully retrieved journals list",
+                        "schema": {
+                            "$ref": "#/definitions/dto.TradingJournalListResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid limit or offset (only when strict query validation is enabled)",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized - missing or invalid token",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponse"
+                        }
+                    }
+                },
                 "security": [
                     {
                         "BearerAuth": []
                     }
-                ],
-                "description": "Retrieve a specific trading journal entry by its ID",
+                ]
+            },
+            "post": {
+                "description": "Create a new trading journal for the authenticated user. With dedupe=true or an Idempotency-Key header, an existing journal with the same name, ignoring case, is returned instead of creating a duplicate.",
                 "consumes": [
                     "application/json"
                 ],
@@ -602,34 +679,59 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Trading Journal Entries"
+                    "Trading Journals"
                 ],
-                "summary": "Get trading journal entry by ID",
+                "summary": "Create a new trading journal",
                 "parameters": [
+                    {
+                        "description": "Trading journal details",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dto.CreateTradingJournalRequest"
+                        }
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Return the existing journal with the same name instead of creating a duplicate",
+                        "name": "dedupe",
+                        "in": "query"
+                    },
                     {
                         "type": "string",
-                        "description": "Trading Journal ID (UUID)",
-                        "name": "id",
-                        "in": "path",
-                        "required": true
+                        "description": "Enables the same deduplication as dedupe=true",
+                        "name": "Idempotency-Key",
+                        "in": "header"
                     },
                     {
                         "type": "string",
-                        "description": "Trading Entry ID (UUID)",
-                        "name": "entryId",
-                        "in": "path",
-                        "required": true
+                        "description": "Journal template ID (UUID) whose defaults fill any fields not set in the request",
+                        "name": "from_template",
+                        "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "Successfully retrieved trading entry",
+                        "description": "Existing trading journal with the same name",
                         "schema": {
-                            "$ref": "#/definitions/dto.TradingJournalEntryResponse"
+                            "$ref": "#/definitions/dto.TradingJournalResponse"
+                        }
+                    },
+                    "201": {
+                        "description": "Successfully created trading journal",
+                        "schema": {
+                            "$ref": "#/definitions/dto.TradingJournalResponse"
+                        },
+                        "headers": {
+                            "Location": {
+                                "type": "string",
+                                "description": "URL of the created journal"
+                            }
                         }
                     },
                     "400": {
-                        "description": "Invalid journal ID or entry ID",
+                        "description": "Invalid request body or validation failed",
                         "schema": {
                             "$ref": "#/definitions/v1.ErrorResponse"
                         }
@@ -640,14 +742,14 @@ const docTemplate = `{
                             "$ref": "#/definitions/v1.ErrorResponse"
                         }
                     },
-                    "403": {
-                        "description": "Access denied - entry does not belong to journal",
+                    "404": {
+                        "description": "Journal template not found",
                         "schema": {
                             "$ref": "#/definitions/v1.ErrorResponse"
                         }
                     },
-                    "404": {
-                        "description": "Entry not found",
+                    "409": {
+                        "description": "A journal with this name already exists (when unique names are enforced)",
                         "schema": {
                             "$ref": "#/definitions/v1.ErrorResponse"
                         }
@@ -658,15 +760,17 @@ const docTemplate = `{
                             "$ref": "#/definitions/v1.ErrorResponse"
                         }
                     }
-                }
-            },
-            "put": {
+                },
                 "security": [
                     {
                         "BearerAuth": []
                     }
-                ],
-                "description": "Update an existing trading journal entry",
+                ]
+            }
+        },
+        "/api/v1/journals/import": {
+            "post": {
+                "description": "Recreate a journal and its entries from an export document. Everything gets new IDs and is owned by the authenticated user; nothing is written if any entry is invalid. With dry_run=true nothing is written at all; the response reports how many entries would be created and why each invalid entry would be rejected.",
                 "consumes": [
                     "application/json"
                 ],
@@ -674,61 +778,59 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Trading Journal Entries"
+                    "Trading Journals"
                 ],
-                "summary": "Update trading journal entry",
+                "summary": "Import trading journal",
                 "parameters": [
                     {
-                        "type": "string",
-                        "description": "Trading Journal ID (UUID)",
-                        "name": "id",
-                        "in": "path",
-                        "required": true
-                    },
-                    {
-                        "type": "string",
-                        "description": "Trading Entry ID (UUID)",
-                        "name": "entryId",
-                        "in": "path",
-                        "required": true
-                    },
-                    {
-                        "description": "Updated entry details",
+                        "description": "Journal export document",
                         "name": "request",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/dto.UpdateTradingJournalEntryRequest"
+                            "$ref": "#/definitions/dto.JournalExportDocument"
                         }
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Validate the document and report per-entry errors without importing it",
+                        "name": "dry_run",
+                        "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "Successfully updated trading entry",
+                        "description": "Dry run result",
                         "schema": {
-                            "$ref": "#/definitions/dto.TradingJournalEntryResponse"
+                            "$ref": "#/definitions/dto.ImportPreviewResponse"
                         }
                     },
-                    "400": {
-                        "description": "Invalid request body, validation failed, invalid journal ID, or invalid entry ID",
+                    "201": {
+                        "description": "Successfully imported journal",
                         "schema": {
-                            "$ref": "#/definitions/v1.ErrorResponse"
+                            "$ref": "#/definitions/dto.TradingJournalWithEntriesResponse"
+                        },
+                        "headers": {
+                            "Location": {
+                                "type": "string",
+                                "description": "URL of the imported journal"
+                            }
                         }
                     },
-                    "401": {
-                        "description": "Unauthorized - missing or invalid token",
+                    "400": {
+                        "description": "Invalid document, unsupported version, or validation failed",
                         "schema": {
                             "$ref": "#/definitions/v1.ErrorResponse"
                         }
                     },
-                    "403": {
-                        "description": "Access denied - entry does not belong to journal",
+                    "401": {
+                        "description": "Unauthorized - missing or invalid token",
                         "schema": {
                             "$ref": "#/definitions/v1.ErrorResponse"
                         }
                     },
-                    "404": {
-                        "description": "Entry not found",
+                    "409": {
+                        "description": "A journal with this name already exists (when unique names are enforced)",
                         "schema": {
                             "$ref": "#/definitions/v1.ErrorResponse"
                         }
@@ -739,15 +841,17 @@ const docTemplate = `{
                             "$ref": "#/definitions/v1.ErrorResponse"
                         }
                     }
-                }
-            },
-            "delete": {
+                },
                 "security": [
                     {
                         "BearerAuth": []
                     }
-                ],
-                "description": "Delete a specific trading journal entry",
+                ]
+            }
+        },
+        "/api/v1/journals/{id}": {
+            "get": {
+                "description": "Retrieve a specific trading journal by its ID. The response carries an ETag; send it back in If-None-Match to get 304 Not Modified while the journal and its entry count are unchanged.",
                 "consumes": [
                     "application/json"
                 ],
@@ -755,9 +859,9 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Trading Journal Entries"
+                    "Trading Journals"
                 ],
-                "summary": "Delete trading journal entry",
+                "summary": "Get trading journal by ID",
                 "parameters": [
                     {
                         "type": "string",
@@ -768,24 +872,23 @@ const docTemplate = `{
                     },
                     {
                         "type": "string",
-                        "description": "Trading Entry ID (UUID)",
-                        "name": "entryId",
-                        "in": "path",
-                        "required": true
+                        "description": "ETag from a previous response",
+                        "name": "If-None-Match",
+                        "in": "header"
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "Successfully deleted entry",
+                        "description": "Successfully retrieved trading journal",
                         "schema": {
-                            "type": "object",
-                            "additionalProperties": {
-                                "type": "string"
-                            }
+                            "$ref": "#/definitions/dto.TradingJournalResponse"
                         }
                     },
+                    "304": {
+                        "description": "Journal not modified"
+                    },
                     "400": {
-                        "description": "Invalid journal ID or entry ID",
+                        "description": "Invalid journal ID",
                         "schema": {
                             "$ref": "#/definitions/v1.ErrorResponse"
                         }
@@ -796,23 +899,27 @@ const docTemplate = `{
                             "$ref": "#/definitions/v1.ErrorResponse"
                         }
                     },
+                    "404": {
+                        "description": "Journal not found",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponse"
+                        }
+                    },
                     "500": {
-                        "description": "Internal server error or access denied",
+                        "description": "Internal server error",
                         "schema": {
                             "$ref": "#/definitions/v1.ErrorResponse"
                         }
                     }
-                }
-            }
-        },
-        "/api/v1/journals/{id}/with-entries": {
-            "get": {
+                },
                 "security": [
                     {
                         "BearerAuth": []
                     }
-                ],
-                "description": "Retrieve a specific trading journal by its ID including all associated entries",
+                ]
+            },
+            "put": {
+                "description": "Update an existing trading journal's name, description, default asset, default session and tags",
                 "consumes": [
                     "application/json"
                 ],
@@ -822,7 +929,7 @@ const docTemplate = `{
                 "tags": [
                     "Trading Journals"
                 ],
-                "summary": "Get trading journal with entries",
+                "summary": "Update trading journal",
                 "parameters": [
                     {
                         "type": "string",
@@ -830,17 +937,26 @@ const docTemplate = `{
                         "name": "id",
                         "in": "path",
                         "required": true
+                    },
+                    {
+                        "description": "Updated journal details",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dto.UpdateTradingJournalRequest"
+                        }
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "Successfully retrieved trading journal with entries",
+                        "description": "Successfully updated trading journal",
                         "schema": {
-                            "$ref": "#/definitions/dto.TradingJournalWithEntriesResponse"
+                            "$ref": "#/definitions/dto.TradingJournalResponse"
                         }
                     },
                     "400": {
-                        "description": "Invalid journal ID",
+                        "description": "Invalid request body, validation failed, or invalid journal ID",
                         "schema": {
                             "$ref": "#/definitions/v1.ErrorResponse"
                         }
@@ -856,105 +972,5171 @@ const docTemplate = `{
                         "schema": {
                             "$ref": "#/definitions/v1.ErrorResponse"
                         }
-                    }
-                }
-            }
-        }
-    },
-    "definitions": {
-        "dto.AuthResponse": {
+                    },
+                    "409": {
+                        "description": "A journal with this name already exists (when unique names are enforced)",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponse"
+                        }
+                    },
+                    "423": {
+                        "description": "Journal is locked",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponse"
+                        }
+                    }
+                },
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ]
+            },
+            "delete": {
+                "description": "Delete a trading journal and all its associated entries",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Trading Journals"
+                ],
+                "summary": "Delete trading journal",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Trading Journal ID (UUID)",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Successfully deleted journal",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid journal ID",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized - missing or invalid token",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Journal not found",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponse"
+                        }
+                    },
+                    "423": {
+                        "description": "Journal is locked",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponse"
+                        }
+                    }
+                },
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ]
+            }
+        },
+        "/api/v1/journals/{id}/archive": {
+            "post": {
+                "description": "Hide a trading journal from the default list without deleting it. Archived journals stay readable by ID.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Trading Journals"
+                ],
+                "summary": "Archive trading journal",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Trading Journal ID (UUID)",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Successfully archived journal",
+                        "schema": {
+                            "$ref": "#/definitions/dto.TradingJournalResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid journal ID",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized - missing or invalid token",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Journal not found",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponse"
+                        }
+                    }
+                },
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ]
+            }
+        },
+        "/api/v1/journals/{id}/entries": {
+            "get": {
+                "description": "Get a paginated list of all entries for a specific trading journal. With modified_since the endpoint switches to incremental sync: it returns a dto.EntrySyncResponse with entries changed after that time (including deleted ones, flagged with deleted=true), ordered by updated_at, paged with cursor instead of offset, and ignores the other filters.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Trading Journal Entries"
+                ],
+                "summary": "List trading journal entries",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Trading Journal ID (UUID)",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Maximum number of entries to return (default: 20, max: 100)",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Number of entries to skip (default: 0)",
+                        "name": "offset",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Only return pinned (true) or unpinned (false) entries",
+                        "name": "pinned",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "unreviewed",
+                            "reviewed",
+                            "flagged"
+                        ],
+                        "type": "string",
+                        "description": "Only return entries with this review status",
+                        "name": "review_status",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Only return entries that followed (true) or broke (false) the trading plan",
+                        "name": "followed_plan",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "swing",
+                            "intraday"
+                        ],
+                        "type": "string",
+                        "description": "Only return entries of this trade type",
+                        "name": "trade_type",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "market",
+                            "limit"
+                        ],
+                        "type": "string",
+                        "description": "Only return entries with this entry order type",
+                        "name": "entry_type",
+                        "in": "query"
+                    },
+                    {
+                        "type": "number",
+                        "description": "Only return entries with max RR greater than or equal to this value",
+                        "name": "min_rr",
+                        "in": "query"
+                    },
+                    {
+                        "type": "number",
+                        "description": "Only return entries with max RR less than or equal to this value",
+                        "name": "max_rr",
+                        "in": "query"
+                    },
+                    {
+                        "type": "number",
+                        "description": "Only return entries with realized P\u0026L greater than or equal to this value (may be negative)",
+                        "name": "min_realized",
+                        "in": "query"
+                    },
+                    {
+                        "type": "number",
+                        "description": "Only return entries with realized P\u0026L less than or equal to this value (may be negative)",
+                        "name": "max_realized",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Sync mode: only return entries changed after this RFC 3339 timestamp",
+                        "name": "modified_since",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Sync mode: next_cursor from the previous sync page",
+                        "name": "cursor",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Successfully retrieved entries list",
+                        "schema": {
+                            "$ref": "#/definitions/dto.TradingJournalEntryListResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid journal ID or filter, or invalid limit or offset when strict query validation is enabled",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized - missing or invalid token",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Access denied - journal does not belong to user",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponse"
+                        }
+                    }
+                },
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ]
+            },
+            "post": {
+                "description": "Create a new trade entry in a specific trading journal. With from_template, the entry template's asset, session, trade type, direction and max RR fill any of those fields the request leaves out.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Trading Journal Entries"
+                ],
+                "summary": "Create a new trading journal entry",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Trading Journal ID (UUID)",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Entry template ID (UUID) of this journal whose fields fill any the request leaves out. A max_rr of 0 counts as left out and is replaced by the template's",
+                        "name": "from_template",
+                        "in": "query"
+                    },
+                    {
+                        "description": "Trading entry details",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dto.CreateTradingJournalEntryRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Successfully created trading entry",
+                        "schema": {
+                            "$ref": "#/definitions/dto.TradingJournalEntryResponse"
+                        },
+                        "headers": {
+                            "Location": {
+                                "type": "string",
+                                "description": "URL of the created entry"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid request body, validation failed (including an entry the journal's validation profile or notes requirement rejects, or a field set by neither the request nor the template), or invalid journal or template ID",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized - missing or invalid token",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Access denied - journal does not belong to user",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Journal or entry template not found",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponse"
+                        }
+                    },
+                    "423": {
+                        "description": "Journal is locked",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponse"
+                        }
+                    }
+                },
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ]
+            }
+        },
+        "/api/v1/journals/{id}/entries/bulk": {
+            "patch": {
+                "description": "Set fields on every entry of the journal matching filter, e.g. realized 0 on all break-even trades, in one transaction. Only realized, max_rr, result, session, trade_type, entry_type, followed_plan and review_status can be set. Every changed entry is validated again, including against the journal's policy, and regraded; if any entry fails, nothing is saved. An empty filter matches every entry, and limit and offset are ignored. At most 1000 entries can match. With dry_run the changed entries are returned without saving them. Entries with partial exits keep the sum of their exits as realized.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Trading Journal Entries"
+                ],
+                "summary": "Bulk update trading journal entries",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Trading Journal ID (UUID)",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Filter, fields to set and dry run flag",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dto.BulkUpdateEntriesRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Updated entries, or the preview for a dry run",
+                        "schema": {
+                            "$ref": "#/definitions/dto.BulkUpdateEntriesResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid request body, no fields to set, too many matching entries, an entry the update would leave invalid, or invalid journal ID",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized - missing or invalid token",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Access denied - journal does not belong to user",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Journal not found",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponse"
+                        }
+                    },
+                    "423": {
+                        "description": "Journal is locked",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponse"
+                        }
+                    }
+                },
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ]
+            }
+        },
+        "/api/v1/journals/{id}/entries/calendar": {
+            "get": {
+                "description": "Retrieve the number of entries and net realized per day for one year, for a contribution-style heatmap. Only days with entries are returned.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Trading Journal Entries"
+                ],
+                "summary": "Get entry calendar heatmap",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Trading Journal ID (UUID)",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Calendar year (default: current year)",
+                        "name": "year",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "IANA time zone whose days entries are grouped by, e.g. Asia/Tokyo (default: UTC)",
+                        "name": "timezone",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Successfully retrieved calendar",
+                        "schema": {
+                            "$ref": "#/definitions/dto.CalendarResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid journal ID, year or timezone",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized - missing or invalid token",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Access denied - journal does not belong to user",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponse"
+                        }
+                    }
+                },
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ]
+            }
+        },
+        "/api/v1/journals/{id}/entries/facets": {
+            "get": {
+                "description": "Retrieve the distinct assets, sessions, results and trade types that appear in the journal's entries, with how many entries use each, most used first. Values no entry uses are omitted.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Trading Journal Entries"
+                ],
+                "summary": "Get the filter values used in a journal",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Trading Journal ID (UUID)",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Successfully retrieved entry facets",
+                        "schema": {
+                            "$ref": "#/definitions/dto.EntryFacetsResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid journal ID",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized - missing or invalid token",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Access denied - journal does not belong to user",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponse"
+                        }
+                    }
+                },
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ]
+            }
+        },
+        "/api/v1/journals/{id}/entries/move": {
+            "post": {
+                "description": "Move entries of this journal to another journal owned by the same user, or copy them if copy is true. Ownership of both journals and of every entry is verified in the same transaction as the move, so either all entries are transferred or none. Entries must satisfy the target journal's policy. Copies get new IDs and timestamps; partial exits and notes stay with the source entries.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Trading Journal Entries"
+                ],
+                "summary": "Move or copy entries to another journal",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Source Trading Journal ID (UUID)",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Entries to transfer and the target journal",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dto.TransferEntriesRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Entries moved",
+                        "schema": {
+                            "$ref": "#/definitions/dto.TransferEntriesResponse"
+                        }
+                    },
+                    "201": {
+                        "description": "Entries copied",
+                        "schema": {
+                            "$ref": "#/definitions/dto.TransferEntriesResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid request body, validation failed (including an entry the target journal's validation profile or notes requirement rejects), invalid journal ID, or target journal equal to the source",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized - missing or invalid token",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Access denied - journal does not belong to user",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Journal or entries not found",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponse"
+                        }
+                    },
+                    "423": {
+                        "description": "Journal is locked",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponse"
+                        }
+                    }
+                },
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ]
+            }
+        },
+        "/api/v1/journals/{id}/entries/statistics": {
+            "get": {
+                "description": "Retrieve statistical data for a specific trading journal including win rate, total trades, and performance metrics",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Trading Journal Entries"
+                ],
+                "summary": "Get trading journal statistics",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Trading Journal ID (UUID)",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Successfully retrieved journal statistics",
+                        "schema": {
+                            "$ref": "#/definitions/dto.TradingJournalStatisticsResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid journal ID",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized - missing or invalid token",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Access denied - journal does not belong to user",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponse"
+                        }
+                    }
+                },
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ]
+            }
+        },
+        "/api/v1/journals/{id}/entries/statistics/adherence": {
+            "get": {
+                "description": "Compare win rate and net realized of trades that followed the trading plan with those that didn't",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Trading Journal Entries"
+                ],
+                "summary": "Get trading plan adherence statistics",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Trading Journal ID (UUID)",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Successfully retrieved plan adherence statistics",
+                        "schema": {
+                            "$ref": "#/definitions/dto.AdherenceStatisticsResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid journal ID",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized - missing or invalid token",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Access denied - journal does not belong to user",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponse"
+                        }
+                    }
+                },
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ]
+            }
+        },
+        "/api/v1/journals/{id}/entries/statistics/asset-correlation": {
+            "get": {
+                "description": "Retrieve per-asset win rates and, for every two assets traded on the same day, the number of shared days and the combined win rate on those days. Assets with fewer than 5 trades are skipped and only the 10 most traded assets are included.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Trading Journal Entries"
+                ],
+                "summary": "Get asset correlation matrix",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Trading Journal ID (UUID)",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "IANA time zone whose days trades are grouped by (default: UTC)",
+                        "name": "timezone",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Successfully retrieved asset correlation",
+                        "schema": {
+                            "$ref": "#/definitions/dto.AssetCorrelationResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid journal ID or timezone",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized - missing or invalid token",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Access denied - journal does not belong to user",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponse"
+                        }
+                    }
+                },
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ]
+            }
+        },
+        "/api/v1/journals/{id}/entries/statistics/by-category": {
+            "get": {
+                "description": "Retrieve win rate and net realized grouped by asset category (major, minor, exotic). Categories without trades are omitted.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Trading Journal Entries"
+                ],
+                "summary": "Get trading journal statistics by asset category",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Trading Journal ID (UUID)",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Successfully retrieved journal statistics by asset category",
+                        "schema": {
+                            "$ref": "#/definitions/dto.CategoryStatisticsListResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid journal ID",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized - missing or invalid token",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Access denied - journal does not belong to user",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponse"
+                        }
+                    }
+                },
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ]
+            }
+        },
+        "/api/v1/journals/{id}/entries/statistics/by-confidence": {
+            "get": {
+                "description": "Retrieve win rate and net realized grouped by the pre-trade confidence (1-5) recorded on each entry, to show whether conviction is calibrated. Entries without a confidence are excluded.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Trading Journal Entries"
+                ],
+                "summary": "Get trading journal statistics by confidence",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Trading Journal ID (UUID)",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Successfully retrieved journal statistics by confidence",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ConfidenceStatisticsListResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid journal ID",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized - missing or invalid token",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Access denied - journal does not belong to user",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponse"
+                        }
+                    }
+                },
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ]
+            }
+        },
+        "/api/v1/journals/{id}/entries/statistics/by-emotion": {
+            "get": {
+                "description": "Retrieve win rate and performance grouped by the emotional state recorded on each entry. Entries without an emotion are excluded.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Trading Journal Entries"
+                ],
+                "summary": "Get trading journal statistics by emotion",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Trading Journal ID (UUID)",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Successfully retrieved journal statistics by emotion",
+                        "schema": {
+                            "$ref": "#/definitions/dto.EmotionStatisticsListResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid journal ID",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized - missing or invalid token",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Access denied - journal does not belong to user",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponse"
+                        }
+                    }
+                },
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ]
+            }
+        },
+        "/api/v1/journals/{id}/entries/statistics/by-grade": {
+            "get": {
+                "description": "Retrieve win rate and performance grouped by the letter grade computed for each entry from plan adherence, RR reached versus planned and outcome. Grades without trades are omitted.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Trading Journal Entries"
+                ],
+                "summary": "Get trading journal statistics by grade",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Trading Journal ID (UUID)",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Successfully retrieved journal statistics by grade",
+                        "schema": {
+                            "$ref": "#/definitions/dto.GradeStatisticsListResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid journal ID",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized - missing or invalid token",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Access denied - journal does not belong to user",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponse"
+                        }
+                    }
+                },
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ]
+            }
+        },
+        "/api/v1/journals/{id}/entries/statistics/checklist-adherence": {
+            "get": {
+                "description": "Retrieve, for each pre-trade checklist item used in the journal, how often it was checked and the win rate of trades with and without it checked, most used items first. Entries without a checklist are excluded.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Trading Journal Entries"
+                ],
+                "summary": "Get trading journal checklist adherence",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Trading Journal ID (UUID)",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Successfully retrieved checklist adherence",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ChecklistAdherenceResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid journal ID",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized - missing or invalid token",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Access denied - journal does not belong to user",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponse"
+                        }
+                    }
+                },
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ]
+            }
+        },
+        "/api/v1/journals/{id}/entries/statistics/compare": {
+            "get": {
+                "description": "Retrieve the journal statistics for two periods side by side, with delta holding period B minus period A, e.g. to see month-over-month improvement. Periods are inclusive YYYY-MM-DD days and may overlap.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Trading Journal Entries"
+                ],
+                "summary": "Compare trading journal statistics of two periods",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Trading Journal ID (UUID)",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "First day of period A (YYYY-MM-DD)",
+                        "name": "period_a_start",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Last day of period A (YYYY-MM-DD)",
+                        "name": "period_a_end",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "First day of period B (YYYY-MM-DD)",
+                        "name": "period_b_start",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Last day of period B (YYYY-MM-DD)",
+                        "name": "period_b_end",
+                        "in": "query",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Successfully compared journal statistics",
+                        "schema": {
+                            "$ref": "#/definitions/dto.StatisticsComparisonResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid journal ID, or a missing, malformed or reversed period",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized - missing or invalid token",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Access denied - journal does not belong to user",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponse"
+                        }
+                    }
+                },
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ]
+            }
+        },
+        "/api/v1/journals/{id}/entries/statistics/review-progress": {
+            "get": {
+                "description": "Retrieve the number of entries in each review status",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Trading Journal Entries"
+                ],
+                "summary": "Get review progress",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Trading Journal ID (UUID)",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Successfully retrieved review progress",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ReviewProgressResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid journal ID",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized - missing or invalid token",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Access denied - journal does not belong to user",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponse"
+                        }
+                    }
+                },
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ]
+            }
+        },
+        "/api/v1/journals/{id}/entries/undo": {
+            "post": {
+                "description": "Restore the journal's most recently soft-deleted entry, provided it was deleted in the last 10 minutes. Only the journal owner can undo.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Trading Journal Entries"
+                ],
+                "summary": "Undo last entry deletion",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Trading Journal ID (UUID)",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Restored entry",
+                        "schema": {
+                            "$ref": "#/definitions/dto.TradingJournalEntryResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid journal ID",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized - missing or invalid token",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Access denied - journal does not belong to user",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "No recently deleted entry to restore",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponse"
+                        }
+                    },
+                    "423": {
+                        "description": "Journal is locked",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponse"
+                        }
+                    }
+                },
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ]
+            }
+        },
+        "/api/v1/journals/{id}/entries/{entryId}": {
+            "get": {
+                "description": "Retrieve a specific trading journal entry by its ID",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Trading Journal Entries"
+                ],
+                "summary": "Get trading journal entry by ID",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Trading Journal ID (UUID)",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Trading Entry ID (UUID)",
+                        "name": "entryId",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Successfully retrieved trading entry",
+                        "schema": {
+                            "$ref": "#/definitions/dto.TradingJournalEntryResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid journal ID or entry ID",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized - missing or invalid token",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Access denied - journal does not belong to user, or entry does not belong to journal",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Entry not found",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponse"
+                        }
+                    }
+                },
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ]
+            },
+            "put": {
+                "description": "Update an existing trading journal entry",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Trading Journal Entries"
+                ],
+                "summary": "Update trading journal entry",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Trading Journal ID (UUID)",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Trading Entry ID (UUID)",
+                        "name": "entryId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Updated entry details",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dto.UpdateTradingJournalEntryRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Successfully updated trading entry",
+                        "schema": {
+                            "$ref": "#/definitions/dto.TradingJournalEntryResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid request body, validation failed (including an entry the journal's validation profile or notes requirement rejects), invalid journal ID, or invalid entry ID",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized - missing or invalid token",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Access denied - journal does not belong to user, or entry does not belong to journal",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Entry not found",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponse"
+                        }
+                    },
+                    "423": {
+                        "description": "Journal is locked",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponse"
+                        }
+                    }
+                },
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ]
+            },
+            "delete": {
+                "description": "Delete a specific trading journal entry. By default the entry is soft-deleted: it disappears from all lists, counts and statistics but the row is kept. With hard=true the journal owner permanently removes the row (including an already soft-deleted entry); this cannot be undone.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Trading Journal Entries"
+                ],
+                "summary": "Delete trading journal entry",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Trading Journal ID (UUID)",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Trading Entry ID (UUID)",
+                        "name": "entryId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Permanently delete instead of soft-deleting (default: false)",
+                        "name": "hard",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Successfully deleted entry",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid journal ID or entry ID",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized - missing or invalid token",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Access denied - journal does not belong to user",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Entry not found",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponse"
+                        }
+                    },
+                    "423": {
+                        "description": "Journal is locked",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponse"
+                        }
+                    }
+                },
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ]
+            }
+        },
+        "/api/v1/journals/{id}/entries/{entryId}/duplicate": {
+            "post": {
+                "description": "Copy an existing entry into a new one in the same journal, for quickly logging a similar trade. The copy gets a new ID and timestamps and its day defaults to now; fields set in the optional body override the source values. Partial exits are not copied.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Trading Journal Entries"
+                ],
+                "summary": "Duplicate trading journal entry",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Trading Journal ID (UUID)",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Source Trading Entry ID (UUID)",
+                        "name": "entryId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Optional overrides for the copy",
+                        "name": "request",
+                        "in": "body",
+                        "schema": {
+                            "$ref": "#/definitions/dto.DuplicateTradingJournalEntryRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Successfully duplicated trading entry",
+                        "schema": {
+                            "$ref": "#/definitions/dto.TradingJournalEntryResponse"
+                        },
+                        "headers": {
+                            "Location": {
+                                "type": "string",
+                                "description": "URL of the new entry"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid request body, validation failed (including an entry the journal's validation profile or notes requirement rejects), invalid journal ID, or invalid entry ID",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized - missing or invalid token",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Access denied - journal does not belong to user",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Entry not found",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponse"
+                        }
+                    },
+                    "423": {
+                        "description": "Journal is locked",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponse"
+                        }
+                    }
+                },
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ]
+            }
+        },
+        "/api/v1/journals/{id}/entries/{entryId}/exits": {
+            "get": {
+                "description": "Get all partial exits recorded for a trade in the order they were taken, with their total realized",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Trading Journal Entries"
+                ],
+                "summary": "List an entry's exits",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Trading Journal ID (UUID)",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Trading Entry ID (UUID)",
+                        "name": "entryId",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Successfully retrieved exits",
+                        "schema": {
+                            "$ref": "#/definitions/dto.EntryExitListResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid journal ID or entry ID",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized - missing or invalid token",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Access denied - journal does not belong to user",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Entry not found",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponse"
+                        }
+                    }
+                },
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ]
+            },
+            "post": {
+                "description": "Record one tranche of a position being closed. Once an entry has exits, its realized is the sum of their realized and is used in statistics. The entry with the new realized must still satisfy the journal's policy, e.g. a take profit cannot end up with a negative realized.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Trading Journal Entries"
+                ],
+                "summary": "Record a partial exit",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Trading Journal ID (UUID)",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Trading Entry ID (UUID)",
+                        "name": "entryId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Exit details",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dto.CreateEntryExitRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Successfully recorded exit",
+                        "schema": {
+                            "$ref": "#/definitions/dto.EntryExitResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid request body, validation failed, journal policy violated, invalid journal ID, or invalid entry ID",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized - missing or invalid token",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Access denied - journal does not belong to user",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Entry not found",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponse"
+                        }
+                    },
+                    "423": {
+                        "description": "Journal is locked",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponse"
+                        }
+                    }
+                },
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ]
+            }
+        },
+        "/api/v1/journals/{id}/entries/{entryId}/links": {
+            "get": {
+                "description": "List the entries linked to a trading journal entry, newest first",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Trading Journal Entries"
+                ],
+                "summary": "Get linked entries",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Trading Journal ID (UUID)",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Trading Entry ID (UUID)",
+                        "name": "entryId",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Linked entries",
+                        "schema": {
+                            "$ref": "#/definitions/dto.RelatedEntriesResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid journal ID or entry ID",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized - missing or invalid token",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Access denied - journal does not belong to user",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Entry not found",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponse"
+                        }
+                    }
+                },
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ]
+            },
+            "post": {
+                "description": "Link an entry to another entry of the same journal, such as a re-entry or a scale-in. Links go both ways; linking entries that are already linked does nothing.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Trading Journal Entries"
+                ],
+                "summary": "Link trading journal entries",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Trading Journal ID (UUID)",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Trading Entry ID (UUID)",
+                        "name": "entryId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Entry to link to",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dto.LinkEntryRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Successfully linked entries",
+                        "schema": {
+                            "$ref": "#/definitions/dto.TradingJournalEntryResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid request body, self link, too many links, journal ID or entry ID",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized - missing or invalid token",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Access denied - journal does not belong to user",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Entry not found in this journal",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponse"
+                        }
+                    },
+                    "423": {
+                        "description": "Journal is locked",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponse"
+                        }
+                    }
+                },
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ]
+            }
+        },
+        "/api/v1/journals/{id}/entries/{entryId}/links/{linkedId}": {
+            "delete": {
+                "description": "Remove the link between two entries, from both sides. Unlinking entries that are not linked does nothing.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Trading Journal Entries"
+                ],
+                "summary": "Unlink trading journal entries",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Trading Journal ID (UUID)",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Trading Entry ID (UUID)",
+                        "name": "entryId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Linked Entry ID (UUID)",
+                        "name": "linkedId",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Successfully unlinked entries",
+                        "schema": {
+                            "$ref": "#/definitions/dto.TradingJournalEntryResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid journal ID or entry ID",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized - missing or invalid token",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Access denied - journal does not belong to user",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Entry not found",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponse"
+                        }
+                    },
+                    "423": {
+                        "description": "Journal is locked",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponse"
+                        }
+                    }
+                },
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ]
+            }
+        },
+        "/api/v1/journals/{id}/entries/{entryId}/notes": {
+            "get": {
+                "description": "Get all review notes appended to a trade, oldest first",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Trading Journal Entries"
+                ],
+                "summary": "List an entry's review notes",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Trading Journal ID (UUID)",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Trading Entry ID (UUID)",
+                        "name": "entryId",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Successfully retrieved notes",
+                        "schema": {
+                            "$ref": "#/definitions/dto.EntryNoteListResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid journal ID or entry ID",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized - missing or invalid token",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Access denied - journal does not belong to user",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Entry not found",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponse"
+                        }
+                    }
+                },
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ]
+            },
+            "post": {
+                "description": "Append a dated review note to a trade. Notes are append-only and do not change the entry's original notes.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Trading Journal Entries"
+                ],
+                "summary": "Append a review note to an entry",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Trading Journal ID (UUID)",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Trading Entry ID (UUID)",
+                        "name": "entryId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Note body",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dto.CreateEntryNoteRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Successfully appended note",
+                        "schema": {
+                            "$ref": "#/definitions/dto.EntryNoteResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid request body, validation failed, invalid journal ID, or invalid entry ID",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized - missing or invalid token",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Access denied - journal does not belong to user",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Entry not found",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponse"
+                        }
+                    },
+                    "423": {
+                        "description": "Journal is locked",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponse"
+                        }
+                    }
+                },
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ]
+            }
+        },
+        "/api/v1/journals/{id}/entries/{entryId}/pin": {
+            "post": {
+                "description": "Mark a trading journal entry as pinned for later review",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Trading Journal Entries"
+                ],
+                "summary": "Pin trading journal entry",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Trading Journal ID (UUID)",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Trading Entry ID (UUID)",
+                        "name": "entryId",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Successfully pinned entry",
+                        "schema": {
+                            "$ref": "#/definitions/dto.TradingJournalEntryResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid journal ID or entry ID",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized - missing or invalid token",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Access denied - journal does not belong to user",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Entry not found",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponse"
+                        }
+                    },
+                    "423": {
+                        "description": "Journal is locked",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponse"
+                        }
+                    }
+                },
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ]
+            }
+        },
+        "/api/v1/journals/{id}/entries/{entryId}/review": {
+            "patch": {
+                "description": "Move a trading journal entry through the post-trade review workflow. New entries start as unreviewed; any status can be set from any other.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Trading Journal Entries"
+                ],
+                "summary": "Update entry review status",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Trading Journal ID (UUID)",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Trading Entry ID (UUID)",
+                        "name": "entryId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "New review status",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dto.UpdateReviewStatusRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Successfully updated review status",
+                        "schema": {
+                            "$ref": "#/definitions/dto.TradingJournalEntryResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid request body, review status, journal ID or entry ID",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized - missing or invalid token",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Access denied - journal does not belong to user",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Entry not found",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponse"
+                        }
+                    },
+                    "423": {
+                        "description": "Journal is locked",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponse"
+                        }
+                    }
+                },
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ]
+            }
+        },
+        "/api/v1/journals/{id}/entries/{entryId}/unpin": {
+            "post": {
+                "description": "Remove the pinned mark from a trading journal entry",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Trading Journal Entries"
+                ],
+                "summary": "Unpin trading journal entry",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Trading Journal ID (UUID)",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Trading Entry ID (UUID)",
+                        "name": "entryId",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Successfully unpinned entry",
+                        "schema": {
+                            "$ref": "#/definitions/dto.TradingJournalEntryResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid journal ID or entry ID",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized - missing or invalid token",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Access denied - journal does not belong to user",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Entry not found",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponse"
+                        }
+                    },
+                    "423": {
+                        "description": "Journal is locked",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponse"
+                        }
+                    }
+                },
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ]
+            }
+        },
+        "/api/v1/journals/{id}/entry-templates": {
+            "get": {
+                "description": "Get a paginated list of the journal's entry templates, ordered by name",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Entry Templates"
+                ],
+                "summary": "List a journal's entry templates",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Trading Journal ID (UUID)",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Maximum number of templates to return (default: 20, max: 100)",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Number of templates to skip (default: 0)",
+                        "name": "offset",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Successfully retrieved templates list",
+                        "schema": {
+                            "$ref": "#/definitions/dto.EntryTemplateListResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid journal ID, or invalid limit or offset when strict query validation is enabled",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized - missing or invalid token",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Access denied - journal does not belong to user",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponse"
+                        }
+                    }
+                },
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ]
+            },
+            "post": {
+                "description": "Save the setup fields of a frequently traded setup (asset, session, trade type, direction, max RR) for the journal. Pass its ID as from_template when creating an entry to only send the outcome fields.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Entry Templates"
+                ],
+                "summary": "Create an entry template",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Trading Journal ID (UUID)",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Entry template details",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dto.CreateEntryTemplateRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Successfully created entry template",
+                        "schema": {
+                            "$ref": "#/definitions/dto.EntryTemplateResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid request body, validation failed, or invalid journal ID",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized - missing or invalid token",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Access denied - journal does not belong to user",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Journal not found",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponse"
+                        }
+                    }
+                },
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ]
+            }
+        },
+        "/api/v1/journals/{id}/entry-templates/{templateId}": {
+            "delete": {
+                "description": "Delete an entry template. Entries already created from it are not affected.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Entry Templates"
+                ],
+                "summary": "Delete entry template",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Trading Journal ID (UUID)",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Entry Template ID (UUID)",
+                        "name": "templateId",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Successfully deleted template",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid journal or template ID",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized - missing or invalid token",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Access denied - journal does not belong to user",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Entry template not found",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponse"
+                        }
+                    }
+                },
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ]
+            }
+        },
+        "/api/v1/journals/{id}/export": {
+            "get": {
+                "description": "Export the journal and all its entries as a self-contained, versioned JSON document that can be re-imported via POST /api/v1/journals/import. The document is streamed while entries are loaded, so memory use doesn't depend on the journal's size; an error midway leaves it truncated rather than returning an error status, and a complete document ends with the closing brace.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Trading Journals"
+                ],
+                "summary": "Export trading journal",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Trading Journal ID (UUID)",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "default": "json",
+                        "description": "Export format (only json is supported)",
+                        "name": "format",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Journal export document",
+                        "schema": {
+                            "$ref": "#/definitions/dto.JournalExportDocument"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid journal ID or unsupported format",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized - missing or invalid token",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Journal not found",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponse"
+                        }
+                    }
+                },
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ]
+            },
+            "post": {
+                "description": "Queue an export of the journal and its entries, for journals too large to export within a single request. Poll GET /api/v1/exports/{jobId} until the status is ready, then download the document from download_url. Jobs and results expire after an hour. When too many exports are already queued the job is refused with 503; retry later.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Trading Journals"
+                ],
+                "summary": "Start an asynchronous journal export",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Trading Journal ID (UUID)",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "202": {
+                        "description": "Export job queued",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ExportJobResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid journal ID",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized - missing or invalid token",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Journal not found",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponse"
+                        }
+                    },
+                    "503": {
+                        "description": "Asynchronous export is unavailable or too many exports are queued",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponse"
+                        }
+                    }
+                },
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ]
+            }
+        },
+        "/api/v1/journals/{id}/import": {
+            "post": {
+                "description": "Add the trades of a cTrader position history or TradingView strategy tester \"List of trades\" CSV export to the journal. Platform exports have no chart links or planned RR, so ltf, htf and max_rr apply to every imported trade; session, trade type and result are derived from the open and close times and the net profit. Trades that cannot be mapped or fail validation are skipped and reported by line; the rest are imported together.",
+                "consumes": [
+                    "text/csv"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Trading Journals"
+                ],
+                "summary": "Import entries from a trading platform",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Trading Journal ID (UUID)",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "enum": [
+                            "ctrader",
+                            "tradingview"
+                        ],
+                        "type": "string",
+                        "description": "Platform the export comes from",
+                        "name": "source",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Lower timeframe chart URL for every imported entry",
+                        "name": "ltf",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Higher timeframe chart URL for every imported entry",
+                        "name": "htf",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "number",
+                        "description": "Max RR for every imported entry",
+                        "name": "max_rr",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Currency pair for trades without a symbol (TradingView exports have none)",
+                        "name": "asset",
+                        "in": "query"
+                    },
+                    {
+                        "description": "CSV export",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Imported entries and skipped rows",
+                        "schema": {
+                            "$ref": "#/definitions/dto.PlatformImportResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid journal ID, query parameters, or file",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized - missing or invalid token",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Journal not found",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponse"
+                        }
+                    },
+                    "423": {
+                        "description": "Journal is locked",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponse"
+                        }
+                    }
+                },
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ]
+            }
+        },
+        "/api/v1/journals/{id}/lock": {
+            "post": {
+                "description": "Make a trading journal read-only, e.g. to freeze a completed prop-firm challenge. While locked, every write to the journal, its entries, their notes and exits is rejected with 423; reads keep working.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Trading Journals"
+                ],
+                "summary": "Lock trading journal",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Trading Journal ID (UUID)",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Successfully locked journal",
+                        "schema": {
+                            "$ref": "#/definitions/dto.TradingJournalResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid journal ID",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized - missing or invalid token",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Journal not found",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponse"
+                        }
+                    }
+                },
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ]
+            }
+        },
+        "/api/v1/journals/{id}/recompute-summary": {
+            "post": {
+                "description": "Rebuild the journal's stored summary (trade count, wins, win rate, net realized) from its entries. The summary is kept up to date on every entry write and shown in the journal list; this repairs it if it has drifted.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Trading Journals"
+                ],
+                "summary": "Recompute journal summary",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Trading Journal ID (UUID)",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Recomputed summary",
+                        "schema": {
+                            "$ref": "#/definitions/dto.JournalSummaryResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid journal ID",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized - missing or invalid token",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Journal not found",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponse"
+                        }
+                    }
+                },
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ]
+            }
+        },
+        "/api/v1/journals/{id}/unarchive": {
+            "post": {
+                "description": "Restore an archived trading journal to the default list",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Trading Journals"
+                ],
+                "summary": "Unarchive trading journal",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Trading Journal ID (UUID)",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Successfully unarchived journal",
+                        "schema": {
+                            "$ref": "#/definitions/dto.TradingJournalResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid journal ID",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized - missing or invalid token",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Journal not found",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponse"
+                        }
+                    }
+                },
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ]
+            }
+        },
+        "/api/v1/journals/{id}/unlock": {
+            "post": {
+                "description": "Make a locked trading journal writable again",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Trading Journals"
+                ],
+                "summary": "Unlock trading journal",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Trading Journal ID (UUID)",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Successfully unlocked journal",
+                        "schema": {
+                            "$ref": "#/definitions/dto.TradingJournalResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid journal ID",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized - missing or invalid token",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Journal not found",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponse"
+                        }
+                    }
+                },
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ]
+            }
+        },
+        "/api/v1/journals/{id}/with-entries": {
+            "get": {
+                "description": "Retrieve a specific trading journal by its ID with one page of its entries, newest first",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Trading Journals"
+                ],
+                "summary": "Get trading journal with entries",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Trading Journal ID (UUID)",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Maximum number of entries to return (default: 20, max: 100)",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Number of entries to skip (default: 0)",
+                        "name": "offset",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Successfully retrieved trading journal with entries",
+                        "schema": {
+                            "$ref": "#/definitions/dto.TradingJournalWithEntriesResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid journal ID, or invalid limit or offset (only when strict query validation is enabled)",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized - missing or invalid token",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Journal not found",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponse"
+                        }
+                    }
+                },
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ]
+            }
+        },
+        "/api/v1/meta/enums": {
+            "get": {
+                "description": "Retrieve every valid value of the enums the API accepts, such as currency pairs, sessions and timeframes, so clients can build dropdowns without hardcoding them",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Meta"
+                ],
+                "summary": "List valid enum values",
+                "responses": {
+                    "200": {
+                        "description": "Successfully retrieved enum values",
+                        "schema": {
+                            "$ref": "#/definitions/dto.EnumsResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/users/me": {
+            "get": {
+                "description": "Retrieve the profile of the authenticated user",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Users"
+                ],
+                "summary": "Get current user",
+                "responses": {
+                    "200": {
+                        "description": "Successfully retrieved user",
+                        "schema": {
+                            "$ref": "#/definitions/dto.UserResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized - missing or invalid token",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "User not found",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponse"
+                        }
+                    }
+                },
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ]
+            }
+        },
+        "/api/v1/users/me/export": {
+            "get": {
+                "description": "Download everything stored about the authenticated user as one JSON document, for data portability: the profile (without the password hash), every device session including expired ones, every journal including archived ones, every entry, and the entries' review notes and partial exits. The document is streamed while it is generated, so an error midway leaves it truncated rather than returning an error status; a complete document ends with the closing brace.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Users"
+                ],
+                "summary": "Export account data",
+                "responses": {
+                    "200": {
+                        "description": "Account export document",
+                        "schema": {
+                            "$ref": "#/definitions/dto.AccountExportDocument"
+                        },
+                        "headers": {
+                            "Content-Disposition": {
+                                "type": "string",
+                                "description": "attachment; filename=\\\"normark-export-{user id}.json\\"
+                            }
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized - missing or invalid token",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "User not found",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponse"
+                        }
+                    }
+                },
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ]
+            }
+        },
+        "/api/v1/users/me/sessions": {
+            "get": {
+                "description": "List the authenticated user's signed-in devices, most recently used first",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Users"
+                ],
+                "summary": "List active sessions",
+                "responses": {
+                    "200": {
+                        "description": "Successfully retrieved sessions",
+                        "schema": {
+                            "$ref": "#/definitions/dto.SessionListResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized - missing or invalid token",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponse"
+                        }
+                    }
+                },
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ]
+            }
+        },
+        "/api/v1/users/me/sessions/{id}": {
+            "delete": {
+                "description": "Sign out one device. Its refresh token stops working; other sessions are unaffected. Access tokens already issued stay valid until they expire.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Users"
+                ],
+                "summary": "Revoke a session",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Session ID (UUID)",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Successfully revoked session",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid session ID",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized - missing or invalid token",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Session not found",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponse"
+                        }
+                    }
+                },
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ]
+            }
+        },
+        "/api/v1/users/me/statistics": {
+            "get": {
+                "description": "Retrieve aggregate trade counts, win rate and net realized across every journal owned by the authenticated user, with a per-journal summary",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Users"
+                ],
+                "summary": "Get statistics across all of the user's journals",
+                "responses": {
+                    "200": {
+                        "description": "Successfully retrieved user statistics",
+                        "schema": {
+                            "$ref": "#/definitions/dto.UserStatisticsResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized - missing or invalid token",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponse"
+                        }
+                    }
+                },
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ]
+            }
+        }
+    },
+    "definitions": {
+        "dto.AccountExportDocument": {
+            "type": "object",
+            "properties": {
+                "entries": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dto.TradingJournalEntryResponse"
+                    }
+                },
+                "entry_exits": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dto.EntryExitResponse"
+                    }
+                },
+                "entry_notes": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dto.EntryNoteResponse"
+                    }
+                },
+                "exported_at": {
+                    "type": "string"
+                },
+                "journals": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dto.TradingJournalResponse"
+                    }
+                },
+                "sessions": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dto.SessionResponse"
+                    }
+                },
+                "user": {
+                    "$ref": "#/definitions/dto.UserResponse"
+                },
+                "version": {
+                    "type": "integer"
+                }
+            }
+        },
+        "dto.AdherenceStatisticsResponse": {
+            "type": "object",
+            "properties": {
+                "in_plan": {
+                    "$ref": "#/definitions/dto.PlanAdherenceBucketResponse"
+                },
+                "out_of_plan": {
+                    "$ref": "#/definitions/dto.PlanAdherenceBucketResponse"
+                },
+                "realized_difference": {
+                    "description": "RealizedDifference is in-plan minus out-of-plan net realized.",
+                    "type": "number"
+                }
+            }
+        },
+        "dto.AssetCorrelationResponse": {
+            "type": "object",
+            "properties": {
+                "assets": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dto.AssetStatisticsResponse"
+                    }
+                },
+                "pairs": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dto.AssetPairStatisticsResponse"
+                    }
+                }
+            }
+        },
+        "dto.AssetPairStatisticsResponse": {
+            "type": "object",
+            "properties": {
+                "asset_a": {
+                    "$ref": "#/definitions/types.CurrencyPair"
+                },
+                "asset_b": {
+                    "$ref": "#/definitions/types.CurrencyPair"
+                },
+                "shared_days": {
+                    "type": "integer"
+                },
+                "total_trades": {
+                    "type": "integer"
+                },
+                "win_rate": {
+                    "type": "number"
+                },
+                "wins": {
+                    "type": "integer"
+                }
+            }
+        },
+        "dto.AssetStatisticsResponse": {
+            "type": "object",
+            "properties": {
+                "asset": {
+                    "$ref": "#/definitions/types.CurrencyPair"
+                },
+                "total_trades": {
+                    "type": "integer"
+                },
+                "win_rate": {
+                    "type": "number"
+                },
+                "wins": {
+                    "type": "integer"
+                }
+            }
+        },
+        "dto.AuthResponse": {
+            "type": "object",
+            "properties": {
+                "access_token": {
+                    "type": "string"
+                },
+                "expires_at": {
+                    "type": "string"
+                },
+                "refresh_token": {
+                    "type": "string"
+                }
+            }
+        },
+        "dto.BulkEntryUpdate": {
+            "type": "object",
+            "properties": {
+                "entry_type": {
+                    "$ref": "#/definitions/types.EntryType"
+                },
+                "followed_plan": {
+                    "type": "boolean"
+                },
+                "max_rr": {
+                    "type": "number"
+                },
+                "realized": {
+                    "type": "number"
+                },
+                "result": {
+                    "$ref": "#/definitions/types.TradeResult"
+                },
+                "review_status": {
+                    "$ref": "#/definitions/types.ReviewStatus"
+                },
+                "session": {
+                    "$ref": "#/definitions/types.TradingSession"
+                },
+                "trade_type": {
+                    "$ref": "#/definitions/types.TradeType"
+                }
+            }
+        },
+        "dto.BulkUpdateEntriesRequest": {
+            "type": "object",
+            "properties": {
+                "dry_run": {
+                    "type": "boolean"
+                },
+                "filter": {
+                    "$ref": "#/definitions/dto.FilterEntriesRequest"
+                },
+                "set": {
+                    "$ref": "#/definitions/dto.BulkEntryUpdate"
+                }
+            }
+        },
+        "dto.BulkUpdateEntriesResponse": {
+            "type": "object",
+            "properties": {
+                "dry_run": {
+                    "type": "boolean"
+                },
+                "entries": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dto.TradingJournalEntryResponse"
+                    }
+                },
+                "matched": {
+                    "type": "integer"
+                }
+            }
+        },
+        "dto.CalendarDayResponse": {
+            "type": "object",
+            "properties": {
+                "count": {
+                    "type": "integer"
+                },
+                "net_realized": {
+                    "type": "number"
+                }
+            }
+        },
+        "dto.CalendarResponse": {
+            "type": "object",
+            "properties": {
+                "days": {
+                    "description": "Days is keyed by date (YYYY-MM-DD). Days without entries are omitted.",
+                    "type": "object",
+                    "additionalProperties": {
+                        "$ref": "#/definitions/dto.CalendarDayResponse"
+                    }
+                },
+                "year": {
+                    "type": "integer"
+                }
+            }
+        },
+        "dto.CategoryStatisticsListResponse": {
+            "type": "object",
+            "properties": {
+                "categories": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dto.CategoryStatisticsResponse"
+                    }
+                }
+            }
+        },
+        "dto.CategoryStatisticsResponse": {
+            "type": "object",
+            "properties": {
+                "break_even": {
+                    "type": "integer"
+                },
+                "category": {
+                    "$ref": "#/definitions/types.AssetCategory"
+                },
+                "losses": {
+                    "type": "integer"
+                },
+                "total_realized": {
+                    "type": "number"
+                },
+                "total_trades": {
+                    "type": "integer"
+                },
+                "win_rate": {
+                    "type": "number"
+                },
+                "wins": {
+                    "type": "integer"
+                }
+            }
+        },
+        "dto.ChecklistAdherenceResponse": {
+            "type": "object",
+            "properties": {
+                "items": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dto.ChecklistItemStatisticsResponse"
+                    }
+                }
+            }
+        },
+        "dto.ChecklistItemStatisticsResponse": {
+            "type": "object",
+            "properties": {
+                "adherence_rate": {
+                    "description": "AdherenceRate is the percentage of trades the item was checked on.",
+                    "type": "number"
+                },
+                "checked": {
+                    "type": "integer"
+                },
+                "checked_win_rate": {
+                    "type": "number"
+                },
+                "item": {
+                    "type": "string"
+                },
+                "total_trades": {
+                    "type": "integer"
+                },
+                "unchecked_win_rate": {
+                    "type": "number"
+                },
+                "win_rate_difference": {
+                    "description": "WinRateDifference is the checked minus the unchecked win rate; the\nlarger it is, the more the item goes with winning trades.",
+                    "type": "number"
+                }
+            }
+        },
+        "dto.ConfidenceStatisticsListResponse": {
+            "type": "object",
+            "properties": {
+                "levels": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dto.ConfidenceStatisticsResponse"
+                    }
+                }
+            }
+        },
+        "dto.ConfidenceStatisticsResponse": {
+            "type": "object",
+            "properties": {
+                "break_even": {
+                    "type": "integer"
+                },
+                "confidence": {
+                    "type": "integer"
+                },
+                "losses": {
+                    "type": "integer"
+                },
+                "total_realized": {
+                    "type": "number"
+                },
+                "total_trades": {
+                    "type": "integer"
+                },
+                "win_rate": {
+                    "type": "number"
+                },
+                "wins": {
+                    "type": "integer"
+                }
+            }
+        },
+        "dto.CreateEntryExitRequest": {
+            "type": "object",
+            "required": [
+                "price",
+                "size"
+            ],
+            "properties": {
+                "exited_at": {
+                    "description": "ExitedAt defaults to the time the exit is recorded.",
+                    "type": "string"
+                },
+                "price": {
+                    "type": "number"
+                },
+                "realized": {
+                    "type": "number"
+                },
+                "size": {
+                    "type": "number"
+                }
+            }
+        },
+        "dto.CreateEntryNoteRequest": {
+            "type": "object",
+            "required": [
+                "body"
+            ],
+            "properties": {
+                "body": {
+                    "type": "string",
+                    "maxLength": 5000,
+                    "minLength": 1
+                }
+            }
+        },
+        "dto.CreateEntryTemplateRequest": {
+            "type": "object",
+            "required": [
+                "name"
+            ],
+            "properties": {
+                "asset": {
+                    "$ref": "#/definitions/types.CurrencyPair"
+                },
+                "direction": {
+                    "$ref": "#/definitions/types.TradeDirection"
+                },
+                "max_rr": {
+                    "type": "number"
+                },
+                "name": {
+                    "type": "string",
+                    "maxLength": 255,
+                    "minLength": 1
+                },
+                "session": {
+                    "$ref": "#/definitions/types.TradingSession"
+                },
+                "trade_type": {
+                    "$ref": "#/definitions/types.TradeType"
+                }
+            }
+        },
+        "dto.CreateJournalTemplateRequest": {
+            "type": "object",
+            "required": [
+                "name"
+            ],
+            "properties": {
+                "default_asset": {
+                    "$ref": "#/definitions/types.CurrencyPair"
+                },
+                "default_session": {
+                    "$ref": "#/definitions/types.TradingSession"
+                },
+                "description": {
+                    "type": "string",
+                    "maxLength": 1000
+                },
+                "name": {
+                    "type": "string",
+                    "maxLength": 255,
+                    "minLength": 1
+                },
+                "tags": {
+                    "type": "array",
+                    "maxItems": 20,
+                    "items": {
+                        "type": "string"
+                    }
+                }
+            }
+        },
+        "dto.CreateTradingJournalEntryRequest": {
+            "type": "object",
+            "required": [
+                "day",
+                "entry_type",
+                "htf",
+                "ltf",
+                "realized",
+                "result"
+            ],
+            "properties": {
+                "asset": {
+                    "$ref": "#/definitions/types.CurrencyPair"
+                },
+                "checklist": {
+                    "type": "array",
+                    "maxItems": 30,
+                    "uniqueItems": true,
+                    "items": {
+                        "$ref": "#/definitions/types.ChecklistItem"
+                    }
+                },
+                "confidence": {
+                    "type": "integer",
+                    "maximum": 5,
+                    "minimum": 1
+                },
+                "day": {
+                    "type": "string"
+                },
+                "direction": {
+                    "$ref": "#/definitions/types.TradeDirection"
+                },
+                "emotion": {
+                    "$ref": "#/definitions/types.Emotion"
+                },
+                "entry_charts": {
+                    "type": "array",
+                    "maxItems": 10,
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "entry_price": {
+                    "type": "number"
+                },
+                "entry_type": {
+                    "$ref": "#/definitions/types.EntryType"
+                },
+                "followed_plan": {
+                    "type": "boolean"
+                },
+                "htf": {
+                    "type": "string"
+                },
+                "ltf": {
+                    "type": "string"
+                },
+                "max_rr": {
+                    "type": "number",
+                    "minimum": 0
+                },
+                "notes": {
+                    "type": "string",
+                    "maxLength": 5000
+                },
+                "position_size": {
+                    "type": "number",
+                    "minimum": 0
+                },
+                "realized": {
+                    "type": "number"
+                },
+                "result": {
+                    "$ref": "#/definitions/types.TradeResult"
+                },
+                "risk_percent": {
+                    "type": "number",
+                    "maximum": 100,
+                    "minimum": 0
+                },
+                "session": {
+                    "$ref": "#/definitions/types.TradingSession"
+                },
+                "setup": {
+                    "type": "string",
+                    "maxLength": 500
+                },
+                "setup_charts": {
+                    "type": "array",
+                    "maxItems": 10,
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "stop_loss_price": {
+                    "type": "number"
+                },
+                "take_profit_price": {
+                    "type": "number"
+                },
+                "trade_type": {
+                    "$ref": "#/definitions/types.TradeType"
+                }
+            }
+        },
+        "dto.CreateTradingJournalRequest": {
+            "type": "object",
+            "required": [
+                "name"
+            ],
+            "properties": {
+                "account_number": {
+                    "type": "string",
+                    "maxLength": 50
+                },
+                "broker": {
+                    "type": "string",
+                    "maxLength": 100
+                },
+                "default_asset": {
+                    "$ref": "#/definitions/types.CurrencyPair"
+                },
+                "default_session": {
+                    "$ref": "#/definitions/types.TradingSession"
+                },
+                "description": {
+                    "type": "string",
+                    "maxLength": 1000
+                },
+                "name": {
+                    "type": "string",
+                    "maxLength": 255,
+                    "minLength": 1
+                },
+                "pip_value": {
+                    "type": "number"
+                },
+                "require_notes_on_loss": {
+                    "type": "boolean"
+                },
+                "tags": {
+                    "type": "array",
+                    "maxItems": 20,
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "validation_profile": {
+                    "description": "ValidationProfile defaults to standard on create and is left\nunchanged on update when empty.",
+                    "enum": [
+                        "lenient",
+                        "standard",
+                        "strict"
+                    ],
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/types.ValidationProfile"
+                        }
+                    ]
+                }
+            }
+        },
+        "dto.DuplicateTradingJournalEntryRequest": {
+            "type": "object",
+            "properties": {
+                "asset": {
+                    "$ref": "#/definitions/types.CurrencyPair"
+                },
+                "day": {
+                    "type": "string"
+                },
+                "max_rr": {
+                    "type": "number"
+                },
+                "notes": {
+                    "type": "string",
+                    "maxLength": 5000
+                },
+                "realized": {
+                    "type": "number"
+                },
+                "result": {
+                    "$ref": "#/definitions/types.TradeResult"
+                }
+            }
+        },
+        "dto.EmotionStatisticsListResponse": {
+            "type": "object",
+            "properties": {
+                "emotions": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dto.EmotionStatisticsResponse"
+                    }
+                }
+            }
+        },
+        "dto.EmotionStatisticsResponse": {
+            "type": "object",
+            "properties": {
+                "break_even": {
+                    "type": "integer"
+                },
+                "emotion": {
+                    "$ref": "#/definitions/types.Emotion"
+                },
+                "losses": {
+                    "type": "integer"
+                },
+                "total_realized": {
+                    "type": "number"
+                },
+                "total_trades": {
+                    "type": "integer"
+                },
+                "win_rate": {
+                    "type": "number"
+                },
+                "wins": {
+                    "type": "integer"
+                }
+            }
+        },
+        "dto.EntryExitListResponse": {
+            "type": "object",
+            "properties": {
+                "exits": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dto.EntryExitResponse"
+                    }
+                },
+                "total": {
+                    "type": "integer"
+                },
+                "total_realized": {
+                    "description": "TotalRealized is the entry's effective realized: the sum of its exits.",
+                    "type": "number"
+                }
+            }
+        },
+        "dto.EntryExitResponse": {
+            "type": "object",
+            "properties": {
+                "created_at": {
+                    "type": "string"
+                },
+                "entry_id": {
+                    "type": "string"
+                },
+                "exited_at": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "price": {
+                    "type": "number"
+                },
+                "realized": {
+                    "type": "number"
+                },
+                "size": {
+                    "type": "number"
+                }
+            }
+        },
+        "dto.EntryFacetsResponse": {
+            "type": "object",
+            "properties": {
+                "assets": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dto.FacetValueResponse"
+                    }
+                },
+                "results": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dto.FacetValueResponse"
+                    }
+                },
+                "sessions": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dto.FacetValueResponse"
+                    }
+                },
+                "trade_types": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dto.FacetValueResponse"
+                    }
+                }
+            }
+        },
+        "dto.EntryNoteListResponse": {
+            "type": "object",
+            "properties": {
+                "notes": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dto.EntryNoteResponse"
+                    }
+                },
+                "total": {
+                    "type": "integer"
+                }
+            }
+        },
+        "dto.EntryNoteResponse": {
+            "type": "object",
+            "properties": {
+                "body": {
+                    "type": "string"
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "entry_id": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                }
+            }
+        },
+        "dto.EntryTemplateListResponse": {
+            "type": "object",
+            "properties": {
+                "current_page": {
+                    "type": "integer"
+                },
+                "has_next": {
+                    "type": "boolean"
+                },
+                "has_prev": {
+                    "type": "boolean"
+                },
+                "limit": {
+                    "type": "integer"
+                },
+                "offset": {
+                    "type": "integer"
+                },
+                "templates": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dto.EntryTemplateResponse"
+                    }
+                },
+                "total": {
+                    "type": "integer"
+                },
+                "total_pages": {
+                    "type": "integer"
+                }
+            }
+        },
+        "dto.EntryTemplateResponse": {
+            "type": "object",
+            "properties": {
+                "asset": {
+                    "$ref": "#/definitions/types.CurrencyPair"
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "direction": {
+                    "$ref": "#/definitions/types.TradeDirection"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "journal_id": {
+                    "type": "string"
+                },
+                "max_rr": {
+                    "type": "number"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "session": {
+                    "$ref": "#/definitions/types.TradingSession"
+                },
+                "trade_type": {
+                    "$ref": "#/definitions/types.TradeType"
+                },
+                "updated_at": {
+                    "type": "string"
+                }
+            }
+        },
+        "dto.EnumsResponse": {
+            "type": "object",
+            "properties": {
+                "currency_pairs": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/types.CurrencyPair"
+                    }
+                },
+                "entry_types": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/types.EntryType"
+                    }
+                },
+                "time_frames": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/types.TimeFrame"
+                    }
+                },
+                "trade_directions": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/types.TradeDirection"
+                    }
+                },
+                "trade_results": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/types.TradeResult"
+                    }
+                },
+                "trade_types": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/types.TradeType"
+                    }
+                },
+                "trading_sessions": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/types.TradingSession"
+                    }
+                }
+            }
+        },
+        "dto.ExportJobResponse": {
+            "type": "object",
+            "properties": {
+                "completed_at": {
+                    "type": "string"
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "download_url": {
+                    "description": "DownloadURL is set once the job is ready.",
+                    "type": "string"
+                },
+                "error": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "journal_id": {
+                    "type": "string"
+                },
+                "status": {
+                    "type": "string",
+                    "enum": [
+                        "pending",
+                        "running",
+                        "ready",
+                        "failed"
+                    ]
+                }
+            }
+        },
+        "dto.FacetValueResponse": {
+            "type": "object",
+            "properties": {
+                "count": {
+                    "type": "integer"
+                },
+                "value": {
+                    "type": "string"
+                }
+            }
+        },
+        "dto.FilterEntriesRequest": {
+            "type": "object",
+            "properties": {
+                "asset": {
+                    "$ref": "#/definitions/types.CurrencyPair"
+                },
+                "end_date": {
+                    "type": "string"
+                },
+                "entry_type": {
+                    "$ref": "#/definitions/types.EntryType"
+                },
+                "followed_plan": {
+                    "type": "boolean"
+                },
+                "limit": {
+                    "type": "integer",
+                    "maximum": 100,
+                    "minimum": 1
+                },
+                "max_realized": {
+                    "type": "number"
+                },
+                "max_rr": {
+                    "type": "number",
+                    "minimum": 0
+                },
+                "min_realized": {
+                    "type": "number"
+                },
+                "min_rr": {
+                    "type": "number",
+                    "minimum": 0
+                },
+                "offset": {
+                    "type": "integer",
+                    "minimum": 0
+                },
+                "pinned": {
+                    "type": "boolean"
+                },
+                "result": {
+                    "$ref": "#/definitions/types.TradeResult"
+                },
+                "review_status": {
+                    "$ref": "#/definitions/types.ReviewStatus"
+                },
+                "session": {
+                    "$ref": "#/definitions/types.TradingSession"
+                },
+                "start_date": {
+                    "type": "string"
+                },
+                "trade_type": {
+                    "$ref": "#/definitions/types.TradeType"
+                }
+            }
+        },
+        "dto.GoogleSignInRequest": {
+            "type": "object",
+            "required": [
+                "id_token"
+            ],
+            "properties": {
+                "id_token": {
+                    "type": "string"
+                }
+            }
+        },
+        "dto.GradeStatisticsListResponse": {
+            "type": "object",
+            "properties": {
+                "grades": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dto.GradeStatisticsResponse"
+                    }
+                }
+            }
+        },
+        "dto.GradeStatisticsResponse": {
+            "type": "object",
+            "properties": {
+                "break_even": {
+                    "type": "integer"
+                },
+                "grade": {
+                    "$ref": "#/definitions/types.TradeGrade"
+                },
+                "losses": {
+                    "type": "integer"
+                },
+                "total_realized": {
+                    "type": "number"
+                },
+                "total_trades": {
+                    "type": "integer"
+                },
+                "win_rate": {
+                    "type": "number"
+                },
+                "wins": {
+                    "type": "integer"
+                }
+            }
+        },
+        "dto.ImportPreviewResponse": {
             "type": "object",
             "properties": {
-                "access_token": {
+                "entries_to_create": {
+                    "type": "integer"
+                },
+                "errors": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dto.ImportRowErrorResponse"
+                    }
+                },
+                "journal_name": {
+                    "type": "string"
+                }
+            }
+        },
+        "dto.ImportRowErrorResponse": {
+            "type": "object",
+            "properties": {
+                "error": {
+                    "type": "string"
+                },
+                "index": {
+                    "description": "Index is the entry's position in the document's entries array.",
+                    "type": "integer"
+                }
+            }
+        },
+        "dto.JournalExportDocument": {
+            "type": "object",
+            "required": [
+                "version"
+            ],
+            "properties": {
+                "entries": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dto.JournalExportEntry"
+                    }
+                },
+                "exported_at": {
+                    "type": "string"
+                },
+                "journal": {
+                    "$ref": "#/definitions/dto.JournalExportJournal"
+                },
+                "version": {
+                    "type": "integer"
+                }
+            }
+        },
+        "dto.JournalExportEntry": {
+            "type": "object",
+            "required": [
+                "asset",
+                "day",
+                "direction",
+                "entry_type",
+                "htf",
+                "ltf",
+                "result",
+                "session",
+                "trade_type"
+            ],
+            "properties": {
+                "asset": {
+                    "$ref": "#/definitions/types.CurrencyPair"
+                },
+                "checklist": {
+                    "type": "array",
+                    "maxItems": 30,
+                    "uniqueItems": true,
+                    "items": {
+                        "$ref": "#/definitions/types.ChecklistItem"
+                    }
+                },
+                "confidence": {
+                    "type": "integer",
+                    "maximum": 5,
+                    "minimum": 1
+                },
+                "day": {
+                    "type": "string"
+                },
+                "direction": {
+                    "$ref": "#/definitions/types.TradeDirection"
+                },
+                "emotion": {
+                    "$ref": "#/definitions/types.Emotion"
+                },
+                "entry_charts": {
+                    "type": "array",
+                    "maxItems": 10,
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "entry_price": {
+                    "type": "number"
+                },
+                "entry_type": {
+                    "$ref": "#/definitions/types.EntryType"
+                },
+                "followed_plan": {
+                    "type": "boolean"
+                },
+                "htf": {
+                    "type": "string"
+                },
+                "is_pinned": {
+                    "type": "boolean"
+                },
+                "ltf": {
+                    "type": "string"
+                },
+                "max_rr": {
+                    "type": "number"
+                },
+                "notes": {
+                    "type": "string",
+                    "maxLength": 5000
+                },
+                "position_size": {
+                    "type": "number",
+                    "minimum": 0
+                },
+                "realized": {
+                    "type": "number"
+                },
+                "result": {
+                    "$ref": "#/definitions/types.TradeResult"
+                },
+                "review_status": {
+                    "$ref": "#/definitions/types.ReviewStatus"
+                },
+                "risk_percent": {
+                    "type": "number",
+                    "maximum": 100,
+                    "minimum": 0
+                },
+                "session": {
+                    "$ref": "#/definitions/types.TradingSession"
+                },
+                "setup": {
+                    "type": "string",
+                    "maxLength": 500
+                },
+                "setup_charts": {
+                    "type": "array",
+                    "maxItems": 10,
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "stop_loss_price": {
+                    "type": "number"
+                },
+                "take_profit_price": {
+                    "type": "number"
+                },
+                "trade_type": {
+                    "$ref": "#/definitions/types.TradeType"
+                }
+            }
+        },
+        "dto.JournalExportJournal": {
+            "type": "object",
+            "required": [
+                "name"
+            ],
+            "properties": {
+                "account_number": {
+                    "type": "string",
+                    "maxLength": 50
+                },
+                "broker": {
+                    "type": "string",
+                    "maxLength": 100
+                },
+                "default_asset": {
+                    "$ref": "#/definitions/types.CurrencyPair"
+                },
+                "default_session": {
+                    "$ref": "#/definitions/types.TradingSession"
+                },
+                "description": {
+                    "type": "string",
+                    "maxLength": 1000
+                },
+                "is_archived": {
+                    "type": "boolean"
+                },
+                "is_locked": {
+                    "type": "boolean"
+                },
+                "name": {
+                    "type": "string",
+                    "maxLength": 255,
+                    "minLength": 1
+                },
+                "pip_value": {
+                    "type": "number"
+                },
+                "require_notes_on_loss": {
+                    "type": "boolean"
+                },
+                "tags": {
+                    "type": "array",
+                    "maxItems": 20,
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "validation_profile": {
+                    "enum": [
+                        "lenient",
+                        "standard",
+                        "strict"
+                    ],
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/types.ValidationProfile"
+                        }
+                    ]
+                }
+            }
+        },
+        "dto.JournalStatisticsSummaryResponse": {
+            "type": "object",
+            "properties": {
+                "break_even": {
+                    "type": "integer"
+                },
+                "journal_id": {
+                    "type": "string"
+                },
+                "journal_name": {
+                    "type": "string"
+                },
+                "losses": {
+                    "type": "integer"
+                },
+                "total_realized": {
+                    "type": "number"
+                },
+                "total_trades": {
+                    "type": "integer"
+                },
+                "win_rate": {
+                    "type": "number"
+                },
+                "wins": {
+                    "type": "integer"
+                }
+            }
+        },
+        "dto.JournalSummaryResponse": {
+            "type": "object",
+            "properties": {
+                "computed_at": {
+                    "type": "string"
+                },
+                "journal_id": {
+                    "type": "string"
+                },
+                "net_realized": {
+                    "type": "number"
+                },
+                "total_trades": {
+                    "type": "integer"
+                },
+                "win_rate": {
+                    "type": "number"
+                },
+                "wins": {
+                    "type": "integer"
+                }
+            }
+        },
+        "dto.JournalTemplateListResponse": {
+            "type": "object",
+            "properties": {
+                "current_page": {
+                    "type": "integer"
+                },
+                "has_next": {
+                    "type": "boolean"
+                },
+                "has_prev": {
+                    "type": "boolean"
+                },
+                "limit": {
+                    "type": "integer"
+                },
+                "offset": {
+                    "type": "integer"
+                },
+                "templates": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dto.JournalTemplateResponse"
+                    }
+                },
+                "total": {
+                    "type": "integer"
+                },
+                "total_pages": {
+                    "type": "integer"
+                }
+            }
+        },
+        "dto.JournalTemplateResponse": {
+            "type": "object",
+            "properties": {
+                "created_at": {
+                    "type": "string"
+                },
+                "default_asset": {
+                    "$ref": "#/definitions/types.CurrencyPair"
+                },
+                "default_session": {
+                    "$ref": "#/definitions/types.TradingSession"
+                },
+                "description": {
                     "type": "string"
                 },
-                "expires_at": {
+                "id": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "tags": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "updated_at": {
                     "type": "string"
                 },
-                "refresh_token": {
+                "user_id": {
                     "type": "string"
                 }
             }
         },
-        "dto.CreateTradingJournalEntryRequest": {
+        "dto.LinkEntryRequest": {
             "type": "object",
             "required": [
-                "asset",
-                "day",
-                "direction",
-                "entry_type",
-                "htf",
-                "ltf",
-                "max_rr",
-                "realized",
-                "result",
-                "session",
-                "trade_type"
+                "entry_id"
             ],
             "properties": {
-                "asset": {
-                    "$ref": "#/definitions/types.CurrencyPair"
-                },
-                "day": {
+                "entry_id": {
                     "type": "string"
+                }
+            }
+        },
+        "dto.Pagination": {
+            "type": "object",
+            "properties": {
+                "current_page": {
+                    "type": "integer"
                 },
-                "direction": {
-                    "$ref": "#/definitions/types.TradeDirection"
+                "has_next": {
+                    "type": "boolean"
                 },
-                "entry_charts": {
-                    "type": "array",
-                    "items": {
-                        "type": "string"
-                    }
+                "has_prev": {
+                    "type": "boolean"
                 },
-                "entry_type": {
-                    "$ref": "#/definitions/types.EntryType"
+                "limit": {
+                    "type": "integer"
                 },
-                "htf": {
-                    "$ref": "#/definitions/types.TimeFrame"
+                "offset": {
+                    "type": "integer"
                 },
-                "ltf": {
-                    "$ref": "#/definitions/types.TimeFrame"
+                "total": {
+                    "type": "integer"
                 },
-                "max_rr": {
-                    "type": "number"
+                "total_pages": {
+                    "type": "integer"
+                }
+            }
+        },
+        "dto.PeriodStatisticsResponse": {
+            "type": "object",
+            "properties": {
+                "end": {
+                    "type": "string"
                 },
-                "notes": {
-                    "type": "string",
-                    "maxLength": 5000
+                "start": {
+                    "type": "string"
                 },
-                "realized": {
+                "statistics": {
+                    "$ref": "#/definitions/dto.TradingJournalStatisticsResponse"
+                }
+            }
+        },
+        "dto.PlanAdherenceBucketResponse": {
+            "type": "object",
+            "properties": {
+                "break_even": {
+                    "type": "integer"
+                },
+                "losses": {
+                    "type": "integer"
+                },
+                "total_realized": {
                     "type": "number"
                 },
-                "result": {
-                    "$ref": "#/definitions/types.TradeResult"
+                "total_trades": {
+                    "type": "integer"
                 },
-                "session": {
-                    "$ref": "#/definitions/types.TradingSession"
+                "win_rate": {
+                    "type": "number"
                 },
-                "setup": {
+                "wins": {
+                    "type": "integer"
+                }
+            }
+        },
+        "dto.PlatformImportResponse": {
+            "type": "object",
+            "properties": {
+                "imported": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dto.TradingJournalEntryResponse"
+                    }
+                },
+                "source": {
                     "type": "string",
-                    "maxLength": 500
+                    "enum": [
+                        "ctrader",
+                        "tradingview"
+                    ]
                 },
-                "trade_type": {
-                    "$ref": "#/definitions/types.TradeType"
+                "unmapped": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dto.UnmappedImportRowResponse"
+                    }
                 }
             }
         },
-        "dto.CreateTradingJournalRequest": {
+        "dto.RefreshTokenRequest": {
             "type": "object",
             "required": [
-                "name"
+                "refresh_token"
             ],
             "properties": {
-                "description": {
-                    "type": "string",
-                    "maxLength": 1000
+                "refresh_token": {
+                    "type": "string"
+                }
+            }
+        },
+        "dto.RefreshTokenResponse": {
+            "type": "object",
+            "properties": {
+                "access_token": {
+                    "type": "string"
                 },
-                "name": {
-                    "type": "string",
-                    "maxLength": 255,
-                    "minLength": 1
+                "expires_at": {
+                    "type": "string"
+                }
+            }
+        },
+        "dto.RelatedEntriesResponse": {
+            "type": "object",
+            "properties": {
+                "entries": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dto.TradingJournalEntryResponse"
+                    }
+                }
+            }
+        },
+        "dto.ReviewProgressResponse": {
+            "type": "object",
+            "properties": {
+                "flagged": {
+                    "type": "integer"
+                },
+                "reviewed": {
+                    "type": "integer"
+                },
+                "total": {
+                    "type": "integer"
+                },
+                "unreviewed": {
+                    "type": "integer"
+                }
+            }
+        },
+        "dto.SessionListResponse": {
+            "type": "object",
+            "properties": {
+                "sessions": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dto.SessionResponse"
+                    }
+                }
+            }
+        },
+        "dto.SessionResponse": {
+            "type": "object",
+            "properties": {
+                "created_at": {
+                    "type": "string"
+                },
+                "expires_at": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "ip_address": {
+                    "type": "string"
+                },
+                "last_used_at": {
+                    "type": "string"
+                },
+                "user_agent": {
+                    "type": "string"
                 }
             }
         },
@@ -995,15 +6177,85 @@ const docTemplate = `{
                 }
             }
         },
+        "dto.StatisticsComparisonResponse": {
+            "type": "object",
+            "properties": {
+                "delta": {
+                    "$ref": "#/definitions/dto.StatisticsDeltaResponse"
+                },
+                "period_a": {
+                    "$ref": "#/definitions/dto.PeriodStatisticsResponse"
+                },
+                "period_b": {
+                    "$ref": "#/definitions/dto.PeriodStatisticsResponse"
+                }
+            }
+        },
+        "dto.StatisticsDeltaResponse": {
+            "type": "object",
+            "properties": {
+                "avg_achieved_rr": {
+                    "type": "number"
+                },
+                "avg_loss": {
+                    "type": "number"
+                },
+                "avg_planned_rr": {
+                    "type": "number"
+                },
+                "avg_risk_reward": {
+                    "type": "number"
+                },
+                "avg_win": {
+                    "type": "number"
+                },
+                "break_even": {
+                    "type": "integer"
+                },
+                "kelly_fraction": {
+                    "type": "number"
+                },
+                "losses": {
+                    "type": "integer"
+                },
+                "risk_of_ruin": {
+                    "type": "number"
+                },
+                "rr_difference": {
+                    "type": "number"
+                },
+                "total_realized": {
+                    "type": "number"
+                },
+                "total_trades": {
+                    "type": "integer"
+                },
+                "win_rate": {
+                    "type": "number"
+                },
+                "wins": {
+                    "type": "integer"
+                }
+            }
+        },
         "dto.TradingJournalEntryListResponse": {
             "type": "object",
             "properties": {
+                "current_page": {
+                    "type": "integer"
+                },
                 "entries": {
                     "type": "array",
                     "items": {
                         "$ref": "#/definitions/dto.TradingJournalEntryResponse"
                     }
                 },
+                "has_next": {
+                    "type": "boolean"
+                },
+                "has_prev": {
+                    "type": "boolean"
+                },
                 "limit": {
                     "type": "integer"
                 },
@@ -1012,6 +6264,9 @@ const docTemplate = `{
                 },
                 "total": {
                     "type": "integer"
+                },
+                "total_pages": {
+                    "type": "integer"
                 }
             }
         },
@@ -1021,6 +6276,15 @@ const docTemplate = `{
                 "asset": {
                     "$ref": "#/definitions/types.CurrencyPair"
                 },
+                "checklist": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/types.ChecklistItem"
+                    }
+                },
+                "confidence": {
+                    "type": "integer"
+                },
                 "created_at": {
                     "type": "string"
                 },
@@ -1030,26 +6294,41 @@ const docTemplate = `{
                 "direction": {
                     "$ref": "#/definitions/types.TradeDirection"
                 },
+                "emotion": {
+                    "$ref": "#/definitions/types.Emotion"
+                },
                 "entry_charts": {
                     "type": "array",
                     "items": {
                         "type": "string"
                     }
                 },
+                "entry_price": {
+                    "type": "number"
+                },
                 "entry_type": {
                     "$ref": "#/definitions/types.EntryType"
                 },
+                "followed_plan": {
+                    "type": "boolean"
+                },
+                "grade": {
+                    "$ref": "#/definitions/types.TradeGrade"
+                },
                 "htf": {
-                    "$ref": "#/definitions/types.TimeFrame"
+                    "type": "string"
                 },
                 "id": {
                     "type": "string"
                 },
+                "is_pinned": {
+                    "type": "boolean"
+                },
                 "journal_id": {
                     "type": "string"
                 },
                 "ltf": {
-                    "$ref": "#/definitions/types.TimeFrame"
+                    "type": "string"
                 },
                 "max_rr": {
                     "type": "number"
@@ -1057,18 +6336,51 @@ const docTemplate = `{
                 "notes": {
                     "type": "string"
                 },
+                "planned_rr": {
+                    "type": "number"
+                },
+                "position_size": {
+                    "type": "number"
+                },
                 "realized": {
                     "type": "number"
                 },
+                "realized_pips": {
+                    "type": "number"
+                },
+                "related_entry_ids": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
                 "result": {
                     "$ref": "#/definitions/types.TradeResult"
                 },
+                "review_status": {
+                    "$ref": "#/definitions/types.ReviewStatus"
+                },
+                "risk_percent": {
+                    "type": "number"
+                },
                 "session": {
                     "$ref": "#/definitions/types.TradingSession"
                 },
                 "setup": {
                     "type": "string"
                 },
+                "setup_charts": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "stop_loss_price": {
+                    "type": "number"
+                },
+                "take_profit_price": {
+                    "type": "number"
+                },
                 "trade_type": {
                     "$ref": "#/definitions/types.TradeType"
                 },
@@ -1080,6 +6392,15 @@ const docTemplate = `{
         "dto.TradingJournalListResponse": {
             "type": "object",
             "properties": {
+                "current_page": {
+                    "type": "integer"
+                },
+                "has_next": {
+                    "type": "boolean"
+                },
+                "has_prev": {
+                    "type": "boolean"
+                },
                 "journals": {
                     "type": "array",
                     "items": {
@@ -1094,47 +6415,141 @@ const docTemplate = `{
                 },
                 "total": {
                     "type": "integer"
+                },
+                "total_pages": {
+                    "type": "integer"
                 }
             }
         },
         "dto.TradingJournalResponse": {
             "type": "object",
             "properties": {
+                "account_number": {
+                    "type": "string"
+                },
+                "broker": {
+                    "type": "string"
+                },
                 "created_at": {
                     "type": "string"
                 },
+                "default_asset": {
+                    "$ref": "#/definitions/types.CurrencyPair"
+                },
+                "default_session": {
+                    "$ref": "#/definitions/types.TradingSession"
+                },
                 "description": {
                     "type": "string"
                 },
+                "first_entry_date": {
+                    "type": "string"
+                },
                 "id": {
                     "type": "string"
                 },
+                "is_archived": {
+                    "type": "boolean"
+                },
+                "is_locked": {
+                    "type": "boolean"
+                },
+                "last_entry_date": {
+                    "type": "string"
+                },
                 "name": {
                     "type": "string"
                 },
+                "pip_value": {
+                    "type": "number"
+                },
+                "require_notes_on_loss": {
+                    "type": "boolean"
+                },
+                "summary": {
+                    "description": "Summary is only included in journal lists.",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/dto.JournalSummaryResponse"
+                        }
+                    ]
+                },
+                "tags": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
                 "updated_at": {
                     "type": "string"
                 },
                 "user_id": {
                     "type": "string"
+                },
+                "validation_profile": {
+                    "$ref": "#/definitions/types.ValidationProfile"
                 }
             }
         },
         "dto.TradingJournalStatisticsResponse": {
             "type": "object",
             "properties": {
+                "avg_achieved_rr": {
+                    "type": "number"
+                },
+                "avg_loss": {
+                    "type": "number"
+                },
+                "avg_loss_pips": {
+                    "type": "number"
+                },
+                "avg_planned_rr": {
+                    "type": "number"
+                },
+                "avg_risk_percent": {
+                    "type": "number"
+                },
                 "avg_risk_reward": {
                     "type": "number"
                 },
+                "avg_win": {
+                    "type": "number"
+                },
+                "avg_win_pips": {
+                    "type": "number"
+                },
                 "break_even": {
                     "type": "integer"
                 },
+                "generated_at": {
+                    "type": "string"
+                },
+                "kelly_fraction": {
+                    "type": "number"
+                },
                 "losses": {
                     "type": "integer"
                 },
+                "range_end": {
+                    "type": "string"
+                },
+                "range_start": {
+                    "description": "RangeStart and RangeEnd are the days of the first and last entry\ncovered, null when the journal has no entries.",
+                    "type": "string"
+                },
+                "risk_of_ruin": {
+                    "type": "number"
+                },
+                "rr_difference": {
+                    "type": "number"
+                },
                 "total_realized": {
                     "type": "number"
                 },
+                "total_realized_pips": {
+                    "description": "The pip figures are only set when the journal has a pip value.",
+                    "type": "number"
+                },
                 "total_trades": {
                     "type": "integer"
                 },
@@ -1149,9 +6564,21 @@ const docTemplate = `{
         "dto.TradingJournalWithEntriesResponse": {
             "type": "object",
             "properties": {
+                "account_number": {
+                    "type": "string"
+                },
+                "broker": {
+                    "type": "string"
+                },
                 "created_at": {
                     "type": "string"
                 },
+                "default_asset": {
+                    "$ref": "#/definitions/types.CurrencyPair"
+                },
+                "default_session": {
+                    "$ref": "#/definitions/types.TradingSession"
+                },
                 "description": {
                     "type": "string"
                 },
@@ -1161,17 +6588,110 @@ const docTemplate = `{
                         "$ref": "#/definitions/dto.TradingJournalEntryResponse"
                     }
                 },
+                "first_entry_date": {
+                    "type": "string"
+                },
                 "id": {
                     "type": "string"
                 },
+                "is_archived": {
+                    "type": "boolean"
+                },
+                "is_locked": {
+                    "type": "boolean"
+                },
+                "last_entry_date": {
+                    "type": "string"
+                },
                 "name": {
                     "type": "string"
                 },
+                "pagination": {
+                    "$ref": "#/definitions/dto.Pagination"
+                },
+                "pip_value": {
+                    "type": "number"
+                },
+                "require_notes_on_loss": {
+                    "type": "boolean"
+                },
+                "tags": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
                 "updated_at": {
                     "type": "string"
                 },
                 "user_id": {
                     "type": "string"
+                },
+                "validation_profile": {
+                    "$ref": "#/definitions/types.ValidationProfile"
+                }
+            }
+        },
+        "dto.TransferEntriesRequest": {
+            "type": "object",
+            "required": [
+                "entry_ids",
+                "target_journal_id"
+            ],
+            "properties": {
+                "copy": {
+                    "type": "boolean"
+                },
+                "entry_ids": {
+                    "type": "array",
+                    "maxItems": 500,
+                    "minItems": 1,
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "target_journal_id": {
+                    "type": "string"
+                }
+            }
+        },
+        "dto.TransferEntriesResponse": {
+            "type": "object",
+            "properties": {
+                "copied": {
+                    "type": "boolean"
+                },
+                "entries": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dto.TradingJournalEntryResponse"
+                    }
+                },
+                "target_journal_id": {
+                    "type": "string"
+                }
+            }
+        },
+        "dto.UnmappedImportRowResponse": {
+            "type": "object",
+            "properties": {
+                "error": {
+                    "type": "string"
+                },
+                "line": {
+                    "description": "Line is the 1-based line of the uploaded file the trade starts on.",
+                    "type": "integer"
+                }
+            }
+        },
+        "dto.UpdateReviewStatusRequest": {
+            "type": "object",
+            "required": [
+                "review_status"
+            ],
+            "properties": {
+                "review_status": {
+                    "$ref": "#/definitions/types.ReviewStatus"
                 }
             }
         },
@@ -1194,26 +6714,49 @@ const docTemplate = `{
                 "asset": {
                     "$ref": "#/definitions/types.CurrencyPair"
                 },
+                "checklist": {
+                    "type": "array",
+                    "maxItems": 30,
+                    "uniqueItems": true,
+                    "items": {
+                        "$ref": "#/definitions/types.ChecklistItem"
+                    }
+                },
+                "confidence": {
+                    "type": "integer",
+                    "maximum": 5,
+                    "minimum": 1
+                },
                 "day": {
                     "type": "string"
                 },
                 "direction": {
                     "$ref": "#/definitions/types.TradeDirection"
                 },
+                "emotion": {
+                    "$ref": "#/definitions/types.Emotion"
+                },
                 "entry_charts": {
                     "type": "array",
+                    "maxItems": 10,
                     "items": {
                         "type": "string"
                     }
                 },
+                "entry_price": {
+                    "type": "number"
+                },
                 "entry_type": {
                     "$ref": "#/definitions/types.EntryType"
                 },
+                "followed_plan": {
+                    "type": "boolean"
+                },
                 "htf": {
-                    "$ref": "#/definitions/types.TimeFrame"
+                    "type": "string"
                 },
                 "ltf": {
-                    "$ref": "#/definitions/types.TimeFrame"
+                    "type": "string"
                 },
                 "max_rr": {
                     "type": "number"
@@ -1222,12 +6765,21 @@ const docTemplate = `{
                     "type": "string",
                     "maxLength": 5000
                 },
+                "position_size": {
+                    "type": "number",
+                    "minimum": 0
+                },
                 "realized": {
                     "type": "number"
                 },
                 "result": {
                     "$ref": "#/definitions/types.TradeResult"
                 },
+                "risk_percent": {
+                    "type": "number",
+                    "maximum": 100,
+                    "minimum": 0
+                },
                 "session": {
                     "$ref": "#/definitions/types.TradingSession"
                 },
@@ -1235,6 +6787,19 @@ const docTemplate = `{
                     "type": "string",
                     "maxLength": 500
                 },
+                "setup_charts": {
+                    "type": "array",
+                    "maxItems": 10,
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "stop_loss_price": {
+                    "type": "number"
+                },
+                "take_profit_price": {
+                    "type": "number"
+                },
                 "trade_type": {
                     "$ref": "#/definitions/types.TradeType"
                 }
@@ -1246,6 +6811,20 @@ const docTemplate = `{
                 "name"
             ],
             "properties": {
+                "account_number": {
+                    "type": "string",
+                    "maxLength": 50
+                },
+                "broker": {
+                    "type": "string",
+                    "maxLength": 100
+                },
+                "default_asset": {
+                    "$ref": "#/definitions/types.CurrencyPair"
+                },
+                "default_session": {
+                    "$ref": "#/definitions/types.TradingSession"
+                },
                 "description": {
                     "type": "string",
                     "maxLength": 1000
@@ -1254,6 +6833,118 @@ const docTemplate = `{
                     "type": "string",
                     "maxLength": 255,
                     "minLength": 1
+                },
+                "pip_value": {
+                    "type": "number"
+                },
+                "require_notes_on_loss": {
+                    "type": "boolean"
+                },
+                "tags": {
+                    "type": "array",
+                    "maxItems": 20,
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "validation_profile": {
+                    "description": "ValidationProfile defaults to standard on create and is left\nunchanged on update when empty.",
+                    "enum": [
+                        "lenient",
+                        "standard",
+                        "strict"
+                    ],
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/types.ValidationProfile"
+                        }
+                    ]
+                }
+            }
+        },
+        "dto.UserResponse": {
+            "type": "object",
+            "properties": {
+                "created_at": {
+                    "type": "string"
+                },
+                "email": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "provider": {
+                    "type": "string",
+                    "enum": [
+                        "password",
+                        "google"
+                    ]
+                },
+                "username": {
+                    "type": "string"
+                }
+            }
+        },
+        "dto.UserStatisticsResponse": {
+            "type": "object",
+            "properties": {
+                "break_even": {
+                    "type": "integer"
+                },
+                "journals": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dto.JournalStatisticsSummaryResponse"
+                    }
+                },
+                "losses": {
+                    "type": "integer"
+                },
+                "total_journals": {
+                    "type": "integer"
+                },
+                "total_realized": {
+                    "type": "number"
+                },
+                "total_trades": {
+                    "type": "integer"
+                },
+                "win_rate": {
+                    "type": "number"
+                },
+                "wins": {
+                    "type": "integer"
+                }
+            }
+        },
+        "types.AssetCategory": {
+            "type": "string",
+            "enum": [
+                "major",
+                "minor",
+                "exotic",
+                "other"
+            ],
+            "x-enum-varnames": [
+                "AssetCategoryMajor",
+                "AssetCategoryMinor",
+                "AssetCategoryExotic",
+                "AssetCategoryOther"
+            ]
+        },
+        "types.ChecklistItem": {
+            "type": "object",
+            "required": [
+                "item"
+            ],
+            "properties": {
+                "checked": {
+                    "type": "boolean"
+                },
+                "item": {
+                    "type": "string",
+                    "maxLength": 200
                 }
             }
         },
@@ -1306,6 +6997,23 @@ const docTemplate = `{
                 "CurrencyPairUSDSEK"
             ]
         },
+        "types.Emotion": {
+            "type": "string",
+            "enum": [
+                "calm",
+                "fearful",
+                "greedy",
+                "fomo",
+                "disciplined"
+            ],
+            "x-enum-varnames": [
+                "EmotionCalm",
+                "EmotionFearful",
+                "EmotionGreedy",
+                "EmotionFOMO",
+                "EmotionDisciplined"
+            ]
+        },
         "types.EntryType": {
             "type": "string",
             "enum": [
@@ -1317,6 +7025,19 @@ const docTemplate = `{
                 "EntryTypeLimit"
             ]
         },
+        "types.ReviewStatus": {
+            "type": "string",
+            "enum": [
+                "unreviewed",
+                "reviewed",
+                "flagged"
+            ],
+            "x-enum-varnames": [
+                "ReviewStatusUnreviewed",
+                "ReviewStatusReviewed",
+                "ReviewStatusFlagged"
+            ]
+        },
         "types.TimeFrame": {
             "type": "string",
             "enum": [
@@ -1353,6 +7074,23 @@ const docTemplate = `{
                 "TradeDirectionSell"
             ]
         },
+        "types.TradeGrade": {
+            "type": "string",
+            "enum": [
+                "A",
+                "B",
+                "C",
+                "D",
+                "F"
+            ],
+            "x-enum-varnames": [
+                "TradeGradeA",
+                "TradeGradeB",
+                "TradeGradeC",
+                "TradeGradeD",
+                "TradeGradeF"
+            ]
+        },
         "types.TradeResult": {
             "type": "string",
             "enum": [
@@ -1400,9 +7138,26 @@ const docTemplate = `{
                 "TradingSessionNewYork"
             ]
         },
+        "types.ValidationProfile": {
+            "type": "string",
+            "enum": [
+                "lenient",
+                "standard",
+                "strict"
+            ],
+            "x-enum-varnames": [
+                "ValidationProfileLenient",
+                "ValidationProfileStandard",
+                "ValidationProfileStrict"
+            ]
+        },
         "v1.ErrorResponse": {
             "type": "object",
             "properties": {
+                "code": {
+                    "type": "string",
+                    "example": "not_found"
+                },
                 "error": {
                     "type": "string"
                 }
@@ -1426,7 +7181,7 @@ var SwaggerInfo = &swag.Spec{
 	BasePath:         "/",
 	Schemes:          []string{},
 	Title:            "Normark Trading Journal API",
-	Description:      "A comprehensive trading journal API for tracking and analyzing trading performance",
+	Description:      "A comprehensive trading journal API for tracking and analyzing trading performance. Send Accept: application/json; profile=\"envelope\" to get successful responses wrapped as {\"data\": ..., \"meta\": ...}, with list pagination in meta.",
 	InfoInstanceName: "swagger",
 	SwaggerTemplate:  docTemplate,
 	LeftDelim:        "{{",
@@ -10,13 +10,18 @@ import (
 	"syscall"
 	"time"
 
+	"github.com/gin-gonic/gin"
 	"github.com/user/normark/internal/config"
 	v1 "github.com/user/normark/internal/controller/http/v1"
+	"github.com/user/normark/internal/entity"
 	"github.com/user/normark/internal/service"
 	bunstorage "github.com/user/normark/internal/storage/bun"
 	"github.com/user/normark/internal/storage/cache"
 	"github.com/user/normark/pkg/auth"
 	"github.com/user/normark/pkg/db"
+	"github.com/user/normark/pkg/fieldcrypt"
+	applogger "github.com/user/normark/pkg/logger"
+	"github.com/user/normark/pkg/metrics"
 	"go.uber.org/zap"
 )
 
@@ -28,7 +33,7 @@ type App struct {
 	cfg    *config.Config
 	logger *zap.Logger
 	db     *db.DB
-	cache  *cache.Redis
+	cache  *cache.Breaker
 	server *http.Server
 }
 
@@ -38,7 +43,7 @@ func New() (*App, error) {
 		return nil, fmt.Errorf("failed to load config: %w", err)
 	}
 
-	logger, err := zap.NewProduction()
+	logger, err := applogger.New(&cfg.Log)
 	if err != nil {
 		return nil, fmt.Errorf("failed to create logger: %w", err)
 	}
@@ -68,22 +73,26 @@ func (a *App) Run() error {
 }
 
 func (a *App) initDatabase(ctx context.Context) error {
-	database, err := db.NewPostgresConnection(ctx, &a.cfg.Postgres)
+	database, err := db.NewPostgresConnection(ctx, &a.cfg.Postgres, a.logger)
 	if err != nil {
 		a.logger.Error("failed to connect to database", zap.Error(err))
 		return fmt.Errorf("failed to connect to database: %w", err)
 	}
 
 	a.db = database
-	a.logger.Info("database connected successfully")
+	a.logger.Info("database connected successfully", zap.Bool("replica", database.HasReplica()))
 	return nil
 }
 
 func (a *App) initCache(ctx context.Context) error {
 	redisCache := cache.New(cache.Config{
-		Addr:     a.cfg.Redis.Addr,
-		Password: a.cfg.Redis.Password,
-		DB:       a.cfg.Redis.DB,
+		Addr:         a.cfg.Redis.Addr,
+		Password:     a.cfg.Redis.Password,
+		DB:           a.cfg.Redis.DB,
+		PoolSize:     a.cfg.Redis.PoolSize,
+		MinIdleConns: a.cfg.Redis.MinIdleConns,
+		DialTimeout:  a.cfg.Redis.DialTimeout,
+		ReadTimeout:  a.cfg.Redis.ReadTimeout,
 	})
 
 	if err := redisCache.Ping(ctx); err != nil {
@@ -91,7 +100,10 @@ func (a *App) initCache(ctx context.Context) error {
 		return nil
 	}
 
-	a.cache = redisCache
+	a.cache = cache.NewBreaker(redisCache, cache.BreakerConfig{
+		Threshold: a.cfg.Redis.BreakerThreshold,
+		Cooldown:  a.cfg.Redis.BreakerCooldown,
+	}, a.logger)
 	a.logger.Info("redis cache connected successfully", zap.String("addr", a.cfg.Redis.Addr))
 	return nil
 }
@@ -106,54 +118,160 @@ func (a *App) initServer() error {
 		a.logger.Error("failed to create jwt manager", zap.Error(err))
 		return fmt.Errorf("failed to create jwt manager: %w", err)
 	}
+	jwtManager.WithLeeway(a.cfg.JWT.Leeway)
+
+	var recorder *metrics.Recorder
+	if a.cfg.Metrics.Enabled {
+		recorder = metrics.New()
+	}
 
 	userStorage := bunstorage.NewUserStorage(a.db.DB)
-	userService := service.NewUserService(userStorage, jwtManager, a.logger)
+	sessionStorage := bunstorage.NewSessionStorage(a.db.DB)
+	userService := service.NewUserService(userStorage, sessionStorage, jwtManager, a.logger)
 	if a.cache != nil {
 		userService = userService.WithCache(a.cache)
 	}
+	if recorder != nil {
+		userService = userService.WithMetrics(recorder)
+	}
+	if a.cfg.Google.ClientID != "" {
+		userService = userService.WithGoogleVerifier(auth.NewGoogleVerifier(a.cfg.Google.ClientID))
+	}
+
+	notesKey, err := a.cfg.Journal.NotesKey()
+	if err != nil {
+		return fmt.Errorf("invalid notes encryption key: %w", err)
+	}
+	var notesCipher *fieldcrypt.Cipher
+	if notesKey != nil {
+		notesCipher, err = fieldcrypt.New(notesKey)
+		if err != nil {
+			a.logger.Error("failed to create notes cipher", zap.Error(err))
+			return fmt.Errorf("failed to create notes cipher: %w", err)
+		}
+	}
 
 	tradingJournalStorage := bunstorage.NewTradingJournalStorage(a.db.DB)
-	tradingJournalService := service.NewTradingJournalService(tradingJournalStorage, a.logger)
+	if a.db.HasReplica() {
+		tradingJournalStorage = tradingJournalStorage.WithReplica(a.db.Reader())
+	}
+	if notesCipher != nil {
+		tradingJournalStorage = tradingJournalStorage.WithCipher(notesCipher)
+	}
+	journalTemplateStorage := bunstorage.NewJournalTemplateStorage(a.db.DB)
+	journalTemplateService := service.NewJournalTemplateService(journalTemplateStorage, a.logger)
+
+	gradeWeights := entity.GradeWeights{
+		FollowedPlan: a.cfg.Journal.GradeWeightFollowedPlan,
+		RR:           a.cfg.Journal.GradeWeightRR,
+		Outcome:      a.cfg.Journal.GradeWeightOutcome,
+	}
+
+	tradingJournalService := service.NewTradingJournalService(
+		tradingJournalStorage,
+		journalTemplateStorage,
+		a.logger,
+	).WithGradeWeights(gradeWeights)
 	if a.cache != nil {
 		tradingJournalService = tradingJournalService.WithCache(a.cache)
 	}
+	if a.cfg.Journal.UniqueNames {
+		tradingJournalService = tradingJournalService.WithUniqueNames()
+	}
+	if recorder != nil {
+		tradingJournalService = tradingJournalService.WithMetrics(recorder)
+	}
 
 	tradingJournalEntryStorage := bunstorage.NewTradingJournalEntryStorage(a.db.DB)
+	if a.db.HasReplica() {
+		tradingJournalEntryStorage = tradingJournalEntryStorage.WithReplica(a.db.Reader())
+	}
+	if notesCipher != nil {
+		tradingJournalEntryStorage = tradingJournalEntryStorage.WithCipher(notesCipher)
+	}
+	entryTemplateStorage := bunstorage.NewEntryTemplateStorage(a.db.DB)
+	entryTemplateService := service.NewEntryTemplateService(entryTemplateStorage, tradingJournalStorage, a.logger)
+
 	tradingJournalEntryService := service.NewTradingJournalEntryService(
 		tradingJournalEntryStorage,
 		tradingJournalStorage,
+		entryTemplateStorage,
+		a.logger,
+	).WithGradeWeights(gradeWeights)
+	if a.cache != nil {
+		tradingJournalEntryService = tradingJournalEntryService.WithCache(a.cache)
+	}
+	if recorder != nil {
+		tradingJournalEntryService = tradingJournalEntryService.WithMetrics(recorder)
+	}
+
+	entryNoteStorage := bunstorage.NewEntryNoteStorage(a.db.DB)
+	if notesCipher != nil {
+		entryNoteStorage = entryNoteStorage.WithCipher(notesCipher)
+	}
+	entryNoteService := service.NewEntryNoteService(entryNoteStorage, tradingJournalEntryStorage, tradingJournalStorage, a.logger)
+
+	entryExitStorage := bunstorage.NewEntryExitStorage(a.db.DB)
+	entryExitService := service.NewEntryExitService(entryExitStorage, tradingJournalEntryStorage, tradingJournalStorage, a.logger)
+
+	exportJobService := service.NewExportJobService(tradingJournalService, a.logger)
+	if a.cache != nil {
+		exportJobService = exportJobService.WithCache(a.cache)
+	}
+	if notesCipher != nil {
+		exportJobService = exportJobService.WithCipher(notesCipher)
+	}
+
+	accountExportService := service.NewAccountExportService(
+		userStorage,
+		sessionStorage,
+		tradingJournalStorage,
+		entryNoteStorage,
+		entryExitStorage,
 		a.logger,
 	)
 
 	middleware := v1.NewMiddleware(a.logger, jwtManager, &a.cfg.CORS)
+	middleware.SetJournalAccessVerifier(tradingJournalService)
 	rateLimiter := v1.NewRateLimiter(&a.cfg.RateLimit, a.logger)
 	handler := v1.NewHandler(
 		userService,
 		tradingJournalService,
 		tradingJournalEntryService,
+		journalTemplateService,
+		entryTemplateService,
+		entryNoteService,
+		entryExitService,
+		exportJobService,
+		accountExportService,
 		a.logger,
 		middleware,
 		rateLimiter,
 		a.cfg.App.Environment,
+		a.cfg.Server.StrictQuery,
 	)
 
 	router := handler.InitRoutes()
-
-	addr := ":" + a.cfg.Server.Port
-
-	a.server = &http.Server{
-		Addr:         addr,
-		Handler:      router,
-		ReadTimeout:  10 * time.Second,
-		WriteTimeout: 10 * time.Second,
-		IdleTimeout:  60 * time.Second,
+	if recorder != nil {
+		router.GET("/metrics", gin.WrapH(recorder.Handler()))
 	}
 
-	a.logger.Info("server initialized", zap.String("addr", addr))
+	a.server = newHTTPServer(&a.cfg.Server, router)
+
+	a.logger.Info("server initialized", zap.String("addr", a.server.Addr))
 	return nil
 }
 
+func newHTTPServer(cfg *config.Server, handler http.Handler) *http.Server {
+	return &http.Server{
+		Addr:         ":" + cfg.Port,
+		Handler:      handler,
+		ReadTimeout:  cfg.ReadTimeout,
+		WriteTimeout: cfg.WriteTimeout,
+		IdleTimeout:  cfg.IdleTimeout,
+	}
+}
+
 func (a *App) start() error {
 	errChan := make(chan error, 1)
 
@@ -0,0 +1,30 @@
+package app
+
+import (
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/user/normark/internal/config"
+)
+
+func TestNewHTTPServerAppliesTimeouts(t *testing.T) {
+	cfg := &config.Server{
+		Port:         "9090",
+		ReadTimeout:  30 * time.Second,
+		WriteTimeout: 2 * time.Minute,
+		IdleTimeout:  90 * time.Second,
+	}
+	handler := http.NotFoundHandler()
+
+	server := newHTTPServer(cfg, handler)
+
+	if server.Addr != ":9090" {
+		t.Errorf("Addr = %q, want %q", server.Addr, ":9090")
+	}
+	if server.ReadTimeout != cfg.ReadTimeout || server.WriteTimeout != cfg.WriteTimeout || server.IdleTimeout != cfg.IdleTimeout {
+		t.Errorf("timeouts = %v/%v/%v, want %v/%v/%v",
+			server.ReadTimeout, server.WriteTimeout, server.IdleTimeout,
+			cfg.ReadTimeout, cfg.WriteTimeout, cfg.IdleTimeout)
+	}
+}
@@ -1,12 +1,18 @@
 package config
 
+import "time"
+
 type Config struct {
 	App       App
+	Log       Log
 	Server    Server
 	Postgres  Postgres
 	Redis     Redis
 	JWT       JWT
+	Google    Google
 	CORS      CORS
+	Journal   Journal
+	Metrics   Metrics
 	RateLimit RateLimit
 }
 
@@ -14,8 +20,25 @@ type App struct {
 	Environment string `env:"APP_ENV" envDefault:"development"`
 }
 
+type Log struct {
+	// Level is a zap level: debug, info, warn, error, dpanic, panic or fatal.
+	Level string `env:"LOG_LEVEL" envDefault:"info"`
+	// Format is json for structured output or console for human-readable
+	// output during local development.
+	Format string `env:"LOG_FORMAT" envDefault:"json"`
+}
+
 type Server struct {
 	Port string `env:"SERVER_PORT" envDefault:"8080"`
+
+	ReadTimeout  time.Duration `env:"SERVER_READ_TIMEOUT" envDefault:"10s"`
+	WriteTimeout time.Duration `env:"SERVER_WRITE_TIMEOUT" envDefault:"10s"`
+	IdleTimeout  time.Duration `env:"SERVER_IDLE_TIMEOUT" envDefault:"60s"`
+
+	// StrictQuery rejects list requests whose limit or offset is invalid
+	// with 400. When false such values silently fall back to the defaults,
+	// as they always have.
+	StrictQuery bool `env:"SERVER_STRICT_QUERY" envDefault:"false"`
 }
 
 type Postgres struct {
@@ -25,29 +48,100 @@ type Postgres struct {
 	Password string `env:"POSTGRES_PASSWORD,required"`
 	Database string `env:"POSTGRES_DB" envDefault:"postgres"`
 	SSLMode  string `env:"POSTGRES_SSL_MODE" envDefault:"disable"`
+
+	// ReplicaDSN points read-only queries at a replica. Empty means all
+	// queries go to the primary.
+	ReplicaDSN string `env:"POSTGRES_REPLICA_DSN" envDefault:""`
+
+	// SlowQueryThreshold logs queries that run at least this long at warn
+	// level. Zero disables slow query logging.
+	SlowQueryThreshold time.Duration `env:"POSTGRES_SLOW_QUERY_THRESHOLD" envDefault:"200ms"`
 }
 
 type Redis struct {
 	Addr     string `env:"REDIS_ADDR" envDefault:"localhost:6379"`
 	Password string `env:"REDIS_PASSWORD" envDefault:""`
 	DB       int    `env:"REDIS_DB" envDefault:"0"`
+
+	// PoolSize caps open connections. Zero uses the go-redis default of ten
+	// per CPU.
+	PoolSize     int           `env:"REDIS_POOL_SIZE" envDefault:"0"`
+	MinIdleConns int           `env:"REDIS_MIN_IDLE_CONNS" envDefault:"0"`
+	DialTimeout  time.Duration `env:"REDIS_DIAL_TIMEOUT" envDefault:"5s"`
+	ReadTimeout  time.Duration `env:"REDIS_READ_TIMEOUT" envDefault:"3s"`
+
+	// After BreakerThreshold consecutive failures the cache is bypassed for
+	// BreakerCooldown, then retried.
+	BreakerThreshold int           `env:"REDIS_BREAKER_THRESHOLD" envDefault:"5"`
+	BreakerCooldown  time.Duration `env:"REDIS_BREAKER_COOLDOWN" envDefault:"30s"`
+}
+
+type Journal struct {
+	// UniqueNames rejects a journal whose name matches, ignoring case,
+	// another journal owned by the same user.
+	UniqueNames bool `env:"JOURNAL_UNIQUE_NAMES" envDefault:"false"`
+
+	// The grade weights set how much plan adherence, RR reached versus
+	// planned and outcome count toward an entry's grade. Only their ratios
+	// matter.
+	GradeWeightFollowedPlan float64 `env:"JOURNAL_GRADE_WEIGHT_FOLLOWED_PLAN" envDefault:"50"`
+	GradeWeightRR           float64 `env:"JOURNAL_GRADE_WEIGHT_RR" envDefault:"25"`
+	GradeWeightOutcome      float64 `env:"JOURNAL_GRADE_WEIGHT_OUTCOME" envDefault:"25"`
+
+	// NotesEncryptionKey is a base64-encoded 32-byte key. When set, entry
+	// notes and setups and the bodies of review notes are encrypted at rest
+	// with AES-GCM, as are asynchronous export results while they wait in
+	// Redis; existing plaintext values stay readable. Losing the key loses
+	// the notes.
+	NotesEncryptionKey string `env:"JOURNAL_NOTES_ENCRYPTION_KEY"`
+}
+
+type Metrics struct {
+	// Enabled serves business event counters at /metrics. The endpoint is
+	// unauthenticated, so expose it only to the scraper.
+	Enabled bool `env:"METRICS_ENABLED" envDefault:"false"`
 }
 
 type JWT struct {
 	Secret             string `env:"JWT_SECRET,required"`
 	AccessTokenExpiry  int    `env:"JWT_ACCESS_TOKEN_EXPIRY" envDefault:"15"`
 	RefreshTokenExpiry int    `env:"JWT_REFRESH_TOKEN_EXPIRY" envDefault:"10080"`
+	// Leeway tolerates clock drift between nodes when checking a token's
+	// expiry and not-before times.
+	Leeway time.Duration `env:"JWT_LEEWAY" envDefault:"30s"`
+}
+
+type Google struct {
+	// ClientID is the OAuth client ID Google ID tokens must be issued to.
+	// Google sign-in is disabled when it is empty.
+	ClientID string `env:"GOOGLE_CLIENT_ID"`
 }
 
 type CORS struct {
 	AllowOrigins     []string `env:"CORS_ALLOW_ORIGINS" envSeparator:"," envDefault:"http://localhost:3000"`
-	AllowMethods     []string `env:"CORS_ALLOW_METHODS" envSeparator:"," envDefault:"GET,POST,PUT,DELETE,OPTIONS"`
+	AllowMethods     []string `env:"CORS_ALLOW_METHODS" envSeparator:"," envDefault:"GET,POST,PUT,PATCH,DELETE,OPTIONS"`
 	AllowHeaders     []string `env:"CORS_ALLOW_HEADERS" envSeparator:"," envDefault:"Origin,Content-Type,Authorization"`
 	AllowCredentials bool     `env:"CORS_ALLOW_CREDENTIALS" envDefault:"true"`
 	MaxAge           int      `env:"CORS_MAX_AGE" envDefault:"43200"`
+
+	// ExemptPaths lists path prefixes that get no CORS handling at all,
+	// e.g. /health or /metrics scraped by infrastructure, not browsers.
+	ExemptPaths []string `env:"CORS_EXEMPT_PATHS" envSeparator:","`
+	// AuthAllowOrigins restricts the /auth endpoints to a narrower set of
+	// origins. Empty means they use AllowOrigins.
+	AuthAllowOrigins []string `env:"CORS_AUTH_ALLOW_ORIGINS" envSeparator:","`
 }
 
 type RateLimit struct {
 	RequestsPerSecond int `env:"RATE_LIMIT_RPS" envDefault:"10"`
 	Burst             int `env:"RATE_LIMIT_BURST" envDefault:"20"`
+
+	// ExemptCIDRs lists client ranges that are never rate limited, such as
+	// internal services and health checkers, e.g. 10.0.0.0/8.
+	ExemptCIDRs []string `env:"RATE_LIMIT_EXEMPT_CIDRS" envSeparator:","`
+	// TrustedProxies lists the ranges of reverse proxies whose
+	// X-Forwarded-For and X-Real-IP headers are believed. Headers from any
+	// other peer are ignored so clients cannot pick their own IP. The same
+	// ranges decide the IP recorded on device sessions.
+	TrustedProxies []string `env:"RATE_LIMIT_TRUSTED_PROXIES" envSeparator:","`
 }
@@ -1,9 +1,18 @@
 package config
 
 import (
+	"encoding/base64"
 	"fmt"
+	"net/netip"
+	"time"
 
 	"github.com/caarlos0/env/v10"
+	"go.uber.org/zap/zapcore"
+)
+
+const (
+	LogFormatJSON    = "json"
+	LogFormatConsole = "console"
 )
 
 func Load() (*Config, error) {
@@ -13,6 +22,34 @@ func Load() (*Config, error) {
 		return nil, fmt.Errorf("failed to parse config: %w", err)
 	}
 
+	if err := cfg.Log.Validate(); err != nil {
+		return nil, fmt.Errorf("invalid log config: %w", err)
+	}
+
+	if err := cfg.Server.Validate(); err != nil {
+		return nil, fmt.Errorf("invalid server config: %w", err)
+	}
+
+	if err := cfg.JWT.Validate(); err != nil {
+		return nil, fmt.Errorf("invalid jwt config: %w", err)
+	}
+
+	if err := cfg.Redis.Validate(); err != nil {
+		return nil, fmt.Errorf("invalid redis config: %w", err)
+	}
+
+	if err := cfg.Journal.Validate(); err != nil {
+		return nil, fmt.Errorf("invalid journal config: %w", err)
+	}
+
+	if err := cfg.CORS.Validate(); err != nil {
+		return nil, fmt.Errorf("invalid cors config: %w", err)
+	}
+
+	if err := cfg.RateLimit.Validate(); err != nil {
+		return nil, fmt.Errorf("invalid rate limit config: %w", err)
+	}
+
 	return cfg, nil
 }
 
@@ -35,3 +72,144 @@ func (a *App) IsProduction() bool {
 func (a *App) IsDevelopment() bool {
 	return a.Environment == "development"
 }
+
+func (s *Server) Validate() error {
+	if s.ReadTimeout <= 0 || s.WriteTimeout <= 0 || s.IdleTimeout <= 0 {
+		return fmt.Errorf("server timeouts must be positive")
+	}
+
+	return nil
+}
+
+// maxJWTLeeway bounds JWT_LEEWAY; tolerating more than a few minutes of
+// drift would noticeably extend the life of every access token.
+const maxJWTLeeway = 5 * time.Minute
+
+func (j *JWT) Validate() error {
+	if j.Leeway < 0 || j.Leeway > maxJWTLeeway {
+		return fmt.Errorf("JWT_LEEWAY must be between 0 and %s", maxJWTLeeway)
+	}
+
+	return nil
+}
+
+func (r *Redis) Validate() error {
+	if r.PoolSize < 0 {
+		return fmt.Errorf("REDIS_POOL_SIZE must not be negative")
+	}
+
+	if r.MinIdleConns < 0 {
+		return fmt.Errorf("REDIS_MIN_IDLE_CONNS must not be negative")
+	}
+
+	if r.PoolSize > 0 && r.MinIdleConns > r.PoolSize {
+		return fmt.Errorf("REDIS_MIN_IDLE_CONNS (%d) must not exceed REDIS_POOL_SIZE (%d)", r.MinIdleConns, r.PoolSize)
+	}
+
+	if r.DialTimeout < 0 || r.ReadTimeout < 0 {
+		return fmt.Errorf("redis timeouts must not be negative")
+	}
+
+	if r.BreakerThreshold < 1 {
+		return fmt.Errorf("REDIS_BREAKER_THRESHOLD must be at least 1")
+	}
+
+	if r.BreakerCooldown <= 0 {
+		return fmt.Errorf("REDIS_BREAKER_COOLDOWN must be positive")
+	}
+
+	return nil
+}
+
+// notesKeySize is the length of the AES-256 key notes are encrypted with.
+const notesKeySize = 32
+
+func (j *Journal) Validate() error {
+	if j.GradeWeightFollowedPlan < 0 || j.GradeWeightRR < 0 || j.GradeWeightOutcome < 0 {
+		return fmt.Errorf("grade weights must not be negative")
+	}
+
+	if j.GradeWeightFollowedPlan+j.GradeWeightRR+j.GradeWeightOutcome == 0 {
+		return fmt.Errorf("at least one grade weight must be positive")
+	}
+
+	if _, err := j.NotesKey(); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// NotesKey decodes NotesEncryptionKey. It returns nil when notes are not
+// encrypted.
+func (j *Journal) NotesKey() ([]byte, error) {
+	if j.NotesEncryptionKey == "" {
+		return nil, nil
+	}
+
+	key, err := base64.StdEncoding.DecodeString(j.NotesEncryptionKey)
+	if err != nil || len(key) != notesKeySize {
+		return nil, fmt.Errorf("JOURNAL_NOTES_ENCRYPTION_KEY must be %d base64-encoded bytes", notesKeySize)
+	}
+
+	return key, nil
+}
+
+// Validate rejects a wildcard origin combined with credentials. Browsers
+// refuse credentialed responses with Access-Control-Allow-Origin: *, so the
+// combination either breaks every credentialed request or, if the origin
+// is reflected instead, lets any site make them.
+func (c *CORS) Validate() error {
+	if !c.AllowCredentials {
+		return nil
+	}
+
+	for _, origins := range [][]string{c.AllowOrigins, c.AuthAllowOrigins} {
+		for _, origin := range origins {
+			if origin == "*" {
+				return fmt.Errorf("wildcard origin cannot be combined with CORS_ALLOW_CREDENTIALS=true")
+			}
+		}
+	}
+
+	return nil
+}
+
+// ForAuth returns the policy for the /auth endpoints: the same settings with
+// AuthAllowOrigins, if set, in place of AllowOrigins.
+func (c *CORS) ForAuth() *CORS {
+	auth := *c
+	if len(c.AuthAllowOrigins) > 0 {
+		auth.AllowOrigins = c.AuthAllowOrigins
+	}
+
+	return &auth
+}
+
+func (r *RateLimit) Validate() error {
+	for _, cidr := range r.ExemptCIDRs {
+		if _, err := netip.ParsePrefix(cidr); err != nil {
+			return fmt.Errorf("invalid RATE_LIMIT_EXEMPT_CIDRS entry %q: %w", cidr, err)
+		}
+	}
+
+	for _, cidr := range r.TrustedProxies {
+		if _, err := netip.ParsePrefix(cidr); err != nil {
+			return fmt.Errorf("invalid RATE_LIMIT_TRUSTED_PROXIES entry %q: %w", cidr, err)
+		}
+	}
+
+	return nil
+}
+
+func (l *Log) Validate() error {
+	if _, err := zapcore.ParseLevel(l.Level); err != nil {
+		return fmt.Errorf("invalid log level %q: %w", l.Level, err)
+	}
+
+	if l.Format != LogFormatJSON && l.Format != LogFormatConsole {
+		return fmt.Errorf("invalid log format %q: must be %s or %s", l.Format, LogFormatJSON, LogFormatConsole)
+	}
+
+	return nil
+}
@@ -0,0 +1,354 @@
+package config
+
+import (
+	"encoding/base64"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestLogValidate(t *testing.T) {
+	tests := []struct {
+		name    string
+		log     Log
+		wantErr bool
+	}{
+		{"info json", Log{Level: "info", Format: LogFormatJSON}, false},
+		{"debug console", Log{Level: "debug", Format: LogFormatConsole}, false},
+		{"upper case level", Log{Level: "WARN", Format: LogFormatJSON}, false},
+		{"unknown level", Log{Level: "verbose", Format: LogFormatJSON}, true},
+		{"empty format", Log{Level: "info"}, true},
+		{"unknown format", Log{Level: "info", Format: "logfmt"}, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := tt.log.Validate(); (err != nil) != tt.wantErr {
+				t.Errorf("Validate() error = %v, want error %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestLoadRejectsInvalidLogLevel(t *testing.T) {
+	t.Setenv("POSTGRES_PASSWORD", "secret")
+	t.Setenv("JWT_SECRET", "secret")
+	t.Setenv("LOG_LEVEL", "verbose")
+
+	_, err := Load()
+	if err == nil || !strings.Contains(err.Error(), "invalid log config") {
+		t.Fatalf("Load() error = %v, want an invalid log config error", err)
+	}
+}
+
+func TestCORSValidate(t *testing.T) {
+	tests := []struct {
+		name    string
+		cors    CORS
+		wantErr bool
+	}{
+		{"wildcard without credentials", CORS{AllowOrigins: []string{"*"}}, false},
+		{"explicit origins with credentials", CORS{AllowOrigins: []string{"https://app.example.com"}, AllowCredentials: true}, false},
+		{"wildcard with credentials", CORS{AllowOrigins: []string{"https://app.example.com", "*"}, AllowCredentials: true}, true},
+		{"auth wildcard with credentials", CORS{AllowOrigins: []string{"https://app.example.com"}, AuthAllowOrigins: []string{"*"}, AllowCredentials: true}, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := tt.cors.Validate(); (err != nil) != tt.wantErr {
+				t.Errorf("Validate() error = %v, want error %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestLoadRejectsCredentialedWildcardOrigin(t *testing.T) {
+	t.Setenv("POSTGRES_PASSWORD", "secret")
+	t.Setenv("JWT_SECRET", "secret")
+	t.Setenv("CORS_ALLOW_ORIGINS", "*")
+	t.Setenv("CORS_ALLOW_CREDENTIALS", "true")
+
+	_, err := Load()
+	if err == nil || !strings.Contains(err.Error(), "invalid cors config") {
+		t.Fatalf("Load() error = %v, want an invalid cors config error", err)
+	}
+}
+
+func TestCORSForAuth(t *testing.T) {
+	base := CORS{AllowOrigins: []string{"https://app.example.com"}, AllowCredentials: true}
+
+	if got := base.ForAuth().AllowOrigins; len(got) != 1 || got[0] != "https://app.example.com" {
+		t.Errorf("ForAuth() without auth origins = %v, want the global origins", got)
+	}
+
+	base.AuthAllowOrigins = []string{"https://login.example.com"}
+	auth := base.ForAuth()
+	if len(auth.AllowOrigins) != 1 || auth.AllowOrigins[0] != "https://login.example.com" {
+		t.Errorf("ForAuth() origins = %v, want the auth origins", auth.AllowOrigins)
+	}
+	if !auth.AllowCredentials {
+		t.Error("ForAuth() dropped AllowCredentials")
+	}
+	if base.AllowOrigins[0] != "https://app.example.com" {
+		t.Errorf("ForAuth() modified the receiver: %v", base.AllowOrigins)
+	}
+}
+
+func TestRedisValidate(t *testing.T) {
+	tests := []struct {
+		name    string
+		modify  func(r *Redis)
+		wantErr bool
+	}{
+		{"defaults", func(*Redis) {}, false},
+		{"tuned pool", func(r *Redis) { r.PoolSize, r.MinIdleConns = 50, 10 }, false},
+		{"idle conns with default pool", func(r *Redis) { r.MinIdleConns = 10 }, false},
+		{"negative pool size", func(r *Redis) { r.PoolSize = -1 }, true},
+		{"negative idle conns", func(r *Redis) { r.MinIdleConns = -1 }, true},
+		{"idle conns above pool size", func(r *Redis) { r.PoolSize, r.MinIdleConns = 5, 10 }, true},
+		{"negative dial timeout", func(r *Redis) { r.DialTimeout = -time.Second }, true},
+		{"negative read timeout", func(r *Redis) { r.ReadTimeout = -time.Second }, true},
+		{"zero breaker threshold", func(r *Redis) { r.BreakerThreshold = 0 }, true},
+		{"zero breaker cooldown", func(r *Redis) { r.BreakerCooldown = 0 }, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			redis := Redis{
+				DialTimeout:      5 * time.Second,
+				ReadTimeout:      3 * time.Second,
+				BreakerThreshold: 5,
+				BreakerCooldown:  30 * time.Second,
+			}
+			tt.modify(&redis)
+
+			if err := redis.Validate(); (err != nil) != tt.wantErr {
+				t.Errorf("Validate() error = %v, want error %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestServerValidate(t *testing.T) {
+	tests := []struct {
+		name    string
+		mutate  func(s *Server)
+		wantErr bool
+	}{
+		{"defaults", func(*Server) {}, false},
+		{"zero read timeout", func(s *Server) { s.ReadTimeout = 0 }, true},
+		{"negative write timeout", func(s *Server) { s.WriteTimeout = -time.Second }, true},
+		{"zero idle timeout", func(s *Server) { s.IdleTimeout = 0 }, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := Server{
+				Port:         "8080",
+				ReadTimeout:  10 * time.Second,
+				WriteTimeout: 10 * time.Second,
+				IdleTimeout:  60 * time.Second,
+			}
+			tt.mutate(&server)
+
+			if err := server.Validate(); (err != nil) != tt.wantErr {
+				t.Errorf("Validate() error = %v, want error %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestLoadServerTimeouts(t *testing.T) {
+	tests := []struct {
+		name      string
+		env       map[string]string
+		wantRead  time.Duration
+		wantWrite time.Duration
+		wantIdle  time.Duration
+	}{
+		{"defaults", nil, 10 * time.Second, 10 * time.Second, 60 * time.Second},
+		{"from env", map[string]string{
+			"SERVER_READ_TIMEOUT":  "30s",
+			"SERVER_WRITE_TIMEOUT": "2m",
+			"SERVER_IDLE_TIMEOUT":  "90s",
+		}, 30 * time.Second, 2 * time.Minute, 90 * time.Second},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Setenv("POSTGRES_PASSWORD", "secret")
+			t.Setenv("JWT_SECRET", "secret")
+			for key, value := range tt.env {
+				t.Setenv(key, value)
+			}
+
+			cfg, err := Load()
+			if err != nil {
+				t.Fatalf("Load() error = %v", err)
+			}
+			if cfg.Server.ReadTimeout != tt.wantRead || cfg.Server.WriteTimeout != tt.wantWrite || cfg.Server.IdleTimeout != tt.wantIdle {
+				t.Errorf("timeouts = %v/%v/%v, want %v/%v/%v",
+					cfg.Server.ReadTimeout, cfg.Server.WriteTimeout, cfg.Server.IdleTimeout,
+					tt.wantRead, tt.wantWrite, tt.wantIdle)
+			}
+		})
+	}
+}
+
+func TestLoadRejectsNonPositiveServerTimeout(t *testing.T) {
+	t.Setenv("POSTGRES_PASSWORD", "secret")
+	t.Setenv("JWT_SECRET", "secret")
+	t.Setenv("SERVER_WRITE_TIMEOUT", "0s")
+
+	_, err := Load()
+	if err == nil || !strings.Contains(err.Error(), "invalid server config") {
+		t.Fatalf("Load() error = %v, want an invalid server config error", err)
+	}
+}
+
+func TestRateLimitValidateExemptCIDRs(t *testing.T) {
+	tests := []struct {
+		name    string
+		cidrs   []string
+		wantErr bool
+	}{
+		{"none", nil, false},
+		{"ipv4 and ipv6 ranges", []string{"10.0.0.0/8", "fd00::/8"}, false},
+		{"single address", []string{"127.0.0.1/32"}, false},
+		{"missing prefix length", []string{"10.0.0.1"}, true},
+		{"not an address", []string{"internal"}, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rateLimit := RateLimit{RequestsPerSecond: 10, Burst: 20, ExemptCIDRs: tt.cidrs}
+			if err := rateLimit.Validate(); (err != nil) != tt.wantErr {
+				t.Errorf("Validate() error = %v, want error %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestRateLimitValidateTrustedProxies(t *testing.T) {
+	tests := []struct {
+		name    string
+		proxies []string
+		wantErr bool
+	}{
+		{"none", nil, false},
+		{"proxy ranges", []string{"10.0.0.0/8", "fd00::/8"}, false},
+		{"missing prefix length", []string{"10.0.0.1"}, true},
+		{"hostname", []string{"proxy.internal"}, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rateLimit := RateLimit{RequestsPerSecond: 10, Burst: 20, TrustedProxies: tt.proxies}
+			if err := rateLimit.Validate(); (err != nil) != tt.wantErr {
+				t.Errorf("Validate() error = %v, want error %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestJournalValidateGradeWeights(t *testing.T) {
+	tests := []struct {
+		name    string
+		modify  func(j *Journal)
+		wantErr bool
+	}{
+		{"defaults", func(*Journal) {}, false},
+		{"outcome only", func(j *Journal) { j.GradeWeightFollowedPlan, j.GradeWeightRR = 0, 0 }, false},
+		{"negative weight", func(j *Journal) { j.GradeWeightRR = -1 }, true},
+		{"all zero", func(j *Journal) { j.GradeWeightFollowedPlan, j.GradeWeightRR, j.GradeWeightOutcome = 0, 0, 0 }, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			journal := Journal{GradeWeightFollowedPlan: 50, GradeWeightRR: 25, GradeWeightOutcome: 25}
+			tt.modify(&journal)
+
+			if err := journal.Validate(); (err != nil) != tt.wantErr {
+				t.Errorf("Validate() error = %v, want error %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestJWTValidateLeeway(t *testing.T) {
+	tests := []struct {
+		name    string
+		leeway  time.Duration
+		wantErr bool
+	}{
+		{"default", 30 * time.Second, false},
+		{"none", 0, false},
+		{"maximum", 5 * time.Minute, false},
+		{"negative", -time.Second, true},
+		{"above maximum", 5*time.Minute + time.Second, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			jwt := JWT{Leeway: tt.leeway}
+			if err := jwt.Validate(); (err != nil) != tt.wantErr {
+				t.Errorf("Validate() error = %v, want error %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestLoadRejectsInvalidJWTLeeway(t *testing.T) {
+	t.Setenv("POSTGRES_PASSWORD", "secret")
+	t.Setenv("JWT_SECRET", "secret")
+	t.Setenv("JWT_LEEWAY", "10m")
+
+	_, err := Load()
+	if err == nil || !strings.Contains(err.Error(), "invalid jwt config") {
+		t.Fatalf("Load() error = %v, want an invalid jwt config error", err)
+	}
+}
+
+func TestJournalNotesKey(t *testing.T) {
+	key := strings.Repeat("k", notesKeySize)
+
+	tests := []struct {
+		name    string
+		encoded string
+		want    string
+		wantErr bool
+	}{
+		{"unset", "", "", false},
+		{"32 bytes", base64.StdEncoding.EncodeToString([]byte(key)), key, false},
+		{"16 bytes", base64.StdEncoding.EncodeToString([]byte(key[:16])), "", true},
+		{"not base64", "not a key!", "", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			journal := Journal{GradeWeightFollowedPlan: 50, GradeWeightRR: 25, GradeWeightOutcome: 25, NotesEncryptionKey: tt.encoded}
+
+			got, err := journal.NotesKey()
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("NotesKey() error = %v, want error %v", err, tt.wantErr)
+			}
+			if string(got) != tt.want {
+				t.Errorf("NotesKey() = %q, want %q", got, tt.want)
+			}
+			if err := journal.Validate(); (err != nil) != tt.wantErr {
+				t.Errorf("Validate() error = %v, want error %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestLoadRejectsInvalidNotesKey(t *testing.T) {
+	t.Setenv("POSTGRES_PASSWORD", "secret")
+	t.Setenv("JWT_SECRET", "secret")
+	t.Setenv("JOURNAL_NOTES_ENCRYPTION_KEY", "c2hvcnQ=")
+
+	_, err := Load()
+	if err == nil || !strings.Contains(err.Error(), "invalid journal config") {
+		t.Fatalf("Load() error = %v, want an invalid journal config error", err)
+	}
+}
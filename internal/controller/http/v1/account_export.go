@@ -0,0 +1,85 @@
+package v1
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+
+	"github.com/cockroachdb/errors"
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/user/normark/internal/entity"
+	"go.uber.org/zap"
+)
+
+type AccountExportService interface {
+	Export(ctx context.Context, userID uuid.UUID, w io.Writer) error
+}
+
+type AccountExportHandler struct {
+	exportService AccountExportService
+}
+
+func NewAccountExportHandler(exportService AccountExportService) *AccountExportHandler {
+	return &AccountExportHandler{
+		exportService: exportService,
+	}
+}
+
+func (h *AccountExportHandler) InitRoutes(group *gin.RouterGroup) {
+	group.GET("/export", h.Export)
+}
+
+// Export godoc
+// @Summary      Export account data
+// @Description  Download everything stored about the authenticated user as one JSON document, for data portability: the profile (without the password hash), every device session including expired ones, every journal including archived ones, every entry, and the entries' review notes and partial exits. The document is streamed while it is generated, so an error midway leaves it truncated rather than returning an error status; a complete document ends with the closing brace.
+// @Tags         Users
+// @Produce      json
+// @Security     BearerAuth
+// @Success      200 {object} dto.AccountExportDocument "Account export document"
+// @Header       200 {string} Content-Disposition "attachment; filename=\"normark-export-{user id}.json\""
+// @Failure      401 {object} ErrorResponse "Unauthorized - missing or invalid token"
+// @Failure      404 {object} ErrorResponse "User not found"
+// @Failure      500 {object} ErrorResponse "Internal server error"
+// @Router       /api/v1/users/me/export [get]
+func (h *AccountExportHandler) Export(c *gin.Context) {
+	userID, exists := c.Get("userID")
+	if !exists {
+		loggerFromContext(c).Error("user id not found in context")
+		newErrorResponse(c, http.StatusUnauthorized, "unauthorized")
+		return
+	}
+
+	uid, ok := userID.(uuid.UUID)
+	if !ok {
+		loggerFromContext(c).Error("invalid user id type in context")
+		newErrorResponse(c, http.StatusInternalServerError, "internal server error")
+		return
+	}
+
+	// Like the journal export, the document is a file and is never wrapped
+	// in a response envelope.
+	c.Header("Content-Type", "application/json; charset=utf-8")
+	c.Header("Content-Disposition", fmt.Sprintf("attachment; filename=\"normark-export-%s.json\"", uid))
+	c.Status(http.StatusOK)
+
+	err := h.exportService.Export(c.Request.Context(), uid, c.Writer)
+	if err == nil {
+		return
+	}
+
+	loggerFromContext(c).Error("failed to export account", zap.Error(err))
+	if c.Writer.Written() {
+		// The status is already sent; the truncated body is the only signal.
+		c.Abort()
+		return
+	}
+
+	c.Writer.Header().Del("Content-Disposition")
+	if errors.Is(err, entity.ErrNotFound) {
+		newErrorResponse(c, http.StatusNotFound, "user not found")
+		return
+	}
+	newErrorResponse(c, http.StatusInternalServerError, err.Error())
+}
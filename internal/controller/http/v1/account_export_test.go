@@ -0,0 +1,91 @@
+package v1
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"testing"
+
+	"github.com/cockroachdb/errors"
+	"github.com/google/uuid"
+	"github.com/user/normark/internal/entity"
+)
+
+// fakeAccountExportService writes body and then fails with err.
+type fakeAccountExportService struct {
+	body   string
+	err    error
+	userID uuid.UUID
+}
+
+func (s *fakeAccountExportService) Export(_ context.Context, userID uuid.UUID, w io.Writer) error {
+	s.userID = userID
+	if s.body != "" {
+		_, _ = io.WriteString(w, s.body)
+	}
+	return s.err
+}
+
+func TestExportAccount(t *testing.T) {
+	tests := []struct {
+		name            string
+		service         *fakeAccountExportService
+		wantStatus      int
+		wantBody        string
+		wantCode        string
+		wantDisposition bool
+	}{
+		{
+			name:            "complete document",
+			service:         &fakeAccountExportService{body: `{"version":1}`},
+			wantStatus:      http.StatusOK,
+			wantBody:        `{"version":1}`,
+			wantDisposition: true,
+		},
+		{
+			name:       "user not found",
+			service:    &fakeAccountExportService{err: entity.ErrNotFound},
+			wantStatus: http.StatusNotFound,
+			wantCode:   CodeNotFound,
+		},
+		{
+			name:       "failure before writing",
+			service:    &fakeAccountExportService{err: errors.New("connection reset")},
+			wantStatus: http.StatusInternalServerError,
+			wantCode:   CodeInternal,
+		},
+		{
+			name:            "failure midway leaves the document truncated",
+			service:         &fakeAccountExportService{body: `{"version":1,"journals":[`, err: errors.New("connection reset")},
+			wantStatus:      http.StatusOK,
+			wantBody:        `{"version":1,"journals":[`,
+			wantDisposition: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			router := newTestRouter(t, &fakeJournalAccess{}, testServices{accountExport: tt.service})
+
+			rec := doRequest(router, http.MethodGet, "/api/v1/users/me/export", "")
+			if rec.Code != tt.wantStatus {
+				t.Fatalf("status = %d, want %d; body %s", rec.Code, tt.wantStatus, rec.Body)
+			}
+			if tt.service.userID != testUserID {
+				t.Errorf("exported user %s, want %s", tt.service.userID, testUserID)
+			}
+			if disposition := rec.Header().Get("Content-Disposition"); (disposition != "") != tt.wantDisposition {
+				t.Errorf("Content-Disposition = %q, want set %v", disposition, tt.wantDisposition)
+			}
+			if tt.wantCode != "" {
+				if code := decodeErrorCode(t, rec); code != tt.wantCode {
+					t.Errorf("code = %q, want %q", code, tt.wantCode)
+				}
+				return
+			}
+			if rec.Body.String() != tt.wantBody {
+				t.Errorf("body = %s, want %s", rec.Body, tt.wantBody)
+			}
+		})
+	}
+}
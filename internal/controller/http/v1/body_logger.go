@@ -0,0 +1,122 @@
+package v1
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	"go.uber.org/zap"
+)
+
+const (
+	maxLoggedBodyBytes = 4096
+	redactedValue      = "[REDACTED]"
+)
+
+// sensitiveKeyParts marks JSON keys whose values are never logged. Matching
+// is case-insensitive and by substring, so "refresh_token" and "newPassword"
+// are covered too.
+var sensitiveKeyParts = []string{"password", "token", "secret", "authorization"}
+
+type bodyLogWriter struct {
+	gin.ResponseWriter
+	body *bytes.Buffer
+}
+
+func (w *bodyLogWriter) Write(b []byte) (int, error) {
+	w.body.Write(b)
+	return w.ResponseWriter.Write(b)
+}
+
+func (w *bodyLogWriter) WriteString(s string) (int, error) {
+	w.body.WriteString(s)
+	return w.ResponseWriter.WriteString(s)
+}
+
+// BodyLogger logs request and response bodies at debug level with sensitive
+// fields redacted. It is meant for local debugging only; the handler never
+// registers it in production.
+func (m *Middleware) BodyLogger() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		var requestBody []byte
+		if c.Request.Body != nil {
+			body, err := io.ReadAll(c.Request.Body)
+			if err != nil {
+				loggerFromContext(c).Debug("failed to read request body", zap.Error(err))
+			}
+			requestBody = body
+			c.Request.Body = io.NopCloser(bytes.NewReader(body))
+		}
+
+		writer := &bodyLogWriter{ResponseWriter: c.Writer, body: &bytes.Buffer{}}
+		c.Writer = writer
+
+		c.Next()
+
+		loggerFromContext(c).Debug(
+			"request body",
+			zap.String("method", c.Request.Method),
+			zap.String("path", c.Request.URL.Path),
+			zap.Int("status", writer.Status()),
+			zap.String("request_body", redactBody(requestBody)),
+			zap.String("response_body", redactBody(writer.body.Bytes())),
+		)
+	}
+}
+
+// redactBody returns the body with sensitive JSON values replaced. Bodies
+// that are not JSON are omitted entirely, since they can't be redacted.
+func redactBody(body []byte) string {
+	if len(body) == 0 {
+		return ""
+	}
+
+	var payload any
+	if err := json.Unmarshal(body, &payload); err != nil {
+		return "[non-JSON body omitted]"
+	}
+
+	redacted, err := json.Marshal(redactValue(payload))
+	if err != nil {
+		return "[unloggable body omitted]"
+	}
+
+	if len(redacted) > maxLoggedBodyBytes {
+		return string(redacted[:maxLoggedBodyBytes]) + "...[truncated]"
+	}
+
+	return string(redacted)
+}
+
+func redactValue(value any) any {
+	switch v := value.(type) {
+	case map[string]any:
+		for key, inner := range v {
+			if isSensitiveKey(key) {
+				v[key] = redactedValue
+				continue
+			}
+			v[key] = redactValue(inner)
+		}
+		return v
+	case []any:
+		for i, inner := range v {
+			v[i] = redactValue(inner)
+		}
+		return v
+	default:
+		return v
+	}
+}
+
+func isSensitiveKey(key string) bool {
+	lower := strings.ToLower(key)
+	for _, part := range sensitiveKeyParts {
+		if strings.Contains(lower, part) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,93 @@
+package v1
+
+import (
+	"net/http"
+	"strings"
+	"testing"
+
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+	"go.uber.org/zap/zaptest/observer"
+)
+
+func TestRedactBody(t *testing.T) {
+	tests := []struct {
+		name string
+		body string
+		want string
+	}{
+		{"empty", "", ""},
+		{"no sensitive fields", `{"email":"trader@example.com"}`, `{"email":"trader@example.com"}`},
+		{"password", `{"email":"trader@example.com","password":"hunter2"}`, `{"email":"trader@example.com","password":"[REDACTED]"}`},
+		{"key case and substring", `{"newPassword":"hunter2","refresh_token":"abc","Client_Secret":"s"}`,
+			`{"Client_Secret":"[REDACTED]","newPassword":"[REDACTED]","refresh_token":"[REDACTED]"}`},
+		{"nested", `{"user":{"access_token":"abc"},"sessions":[{"token":"def","device":"phone"}]}`,
+			`{"sessions":[{"device":"phone","token":"[REDACTED]"}],"user":{"access_token":"[REDACTED]"}}`},
+		{"sensitive object", `{"authorization":{"scheme":"Bearer"}}`, `{"authorization":"[REDACTED]"}`},
+		{"not json", "password=hunter2", "[non-JSON body omitted]"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := redactBody([]byte(tt.body)); got != tt.want {
+				t.Errorf("redactBody() = %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRedactBodyTruncates(t *testing.T) {
+	got := redactBody([]byte(`{"notes":"` + strings.Repeat("x", 2*maxLoggedBodyBytes) + `"}`))
+
+	if !strings.HasSuffix(got, "...[truncated]") || len(got) != maxLoggedBodyBytes+len("...[truncated]") {
+		t.Errorf("redactBody() returned %d bytes, want %d ending in the truncation marker", len(got), maxLoggedBodyBytes)
+	}
+}
+
+func TestBodyLoggerRedactsPasswords(t *testing.T) {
+	tests := []struct {
+		environment string
+		level       zapcore.Level
+		wantLogged  bool
+	}{
+		{"development", zapcore.DebugLevel, true},
+		{"development", zapcore.InfoLevel, false},
+		{"production", zapcore.DebugLevel, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.environment+" "+tt.level.String(), func(t *testing.T) {
+			core, logs := observer.New(tt.level)
+			router := newLoggedTestRouter(t, zap.New(core), tt.environment, &fakeJournalAccess{}, testServices{journals: &dedupeJournalService{}})
+
+			rec := doRequest(router, http.MethodPost, "/api/v1/journals", `{"name":"Swing","password":"hunter2"}`)
+			if rec.Code != http.StatusCreated {
+				t.Fatalf("status = %d, want %d; body %s", rec.Code, http.StatusCreated, rec.Body)
+			}
+
+			entries := logs.FilterMessage("request body").All()
+			if (len(entries) == 1) != tt.wantLogged {
+				t.Fatalf("logged %d request bodies, want logged %v", len(entries), tt.wantLogged)
+			}
+
+			for _, entry := range logs.All() {
+				for _, field := range entry.Context {
+					if strings.Contains(field.String, "hunter2") {
+						t.Errorf("log %q field %s contains the password: %s", entry.Message, field.Key, field.String)
+					}
+				}
+			}
+			if !tt.wantLogged {
+				return
+			}
+
+			fields := entries[0].ContextMap()
+			if body := fields["request_body"].(string); !strings.Contains(body, `"password":"[REDACTED]"`) || !strings.Contains(body, `"name":"Swing"`) {
+				t.Errorf("request body = %s, want the name kept and the password redacted", body)
+			}
+			if body := fields["response_body"].(string); !strings.Contains(body, `"name":"Swing"`) {
+				t.Errorf("response body = %s, want the created journal", body)
+			}
+		})
+	}
+}
@@ -0,0 +1,134 @@
+package v1
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/cockroachdb/errors"
+	"github.com/gin-gonic/gin"
+	"github.com/go-playground/validator/v10"
+	"github.com/google/uuid"
+	"github.com/user/normark/internal/dto"
+	"github.com/user/normark/internal/dto/mapper"
+	"github.com/user/normark/internal/entity"
+	"go.uber.org/zap"
+)
+
+type EntryExitService interface {
+	Add(ctx context.Context, entryID uuid.UUID, journalID uuid.UUID, req *dto.CreateEntryExitRequest) (*entity.EntryExit, error)
+	List(ctx context.Context, entryID uuid.UUID, journalID uuid.UUID) ([]*entity.EntryExit, error)
+}
+
+type EntryExitHandler struct {
+	exitService EntryExitService
+	validate    *validator.Validate
+}
+
+func NewEntryExitHandler(
+	exitService EntryExitService,
+	validate *validator.Validate,
+) *EntryExitHandler {
+	return &EntryExitHandler{
+		exitService: exitService,
+		validate:    validate,
+	}
+}
+
+func (h *EntryExitHandler) InitRoutes(group *gin.RouterGroup) {
+	group.POST("", h.Add)
+	group.GET("", h.List)
+}
+
+// Add godoc
+// @Summary      Record a partial exit
+// @Description  Record one tranche of a position being closed. Once an entry has exits, its realized is the sum of their realized and is used in statistics. The entry with the new realized must still satisfy the journal's policy, e.g. a take profit cannot end up with a negative realized.
+// @Tags         Trading Journal Entries
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Param        id path string true "Trading Journal ID (UUID)"
+// @Param        entryId path string true "Trading Entry ID (UUID)"
+// @Param        request body dto.CreateEntryExitRequest true "Exit details"
+// @Success      201 {object} dto.EntryExitResponse "Successfully recorded exit"
+// @Failure      400 {object} ErrorResponse "Invalid request body, validation failed, journal policy violated, invalid journal ID, or invalid entry ID"
+// @Failure      401 {object} ErrorResponse "Unauthorized - missing or invalid token"
+// @Failure      403 {object} ErrorResponse "Access denied - journal does not belong to user"
+// @Failure      404 {object} ErrorResponse "Entry not found"
+// @Failure      423 {object} ErrorResponse "Journal is locked"
+// @Failure      500 {object} ErrorResponse "Internal server error"
+// @Router       /api/v1/journals/{id}/entries/{entryId}/exits [post]
+func (h *EntryExitHandler) Add(c *gin.Context) {
+	journalID := uuidParam(c, "id")
+
+	entryID := uuidParam(c, "entryId")
+
+	var req dto.CreateEntryExitRequest
+
+	if err := c.ShouldBindJSON(&req); err != nil {
+		loggerFromContext(c).Error("failed to bind request", zap.Error(err))
+		newErrorResponse(c, http.StatusBadRequest, "invalid request body")
+		return
+	}
+
+	if err := h.validate.Struct(&req); err != nil {
+		loggerFromContext(c).Error("validation failed", zap.Error(err))
+		newErrorResponseFromError(c, http.StatusBadRequest, err)
+		return
+	}
+
+	exit, err := h.exitService.Add(c.Request.Context(), entryID, journalID, &req)
+	if err != nil {
+		loggerFromContext(c).Error("failed to add entry exit", zap.Error(err))
+		if errors.Is(err, entity.ErrNotFound) {
+			newErrorResponse(c, http.StatusNotFound, "entry not found")
+			return
+		}
+		if errors.Is(err, entity.ErrJournalLocked) {
+			newErrorResponseFromError(c, http.StatusLocked, err)
+			return
+		}
+		if errors.Is(err, entity.ErrEntryPolicy) {
+			newErrorResponseFromError(c, http.StatusBadRequest, err)
+			return
+		}
+		newErrorResponse(c, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	respond(c, http.StatusCreated, mapper.ToEntryExitResponse(exit))
+}
+
+// List godoc
+// @Summary      List an entry's exits
+// @Description  Get all partial exits recorded for a trade in the order they were taken, with their total realized
+// @Tags         Trading Journal Entries
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Param        id path string true "Trading Journal ID (UUID)"
+// @Param        entryId path string true "Trading Entry ID (UUID)"
+// @Success      200 {object} dto.EntryExitListResponse "Successfully retrieved exits"
+// @Failure      400 {object} ErrorResponse "Invalid journal ID or entry ID"
+// @Failure      401 {object} ErrorResponse "Unauthorized - missing or invalid token"
+// @Failure      403 {object} ErrorResponse "Access denied - journal does not belong to user"
+// @Failure      404 {object} ErrorResponse "Entry not found"
+// @Failure      500 {object} ErrorResponse "Internal server error"
+// @Router       /api/v1/journals/{id}/entries/{entryId}/exits [get]
+func (h *EntryExitHandler) List(c *gin.Context) {
+	journalID := uuidParam(c, "id")
+
+	entryID := uuidParam(c, "entryId")
+
+	exits, err := h.exitService.List(c.Request.Context(), entryID, journalID)
+	if err != nil {
+		loggerFromContext(c).Error("failed to list entry exits", zap.Error(err))
+		if errors.Is(err, entity.ErrNotFound) {
+			newErrorResponse(c, http.StatusNotFound, "entry not found")
+			return
+		}
+		newErrorResponse(c, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	respond(c, http.StatusOK, mapper.ToEntryExitListResponse(exits))
+}
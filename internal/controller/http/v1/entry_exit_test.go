@@ -0,0 +1,122 @@
+package v1
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/cockroachdb/errors"
+	"github.com/google/uuid"
+	"github.com/user/normark/internal/dto"
+	"github.com/user/normark/internal/entity"
+)
+
+// memoryExitService keeps exits of the entries in entries, in order.
+type memoryExitService struct {
+	EntryExitService
+	entries map[uuid.UUID]bool
+	locked  bool
+	err     error
+	exits   []*entity.EntryExit
+}
+
+func (s *memoryExitService) Add(_ context.Context, entryID uuid.UUID, _ uuid.UUID, req *dto.CreateEntryExitRequest) (*entity.EntryExit, error) {
+	if !s.entries[entryID] {
+		return nil, errors.Wrap(entity.ErrNotFound, "trading journal entry")
+	}
+	if s.locked {
+		return nil, entity.ErrJournalLocked
+	}
+	if s.err != nil {
+		return nil, s.err
+	}
+	exit := entity.NewEntryExit(entryID, req.Size, req.Price, req.Realized, time.Now())
+	exit.ID = uuid.New()
+	s.exits = append(s.exits, exit)
+	return exit, nil
+}
+
+func (s *memoryExitService) List(_ context.Context, entryID uuid.UUID, _ uuid.UUID) ([]*entity.EntryExit, error) {
+	if !s.entries[entryID] {
+		return nil, errors.Wrap(entity.ErrNotFound, "trading journal entry")
+	}
+	return s.exits, nil
+}
+
+func TestEntryExitsHandler(t *testing.T) {
+	journalID := uuid.New()
+	entryID := uuid.New()
+	exits := &memoryExitService{entries: map[uuid.UUID]bool{entryID: true}}
+	access := &fakeJournalAccess{owned: map[uuid.UUID]bool{journalID: true}}
+	router := newTestRouter(t, access, testServices{exits: exits})
+	path := "/api/v1/journals/" + journalID.String() + "/entries/" + entryID.String() + "/exits"
+
+	for _, body := range []string{
+		`{"size":0.5,"price":1.1050,"realized":10.1}`,
+		`{"size":0.5,"price":1.1080,"realized":20.2}`,
+	} {
+		rec := doRequest(router, http.MethodPost, path, body)
+		if rec.Code != http.StatusCreated {
+			t.Fatalf("add: status = %d, want %d; body %s", rec.Code, http.StatusCreated, rec.Body)
+		}
+	}
+
+	rec := doRequest(router, http.MethodGet, path, "")
+	if rec.Code != http.StatusOK {
+		t.Fatalf("list: status = %d, want %d; body %s", rec.Code, http.StatusOK, rec.Body)
+	}
+
+	var response dto.EntryExitListResponse
+	if err := json.Unmarshal(rec.Body.Bytes(), &response); err != nil {
+		t.Fatalf("decode response: %v", err)
+	}
+	if response.Total != 2 || len(response.Exits) != 2 {
+		t.Fatalf("listed %d exits (total %d), want 2", len(response.Exits), response.Total)
+	}
+	if response.TotalRealized != 30.3 {
+		t.Errorf("total realized = %v, want 30.3", response.TotalRealized)
+	}
+}
+
+func TestEntryExitsHandlerErrors(t *testing.T) {
+	journalID := uuid.New()
+	entryID := uuid.New()
+	base := "/api/v1/journals/" + journalID.String() + "/entries/"
+	exit := `{"size":0.5,"price":1.1050,"realized":10}`
+
+	tests := []struct {
+		name       string
+		method     string
+		path       string
+		body       string
+		locked     bool
+		err        error
+		wantStatus int
+	}{
+		{name: "missing size", method: http.MethodPost, path: base + entryID.String() + "/exits", body: `{"price":1.1}`, wantStatus: http.StatusBadRequest},
+		{name: "negative price", method: http.MethodPost, path: base + entryID.String() + "/exits", body: `{"size":1,"price":-1}`, wantStatus: http.StatusBadRequest},
+		{name: "unknown entry", method: http.MethodPost, path: base + uuid.NewString() + "/exits", body: exit, wantStatus: http.StatusNotFound},
+		{name: "locked journal", method: http.MethodPost, path: base + entryID.String() + "/exits", body: exit, locked: true, wantStatus: http.StatusLocked},
+		{name: "journal policy violated", method: http.MethodPost, path: base + entryID.String() + "/exits", body: exit, err: errors.Mark(errors.New("realized contradicts result"), entity.ErrEntryPolicy), wantStatus: http.StatusBadRequest},
+		{name: "storage failure", method: http.MethodPost, path: base + entryID.String() + "/exits", body: exit, err: errors.New("connection refused"), wantStatus: http.StatusInternalServerError},
+		{name: "list unknown entry", method: http.MethodGet, path: base + uuid.NewString() + "/exits", wantStatus: http.StatusNotFound},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			exits := &memoryExitService{entries: map[uuid.UUID]bool{entryID: true}, locked: tt.locked, err: tt.err}
+			access := &fakeJournalAccess{owned: map[uuid.UUID]bool{journalID: true}}
+			router := newTestRouter(t, access, testServices{exits: exits})
+
+			rec := doRequest(router, tt.method, tt.path, tt.body)
+			if rec.Code != tt.wantStatus {
+				t.Fatalf("status = %d, want %d; body %s", rec.Code, tt.wantStatus, rec.Body)
+			}
+			if len(exits.exits) != 0 {
+				t.Errorf("stored %d exits, want none", len(exits.exits))
+			}
+		})
+	}
+}
@@ -0,0 +1,135 @@
+package v1
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/cockroachdb/errors"
+	"github.com/gin-gonic/gin"
+	"github.com/go-playground/validator/v10"
+	"github.com/google/uuid"
+	"github.com/user/normark/internal/dto"
+	"github.com/user/normark/internal/dto/mapper"
+	"github.com/user/normark/internal/entity"
+	"go.uber.org/zap"
+)
+
+type EntryNoteService interface {
+	Add(ctx context.Context, entryID uuid.UUID, journalID uuid.UUID, body string) (*entity.EntryNote, error)
+	List(ctx context.Context, entryID uuid.UUID, journalID uuid.UUID) ([]*entity.EntryNote, error)
+}
+
+type EntryNoteHandler struct {
+	noteService EntryNoteService
+	validate    *validator.Validate
+}
+
+func NewEntryNoteHandler(
+	noteService EntryNoteService,
+	validate *validator.Validate,
+) *EntryNoteHandler {
+	return &EntryNoteHandler{
+		noteService: noteService,
+		validate:    validate,
+	}
+}
+
+func (h *EntryNoteHandler) InitRoutes(group *gin.RouterGroup) {
+	group.POST("", h.Add)
+	group.GET("", h.List)
+}
+
+// Add godoc
+// @Summary      Append a review note to an entry
+// @Description  Append a dated review note to a trade. Notes are append-only and do not change the entry's original notes.
+// @Tags         Trading Journal Entries
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Param        id path string true "Trading Journal ID (UUID)"
+// @Param        entryId path string true "Trading Entry ID (UUID)"
+// @Param        request body dto.CreateEntryNoteRequest true "Note body"
+// @Success      201 {object} dto.EntryNoteResponse "Successfully appended note"
+// @Failure      400 {object} ErrorResponse "Invalid request body, validation failed, invalid journal ID, or invalid entry ID"
+// @Failure      401 {object} ErrorResponse "Unauthorized - missing or invalid token"
+// @Failure      403 {object} ErrorResponse "Access denied - journal does not belong to user"
+// @Failure      404 {object} ErrorResponse "Entry not found"
+// @Failure      423 {object} ErrorResponse "Journal is locked"
+// @Failure      500 {object} ErrorResponse "Internal server error"
+// @Router       /api/v1/journals/{id}/entries/{entryId}/notes [post]
+func (h *EntryNoteHandler) Add(c *gin.Context) {
+	journalID := uuidParam(c, "id")
+
+	entryID := uuidParam(c, "entryId")
+
+	var req dto.CreateEntryNoteRequest
+
+	if err := c.ShouldBindJSON(&req); err != nil {
+		loggerFromContext(c).Error("failed to bind request", zap.Error(err))
+		newErrorResponse(c, http.StatusBadRequest, "invalid request body")
+		return
+	}
+
+	if err := h.validate.Struct(&req); err != nil {
+		loggerFromContext(c).Error("validation failed", zap.Error(err))
+		newErrorResponseFromError(c, http.StatusBadRequest, err)
+		return
+	}
+
+	note, err := h.noteService.Add(c.Request.Context(), entryID, journalID, req.Body)
+	if err != nil {
+		loggerFromContext(c).Error("failed to add entry note", zap.Error(err))
+		if errors.Is(err, entity.ErrNotFound) {
+			newErrorResponse(c, http.StatusNotFound, "entry not found")
+			return
+		}
+		if errors.Is(err, entity.ErrJournalLocked) {
+			newErrorResponseFromError(c, http.StatusLocked, err)
+			return
+		}
+		newErrorResponse(c, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	respond(c, http.StatusCreated, mapper.ToEntryNoteResponse(note))
+}
+
+// List godoc
+// @Summary      List an entry's review notes
+// @Description  Get all review notes appended to a trade, oldest first
+// @Tags         Trading Journal Entries
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Param        id path string true "Trading Journal ID (UUID)"
+// @Param        entryId path string true "Trading Entry ID (UUID)"
+// @Success      200 {object} dto.EntryNoteListResponse "Successfully retrieved notes"
+// @Failure      400 {object} ErrorResponse "Invalid journal ID or entry ID"
+// @Failure      401 {object} ErrorResponse "Unauthorized - missing or invalid token"
+// @Failure      403 {object} ErrorResponse "Access denied - journal does not belong to user"
+// @Failure      404 {object} ErrorResponse "Entry not found"
+// @Failure      500 {object} ErrorResponse "Internal server error"
+// @Router       /api/v1/journals/{id}/entries/{entryId}/notes [get]
+func (h *EntryNoteHandler) List(c *gin.Context) {
+	journalID := uuidParam(c, "id")
+
+	entryID := uuidParam(c, "entryId")
+
+	notes, err := h.noteService.List(c.Request.Context(), entryID, journalID)
+	if err != nil {
+		loggerFromContext(c).Error("failed to list entry notes", zap.Error(err))
+		if errors.Is(err, entity.ErrNotFound) {
+			newErrorResponse(c, http.StatusNotFound, "entry not found")
+			return
+		}
+		newErrorResponse(c, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	response := &dto.EntryNoteListResponse{
+		Notes: mapper.ToEntryNoteResponses(notes),
+		Total: len(notes),
+	}
+
+	respond(c, http.StatusOK, response)
+}
@@ -0,0 +1,107 @@
+package v1
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/cockroachdb/errors"
+	"github.com/google/uuid"
+	"github.com/user/normark/internal/dto"
+	"github.com/user/normark/internal/entity"
+)
+
+// memoryNoteService keeps notes of the entries in entries, in order.
+type memoryNoteService struct {
+	EntryNoteService
+	entries map[uuid.UUID]bool
+	notes   []*entity.EntryNote
+}
+
+func (s *memoryNoteService) Add(_ context.Context, entryID uuid.UUID, _ uuid.UUID, body string) (*entity.EntryNote, error) {
+	if !s.entries[entryID] {
+		return nil, errors.Wrap(entity.ErrNotFound, "trading journal entry")
+	}
+	note := entity.NewEntryNote(entryID, body)
+	note.ID = uuid.New()
+	s.notes = append(s.notes, note)
+	return note, nil
+}
+
+func (s *memoryNoteService) List(_ context.Context, entryID uuid.UUID, _ uuid.UUID) ([]*entity.EntryNote, error) {
+	if !s.entries[entryID] {
+		return nil, errors.Wrap(entity.ErrNotFound, "trading journal entry")
+	}
+	return s.notes, nil
+}
+
+func TestEntryNotesHandler(t *testing.T) {
+	journalID := uuid.New()
+	entryID := uuid.New()
+	notes := &memoryNoteService{entries: map[uuid.UUID]bool{entryID: true}}
+	access := &fakeJournalAccess{owned: map[uuid.UUID]bool{journalID: true}}
+	router := newTestRouter(t, access, testServices{notes: notes})
+	path := "/api/v1/journals/" + journalID.String() + "/entries/" + entryID.String() + "/notes"
+
+	bodies := []string{"entry was early", "should have scaled out"}
+	for _, body := range bodies {
+		rec := doRequest(router, http.MethodPost, path, `{"body":"`+body+`"}`)
+		if rec.Code != http.StatusCreated {
+			t.Fatalf("append: status = %d, want %d; body %s", rec.Code, http.StatusCreated, rec.Body)
+		}
+	}
+
+	rec := doRequest(router, http.MethodGet, path, "")
+	if rec.Code != http.StatusOK {
+		t.Fatalf("list: status = %d, want %d; body %s", rec.Code, http.StatusOK, rec.Body)
+	}
+
+	var response dto.EntryNoteListResponse
+	if err := json.Unmarshal(rec.Body.Bytes(), &response); err != nil {
+		t.Fatalf("decode response: %v", err)
+	}
+	if response.Total != len(bodies) || len(response.Notes) != len(bodies) {
+		t.Fatalf("listed %d notes (total %d), want %d", len(response.Notes), response.Total, len(bodies))
+	}
+	for i, note := range response.Notes {
+		if note.Body != bodies[i] || note.EntryID != entryID {
+			t.Errorf("note %d = %q on %s, want %q on %s", i, note.Body, note.EntryID, bodies[i], entryID)
+		}
+	}
+}
+
+func TestEntryNotesHandlerErrors(t *testing.T) {
+	journalID := uuid.New()
+	entryID := uuid.New()
+	base := "/api/v1/journals/" + journalID.String() + "/entries/"
+
+	tests := []struct {
+		name       string
+		method     string
+		path       string
+		body       string
+		wantStatus int
+	}{
+		{"missing body", http.MethodPost, base + entryID.String() + "/notes", `{}`, http.StatusBadRequest},
+		{"unknown entry", http.MethodPost, base + uuid.NewString() + "/notes", `{"body":"note"}`, http.StatusNotFound},
+		{"list unknown entry", http.MethodGet, base + uuid.NewString() + "/notes", "", http.StatusNotFound},
+		{"invalid entry id", http.MethodGet, base + "not-a-uuid/notes", "", http.StatusBadRequest},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			notes := &memoryNoteService{entries: map[uuid.UUID]bool{entryID: true}}
+			access := &fakeJournalAccess{owned: map[uuid.UUID]bool{journalID: true}}
+			router := newTestRouter(t, access, testServices{notes: notes})
+
+			rec := doRequest(router, tt.method, tt.path, tt.body)
+			if rec.Code != tt.wantStatus {
+				t.Fatalf("status = %d, want %d; body %s", rec.Code, tt.wantStatus, rec.Body)
+			}
+			if len(notes.notes) != 0 {
+				t.Errorf("stored %d notes, want none", len(notes.notes))
+			}
+		})
+	}
+}
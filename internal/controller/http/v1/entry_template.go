@@ -0,0 +1,171 @@
+package v1
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/cockroachdb/errors"
+	"github.com/gin-gonic/gin"
+	"github.com/go-playground/validator/v10"
+	"github.com/google/uuid"
+	"github.com/user/normark/internal/dto"
+	"github.com/user/normark/internal/dto/mapper"
+	"github.com/user/normark/internal/entity"
+	"go.uber.org/zap"
+)
+
+type EntryTemplateService interface {
+	Create(ctx context.Context, journalID uuid.UUID, req *dto.CreateEntryTemplateRequest) (*entity.EntryTemplate, error)
+	GetJournalTemplates(ctx context.Context, journalID uuid.UUID, limit, offset int) ([]*entity.EntryTemplate, error)
+	CountJournalTemplates(ctx context.Context, journalID uuid.UUID) (int, error)
+	Delete(ctx context.Context, id uuid.UUID, journalID uuid.UUID) error
+}
+
+type EntryTemplateHandler struct {
+	templateService EntryTemplateService
+	validate        *validator.Validate
+	strictQuery     bool
+}
+
+func NewEntryTemplateHandler(
+	templateService EntryTemplateService,
+	validate *validator.Validate,
+	strictQuery bool,
+) *EntryTemplateHandler {
+	return &EntryTemplateHandler{
+		templateService: templateService,
+		validate:        validate,
+		strictQuery:     strictQuery,
+	}
+}
+
+// InitRoutes registers the template routes; group is a single journal's
+// templates, /journals/:id/entry-templates.
+func (h *EntryTemplateHandler) InitRoutes(group *gin.RouterGroup) {
+	group.POST("", h.Create)
+	group.GET("", ParsePagination(h.strictQuery), h.List)
+	group.DELETE("/:templateId", ParseUUIDParam("templateId"), h.Delete)
+}
+
+// Create godoc
+// @Summary      Create an entry template
+// @Description  Save the setup fields of a frequently traded setup (asset, session, trade type, direction, max RR) for the journal. Pass its ID as from_template when creating an entry to only send the outcome fields.
+// @Tags         Entry Templates
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Param        id path string true "Trading Journal ID (UUID)"
+// @Param        request body dto.CreateEntryTemplateRequest true "Entry template details"
+// @Success      201 {object} dto.EntryTemplateResponse "Successfully created entry template"
+// @Failure      400 {object} ErrorResponse "Invalid request body, validation failed, or invalid journal ID"
+// @Failure      401 {object} ErrorResponse "Unauthorized - missing or invalid token"
+// @Failure      403 {object} ErrorResponse "Access denied - journal does not belong to user"
+// @Failure      404 {object} ErrorResponse "Journal not found"
+// @Failure      500 {object} ErrorResponse "Internal server error"
+// @Router       /api/v1/journals/{id}/entry-templates [post]
+func (h *EntryTemplateHandler) Create(c *gin.Context) {
+	journalID := uuidParam(c, "id")
+
+	var req dto.CreateEntryTemplateRequest
+
+	if err := c.ShouldBindJSON(&req); err != nil {
+		loggerFromContext(c).Error("failed to bind request", zap.Error(err))
+		newErrorResponse(c, http.StatusBadRequest, "invalid request body")
+		return
+	}
+
+	if err := h.validate.Struct(&req); err != nil {
+		loggerFromContext(c).Error("validation failed", zap.Error(err))
+		newErrorResponseFromError(c, http.StatusBadRequest, err)
+		return
+	}
+
+	template, err := h.templateService.Create(c.Request.Context(), journalID, &req)
+	if err != nil {
+		loggerFromContext(c).Error("failed to create entry template", zap.Error(err))
+		if errors.Is(err, entity.ErrNotFound) {
+			newErrorResponse(c, http.StatusNotFound, "journal not found")
+			return
+		}
+		newErrorResponse(c, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	respond(c, http.StatusCreated, mapper.ToEntryTemplateResponse(template))
+}
+
+// List godoc
+// @Summary      List a journal's entry templates
+// @Description  Get a paginated list of the journal's entry templates, ordered by name
+// @Tags         Entry Templates
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Param        id path string true "Trading Journal ID (UUID)"
+// @Param        limit query int false "Maximum number of templates to return (default: 20, max: 100)"
+// @Param        offset query int false "Number of templates to skip (default: 0)"
+// @Success      200 {object} dto.EntryTemplateListResponse "Successfully retrieved templates list"
+// @Failure      400 {object} ErrorResponse "Invalid journal ID, or invalid limit or offset when strict query validation is enabled"
+// @Failure      401 {object} ErrorResponse "Unauthorized - missing or invalid token"
+// @Failure      403 {object} ErrorResponse "Access denied - journal does not belong to user"
+// @Failure      500 {object} ErrorResponse "Internal server error"
+// @Router       /api/v1/journals/{id}/entry-templates [get]
+func (h *EntryTemplateHandler) List(c *gin.Context) {
+	journalID := uuidParam(c, "id")
+
+	limit, offset := pagination(c)
+
+	templates, err := h.templateService.GetJournalTemplates(c.Request.Context(), journalID, limit, offset)
+	if err != nil {
+		loggerFromContext(c).Error("failed to get journal entry templates", zap.Error(err))
+		newErrorResponse(c, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	total, err := h.templateService.CountJournalTemplates(c.Request.Context(), journalID)
+	if err != nil {
+		loggerFromContext(c).Error("failed to count journal entry templates", zap.Error(err))
+		newErrorResponse(c, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	response := &dto.EntryTemplateListResponse{
+		Templates:  mapper.ToEntryTemplateResponses(templates),
+		Pagination: dto.NewPagination(total, limit, offset),
+	}
+
+	respond(c, http.StatusOK, response)
+}
+
+// Delete godoc
+// @Summary      Delete entry template
+// @Description  Delete an entry template. Entries already created from it are not affected.
+// @Tags         Entry Templates
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Param        id path string true "Trading Journal ID (UUID)"
+// @Param        templateId path string true "Entry Template ID (UUID)"
+// @Success      200 {object} map[string]string "Successfully deleted template"
+// @Failure      400 {object} ErrorResponse "Invalid journal or template ID"
+// @Failure      401 {object} ErrorResponse "Unauthorized - missing or invalid token"
+// @Failure      403 {object} ErrorResponse "Access denied - journal does not belong to user"
+// @Failure      404 {object} ErrorResponse "Entry template not found"
+// @Failure      500 {object} ErrorResponse "Internal server error"
+// @Router       /api/v1/journals/{id}/entry-templates/{templateId} [delete]
+func (h *EntryTemplateHandler) Delete(c *gin.Context) {
+	journalID := uuidParam(c, "id")
+	templateID := uuidParam(c, "templateId")
+
+	if err := h.templateService.Delete(c.Request.Context(), templateID, journalID); err != nil {
+		loggerFromContext(c).Error("failed to delete entry template", zap.Error(err))
+		if errors.Is(err, entity.ErrNotFound) {
+			newErrorResponse(c, http.StatusNotFound, "entry template not found")
+			return
+		}
+		newErrorResponse(c, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	respond(c, http.StatusOK, gin.H{"message": "template deleted successfully"})
+}
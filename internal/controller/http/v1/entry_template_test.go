@@ -0,0 +1,175 @@
+package v1
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/cockroachdb/errors"
+	"github.com/google/uuid"
+	"github.com/user/normark/internal/dto"
+	"github.com/user/normark/internal/entity"
+	"github.com/user/normark/internal/types"
+)
+
+// fakeTemplateService builds templates from requests and fails with err
+// when set.
+type fakeTemplateService struct {
+	EntryTemplateService
+	err       error
+	templates []*entity.EntryTemplate
+	deleted   uuid.UUID
+}
+
+func (s *fakeTemplateService) Create(_ context.Context, journalID uuid.UUID, req *dto.CreateEntryTemplateRequest) (*entity.EntryTemplate, error) {
+	if s.err != nil {
+		return nil, s.err
+	}
+	template := entity.NewEntryTemplate(journalID, req.Name)
+	template.ID = uuid.New()
+	template.Asset = req.Asset
+	template.Direction = req.Direction
+	template.MaxRR = req.MaxRR
+	return template, nil
+}
+
+func (s *fakeTemplateService) GetJournalTemplates(_ context.Context, _ uuid.UUID, limit, offset int) ([]*entity.EntryTemplate, error) {
+	if s.err != nil {
+		return nil, s.err
+	}
+	return s.templates[offset:min(offset+limit, len(s.templates))], nil
+}
+
+func (s *fakeTemplateService) CountJournalTemplates(context.Context, uuid.UUID) (int, error) {
+	return len(s.templates), nil
+}
+
+func (s *fakeTemplateService) Delete(_ context.Context, id uuid.UUID, _ uuid.UUID) error {
+	if s.err != nil {
+		return s.err
+	}
+	s.deleted = id
+	return nil
+}
+
+func TestCreateEntryTemplateHandler(t *testing.T) {
+	journalID := uuid.New()
+	path := "/api/v1/journals/" + journalID.String() + "/entry-templates"
+
+	tests := []struct {
+		name       string
+		body       string
+		err        error
+		wantStatus int
+		wantCode   string
+	}{
+		{"created", `{"name":"London breakout","asset":"EURUSD","direction":"buy","max_rr":3}`, nil, http.StatusCreated, ""},
+		{"missing name", `{"asset":"EURUSD"}`, nil, http.StatusBadRequest, CodeValidationFailed},
+		{"non-positive max rr", `{"name":"London breakout","max_rr":0}`, nil, http.StatusBadRequest, CodeValidationFailed},
+		{"malformed body", `{"name":`, nil, http.StatusBadRequest, CodeBadRequest},
+		{"missing journal", `{"name":"London breakout"}`, errors.Wrap(entity.ErrNotFound, "failed to verify journal existence"), http.StatusNotFound, CodeNotFound},
+		{"storage failure", `{"name":"London breakout"}`, errors.New("connection refused"), http.StatusInternalServerError, CodeInternal},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			templates := &fakeTemplateService{err: tt.err}
+			router := newTestRouter(t, &fakeJournalAccess{owned: map[uuid.UUID]bool{journalID: true}}, testServices{templates: templates})
+
+			rec := doRequest(router, http.MethodPost, path, tt.body)
+			if rec.Code != tt.wantStatus {
+				t.Fatalf("status = %d, want %d; body %s", rec.Code, tt.wantStatus, rec.Body)
+			}
+			if tt.wantCode != "" {
+				if code := decodeErrorCode(t, rec); code != tt.wantCode {
+					t.Errorf("code = %q, want %q", code, tt.wantCode)
+				}
+				return
+			}
+
+			var got dto.EntryTemplateResponse
+			if err := json.Unmarshal(rec.Body.Bytes(), &got); err != nil {
+				t.Fatalf("decode body: %v", err)
+			}
+			if got.JournalID != journalID || got.Name != "London breakout" || got.Asset == nil || *got.Asset != types.CurrencyPairEURUSD ||
+				got.Direction == nil || *got.Direction != types.TradeDirectionBuy || got.MaxRR == nil || *got.MaxRR != 3 {
+				t.Errorf("response = %+v, want the template of the body", got)
+			}
+			if got.Session != nil || got.TradeType != nil {
+				t.Errorf("response = %+v, want no session or trade type", got)
+			}
+		})
+	}
+}
+
+func TestListEntryTemplatesHandler(t *testing.T) {
+	journalID := uuid.New()
+	templates := &fakeTemplateService{}
+	for _, name := range []string{"Asia range", "London breakout", "NY reversal"} {
+		template := entity.NewEntryTemplate(journalID, name)
+		template.ID = uuid.New()
+		templates.templates = append(templates.templates, template)
+	}
+	router := newTestRouter(t, &fakeJournalAccess{owned: map[uuid.UUID]bool{journalID: true}}, testServices{templates: templates})
+
+	rec := doRequest(router, http.MethodGet, "/api/v1/journals/"+journalID.String()+"/entry-templates?limit=2&offset=1", "")
+	if rec.Code != http.StatusOK {
+		t.Fatalf("status = %d, want %d; body %s", rec.Code, http.StatusOK, rec.Body)
+	}
+
+	var got dto.EntryTemplateListResponse
+	if err := json.Unmarshal(rec.Body.Bytes(), &got); err != nil {
+		t.Fatalf("decode body: %v", err)
+	}
+	if len(got.Templates) != 2 || got.Templates[0].Name != "London breakout" || got.Templates[1].Name != "NY reversal" {
+		t.Errorf("templates = %+v, want the second page of names", got.Templates)
+	}
+	if got.Total != 3 || got.Limit != 2 || got.Offset != 1 {
+		t.Errorf("pagination = %+v, want total 3, limit 2, offset 1", got.Pagination)
+	}
+}
+
+func TestDeleteEntryTemplateHandler(t *testing.T) {
+	journalID := uuid.New()
+	templateID := uuid.New()
+	path := "/api/v1/journals/" + journalID.String() + "/entry-templates/" + templateID.String()
+
+	tests := []struct {
+		name       string
+		err        error
+		wantStatus int
+	}{
+		{"deleted", nil, http.StatusOK},
+		{"template of another journal", entity.ErrEntryTemplateNotFound, http.StatusNotFound},
+		{"storage failure", errors.New("connection refused"), http.StatusInternalServerError},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			templates := &fakeTemplateService{err: tt.err}
+			router := newTestRouter(t, &fakeJournalAccess{owned: map[uuid.UUID]bool{journalID: true}}, testServices{templates: templates})
+
+			rec := doRequest(router, http.MethodDelete, path, "")
+			if rec.Code != tt.wantStatus {
+				t.Fatalf("status = %d, want %d; body %s", rec.Code, tt.wantStatus, rec.Body)
+			}
+			if tt.err == nil && templates.deleted != templateID {
+				t.Errorf("deleted %s, want %s", templates.deleted, templateID)
+			}
+		})
+	}
+
+	t.Run("invalid template id", func(t *testing.T) {
+		templates := &fakeTemplateService{}
+		router := newTestRouter(t, &fakeJournalAccess{owned: map[uuid.UUID]bool{journalID: true}}, testServices{templates: templates})
+
+		rec := doRequest(router, http.MethodDelete, "/api/v1/journals/"+journalID.String()+"/entry-templates/not-a-uuid", "")
+		if rec.Code != http.StatusBadRequest {
+			t.Fatalf("status = %d, want %d; body %s", rec.Code, http.StatusBadRequest, rec.Body)
+		}
+		if templates.deleted != uuid.Nil {
+			t.Error("service reached with an invalid template id")
+		}
+	})
+}
@@ -0,0 +1,115 @@
+package v1
+
+import (
+	"net/http"
+
+	"github.com/cockroachdb/errors"
+	"github.com/gin-gonic/gin"
+	"github.com/go-playground/validator/v10"
+	"github.com/user/normark/internal/entity"
+)
+
+// Error codes are part of the API contract: clients branch on them instead
+// of matching messages, so existing values must not change.
+const (
+	CodeBadRequest               = "bad_request"
+	CodeValidationFailed         = "validation_failed"
+	CodeUnauthorized             = "unauthorized"
+	CodeInvalidCredentials       = "invalid_credentials"
+	CodeInvalidRefreshToken      = "invalid_refresh_token"
+	CodeAccessDenied             = "access_denied"
+	CodeNotFound                 = "not_found"
+	CodeConflict                 = "conflict"
+	CodeJournalLocked            = "journal_locked"
+	CodeInvalidSyncCursor        = "invalid_sync_cursor"
+	CodeUnsupportedExportVersion = "unsupported_export_version"
+	CodeRateLimited              = "rate_limited"
+	CodeUnsupportedMediaType     = "unsupported_media_type"
+	CodeInternal                 = "internal_error"
+	CodeServiceUnavailable       = "service_unavailable"
+)
+
+type ErrorResponse struct {
+	Code  string `json:"code" example:"not_found"`
+	Error string `json:"error"`
+}
+
+// sentinelCodes maps domain errors to their codes. The first match wins, so
+// more specific errors come before the classes they are marked with.
+var sentinelCodes = []struct {
+	err  error
+	code string
+}{
+	{entity.ErrInvalidCredentials, CodeInvalidCredentials},
+	{entity.ErrInvalidGoogleToken, CodeInvalidCredentials},
+	{entity.ErrInvalidRefreshToken, CodeInvalidRefreshToken},
+	{entity.ErrInvalidSyncCursor, CodeInvalidSyncCursor},
+	{entity.ErrUnsupportedExportVersion, CodeUnsupportedExportVersion},
+	{entity.ErrInvalidReviewStatus, CodeValidationFailed},
+	{entity.ErrEntryPolicy, CodeValidationFailed},
+	{entity.ErrSameJournal, CodeValidationFailed},
+	{entity.ErrSelfLink, CodeValidationFailed},
+	{entity.ErrTooManyLinks, CodeValidationFailed},
+	{entity.ErrEmptyBulkUpdate, CodeValidationFailed},
+	{entity.ErrBulkUpdateTooLarge, CodeValidationFailed},
+	{entity.ErrInvalidBulkUpdate, CodeValidationFailed},
+	{entity.ErrIncompleteTemplatedEntry, CodeValidationFailed},
+	{entity.ErrJournalLocked, CodeJournalLocked},
+	{entity.ErrNotFound, CodeNotFound},
+	{entity.ErrConflict, CodeConflict},
+}
+
+// newErrorResponse aborts with the code implied by the status.
+func newErrorResponse(c *gin.Context, statusCode int, message string) {
+	newErrorResponseWithCode(c, statusCode, statusErrorCode(statusCode), message)
+}
+
+func newErrorResponseWithCode(c *gin.Context, statusCode int, code string, message string) {
+	c.AbortWithStatusJSON(statusCode, ErrorResponse{Code: code, Error: message})
+}
+
+// newErrorResponseFromError aborts with err's message and the code of the
+// sentinel or validation error it wraps, falling back to the status code.
+func newErrorResponseFromError(c *gin.Context, statusCode int, err error) {
+	newErrorResponseWithCode(c, statusCode, errorCode(err, statusCode), err.Error())
+}
+
+func errorCode(err error, statusCode int) string {
+	var validationErrs validator.ValidationErrors
+	if errors.As(err, &validationErrs) {
+		return CodeValidationFailed
+	}
+
+	for _, sentinel := range sentinelCodes {
+		if errors.Is(err, sentinel.err) {
+			return sentinel.code
+		}
+	}
+
+	return statusErrorCode(statusCode)
+}
+
+func statusErrorCode(statusCode int) string {
+	switch statusCode {
+	case http.StatusBadRequest:
+		return CodeBadRequest
+	case http.StatusUnauthorized:
+		return CodeUnauthorized
+	case http.StatusForbidden:
+		return CodeAccessDenied
+	case http.StatusNotFound:
+		return CodeNotFound
+	case http.StatusConflict:
+		return CodeConflict
+	case http.StatusLocked:
+		return CodeJournalLocked
+	case http.StatusUnsupportedMediaType:
+		return CodeUnsupportedMediaType
+	case http.StatusTooManyRequests:
+		return CodeRateLimited
+	case http.StatusServiceUnavailable:
+		return CodeServiceUnavailable
+	default:
+		return CodeInternal
+	}
+}
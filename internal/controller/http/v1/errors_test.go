@@ -0,0 +1,184 @@
+package v1
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/cockroachdb/errors"
+	"github.com/gin-gonic/gin"
+	"github.com/go-playground/validator/v10"
+	"github.com/google/uuid"
+	"github.com/user/normark/internal/config"
+	"github.com/user/normark/internal/entity"
+	"go.uber.org/zap"
+)
+
+func TestErrorCode(t *testing.T) {
+	validationErr := validator.New().Var("", "required")
+
+	tests := []struct {
+		name       string
+		err        error
+		statusCode int
+		want       string
+	}{
+		{"validation errors", validationErr, http.StatusBadRequest, CodeValidationFailed},
+		{"wrapped validation errors", errors.Wrap(validationErr, "create entry"), http.StatusBadRequest, CodeValidationFailed},
+		{"invalid credentials", entity.ErrInvalidCredentials, http.StatusUnauthorized, CodeInvalidCredentials},
+		{"invalid refresh token", entity.ErrInvalidRefreshToken, http.StatusUnauthorized, CodeInvalidRefreshToken},
+		{"invalid sync cursor", entity.ErrInvalidSyncCursor, http.StatusBadRequest, CodeInvalidSyncCursor},
+		{"domain validation", entity.ErrInvalidReviewStatus, http.StatusBadRequest, CodeValidationFailed},
+		{"journal locked", entity.ErrJournalLocked, http.StatusLocked, CodeJournalLocked},
+		{"wrapped not found", errors.Wrap(entity.ErrNotFound, "trading journal"), http.StatusNotFound, CodeNotFound},
+		{"marked as conflict", entity.ErrUserAlreadyExists, http.StatusConflict, CodeConflict},
+		{"unknown error", errors.New("boom"), http.StatusInternalServerError, CodeInternal},
+		{"unknown error with status", errors.New("bad input"), http.StatusBadRequest, CodeBadRequest},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := errorCode(tt.err, tt.statusCode); got != tt.want {
+				t.Errorf("errorCode() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestStatusErrorCode(t *testing.T) {
+	tests := []struct {
+		statusCode int
+		want       string
+	}{
+		{http.StatusBadRequest, CodeBadRequest},
+		{http.StatusUnauthorized, CodeUnauthorized},
+		{http.StatusForbidden, CodeAccessDenied},
+		{http.StatusNotFound, CodeNotFound},
+		{http.StatusConflict, CodeConflict},
+		{http.StatusLocked, CodeJournalLocked},
+		{http.StatusUnsupportedMediaType, CodeUnsupportedMediaType},
+		{http.StatusTooManyRequests, CodeRateLimited},
+		{http.StatusServiceUnavailable, CodeServiceUnavailable},
+		{http.StatusInternalServerError, CodeInternal},
+		{http.StatusBadGateway, CodeInternal},
+	}
+
+	for _, tt := range tests {
+		t.Run(http.StatusText(tt.statusCode), func(t *testing.T) {
+			if got := statusErrorCode(tt.statusCode); got != tt.want {
+				t.Errorf("statusErrorCode(%d) = %q, want %q", tt.statusCode, got, tt.want)
+			}
+		})
+	}
+}
+
+func decodeErrorCode(t *testing.T, rec *httptest.ResponseRecorder) string {
+	t.Helper()
+
+	var response ErrorResponse
+	if err := json.Unmarshal(rec.Body.Bytes(), &response); err != nil {
+		t.Fatalf("decode error response %s: %v", rec.Body, err)
+	}
+	if response.Error == "" {
+		t.Errorf("error response %s has no message", rec.Body)
+	}
+	return response.Code
+}
+
+func TestErrorPathsReturnCodes(t *testing.T) {
+	journalID := uuid.New()
+	signUp := `{"email":"trader@example.com","username":"trader","password":"password123"}`
+
+	tests := []struct {
+		name        string
+		method      string
+		path        string
+		body        string
+		token       bool
+		contentType string
+		users       UserService
+		wantStatus  int
+		wantCode    string
+	}{
+		{
+			name: "missing token", method: http.MethodGet, path: "/api/v1/users/me",
+			wantStatus: http.StatusUnauthorized, wantCode: CodeUnauthorized,
+		},
+		{
+			name: "foreign journal", method: http.MethodGet, path: "/api/v1/journals/" + uuid.NewString() + "/entries", token: true,
+			wantStatus: http.StatusForbidden, wantCode: CodeAccessDenied,
+		},
+		{
+			name: "malformed path uuid", method: http.MethodGet, path: "/api/v1/journals/" + journalID.String() + "/entries/not-a-uuid", token: true,
+			wantStatus: http.StatusBadRequest, wantCode: CodeBadRequest,
+		},
+		{
+			name: "body is not json", method: http.MethodPost, path: "/api/v1/auth/sign-up", body: signUp, contentType: "text/plain",
+			wantStatus: http.StatusUnsupportedMediaType, wantCode: CodeUnsupportedMediaType,
+		},
+		{
+			name: "invalid sign-up", method: http.MethodPost, path: "/api/v1/auth/sign-up", body: `{"email":"trader@example.com","username":"t","password":"password123"}`,
+			wantStatus: http.StatusBadRequest, wantCode: CodeValidationFailed,
+		},
+		{
+			name: "duplicate sign-up", method: http.MethodPost, path: "/api/v1/auth/sign-up", body: signUp,
+			users:      &signUpUserService{err: entity.ErrUserAlreadyExists},
+			wantStatus: http.StatusConflict, wantCode: CodeConflict,
+		},
+		{
+			name: "sign-up failure", method: http.MethodPost, path: "/api/v1/auth/sign-up", body: signUp,
+			users:      &signUpUserService{err: errors.New("connection reset")},
+			wantStatus: http.StatusInternalServerError, wantCode: CodeInternal,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			access := &fakeJournalAccess{owned: map[uuid.UUID]bool{journalID: true}}
+			router := newTestRouter(t, access, testServices{users: tt.users})
+
+			req := httptest.NewRequest(tt.method, tt.path, strings.NewReader(tt.body))
+			if tt.token {
+				req.Header.Set("Authorization", "Bearer token")
+			}
+			contentType := tt.contentType
+			if contentType == "" {
+				contentType = "application/json"
+			}
+			req.Header.Set("Content-Type", contentType)
+			rec := httptest.NewRecorder()
+			router.ServeHTTP(rec, req)
+
+			if rec.Code != tt.wantStatus {
+				t.Fatalf("status = %d, want %d; body %s", rec.Code, tt.wantStatus, rec.Body)
+			}
+			if code := decodeErrorCode(t, rec); code != tt.wantCode {
+				t.Errorf("code = %q, want %q", code, tt.wantCode)
+			}
+		})
+	}
+}
+
+func TestRateLimitedCode(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	limiter := NewRateLimiter(&config.RateLimit{RequestsPerSecond: 1, Burst: 1}, zap.NewNop())
+	router := gin.New()
+	router.Use(limiter.Limit())
+	router.GET("/ping", func(c *gin.Context) { c.Status(http.StatusOK) })
+
+	var rec *httptest.ResponseRecorder
+	for range 2 {
+		rec = httptest.NewRecorder()
+		router.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/ping", nil))
+	}
+
+	if rec.Code != http.StatusTooManyRequests {
+		t.Fatalf("status = %d, want %d", rec.Code, http.StatusTooManyRequests)
+	}
+	if code := decodeErrorCode(t, rec); code != CodeRateLimited {
+		t.Errorf("code = %q, want %q", code, CodeRateLimited)
+	}
+}
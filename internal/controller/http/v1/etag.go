@@ -0,0 +1,52 @@
+package v1
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/user/normark/internal/entity"
+)
+
+// journalETag identifies a version of the journal. UpdatedAt changes on
+// every journal write; the entry count covers entries being added or removed,
+// and the entry span covers an entry being moved to another day, which
+// changes neither.
+func journalETag(journal *entity.TradingJournal, entryCount int) string {
+	sum := sha256.Sum256([]byte(fmt.Sprintf(
+		"%s:%d:%d:%d:%d",
+		journal.ID,
+		journal.UpdatedAt.UnixNano(),
+		entryCount,
+		unixNanoOrZero(journal.FirstEntryDate),
+		unixNanoOrZero(journal.LastEntryDate),
+	)))
+
+	return `W/"` + hex.EncodeToString(sum[:16]) + `"`
+}
+
+func unixNanoOrZero(t *time.Time) int64 {
+	if t == nil {
+		return 0
+	}
+	return t.UnixNano()
+}
+
+// etagMatches reports whether an If-None-Match header matches the ETag,
+// using the weak comparison RFC 9110 requires for If-None-Match.
+func etagMatches(ifNoneMatch, etag string) bool {
+	if ifNoneMatch == "" {
+		return false
+	}
+
+	for _, candidate := range strings.Split(ifNoneMatch, ",") {
+		candidate = strings.TrimSpace(candidate)
+		if candidate == "*" || strings.TrimPrefix(candidate, "W/") == strings.TrimPrefix(etag, "W/") {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,143 @@
+package v1
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/user/normark/internal/entity"
+)
+
+func TestJournalETag(t *testing.T) {
+	journal := entity.NewTradingJournal(uuid.New(), "Swing", "")
+	journal.ID = uuid.New()
+	journal.UpdatedAt = time.Date(2026, 3, 2, 9, 0, 0, 0, time.UTC)
+
+	etag := journalETag(journal, 10)
+	if !strings.HasPrefix(etag, `W/"`) || !strings.HasSuffix(etag, `"`) {
+		t.Fatalf("etag = %s, want a weak ETag", etag)
+	}
+	if again := journalETag(journal, 10); again != etag {
+		t.Errorf("etag of an unchanged journal = %s, want %s", again, etag)
+	}
+
+	updated := *journal
+	updated.UpdatedAt = journal.UpdatedAt.Add(time.Millisecond)
+	other := *journal
+	other.ID = uuid.New()
+	first := time.Date(2026, 2, 2, 0, 0, 0, 0, time.UTC)
+	last := time.Date(2026, 2, 27, 0, 0, 0, 0, time.UTC)
+	spanned := *journal
+	spanned.FirstEntryDate, spanned.LastEntryDate = &first, &last
+	earlier := first.AddDate(0, 0, -1)
+	movedFirst := spanned
+	movedFirst.FirstEntryDate = &earlier
+	later := last.AddDate(0, 0, 1)
+	movedLast := spanned
+	movedLast.LastEntryDate = &later
+
+	spannedETag := journalETag(&spanned, 10)
+	for name, changed := range map[string]string{
+		"journal updated": journalETag(&updated, 10),
+		"entry added":     journalETag(journal, 11),
+		"other journal":   journalETag(&other, 10),
+		"entry span set":  spannedETag,
+	} {
+		if changed == etag {
+			t.Errorf("%s: etag unchanged", name)
+		}
+	}
+
+	for name, changed := range map[string]string{
+		"first entry moved": journalETag(&movedFirst, 10),
+		"last entry moved":  journalETag(&movedLast, 10),
+	} {
+		if changed == spannedETag {
+			t.Errorf("%s: etag unchanged", name)
+		}
+	}
+}
+
+func TestETagMatches(t *testing.T) {
+	const etag = `W/"abc"`
+
+	tests := []struct {
+		ifNoneMatch string
+		want        bool
+	}{
+		{"", false},
+		{`W/"abc"`, true},
+		{`"abc"`, true},
+		{`W/"abd"`, false},
+		{`"xyz", W/"abc"`, true},
+		{`"xyz","uvw"`, false},
+		{"*", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.ifNoneMatch, func(t *testing.T) {
+			if got := etagMatches(tt.ifNoneMatch, etag); got != tt.want {
+				t.Errorf("etagMatches(%q) = %v, want %v", tt.ifNoneMatch, got, tt.want)
+			}
+		})
+	}
+}
+
+// etagJournalService serves one journal with a settable entry count.
+type etagJournalService struct {
+	TradingJournalService
+	journal *entity.TradingJournal
+	entries int
+}
+
+func (s *etagJournalService) GetByID(context.Context, uuid.UUID) (*entity.TradingJournal, error) {
+	return s.journal, nil
+}
+
+func (s *etagJournalService) CountEntries(context.Context, uuid.UUID) (int, error) {
+	return s.entries, nil
+}
+
+func TestGetJournalConditional(t *testing.T) {
+	journal := entity.NewTradingJournal(testUserID, "Swing", "")
+	journal.ID = uuid.New()
+	journal.UpdatedAt = time.Date(2026, 3, 2, 9, 0, 0, 0, time.UTC)
+	journals := &etagJournalService{journal: journal, entries: 3}
+	router := newTestRouter(t, &fakeJournalAccess{}, testServices{journals: journals})
+	path := "/api/v1/journals/" + journal.ID.String()
+
+	get := func(ifNoneMatch string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(http.MethodGet, path, nil)
+		req.Header.Set("Authorization", "Bearer token")
+		if ifNoneMatch != "" {
+			req.Header.Set("If-None-Match", ifNoneMatch)
+		}
+		rec := httptest.NewRecorder()
+		router.ServeHTTP(rec, req)
+		return rec
+	}
+
+	first := get("")
+	etag := first.Header().Get("ETag")
+	if first.Code != http.StatusOK || etag == "" {
+		t.Fatalf("status = %d, ETag = %q; want 200 with an ETag", first.Code, etag)
+	}
+
+	notModified := get(etag)
+	if notModified.Code != http.StatusNotModified || notModified.Body.Len() != 0 {
+		t.Errorf("unchanged journal: status = %d with %d body bytes, want 304 without a body", notModified.Code, notModified.Body.Len())
+	}
+	if notModified.Header().Get("ETag") != etag {
+		t.Errorf("304 ETag = %q, want %q", notModified.Header().Get("ETag"), etag)
+	}
+
+	journals.entries++
+	changed := get(etag)
+	if changed.Code != http.StatusOK || changed.Header().Get("ETag") == etag {
+		t.Errorf("after an entry was added: status = %d, ETag = %q, want 200 with a new ETag", changed.Code, changed.Header().Get("ETag"))
+	}
+}
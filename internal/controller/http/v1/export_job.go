@@ -0,0 +1,180 @@
+package v1
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/cockroachdb/errors"
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/user/normark/internal/dto/mapper"
+	"github.com/user/normark/internal/entity"
+	"go.uber.org/zap"
+)
+
+type ExportJobService interface {
+	Start(ctx context.Context, journalID uuid.UUID, userID uuid.UUID) (*entity.ExportJob, error)
+	Get(ctx context.Context, jobID uuid.UUID, userID uuid.UUID) (*entity.ExportJob, error)
+	GetResult(ctx context.Context, jobID uuid.UUID, userID uuid.UUID) (*entity.ExportJob, []byte, error)
+}
+
+type ExportJobHandler struct {
+	exportJobService ExportJobService
+}
+
+func NewExportJobHandler(
+	exportJobService ExportJobService,
+) *ExportJobHandler {
+	return &ExportJobHandler{
+		exportJobService: exportJobService,
+	}
+}
+
+// InitJournalRoutes registers the route that starts an export; group is a
+// single journal, /journals/:id.
+func (h *ExportJobHandler) InitJournalRoutes(group *gin.RouterGroup) {
+	group.POST("/export", h.Start)
+}
+
+func (h *ExportJobHandler) InitRoutes(group *gin.RouterGroup) {
+	job := group.Group("/:jobId", ParseUUIDParam("jobId"))
+	job.GET("", h.Get)
+	job.GET("/download", h.Download)
+}
+
+// Start godoc
+// @Summary      Start an asynchronous journal export
+// @Description  Queue an export of the journal and its entries, for journals too large to export within a single request. Poll GET /api/v1/exports/{jobId} until the status is ready, then download the document from download_url. Jobs and results expire after an hour. When too many exports are already queued the job is refused with 503; retry later.
+// @Tags         Trading Journals
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Param        id path string true "Trading Journal ID (UUID)"
+// @Success      202 {object} dto.ExportJobResponse "Export job queued"
+// @Failure      400 {object} ErrorResponse "Invalid journal ID"
+// @Failure      401 {object} ErrorResponse "Unauthorized - missing or invalid token"
+// @Failure      404 {object} ErrorResponse "Journal not found"
+// @Failure      500 {object} ErrorResponse "Internal server error"
+// @Failure      503 {object} ErrorResponse "Asynchronous export is unavailable or too many exports are queued"
+// @Router       /api/v1/journals/{id}/export [post]
+func (h *ExportJobHandler) Start(c *gin.Context) {
+	journalID := uuidParam(c, "id")
+
+	uid, ok := h.userID(c)
+	if !ok {
+		return
+	}
+
+	job, err := h.exportJobService.Start(c.Request.Context(), journalID, uid)
+	if err != nil {
+		loggerFromContext(c).Error("failed to start export job", zap.Error(err))
+		h.handleError(c, err, "journal not found")
+		return
+	}
+
+	respond(c, http.StatusAccepted, mapper.ToExportJobResponse(job, downloadURL(job)))
+}
+
+// Get godoc
+// @Summary      Get an export job
+// @Description  Get the status of an asynchronous export. download_url is set once the status is ready.
+// @Tags         Trading Journals
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Param        jobId path string true "Export Job ID (UUID)"
+// @Success      200 {object} dto.ExportJobResponse "Successfully retrieved export job"
+// @Failure      400 {object} ErrorResponse "Invalid job ID"
+// @Failure      401 {object} ErrorResponse "Unauthorized - missing or invalid token"
+// @Failure      404 {object} ErrorResponse "Export job not found or expired"
+// @Failure      500 {object} ErrorResponse "Internal server error"
+// @Failure      503 {object} ErrorResponse "Asynchronous export is unavailable"
+// @Router       /api/v1/exports/{jobId} [get]
+func (h *ExportJobHandler) Get(c *gin.Context) {
+	jobID := uuidParam(c, "jobId")
+
+	uid, ok := h.userID(c)
+	if !ok {
+		return
+	}
+
+	job, err := h.exportJobService.Get(c.Request.Context(), jobID, uid)
+	if err != nil {
+		loggerFromContext(c).Error("failed to get export job", zap.Error(err))
+		h.handleError(c, err, "export job not found")
+		return
+	}
+
+	respond(c, http.StatusOK, mapper.ToExportJobResponse(job, downloadURL(job)))
+}
+
+// Download godoc
+// @Summary      Download an export
+// @Description  Download the export document of a ready job. It has the same format as GET /api/v1/journals/{id}/export.
+// @Tags         Trading Journals
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Param        jobId path string true "Export Job ID (UUID)"
+// @Success      200 {object} dto.JournalExportDocument "Journal export document"
+// @Failure      400 {object} ErrorResponse "Invalid job ID"
+// @Failure      401 {object} ErrorResponse "Unauthorized - missing or invalid token"
+// @Failure      404 {object} ErrorResponse "Export job not found or expired"
+// @Failure      409 {object} ErrorResponse "Export job is not ready"
+// @Failure      500 {object} ErrorResponse "Internal server error"
+// @Failure      503 {object} ErrorResponse "Asynchronous export is unavailable"
+// @Router       /api/v1/exports/{jobId}/download [get]
+func (h *ExportJobHandler) Download(c *gin.Context) {
+	jobID := uuidParam(c, "jobId")
+
+	uid, ok := h.userID(c)
+	if !ok {
+		return
+	}
+
+	job, result, err := h.exportJobService.GetResult(c.Request.Context(), jobID, uid)
+	if err != nil {
+		loggerFromContext(c).Error("failed to get export result", zap.Error(err))
+		h.handleError(c, err, "export job not found")
+		return
+	}
+
+	c.Header("Content-Disposition", fmt.Sprintf("attachment; filename=\"journal-%s.json\"", job.JournalID))
+	c.Data(http.StatusOK, "application/json; charset=utf-8", result)
+}
+
+func (h *ExportJobHandler) userID(c *gin.Context) (uuid.UUID, bool) {
+	userID, exists := c.Get("userID")
+	if !exists {
+		loggerFromContext(c).Error("user id not found in context")
+		newErrorResponse(c, http.StatusUnauthorized, "unauthorized")
+		return uuid.Nil, false
+	}
+
+	uid, ok := userID.(uuid.UUID)
+	if !ok {
+		loggerFromContext(c).Error("invalid user id type in context")
+		newErrorResponse(c, http.StatusInternalServerError, "internal server error")
+		return uuid.Nil, false
+	}
+
+	return uid, true
+}
+
+func (h *ExportJobHandler) handleError(c *gin.Context, err error, notFoundMessage string) {
+	switch {
+	case errors.Is(err, entity.ErrAsyncExportDisabled), errors.Is(err, entity.ErrExportQueueFull):
+		newErrorResponseFromError(c, http.StatusServiceUnavailable, err)
+	case errors.Is(err, entity.ErrNotFound):
+		newErrorResponse(c, http.StatusNotFound, notFoundMessage)
+	case errors.Is(err, entity.ErrConflict):
+		newErrorResponseFromError(c, http.StatusConflict, err)
+	default:
+		newErrorResponse(c, http.StatusInternalServerError, err.Error())
+	}
+}
+
+func downloadURL(job *entity.ExportJob) string {
+	return fmt.Sprintf("/api/v1/exports/%s/download", job.ID)
+}
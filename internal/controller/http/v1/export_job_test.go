@@ -0,0 +1,131 @@
+package v1
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/cockroachdb/errors"
+	"github.com/google/uuid"
+	"github.com/user/normark/internal/dto"
+	"github.com/user/normark/internal/entity"
+)
+
+// memoryExportJobService keeps jobs in jobs. Tests finish a job by setting
+// its status. Start and Get fail with err when set.
+type memoryExportJobService struct {
+	jobs map[uuid.UUID]*entity.ExportJob
+	err  error
+}
+
+func (s *memoryExportJobService) Start(_ context.Context, journalID uuid.UUID, userID uuid.UUID) (*entity.ExportJob, error) {
+	if s.err != nil {
+		return nil, s.err
+	}
+	job := entity.NewExportJob(userID, journalID)
+	s.jobs[job.ID] = job
+	return job, nil
+}
+
+func (s *memoryExportJobService) Get(_ context.Context, jobID uuid.UUID, userID uuid.UUID) (*entity.ExportJob, error) {
+	if s.err != nil {
+		return nil, s.err
+	}
+	job, ok := s.jobs[jobID]
+	if !ok || job.UserID != userID {
+		return nil, entity.ErrExportJobNotFound
+	}
+	return job, nil
+}
+
+func (s *memoryExportJobService) GetResult(ctx context.Context, jobID uuid.UUID, userID uuid.UUID) (*entity.ExportJob, []byte, error) {
+	job, err := s.Get(ctx, jobID, userID)
+	if err != nil {
+		return nil, nil, err
+	}
+	if job.Status != entity.ExportJobStatusReady {
+		return nil, nil, entity.ErrExportJobNotReady
+	}
+	return job, []byte(`{"version":1}`), nil
+}
+
+func TestExportJobHandlers(t *testing.T) {
+	journalID := uuid.New()
+	exportJobs := &memoryExportJobService{jobs: map[uuid.UUID]*entity.ExportJob{}}
+	router := newTestRouter(t, &fakeJournalAccess{}, testServices{exportJobs: exportJobs})
+
+	rec := doRequest(router, http.MethodPost, "/api/v1/journals/"+journalID.String()+"/export", "")
+	if rec.Code != http.StatusAccepted {
+		t.Fatalf("start: status = %d, want %d; body %s", rec.Code, http.StatusAccepted, rec.Body)
+	}
+	var started dto.ExportJobResponse
+	if err := json.Unmarshal(rec.Body.Bytes(), &started); err != nil {
+		t.Fatalf("decode response: %v", err)
+	}
+	if started.JournalID != journalID || started.Status != string(entity.ExportJobStatusPending) {
+		t.Fatalf("started job = %+v, want a pending export of %s", started, journalID)
+	}
+	jobPath := "/api/v1/exports/" + started.ID.String()
+
+	if rec := doRequest(router, http.MethodGet, jobPath+"/download", ""); rec.Code != http.StatusConflict {
+		t.Errorf("download pending job: status = %d, want %d; body %s", rec.Code, http.StatusConflict, rec.Body)
+	}
+
+	exportJobs.jobs[started.ID].Status = entity.ExportJobStatusReady
+
+	rec = doRequest(router, http.MethodGet, jobPath, "")
+	if rec.Code != http.StatusOK {
+		t.Fatalf("get: status = %d, want %d; body %s", rec.Code, http.StatusOK, rec.Body)
+	}
+	var ready dto.ExportJobResponse
+	if err := json.Unmarshal(rec.Body.Bytes(), &ready); err != nil {
+		t.Fatalf("decode response: %v", err)
+	}
+	if ready.Status != string(entity.ExportJobStatusReady) || ready.DownloadURL != jobPath+"/download" {
+		t.Errorf("ready job = %+v, want status ready with download url %s", ready, jobPath+"/download")
+	}
+
+	rec = doRequest(router, http.MethodGet, jobPath+"/download", "")
+	if rec.Code != http.StatusOK {
+		t.Fatalf("download: status = %d, want %d; body %s", rec.Code, http.StatusOK, rec.Body)
+	}
+	if got, want := rec.Header().Get("Content-Disposition"), `attachment; filename="journal-`+journalID.String()+`.json"`; got != want {
+		t.Errorf("Content-Disposition = %q, want %q", got, want)
+	}
+	if rec.Body.String() != `{"version":1}` {
+		t.Errorf("body = %s, want the export document", rec.Body)
+	}
+}
+
+func TestExportJobHandlerErrors(t *testing.T) {
+	foreignJob := entity.NewExportJob(uuid.New(), uuid.New())
+
+	tests := []struct {
+		name       string
+		method     string
+		path       string
+		err        error
+		wantStatus int
+	}{
+		{"async export disabled", http.MethodPost, "/api/v1/journals/" + uuid.NewString() + "/export", entity.ErrAsyncExportDisabled, http.StatusServiceUnavailable},
+		{"export queue full", http.MethodPost, "/api/v1/journals/" + uuid.NewString() + "/export", entity.ErrExportQueueFull, http.StatusServiceUnavailable},
+		{"unknown job", http.MethodGet, "/api/v1/exports/" + uuid.NewString(), nil, http.StatusNotFound},
+		{"job of another user", http.MethodGet, "/api/v1/exports/" + foreignJob.ID.String(), nil, http.StatusNotFound},
+		{"download job of another user", http.MethodGet, "/api/v1/exports/" + foreignJob.ID.String() + "/download", nil, http.StatusNotFound},
+		{"cache failure", http.MethodGet, "/api/v1/exports/" + foreignJob.ID.String(), errors.New("failed to get export job: connection refused"), http.StatusInternalServerError},
+		{"malformed job id", http.MethodGet, "/api/v1/exports/not-a-uuid", nil, http.StatusBadRequest},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			exportJobs := &memoryExportJobService{jobs: map[uuid.UUID]*entity.ExportJob{foreignJob.ID: foreignJob}, err: tt.err}
+			router := newTestRouter(t, &fakeJournalAccess{}, testServices{exportJobs: exportJobs})
+
+			rec := doRequest(router, tt.method, tt.path, "")
+			if rec.Code != tt.wantStatus {
+				t.Errorf("status = %d, want %d; body %s", rec.Code, tt.wantStatus, rec.Body)
+			}
+		})
+	}
+}
@@ -14,31 +14,52 @@ type Handler struct {
 	userService                UserService
 	tradingJournalService      TradingJournalService
 	tradingJournalEntryService TradingJournalEntryService
+	journalTemplateService     JournalTemplateService
+	entryTemplateService       EntryTemplateService
+	entryNoteService           EntryNoteService
+	entryExitService           EntryExitService
+	exportJobService           ExportJobService
+	accountExportService       AccountExportService
 	logger                     *zap.Logger
 	validate                   *validator.Validate
 	middleware                 *Middleware
 	rateLimiter                *RateLimiter
 	environment                string
+	strictQuery                bool
 }
 
 func NewHandler(
 	userService UserService,
 	tradingJournalService TradingJournalService,
 	tradingJournalEntryService TradingJournalEntryService,
+	journalTemplateService JournalTemplateService,
+	entryTemplateService EntryTemplateService,
+	entryNoteService EntryNoteService,
+	entryExitService EntryExitService,
+	exportJobService ExportJobService,
+	accountExportService AccountExportService,
 	logger *zap.Logger,
 	middleware *Middleware,
 	rateLimiter *RateLimiter,
 	environment string,
+	strictQuery bool,
 ) *Handler {
 	return &Handler{
 		userService:                userService,
 		tradingJournalService:      tradingJournalService,
 		tradingJournalEntryService: tradingJournalEntryService,
+		journalTemplateService:     journalTemplateService,
+		entryTemplateService:       entryTemplateService,
+		entryNoteService:           entryNoteService,
+		entryExitService:           entryExitService,
+		exportJobService:           exportJobService,
+		accountExportService:       accountExportService,
 		logger:                     logger,
-		validate:                   validator.New(),
+		validate:                   newValidator(),
 		middleware:                 middleware,
 		rateLimiter:                rateLimiter,
 		environment:                environment,
+		strictQuery:                strictQuery,
 	}
 }
 
@@ -63,55 +84,137 @@ func (h *Handler) InitRoutes() *gin.Engine {
 }
 
 func (h *Handler) setupMiddleware(router *gin.Engine) {
+	// Sessions record c.ClientIP(), so gin must honor forwarding headers from
+	// the same proxies as the rate limiter. Without any it uses the peer.
+	if err := router.SetTrustedProxies(h.rateLimiter.trustedProxies()); err != nil {
+		h.logger.Error("failed to set trusted proxies", zap.Error(err))
+	}
+
 	router.Use(gin.Recovery())
+	// CORS runs first so preflight OPTIONS requests are answered with 204
+	// and aborted before they reach the rate limiter, logging or auth.
+	router.Use(h.middleware.RoutedCORS("/api/v1/auth"))
+	router.Use(h.middleware.RequestContext())
 	router.Use(h.rateLimiter.Limit())
-	router.Use(h.middleware.CORS())
 	router.Use(h.middleware.RequestLogger())
+	// The platform import takes a raw CSV body.
+	router.Use(h.middleware.RequireJSON("/api/v1/journals/:id/import"))
+
+	// Body logging can leak PII, so it only runs outside production and
+	// only when debug logging is on.
+	if h.environment != "production" && h.logger.Core().Enabled(zap.DebugLevel) {
+		router.Use(h.middleware.BodyLogger())
+		h.logger.Debug("request/response body logging enabled")
+	}
 }
 
+// initPublicRoutes registers the routes reachable without an access token:
+// sign-up, sign-in, token refresh and API metadata. Routes that act on the signed-in user,
+// such as logout, belong in initAuthenticatedRoutes even when they share the
+// /auth prefix.
 func (h *Handler) initPublicRoutes(api *gin.RouterGroup) {
 	auth := api.Group("/auth")
 	{
-		userHandler := NewUserHandler(h.userService, h.logger, h.validate)
+		userHandler := NewUserHandler(h.userService, h.validate)
 		userHandler.InitRoutes(auth)
 	}
+
+	meta := api.Group("/meta")
+	{
+		metaHandler := NewMetaHandler()
+		metaHandler.InitRoutes(meta)
+	}
 }
 
 func (h *Handler) initAuthenticatedRoutes(api *gin.RouterGroup) {
 	authenticated := api.Group("")
-	authenticated.Use(h.middleware.Auth())
+	authenticated.Use(h.middleware.Auth(), h.middleware.UserLogger())
 	{
+		h.initAuthRoutes(authenticated)
 		h.initJournalRoutes(authenticated)
+		h.initJournalTemplateRoutes(authenticated)
+		h.initUserRoutes(authenticated)
+	}
+}
+
+func (h *Handler) initAuthRoutes(group *gin.RouterGroup) {
+	auth := group.Group("/auth")
+	{
+		userHandler := NewUserHandler(h.userService, h.validate)
+		userHandler.InitAuthenticatedRoutes(auth)
+	}
+}
+
+func (h *Handler) initUserRoutes(group *gin.RouterGroup) {
+	users := group.Group("/users")
+	{
+		userHandler := NewUserHandler(h.userService, h.validate)
+		userHandler.InitProfileRoutes(users)
+
+		h.initCurrentUserRoutes(users)
+	}
+}
+
+func (h *Handler) initCurrentUserRoutes(users *gin.RouterGroup) {
+	me := users.Group("/me")
+	{
+		statisticsHandler := NewUserStatisticsHandler(h.tradingJournalEntryService)
+		statisticsHandler.InitRoutes(me)
+
+		sessionHandler := NewSessionHandler(h.userService)
+		sessionHandler.InitRoutes(me)
+
+		accountExportHandler := NewAccountExportHandler(h.accountExportService)
+		accountExportHandler.InitRoutes(me)
 	}
 }
 
 func (h *Handler) initJournalRoutes(group *gin.RouterGroup) {
 	journals := group.Group("/journals")
 	{
-		journalHandler := NewTradingJournalHandler(h.tradingJournalService, h.logger, h.validate)
+		journalHandler := NewTradingJournalHandler(h.tradingJournalService, h.validate, h.strictQuery)
 		journalHandler.InitRoutes(journals)
 
+		exportJobHandler := NewExportJobHandler(h.exportJobService)
+		exportJobHandler.InitJournalRoutes(journals.Group("/:id", ParseUUIDParam("id")))
+
+		entryTemplateHandler := NewEntryTemplateHandler(h.entryTemplateService, h.validate, h.strictQuery)
+		entryTemplateHandler.InitRoutes(journals.Group("/:id/entry-templates", ParseUUIDParam("id"), h.middleware.VerifyJournalAccess()))
+
 		h.initJournalEntryRoutes(journals)
 	}
+
+	exports := group.Group("/exports")
+	{
+		exportJobHandler := NewExportJobHandler(h.exportJobService)
+		exportJobHandler.InitRoutes(exports)
+	}
+}
+
+func (h *Handler) initJournalTemplateRoutes(group *gin.RouterGroup) {
+	templates := group.Group("/journal-templates")
+	{
+		templateHandler := NewJournalTemplateHandler(h.journalTemplateService, h.validate, h.strictQuery)
+		templateHandler.InitRoutes(templates)
+	}
 }
 
 func (h *Handler) initJournalEntryRoutes(journals *gin.RouterGroup) {
-	entries := journals.Group("/:id/entries")
+	entries := journals.Group("/:id/entries", ParseUUIDParam("id"), h.middleware.VerifyJournalAccess())
 	{
 		entryHandler := NewTradingJournalEntryHandler(
 			h.tradingJournalEntryService,
-			h.tradingJournalService,
-			h.logger,
 			h.validate,
+			h.strictQuery,
 		)
 		entryHandler.InitRoutes(entries)
-	}
-}
 
-type ErrorResponse struct {
-	Error string `json:"error"`
-}
+		notes := entries.Group("/:entryId/notes", ParseUUIDParam("entryId"))
+		noteHandler := NewEntryNoteHandler(h.entryNoteService, h.validate)
+		noteHandler.InitRoutes(notes)
 
-func newErrorResponse(c *gin.Context, statusCode int, message string) {
-	c.AbortWithStatusJSON(statusCode, ErrorResponse{Error: message})
+		exits := entries.Group("/:entryId/exits", ParseUUIDParam("entryId"))
+		exitHandler := NewEntryExitHandler(h.entryExitService, h.validate)
+		exitHandler.InitRoutes(exits)
+	}
 }
@@ -0,0 +1,246 @@
+package v1
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/user/normark/internal/config"
+	"github.com/user/normark/internal/dto"
+	"github.com/user/normark/internal/entity"
+	"github.com/user/normark/pkg/auth"
+	"go.uber.org/zap"
+)
+
+var testUserID = uuid.MustParse("7f1c1b9e-54a4-4c53-9d8f-1f2a3b4c5d6e")
+
+type fakeJWTValidator struct{}
+
+func (fakeJWTValidator) ValidateToken(string) (*auth.Claims, error) {
+	return &auth.Claims{UserID: testUserID, SessionID: uuid.New()}, nil
+}
+
+// fakeJournalAccess grants access to the journals in owned only.
+type fakeJournalAccess struct {
+	owned map[uuid.UUID]bool
+}
+
+func (f *fakeJournalAccess) VerifyAccess(_ context.Context, journalID uuid.UUID, userID uuid.UUID) (bool, error) {
+	return userID == testUserID && f.owned[journalID], nil
+}
+
+// testServices holds the services a test router is built with. Services a
+// test leaves nil must not be reached.
+type testServices struct {
+	users            UserService
+	journals         TradingJournalService
+	entries          TradingJournalEntryService
+	journalTemplates JournalTemplateService
+	templates        EntryTemplateService
+	notes            EntryNoteService
+	exits            EntryExitService
+	exportJobs       ExportJobService
+	accountExport    AccountExportService
+}
+
+func newTestRouter(t *testing.T, access JournalAccessVerifier, services testServices) *gin.Engine {
+	t.Helper()
+	return newLoggedTestRouter(t, zap.NewNop(), "production", access, services)
+}
+
+// newLoggedTestRouter builds the router with logger in environment.
+func newLoggedTestRouter(t *testing.T, logger *zap.Logger, environment string, access JournalAccessVerifier, services testServices) *gin.Engine {
+	t.Helper()
+	return newTestHandler(t, logger, environment, access, services).InitRoutes()
+}
+
+// newTestHandler returns the Handler behind a test router, for tests that
+// change its settings before calling InitRoutes.
+func newTestHandler(t *testing.T, logger *zap.Logger, environment string, access JournalAccessVerifier, services testServices) *Handler {
+	t.Helper()
+	gin.SetMode(gin.TestMode)
+
+	middleware := NewMiddleware(logger, fakeJWTValidator{}, &config.CORS{AllowOrigins: []string{"http://localhost:3000"}})
+	middleware.SetJournalAccessVerifier(access)
+	rateLimiter := NewRateLimiter(&config.RateLimit{RequestsPerSecond: 1000, Burst: 1000}, logger)
+
+	return NewHandler(
+		services.users,
+		services.journals,
+		services.entries,
+		services.journalTemplates,
+		services.templates,
+		services.notes,
+		services.exits,
+		services.exportJobs,
+		services.accountExport,
+		logger,
+		middleware,
+		rateLimiter,
+		environment,
+		false,
+	)
+}
+
+func doRequest(router *gin.Engine, method, path, body string) *httptest.ResponseRecorder {
+	req := httptest.NewRequest(method, path, strings.NewReader(body))
+	req.Header.Set("Authorization", "Bearer token")
+	req.Header.Set("Content-Type", "application/json")
+	rec := httptest.NewRecorder()
+	router.ServeHTTP(rec, req)
+	return rec
+}
+
+type bulkEntryService struct {
+	TradingJournalEntryService
+	calls int
+	err   error
+}
+
+func (s *bulkEntryService) BulkUpdate(context.Context, uuid.UUID, *dto.BulkUpdateEntriesRequest) ([]*entity.TradingJournalEntry, error) {
+	s.calls++
+	return nil, s.err
+}
+
+func TestEntryRoutesRequireJournalAccess(t *testing.T) {
+	foreignJournal := uuid.New()
+	entryID := uuid.New()
+	entries := &bulkEntryService{}
+	router := newTestRouter(t, &fakeJournalAccess{}, testServices{entries: entries})
+
+	tests := []struct {
+		method string
+		path   string
+		body   string
+	}{
+		{http.MethodPatch, "/entries/bulk", `{"set":{"followed_plan":true}}`},
+		{http.MethodGet, "/entries", ""},
+		{http.MethodPost, "/entries/" + entryID.String() + "/pin", ""},
+		{http.MethodPatch, "/entries/" + entryID.String() + "/review", `{"review_status":"reviewed"}`},
+		{http.MethodPost, "/entries/" + entryID.String() + "/links", `{}`},
+		{http.MethodPost, "/entries/" + entryID.String() + "/notes", `{"body":"note"}`},
+		{http.MethodPost, "/entries/" + entryID.String() + "/exits", `{}`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.method+" "+tt.path, func(t *testing.T) {
+			rec := doRequest(router, tt.method, "/api/v1/journals/"+foreignJournal.String()+tt.path, tt.body)
+			if rec.Code != http.StatusForbidden {
+				t.Fatalf("status = %d, want %d; body %s", rec.Code, http.StatusForbidden, rec.Body)
+			}
+		})
+	}
+
+	if entries.calls != 0 {
+		t.Errorf("entry service reached %d times for a foreign journal", entries.calls)
+	}
+}
+
+func TestBulkUpdateHandler(t *testing.T) {
+	journalID := uuid.New()
+	path := "/api/v1/journals/" + journalID.String() + "/entries/bulk"
+
+	tests := []struct {
+		name       string
+		body       string
+		err        error
+		wantStatus int
+		wantCalls  int
+	}{
+		{"updated", `{"set":{"followed_plan":false}}`, nil, http.StatusOK, 1},
+		{"malformed body", `{"set":`, nil, http.StatusBadRequest, 0},
+		{"failed validation", `{"set":{"max_rr":-1}}`, nil, http.StatusBadRequest, 0},
+		{"empty update", `{"set":{}}`, entity.ErrEmptyBulkUpdate, http.StatusBadRequest, 1},
+		{"too many entries", `{"set":{"followed_plan":false}}`, entity.ErrBulkUpdateTooLarge, http.StatusBadRequest, 1},
+		{"policy violation", `{"set":{"result":"SL"}}`, entity.ErrResultContradictsRealized, http.StatusBadRequest, 1},
+		{"journal not found", `{"set":{"followed_plan":false}}`, entity.ErrNotFound, http.StatusNotFound, 1},
+		{"locked journal", `{"set":{"followed_plan":false}}`, entity.ErrJournalLocked, http.StatusLocked, 1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			entries := &bulkEntryService{err: tt.err}
+			access := &fakeJournalAccess{owned: map[uuid.UUID]bool{journalID: true}}
+			router := newTestRouter(t, access, testServices{entries: entries})
+
+			rec := doRequest(router, http.MethodPatch, path, tt.body)
+			if rec.Code != tt.wantStatus {
+				t.Fatalf("status = %d, want %d; body %s", rec.Code, tt.wantStatus, rec.Body)
+			}
+			if entries.calls != tt.wantCalls {
+				t.Errorf("service calls = %d, want %d", entries.calls, tt.wantCalls)
+			}
+		})
+	}
+}
+
+type recordingTemplateService struct {
+	EntryTemplateService
+	calls int
+}
+
+func (s *recordingTemplateService) Create(_ context.Context, journalID uuid.UUID, req *dto.CreateEntryTemplateRequest) (*entity.EntryTemplate, error) {
+	s.calls++
+	template := entity.NewEntryTemplate(journalID, req.Name)
+	template.ID = uuid.New()
+	return template, nil
+}
+
+func (s *recordingTemplateService) GetJournalTemplates(context.Context, uuid.UUID, int, int) ([]*entity.EntryTemplate, error) {
+	s.calls++
+	return nil, nil
+}
+
+func (s *recordingTemplateService) CountJournalTemplates(context.Context, uuid.UUID) (int, error) {
+	return 0, nil
+}
+
+func (s *recordingTemplateService) Delete(context.Context, uuid.UUID, uuid.UUID) error {
+	s.calls++
+	return nil
+}
+
+func TestEntryTemplateRoutesRequireJournalAccess(t *testing.T) {
+	ownJournal := uuid.New()
+	foreignJournal := uuid.New()
+	templateID := uuid.New()
+
+	tests := []struct {
+		method string
+		path   string
+		body   string
+		want   int
+	}{
+		{http.MethodPost, "", `{"name":"London open"}`, http.StatusCreated},
+		{http.MethodGet, "", "", http.StatusOK},
+		{http.MethodDelete, "/" + templateID.String(), "", http.StatusOK},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.method, func(t *testing.T) {
+			templates := &recordingTemplateService{}
+			access := &fakeJournalAccess{owned: map[uuid.UUID]bool{ownJournal: true}}
+			router := newTestRouter(t, access, testServices{templates: templates})
+
+			rec := doRequest(router, tt.method, "/api/v1/journals/"+foreignJournal.String()+"/entry-templates"+tt.path, tt.body)
+			if rec.Code != http.StatusForbidden {
+				t.Fatalf("foreign journal: status = %d, want %d; body %s", rec.Code, http.StatusForbidden, rec.Body)
+			}
+			if templates.calls != 0 {
+				t.Fatalf("foreign journal: service reached %d times", templates.calls)
+			}
+
+			rec = doRequest(router, tt.method, "/api/v1/journals/"+ownJournal.String()+"/entry-templates"+tt.path, tt.body)
+			if rec.Code != tt.want {
+				t.Fatalf("own journal: status = %d, want %d; body %s", rec.Code, tt.want, rec.Body)
+			}
+			if templates.calls != 1 {
+				t.Errorf("own journal: service calls = %d, want 1", templates.calls)
+			}
+		})
+	}
+}
@@ -0,0 +1,201 @@
+package v1
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/cockroachdb/errors"
+	"github.com/gin-gonic/gin"
+	"github.com/go-playground/validator/v10"
+	"github.com/google/uuid"
+	"github.com/user/normark/internal/dto"
+	"github.com/user/normark/internal/dto/mapper"
+	"github.com/user/normark/internal/entity"
+	"go.uber.org/zap"
+)
+
+type JournalTemplateService interface {
+	Create(ctx context.Context, userID uuid.UUID, req *dto.CreateJournalTemplateRequest) (*entity.JournalTemplate, error)
+	GetUserTemplates(ctx context.Context, userID uuid.UUID, limit, offset int) ([]*entity.JournalTemplate, error)
+	CountUserTemplates(ctx context.Context, userID uuid.UUID) (int, error)
+	Delete(ctx context.Context, id uuid.UUID, userID uuid.UUID) error
+}
+
+type JournalTemplateHandler struct {
+	templateService JournalTemplateService
+	validate        *validator.Validate
+	strictQuery     bool
+}
+
+func NewJournalTemplateHandler(
+	templateService JournalTemplateService,
+	validate *validator.Validate,
+	strictQuery bool,
+) *JournalTemplateHandler {
+	return &JournalTemplateHandler{
+		templateService: templateService,
+		validate:        validate,
+		strictQuery:     strictQuery,
+	}
+}
+
+func (h *JournalTemplateHandler) InitRoutes(group *gin.RouterGroup) {
+	group.POST("", h.Create)
+	group.GET("", ParsePagination(h.strictQuery), h.List)
+	group.DELETE("/:id", h.Delete)
+}
+
+// Create godoc
+// @Summary      Create a journal template
+// @Description  Save a reusable set of journal defaults (description, default asset, default session, tags). Pass its ID as from_template when creating a journal.
+// @Tags         Journal Templates
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Param        request body dto.CreateJournalTemplateRequest true "Journal template details"
+// @Success      201 {object} dto.JournalTemplateResponse "Successfully created journal template"
+// @Failure      400 {object} ErrorResponse "Invalid request body or validation failed"
+// @Failure      401 {object} ErrorResponse "Unauthorized - missing or invalid token"
+// @Failure      500 {object} ErrorResponse "Internal server error"
+// @Router       /api/v1/journal-templates [post]
+func (h *JournalTemplateHandler) Create(c *gin.Context) {
+	var req dto.CreateJournalTemplateRequest
+
+	if err := c.ShouldBindJSON(&req); err != nil {
+		loggerFromContext(c).Error("failed to bind request", zap.Error(err))
+		newErrorResponse(c, http.StatusBadRequest, "invalid request body")
+		return
+	}
+
+	if err := h.validate.Struct(&req); err != nil {
+		loggerFromContext(c).Error("validation failed", zap.Error(err))
+		newErrorResponseFromError(c, http.StatusBadRequest, err)
+		return
+	}
+
+	userID, exists := c.Get("userID")
+	if !exists {
+		loggerFromContext(c).Error("user id not found in context")
+		newErrorResponse(c, http.StatusUnauthorized, "unauthorized")
+		return
+	}
+
+	uid, ok := userID.(uuid.UUID)
+	if !ok {
+		loggerFromContext(c).Error("invalid user id type in context")
+		newErrorResponse(c, http.StatusInternalServerError, "internal server error")
+		return
+	}
+
+	template, err := h.templateService.Create(c.Request.Context(), uid, &req)
+	if err != nil {
+		loggerFromContext(c).Error("failed to create journal template", zap.Error(err))
+		newErrorResponse(c, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	respond(c, http.StatusCreated, mapper.ToJournalTemplateResponse(template))
+}
+
+// List godoc
+// @Summary      List user's journal templates
+// @Description  Get a paginated list of journal templates for the authenticated user
+// @Tags         Journal Templates
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Param        limit query int false "Maximum number of templates to return (default: 20, max: 100)"
+// @Param        offset query int false "Number of templates to skip (default: 0)"
+// @Success      200 {object} dto.JournalTemplateListResponse "Successfully retrieved templates list"
+// @Failure      400 {object} ErrorResponse "Invalid limit or offset (only when strict query validation is enabled)"
+// @Failure      401 {object} ErrorResponse "Unauthorized - missing or invalid token"
+// @Failure      500 {object} ErrorResponse "Internal server error"
+// @Router       /api/v1/journal-templates [get]
+func (h *JournalTemplateHandler) List(c *gin.Context) {
+	userID, exists := c.Get("userID")
+	if !exists {
+		loggerFromContext(c).Error("user id not found in context")
+		newErrorResponse(c, http.StatusUnauthorized, "unauthorized")
+		return
+	}
+
+	uid, ok := userID.(uuid.UUID)
+	if !ok {
+		loggerFromContext(c).Error("invalid user id type in context")
+		newErrorResponse(c, http.StatusInternalServerError, "internal server error")
+		return
+	}
+
+	limit, offset := pagination(c)
+
+	templates, err := h.templateService.GetUserTemplates(c.Request.Context(), uid, limit, offset)
+	if err != nil {
+		loggerFromContext(c).Error("failed to get user journal templates", zap.Error(err))
+		newErrorResponse(c, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	total, err := h.templateService.CountUserTemplates(c.Request.Context(), uid)
+	if err != nil {
+		loggerFromContext(c).Error("failed to count user journal templates", zap.Error(err))
+		newErrorResponse(c, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	response := &dto.JournalTemplateListResponse{
+		Templates:  mapper.ToJournalTemplateResponses(templates),
+		Pagination: dto.NewPagination(total, limit, offset),
+	}
+
+	respond(c, http.StatusOK, response)
+}
+
+// Delete godoc
+// @Summary      Delete journal template
+// @Description  Delete a journal template. Journals already created from it are not affected.
+// @Tags         Journal Templates
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Param        id path string true "Journal Template ID (UUID)"
+// @Success      200 {object} map[string]string "Successfully deleted template"
+// @Failure      400 {object} ErrorResponse "Invalid template ID"
+// @Failure      401 {object} ErrorResponse "Unauthorized - missing or invalid token"
+// @Failure      404 {object} ErrorResponse "Journal template not found"
+// @Failure      500 {object} ErrorResponse "Internal server error"
+// @Router       /api/v1/journal-templates/{id} [delete]
+func (h *JournalTemplateHandler) Delete(c *gin.Context) {
+	idStr := c.Param("id")
+	id, err := uuid.Parse(idStr)
+	if err != nil {
+		loggerFromContext(c).Error("invalid template id", zap.Error(err))
+		newErrorResponse(c, http.StatusBadRequest, "invalid template id")
+		return
+	}
+
+	userID, exists := c.Get("userID")
+	if !exists {
+		loggerFromContext(c).Error("user id not found in context")
+		newErrorResponse(c, http.StatusUnauthorized, "unauthorized")
+		return
+	}
+
+	uid, ok := userID.(uuid.UUID)
+	if !ok {
+		loggerFromContext(c).Error("invalid user id type in context")
+		newErrorResponse(c, http.StatusInternalServerError, "internal server error")
+		return
+	}
+
+	if err := h.templateService.Delete(c.Request.Context(), id, uid); err != nil {
+		loggerFromContext(c).Error("failed to delete journal template", zap.Error(err))
+		if errors.Is(err, entity.ErrNotFound) {
+			newErrorResponse(c, http.StatusNotFound, "journal template not found")
+			return
+		}
+		newErrorResponse(c, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	respond(c, http.StatusOK, gin.H{"message": "template deleted successfully"})
+}
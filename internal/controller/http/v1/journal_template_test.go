@@ -0,0 +1,116 @@
+package v1
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/cockroachdb/errors"
+	"github.com/google/uuid"
+	"github.com/user/normark/internal/dto"
+	"github.com/user/normark/internal/entity"
+	"github.com/user/normark/internal/types"
+)
+
+type recordingJournalTemplateService struct {
+	JournalTemplateService
+	userID uuid.UUID
+	req    *dto.CreateJournalTemplateRequest
+}
+
+func (s *recordingJournalTemplateService) Create(_ context.Context, userID uuid.UUID, req *dto.CreateJournalTemplateRequest) (*entity.JournalTemplate, error) {
+	s.userID = userID
+	s.req = req
+	template := entity.NewJournalTemplate(userID, req.Name, req.Description)
+	template.ID = uuid.New()
+	return template, nil
+}
+
+func TestCreateJournalTemplateHandler(t *testing.T) {
+	tests := []struct {
+		name       string
+		body       string
+		wantStatus int
+	}{
+		{"created", `{"name":"Swing","default_asset":"EURUSD","default_session":"london","tags":["majors"]}`, http.StatusCreated},
+		{"missing name", `{"default_asset":"EURUSD"}`, http.StatusBadRequest},
+		{"malformed body", `{"name":`, http.StatusBadRequest},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			templates := &recordingJournalTemplateService{}
+			router := newTestRouter(t, &fakeJournalAccess{}, testServices{journalTemplates: templates})
+
+			rec := doRequest(router, http.MethodPost, "/api/v1/journal-templates", tt.body)
+			if rec.Code != tt.wantStatus {
+				t.Fatalf("status = %d, want %d; body %s", rec.Code, tt.wantStatus, rec.Body)
+			}
+			if rec.Code != http.StatusCreated {
+				if templates.req != nil {
+					t.Errorf("service reached with an invalid request")
+				}
+				return
+			}
+
+			if templates.userID != testUserID {
+				t.Errorf("template created for %s, want the authenticated user %s", templates.userID, testUserID)
+			}
+			if *templates.req.DefaultAsset != types.CurrencyPairEURUSD || *templates.req.DefaultSession != types.TradingSessionLondon {
+				t.Errorf("request = %+v, want the template defaults", templates.req)
+			}
+		})
+	}
+}
+
+// templatedJournalService creates journals from the template templateID only.
+type templatedJournalService struct {
+	TradingJournalService
+	templateID uuid.UUID
+	req        *dto.CreateTradingJournalRequest
+}
+
+func (s *templatedJournalService) Create(_ context.Context, userID uuid.UUID, req *dto.CreateTradingJournalRequest) (*entity.TradingJournal, error) {
+	s.req = req
+	if req.TemplateID != nil && *req.TemplateID != s.templateID {
+		return nil, errors.Wrap(entity.ErrNotFound, "journal template")
+	}
+	journal := entity.NewTradingJournal(userID, req.Name, req.Description)
+	journal.ID = uuid.New()
+	return journal, nil
+}
+
+func TestCreateJournalFromTemplate(t *testing.T) {
+	templateID := uuid.New()
+
+	tests := []struct {
+		name           string
+		query          string
+		wantStatus     int
+		wantTemplateID *uuid.UUID
+	}{
+		{"without template", "", http.StatusCreated, nil},
+		{"from template", "?from_template=" + templateID.String(), http.StatusCreated, &templateID},
+		{"unknown template", "?from_template=" + uuid.NewString(), http.StatusNotFound, nil},
+		{"invalid template id", "?from_template=swing", http.StatusBadRequest, nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			journals := &templatedJournalService{templateID: templateID}
+			router := newTestRouter(t, &fakeJournalAccess{}, testServices{journals: journals})
+
+			rec := doRequest(router, http.MethodPost, "/api/v1/journals"+tt.query, `{"name":"2026"}`)
+			if rec.Code != tt.wantStatus {
+				t.Fatalf("status = %d, want %d; body %s", rec.Code, tt.wantStatus, rec.Body)
+			}
+			if rec.Code != http.StatusCreated {
+				return
+			}
+
+			if !equalPtr(journals.req.TemplateID, tt.wantTemplateID) {
+				t.Errorf("template id = %v, want %v", journals.req.TemplateID, tt.wantTemplateID)
+			}
+		})
+	}
+}
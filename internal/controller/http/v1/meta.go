@@ -0,0 +1,43 @@
+package v1
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/user/normark/internal/dto"
+	"github.com/user/normark/internal/types"
+)
+
+// MetaHandler serves data about the API itself.
+type MetaHandler struct {
+	enums *dto.EnumsResponse
+}
+
+func NewMetaHandler() *MetaHandler {
+	return &MetaHandler{
+		enums: &dto.EnumsResponse{
+			CurrencyPairs:   types.CurrencyPairs,
+			TradingSessions: types.TradingSessions,
+			TradeTypes:      types.TradeTypes,
+			TradeDirections: types.TradeDirections,
+			EntryTypes:      types.EntryTypes,
+			TradeResults:    types.TradeResults,
+			TimeFrames:      types.TimeFrames,
+		},
+	}
+}
+
+func (h *MetaHandler) InitRoutes(group *gin.RouterGroup) {
+	group.GET("/enums", h.GetEnums)
+}
+
+// GetEnums godoc
+// @Summary      List valid enum values
+// @Description  Retrieve every valid value of the enums the API accepts, such as currency pairs, sessions and timeframes, so clients can build dropdowns without hardcoding them
+// @Tags         Meta
+// @Produce      json
+// @Success      200 {object} dto.EnumsResponse "Successfully retrieved enum values"
+// @Router       /api/v1/meta/enums [get]
+func (h *MetaHandler) GetEnums(c *gin.Context) {
+	respond(c, http.StatusOK, h.enums)
+}
@@ -0,0 +1,49 @@
+package v1
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"testing"
+
+	"github.com/user/normark/internal/dto"
+	"github.com/user/normark/internal/types"
+)
+
+func TestGetEnums(t *testing.T) {
+	router := newTestRouter(t, &fakeJournalAccess{}, testServices{})
+
+	// The enums are public: no Authorization header.
+	req := httptest.NewRequest(http.MethodGet, "/api/v1/meta/enums", nil)
+	rec := httptest.NewRecorder()
+	router.ServeHTTP(rec, req)
+
+	if rec.Code != http.StatusOK {
+		t.Fatalf("status = %d, want %d; body %s", rec.Code, http.StatusOK, rec.Body)
+	}
+
+	var response dto.EnumsResponse
+	if err := json.Unmarshal(rec.Body.Bytes(), &response); err != nil {
+		t.Fatalf("decode response: %v", err)
+	}
+
+	tests := []struct {
+		name string
+		got  bool
+	}{
+		{"currency_pairs", slices.Equal(response.CurrencyPairs, types.CurrencyPairs)},
+		{"trading_sessions", slices.Equal(response.TradingSessions, types.TradingSessions)},
+		{"trade_types", slices.Equal(response.TradeTypes, types.TradeTypes)},
+		{"trade_directions", slices.Equal(response.TradeDirections, types.TradeDirections)},
+		{"entry_types", slices.Equal(response.EntryTypes, types.EntryTypes)},
+		{"trade_results", slices.Equal(response.TradeResults, types.TradeResults)},
+		{"time_frames", slices.Equal(response.TimeFrames, types.TimeFrames)},
+	}
+
+	for _, tt := range tests {
+		if !tt.got {
+			t.Errorf("%s does not list every value of the types package; body %s", tt.name, rec.Body)
+		}
+	}
+}
@@ -2,6 +2,7 @@ package v1
 
 import (
 	"context"
+	"mime"
 	"net/http"
 	"strings"
 	"time"
@@ -45,22 +46,103 @@ func (m *Middleware) SetJournalAccessVerifier(verifier JournalAccessVerifier) {
 	m.journalAccessVerifier = verifier
 }
 
-func (m *Middleware) CORS() gin.HandlerFunc {
+// CORS builds the CORS middleware for a single policy.
+func (m *Middleware) CORS(cfg *config.CORS) gin.HandlerFunc {
 	return cors.New(cors.Config{
-		AllowOrigins:     m.corsConfig.AllowOrigins,
-		AllowMethods:     m.corsConfig.AllowMethods,
-		AllowHeaders:     m.corsConfig.AllowHeaders,
-		AllowCredentials: m.corsConfig.AllowCredentials,
-		MaxAge:           time.Duration(m.corsConfig.MaxAge) * time.Second,
+		AllowOrigins:     cfg.AllowOrigins,
+		AllowMethods:     cfg.AllowMethods,
+		AllowHeaders:     cfg.AllowHeaders,
+		AllowCredentials: cfg.AllowCredentials,
+		MaxAge:           time.Duration(cfg.MaxAge) * time.Second,
 	})
 }
 
+// RoutedCORS applies the configured CORS policy by path. Exempt prefixes are
+// passed through untouched and requests under authPrefix use the auth
+// policy. It has to run on the router rather than on route groups because
+// preflight OPTIONS requests never match a group's routes.
+func (m *Middleware) RoutedCORS(authPrefix string) gin.HandlerFunc {
+	global := m.CORS(m.corsConfig)
+	auth := m.CORS(m.corsConfig.ForAuth())
+
+	return func(c *gin.Context) {
+		path := c.Request.URL.Path
+
+		for _, prefix := range m.corsConfig.ExemptPaths {
+			if prefix != "" && strings.HasPrefix(path, prefix) {
+				c.Next()
+				return
+			}
+		}
+
+		if strings.HasPrefix(path, authPrefix) {
+			auth(c)
+			return
+		}
+
+		global(c)
+	}
+}
+
+const (
+	headerRequestID = "X-Request-ID"
+	loggerKey       = "logger"
+
+	// maxRequestIDLength bounds a client-supplied request ID, which ends up
+	// in every log entry of the request.
+	maxRequestIDLength = 64
+)
+
+// RequestContext gives the request an ID and a logger carrying it, which
+// handlers get with loggerFromContext. A client-supplied X-Request-ID is
+// kept so a request can be traced across services; otherwise one is
+// generated. The ID is echoed in the response.
+func (m *Middleware) RequestContext() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		requestID := c.GetHeader(headerRequestID)
+		if requestID == "" || len(requestID) > maxRequestIDLength {
+			requestID = uuid.NewString()
+		}
+
+		c.Header(headerRequestID, requestID)
+		c.Set(loggerKey, m.logger.With(zap.String("request_id", requestID)))
+
+		c.Next()
+	}
+}
+
+// UserLogger adds the authenticated user's ID to the request logger. It
+// must run after Auth.
+func (m *Middleware) UserLogger() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		if userID, ok := c.Get("userID"); ok {
+			if uid, ok := userID.(uuid.UUID); ok {
+				c.Set(loggerKey, loggerFromContext(c).With(zap.String("user_id", uid.String())))
+			}
+		}
+
+		c.Next()
+	}
+}
+
+// loggerFromContext returns the request logger set up by RequestContext and
+// UserLogger, or the global logger outside a request.
+func loggerFromContext(c *gin.Context) *zap.Logger {
+	if logger, ok := c.Get(loggerKey); ok {
+		if l, ok := logger.(*zap.Logger); ok {
+			return l
+		}
+	}
+
+	return zap.L()
+}
+
 func (m *Middleware) RequestLogger() gin.HandlerFunc {
 	return func(c *gin.Context) {
 		c.Next()
 
 		if len(c.Errors) > 0 {
-			m.logger.Error(
+			loggerFromContext(c).Error(
 				"request failed",
 				zap.String("method", c.Request.Method),
 				zap.String("path", c.Request.URL.Path),
@@ -70,18 +152,52 @@ func (m *Middleware) RequestLogger() gin.HandlerFunc {
 	}
 }
 
+// RequireJSON rejects a POST, PUT or PATCH body that is not
+// application/json with 415, so a wrong Content-Type fails clearly instead
+// of as a binding error. Requests without a body pass, as do the routes in
+// exemptRoutes (full route patterns such as "/api/v1/journals/:id/import"),
+// which take other formats.
+func (m *Middleware) RequireJSON(exemptRoutes ...string) gin.HandlerFunc {
+	exempt := make(map[string]bool, len(exemptRoutes))
+	for _, route := range exemptRoutes {
+		exempt[route] = true
+	}
+
+	return func(c *gin.Context) {
+		switch c.Request.Method {
+		case http.MethodPost, http.MethodPut, http.MethodPatch:
+		default:
+			c.Next()
+			return
+		}
+
+		if c.Request.ContentLength == 0 || exempt[c.FullPath()] {
+			c.Next()
+			return
+		}
+
+		mediaType, _, err := mime.ParseMediaType(c.GetHeader("Content-Type"))
+		if err != nil || mediaType != "application/json" {
+			newErrorResponse(c, http.StatusUnsupportedMediaType, "request body must be application/json")
+			return
+		}
+
+		c.Next()
+	}
+}
+
 func (m *Middleware) Auth() gin.HandlerFunc {
 	return func(c *gin.Context) {
 		authHeader := c.GetHeader("Authorization")
 		if authHeader == "" {
-			m.logger.Error("missing authorization header")
+			loggerFromContext(c).Error("missing authorization header")
 			newErrorResponse(c, http.StatusUnauthorized, "missing authorization header")
 			return
 		}
 
 		parts := strings.Split(authHeader, " ")
 		if len(parts) != 2 || parts[0] != "Bearer" {
-			m.logger.Error("invalid authorization header format")
+			loggerFromContext(c).Error("invalid authorization header format")
 			newErrorResponse(c, http.StatusUnauthorized, "invalid authorization header format")
 			return
 		}
@@ -90,12 +206,13 @@ func (m *Middleware) Auth() gin.HandlerFunc {
 
 		claims, err := m.jwtValidator.ValidateToken(tokenString)
 		if err != nil {
-			m.logger.Error("invalid token", zap.Error(err))
+			loggerFromContext(c).Error("invalid token", zap.Error(err))
 			newErrorResponse(c, http.StatusUnauthorized, "invalid token")
 			return
 		}
 
 		c.Set("userID", claims.UserID)
+		c.Set("sessionID", claims.SessionID)
 		c.Set("email", claims.Email)
 		c.Set("username", claims.Username)
 
@@ -112,41 +229,41 @@ func (m *Middleware) VerifyJournalAccess() gin.HandlerFunc {
 		} else if journalID := c.Param("journalId"); journalID != "" {
 			journalIDStr = journalID
 		} else {
-			m.logger.Error("journal id not found in request")
+			loggerFromContext(c).Error("journal id not found in request")
 			newErrorResponse(c, http.StatusBadRequest, "journal id required")
 			return
 		}
 
 		journalID, err := uuid.Parse(journalIDStr)
 		if err != nil {
-			m.logger.Error("invalid journal id", zap.Error(err))
+			loggerFromContext(c).Error("invalid journal id", zap.Error(err))
 			newErrorResponse(c, http.StatusBadRequest, "invalid journal id")
 			return
 		}
 
 		userID, exists := c.Get("userID")
 		if !exists {
-			m.logger.Error("user id not found in context")
+			loggerFromContext(c).Error("user id not found in context")
 			newErrorResponse(c, http.StatusUnauthorized, "unauthorized")
 			return
 		}
 
 		uid, ok := userID.(uuid.UUID)
 		if !ok {
-			m.logger.Error("invalid user id type in context")
+			loggerFromContext(c).Error("invalid user id type in context")
 			newErrorResponse(c, http.StatusInternalServerError, "internal server error")
 			return
 		}
 
 		hasAccess, err := m.journalAccessVerifier.VerifyAccess(c.Request.Context(), journalID, uid)
 		if err != nil {
-			m.logger.Error("failed to verify journal access", zap.Error(err))
+			loggerFromContext(c).Error("failed to verify journal access", zap.Error(err))
 			newErrorResponse(c, http.StatusInternalServerError, err.Error())
 			return
 		}
 
 		if !hasAccess {
-			m.logger.Error("user does not have access to journal")
+			loggerFromContext(c).Error("user does not have access to journal")
 			newErrorResponse(c, http.StatusForbidden, "access denied")
 			return
 		}
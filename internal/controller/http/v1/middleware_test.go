@@ -0,0 +1,253 @@
+package v1
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/user/normark/internal/config"
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+	"go.uber.org/zap/zaptest/observer"
+)
+
+func TestRoutedCORS(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	const (
+		appOrigin   = "https://app.example.com"
+		loginOrigin = "https://login.example.com"
+	)
+
+	middleware := NewMiddleware(zap.NewNop(), fakeJWTValidator{}, &config.CORS{
+		AllowOrigins:     []string{appOrigin},
+		AllowMethods:     []string{http.MethodGet, http.MethodPost},
+		AllowCredentials: true,
+		ExemptPaths:      []string{"/metrics"},
+		AuthAllowOrigins: []string{loginOrigin},
+	})
+
+	router := gin.New()
+	router.Use(middleware.RoutedCORS("/api/v1/auth"))
+	for _, path := range []string{"/metrics", "/api/v1/auth/sign-in", "/api/v1/journals"} {
+		router.GET(path, func(c *gin.Context) { c.Status(http.StatusOK) })
+	}
+
+	tests := []struct {
+		name       string
+		path       string
+		origin     string
+		wantStatus int
+		wantOrigin string
+	}{
+		{"exempt path ignores cors", "/metrics", "https://other.example.com", http.StatusOK, ""},
+		{"auth path allows auth origin", "/api/v1/auth/sign-in", loginOrigin, http.StatusOK, loginOrigin},
+		{"auth path rejects global origin", "/api/v1/auth/sign-in", appOrigin, http.StatusForbidden, ""},
+		{"global path allows global origin", "/api/v1/journals", appOrigin, http.StatusOK, appOrigin},
+		{"global path rejects auth origin", "/api/v1/journals", loginOrigin, http.StatusForbidden, ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodGet, tt.path, nil)
+			req.Header.Set("Origin", tt.origin)
+			rec := httptest.NewRecorder()
+			router.ServeHTTP(rec, req)
+
+			if rec.Code != tt.wantStatus {
+				t.Errorf("status = %d, want %d", rec.Code, tt.wantStatus)
+			}
+			if got := rec.Header().Get("Access-Control-Allow-Origin"); got != tt.wantOrigin {
+				t.Errorf("Access-Control-Allow-Origin = %q, want %q", got, tt.wantOrigin)
+			}
+		})
+	}
+}
+
+func TestPreflightSkipsRateLimitAndAuth(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	const origin = "https://app.example.com"
+
+	logger := zap.NewNop()
+	middleware := NewMiddleware(logger, fakeJWTValidator{}, &config.CORS{
+		AllowOrigins: []string{origin},
+		AllowMethods: []string{http.MethodGet, http.MethodPost, http.MethodPut},
+		AllowHeaders: []string{"Content-Type", "Authorization"},
+		MaxAge:       600,
+	})
+	middleware.SetJournalAccessVerifier(&fakeJournalAccess{})
+	// One request per client, so a preflight that used a token would leave
+	// none for the request it precedes.
+	rateLimiter := NewRateLimiter(&config.RateLimit{RequestsPerSecond: 1, Burst: 1}, logger)
+	router := NewHandler(nil, &archiveJournalService{}, nil, nil, nil, nil, nil, nil, nil,
+		logger, middleware, rateLimiter, "production", false).InitRoutes()
+
+	for i := range 3 {
+		// No Authorization header: browsers never send credentials on a preflight.
+		req := httptest.NewRequest(http.MethodOptions, "/api/v1/journals", nil)
+		req.Header.Set("Origin", origin)
+		req.Header.Set("Access-Control-Request-Method", http.MethodPut)
+		req.Header.Set("Access-Control-Request-Headers", "Authorization")
+		rec := httptest.NewRecorder()
+		router.ServeHTTP(rec, req)
+
+		if rec.Code != http.StatusNoContent {
+			t.Fatalf("preflight %d: status = %d, want %d", i, rec.Code, http.StatusNoContent)
+		}
+		for header, want := range map[string]string{
+			"Access-Control-Allow-Origin":  origin,
+			"Access-Control-Allow-Methods": "GET,POST,PUT",
+			"Access-Control-Allow-Headers": "Content-Type,Authorization",
+			"Access-Control-Max-Age":       "600",
+		} {
+			if got := rec.Header().Get(header); got != want {
+				t.Errorf("preflight %d: %s = %q, want %q", i, header, got, want)
+			}
+		}
+	}
+
+	for _, wantStatus := range []int{http.StatusOK, http.StatusTooManyRequests} {
+		req := httptest.NewRequest(http.MethodGet, "/api/v1/journals", nil)
+		req.Header.Set("Origin", origin)
+		req.Header.Set("Authorization", "Bearer token")
+		rec := httptest.NewRecorder()
+		router.ServeHTTP(rec, req)
+
+		if rec.Code != wantStatus {
+			t.Fatalf("status = %d, want %d; body %s", rec.Code, wantStatus, rec.Body)
+		}
+		// Browsers can only read a 429 that carries CORS headers.
+		if got := rec.Header().Get("Access-Control-Allow-Origin"); got != origin {
+			t.Errorf("status %d: Access-Control-Allow-Origin = %q, want %q", rec.Code, got, origin)
+		}
+	}
+}
+
+func TestHandlerLogsCarryRequestAndUserIDs(t *testing.T) {
+	tests := []struct {
+		name          string
+		requestID     string
+		token         bool
+		message       string
+		wantRequestID string
+		wantUserID    bool
+	}{
+		{"client request id", "trace-123", true, "failed to set trading journal archived flag", "trace-123", true},
+		{"generated request id", "", true, "failed to set trading journal archived flag", "", true},
+		{"oversized request id", strings.Repeat("x", maxRequestIDLength+1), true, "failed to set trading journal archived flag", "", true},
+		{"unauthenticated", "trace-456", false, "missing authorization header", "trace-456", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			core, logs := observer.New(zapcore.InfoLevel)
+			journals := &archiveJournalService{}
+			router := newLoggedTestRouter(t, zap.New(core), "production", &fakeJournalAccess{}, testServices{journals: journals})
+
+			req := httptest.NewRequest(http.MethodPost, "/api/v1/journals/"+uuid.NewString()+"/archive", nil)
+			if tt.token {
+				req.Header.Set("Authorization", "Bearer token")
+			}
+			if tt.requestID != "" {
+				req.Header.Set(headerRequestID, tt.requestID)
+			}
+			rec := httptest.NewRecorder()
+			router.ServeHTTP(rec, req)
+
+			requestID := rec.Header().Get(headerRequestID)
+			if tt.wantRequestID != "" && requestID != tt.wantRequestID {
+				t.Errorf("%s = %q, want %q", headerRequestID, requestID, tt.wantRequestID)
+			}
+			if tt.wantRequestID == "" {
+				if _, err := uuid.Parse(requestID); err != nil {
+					t.Errorf("%s = %q, want a generated UUID", headerRequestID, requestID)
+				}
+			}
+
+			entries := logs.FilterMessage(tt.message).All()
+			if len(entries) != 1 {
+				t.Fatalf("logged %q %d times, want once", tt.message, len(entries))
+			}
+			fields := entries[0].ContextMap()
+			if fields["request_id"] != requestID {
+				t.Errorf("request_id = %v, want %q", fields["request_id"], requestID)
+			}
+			userID, ok := fields["user_id"]
+			if tt.wantUserID && userID != testUserID.String() {
+				t.Errorf("user_id = %v, want %s", userID, testUserID)
+			}
+			if !tt.wantUserID && ok {
+				t.Errorf("user_id = %v logged before authentication", userID)
+			}
+		})
+	}
+}
+
+func TestLoggerFromContextFallsBackToGlobal(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	c, _ := gin.CreateTestContext(httptest.NewRecorder())
+
+	if got := loggerFromContext(c); got != zap.L() {
+		t.Errorf("loggerFromContext() = %p, want the global logger %p", got, zap.L())
+	}
+
+	logger := zap.NewNop()
+	c.Set(loggerKey, logger)
+	if got := loggerFromContext(c); got != logger {
+		t.Errorf("loggerFromContext() = %p, want the request logger %p", got, logger)
+	}
+}
+
+func TestRequireJSON(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	middleware := NewMiddleware(zap.NewNop(), fakeJWTValidator{}, &config.CORS{})
+	router := gin.New()
+	router.Use(middleware.RequireJSON("/journals/:id/import"))
+	ok := func(c *gin.Context) { c.Status(http.StatusOK) }
+	router.Any("/journals", ok)
+	router.POST("/journals/:id/import", ok)
+
+	tests := []struct {
+		name        string
+		method      string
+		path        string
+		body        string
+		contentType string
+		wantStatus  int
+	}{
+		{"json", http.MethodPost, "/journals", `{}`, "application/json", http.StatusOK},
+		{"json with charset", http.MethodPut, "/journals", `{}`, "application/json; charset=utf-8", http.StatusOK},
+		{"text", http.MethodPost, "/journals", `{}`, "text/plain", http.StatusUnsupportedMediaType},
+		{"form", http.MethodPatch, "/journals", "name=Swing", "application/x-www-form-urlencoded", http.StatusUnsupportedMediaType},
+		{"missing content type", http.MethodPost, "/journals", `{}`, "", http.StatusUnsupportedMediaType},
+		{"no body", http.MethodPost, "/journals", "", "text/plain", http.StatusOK},
+		{"read", http.MethodGet, "/journals", `{}`, "text/plain", http.StatusOK},
+		{"delete", http.MethodDelete, "/journals", `{}`, "text/plain", http.StatusOK},
+		{"exempt route", http.MethodPost, "/journals/" + uuid.NewString() + "/import", "a,b", "text/csv", http.StatusOK},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(tt.method, tt.path, strings.NewReader(tt.body))
+			if tt.contentType != "" {
+				req.Header.Set("Content-Type", tt.contentType)
+			}
+			rec := httptest.NewRecorder()
+			router.ServeHTTP(rec, req)
+
+			if rec.Code != tt.wantStatus {
+				t.Fatalf("status = %d, want %d; body %s", rec.Code, tt.wantStatus, rec.Body)
+			}
+			if rec.Code == http.StatusUnsupportedMediaType {
+				if code := decodeErrorCode(t, rec); code != CodeUnsupportedMediaType {
+					t.Errorf("code = %q, want %q", code, CodeUnsupportedMediaType)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,141 @@
+package v1
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/cockroachdb/errors"
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/user/normark/internal/entity"
+)
+
+// ParseUUIDParam parses the named path parameter as a UUID and stores it in
+// the context, so handlers read it with uuidParam instead of parsing it
+// themselves. Malformed IDs are rejected with 400 before the handler runs.
+func ParseUUIDParam(name string) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		id, err := uuid.Parse(c.Param(name))
+		if err != nil {
+			newErrorResponse(c, http.StatusBadRequest, fmt.Sprintf("invalid %s: must be a UUID", name))
+			return
+		}
+
+		c.Set(uuidParamKey(name), id)
+		c.Next()
+	}
+}
+
+// uuidParam returns a path parameter parsed by ParseUUIDParam. It panics if
+// the route was registered without that middleware.
+func uuidParam(c *gin.Context, name string) uuid.UUID {
+	return c.MustGet(uuidParamKey(name)).(uuid.UUID)
+}
+
+func uuidParamKey(name string) string {
+	return "param:" + name
+}
+
+const (
+	defaultPageSize = 20
+	maxPageSize     = 100
+)
+
+const (
+	limitKey  = "param:limit"
+	offsetKey = "param:offset"
+)
+
+// ParsePagination parses the limit and offset query parameters and stores
+// them in the context, so list handlers read them with pagination. A limit
+// outside 1..maxPageSize or a negative or non-numeric offset is rejected with
+// 400 when strict is set; otherwise it falls back to the default.
+func ParsePagination(strict bool) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		limit, err := parseIntQuery(c, "limit", defaultPageSize, 1, maxPageSize)
+		if err != nil && strict {
+			newErrorResponse(c, http.StatusBadRequest, fmt.Sprintf("invalid limit: must be an integer between 1 and %d", maxPageSize))
+			return
+		}
+
+		offset, err := parseIntQuery(c, "offset", 0, 0, -1)
+		if err != nil && strict {
+			newErrorResponse(c, http.StatusBadRequest, "invalid offset: must be a non-negative integer")
+			return
+		}
+
+		c.Set(limitKey, limit)
+		c.Set(offsetKey, offset)
+		c.Next()
+	}
+}
+
+// pagination returns the limit and offset parsed by ParsePagination. It
+// panics if the route was registered without that middleware.
+func pagination(c *gin.Context) (limit, offset int) {
+	return c.MustGet(limitKey).(int), c.MustGet(offsetKey).(int)
+}
+
+// parseIntQuery returns the named query parameter, or def when it is missing
+// or invalid. A negative max means no upper bound.
+func parseIntQuery(c *gin.Context, key string, def, min, max int) (int, error) {
+	str := c.Query(key)
+	if str == "" {
+		return def, nil
+	}
+
+	value, err := strconv.Atoi(str)
+	if err != nil || value < min || (max >= 0 && value > max) {
+		return def, fmt.Errorf("invalid %s", key)
+	}
+
+	return value, nil
+}
+
+// periodQuery returns the period given by the <prefix>_start and
+// <prefix>_end query parameters, both required YYYY-MM-DD days. The end day
+// is included, so the period runs to its last microsecond, the database's
+// precision.
+func periodQuery(c *gin.Context, prefix string) (entity.Period, error) {
+	startStr, endStr := c.Query(prefix+"_start"), c.Query(prefix+"_end")
+	if startStr == "" || endStr == "" {
+		return entity.Period{}, fmt.Errorf("%s_start and %s_end are required", prefix, prefix)
+	}
+
+	start, err := time.Parse(time.DateOnly, startStr)
+	if err != nil {
+		return entity.Period{}, fmt.Errorf("invalid %s_start: must be YYYY-MM-DD", prefix)
+	}
+	end, err := time.Parse(time.DateOnly, endStr)
+	if err != nil {
+		return entity.Period{}, fmt.Errorf("invalid %s_end: must be YYYY-MM-DD", prefix)
+	}
+	if end.Before(start) {
+		return entity.Period{}, fmt.Errorf("%s_end is before %s_start", prefix, prefix)
+	}
+
+	return entity.Period{
+		Start: start,
+		End:   end.AddDate(0, 0, 1).Add(-time.Microsecond),
+	}, nil
+}
+
+// timezoneQuery returns the IANA time zone named by the timezone query
+// parameter, or UTC without one. Day-bucketed statistics use it so trades
+// fall on the trader's local day.
+func timezoneQuery(c *gin.Context) (*time.Location, error) {
+	name := c.Query("timezone")
+	if name == "" {
+		return time.UTC, nil
+	}
+
+	// LoadLocation also accepts "Local", the server's own zone.
+	loc, err := time.LoadLocation(name)
+	if err != nil || name == "Local" {
+		return nil, errors.New("invalid timezone: must be an IANA time zone name")
+	}
+
+	return loc, nil
+}
@@ -0,0 +1,271 @@
+package v1
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/user/normark/internal/entity"
+	"go.uber.org/zap"
+)
+
+func TestParseUUIDParam(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	id := uuid.New()
+
+	tests := []struct {
+		name    string
+		param   string
+		status  int
+		reached bool
+	}{
+		{name: "valid", param: id.String(), status: http.StatusOK, reached: true},
+		{name: "malformed", param: "not-a-uuid", status: http.StatusBadRequest},
+		{name: "oversized", param: id.String() + strings.Repeat("0", 200), status: http.StatusBadRequest},
+		{name: "truncated", param: id.String()[:35], status: http.StatusBadRequest},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got uuid.UUID
+			reached := false
+
+			router := gin.New()
+			router.GET("/items/:itemId", ParseUUIDParam("itemId"), func(c *gin.Context) {
+				reached = true
+				got = uuidParam(c, "itemId")
+				c.Status(http.StatusOK)
+			})
+
+			rec := httptest.NewRecorder()
+			router.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/items/"+tt.param, nil))
+
+			if rec.Code != tt.status {
+				t.Fatalf("status = %d, want %d; body %s", rec.Code, tt.status, rec.Body)
+			}
+			if reached != tt.reached {
+				t.Fatalf("handler reached = %v, want %v", reached, tt.reached)
+			}
+			if tt.reached && got != id {
+				t.Errorf("parsed id = %s, want %s", got, id)
+			}
+			if !tt.reached && !strings.Contains(rec.Body.String(), "invalid itemId") {
+				t.Errorf("body = %s, want it to name the parameter", rec.Body)
+			}
+		})
+	}
+}
+
+func TestMalformedPathUUIDsRejectedBeforeHandlers(t *testing.T) {
+	journalID := uuid.New()
+	access := &fakeJournalAccess{owned: map[uuid.UUID]bool{journalID: true}}
+	journal := "/api/v1/journals/" + journalID.String()
+
+	tests := []struct {
+		name   string
+		method string
+		path   string
+		param  string
+	}{
+		{name: "journal", method: http.MethodGet, path: "/api/v1/journals/not-a-uuid", param: "id"},
+		{name: "journal of entries", method: http.MethodGet, path: "/api/v1/journals/not-a-uuid/entries", param: "id"},
+		{name: "entry", method: http.MethodGet, path: journal + "/entries/not-a-uuid", param: "entryId"},
+		{name: "entry notes", method: http.MethodGet, path: journal + "/entries/not-a-uuid/notes", param: "entryId"},
+		{name: "entry exits", method: http.MethodGet, path: journal + "/entries/not-a-uuid/exits", param: "entryId"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// No services: reaching a handler would fail the request.
+			router := newTestRouter(t, access, testServices{})
+
+			rec := doRequest(router, tt.method, tt.path, "")
+			if rec.Code != http.StatusBadRequest {
+				t.Fatalf("status = %d, want %d; body %s", rec.Code, http.StatusBadRequest, rec.Body)
+			}
+			if !strings.Contains(rec.Body.String(), "invalid "+tt.param) {
+				t.Errorf("body = %s, want it to name %s", rec.Body, tt.param)
+			}
+		})
+	}
+}
+
+func TestParsePagination(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	tests := []struct {
+		name       string
+		query      string
+		strict     bool
+		wantStatus int
+		wantLimit  int
+		wantOffset int
+	}{
+		{"defaults", "", true, http.StatusOK, defaultPageSize, 0},
+		{"valid", "?limit=50&offset=100", true, http.StatusOK, 50, 100},
+		{"largest page", "?limit=100", true, http.StatusOK, maxPageSize, 0},
+		{"strict non-numeric limit", "?limit=ten", true, http.StatusBadRequest, 0, 0},
+		{"strict zero limit", "?limit=0", true, http.StatusBadRequest, 0, 0},
+		{"strict limit above max", "?limit=101", true, http.StatusBadRequest, 0, 0},
+		{"strict negative offset", "?offset=-1", true, http.StatusBadRequest, 0, 0},
+		{"strict non-numeric offset", "?offset=next", true, http.StatusBadRequest, 0, 0},
+		{"lenient non-numeric limit", "?limit=ten&offset=40", false, http.StatusOK, defaultPageSize, 40},
+		{"lenient limit above max", "?limit=101", false, http.StatusOK, defaultPageSize, 0},
+		{"lenient negative offset", "?limit=10&offset=-1", false, http.StatusOK, 10, 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var limit, offset int
+			router := gin.New()
+			router.GET("/items", ParsePagination(tt.strict), func(c *gin.Context) {
+				limit, offset = pagination(c)
+				c.Status(http.StatusOK)
+			})
+
+			rec := httptest.NewRecorder()
+			router.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/items"+tt.query, nil))
+
+			if rec.Code != tt.wantStatus {
+				t.Fatalf("status = %d, want %d; body %s", rec.Code, tt.wantStatus, rec.Body)
+			}
+			if limit != tt.wantLimit || offset != tt.wantOffset {
+				t.Errorf("limit, offset = %d, %d, want %d, %d", limit, offset, tt.wantLimit, tt.wantOffset)
+			}
+		})
+	}
+}
+
+// pagedJournalService records the page the journal list asks for.
+type pagedJournalService struct {
+	TradingJournalService
+	limit, offset int
+}
+
+func (s *pagedJournalService) GetUserJournals(_ context.Context, _ uuid.UUID, limit, offset int, _ bool, _ string) ([]*entity.TradingJournal, error) {
+	s.limit, s.offset = limit, offset
+	return nil, nil
+}
+
+func (s *pagedJournalService) CountUserJournals(context.Context, uuid.UUID, bool, string) (int, error) {
+	return 0, nil
+}
+
+func TestListStrictQuery(t *testing.T) {
+	tests := []struct {
+		name       string
+		strict     bool
+		query      string
+		wantStatus int
+		wantLimit  int
+	}{
+		{"lenient falls back to the default", false, "?limit=abc", http.StatusOK, defaultPageSize},
+		{"strict rejects", true, "?limit=abc", http.StatusBadRequest, 0},
+		{"strict accepts a valid page", true, "?limit=5", http.StatusOK, 5},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			journals := &pagedJournalService{}
+			handler := newTestHandler(t, zap.NewNop(), "production", &fakeJournalAccess{}, testServices{journals: journals})
+			handler.strictQuery = tt.strict
+
+			rec := doRequest(handler.InitRoutes(), http.MethodGet, "/api/v1/journals"+tt.query, "")
+			if rec.Code != tt.wantStatus {
+				t.Fatalf("status = %d, want %d; body %s", rec.Code, tt.wantStatus, rec.Body)
+			}
+			if journals.limit != tt.wantLimit {
+				t.Errorf("limit passed to service = %d, want %d", journals.limit, tt.wantLimit)
+			}
+		})
+	}
+}
+
+func TestTimezoneQuery(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	tests := []struct {
+		query   string
+		want    string
+		wantErr bool
+	}{
+		{"", "UTC", false},
+		{"?timezone=UTC", "UTC", false},
+		{"?timezone=Asia/Tokyo", "Asia/Tokyo", false},
+		{"?timezone=America/New_York", "America/New_York", false},
+		{"?timezone=Local", "", true},
+		{"?timezone=Mars/Base", "", true},
+		{"?timezone=%2B09:00", "", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.query, func(t *testing.T) {
+			c, _ := gin.CreateTestContext(httptest.NewRecorder())
+			c.Request = httptest.NewRequest(http.MethodGet, "/"+tt.query, nil)
+
+			loc, err := timezoneQuery(c)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("timezoneQuery() error = %v, want error %v", err, tt.wantErr)
+			}
+			if err == nil && loc.String() != tt.want {
+				t.Errorf("timezoneQuery() = %s, want %s", loc, tt.want)
+			}
+		})
+	}
+}
+
+func TestPeriodQuery(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	tests := []struct {
+		query   string
+		want    entity.Period
+		wantErr string
+	}{
+		{
+			query: "?p_start=2026-01-01&p_end=2026-01-31",
+			want: entity.Period{
+				Start: time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC),
+				End:   time.Date(2026, 1, 31, 23, 59, 59, 999999000, time.UTC),
+			},
+		},
+		{
+			query: "?p_start=2026-01-01&p_end=2026-01-01",
+			want: entity.Period{
+				Start: time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC),
+				End:   time.Date(2026, 1, 1, 23, 59, 59, 999999000, time.UTC),
+			},
+		},
+		{query: "?p_start=2026-01-01", wantErr: "p_start and p_end are required"},
+		{query: "?p_end=2026-01-31", wantErr: "p_start and p_end are required"},
+		{query: "?p_start=01/01/2026&p_end=2026-01-31", wantErr: "invalid p_start"},
+		{query: "?p_start=2026-01-01&p_end=2026-01-31T00:00:00Z", wantErr: "invalid p_end"},
+		{query: "?p_start=2026-02-01&p_end=2026-01-31", wantErr: "p_end is before p_start"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.query, func(t *testing.T) {
+			c, _ := gin.CreateTestContext(httptest.NewRecorder())
+			c.Request = httptest.NewRequest(http.MethodGet, "/"+tt.query, nil)
+
+			got, err := periodQuery(c, "p")
+			if tt.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+					t.Fatalf("periodQuery() error = %v, want %q", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("periodQuery() error = %v", err)
+			}
+			if !got.Start.Equal(tt.want.Start) || !got.End.Equal(tt.want.End) {
+				t.Errorf("periodQuery() = %v to %v, want %v to %v", got.Start, got.End, tt.want.Start, tt.want.End)
+			}
+		})
+	}
+}
@@ -3,6 +3,8 @@ package v1
 import (
 	"net"
 	"net/http"
+	"net/netip"
+	"strings"
 	"sync"
 
 	"github.com/gin-gonic/gin"
@@ -22,6 +24,8 @@ type RateLimiter struct {
 	mu       sync.RWMutex
 	rps      int
 	burst    int
+	exempt   []netip.Prefix
+	proxies  []netip.Prefix
 	logger   *zap.Logger
 }
 
@@ -30,10 +34,61 @@ func NewRateLimiter(cfg *config.RateLimit, logger *zap.Logger) *RateLimiter {
 		visitors: make(map[string]*rate.Limiter),
 		rps:      cfg.RequestsPerSecond,
 		burst:    cfg.Burst,
+		exempt:   parsePrefixes(cfg.ExemptCIDRs),
+		proxies:  parsePrefixes(cfg.TrustedProxies),
 		logger:   logger,
 	}
 }
 
+// parsePrefixes skips malformed ranges, which config.Load has already
+// rejected.
+func parsePrefixes(cidrs []string) []netip.Prefix {
+	prefixes := make([]netip.Prefix, 0, len(cidrs))
+	for _, cidr := range cidrs {
+		if prefix, err := netip.ParsePrefix(cidr); err == nil {
+			prefixes = append(prefixes, prefix)
+		}
+	}
+
+	return prefixes
+}
+
+// trustedProxies returns the trusted proxy ranges in the form
+// gin.Engine.SetTrustedProxies takes, or nil when none are configured.
+func (rl *RateLimiter) trustedProxies() []string {
+	if len(rl.proxies) == 0 {
+		return nil
+	}
+
+	proxies := make([]string, 0, len(rl.proxies))
+	for _, prefix := range rl.proxies {
+		proxies = append(proxies, prefix.String())
+	}
+
+	return proxies
+}
+
+// inPrefixes reports whether ip is a valid address inside one of prefixes.
+func inPrefixes(prefixes []netip.Prefix, ip string) bool {
+	if len(prefixes) == 0 {
+		return false
+	}
+
+	addr, err := netip.ParseAddr(ip)
+	if err != nil {
+		return false
+	}
+	addr = addr.Unmap()
+
+	for _, prefix := range prefixes {
+		if prefix.Contains(addr) {
+			return true
+		}
+	}
+
+	return false
+}
+
 func (rl *RateLimiter) getVisitor(ip string) *rate.Limiter {
 	rl.mu.Lock()
 	defer rl.mu.Unlock()
@@ -56,32 +111,58 @@ func (rl *RateLimiter) cleanupVisitors() {
 	}
 }
 
+// getIP returns the client IP. Forwarding headers are only honored when the
+// immediate peer is a trusted proxy; otherwise a client could set them to
+// any address it likes.
 func (rl *RateLimiter) getIP(c *gin.Context) string {
-	forwarded := c.GetHeader(headerXForwardedFor)
-	if forwarded != "" {
-		ip, _, err := net.SplitHostPort(forwarded)
-		if err == nil {
-			return ip
+	peer := stripPort(c.Request.RemoteAddr)
+	if !inPrefixes(rl.proxies, peer) {
+		return peer
+	}
+
+	// Each proxy appends the address it received the request from, so the
+	// client is the rightmost hop that is not itself a trusted proxy. Hops
+	// further left were supplied by the client and cannot be trusted.
+	if forwarded := c.GetHeader(headerXForwardedFor); forwarded != "" {
+		hops := strings.Split(forwarded, ",")
+		for i := len(hops) - 1; i >= 0; i-- {
+			hop := stripPort(strings.TrimSpace(hops[i]))
+			if hop == "" {
+				continue
+			}
+			if i == 0 || !inPrefixes(rl.proxies, hop) {
+				return hop
+			}
 		}
-		return forwarded
 	}
 
-	realIP := c.GetHeader(headerXRealIP)
-	if realIP != "" {
-		return realIP
+	if realIP := strings.TrimSpace(c.GetHeader(headerXRealIP)); realIP != "" {
+		return stripPort(realIP)
 	}
 
-	ip, _, err := net.SplitHostPort(c.Request.RemoteAddr)
-	if err != nil {
-		return c.Request.RemoteAddr
+	return peer
+}
+
+// stripPort drops the port from a host:port address and returns anything
+// else unchanged.
+func stripPort(addr string) string {
+	if host, _, err := net.SplitHostPort(addr); err == nil {
+		return host
 	}
 
-	return ip
+	return addr
 }
 
 func (rl *RateLimiter) Limit() gin.HandlerFunc {
 	return func(c *gin.Context) {
 		ip := rl.getIP(c)
+
+		if inPrefixes(rl.exempt, ip) {
+			rl.logger.Debug("rate limit exemption applied", zap.String("ip", ip))
+			c.Next()
+			return
+		}
+
 		limiter := rl.getVisitor(ip)
 
 		if !limiter.Allow() {
@@ -0,0 +1,125 @@
+package v1
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/user/normark/internal/config"
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+	"go.uber.org/zap/zaptest/observer"
+)
+
+func TestRateLimitExemptCIDRs(t *testing.T) {
+	exempt := []string{"10.0.0.0/8", "fd00::/8"}
+
+	tests := []struct {
+		name       string
+		remoteAddr string
+		wantStatus int
+		wantLogged bool
+	}{
+		{"exempt ipv4", "10.1.2.3:5000", http.StatusOK, true},
+		{"exempt ipv4-mapped ipv6", "[::ffff:10.1.2.3]:5000", http.StatusOK, true},
+		{"exempt ipv6", "[fd00::1]:5000", http.StatusOK, true},
+		{"outside the ranges", "192.0.2.1:5000", http.StatusTooManyRequests, false},
+		{"just outside an ipv4 range", "11.0.0.1:5000", http.StatusTooManyRequests, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gin.SetMode(gin.TestMode)
+			core, logs := observer.New(zapcore.DebugLevel)
+			limiter := NewRateLimiter(&config.RateLimit{RequestsPerSecond: 1, Burst: 1, ExemptCIDRs: exempt}, zap.New(core))
+			router := gin.New()
+			router.Use(limiter.Limit())
+			router.GET("/ping", func(c *gin.Context) { c.Status(http.StatusOK) })
+
+			// The burst allows one request, so only an exempt client gets a
+			// second one through.
+			var rec *httptest.ResponseRecorder
+			for range 2 {
+				req := httptest.NewRequest(http.MethodGet, "/ping", nil)
+				req.RemoteAddr = tt.remoteAddr
+				rec = httptest.NewRecorder()
+				router.ServeHTTP(rec, req)
+			}
+
+			if rec.Code != tt.wantStatus {
+				t.Errorf("second request status = %d, want %d", rec.Code, tt.wantStatus)
+			}
+			if logged := logs.FilterMessage("rate limit exemption applied").Len() > 0; logged != tt.wantLogged {
+				t.Errorf("exemption logged = %v, want %v", logged, tt.wantLogged)
+			}
+		})
+	}
+}
+
+func TestRateLimiterClientIP(t *testing.T) {
+	proxies := []string{"10.0.0.0/8"}
+
+	tests := []struct {
+		name         string
+		remoteAddr   string
+		forwardedFor string
+		realIP       string
+		wantIP       string
+	}{
+		{"direct client", "192.0.2.1:5000", "", "", "192.0.2.1"},
+		{"spoofed forwarded-for from untrusted peer", "192.0.2.1:5000", "203.0.113.9", "", "192.0.2.1"},
+		{"spoofed real ip from untrusted peer", "192.0.2.1:5000", "", "203.0.113.9", "192.0.2.1"},
+		{"client behind trusted proxy", "10.0.0.5:5000", "198.51.100.7", "", "198.51.100.7"},
+		{"client-supplied hops are skipped", "10.0.0.5:5000", "203.0.113.9, 198.51.100.7", "", "198.51.100.7"},
+		{"chain of trusted proxies", "10.0.0.5:5000", "203.0.113.9, 198.51.100.7, 10.0.0.6", "", "198.51.100.7"},
+		{"only proxies in the chain", "10.0.0.5:5000", "10.0.0.7, 10.0.0.6", "", "10.0.0.7"},
+		{"hop with port", "10.0.0.5:5000", "198.51.100.7:4321", "", "198.51.100.7"},
+		{"real ip from trusted proxy", "10.0.0.5:5000", "", "198.51.100.7", "198.51.100.7"},
+		{"trusted proxy without headers", "10.0.0.5:5000", "", "", "10.0.0.5"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			limiter := NewRateLimiter(&config.RateLimit{RequestsPerSecond: 1, Burst: 1, TrustedProxies: proxies}, zap.NewNop())
+
+			req := httptest.NewRequest(http.MethodGet, "/ping", nil)
+			req.RemoteAddr = tt.remoteAddr
+			if tt.forwardedFor != "" {
+				req.Header.Set(headerXForwardedFor, tt.forwardedFor)
+			}
+			if tt.realIP != "" {
+				req.Header.Set(headerXRealIP, tt.realIP)
+			}
+			c, _ := gin.CreateTestContext(httptest.NewRecorder())
+			c.Request = req
+
+			if got := limiter.getIP(c); got != tt.wantIP {
+				t.Errorf("getIP() = %q, want %q", got, tt.wantIP)
+			}
+		})
+	}
+}
+
+func TestRateLimitIgnoresSpoofedForwardedFor(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	limiter := NewRateLimiter(&config.RateLimit{RequestsPerSecond: 1, Burst: 1, TrustedProxies: []string{"10.0.0.0/8"}}, zap.NewNop())
+	router := gin.New()
+	router.Use(limiter.Limit())
+	router.GET("/ping", func(c *gin.Context) { c.Status(http.StatusOK) })
+
+	// A new forwarded address per request would get a fresh limiter each
+	// time if the header were trusted.
+	var rec *httptest.ResponseRecorder
+	for _, spoofed := range []string{"203.0.113.1", "203.0.113.2"} {
+		req := httptest.NewRequest(http.MethodGet, "/ping", nil)
+		req.RemoteAddr = "192.0.2.1:5000"
+		req.Header.Set(headerXForwardedFor, spoofed)
+		rec = httptest.NewRecorder()
+		router.ServeHTTP(rec, req)
+	}
+
+	if rec.Code != http.StatusTooManyRequests {
+		t.Errorf("second request status = %d, want %d", rec.Code, http.StatusTooManyRequests)
+	}
+}
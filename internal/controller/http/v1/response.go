@@ -0,0 +1,66 @@
+package v1
+
+import (
+	"mime"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	"github.com/user/normark/internal/dto"
+)
+
+// envelopeProfile is the Accept profile a client sends to get successful
+// responses wrapped in an envelope:
+//
+//	Accept: application/json; profile="envelope"
+const envelopeProfile = "envelope"
+
+// envelope wraps a successful payload. For paginated lists, data is the list
+// itself and the pagination moves to meta.
+type envelope struct {
+	Data any           `json:"data"`
+	Meta *envelopeMeta `json:"meta,omitempty"`
+}
+
+type envelopeMeta struct {
+	Pagination *dto.Pagination `json:"pagination,omitempty"`
+}
+
+// respond writes a successful JSON response, wrapped in an envelope if the
+// client asked for one. Responses are unwrapped by default so existing
+// clients keep working.
+func respond(c *gin.Context, status int, payload any) {
+	c.Header("Vary", "Accept")
+
+	if !wantsEnvelope(c.GetHeader("Accept")) {
+		c.JSON(status, payload)
+		return
+	}
+
+	env := envelope{Data: payload}
+	if paged, ok := payload.(dto.Paged); ok {
+		page := paged.Page()
+		env.Data = paged.Items()
+		env.Meta = &envelopeMeta{Pagination: &page}
+	}
+
+	c.JSON(status, env)
+}
+
+// wantsEnvelope reports whether an Accept header asks for a JSON media type
+// with the envelope profile.
+func wantsEnvelope(accept string) bool {
+	for _, part := range strings.Split(accept, ",") {
+		mediaType, params, err := mime.ParseMediaType(strings.TrimSpace(part))
+		if err != nil {
+			continue
+		}
+		if mediaType != "application/json" && mediaType != "*/*" {
+			continue
+		}
+		if params["profile"] == envelopeProfile {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,167 @@
+package v1
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/user/normark/internal/dto"
+	"github.com/user/normark/internal/entity"
+)
+
+const envelopeAccept = `application/json; profile="envelope"`
+
+func TestWantsEnvelope(t *testing.T) {
+	tests := []struct {
+		accept string
+		want   bool
+	}{
+		{"", false},
+		{"application/json", false},
+		{envelopeAccept, true},
+		{`application/json;profile=envelope`, true},
+		{`*/*; profile="envelope"`, true},
+		{`text/html, application/json; profile="envelope"`, true},
+		{`text/html; profile="envelope"`, false},
+		{`application/json; profile="other"`, false},
+		{`application/json; profile="envelope`, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.accept, func(t *testing.T) {
+			if got := wantsEnvelope(tt.accept); got != tt.want {
+				t.Errorf("wantsEnvelope(%q) = %v, want %v", tt.accept, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRespond(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	journals := &dto.TradingJournalListResponse{
+		Journals:   []*dto.TradingJournalResponse{{Name: "Swing"}},
+		Pagination: dto.NewPagination(3, 1, 0),
+	}
+
+	tests := []struct {
+		name    string
+		accept  string
+		payload any
+		want    string
+	}{
+		{
+			name:    "unwrapped object",
+			payload: gin.H{"message": "ok"},
+			want:    `{"message":"ok"}`,
+		},
+		{
+			name:    "wrapped object",
+			accept:  envelopeAccept,
+			payload: gin.H{"message": "ok"},
+			want:    `{"data":{"message":"ok"}}`,
+		},
+		{
+			name:    "unwrapped list keeps pagination inline",
+			payload: journals,
+			want:    `}],"total":3,"limit":1,`,
+		},
+		{
+			name:    "wrapped list moves pagination to meta",
+			accept:  envelopeAccept,
+			payload: journals,
+			want:    `"meta":{"pagination":{"total":3,"limit":1,"offset":0,"total_pages":3,"current_page":1,"has_next":true,"has_prev":false}}`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			router := gin.New()
+			router.GET("/", func(c *gin.Context) { respond(c, http.StatusCreated, tt.payload) })
+
+			req := httptest.NewRequest(http.MethodGet, "/", nil)
+			if tt.accept != "" {
+				req.Header.Set("Accept", tt.accept)
+			}
+			rec := httptest.NewRecorder()
+			router.ServeHTTP(rec, req)
+
+			if rec.Code != http.StatusCreated {
+				t.Fatalf("status = %d, want %d", rec.Code, http.StatusCreated)
+			}
+			if vary := rec.Header().Get("Vary"); vary != "Accept" {
+				t.Errorf("Vary = %q, want %q", vary, "Accept")
+			}
+			if !strings.Contains(rec.Body.String(), tt.want) {
+				t.Errorf("body %s does not contain %s", rec.Body, tt.want)
+			}
+		})
+	}
+}
+
+// envelopeJournalService lists a single journal.
+type envelopeJournalService struct {
+	TradingJournalService
+}
+
+func (s *envelopeJournalService) GetUserJournals(context.Context, uuid.UUID, int, int, bool, string) ([]*entity.TradingJournal, error) {
+	return []*entity.TradingJournal{{ID: uuid.New(), Name: "Swing"}}, nil
+}
+
+func (s *envelopeJournalService) CountUserJournals(context.Context, uuid.UUID, bool, string) (int, error) {
+	return 1, nil
+}
+
+func TestListJournalsEnvelope(t *testing.T) {
+	tests := []struct {
+		name       string
+		accept     string
+		wantPrefix string
+	}{
+		{"unwrapped by default", "", `{"journals":[`},
+		{"wrapped on request", envelopeAccept, `{"data":[`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			router := newTestRouter(t, &fakeJournalAccess{}, testServices{journals: &envelopeJournalService{}})
+
+			req := httptest.NewRequest(http.MethodGet, "/api/v1/journals", nil)
+			req.Header.Set("Authorization", "Bearer token")
+			if tt.accept != "" {
+				req.Header.Set("Accept", tt.accept)
+			}
+			rec := httptest.NewRecorder()
+			router.ServeHTTP(rec, req)
+
+			if rec.Code != http.StatusOK {
+				t.Fatalf("status = %d, want %d; body %s", rec.Code, http.StatusOK, rec.Body)
+			}
+			if !strings.HasPrefix(rec.Body.String(), tt.wantPrefix) {
+				t.Errorf("body %s does not start with %s", rec.Body, tt.wantPrefix)
+			}
+		})
+	}
+
+	t.Run("errors are never wrapped", func(t *testing.T) {
+		router := newTestRouter(t, &fakeJournalAccess{}, testServices{journals: &envelopeJournalService{}})
+
+		req := httptest.NewRequest(http.MethodGet, "/api/v1/journals/not-a-uuid", nil)
+		req.Header.Set("Authorization", "Bearer token")
+		req.Header.Set("Accept", envelopeAccept)
+		rec := httptest.NewRecorder()
+		router.ServeHTTP(rec, req)
+
+		if rec.Code != http.StatusBadRequest {
+			t.Fatalf("status = %d, want %d; body %s", rec.Code, http.StatusBadRequest, rec.Body)
+		}
+		if strings.Contains(rec.Body.String(), `"data"`) {
+			t.Errorf("error body %s is wrapped", rec.Body)
+		}
+		decodeErrorCode(t, rec)
+	})
+}
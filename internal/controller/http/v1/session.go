@@ -0,0 +1,121 @@
+package v1
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/cockroachdb/errors"
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/user/normark/internal/dto/mapper"
+	"github.com/user/normark/internal/entity"
+	"go.uber.org/zap"
+)
+
+type SessionService interface {
+	ListSessions(ctx context.Context, userID uuid.UUID) ([]*entity.Session, error)
+	RevokeSession(ctx context.Context, id uuid.UUID, userID uuid.UUID) error
+}
+
+type SessionHandler struct {
+	sessionService SessionService
+}
+
+func NewSessionHandler(
+	sessionService SessionService,
+) *SessionHandler {
+	return &SessionHandler{
+		sessionService: sessionService,
+	}
+}
+
+func (h *SessionHandler) InitRoutes(group *gin.RouterGroup) {
+	group.GET("/sessions", h.List)
+	group.DELETE("/sessions/:id", h.Revoke)
+}
+
+// List godoc
+// @Summary      List active sessions
+// @Description  List the authenticated user's signed-in devices, most recently used first
+// @Tags         Users
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Success      200 {object} dto.SessionListResponse "Successfully retrieved sessions"
+// @Failure      401 {object} ErrorResponse "Unauthorized - missing or invalid token"
+// @Failure      500 {object} ErrorResponse "Internal server error"
+// @Router       /api/v1/users/me/sessions [get]
+func (h *SessionHandler) List(c *gin.Context) {
+	userID, exists := c.Get("userID")
+	if !exists {
+		loggerFromContext(c).Error("user id not found in context")
+		newErrorResponse(c, http.StatusUnauthorized, "unauthorized")
+		return
+	}
+
+	uid, ok := userID.(uuid.UUID)
+	if !ok {
+		loggerFromContext(c).Error("invalid user id type in context")
+		newErrorResponse(c, http.StatusInternalServerError, "internal server error")
+		return
+	}
+
+	sessions, err := h.sessionService.ListSessions(c.Request.Context(), uid)
+	if err != nil {
+		loggerFromContext(c).Error("failed to list sessions", zap.Error(err))
+		newErrorResponse(c, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	respond(c, http.StatusOK, mapper.ToSessionListResponse(sessions))
+}
+
+// Revoke godoc
+// @Summary      Revoke a session
+// @Description  Sign out one device. Its refresh token stops working; other sessions are unaffected. Access tokens already issued stay valid until they expire.
+// @Tags         Users
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Param        id path string true "Session ID (UUID)"
+// @Success      200 {object} map[string]string "Successfully revoked session"
+// @Failure      400 {object} ErrorResponse "Invalid session ID"
+// @Failure      401 {object} ErrorResponse "Unauthorized - missing or invalid token"
+// @Failure      404 {object} ErrorResponse "Session not found"
+// @Failure      500 {object} ErrorResponse "Internal server error"
+// @Router       /api/v1/users/me/sessions/{id} [delete]
+func (h *SessionHandler) Revoke(c *gin.Context) {
+	idStr := c.Param("id")
+	id, err := uuid.Parse(idStr)
+	if err != nil {
+		loggerFromContext(c).Error("invalid session id", zap.Error(err))
+		newErrorResponse(c, http.StatusBadRequest, "invalid session id")
+		return
+	}
+
+	userID, exists := c.Get("userID")
+	if !exists {
+		loggerFromContext(c).Error("user id not found in context")
+		newErrorResponse(c, http.StatusUnauthorized, "unauthorized")
+		return
+	}
+
+	uid, ok := userID.(uuid.UUID)
+	if !ok {
+		loggerFromContext(c).Error("invalid user id type in context")
+		newErrorResponse(c, http.StatusInternalServerError, "internal server error")
+		return
+	}
+
+	if err := h.sessionService.RevokeSession(c.Request.Context(), id, uid); err != nil {
+		loggerFromContext(c).Error("failed to revoke session", zap.Error(err))
+		if errors.Is(err, entity.ErrSessionNotFound) {
+			newErrorResponse(c, http.StatusNotFound, "session not found")
+			return
+		}
+		newErrorResponse(c, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	respond(c, http.StatusOK, gin.H{"message": "session revoked successfully"})
+}
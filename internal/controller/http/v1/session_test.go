@@ -0,0 +1,87 @@
+package v1
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"slices"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/user/normark/internal/dto"
+	"github.com/user/normark/internal/entity"
+)
+
+// memorySessionService keeps sessions per user and revokes them like the
+// user service: only the owner can revoke a session.
+type memorySessionService struct {
+	UserService
+	sessions []*entity.Session
+}
+
+func (s *memorySessionService) ListSessions(_ context.Context, userID uuid.UUID) ([]*entity.Session, error) {
+	var sessions []*entity.Session
+	for _, session := range s.sessions {
+		if session.UserID == userID {
+			sessions = append(sessions, session)
+		}
+	}
+	return sessions, nil
+}
+
+func (s *memorySessionService) RevokeSession(_ context.Context, id uuid.UUID, userID uuid.UUID) error {
+	for i, session := range s.sessions {
+		if session.ID == id && session.UserID == userID {
+			s.sessions = append(s.sessions[:i], s.sessions[i+1:]...)
+			return nil
+		}
+	}
+	return entity.ErrSessionNotFound
+}
+
+func TestSessionHandlers(t *testing.T) {
+	phone := entity.NewSession(testUserID, "phone", "203.0.113.7")
+	laptop := entity.NewSession(testUserID, "laptop", "203.0.113.8")
+	foreign := entity.NewSession(uuid.New(), "tablet", "198.51.100.1")
+
+	tests := []struct {
+		name      string
+		revoke    string
+		status    int
+		remaining []string
+	}{
+		{name: "revoke one device", revoke: phone.ID.String(), status: http.StatusOK, remaining: []string{"laptop"}},
+		{name: "session of another user", revoke: foreign.ID.String(), status: http.StatusNotFound, remaining: []string{"phone", "laptop"}},
+		{name: "unknown session", revoke: uuid.NewString(), status: http.StatusNotFound, remaining: []string{"phone", "laptop"}},
+		{name: "invalid session id", revoke: "not-a-uuid", status: http.StatusBadRequest, remaining: []string{"phone", "laptop"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			users := &memorySessionService{sessions: []*entity.Session{phone, laptop, foreign}}
+			router := newTestRouter(t, &fakeJournalAccess{}, testServices{users: users})
+
+			rec := doRequest(router, http.MethodDelete, "/api/v1/users/me/sessions/"+tt.revoke, "")
+			if rec.Code != tt.status {
+				t.Fatalf("revoke status = %d, want %d; body %s", rec.Code, tt.status, rec.Body)
+			}
+
+			rec = doRequest(router, http.MethodGet, "/api/v1/users/me/sessions", "")
+			if rec.Code != http.StatusOK {
+				t.Fatalf("list status = %d, want %d; body %s", rec.Code, http.StatusOK, rec.Body)
+			}
+
+			var response dto.SessionListResponse
+			if err := json.Unmarshal(rec.Body.Bytes(), &response); err != nil {
+				t.Fatalf("decode response: %v", err)
+			}
+			got := make([]string, len(response.Sessions))
+			for i, session := range response.Sessions {
+				got[i] = session.UserAgent
+			}
+			if !slices.Equal(got, tt.remaining) {
+				t.Errorf("sessions = %v, want %v", got, tt.remaining)
+			}
+		})
+	}
+}
@@ -2,107 +2,197 @@ package v1
 
 import (
 	"context"
+	"fmt"
+	"io"
+	"math"
 	"net/http"
 	"strconv"
+	"strings"
 
+	"github.com/cockroachdb/errors"
 	"github.com/gin-gonic/gin"
 	"github.com/go-playground/validator/v10"
 	"github.com/google/uuid"
 	"github.com/user/normark/internal/dto"
 	"github.com/user/normark/internal/dto/mapper"
 	"github.com/user/normark/internal/entity"
+	"github.com/user/normark/internal/importer"
+	"github.com/user/normark/internal/types"
 	"go.uber.org/zap"
 )
 
+const headerIdempotencyKey = "Idempotency-Key"
+
+// maxImportFileSize caps the size of an uploaded platform export.
+const maxImportFileSize = 5 << 20
+
 type TradingJournalService interface {
 	Create(ctx context.Context, userID uuid.UUID, req *dto.CreateTradingJournalRequest) (*entity.TradingJournal, error)
+	CreateDeduplicated(ctx context.Context, userID uuid.UUID, req *dto.CreateTradingJournalRequest) (*entity.TradingJournal, bool, error)
 	GetByID(ctx context.Context, id uuid.UUID) (*entity.TradingJournal, error)
-	GetByIDWithEntries(ctx context.Context, id uuid.UUID) (*entity.TradingJournal, error)
-	GetUserJournals(ctx context.Context, userID uuid.UUID, limit, offset int) ([]*entity.TradingJournal, error)
+	GetByIDWithEntries(ctx context.Context, id uuid.UUID, limit, offset int) (*entity.TradingJournal, int, error)
+	GetUserJournals(ctx context.Context, userID uuid.UUID, limit, offset int, includeArchived bool, broker string) ([]*entity.TradingJournal, error)
 	Update(ctx context.Context, journal *entity.TradingJournal) error
+	SetArchived(ctx context.Context, id uuid.UUID, userID uuid.UUID, archived bool) (*entity.TradingJournal, error)
+	SetLocked(ctx context.Context, id uuid.UUID, userID uuid.UUID, locked bool) (*entity.TradingJournal, error)
+	RecomputeSummary(ctx context.Context, id uuid.UUID, userID uuid.UUID) (*entity.JournalSummary, error)
 	Delete(ctx context.Context, id uuid.UUID, userID uuid.UUID) error
-	CountUserJournals(ctx context.Context, userID uuid.UUID) (int, error)
+	CountUserJournals(ctx context.Context, userID uuid.UUID, includeArchived bool, broker string) (int, error)
+	CountEntries(ctx context.Context, id uuid.UUID) (int, error)
 	VerifyAccess(ctx context.Context, journalID uuid.UUID, userID uuid.UUID) (bool, error)
+	StreamExport(ctx context.Context, id uuid.UUID, userID uuid.UUID, w io.Writer) error
+	Import(ctx context.Context, userID uuid.UUID, doc *dto.JournalExportDocument) (*entity.TradingJournal, error)
+	PreviewImport(ctx context.Context, userID uuid.UUID, doc *dto.JournalExportDocument, rowErrs map[int]error) (*entity.ImportPreview, error)
+	ImportEntries(ctx context.Context, id uuid.UUID, userID uuid.UUID, reqs []*dto.CreateTradingJournalEntryRequest, rowErrs map[int]error) (*entity.EntryImport, error)
 }
 
 type TradingJournalHandler struct {
 	journalService TradingJournalService
-	logger         *zap.Logger
 	validate       *validator.Validate
+	strictQuery    bool
 }
 
 func NewTradingJournalHandler(
 	journalService TradingJournalService,
-	logger *zap.Logger,
 	validate *validator.Validate,
+	strictQuery bool,
 ) *TradingJournalHandler {
 	return &TradingJournalHandler{
 		journalService: journalService,
-		logger:         logger,
 		validate:       validate,
+		strictQuery:    strictQuery,
 	}
 }
 
 func (h *TradingJournalHandler) InitRoutes(group *gin.RouterGroup) {
 	group.POST("", h.Create)
-	group.GET("", h.List)
-	group.GET("/:id", h.GetByID)
-	group.GET("/:id/with-entries", h.GetByIDWithEntries)
-	group.PUT("/:id", h.Update)
-	group.DELETE("/:id", h.Delete)
+	group.GET("", ParsePagination(h.strictQuery), h.List)
+	group.POST("/import", h.Import)
+
+	journal := group.Group("/:id", ParseUUIDParam("id"))
+	journal.GET("", h.GetByID)
+	journal.GET("/with-entries", ParsePagination(h.strictQuery), h.GetByIDWithEntries)
+	journal.PUT("", h.Update)
+	journal.DELETE("", h.Delete)
+	journal.POST("/archive", h.Archive)
+	journal.POST("/unarchive", h.Unarchive)
+	journal.POST("/lock", h.Lock)
+	journal.POST("/unlock", h.Unlock)
+	journal.POST("/recompute-summary", h.RecomputeSummary)
+	journal.GET("/export", h.Export)
+	journal.POST("/import", h.ImportEntries)
 }
 
 // Create godoc
 // @Summary      Create a new trading journal
-// @Description  Create a new trading journal for the authenticated user
+// @Description  Create a new trading journal for the authenticated user. With dedupe=true or an Idempotency-Key header, an existing journal with the same name, ignoring case, is returned instead of creating a duplicate.
 // @Tags         Trading Journals
 // @Accept       json
 // @Produce      json
 // @Security     BearerAuth
 // @Param        request body dto.CreateTradingJournalRequest true "Trading journal details"
+// @Param        dedupe query bool false "Return the existing journal with the same name instead of creating a duplicate"
+// @Param        Idempotency-Key header string false "Enables the same deduplication as dedupe=true"
+// @Param        from_template query string false "Journal template ID (UUID) whose defaults fill any fields not set in the request"
+// @Success      200 {object} dto.TradingJournalResponse "Existing trading journal with the same name"
 // @Success      201 {object} dto.TradingJournalResponse "Successfully created trading journal"
+// @Header       201 {string} Location "URL of the created journal"
 // @Failure      400 {object} ErrorResponse "Invalid request body or validation failed"
 // @Failure      401 {object} ErrorResponse "Unauthorized - missing or invalid token"
+// @Failure      404 {object} ErrorResponse "Journal template not found"
+// @Failure      409 {object} ErrorResponse "A journal with this name already exists (when unique names are enforced)"
 // @Failure      500 {object} ErrorResponse "Internal server error"
 // @Router       /api/v1/journals [post]
 func (h *TradingJournalHandler) Create(c *gin.Context) {
 	var req dto.CreateTradingJournalRequest
 
 	if err := c.ShouldBindJSON(&req); err != nil {
-		h.logger.Error("failed to bind request", zap.Error(err))
+		loggerFromContext(c).Error("failed to bind request", zap.Error(err))
 		newErrorResponse(c, http.StatusBadRequest, "invalid request body")
 		return
 	}
 
 	if err := h.validate.Struct(&req); err != nil {
-		h.logger.Error("validation failed", zap.Error(err))
-		newErrorResponse(c, http.StatusBadRequest, err.Error())
+		loggerFromContext(c).Error("validation failed", zap.Error(err))
+		newErrorResponseFromError(c, http.StatusBadRequest, err)
 		return
 	}
 
 	userID, exists := c.Get("userID")
 	if !exists {
-		h.logger.Error("user id not found in context")
+		loggerFromContext(c).Error("user id not found in context")
 		newErrorResponse(c, http.StatusUnauthorized, "unauthorized")
 		return
 	}
 
 	uid, ok := userID.(uuid.UUID)
 	if !ok {
-		h.logger.Error("invalid user id type in context")
+		loggerFromContext(c).Error("invalid user id type in context")
 		newErrorResponse(c, http.StatusInternalServerError, "internal server error")
 		return
 	}
 
+	if templateIDStr := c.Query("from_template"); templateIDStr != "" {
+		templateID, err := uuid.Parse(templateIDStr)
+		if err != nil {
+			loggerFromContext(c).Error("invalid template id", zap.Error(err))
+			newErrorResponse(c, http.StatusBadRequest, "invalid template id")
+			return
+		}
+		req.TemplateID = &templateID
+	}
+
+	dedupe := c.Query("dedupe") == "true" || c.GetHeader(headerIdempotencyKey) != ""
+
+	if dedupe {
+		journal, created, err := h.journalService.CreateDeduplicated(c.Request.Context(), uid, &req)
+		if err != nil {
+			loggerFromContext(c).Error("failed to create trading journal", zap.Error(err))
+			if errors.Is(err, entity.ErrNotFound) {
+				newErrorResponse(c, http.StatusNotFound, "journal template not found")
+				return
+			}
+			if errors.Is(err, entity.ErrConflict) {
+				newErrorResponseFromError(c, http.StatusConflict, err)
+				return
+			}
+			newErrorResponse(c, http.StatusInternalServerError, err.Error())
+			return
+		}
+
+		status := http.StatusOK
+		if created {
+			status = http.StatusCreated
+			c.Header("Location", journalLocation(journal.ID))
+		}
+
+		respond(c, status, mapper.ToTradingJournalResponse(journal))
+		return
+	}
+
 	journal, err := h.journalService.Create(c.Request.Context(), uid, &req)
 	if err != nil {
-		h.logger.Error("failed to create trading journal", zap.Error(err))
+		loggerFromContext(c).Error("failed to create trading journal", zap.Error(err))
+		if errors.Is(err, entity.ErrNotFound) {
+			newErrorResponse(c, http.StatusNotFound, "journal template not found")
+			return
+		}
+		if errors.Is(err, entity.ErrConflict) {
+			newErrorResponseFromError(c, http.StatusConflict, err)
+			return
+		}
 		newErrorResponse(c, http.StatusInternalServerError, err.Error())
 		return
 	}
 
+	c.Header("Location", journalLocation(journal.ID))
 	response := mapper.ToTradingJournalResponse(journal)
-	c.JSON(http.StatusCreated, response)
+	respond(c, http.StatusCreated, response)
+}
+
+// journalLocation is the URL of a journal, sent as the Location of a 201.
+func journalLocation(id uuid.UUID) string {
+	return fmt.Sprintf("/api/v1/journals/%s", id)
 }
 
 // List godoc
@@ -114,133 +204,144 @@ func (h *TradingJournalHandler) Create(c *gin.Context) {
 // @Security     BearerAuth
 // @Param        limit query int false "Maximum number of journals to return (default: 20, max: 100)"
 // @Param        offset query int false "Number of journals to skip (default: 0)"
+// @Param        include_archived query bool false "Include archived journals (default: false)"
+// @Param        broker query string false "Only return journals of this broker (case-insensitive)"
 // @Success      200 {object} dto.TradingJournalListResponse "Successfully retrieved journals list"
+// @Failure      400 {object} ErrorResponse "Invalid limit or offset (only when strict query validation is enabled)"
 // @Failure      401 {object} ErrorResponse "Unauthorized - missing or invalid token"
 // @Failure      500 {object} ErrorResponse "Internal server error"
 // @Router       /api/v1/journals [get]
 func (h *TradingJournalHandler) List(c *gin.Context) {
 	userID, exists := c.Get("userID")
 	if !exists {
-		h.logger.Error("user id not found in context")
+		loggerFromContext(c).Error("user id not found in context")
 		newErrorResponse(c, http.StatusUnauthorized, "unauthorized")
 		return
 	}
 
 	uid, ok := userID.(uuid.UUID)
 	if !ok {
-		h.logger.Error("invalid user id type in context")
+		loggerFromContext(c).Error("invalid user id type in context")
 		newErrorResponse(c, http.StatusInternalServerError, "internal server error")
 		return
 	}
 
-	limit := 20
-	offset := 0
+	limit, offset := pagination(c)
 
-	if limitStr := c.Query("limit"); limitStr != "" {
-		if l, err := strconv.Atoi(limitStr); err == nil && l > 0 && l <= 100 {
-			limit = l
-		}
-	}
+	includeArchived := c.Query("include_archived") == "true"
+	broker := strings.TrimSpace(c.Query("broker"))
 
-	if offsetStr := c.Query("offset"); offsetStr != "" {
-		if o, err := strconv.Atoi(offsetStr); err == nil && o >= 0 {
-			offset = o
-		}
-	}
-
-	journals, err := h.journalService.GetUserJournals(c.Request.Context(), uid, limit, offset)
+	journals, err := h.journalService.GetUserJournals(c.Request.Context(), uid, limit, offset, includeArchived, broker)
 	if err != nil {
-		h.logger.Error("failed to get user journals", zap.Error(err))
+		loggerFromContext(c).Error("failed to get user journals", zap.Error(err))
 		newErrorResponse(c, http.StatusInternalServerError, err.Error())
 		return
 	}
 
-	total, err := h.journalService.CountUserJournals(c.Request.Context(), uid)
+	total, err := h.journalService.CountUserJournals(c.Request.Context(), uid, includeArchived, broker)
 	if err != nil {
-		h.logger.Error("failed to count user journals", zap.Error(err))
+		loggerFromContext(c).Error("failed to count user journals", zap.Error(err))
 		newErrorResponse(c, http.StatusInternalServerError, err.Error())
 		return
 	}
 
 	response := &dto.TradingJournalListResponse{
-		Journals: mapper.ToTradingJournalResponses(journals),
-		Total:    total,
-		Limit:    limit,
-		Offset:   offset,
+		Journals:   mapper.ToTradingJournalResponses(journals),
+		Pagination: dto.NewPagination(total, limit, offset),
 	}
 
-	c.JSON(http.StatusOK, response)
+	respond(c, http.StatusOK, response)
 }
 
 // GetByID godoc
 // @Summary      Get trading journal by ID
-// @Description  Retrieve a specific trading journal by its ID
+// @Description  Retrieve a specific trading journal by its ID. The response carries an ETag; send it back in If-None-Match to get 304 Not Modified while the journal and its entry count are unchanged.
 // @Tags         Trading Journals
 // @Accept       json
 // @Produce      json
 // @Security     BearerAuth
 // @Param        id path string true "Trading Journal ID (UUID)"
+// @Param        If-None-Match header string false "ETag from a previous response"
 // @Success      200 {object} dto.TradingJournalResponse "Successfully retrieved trading journal"
+// @Success      304 "Journal not modified"
 // @Failure      400 {object} ErrorResponse "Invalid journal ID"
 // @Failure      401 {object} ErrorResponse "Unauthorized - missing or invalid token"
 // @Failure      404 {object} ErrorResponse "Journal not found"
+// @Failure      500 {object} ErrorResponse "Internal server error"
 // @Router       /api/v1/journals/{id} [get]
 func (h *TradingJournalHandler) GetByID(c *gin.Context) {
-	idStr := c.Param("id")
-	id, err := uuid.Parse(idStr)
+	id := uuidParam(c, "id")
+
+	journal, err := h.journalService.GetByID(c.Request.Context(), id)
 	if err != nil {
-		h.logger.Error("invalid journal id", zap.Error(err))
-		newErrorResponse(c, http.StatusBadRequest, "invalid journal id")
+		loggerFromContext(c).Error("failed to get trading journal", zap.Error(err))
+		if errors.Is(err, entity.ErrNotFound) {
+			newErrorResponse(c, http.StatusNotFound, "journal not found")
+			return
+		}
+		newErrorResponse(c, http.StatusInternalServerError, err.Error())
 		return
 	}
 
-	journal, err := h.journalService.GetByID(c.Request.Context(), id)
+	entryCount, err := h.journalService.CountEntries(c.Request.Context(), id)
 	if err != nil {
-		h.logger.Error("failed to get trading journal", zap.Error(err))
-		newErrorResponse(c, http.StatusNotFound, "journal not found")
+		loggerFromContext(c).Error("failed to count journal entries", zap.Error(err))
+		newErrorResponse(c, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	etag := journalETag(journal, entryCount)
+	c.Header("ETag", etag)
+
+	if etagMatches(c.GetHeader("If-None-Match"), etag) {
+		c.Status(http.StatusNotModified)
 		return
 	}
 
 	response := mapper.ToTradingJournalResponse(journal)
-	c.JSON(http.StatusOK, response)
+	respond(c, http.StatusOK, response)
 }
 
 // GetByIDWithEntries godoc
 // @Summary      Get trading journal with entries
-// @Description  Retrieve a specific trading journal by its ID including all associated entries
+// @Description  Retrieve a specific trading journal by its ID with one page of its entries, newest first
 // @Tags         Trading Journals
 // @Accept       json
 // @Produce      json
 // @Security     BearerAuth
 // @Param        id path string true "Trading Journal ID (UUID)"
+// @Param        limit query int false "Maximum number of entries to return (default: 20, max: 100)"
+// @Param        offset query int false "Number of entries to skip (default: 0)"
 // @Success      200 {object} dto.TradingJournalWithEntriesResponse "Successfully retrieved trading journal with entries"
-// @Failure      400 {object} ErrorResponse "Invalid journal ID"
+// @Failure      400 {object} ErrorResponse "Invalid journal ID, or invalid limit or offset (only when strict query validation is enabled)"
 // @Failure      401 {object} ErrorResponse "Unauthorized - missing or invalid token"
 // @Failure      404 {object} ErrorResponse "Journal not found"
+// @Failure      500 {object} ErrorResponse "Internal server error"
 // @Router       /api/v1/journals/{id}/with-entries [get]
 func (h *TradingJournalHandler) GetByIDWithEntries(c *gin.Context) {
-	idStr := c.Param("id")
-	id, err := uuid.Parse(idStr)
-	if err != nil {
-		h.logger.Error("invalid journal id", zap.Error(err))
-		newErrorResponse(c, http.StatusBadRequest, "invalid journal id")
-		return
-	}
+	id := uuidParam(c, "id")
+	limit, offset := pagination(c)
 
-	journal, err := h.journalService.GetByIDWithEntries(c.Request.Context(), id)
+	journal, total, err := h.journalService.GetByIDWithEntries(c.Request.Context(), id, limit, offset)
 	if err != nil {
-		h.logger.Error("failed to get trading journal with entries", zap.Error(err))
-		newErrorResponse(c, http.StatusNotFound, "journal not found")
+		loggerFromContext(c).Error("failed to get trading journal with entries", zap.Error(err))
+		if errors.Is(err, entity.ErrNotFound) {
+			newErrorResponse(c, http.StatusNotFound, "journal not found")
+			return
+		}
+		newErrorResponse(c, http.StatusInternalServerError, err.Error())
 		return
 	}
 
 	response := mapper.ToTradingJournalWithEntriesResponse(journal)
-	c.JSON(http.StatusOK, response)
+	page := dto.NewPagination(total, limit, offset)
+	response.Pagination = &page
+	respond(c, http.StatusOK, response)
 }
 
 // Update godoc
 // @Summary      Update trading journal
-// @Description  Update an existing trading journal's name and description
+// @Description  Update an existing trading journal's name, description, default asset, default session and tags
 // @Tags         Trading Journals
 // @Accept       json
 // @Produce      json
@@ -251,49 +352,71 @@ func (h *TradingJournalHandler) GetByIDWithEntries(c *gin.Context) {
 // @Failure      400 {object} ErrorResponse "Invalid request body, validation failed, or invalid journal ID"
 // @Failure      401 {object} ErrorResponse "Unauthorized - missing or invalid token"
 // @Failure      404 {object} ErrorResponse "Journal not found"
+// @Failure      409 {object} ErrorResponse "A journal with this name already exists (when unique names are enforced)"
+// @Failure      423 {object} ErrorResponse "Journal is locked"
 // @Failure      500 {object} ErrorResponse "Internal server error"
 // @Router       /api/v1/journals/{id} [put]
 func (h *TradingJournalHandler) Update(c *gin.Context) {
-	idStr := c.Param("id")
-	id, err := uuid.Parse(idStr)
-	if err != nil {
-		h.logger.Error("invalid journal id", zap.Error(err))
-		newErrorResponse(c, http.StatusBadRequest, "invalid journal id")
-		return
-	}
+	id := uuidParam(c, "id")
 
 	var req dto.UpdateTradingJournalRequest
 
 	if err := c.ShouldBindJSON(&req); err != nil {
-		h.logger.Error("failed to bind request", zap.Error(err))
+		loggerFromContext(c).Error("failed to bind request", zap.Error(err))
 		newErrorResponse(c, http.StatusBadRequest, "invalid request body")
 		return
 	}
 
 	if err := h.validate.Struct(&req); err != nil {
-		h.logger.Error("validation failed", zap.Error(err))
-		newErrorResponse(c, http.StatusBadRequest, err.Error())
+		loggerFromContext(c).Error("validation failed", zap.Error(err))
+		newErrorResponseFromError(c, http.StatusBadRequest, err)
 		return
 	}
 
 	journal, err := h.journalService.GetByID(c.Request.Context(), id)
 	if err != nil {
-		h.logger.Error("failed to get trading journal", zap.Error(err))
-		newErrorResponse(c, http.StatusNotFound, "journal not found")
+		loggerFromContext(c).Error("failed to get trading journal", zap.Error(err))
+		if errors.Is(err, entity.ErrNotFound) {
+			newErrorResponse(c, http.StatusNotFound, "journal not found")
+			return
+		}
+		newErrorResponse(c, http.StatusInternalServerError, err.Error())
 		return
 	}
 
 	journal.Name = req.Name
 	journal.Description = req.Description
+	journal.DefaultAsset = req.DefaultAsset
+	journal.DefaultSession = req.DefaultSession
+	journal.Tags = req.Tags
+	journal.RequireNotesOnLoss = req.RequireNotesOnLoss
+	if req.ValidationProfile != "" {
+		journal.ValidationProfile = req.ValidationProfile
+	}
+	journal.Broker = req.Broker
+	journal.AccountNumber = req.AccountNumber
+	journal.PipValue = req.PipValue
 
 	if err := h.journalService.Update(c.Request.Context(), journal); err != nil {
-		h.logger.Error("failed to update trading journal", zap.Error(err))
+		loggerFromContext(c).Error("failed to update trading journal", zap.Error(err))
+		if errors.Is(err, entity.ErrNotFound) {
+			newErrorResponse(c, http.StatusNotFound, "journal not found")
+			return
+		}
+		if errors.Is(err, entity.ErrJournalLocked) {
+			newErrorResponseFromError(c, http.StatusLocked, err)
+			return
+		}
+		if errors.Is(err, entity.ErrConflict) {
+			newErrorResponseFromError(c, http.StatusConflict, err)
+			return
+		}
 		newErrorResponse(c, http.StatusInternalServerError, err.Error())
 		return
 	}
 
 	response := mapper.ToTradingJournalResponse(journal)
-	c.JSON(http.StatusOK, response)
+	respond(c, http.StatusOK, response)
 }
 
 // Delete godoc
@@ -307,36 +430,501 @@ func (h *TradingJournalHandler) Update(c *gin.Context) {
 // @Success      200 {object} map[string]string "Successfully deleted journal"
 // @Failure      400 {object} ErrorResponse "Invalid journal ID"
 // @Failure      401 {object} ErrorResponse "Unauthorized - missing or invalid token"
-// @Failure      500 {object} ErrorResponse "Internal server error or access denied"
+// @Failure      404 {object} ErrorResponse "Journal not found"
+// @Failure      423 {object} ErrorResponse "Journal is locked"
+// @Failure      500 {object} ErrorResponse "Internal server error"
 // @Router       /api/v1/journals/{id} [delete]
 func (h *TradingJournalHandler) Delete(c *gin.Context) {
-	idStr := c.Param("id")
-	id, err := uuid.Parse(idStr)
+	id := uuidParam(c, "id")
+
+	userID, exists := c.Get("userID")
+	if !exists {
+		loggerFromContext(c).Error("user id not found in context")
+		newErrorResponse(c, http.StatusUnauthorized, "unauthorized")
+		return
+	}
+
+	uid, ok := userID.(uuid.UUID)
+	if !ok {
+		loggerFromContext(c).Error("invalid user id type in context")
+		newErrorResponse(c, http.StatusInternalServerError, "internal server error")
+		return
+	}
+
+	if err := h.journalService.Delete(c.Request.Context(), id, uid); err != nil {
+		loggerFromContext(c).Error("failed to delete trading journal", zap.Error(err))
+		if errors.Is(err, entity.ErrNotFound) {
+			newErrorResponse(c, http.StatusNotFound, "journal not found")
+			return
+		}
+		if errors.Is(err, entity.ErrJournalLocked) {
+			newErrorResponseFromError(c, http.StatusLocked, err)
+			return
+		}
+		newErrorResponse(c, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	respond(c, http.StatusOK, gin.H{"message": "journal deleted successfully"})
+}
+
+// Archive godoc
+// @Summary      Archive trading journal
+// @Description  Hide a trading journal from the default list without deleting it. Archived journals stay readable by ID.
+// @Tags         Trading Journals
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Param        id path string true "Trading Journal ID (UUID)"
+// @Success      200 {object} dto.TradingJournalResponse "Successfully archived journal"
+// @Failure      400 {object} ErrorResponse "Invalid journal ID"
+// @Failure      401 {object} ErrorResponse "Unauthorized - missing or invalid token"
+// @Failure      404 {object} ErrorResponse "Journal not found"
+// @Failure      500 {object} ErrorResponse "Internal server error"
+// @Router       /api/v1/journals/{id}/archive [post]
+func (h *TradingJournalHandler) Archive(c *gin.Context) {
+	h.setArchived(c, true)
+}
+
+// Unarchive godoc
+// @Summary      Unarchive trading journal
+// @Description  Restore an archived trading journal to the default list
+// @Tags         Trading Journals
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Param        id path string true "Trading Journal ID (UUID)"
+// @Success      200 {object} dto.TradingJournalResponse "Successfully unarchived journal"
+// @Failure      400 {object} ErrorResponse "Invalid journal ID"
+// @Failure      401 {object} ErrorResponse "Unauthorized - missing or invalid token"
+// @Failure      404 {object} ErrorResponse "Journal not found"
+// @Failure      500 {object} ErrorResponse "Internal server error"
+// @Router       /api/v1/journals/{id}/unarchive [post]
+func (h *TradingJournalHandler) Unarchive(c *gin.Context) {
+	h.setArchived(c, false)
+}
+
+func (h *TradingJournalHandler) setArchived(c *gin.Context, archived bool) {
+	id := uuidParam(c, "id")
+
+	userID, exists := c.Get("userID")
+	if !exists {
+		loggerFromContext(c).Error("user id not found in context")
+		newErrorResponse(c, http.StatusUnauthorized, "unauthorized")
+		return
+	}
+
+	uid, ok := userID.(uuid.UUID)
+	if !ok {
+		loggerFromContext(c).Error("invalid user id type in context")
+		newErrorResponse(c, http.StatusInternalServerError, "internal server error")
+		return
+	}
+
+	journal, err := h.journalService.SetArchived(c.Request.Context(), id, uid, archived)
 	if err != nil {
-		h.logger.Error("invalid journal id", zap.Error(err))
-		newErrorResponse(c, http.StatusBadRequest, "invalid journal id")
+		loggerFromContext(c).Error("failed to set trading journal archived flag", zap.Error(err))
+		if errors.Is(err, entity.ErrNotFound) {
+			newErrorResponse(c, http.StatusNotFound, "journal not found")
+			return
+		}
+		newErrorResponse(c, http.StatusInternalServerError, err.Error())
 		return
 	}
 
+	response := mapper.ToTradingJournalResponse(journal)
+	respond(c, http.StatusOK, response)
+}
+
+// Lock godoc
+// @Summary      Lock trading journal
+// @Description  Make a trading journal read-only, e.g. to freeze a completed prop-firm challenge. While locked, every write to the journal, its entries, their notes and exits is rejected with 423; reads keep working.
+// @Tags         Trading Journals
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Param        id path string true "Trading Journal ID (UUID)"
+// @Success      200 {object} dto.TradingJournalResponse "Successfully locked journal"
+// @Failure      400 {object} ErrorResponse "Invalid journal ID"
+// @Failure      401 {object} ErrorResponse "Unauthorized - missing or invalid token"
+// @Failure      404 {object} ErrorResponse "Journal not found"
+// @Failure      500 {object} ErrorResponse "Internal server error"
+// @Router       /api/v1/journals/{id}/lock [post]
+func (h *TradingJournalHandler) Lock(c *gin.Context) {
+	h.setLocked(c, true)
+}
+
+// Unlock godoc
+// @Summary      Unlock trading journal
+// @Description  Make a locked trading journal writable again
+// @Tags         Trading Journals
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Param        id path string true "Trading Journal ID (UUID)"
+// @Success      200 {object} dto.TradingJournalResponse "Successfully unlocked journal"
+// @Failure      400 {object} ErrorResponse "Invalid journal ID"
+// @Failure      401 {object} ErrorResponse "Unauthorized - missing or invalid token"
+// @Failure      404 {object} ErrorResponse "Journal not found"
+// @Failure      500 {object} ErrorResponse "Internal server error"
+// @Router       /api/v1/journals/{id}/unlock [post]
+func (h *TradingJournalHandler) Unlock(c *gin.Context) {
+	h.setLocked(c, false)
+}
+
+func (h *TradingJournalHandler) setLocked(c *gin.Context, locked bool) {
+	id := uuidParam(c, "id")
+
 	userID, exists := c.Get("userID")
 	if !exists {
-		h.logger.Error("user id not found in context")
+		loggerFromContext(c).Error("user id not found in context")
 		newErrorResponse(c, http.StatusUnauthorized, "unauthorized")
 		return
 	}
 
 	uid, ok := userID.(uuid.UUID)
 	if !ok {
-		h.logger.Error("invalid user id type in context")
+		loggerFromContext(c).Error("invalid user id type in context")
 		newErrorResponse(c, http.StatusInternalServerError, "internal server error")
 		return
 	}
 
-	if err := h.journalService.Delete(c.Request.Context(), id, uid); err != nil {
-		h.logger.Error("failed to delete trading journal", zap.Error(err))
+	journal, err := h.journalService.SetLocked(c.Request.Context(), id, uid, locked)
+	if err != nil {
+		loggerFromContext(c).Error("failed to set trading journal locked flag", zap.Error(err))
+		if errors.Is(err, entity.ErrNotFound) {
+			newErrorResponse(c, http.StatusNotFound, "journal not found")
+			return
+		}
+		newErrorResponse(c, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	response := mapper.ToTradingJournalResponse(journal)
+	respond(c, http.StatusOK, response)
+}
+
+// RecomputeSummary godoc
+// @Summary      Recompute journal summary
+// @Description  Rebuild the journal's stored summary (trade count, wins, win rate, net realized) from its entries. The summary is kept up to date on every entry write and shown in the journal list; this repairs it if it has drifted.
+// @Tags         Trading Journals
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Param        id path string true "Trading Journal ID (UUID)"
+// @Success      200 {object} dto.JournalSummaryResponse "Recomputed summary"
+// @Failure      400 {object} ErrorResponse "Invalid journal ID"
+// @Failure      401 {object} ErrorResponse "Unauthorized - missing or invalid token"
+// @Failure      404 {object} ErrorResponse "Journal not found"
+// @Failure      500 {object} ErrorResponse "Internal server error"
+// @Router       /api/v1/journals/{id}/recompute-summary [post]
+func (h *TradingJournalHandler) RecomputeSummary(c *gin.Context) {
+	id := uuidParam(c, "id")
+
+	userID, exists := c.Get("userID")
+	if !exists {
+		loggerFromContext(c).Error("user id not found in context")
+		newErrorResponse(c, http.StatusUnauthorized, "unauthorized")
+		return
+	}
+
+	uid, ok := userID.(uuid.UUID)
+	if !ok {
+		loggerFromContext(c).Error("invalid user id type in context")
+		newErrorResponse(c, http.StatusInternalServerError, "internal server error")
+		return
+	}
+
+	summary, err := h.journalService.RecomputeSummary(c.Request.Context(), id, uid)
+	if err != nil {
+		loggerFromContext(c).Error("failed to recompute journal summary", zap.Error(err))
+		if errors.Is(err, entity.ErrNotFound) {
+			newErrorResponse(c, http.StatusNotFound, "journal not found")
+			return
+		}
+		newErrorResponse(c, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	respond(c, http.StatusOK, mapper.ToJournalSummaryResponse(summary))
+}
+
+// Export godoc
+// @Summary      Export trading journal
+// @Description  Export the journal and all its entries as a self-contained, versioned JSON document that can be re-imported via POST /api/v1/journals/import. The document is streamed while entries are loaded, so memory use doesn't depend on the journal's size; an error midway leaves it truncated rather than returning an error status, and a complete document ends with the closing brace.
+// @Tags         Trading Journals
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Param        id path string true "Trading Journal ID (UUID)"
+// @Param        format query string false "Export format (only json is supported)" default(json)
+// @Success      200 {object} dto.JournalExportDocument "Journal export document"
+// @Failure      400 {object} ErrorResponse "Invalid journal ID or unsupported format"
+// @Failure      401 {object} ErrorResponse "Unauthorized - missing or invalid token"
+// @Failure      404 {object} ErrorResponse "Journal not found"
+// @Failure      500 {object} ErrorResponse "Internal server error"
+// @Router       /api/v1/journals/{id}/export [get]
+func (h *TradingJournalHandler) Export(c *gin.Context) {
+	id := uuidParam(c, "id")
+
+	if format := c.DefaultQuery("format", "json"); format != "json" {
+		newErrorResponse(c, http.StatusBadRequest, "unsupported export format")
+		return
+	}
+
+	userID, exists := c.Get("userID")
+	if !exists {
+		loggerFromContext(c).Error("user id not found in context")
+		newErrorResponse(c, http.StatusUnauthorized, "unauthorized")
+		return
+	}
+
+	uid, ok := userID.(uuid.UUID)
+	if !ok {
+		loggerFromContext(c).Error("invalid user id type in context")
+		newErrorResponse(c, http.StatusInternalServerError, "internal server error")
+		return
+	}
+
+	// The document is a file meant to be imported again, so it is never
+	// wrapped in a response envelope.
+	c.Header("Content-Type", "application/json; charset=utf-8")
+	c.Header("Content-Disposition", fmt.Sprintf("attachment; filename=\"journal-%s.json\"", id))
+	c.Status(http.StatusOK)
+
+	err := h.journalService.StreamExport(c.Request.Context(), id, uid, c.Writer)
+	if err == nil {
+		return
+	}
+
+	loggerFromContext(c).Error("failed to export trading journal", zap.Error(err))
+	if c.Writer.Written() {
+		// The status is already sent; the truncated body is the only signal.
+		c.Abort()
+		return
+	}
+
+	c.Writer.Header().Del("Content-Disposition")
+	if errors.Is(err, entity.ErrNotFound) {
+		newErrorResponse(c, http.StatusNotFound, "journal not found")
+		return
+	}
+	newErrorResponse(c, http.StatusInternalServerError, err.Error())
+}
+
+// Import godoc
+// @Summary      Import trading journal
+// @Description  Recreate a journal and its entries from an export document. Everything gets new IDs and is owned by the authenticated user; nothing is written if any entry is invalid. With dry_run=true nothing is written at all; the response reports how many entries would be created and why each invalid entry would be rejected.
+// @Tags         Trading Journals
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Param        request body dto.JournalExportDocument true "Journal export document"
+// @Param        dry_run query bool false "Validate the document and report per-entry errors without importing it"
+// @Success      200 {object} dto.ImportPreviewResponse "Dry run result"
+// @Success      201 {object} dto.TradingJournalWithEntriesResponse "Successfully imported journal"
+// @Header       201 {string} Location "URL of the imported journal"
+// @Failure      400 {object} ErrorResponse "Invalid document, unsupported version, or validation failed"
+// @Failure      401 {object} ErrorResponse "Unauthorized - missing or invalid token"
+// @Failure      409 {object} ErrorResponse "A journal with this name already exists (when unique names are enforced)"
+// @Failure      500 {object} ErrorResponse "Internal server error"
+// @Router       /api/v1/journals/import [post]
+func (h *TradingJournalHandler) Import(c *gin.Context) {
+	var doc dto.JournalExportDocument
+
+	if err := c.ShouldBindJSON(&doc); err != nil {
+		loggerFromContext(c).Error("failed to bind request", zap.Error(err))
+		newErrorResponse(c, http.StatusBadRequest, "invalid request body")
+		return
+	}
+
+	dryRun := c.Query("dry_run") == "true"
+
+	// A dry run reports invalid entries per row, so only the rest of the
+	// document has to pass validation up front.
+	var err error
+	if dryRun {
+		err = h.validate.StructExcept(&doc, "Entries")
+	} else {
+		err = h.validate.Struct(&doc)
+	}
+	if err != nil {
+		loggerFromContext(c).Error("validation failed", zap.Error(err))
+		newErrorResponseFromError(c, http.StatusBadRequest, err)
+		return
+	}
+
+	userID, exists := c.Get("userID")
+	if !exists {
+		loggerFromContext(c).Error("user id not found in context")
+		newErrorResponse(c, http.StatusUnauthorized, "unauthorized")
+		return
+	}
+
+	uid, ok := userID.(uuid.UUID)
+	if !ok {
+		loggerFromContext(c).Error("invalid user id type in context")
+		newErrorResponse(c, http.StatusInternalServerError, "internal server error")
+		return
+	}
+
+	if dryRun {
+		h.previewImport(c, uid, &doc)
+		return
+	}
+
+	journal, err := h.journalService.Import(c.Request.Context(), uid, &doc)
+	if err != nil {
+		loggerFromContext(c).Error("failed to import trading journal", zap.Error(err))
+		if errors.Is(err, entity.ErrUnsupportedExportVersion) {
+			newErrorResponseFromError(c, http.StatusBadRequest, err)
+			return
+		}
+		if errors.Is(err, entity.ErrConflict) {
+			newErrorResponseFromError(c, http.StatusConflict, err)
+			return
+		}
+		newErrorResponse(c, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	c.Header("Location", journalLocation(journal.ID))
+	respond(c, http.StatusCreated, mapper.ToTradingJournalWithEntriesResponse(journal))
+}
+
+func (h *TradingJournalHandler) previewImport(c *gin.Context, userID uuid.UUID, doc *dto.JournalExportDocument) {
+	rowErrs := make(map[int]error)
+	for i, entry := range doc.Entries {
+		if err := h.validate.Struct(entry); err != nil {
+			rowErrs[i] = err
+		}
+	}
+
+	preview, err := h.journalService.PreviewImport(c.Request.Context(), userID, doc, rowErrs)
+	if err != nil {
+		loggerFromContext(c).Error("failed to preview trading journal import", zap.Error(err))
+		if errors.Is(err, entity.ErrUnsupportedExportVersion) {
+			newErrorResponseFromError(c, http.StatusBadRequest, err)
+			return
+		}
+		if errors.Is(err, entity.ErrConflict) {
+			newErrorResponseFromError(c, http.StatusConflict, err)
+			return
+		}
+		newErrorResponse(c, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	respond(c, http.StatusOK, mapper.ToImportPreviewResponse(preview))
+}
+
+// ImportEntries godoc
+// @Summary      Import entries from a trading platform
+// @Description  Add the trades of a cTrader position history or TradingView strategy tester "List of trades" CSV export to the journal. Platform exports have no chart links or planned RR, so ltf, htf and max_rr apply to every imported trade; session, trade type and result are derived from the open and close times and the net profit. Trades that cannot be mapped or fail validation are skipped and reported by line; the rest are imported together.
+// @Tags         Trading Journals
+// @Accept       text/csv
+// @Produce      json
+// @Security     BearerAuth
+// @Param        id path string true "Trading Journal ID (UUID)"
+// @Param        source query string true "Platform the export comes from" Enums(ctrader, tradingview)
+// @Param        ltf query string true "Lower timeframe chart URL for every imported entry"
+// @Param        htf query string true "Higher timeframe chart URL for every imported entry"
+// @Param        max_rr query number true "Max RR for every imported entry"
+// @Param        asset query string false "Currency pair for trades without a symbol (TradingView exports have none)"
+// @Param        request body string true "CSV export"
+// @Success      201 {object} dto.PlatformImportResponse "Imported entries and skipped rows"
+// @Failure      400 {object} ErrorResponse "Invalid journal ID, query parameters, or file"
+// @Failure      401 {object} ErrorResponse "Unauthorized - missing or invalid token"
+// @Failure      404 {object} ErrorResponse "Journal not found"
+// @Failure      423 {object} ErrorResponse "Journal is locked"
+// @Failure      500 {object} ErrorResponse "Internal server error"
+// @Router       /api/v1/journals/{id}/import [post]
+func (h *TradingJournalHandler) ImportEntries(c *gin.Context) {
+	id := uuidParam(c, "id")
+
+	query := dto.PlatformImportQuery{
+		Source: c.Query("source"),
+		LTF:    c.Query("ltf"),
+		HTF:    c.Query("htf"),
+		Asset:  types.NormalizeCurrencyPair(c.Query("asset")),
+	}
+
+	if maxRRStr := c.Query("max_rr"); maxRRStr != "" {
+		maxRR, err := strconv.ParseFloat(maxRRStr, 64)
+		if err != nil || math.IsNaN(maxRR) || math.IsInf(maxRR, 0) {
+			newErrorResponse(c, http.StatusBadRequest, "invalid max_rr parameter")
+			return
+		}
+		query.MaxRR = maxRR
+	}
+
+	if err := h.validate.Struct(&query); err != nil {
+		loggerFromContext(c).Error("validation failed", zap.Error(err))
+		newErrorResponseFromError(c, http.StatusBadRequest, err)
+		return
+	}
+
+	if query.Asset != "" && !query.Asset.IsValid() {
+		newErrorResponse(c, http.StatusBadRequest, "invalid asset parameter")
+		return
+	}
+
+	userID, exists := c.Get("userID")
+	if !exists {
+		loggerFromContext(c).Error("user id not found in context")
+		newErrorResponse(c, http.StatusUnauthorized, "unauthorized")
+		return
+	}
+
+	uid, ok := userID.(uuid.UUID)
+	if !ok {
+		loggerFromContext(c).Error("invalid user id type in context")
+		newErrorResponse(c, http.StatusInternalServerError, "internal server error")
+		return
+	}
+
+	body := http.MaxBytesReader(c.Writer, c.Request.Body, maxImportFileSize)
+	rows, err := importer.Parse(importer.Source(query.Source), body, importer.Options{
+		LTF:   query.LTF,
+		HTF:   query.HTF,
+		MaxRR: query.MaxRR,
+		Asset: query.Asset,
+	})
+	if err != nil {
+		loggerFromContext(c).Error("failed to parse import file", zap.Error(err))
+		newErrorResponseFromError(c, http.StatusBadRequest, err)
+		return
+	}
+
+	// Mapped rows go through the same validation as a created entry.
+	reqs := make([]*dto.CreateTradingJournalEntryRequest, len(rows))
+	lines := make([]int, len(rows))
+	rowErrs := make(map[int]error)
+	for i, row := range rows {
+		reqs[i], lines[i] = row.Request, row.Line
+		if row.Err != nil {
+			rowErrs[i] = row.Err
+			continue
+		}
+		if err := h.validate.Struct(row.Request); err != nil {
+			rowErrs[i] = err
+		}
+	}
+
+	result, err := h.journalService.ImportEntries(c.Request.Context(), id, uid, reqs, rowErrs)
+	if err != nil {
+		loggerFromContext(c).Error("failed to import trading journal entries", zap.Error(err))
+		if errors.Is(err, entity.ErrNotFound) {
+			newErrorResponse(c, http.StatusNotFound, "journal not found")
+			return
+		}
+		if errors.Is(err, entity.ErrJournalLocked) {
+			newErrorResponseFromError(c, http.StatusLocked, err)
+			return
+		}
 		newErrorResponse(c, http.StatusInternalServerError, err.Error())
 		return
 	}
 
-	c.JSON(http.StatusOK, gin.H{"message": "journal deleted successfully"})
+	respond(c, http.StatusCreated, mapper.ToPlatformImportResponse(query.Source, result, lines))
 }
@@ -2,12 +2,16 @@ package v1
 
 import (
 	"context"
+	"fmt"
+	"io"
+	"math"
 	"net/http"
 	"strconv"
 	"time"
 
 	"github.com/user/normark/internal/types"
 
+	"github.com/cockroachdb/errors"
 	"github.com/gin-gonic/gin"
 	"github.com/go-playground/validator/v10"
 	"github.com/google/uuid"
@@ -17,103 +21,186 @@ import (
 	"go.uber.org/zap"
 )
 
+const (
+	minCalendarYear = 1970
+	maxCalendarYear = 2100
+)
+
 type TradingJournalEntryService interface {
 	Create(ctx context.Context, journalID uuid.UUID, req *dto.CreateTradingJournalEntryRequest) (*entity.TradingJournalEntry, error)
+	Duplicate(ctx context.Context, id uuid.UUID, journalID uuid.UUID, req *dto.DuplicateTradingJournalEntryRequest) (*entity.TradingJournalEntry, error)
 	GetByID(ctx context.Context, id uuid.UUID) (*entity.TradingJournalEntry, error)
 	GetByIDWithJournal(ctx context.Context, id uuid.UUID) (*entity.TradingJournalEntry, error)
 	GetJournalEntries(ctx context.Context, journalID uuid.UUID, limit, offset int) ([]*entity.TradingJournalEntry, error)
-	GetByDateRange(ctx context.Context, journalID uuid.UUID, startDate, endDate time.Time) ([]*entity.TradingJournalEntry, error)
+	GetByDateRange(ctx context.Context, journalID uuid.UUID, startDate, endDate time.Time, limit, offset int) ([]*entity.TradingJournalEntry, error)
+	CountByDateRange(ctx context.Context, journalID uuid.UUID, startDate, endDate time.Time) (int, error)
 	GetByAsset(ctx context.Context, journalID uuid.UUID, asset types.CurrencyPair, limit, offset int) ([]*entity.TradingJournalEntry, error)
 	GetBySession(ctx context.Context, journalID uuid.UUID, session types.TradingSession, limit, offset int) ([]*entity.TradingJournalEntry, error)
 	GetByResult(ctx context.Context, journalID uuid.UUID, result types.TradeResult, limit, offset int) ([]*entity.TradingJournalEntry, error)
+	GetByTradeType(ctx context.Context, journalID uuid.UUID, tradeType types.TradeType, limit, offset int) ([]*entity.TradingJournalEntry, error)
+	FilterEntries(ctx context.Context, journalID uuid.UUID, filter *dto.FilterEntriesRequest) ([]*entity.TradingJournalEntry, error)
+	GetModifiedSince(ctx context.Context, journalID uuid.UUID, since time.Time, cursor string, limit int) ([]*entity.TradingJournalEntry, string, error)
+	CountFilteredEntries(ctx context.Context, journalID uuid.UUID, filter *dto.FilterEntriesRequest) (int, error)
 	Update(ctx context.Context, entry *entity.TradingJournalEntry) error
+	SetPinned(ctx context.Context, id uuid.UUID, journalID uuid.UUID, pinned bool) (*entity.TradingJournalEntry, error)
+	SetReviewStatus(ctx context.Context, id uuid.UUID, journalID uuid.UUID, status types.ReviewStatus) (*entity.TradingJournalEntry, error)
+	LinkEntry(ctx context.Context, id uuid.UUID, journalID uuid.UUID, relatedID uuid.UUID) (*entity.TradingJournalEntry, error)
+	UnlinkEntry(ctx context.Context, id uuid.UUID, journalID uuid.UUID, relatedID uuid.UUID) (*entity.TradingJournalEntry, error)
+	GetRelated(ctx context.Context, id uuid.UUID, journalID uuid.UUID) ([]*entity.TradingJournalEntry, error)
 	Delete(ctx context.Context, id uuid.UUID, journalID uuid.UUID) error
+	HardDelete(ctx context.Context, id uuid.UUID, journalID uuid.UUID) error
+	Undo(ctx context.Context, journalID uuid.UUID) (*entity.TradingJournalEntry, error)
+	TransferEntries(ctx context.Context, userID uuid.UUID, sourceJournalID uuid.UUID, req *dto.TransferEntriesRequest) ([]*entity.TradingJournalEntry, error)
+	BulkUpdate(ctx context.Context, journalID uuid.UUID, req *dto.BulkUpdateEntriesRequest) ([]*entity.TradingJournalEntry, error)
 	CountJournalEntries(ctx context.Context, journalID uuid.UUID) (int, error)
-	GetStatistics(ctx context.Context, journalID uuid.UUID) (map[string]any, error)
+	GetStatistics(ctx context.Context, journalID uuid.UUID) (*entity.EntryStatistics, error)
+	GetStatisticsByEmotion(ctx context.Context, journalID uuid.UUID) ([]*entity.EmotionStatistics, error)
+	GetStatisticsByCategory(ctx context.Context, journalID uuid.UUID) ([]*entity.CategoryStatistics, error)
+	GetStatisticsByGrade(ctx context.Context, journalID uuid.UUID) ([]*entity.GradeStatistics, error)
+	GetStatisticsByConfidence(ctx context.Context, journalID uuid.UUID) ([]*entity.ConfidenceStatistics, error)
+	GetAdherenceStatistics(ctx context.Context, journalID uuid.UUID) (*entity.AdherenceStatistics, error)
+	GetChecklistAdherence(ctx context.Context, journalID uuid.UUID) ([]*entity.ChecklistItemStatistics, error)
+	GetFacets(ctx context.Context, journalID uuid.UUID) (*entity.EntryFacets, error)
+	GetReviewProgress(ctx context.Context, journalID uuid.UUID) (*entity.ReviewProgress, error)
+	GetCalendar(ctx context.Context, journalID uuid.UUID, year int, loc *time.Location) ([]*entity.DailyStatistics, error)
+	GetAssetCorrelation(ctx context.Context, journalID uuid.UUID, loc *time.Location) (*entity.AssetCorrelation, error)
+	GetUserStatistics(ctx context.Context, userID uuid.UUID) (*entity.UserStatistics, error)
+	ComparePeriods(ctx context.Context, journalID uuid.UUID, a, b entity.Period) (*entity.PeriodComparison, error)
 	VerifyAccess(ctx context.Context, entryID uuid.UUID, journalID uuid.UUID) (bool, error)
 }
 
 type TradingJournalEntryHandler struct {
-	entryService   TradingJournalEntryService
-	journalService TradingJournalService
-	logger         *zap.Logger
-	validate       *validator.Validate
+	entryService TradingJournalEntryService
+	validate     *validator.Validate
+	strictQuery  bool
 }
 
 func NewTradingJournalEntryHandler(
 	entryService TradingJournalEntryService,
-	journalService TradingJournalService,
-	logger *zap.Logger,
 	validate *validator.Validate,
+	strictQuery bool,
 ) *TradingJournalEntryHandler {
 	return &TradingJournalEntryHandler{
-		entryService:   entryService,
-		journalService: journalService,
-		logger:         logger,
-		validate:       validate,
+		entryService: entryService,
+		validate:     validate,
+		strictQuery:  strictQuery,
 	}
 }
 
 func (h *TradingJournalEntryHandler) InitRoutes(group *gin.RouterGroup) {
 	group.POST("", h.Create)
-	group.GET("", h.List)
+	group.GET("", ParsePagination(h.strictQuery), h.List)
 	group.GET("/statistics", h.GetStatistics)
-	group.GET("/:entryId", h.GetByID)
-	group.PUT("/:entryId", h.Update)
-	group.DELETE("/:entryId", h.Delete)
+	group.GET("/statistics/compare", h.CompareStatistics)
+	group.GET("/statistics/by-emotion", h.GetStatisticsByEmotion)
+	group.GET("/statistics/by-category", h.GetStatisticsByCategory)
+	group.GET("/statistics/by-grade", h.GetStatisticsByGrade)
+	group.GET("/statistics/by-confidence", h.GetStatisticsByConfidence)
+	group.GET("/statistics/adherence", h.GetAdherenceStatistics)
+	group.GET("/statistics/checklist-adherence", h.GetChecklistAdherence)
+	group.GET("/statistics/asset-correlation", h.GetAssetCorrelation)
+	group.GET("/statistics/review-progress", h.GetReviewProgress)
+	group.GET("/calendar", h.GetCalendar)
+	group.GET("/facets", h.GetFacets)
+	group.POST("/undo", h.Undo)
+	group.POST("/move", h.Transfer)
+	group.PATCH("/bulk", h.BulkUpdate)
+
+	entry := group.Group("/:entryId", ParseUUIDParam("entryId"))
+	entry.GET("", h.GetByID)
+	entry.PUT("", h.Update)
+	entry.DELETE("", h.Delete)
+	entry.POST("/duplicate", h.Duplicate)
+	entry.POST("/pin", h.Pin)
+	entry.POST("/unpin", h.Unpin)
+	entry.PATCH("/review", h.UpdateReviewStatus)
+	entry.GET("/links", h.GetLinks)
+	entry.POST("/links", h.Link)
+	entry.DELETE("/links/:linkedId", ParseUUIDParam("linkedId"), h.Unlink)
 }
 
 // Create godoc
 // @Summary      Create a new trading journal entry
-// @Description  Create a new trade entry in a specific trading journal
+// @Description  Create a new trade entry in a specific trading journal. With from_template, the entry template's asset, session, trade type, direction and max RR fill any of those fields the request leaves out.
 // @Tags         Trading Journal Entries
 // @Accept       json
 // @Produce      json
 // @Security     BearerAuth
 // @Param        id path string true "Trading Journal ID (UUID)"
+// @Param        from_template query string false "Entry template ID (UUID) of this journal whose fields fill any the request leaves out. A max_rr of 0 counts as left out and is replaced by the template's"
 // @Param        request body dto.CreateTradingJournalEntryRequest true "Trading entry details"
 // @Success      201 {object} dto.TradingJournalEntryResponse "Successfully created trading entry"
-// @Failure      400 {object} ErrorResponse "Invalid request body, validation failed, or invalid journal ID"
+// @Header       201 {string} Location "URL of the created entry"
+// @Failure      400 {object} ErrorResponse "Invalid request body, validation failed (including an entry the journal's validation profile or notes requirement rejects, or a field set by neither the request nor the template), or invalid journal or template ID"
 // @Failure      401 {object} ErrorResponse "Unauthorized - missing or invalid token"
+// @Failure      403 {object} ErrorResponse "Access denied - journal does not belong to user"
+// @Failure      404 {object} ErrorResponse "Journal or entry template not found"
+// @Failure      423 {object} ErrorResponse "Journal is locked"
 // @Failure      500 {object} ErrorResponse "Internal server error"
 // @Router       /api/v1/journals/{id}/entries [post]
 func (h *TradingJournalEntryHandler) Create(c *gin.Context) {
-	journalIDStr := c.Param("id")
-	journalID, err := uuid.Parse(journalIDStr)
-	if err != nil {
-		h.logger.Error("invalid journal id", zap.Error(err))
-		newErrorResponse(c, http.StatusBadRequest, "invalid journal id")
-		return
-	}
+	journalID := uuidParam(c, "id")
 
 	var req dto.CreateTradingJournalEntryRequest
 
 	if err := c.ShouldBindJSON(&req); err != nil {
-		h.logger.Error("failed to bind request", zap.Error(err))
+		loggerFromContext(c).Error("failed to bind request", zap.Error(err))
 		newErrorResponse(c, http.StatusBadRequest, "invalid request body")
 		return
 	}
 
+	if templateIDStr := c.Query("from_template"); templateIDStr != "" {
+		templateID, err := uuid.Parse(templateIDStr)
+		if err != nil {
+			loggerFromContext(c).Error("invalid template id", zap.Error(err))
+			newErrorResponse(c, http.StatusBadRequest, "invalid template id")
+			return
+		}
+		req.TemplateID = &templateID
+	}
+
 	if err := h.validate.Struct(&req); err != nil {
-		h.logger.Error("validation failed", zap.Error(err))
-		newErrorResponse(c, http.StatusBadRequest, err.Error())
+		loggerFromContext(c).Error("validation failed", zap.Error(err))
+		newErrorResponseFromError(c, http.StatusBadRequest, err)
 		return
 	}
 
 	entry, err := h.entryService.Create(c.Request.Context(), journalID, &req)
 	if err != nil {
-		h.logger.Error("failed to create trading journal entry", zap.Error(err))
+		loggerFromContext(c).Error("failed to create trading journal entry", zap.Error(err))
+		if errors.Is(err, entity.ErrEntryTemplateNotFound) {
+			newErrorResponseFromError(c, http.StatusNotFound, entity.ErrEntryTemplateNotFound)
+			return
+		}
+		if errors.Is(err, entity.ErrNotFound) {
+			newErrorResponse(c, http.StatusNotFound, "journal not found")
+			return
+		}
+		if errors.Is(err, entity.ErrJournalLocked) {
+			newErrorResponseFromError(c, http.StatusLocked, err)
+			return
+		}
+		if errors.Is(err, entity.ErrEntryPolicy) || errors.Is(err, entity.ErrIncompleteTemplatedEntry) {
+			newErrorResponseFromError(c, http.StatusBadRequest, err)
+			return
+		}
 		newErrorResponse(c, http.StatusInternalServerError, err.Error())
 		return
 	}
 
+	c.Header("Location", entryLocation(entry.JournalID, entry.ID))
 	response := mapper.ToTradingJournalEntryResponse(entry)
-	c.JSON(http.StatusCreated, response)
+	respond(c, http.StatusCreated, response)
+}
+
+// entryLocation is the URL of an entry, sent as the Location of a 201.
+func entryLocation(journalID, id uuid.UUID) string {
+	return fmt.Sprintf("%s/entries/%s", journalLocation(journalID), id)
 }
 
 // List godoc
 // @Summary      List trading journal entries
-// @Description  Get a paginated list of all entries for a specific trading journal
+// @Description  Get a paginated list of all entries for a specific trading journal. With modified_since the endpoint switches to incremental sync: it returns a dto.EntrySyncResponse with entries changed after that time (including deleted ones, flagged with deleted=true), ordered by updated_at, paged with cursor instead of offset, and ignores the other filters.
 // @Tags         Trading Journal Entries
 // @Accept       json
 // @Produce      json
@@ -121,57 +208,88 @@ func (h *TradingJournalEntryHandler) Create(c *gin.Context) {
 // @Param        id path string true "Trading Journal ID (UUID)"
 // @Param        limit query int false "Maximum number of entries to return (default: 20, max: 100)"
 // @Param        offset query int false "Number of entries to skip (default: 0)"
+// @Param        pinned query bool false "Only return pinned (true) or unpinned (false) entries"
+// @Param        review_status query string false "Only return entries with this review status" Enums(unreviewed, reviewed, flagged)
+// @Param        followed_plan query bool false "Only return entries that followed (true) or broke (false) the trading plan"
+// @Param        trade_type query string false "Only return entries of this trade type" Enums(swing, intraday)
+// @Param        entry_type query string false "Only return entries with this entry order type" Enums(market, limit)
+// @Param        min_rr query number false "Only return entries with max RR greater than or equal to this value"
+// @Param        max_rr query number false "Only return entries with max RR less than or equal to this value"
+// @Param        min_realized query number false "Only return entries with realized P&L greater than or equal to this value (may be negative)"
+// @Param        max_realized query number false "Only return entries with realized P&L less than or equal to this value (may be negative)"
+// @Param        modified_since query string false "Sync mode: only return entries changed after this RFC 3339 timestamp"
+// @Param        cursor query string false "Sync mode: next_cursor from the previous sync page"
 // @Success      200 {object} dto.TradingJournalEntryListResponse "Successfully retrieved entries list"
-// @Failure      400 {object} ErrorResponse "Invalid journal ID"
+// @Failure      400 {object} ErrorResponse "Invalid journal ID or filter, or invalid limit or offset when strict query validation is enabled"
 // @Failure      401 {object} ErrorResponse "Unauthorized - missing or invalid token"
+// @Failure      403 {object} ErrorResponse "Access denied - journal does not belong to user"
 // @Failure      500 {object} ErrorResponse "Internal server error"
 // @Router       /api/v1/journals/{id}/entries [get]
 func (h *TradingJournalEntryHandler) List(c *gin.Context) {
-	journalIDStr := c.Param("id")
-	journalID, err := uuid.Parse(journalIDStr)
+	journalID := uuidParam(c, "id")
+
+	limit, offset := pagination(c)
+
+	if since := c.Query("modified_since"); since != "" {
+		h.listModifiedSince(c, journalID, since, limit)
+		return
+	}
+
+	filter, err := parseEntryFilter(c, limit, offset)
 	if err != nil {
-		h.logger.Error("invalid journal id", zap.Error(err))
-		newErrorResponse(c, http.StatusBadRequest, "invalid journal id")
+		loggerFromContext(c).Error("invalid entry filter", zap.Error(err))
+		newErrorResponse(c, http.StatusBadRequest, err.Error())
 		return
 	}
 
-	limit := 20
-	offset := 0
+	entries, err := h.entryService.FilterEntries(c.Request.Context(), journalID, filter)
+	if err != nil {
+		loggerFromContext(c).Error("failed to get journal entries", zap.Error(err))
+		newErrorResponse(c, http.StatusInternalServerError, err.Error())
+		return
+	}
 
-	if limitStr := c.Query("limit"); limitStr != "" {
-		if l, err := strconv.Atoi(limitStr); err == nil && l > 0 && l <= 100 {
-			limit = l
-		}
+	total, err := h.entryService.CountFilteredEntries(c.Request.Context(), journalID, filter)
+	if err != nil {
+		loggerFromContext(c).Error("failed to count journal entries", zap.Error(err))
+		newErrorResponse(c, http.StatusInternalServerError, err.Error())
+		return
 	}
 
-	if offsetStr := c.Query("offset"); offsetStr != "" {
-		if o, err := strconv.Atoi(offsetStr); err == nil && o >= 0 {
-			offset = o
-		}
+	response := &dto.TradingJournalEntryListResponse{
+		Entries:    mapper.ToTradingJournalEntryResponses(entries),
+		Pagination: dto.NewPagination(total, limit, offset),
 	}
 
-	entries, err := h.entryService.GetJournalEntries(c.Request.Context(), journalID, limit, offset)
+	respond(c, http.StatusOK, response)
+}
+
+func (h *TradingJournalEntryHandler) listModifiedSince(c *gin.Context, journalID uuid.UUID, sinceStr string, limit int) {
+	since, err := time.Parse(time.RFC3339, sinceStr)
 	if err != nil {
-		h.logger.Error("failed to get journal entries", zap.Error(err))
-		newErrorResponse(c, http.StatusInternalServerError, err.Error())
+		loggerFromContext(c).Error("invalid modified_since", zap.Error(err))
+		newErrorResponse(c, http.StatusBadRequest, "invalid modified_since, expected RFC 3339 timestamp")
 		return
 	}
 
-	total, err := h.entryService.CountJournalEntries(c.Request.Context(), journalID)
+	entries, nextCursor, err := h.entryService.GetModifiedSince(c.Request.Context(), journalID, since, c.Query("cursor"), limit)
 	if err != nil {
-		h.logger.Error("failed to count journal entries", zap.Error(err))
+		loggerFromContext(c).Error("failed to get entries modified since", zap.Error(err))
+		if errors.Is(err, entity.ErrInvalidSyncCursor) {
+			newErrorResponseFromError(c, http.StatusBadRequest, err)
+			return
+		}
 		newErrorResponse(c, http.StatusInternalServerError, err.Error())
 		return
 	}
 
-	response := &dto.TradingJournalEntryListResponse{
-		Entries: mapper.ToTradingJournalEntryResponses(entries),
-		Total:   total,
-		Limit:   limit,
-		Offset:  offset,
+	response := &dto.EntrySyncResponse{
+		Entries:    mapper.ToSyncEntryResponses(entries),
+		NextCursor: nextCursor,
+		HasMore:    nextCursor != "",
 	}
 
-	c.JSON(http.StatusOK, response)
+	respond(c, http.StatusOK, response)
 }
 
 // GetByID godoc
@@ -186,49 +304,41 @@ func (h *TradingJournalEntryHandler) List(c *gin.Context) {
 // @Success      200 {object} dto.TradingJournalEntryResponse "Successfully retrieved trading entry"
 // @Failure      400 {object} ErrorResponse "Invalid journal ID or entry ID"
 // @Failure      401 {object} ErrorResponse "Unauthorized - missing or invalid token"
-// @Failure      403 {object} ErrorResponse "Access denied - entry does not belong to journal"
+// @Failure      403 {object} ErrorResponse "Access denied - journal does not belong to user, or entry does not belong to journal"
 // @Failure      404 {object} ErrorResponse "Entry not found"
 // @Failure      500 {object} ErrorResponse "Internal server error"
 // @Router       /api/v1/journals/{id}/entries/{entryId} [get]
 func (h *TradingJournalEntryHandler) GetByID(c *gin.Context) {
-	journalIDStr := c.Param("id")
-	journalID, err := uuid.Parse(journalIDStr)
-	if err != nil {
-		h.logger.Error("invalid journal id", zap.Error(err))
-		newErrorResponse(c, http.StatusBadRequest, "invalid journal id")
-		return
-	}
+	journalID := uuidParam(c, "id")
 
-	entryIDStr := c.Param("entryId")
-	entryID, err := uuid.Parse(entryIDStr)
-	if err != nil {
-		h.logger.Error("invalid entry id", zap.Error(err))
-		newErrorResponse(c, http.StatusBadRequest, "invalid entry id")
-		return
-	}
+	entryID := uuidParam(c, "entryId")
 
 	entryAccess, err := h.entryService.VerifyAccess(c.Request.Context(), entryID, journalID)
 	if err != nil {
-		h.logger.Error("failed to verify entry access", zap.Error(err))
+		loggerFromContext(c).Error("failed to verify entry access", zap.Error(err))
 		newErrorResponse(c, http.StatusInternalServerError, err.Error())
 		return
 	}
 
 	if !entryAccess {
-		h.logger.Error("entry does not belong to journal")
+		loggerFromContext(c).Error("entry does not belong to journal")
 		newErrorResponse(c, http.StatusForbidden, "access denied")
 		return
 	}
 
 	entry, err := h.entryService.GetByID(c.Request.Context(), entryID)
 	if err != nil {
-		h.logger.Error("failed to get trading journal entry", zap.Error(err))
-		newErrorResponse(c, http.StatusNotFound, "entry not found")
+		loggerFromContext(c).Error("failed to get trading journal entry", zap.Error(err))
+		if errors.Is(err, entity.ErrNotFound) {
+			newErrorResponse(c, http.StatusNotFound, "entry not found")
+			return
+		}
+		newErrorResponse(c, http.StatusInternalServerError, err.Error())
 		return
 	}
 
 	response := mapper.ToTradingJournalEntryResponse(entry)
-	c.JSON(http.StatusOK, response)
+	respond(c, http.StatusOK, response)
 }
 
 // Update godoc
@@ -242,60 +352,53 @@ func (h *TradingJournalEntryHandler) GetByID(c *gin.Context) {
 // @Param        entryId path string true "Trading Entry ID (UUID)"
 // @Param        request body dto.UpdateTradingJournalEntryRequest true "Updated entry details"
 // @Success      200 {object} dto.TradingJournalEntryResponse "Successfully updated trading entry"
-// @Failure      400 {object} ErrorResponse "Invalid request body, validation failed, invalid journal ID, or invalid entry ID"
+// @Failure      400 {object} ErrorResponse "Invalid request body, validation failed (including an entry the journal's validation profile or notes requirement rejects), invalid journal ID, or invalid entry ID"
 // @Failure      401 {object} ErrorResponse "Unauthorized - missing or invalid token"
-// @Failure      403 {object} ErrorResponse "Access denied - entry does not belong to journal"
+// @Failure      403 {object} ErrorResponse "Access denied - journal does not belong to user, or entry does not belong to journal"
 // @Failure      404 {object} ErrorResponse "Entry not found"
+// @Failure      423 {object} ErrorResponse "Journal is locked"
 // @Failure      500 {object} ErrorResponse "Internal server error"
 // @Router       /api/v1/journals/{id}/entries/{entryId} [put]
 func (h *TradingJournalEntryHandler) Update(c *gin.Context) {
-	journalIDStr := c.Param("id")
-	journalID, err := uuid.Parse(journalIDStr)
-	if err != nil {
-		h.logger.Error("invalid journal id", zap.Error(err))
-		newErrorResponse(c, http.StatusBadRequest, "invalid journal id")
-		return
-	}
+	journalID := uuidParam(c, "id")
 
-	entryIDStr := c.Param("entryId")
-	entryID, err := uuid.Parse(entryIDStr)
-	if err != nil {
-		h.logger.Error("invalid entry id", zap.Error(err))
-		newErrorResponse(c, http.StatusBadRequest, "invalid entry id")
-		return
-	}
+	entryID := uuidParam(c, "entryId")
 
 	var req dto.UpdateTradingJournalEntryRequest
 
 	if err := c.ShouldBindJSON(&req); err != nil {
-		h.logger.Error("failed to bind request", zap.Error(err))
+		loggerFromContext(c).Error("failed to bind request", zap.Error(err))
 		newErrorResponse(c, http.StatusBadRequest, "invalid request body")
 		return
 	}
 
 	if err := h.validate.Struct(&req); err != nil {
-		h.logger.Error("validation failed", zap.Error(err))
-		newErrorResponse(c, http.StatusBadRequest, err.Error())
+		loggerFromContext(c).Error("validation failed", zap.Error(err))
+		newErrorResponseFromError(c, http.StatusBadRequest, err)
 		return
 	}
 
 	entryAccess, err := h.entryService.VerifyAccess(c.Request.Context(), entryID, journalID)
 	if err != nil {
-		h.logger.Error("failed to verify entry access", zap.Error(err))
+		loggerFromContext(c).Error("failed to verify entry access", zap.Error(err))
 		newErrorResponse(c, http.StatusInternalServerError, err.Error())
 		return
 	}
 
 	if !entryAccess {
-		h.logger.Error("entry does not belong to journal")
+		loggerFromContext(c).Error("entry does not belong to journal")
 		newErrorResponse(c, http.StatusForbidden, "access denied")
 		return
 	}
 
 	entry, err := h.entryService.GetByID(c.Request.Context(), entryID)
 	if err != nil {
-		h.logger.Error("failed to get trading journal entry", zap.Error(err))
-		newErrorResponse(c, http.StatusNotFound, "entry not found")
+		loggerFromContext(c).Error("failed to get trading journal entry", zap.Error(err))
+		if errors.Is(err, entity.ErrNotFound) {
+			newErrorResponse(c, http.StatusNotFound, "entry not found")
+			return
+		}
+		newErrorResponse(c, http.StatusInternalServerError, err.Error())
 		return
 	}
 
@@ -304,6 +407,7 @@ func (h *TradingJournalEntryHandler) Update(c *gin.Context) {
 	entry.LTF = req.LTF
 	entry.HTF = req.HTF
 	entry.EntryCharts = req.EntryCharts
+	entry.SetupCharts = req.SetupCharts
 	entry.Session = req.Session
 	entry.TradeType = req.TradeType
 	entry.Setup = req.Setup
@@ -313,86 +417,1093 @@ func (h *TradingJournalEntryHandler) Update(c *gin.Context) {
 	entry.MaxRR = req.MaxRR
 	entry.Result = req.Result
 	entry.Notes = req.Notes
+	entry.Emotion = req.Emotion
+	entry.Confidence = req.Confidence
+	if req.FollowedPlan != nil {
+		entry.FollowedPlan = *req.FollowedPlan
+	}
+	entry.RiskPercent = req.RiskPercent
+	entry.PositionSize = req.PositionSize
+	entry.EntryPrice = req.EntryPrice
+	entry.StopLossPrice = req.StopLossPrice
+	entry.TakeProfitPrice = req.TakeProfitPrice
+	entry.Checklist = req.Checklist
 
 	if err := h.entryService.Update(c.Request.Context(), entry); err != nil {
-		h.logger.Error("failed to update trading journal entry", zap.Error(err))
+		loggerFromContext(c).Error("failed to update trading journal entry", zap.Error(err))
+		if errors.Is(err, entity.ErrNotFound) {
+			newErrorResponse(c, http.StatusNotFound, "entry not found")
+			return
+		}
+		if errors.Is(err, entity.ErrJournalLocked) {
+			newErrorResponseFromError(c, http.StatusLocked, err)
+			return
+		}
+		if errors.Is(err, entity.ErrEntryPolicy) {
+			newErrorResponseFromError(c, http.StatusBadRequest, err)
+			return
+		}
 		newErrorResponse(c, http.StatusInternalServerError, err.Error())
 		return
 	}
 
 	response := mapper.ToTradingJournalEntryResponse(entry)
-	c.JSON(http.StatusOK, response)
+	respond(c, http.StatusOK, response)
 }
 
 // Delete godoc
 // @Summary      Delete trading journal entry
-// @Description  Delete a specific trading journal entry
+// @Description  Delete a specific trading journal entry. By default the entry is soft-deleted: it disappears from all lists, counts and statistics but the row is kept. With hard=true the journal owner permanently removes the row (including an already soft-deleted entry); this cannot be undone.
 // @Tags         Trading Journal Entries
 // @Accept       json
 // @Produce      json
 // @Security     BearerAuth
 // @Param        id path string true "Trading Journal ID (UUID)"
 // @Param        entryId path string true "Trading Entry ID (UUID)"
+// @Param        hard query bool false "Permanently delete instead of soft-deleting (default: false)"
 // @Success      200 {object} map[string]string "Successfully deleted entry"
 // @Failure      400 {object} ErrorResponse "Invalid journal ID or entry ID"
 // @Failure      401 {object} ErrorResponse "Unauthorized - missing or invalid token"
-// @Failure      500 {object} ErrorResponse "Internal server error or access denied"
+// @Failure      403 {object} ErrorResponse "Access denied - journal does not belong to user"
+// @Failure      404 {object} ErrorResponse "Entry not found"
+// @Failure      423 {object} ErrorResponse "Journal is locked"
+// @Failure      500 {object} ErrorResponse "Internal server error"
 // @Router       /api/v1/journals/{id}/entries/{entryId} [delete]
 func (h *TradingJournalEntryHandler) Delete(c *gin.Context) {
-	journalIDStr := c.Param("id")
-	journalID, err := uuid.Parse(journalIDStr)
+	journalID := uuidParam(c, "id")
+
+	entryID := uuidParam(c, "entryId")
+
+	if c.Query("hard") == "true" {
+		h.hardDelete(c, entryID, journalID)
+		return
+	}
+
+	if err := h.entryService.Delete(c.Request.Context(), entryID, journalID); err != nil {
+		loggerFromContext(c).Error("failed to delete trading journal entry", zap.Error(err))
+		if errors.Is(err, entity.ErrNotFound) {
+			newErrorResponse(c, http.StatusNotFound, "entry not found")
+			return
+		}
+		if errors.Is(err, entity.ErrJournalLocked) {
+			newErrorResponseFromError(c, http.StatusLocked, err)
+			return
+		}
+		newErrorResponse(c, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	respond(c, http.StatusOK, gin.H{"message": "entry deleted successfully"})
+}
+
+func (h *TradingJournalEntryHandler) hardDelete(c *gin.Context, entryID, journalID uuid.UUID) {
+	if err := h.entryService.HardDelete(c.Request.Context(), entryID, journalID); err != nil {
+		loggerFromContext(c).Error("failed to hard delete trading journal entry", zap.Error(err))
+		if errors.Is(err, entity.ErrNotFound) {
+			newErrorResponse(c, http.StatusNotFound, "entry not found")
+			return
+		}
+		if errors.Is(err, entity.ErrJournalLocked) {
+			newErrorResponseFromError(c, http.StatusLocked, err)
+			return
+		}
+		newErrorResponse(c, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	respond(c, http.StatusOK, gin.H{"message": "entry permanently deleted"})
+}
+
+// Undo godoc
+// @Summary      Undo last entry deletion
+// @Description  Restore the journal's most recently soft-deleted entry, provided it was deleted in the last 10 minutes. Only the journal owner can undo.
+// @Tags         Trading Journal Entries
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Param        id path string true "Trading Journal ID (UUID)"
+// @Success      200 {object} dto.TradingJournalEntryResponse "Restored entry"
+// @Failure      400 {object} ErrorResponse "Invalid journal ID"
+// @Failure      401 {object} ErrorResponse "Unauthorized - missing or invalid token"
+// @Failure      403 {object} ErrorResponse "Access denied - journal does not belong to user"
+// @Failure      404 {object} ErrorResponse "No recently deleted entry to restore"
+// @Failure      423 {object} ErrorResponse "Journal is locked"
+// @Failure      500 {object} ErrorResponse "Internal server error"
+// @Router       /api/v1/journals/{id}/entries/undo [post]
+func (h *TradingJournalEntryHandler) Undo(c *gin.Context) {
+	journalID := uuidParam(c, "id")
+
+	entry, err := h.entryService.Undo(c.Request.Context(), journalID)
 	if err != nil {
-		h.logger.Error("invalid journal id", zap.Error(err))
-		newErrorResponse(c, http.StatusBadRequest, "invalid journal id")
+		loggerFromContext(c).Error("failed to undo trading journal entry deletion", zap.Error(err))
+		if errors.Is(err, entity.ErrNotFound) {
+			newErrorResponse(c, http.StatusNotFound, "no recently deleted entry to restore")
+			return
+		}
+		if errors.Is(err, entity.ErrJournalLocked) {
+			newErrorResponseFromError(c, http.StatusLocked, err)
+			return
+		}
+		newErrorResponse(c, http.StatusInternalServerError, err.Error())
 		return
 	}
 
-	entryIDStr := c.Param("entryId")
-	entryID, err := uuid.Parse(entryIDStr)
+	respond(c, http.StatusOK, mapper.ToTradingJournalEntryResponse(entry))
+}
+
+// Duplicate godoc
+// @Summary      Duplicate trading journal entry
+// @Description  Copy an existing entry into a new one in the same journal, for quickly logging a similar trade. The copy gets a new ID and timestamps and its day defaults to now; fields set in the optional body override the source values. Partial exits are not copied.
+// @Tags         Trading Journal Entries
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Param        id path string true "Trading Journal ID (UUID)"
+// @Param        entryId path string true "Source Trading Entry ID (UUID)"
+// @Param        request body dto.DuplicateTradingJournalEntryRequest false "Optional overrides for the copy"
+// @Success      201 {object} dto.TradingJournalEntryResponse "Successfully duplicated trading entry"
+// @Header       201 {string} Location "URL of the new entry"
+// @Failure      400 {object} ErrorResponse "Invalid request body, validation failed (including an entry the journal's validation profile or notes requirement rejects), invalid journal ID, or invalid entry ID"
+// @Failure      401 {object} ErrorResponse "Unauthorized - missing or invalid token"
+// @Failure      403 {object} ErrorResponse "Access denied - journal does not belong to user"
+// @Failure      404 {object} ErrorResponse "Entry not found"
+// @Failure      423 {object} ErrorResponse "Journal is locked"
+// @Failure      500 {object} ErrorResponse "Internal server error"
+// @Router       /api/v1/journals/{id}/entries/{entryId}/duplicate [post]
+func (h *TradingJournalEntryHandler) Duplicate(c *gin.Context) {
+	journalID := uuidParam(c, "id")
+
+	entryID := uuidParam(c, "entryId")
+
+	var req dto.DuplicateTradingJournalEntryRequest
+
+	if err := c.ShouldBindJSON(&req); err != nil && !errors.Is(err, io.EOF) {
+		loggerFromContext(c).Error("failed to bind request", zap.Error(err))
+		newErrorResponse(c, http.StatusBadRequest, "invalid request body")
+		return
+	}
+
+	if err := h.validate.Struct(&req); err != nil {
+		loggerFromContext(c).Error("validation failed", zap.Error(err))
+		newErrorResponseFromError(c, http.StatusBadRequest, err)
+		return
+	}
+
+	entry, err := h.entryService.Duplicate(c.Request.Context(), entryID, journalID, &req)
 	if err != nil {
-		h.logger.Error("invalid entry id", zap.Error(err))
-		newErrorResponse(c, http.StatusBadRequest, "invalid entry id")
+		loggerFromContext(c).Error("failed to duplicate trading journal entry", zap.Error(err))
+		if errors.Is(err, entity.ErrNotFound) {
+			newErrorResponse(c, http.StatusNotFound, "entry not found")
+			return
+		}
+		if errors.Is(err, entity.ErrJournalLocked) {
+			newErrorResponseFromError(c, http.StatusLocked, err)
+			return
+		}
+		if errors.Is(err, entity.ErrEntryPolicy) {
+			newErrorResponseFromError(c, http.StatusBadRequest, err)
+			return
+		}
+		newErrorResponse(c, http.StatusInternalServerError, err.Error())
 		return
 	}
 
-	if err := h.entryService.Delete(c.Request.Context(), entryID, journalID); err != nil {
-		h.logger.Error("failed to delete trading journal entry", zap.Error(err))
+	c.Header("Location", entryLocation(entry.JournalID, entry.ID))
+	response := mapper.ToTradingJournalEntryResponse(entry)
+	respond(c, http.StatusCreated, response)
+}
+
+// Transfer godoc
+// @Summary      Move or copy entries to another journal
+// @Description  Move entries of this journal to another journal owned by the same user, or copy them if copy is true. Ownership of both journals and of every entry is verified in the same transaction as the move, so either all entries are transferred or none. Entries must satisfy the target journal's policy. Copies get new IDs and timestamps; partial exits and notes stay with the source entries.
+// @Tags         Trading Journal Entries
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Param        id path string true "Source Trading Journal ID (UUID)"
+// @Param        request body dto.TransferEntriesRequest true "Entries to transfer and the target journal"
+// @Success      200 {object} dto.TransferEntriesResponse "Entries moved"
+// @Success      201 {object} dto.TransferEntriesResponse "Entries copied"
+// @Failure      400 {object} ErrorResponse "Invalid request body, validation failed (including an entry the target journal's validation profile or notes requirement rejects), invalid journal ID, or target journal equal to the source"
+// @Failure      401 {object} ErrorResponse "Unauthorized - missing or invalid token"
+// @Failure      403 {object} ErrorResponse "Access denied - journal does not belong to user"
+// @Failure      404 {object} ErrorResponse "Journal or entries not found"
+// @Failure      423 {object} ErrorResponse "Journal is locked"
+// @Failure      500 {object} ErrorResponse "Internal server error"
+// @Router       /api/v1/journals/{id}/entries/move [post]
+func (h *TradingJournalEntryHandler) Transfer(c *gin.Context) {
+	journalID := uuidParam(c, "id")
+
+	var req dto.TransferEntriesRequest
+
+	if err := c.ShouldBindJSON(&req); err != nil {
+		loggerFromContext(c).Error("failed to bind request", zap.Error(err))
+		newErrorResponse(c, http.StatusBadRequest, "invalid request body")
+		return
+	}
+
+	if err := h.validate.Struct(&req); err != nil {
+		loggerFromContext(c).Error("validation failed", zap.Error(err))
+		newErrorResponseFromError(c, http.StatusBadRequest, err)
+		return
+	}
+
+	userID, exists := c.Get("userID")
+	if !exists {
+		loggerFromContext(c).Error("user id not found in context")
+		newErrorResponse(c, http.StatusUnauthorized, "unauthorized")
+		return
+	}
+
+	uid, ok := userID.(uuid.UUID)
+	if !ok {
+		loggerFromContext(c).Error("invalid user id type in context")
+		newErrorResponse(c, http.StatusInternalServerError, "internal server error")
+		return
+	}
+
+	entries, err := h.entryService.TransferEntries(c.Request.Context(), uid, journalID, &req)
+	if err != nil {
+		loggerFromContext(c).Error("failed to transfer trading journal entries", zap.Error(err))
+		// The transaction wraps domain errors several times over, so they
+		// are reported by their sentinel.
+		if errors.Is(err, entity.ErrNotFound) {
+			newErrorResponse(c, http.StatusNotFound, "journal or entries not found")
+			return
+		}
+		if errors.Is(err, entity.ErrJournalLocked) {
+			newErrorResponseFromError(c, http.StatusLocked, entity.ErrJournalLocked)
+			return
+		}
+		if errors.Is(err, entity.ErrEntryPolicy) {
+			newErrorResponseWithCode(c, http.StatusBadRequest, CodeValidationFailed, errors.UnwrapAll(err).Error())
+			return
+		}
+		if errors.Is(err, entity.ErrSameJournal) {
+			newErrorResponseFromError(c, http.StatusBadRequest, err)
+			return
+		}
 		newErrorResponse(c, http.StatusInternalServerError, err.Error())
 		return
 	}
 
-	c.JSON(http.StatusOK, gin.H{"message": "entry deleted successfully"})
+	status := http.StatusOK
+	if req.Copy {
+		status = http.StatusCreated
+	}
+
+	respond(c, status, mapper.ToTransferEntriesResponse(req.TargetJournalID, req.Copy, entries))
 }
 
-// GetStatistics godoc
-// @Summary      Get trading journal statistics
-// @Description  Retrieve statistical data for a specific trading journal including win rate, total trades, and performance metrics
+// BulkUpdate godoc
+// @Summary      Bulk update trading journal entries
+// @Description  Set fields on every entry of the journal matching filter, e.g. realized 0 on all break-even trades, in one transaction. Only realized, max_rr, result, session, trade_type, entry_type, followed_plan and review_status can be set. Every changed entry is validated again, including against the journal's policy, and regraded; if any entry fails, nothing is saved. An empty filter matches every entry, and limit and offset are ignored. At most 1000 entries can match. With dry_run the changed entries are returned without saving them. Entries with partial exits keep the sum of their exits as realized.
 // @Tags         Trading Journal Entries
 // @Accept       json
 // @Produce      json
 // @Security     BearerAuth
 // @Param        id path string true "Trading Journal ID (UUID)"
-// @Success      200 {object} dto.TradingJournalStatisticsResponse "Successfully retrieved journal statistics"
-// @Failure      400 {object} ErrorResponse "Invalid journal ID"
+// @Param        request body dto.BulkUpdateEntriesRequest true "Filter, fields to set and dry run flag"
+// @Success      200 {object} dto.BulkUpdateEntriesResponse "Updated entries, or the preview for a dry run"
+// @Failure      400 {object} ErrorResponse "Invalid request body, no fields to set, too many matching entries, an entry the update would leave invalid, or invalid journal ID"
 // @Failure      401 {object} ErrorResponse "Unauthorized - missing or invalid token"
+// @Failure      403 {object} ErrorResponse "Access denied - journal does not belong to user"
+// @Failure      404 {object} ErrorResponse "Journal not found"
+// @Failure      423 {object} ErrorResponse "Journal is locked"
 // @Failure      500 {object} ErrorResponse "Internal server error"
-// @Router       /api/v1/journals/{id}/entries/statistics [get]
-func (h *TradingJournalEntryHandler) GetStatistics(c *gin.Context) {
-	journalIDStr := c.Param("id")
-	journalID, err := uuid.Parse(journalIDStr)
+// @Router       /api/v1/journals/{id}/entries/bulk [patch]
+func (h *TradingJournalEntryHandler) BulkUpdate(c *gin.Context) {
+	journalID := uuidParam(c, "id")
+
+	var req dto.BulkUpdateEntriesRequest
+
+	if err := c.ShouldBindJSON(&req); err != nil {
+		loggerFromContext(c).Error("failed to bind request", zap.Error(err))
+		newErrorResponse(c, http.StatusBadRequest, "invalid request body")
+		return
+	}
+
+	if err := h.validate.Struct(&req); err != nil {
+		loggerFromContext(c).Error("validation failed", zap.Error(err))
+		newErrorResponseFromError(c, http.StatusBadRequest, err)
+		return
+	}
+
+	entries, err := h.entryService.BulkUpdate(c.Request.Context(), journalID, &req)
 	if err != nil {
-		h.logger.Error("invalid journal id", zap.Error(err))
-		newErrorResponse(c, http.StatusBadRequest, "invalid journal id")
+		loggerFromContext(c).Error("failed to bulk update trading journal entries", zap.Error(err))
+		if errors.Is(err, entity.ErrEmptyBulkUpdate) ||
+			errors.Is(err, entity.ErrBulkUpdateTooLarge) ||
+			errors.Is(err, entity.ErrInvalidBulkUpdate) ||
+			errors.Is(err, entity.ErrEntryPolicy) {
+			newErrorResponseFromError(c, http.StatusBadRequest, err)
+			return
+		}
+		if errors.Is(err, entity.ErrNotFound) {
+			newErrorResponse(c, http.StatusNotFound, "journal not found")
+			return
+		}
+		if errors.Is(err, entity.ErrJournalLocked) {
+			newErrorResponseFromError(c, http.StatusLocked, err)
+			return
+		}
+		newErrorResponse(c, http.StatusInternalServerError, err.Error())
 		return
 	}
 
-	stats, err := h.entryService.GetStatistics(c.Request.Context(), journalID)
+	response := &dto.BulkUpdateEntriesResponse{
+		DryRun:  req.DryRun,
+		Matched: len(entries),
+		Entries: mapper.ToTradingJournalEntryResponses(entries),
+	}
+	respond(c, http.StatusOK, response)
+}
+
+// Pin godoc
+// @Summary      Pin trading journal entry
+// @Description  Mark a trading journal entry as pinned for later review
+// @Tags         Trading Journal Entries
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Param        id path string true "Trading Journal ID (UUID)"
+// @Param        entryId path string true "Trading Entry ID (UUID)"
+// @Success      200 {object} dto.TradingJournalEntryResponse "Successfully pinned entry"
+// @Failure      400 {object} ErrorResponse "Invalid journal ID or entry ID"
+// @Failure      401 {object} ErrorResponse "Unauthorized - missing or invalid token"
+// @Failure      403 {object} ErrorResponse "Access denied - journal does not belong to user"
+// @Failure      404 {object} ErrorResponse "Entry not found"
+// @Failure      423 {object} ErrorResponse "Journal is locked"
+// @Failure      500 {object} ErrorResponse "Internal server error"
+// @Router       /api/v1/journals/{id}/entries/{entryId}/pin [post]
+func (h *TradingJournalEntryHandler) Pin(c *gin.Context) {
+	h.setPinned(c, true)
+}
+
+// Unpin godoc
+// @Summary      Unpin trading journal entry
+// @Description  Remove the pinned mark from a trading journal entry
+// @Tags         Trading Journal Entries
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Param        id path string true "Trading Journal ID (UUID)"
+// @Param        entryId path string true "Trading Entry ID (UUID)"
+// @Success      200 {object} dto.TradingJournalEntryResponse "Successfully unpinned entry"
+// @Failure      400 {object} ErrorResponse "Invalid journal ID or entry ID"
+// @Failure      401 {object} ErrorResponse "Unauthorized - missing or invalid token"
+// @Failure      403 {object} ErrorResponse "Access denied - journal does not belong to user"
+// @Failure      404 {object} ErrorResponse "Entry not found"
+// @Failure      423 {object} ErrorResponse "Journal is locked"
+// @Failure      500 {object} ErrorResponse "Internal server error"
+// @Router       /api/v1/journals/{id}/entries/{entryId}/unpin [post]
+func (h *TradingJournalEntryHandler) Unpin(c *gin.Context) {
+	h.setPinned(c, false)
+}
+
+func (h *TradingJournalEntryHandler) setPinned(c *gin.Context, pinned bool) {
+	journalID := uuidParam(c, "id")
+
+	entryID := uuidParam(c, "entryId")
+
+	entry, err := h.entryService.SetPinned(c.Request.Context(), entryID, journalID, pinned)
 	if err != nil {
-		h.logger.Error("failed to get journal statistics", zap.Error(err))
+		loggerFromContext(c).Error("failed to set trading journal entry pinned flag", zap.Error(err))
+		if errors.Is(err, entity.ErrNotFound) {
+			newErrorResponse(c, http.StatusNotFound, "entry not found")
+			return
+		}
+		if errors.Is(err, entity.ErrJournalLocked) {
+			newErrorResponseFromError(c, http.StatusLocked, err)
+			return
+		}
 		newErrorResponse(c, http.StatusInternalServerError, err.Error())
 		return
 	}
 
-	response := mapper.ToStatisticsResponse(stats)
-	c.JSON(http.StatusOK, response)
+	response := mapper.ToTradingJournalEntryResponse(entry)
+	respond(c, http.StatusOK, response)
+}
+
+// UpdateReviewStatus godoc
+// @Summary      Update entry review status
+// @Description  Move a trading journal entry through the post-trade review workflow. New entries start as unreviewed; any status can be set from any other.
+// @Tags         Trading Journal Entries
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Param        id path string true "Trading Journal ID (UUID)"
+// @Param        entryId path string true "Trading Entry ID (UUID)"
+// @Param        request body dto.UpdateReviewStatusRequest true "New review status"
+// @Success      200 {object} dto.TradingJournalEntryResponse "Successfully updated review status"
+// @Failure      400 {object} ErrorResponse "Invalid request body, review status, journal ID or entry ID"
+// @Failure      401 {object} ErrorResponse "Unauthorized - missing or invalid token"
+// @Failure      403 {object} ErrorResponse "Access denied - journal does not belong to user"
+// @Failure      404 {object} ErrorResponse "Entry not found"
+// @Failure      423 {object} ErrorResponse "Journal is locked"
+// @Failure      500 {object} ErrorResponse "Internal server error"
+// @Router       /api/v1/journals/{id}/entries/{entryId}/review [patch]
+func (h *TradingJournalEntryHandler) UpdateReviewStatus(c *gin.Context) {
+	journalID := uuidParam(c, "id")
+
+	entryID := uuidParam(c, "entryId")
+
+	var req dto.UpdateReviewStatusRequest
+
+	if err := c.ShouldBindJSON(&req); err != nil {
+		loggerFromContext(c).Error("failed to bind request", zap.Error(err))
+		newErrorResponse(c, http.StatusBadRequest, "invalid request body")
+		return
+	}
+
+	if err := h.validate.Struct(&req); err != nil {
+		loggerFromContext(c).Error("validation failed", zap.Error(err))
+		newErrorResponseFromError(c, http.StatusBadRequest, err)
+		return
+	}
+
+	entry, err := h.entryService.SetReviewStatus(c.Request.Context(), entryID, journalID, req.ReviewStatus)
+	if err != nil {
+		loggerFromContext(c).Error("failed to set trading journal entry review status", zap.Error(err))
+		if errors.Is(err, entity.ErrInvalidReviewStatus) {
+			newErrorResponseFromError(c, http.StatusBadRequest, err)
+			return
+		}
+		if errors.Is(err, entity.ErrNotFound) {
+			newErrorResponse(c, http.StatusNotFound, "entry not found")
+			return
+		}
+		if errors.Is(err, entity.ErrJournalLocked) {
+			newErrorResponseFromError(c, http.StatusLocked, err)
+			return
+		}
+		newErrorResponse(c, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	response := mapper.ToTradingJournalEntryResponse(entry)
+	respond(c, http.StatusOK, response)
+}
+
+// GetLinks godoc
+// @Summary      Get linked entries
+// @Description  List the entries linked to a trading journal entry, newest first
+// @Tags         Trading Journal Entries
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Param        id path string true "Trading Journal ID (UUID)"
+// @Param        entryId path string true "Trading Entry ID (UUID)"
+// @Success      200 {object} dto.RelatedEntriesResponse "Linked entries"
+// @Failure      400 {object} ErrorResponse "Invalid journal ID or entry ID"
+// @Failure      401 {object} ErrorResponse "Unauthorized - missing or invalid token"
+// @Failure      403 {object} ErrorResponse "Access denied - journal does not belong to user"
+// @Failure      404 {object} ErrorResponse "Entry not found"
+// @Failure      500 {object} ErrorResponse "Internal server error"
+// @Router       /api/v1/journals/{id}/entries/{entryId}/links [get]
+func (h *TradingJournalEntryHandler) GetLinks(c *gin.Context) {
+	journalID := uuidParam(c, "id")
+
+	entryID := uuidParam(c, "entryId")
+
+	entries, err := h.entryService.GetRelated(c.Request.Context(), entryID, journalID)
+	if err != nil {
+		loggerFromContext(c).Error("failed to get linked trading journal entries", zap.Error(err))
+		if errors.Is(err, entity.ErrNotFound) {
+			newErrorResponse(c, http.StatusNotFound, "entry not found")
+			return
+		}
+		newErrorResponse(c, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	response := &dto.RelatedEntriesResponse{
+		Entries: mapper.ToTradingJournalEntryResponses(entries),
+	}
+	respond(c, http.StatusOK, response)
+}
+
+// Link godoc
+// @Summary      Link trading journal entries
+// @Description  Link an entry to another entry of the same journal, such as a re-entry or a scale-in. Links go both ways; linking entries that are already linked does nothing.
+// @Tags         Trading Journal Entries
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Param        id path string true "Trading Journal ID (UUID)"
+// @Param        entryId path string true "Trading Entry ID (UUID)"
+// @Param        request body dto.LinkEntryRequest true "Entry to link to"
+// @Success      200 {object} dto.TradingJournalEntryResponse "Successfully linked entries"
+// @Failure      400 {object} ErrorResponse "Invalid request body, self link, too many links, journal ID or entry ID"
+// @Failure      401 {object} ErrorResponse "Unauthorized - missing or invalid token"
+// @Failure      403 {object} ErrorResponse "Access denied - journal does not belong to user"
+// @Failure      404 {object} ErrorResponse "Entry not found in this journal"
+// @Failure      423 {object} ErrorResponse "Journal is locked"
+// @Failure      500 {object} ErrorResponse "Internal server error"
+// @Router       /api/v1/journals/{id}/entries/{entryId}/links [post]
+func (h *TradingJournalEntryHandler) Link(c *gin.Context) {
+	journalID := uuidParam(c, "id")
+
+	entryID := uuidParam(c, "entryId")
+
+	var req dto.LinkEntryRequest
+
+	if err := c.ShouldBindJSON(&req); err != nil {
+		loggerFromContext(c).Error("failed to bind request", zap.Error(err))
+		newErrorResponse(c, http.StatusBadRequest, "invalid request body")
+		return
+	}
+
+	if err := h.validate.Struct(&req); err != nil {
+		loggerFromContext(c).Error("validation failed", zap.Error(err))
+		newErrorResponseFromError(c, http.StatusBadRequest, err)
+		return
+	}
+
+	entry, err := h.entryService.LinkEntry(c.Request.Context(), entryID, journalID, req.EntryID)
+	if err != nil {
+		loggerFromContext(c).Error("failed to link trading journal entries", zap.Error(err))
+		if errors.Is(err, entity.ErrSelfLink) || errors.Is(err, entity.ErrTooManyLinks) {
+			newErrorResponseFromError(c, http.StatusBadRequest, err)
+			return
+		}
+		if errors.Is(err, entity.ErrNotFound) {
+			newErrorResponse(c, http.StatusNotFound, "entry not found")
+			return
+		}
+		if errors.Is(err, entity.ErrJournalLocked) {
+			newErrorResponseFromError(c, http.StatusLocked, err)
+			return
+		}
+		newErrorResponse(c, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	response := mapper.ToTradingJournalEntryResponse(entry)
+	respond(c, http.StatusOK, response)
+}
+
+// Unlink godoc
+// @Summary      Unlink trading journal entries
+// @Description  Remove the link between two entries, from both sides. Unlinking entries that are not linked does nothing.
+// @Tags         Trading Journal Entries
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Param        id path string true "Trading Journal ID (UUID)"
+// @Param        entryId path string true "Trading Entry ID (UUID)"
+// @Param        linkedId path string true "Linked Entry ID (UUID)"
+// @Success      200 {object} dto.TradingJournalEntryResponse "Successfully unlinked entries"
+// @Failure      400 {object} ErrorResponse "Invalid journal ID or entry ID"
+// @Failure      401 {object} ErrorResponse "Unauthorized - missing or invalid token"
+// @Failure      403 {object} ErrorResponse "Access denied - journal does not belong to user"
+// @Failure      404 {object} ErrorResponse "Entry not found"
+// @Failure      423 {object} ErrorResponse "Journal is locked"
+// @Failure      500 {object} ErrorResponse "Internal server error"
+// @Router       /api/v1/journals/{id}/entries/{entryId}/links/{linkedId} [delete]
+func (h *TradingJournalEntryHandler) Unlink(c *gin.Context) {
+	journalID := uuidParam(c, "id")
+
+	entryID := uuidParam(c, "entryId")
+
+	linkedID := uuidParam(c, "linkedId")
+
+	entry, err := h.entryService.UnlinkEntry(c.Request.Context(), entryID, journalID, linkedID)
+	if err != nil {
+		loggerFromContext(c).Error("failed to unlink trading journal entries", zap.Error(err))
+		if errors.Is(err, entity.ErrNotFound) {
+			newErrorResponse(c, http.StatusNotFound, "entry not found")
+			return
+		}
+		if errors.Is(err, entity.ErrJournalLocked) {
+			newErrorResponseFromError(c, http.StatusLocked, err)
+			return
+		}
+		newErrorResponse(c, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	response := mapper.ToTradingJournalEntryResponse(entry)
+	respond(c, http.StatusOK, response)
+}
+
+// GetStatistics godoc
+// @Summary      Get trading journal statistics
+// @Description  Retrieve statistical data for a specific trading journal including win rate, total trades, and performance metrics
+// @Tags         Trading Journal Entries
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Param        id path string true "Trading Journal ID (UUID)"
+// @Success      200 {object} dto.TradingJournalStatisticsResponse "Successfully retrieved journal statistics"
+// @Failure      400 {object} ErrorResponse "Invalid journal ID"
+// @Failure      401 {object} ErrorResponse "Unauthorized - missing or invalid token"
+// @Failure      403 {object} ErrorResponse "Access denied - journal does not belong to user"
+// @Failure      500 {object} ErrorResponse "Internal server error"
+// @Router       /api/v1/journals/{id}/entries/statistics [get]
+func (h *TradingJournalEntryHandler) GetStatistics(c *gin.Context) {
+	journalID := uuidParam(c, "id")
+
+	stats, err := h.entryService.GetStatistics(c.Request.Context(), journalID)
+	if err != nil {
+		loggerFromContext(c).Error("failed to get journal statistics", zap.Error(err))
+		newErrorResponse(c, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	response := mapper.ToStatisticsResponse(stats)
+	respond(c, http.StatusOK, response)
+}
+
+// CompareStatistics godoc
+// @Summary      Compare trading journal statistics of two periods
+// @Description  Retrieve the journal statistics for two periods side by side, with delta holding period B minus period A, e.g. to see month-over-month improvement. Periods are inclusive YYYY-MM-DD days and may overlap.
+// @Tags         Trading Journal Entries
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Param        id path string true "Trading Journal ID (UUID)"
+// @Param        period_a_start query string true "First day of period A (YYYY-MM-DD)"
+// @Param        period_a_end query string true "Last day of period A (YYYY-MM-DD)"
+// @Param        period_b_start query string true "First day of period B (YYYY-MM-DD)"
+// @Param        period_b_end query string true "Last day of period B (YYYY-MM-DD)"
+// @Success      200 {object} dto.StatisticsComparisonResponse "Successfully compared journal statistics"
+// @Failure      400 {object} ErrorResponse "Invalid journal ID, or a missing, malformed or reversed period"
+// @Failure      401 {object} ErrorResponse "Unauthorized - missing or invalid token"
+// @Failure      403 {object} ErrorResponse "Access denied - journal does not belong to user"
+// @Failure      500 {object} ErrorResponse "Internal server error"
+// @Router       /api/v1/journals/{id}/entries/statistics/compare [get]
+func (h *TradingJournalEntryHandler) CompareStatistics(c *gin.Context) {
+	journalID := uuidParam(c, "id")
+
+	periodA, err := periodQuery(c, "period_a")
+	if err != nil {
+		newErrorResponse(c, http.StatusBadRequest, err.Error())
+		return
+	}
+	periodB, err := periodQuery(c, "period_b")
+	if err != nil {
+		newErrorResponse(c, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	comparison, err := h.entryService.ComparePeriods(c.Request.Context(), journalID, periodA, periodB)
+	if err != nil {
+		loggerFromContext(c).Error("failed to compare journal statistics", zap.Error(err))
+		newErrorResponse(c, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	response := mapper.ToStatisticsComparisonResponse(comparison)
+	respond(c, http.StatusOK, response)
+}
+
+// GetStatisticsByEmotion godoc
+// @Summary      Get trading journal statistics by emotion
+// @Description  Retrieve win rate and performance grouped by the emotional state recorded on each entry. Entries without an emotion are excluded.
+// @Tags         Trading Journal Entries
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Param        id path string true "Trading Journal ID (UUID)"
+// @Success      200 {object} dto.EmotionStatisticsListResponse "Successfully retrieved journal statistics by emotion"
+// @Failure      400 {object} ErrorResponse "Invalid journal ID"
+// @Failure      401 {object} ErrorResponse "Unauthorized - missing or invalid token"
+// @Failure      403 {object} ErrorResponse "Access denied - journal does not belong to user"
+// @Failure      500 {object} ErrorResponse "Internal server error"
+// @Router       /api/v1/journals/{id}/entries/statistics/by-emotion [get]
+func (h *TradingJournalEntryHandler) GetStatisticsByEmotion(c *gin.Context) {
+	journalID := uuidParam(c, "id")
+
+	stats, err := h.entryService.GetStatisticsByEmotion(c.Request.Context(), journalID)
+	if err != nil {
+		loggerFromContext(c).Error("failed to get journal statistics by emotion", zap.Error(err))
+		newErrorResponse(c, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	response := mapper.ToEmotionStatisticsResponses(stats)
+	respond(c, http.StatusOK, response)
+}
+
+// GetStatisticsByCategory godoc
+// @Summary      Get trading journal statistics by asset category
+// @Description  Retrieve win rate and net realized grouped by asset category (major, minor, exotic). Categories without trades are omitted.
+// @Tags         Trading Journal Entries
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Param        id path string true "Trading Journal ID (UUID)"
+// @Success      200 {object} dto.CategoryStatisticsListResponse "Successfully retrieved journal statistics by asset category"
+// @Failure      400 {object} ErrorResponse "Invalid journal ID"
+// @Failure      401 {object} ErrorResponse "Unauthorized - missing or invalid token"
+// @Failure      403 {object} ErrorResponse "Access denied - journal does not belong to user"
+// @Failure      500 {object} ErrorResponse "Internal server error"
+// @Router       /api/v1/journals/{id}/entries/statistics/by-category [get]
+func (h *TradingJournalEntryHandler) GetStatisticsByCategory(c *gin.Context) {
+	journalID := uuidParam(c, "id")
+
+	stats, err := h.entryService.GetStatisticsByCategory(c.Request.Context(), journalID)
+	if err != nil {
+		loggerFromContext(c).Error("failed to get journal statistics by category", zap.Error(err))
+		newErrorResponse(c, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	response := mapper.ToCategoryStatisticsResponses(stats)
+	respond(c, http.StatusOK, response)
+}
+
+// GetStatisticsByGrade godoc
+// @Summary      Get trading journal statistics by grade
+// @Description  Retrieve win rate and performance grouped by the letter grade computed for each entry from plan adherence, RR reached versus planned and outcome. Grades without trades are omitted.
+// @Tags         Trading Journal Entries
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Param        id path string true "Trading Journal ID (UUID)"
+// @Success      200 {object} dto.GradeStatisticsListResponse "Successfully retrieved journal statistics by grade"
+// @Failure      400 {object} ErrorResponse "Invalid journal ID"
+// @Failure      401 {object} ErrorResponse "Unauthorized - missing or invalid token"
+// @Failure      403 {object} ErrorResponse "Access denied - journal does not belong to user"
+// @Failure      500 {object} ErrorResponse "Internal server error"
+// @Router       /api/v1/journals/{id}/entries/statistics/by-grade [get]
+func (h *TradingJournalEntryHandler) GetStatisticsByGrade(c *gin.Context) {
+	journalID := uuidParam(c, "id")
+
+	stats, err := h.entryService.GetStatisticsByGrade(c.Request.Context(), journalID)
+	if err != nil {
+		loggerFromContext(c).Error("failed to get journal statistics by grade", zap.Error(err))
+		newErrorResponse(c, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	response := mapper.ToGradeStatisticsResponses(stats)
+	respond(c, http.StatusOK, response)
+}
+
+// GetStatisticsByConfidence godoc
+// @Summary      Get trading journal statistics by confidence
+// @Description  Retrieve win rate and net realized grouped by the pre-trade confidence (1-5) recorded on each entry, to show whether conviction is calibrated. Entries without a confidence are excluded.
+// @Tags         Trading Journal Entries
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Param        id path string true "Trading Journal ID (UUID)"
+// @Success      200 {object} dto.ConfidenceStatisticsListResponse "Successfully retrieved journal statistics by confidence"
+// @Failure      400 {object} ErrorResponse "Invalid journal ID"
+// @Failure      401 {object} ErrorResponse "Unauthorized - missing or invalid token"
+// @Failure      403 {object} ErrorResponse "Access denied - journal does not belong to user"
+// @Failure      500 {object} ErrorResponse "Internal server error"
+// @Router       /api/v1/journals/{id}/entries/statistics/by-confidence [get]
+func (h *TradingJournalEntryHandler) GetStatisticsByConfidence(c *gin.Context) {
+	journalID := uuidParam(c, "id")
+
+	stats, err := h.entryService.GetStatisticsByConfidence(c.Request.Context(), journalID)
+	if err != nil {
+		loggerFromContext(c).Error("failed to get journal statistics by confidence", zap.Error(err))
+		newErrorResponse(c, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	response := mapper.ToConfidenceStatisticsResponses(stats)
+	respond(c, http.StatusOK, response)
+}
+
+// GetChecklistAdherence godoc
+// @Summary      Get trading journal checklist adherence
+// @Description  Retrieve, for each pre-trade checklist item used in the journal, how often it was checked and the win rate of trades with and without it checked, most used items first. Entries without a checklist are excluded.
+// @Tags         Trading Journal Entries
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Param        id path string true "Trading Journal ID (UUID)"
+// @Success      200 {object} dto.ChecklistAdherenceResponse "Successfully retrieved checklist adherence"
+// @Failure      400 {object} ErrorResponse "Invalid journal ID"
+// @Failure      401 {object} ErrorResponse "Unauthorized - missing or invalid token"
+// @Failure      403 {object} ErrorResponse "Access denied - journal does not belong to user"
+// @Failure      500 {object} ErrorResponse "Internal server error"
+// @Router       /api/v1/journals/{id}/entries/statistics/checklist-adherence [get]
+func (h *TradingJournalEntryHandler) GetChecklistAdherence(c *gin.Context) {
+	journalID := uuidParam(c, "id")
+
+	stats, err := h.entryService.GetChecklistAdherence(c.Request.Context(), journalID)
+	if err != nil {
+		loggerFromContext(c).Error("failed to get journal checklist adherence", zap.Error(err))
+		newErrorResponse(c, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	response := mapper.ToChecklistAdherenceResponse(stats)
+	respond(c, http.StatusOK, response)
+}
+
+// GetAdherenceStatistics godoc
+// @Summary      Get trading plan adherence statistics
+// @Description  Compare win rate and net realized of trades that followed the trading plan with those that didn't
+// @Tags         Trading Journal Entries
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Param        id path string true "Trading Journal ID (UUID)"
+// @Success      200 {object} dto.AdherenceStatisticsResponse "Successfully retrieved plan adherence statistics"
+// @Failure      400 {object} ErrorResponse "Invalid journal ID"
+// @Failure      401 {object} ErrorResponse "Unauthorized - missing or invalid token"
+// @Failure      403 {object} ErrorResponse "Access denied - journal does not belong to user"
+// @Failure      500 {object} ErrorResponse "Internal server error"
+// @Router       /api/v1/journals/{id}/entries/statistics/adherence [get]
+func (h *TradingJournalEntryHandler) GetAdherenceStatistics(c *gin.Context) {
+	journalID := uuidParam(c, "id")
+
+	stats, err := h.entryService.GetAdherenceStatistics(c.Request.Context(), journalID)
+	if err != nil {
+		loggerFromContext(c).Error("failed to get journal plan adherence statistics", zap.Error(err))
+		newErrorResponse(c, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	response := mapper.ToAdherenceStatisticsResponse(stats)
+	respond(c, http.StatusOK, response)
+}
+
+// GetReviewProgress godoc
+// @Summary      Get review progress
+// @Description  Retrieve the number of entries in each review status
+// @Tags         Trading Journal Entries
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Param        id path string true "Trading Journal ID (UUID)"
+// @Success      200 {object} dto.ReviewProgressResponse "Successfully retrieved review progress"
+// @Failure      400 {object} ErrorResponse "Invalid journal ID"
+// @Failure      401 {object} ErrorResponse "Unauthorized - missing or invalid token"
+// @Failure      403 {object} ErrorResponse "Access denied - journal does not belong to user"
+// @Failure      500 {object} ErrorResponse "Internal server error"
+// @Router       /api/v1/journals/{id}/entries/statistics/review-progress [get]
+func (h *TradingJournalEntryHandler) GetReviewProgress(c *gin.Context) {
+	journalID := uuidParam(c, "id")
+
+	progress, err := h.entryService.GetReviewProgress(c.Request.Context(), journalID)
+	if err != nil {
+		loggerFromContext(c).Error("failed to get journal review progress", zap.Error(err))
+		newErrorResponse(c, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	response := mapper.ToReviewProgressResponse(progress)
+	respond(c, http.StatusOK, response)
+}
+
+// GetAssetCorrelation godoc
+// @Summary      Get asset correlation matrix
+// @Description  Retrieve per-asset win rates and, for every two assets traded on the same day, the number of shared days and the combined win rate on those days. Assets with fewer than 5 trades are skipped and only the 10 most traded assets are included.
+// @Tags         Trading Journal Entries
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Param        id path string true "Trading Journal ID (UUID)"
+// @Param        timezone query string false "IANA time zone whose days trades are grouped by (default: UTC)"
+// @Success      200 {object} dto.AssetCorrelationResponse "Successfully retrieved asset correlation"
+// @Failure      400 {object} ErrorResponse "Invalid journal ID or timezone"
+// @Failure      401 {object} ErrorResponse "Unauthorized - missing or invalid token"
+// @Failure      403 {object} ErrorResponse "Access denied - journal does not belong to user"
+// @Failure      500 {object} ErrorResponse "Internal server error"
+// @Router       /api/v1/journals/{id}/entries/statistics/asset-correlation [get]
+func (h *TradingJournalEntryHandler) GetAssetCorrelation(c *gin.Context) {
+	journalID := uuidParam(c, "id")
+
+	loc, err := timezoneQuery(c)
+	if err != nil {
+		newErrorResponse(c, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	correlation, err := h.entryService.GetAssetCorrelation(c.Request.Context(), journalID, loc)
+	if err != nil {
+		loggerFromContext(c).Error("failed to get asset correlation", zap.Error(err))
+		newErrorResponse(c, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	response := mapper.ToAssetCorrelationResponse(correlation)
+	respond(c, http.StatusOK, response)
+}
+
+// GetCalendar godoc
+// @Summary      Get entry calendar heatmap
+// @Description  Retrieve the number of entries and net realized per day for one year, for a contribution-style heatmap. Only days with entries are returned.
+// @Tags         Trading Journal Entries
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Param        id path string true "Trading Journal ID (UUID)"
+// @Param        year query int false "Calendar year (default: current year)"
+// @Param        timezone query string false "IANA time zone whose days entries are grouped by, e.g. Asia/Tokyo (default: UTC)"
+// @Success      200 {object} dto.CalendarResponse "Successfully retrieved calendar"
+// @Failure      400 {object} ErrorResponse "Invalid journal ID, year or timezone"
+// @Failure      401 {object} ErrorResponse "Unauthorized - missing or invalid token"
+// @Failure      403 {object} ErrorResponse "Access denied - journal does not belong to user"
+// @Failure      500 {object} ErrorResponse "Internal server error"
+// @Router       /api/v1/journals/{id}/entries/calendar [get]
+func (h *TradingJournalEntryHandler) GetCalendar(c *gin.Context) {
+	journalID := uuidParam(c, "id")
+
+	loc, err := timezoneQuery(c)
+	if err != nil {
+		newErrorResponse(c, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	year := time.Now().In(loc).Year()
+	if yearStr := c.Query("year"); yearStr != "" {
+		y, err := strconv.Atoi(yearStr)
+		if err != nil || y < minCalendarYear || y > maxCalendarYear {
+			loggerFromContext(c).Error("invalid calendar year", zap.String("year", yearStr))
+			newErrorResponse(c, http.StatusBadRequest, "invalid year")
+			return
+		}
+		year = y
+	}
+
+	stats, err := h.entryService.GetCalendar(c.Request.Context(), journalID, year, loc)
+	if err != nil {
+		loggerFromContext(c).Error("failed to get journal calendar", zap.Error(err))
+		newErrorResponse(c, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	response := mapper.ToCalendarResponse(year, stats)
+	respond(c, http.StatusOK, response)
+}
+
+// GetFacets godoc
+// @Summary      Get the filter values used in a journal
+// @Description  Retrieve the distinct assets, sessions, results and trade types that appear in the journal's entries, with how many entries use each, most used first. Values no entry uses are omitted.
+// @Tags         Trading Journal Entries
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Param        id path string true "Trading Journal ID (UUID)"
+// @Success      200 {object} dto.EntryFacetsResponse "Successfully retrieved entry facets"
+// @Failure      400 {object} ErrorResponse "Invalid journal ID"
+// @Failure      401 {object} ErrorResponse "Unauthorized - missing or invalid token"
+// @Failure      403 {object} ErrorResponse "Access denied - journal does not belong to user"
+// @Failure      500 {object} ErrorResponse "Internal server error"
+// @Router       /api/v1/journals/{id}/entries/facets [get]
+func (h *TradingJournalEntryHandler) GetFacets(c *gin.Context) {
+	journalID := uuidParam(c, "id")
+
+	facets, err := h.entryService.GetFacets(c.Request.Context(), journalID)
+	if err != nil {
+		loggerFromContext(c).Error("failed to get journal entry facets", zap.Error(err))
+		newErrorResponse(c, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	response := mapper.ToEntryFacetsResponse(facets)
+	respond(c, http.StatusOK, response)
+}
+
+// parseEntryFilter builds the entry filter from the list query parameters.
+// Returned errors are safe to show to the client.
+func parseEntryFilter(c *gin.Context, limit, offset int) (*dto.FilterEntriesRequest, error) {
+	filter := &dto.FilterEntriesRequest{
+		Limit:  limit,
+		Offset: offset,
+	}
+
+	if pinnedStr := c.Query("pinned"); pinnedStr != "" {
+		pinned, err := strconv.ParseBool(pinnedStr)
+		if err != nil {
+			return nil, errors.New("invalid pinned filter")
+		}
+		filter.Pinned = &pinned
+	}
+
+	if reviewStatusStr := c.Query("review_status"); reviewStatusStr != "" {
+		reviewStatus := types.ReviewStatus(reviewStatusStr)
+		if !reviewStatus.IsValid() {
+			return nil, errors.New("invalid review status")
+		}
+		filter.ReviewStatus = &reviewStatus
+	}
+
+	if followedPlanStr := c.Query("followed_plan"); followedPlanStr != "" {
+		followedPlan, err := strconv.ParseBool(followedPlanStr)
+		if err != nil {
+			return nil, errors.New("invalid followed_plan filter")
+		}
+		filter.FollowedPlan = &followedPlan
+	}
+
+	if tradeTypeStr := c.Query("trade_type"); tradeTypeStr != "" {
+		tradeType := types.TradeType(tradeTypeStr)
+		if !tradeType.IsValid() {
+			return nil, errors.New("invalid trade type")
+		}
+		filter.TradeType = &tradeType
+	}
+
+	if entryTypeStr := c.Query("entry_type"); entryTypeStr != "" {
+		entryType := types.EntryType(entryTypeStr)
+		if !entryType.IsValid() {
+			return nil, errors.New("invalid entry type")
+		}
+		filter.EntryType = &entryType
+	}
+
+	minRR, err := parseFloatQuery(c, "min_rr")
+	if err != nil {
+		return nil, err
+	}
+
+	maxRR, err := parseFloatQuery(c, "max_rr")
+	if err != nil {
+		return nil, err
+	}
+
+	if (minRR != nil && *minRR < 0) || (maxRR != nil && *maxRR < 0) {
+		return nil, errors.New("min_rr and max_rr must not be negative")
+	}
+
+	if minRR != nil && maxRR != nil && *minRR > *maxRR {
+		return nil, errors.New("min_rr must be less than or equal to max_rr")
+	}
+
+	filter.MinRR = minRR
+	filter.MaxRR = maxRR
+
+	minRealized, err := parseFloatQuery(c, "min_realized")
+	if err != nil {
+		return nil, err
+	}
+
+	maxRealized, err := parseFloatQuery(c, "max_realized")
+	if err != nil {
+		return nil, err
+	}
+
+	if minRealized != nil && maxRealized != nil && *minRealized > *maxRealized {
+		return nil, errors.New("min_realized must be less than or equal to max_realized")
+	}
+
+	filter.MinRealized = minRealized
+	filter.MaxRealized = maxRealized
+
+	return filter, nil
+}
+
+func parseFloatQuery(c *gin.Context, key string) (*float64, error) {
+	str := c.Query(key)
+	if str == "" {
+		return nil, nil
+	}
+
+	// ParseFloat accepts "NaN" and "Inf", which no filter can compare against.
+	value, err := strconv.ParseFloat(str, 64)
+	if err != nil || math.IsNaN(value) || math.IsInf(value, 0) {
+		return nil, errors.Newf("invalid %s", key)
+	}
+
+	return &value, nil
 }
@@ -0,0 +1,1684 @@
+package v1
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/cockroachdb/errors"
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/user/normark/internal/dto"
+	"github.com/user/normark/internal/entity"
+	"github.com/user/normark/internal/types"
+)
+
+func TestParseFloatQuery(t *testing.T) {
+	tests := []struct {
+		query   string
+		want    *float64
+		wantErr bool
+	}{
+		{"", nil, false},
+		{"min_rr=1.5", ptrTo(1.5), false},
+		{"min_rr=-2", ptrTo(-2.0), false},
+		{"min_rr=abc", nil, true},
+		{"min_rr=NaN", nil, true},
+		{"min_rr=nan", nil, true},
+		{"min_rr=Inf", nil, true},
+		{"min_rr=-Inf", nil, true},
+		{"min_rr=%2BInf", nil, true},
+		{"min_rr=1e400", nil, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.query, func(t *testing.T) {
+			c, _ := gin.CreateTestContext(httptest.NewRecorder())
+			c.Request = httptest.NewRequest(http.MethodGet, "/?"+tt.query, nil)
+
+			got, err := parseFloatQuery(c, "min_rr")
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("parseFloatQuery() error = %v, want error %v", err, tt.wantErr)
+			}
+			if (got == nil) != (tt.want == nil) || (got != nil && *got != *tt.want) {
+				t.Errorf("parseFloatQuery() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestListRejectsNonFiniteFilters(t *testing.T) {
+	journalID := uuid.New()
+	access := &fakeJournalAccess{owned: map[uuid.UUID]bool{journalID: true}}
+	router := newTestRouter(t, access, testServices{})
+
+	for _, query := range []string{"min_rr=NaN", "max_rr=Inf", "min_realized=-Inf", "max_realized=NaN"} {
+		t.Run(query, func(t *testing.T) {
+			rec := doRequest(router, http.MethodGet, "/api/v1/journals/"+journalID.String()+"/entries?"+query, "")
+			if rec.Code != http.StatusBadRequest {
+				t.Errorf("status = %d, want %d; body %s", rec.Code, http.StatusBadRequest, rec.Body)
+			}
+		})
+	}
+}
+
+func TestImportRejectsNonFiniteMaxRR(t *testing.T) {
+	router := newTestRouter(t, &fakeJournalAccess{}, testServices{})
+
+	// The other parameters are valid, so only max_rr can be rejected.
+	query := "?source=ctrader&ltf=https://charts.example.com/ltf&htf=https://charts.example.com/htf&max_rr="
+	for _, maxRR := range []string{"NaN", "Inf", "%2BInf", "-Inf"} {
+		t.Run(maxRR, func(t *testing.T) {
+			rec := doRequest(router, http.MethodPost, "/api/v1/journals/"+uuid.NewString()+"/import"+query+maxRR, "")
+			if rec.Code != http.StatusBadRequest || !strings.Contains(rec.Body.String(), "invalid max_rr parameter") {
+				t.Errorf("status = %d, body %s; want %d rejecting max_rr", rec.Code, rec.Body, http.StatusBadRequest)
+			}
+		})
+	}
+}
+
+func ptrTo[T any](v T) *T {
+	return &v
+}
+
+// filterEntryService records the filter the list endpoint passes on and
+// reports total matching entries.
+type filterEntryService struct {
+	TradingJournalEntryService
+	filter *dto.FilterEntriesRequest
+	counts int
+	total  int
+}
+
+func (s *filterEntryService) FilterEntries(_ context.Context, _ uuid.UUID, filter *dto.FilterEntriesRequest) ([]*entity.TradingJournalEntry, error) {
+	s.filter = filter
+	return nil, nil
+}
+
+func (s *filterEntryService) CountFilteredEntries(_ context.Context, _ uuid.UUID, filter *dto.FilterEntriesRequest) (int, error) {
+	s.counts++
+	if filter != s.filter {
+		return 0, errors.New("count filter differs from list filter")
+	}
+	return s.total, nil
+}
+
+// listEntries requests the journal's entries with query and returns the
+// response and the filter the service saw.
+func listEntries(t *testing.T, query string) (*httptest.ResponseRecorder, *filterEntryService) {
+	t.Helper()
+
+	journalID := uuid.New()
+	entries := &filterEntryService{total: 45}
+	access := &fakeJournalAccess{owned: map[uuid.UUID]bool{journalID: true}}
+	router := newTestRouter(t, access, testServices{entries: entries})
+
+	return doRequest(router, http.MethodGet, "/api/v1/journals/"+journalID.String()+"/entries?"+query, ""), entries
+}
+
+func TestListFiltersByTradeType(t *testing.T) {
+	tests := []struct {
+		query      string
+		wantStatus int
+		want       *types.TradeType
+	}{
+		{"", http.StatusOK, nil},
+		{"trade_type=swing", http.StatusOK, ptrTo(types.TradeTypeSwing)},
+		{"trade_type=intraday", http.StatusOK, ptrTo(types.TradeTypeIntraday)},
+		{"trade_type=scalp", http.StatusBadRequest, nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.query, func(t *testing.T) {
+			rec, entries := listEntries(t, tt.query)
+			if rec.Code != tt.wantStatus {
+				t.Fatalf("status = %d, want %d; body %s", rec.Code, tt.wantStatus, rec.Body)
+			}
+			if tt.wantStatus != http.StatusOK {
+				if entries.filter != nil {
+					t.Errorf("service reached with an invalid trade type")
+				}
+				return
+			}
+
+			got := entries.filter.TradeType
+			if (got == nil) != (tt.want == nil) || (got != nil && *got != *tt.want) {
+				t.Errorf("trade type filter = %v, want %v", got, tt.want)
+			}
+
+			var response dto.TradingJournalEntryListResponse
+			if err := json.Unmarshal(rec.Body.Bytes(), &response); err != nil {
+				t.Fatalf("decode response: %v", err)
+			}
+			if entries.counts != 1 || response.Total != entries.total {
+				t.Errorf("total = %d after %d counts, want the filtered total %d", response.Total, entries.counts, entries.total)
+			}
+		})
+	}
+}
+
+func TestListFiltersByEntryType(t *testing.T) {
+	tests := []struct {
+		query      string
+		wantStatus int
+		want       *types.EntryType
+	}{
+		{"", http.StatusOK, nil},
+		{"entry_type=market", http.StatusOK, ptrTo(types.EntryTypeMarket)},
+		{"entry_type=limit", http.StatusOK, ptrTo(types.EntryTypeLimit)},
+		{"entry_type=stop", http.StatusBadRequest, nil},
+		{"entry_type=MARKET", http.StatusBadRequest, nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.query, func(t *testing.T) {
+			rec, entries := listEntries(t, tt.query)
+			if rec.Code != tt.wantStatus {
+				t.Fatalf("status = %d, want %d; body %s", rec.Code, tt.wantStatus, rec.Body)
+			}
+			if tt.wantStatus != http.StatusOK {
+				if entries.filter != nil {
+					t.Errorf("service reached with an invalid entry type")
+				}
+				return
+			}
+
+			got := entries.filter.EntryType
+			if (got == nil) != (tt.want == nil) || (got != nil && *got != *tt.want) {
+				t.Errorf("entry type filter = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestListFiltersByRRRange(t *testing.T) {
+	tests := []struct {
+		query      string
+		wantStatus int
+		wantMin    *float64
+		wantMax    *float64
+	}{
+		{"min_rr=2", http.StatusOK, ptrTo(2.0), nil},
+		{"max_rr=1.5", http.StatusOK, nil, ptrTo(1.5)},
+		{"min_rr=2&max_rr=5", http.StatusOK, ptrTo(2.0), ptrTo(5.0)},
+		{"min_rr=3&max_rr=3", http.StatusOK, ptrTo(3.0), ptrTo(3.0)},
+		{"min_rr=0", http.StatusOK, ptrTo(0.0), nil},
+		{"min_rr=5&max_rr=2", http.StatusBadRequest, nil, nil},
+		{"min_rr=-1", http.StatusBadRequest, nil, nil},
+		{"max_rr=-0.5", http.StatusBadRequest, nil, nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.query, func(t *testing.T) {
+			rec, entries := listEntries(t, tt.query)
+			if rec.Code != tt.wantStatus {
+				t.Fatalf("status = %d, want %d; body %s", rec.Code, tt.wantStatus, rec.Body)
+			}
+			if tt.wantStatus != http.StatusOK {
+				if entries.filter != nil {
+					t.Errorf("service reached with an invalid rr range")
+				}
+				return
+			}
+
+			if !equalPtr(entries.filter.MinRR, tt.wantMin) || !equalPtr(entries.filter.MaxRR, tt.wantMax) {
+				t.Errorf("rr range = [%v, %v], want [%v, %v]", entries.filter.MinRR, entries.filter.MaxRR, tt.wantMin, tt.wantMax)
+			}
+		})
+	}
+}
+
+func TestListCombinesRRRangeWithFilters(t *testing.T) {
+	rec, entries := listEntries(t, "min_rr=2&max_rr=4&trade_type=swing&followed_plan=true")
+	if rec.Code != http.StatusOK {
+		t.Fatalf("status = %d, want %d; body %s", rec.Code, http.StatusOK, rec.Body)
+	}
+
+	filter := entries.filter
+	if !equalPtr(filter.MinRR, ptrTo(2.0)) || !equalPtr(filter.MaxRR, ptrTo(4.0)) ||
+		!equalPtr(filter.TradeType, ptrTo(types.TradeTypeSwing)) || !equalPtr(filter.FollowedPlan, ptrTo(true)) {
+		t.Errorf("filter = %+v, want the rr range combined with the other filters", filter)
+	}
+}
+
+func TestListFiltersByFollowedPlan(t *testing.T) {
+	tests := []struct {
+		query      string
+		wantStatus int
+		want       *bool
+	}{
+		{"", http.StatusOK, nil},
+		{"followed_plan=true", http.StatusOK, ptrTo(true)},
+		{"followed_plan=false", http.StatusOK, ptrTo(false)},
+		{"followed_plan=maybe", http.StatusBadRequest, nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.query, func(t *testing.T) {
+			rec, entries := listEntries(t, tt.query)
+			if rec.Code != tt.wantStatus {
+				t.Fatalf("status = %d, want %d; body %s", rec.Code, tt.wantStatus, rec.Body)
+			}
+			if tt.wantStatus != http.StatusOK {
+				if entries.filter != nil {
+					t.Errorf("service reached with an invalid followed_plan filter")
+				}
+				return
+			}
+
+			if got := entries.filter.FollowedPlan; !equalPtr(got, tt.want) {
+				t.Errorf("followed plan filter = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func equalPtr[T comparable](a, b *T) bool {
+	return (a == nil && b == nil) || (a != nil && b != nil && *a == *b)
+}
+
+func TestListFiltersByRealizedRange(t *testing.T) {
+	tests := []struct {
+		name       string
+		query      string
+		wantStatus int
+		wantMin    *float64
+		wantMax    *float64
+	}{
+		{"losses", "min_realized=-500&max_realized=-100", http.StatusOK, ptrTo(-500.0), ptrTo(-100.0)},
+		{"wins", "min_realized=50.5&max_realized=1000", http.StatusOK, ptrTo(50.5), ptrTo(1000.0)},
+		{"lost more than", "max_realized=-200", http.StatusOK, nil, ptrTo(-200.0)},
+		{"spans zero", "min_realized=-10&max_realized=10", http.StatusOK, ptrTo(-10.0), ptrTo(10.0)},
+		{"min above max", "min_realized=-100&max_realized=-500", http.StatusBadRequest, nil, nil},
+		{"not a number", "min_realized=lots", http.StatusBadRequest, nil, nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rec, entries := listEntries(t, tt.query)
+			if rec.Code != tt.wantStatus {
+				t.Fatalf("status = %d, want %d; body %s", rec.Code, tt.wantStatus, rec.Body)
+			}
+			if tt.wantStatus != http.StatusOK {
+				if entries.filter != nil {
+					t.Errorf("service reached with an invalid realized range")
+				}
+				return
+			}
+
+			if !equalPtr(entries.filter.MinRealized, tt.wantMin) || !equalPtr(entries.filter.MaxRealized, tt.wantMax) {
+				t.Errorf("realized range = [%v, %v], want [%v, %v]",
+					entries.filter.MinRealized, entries.filter.MaxRealized, tt.wantMin, tt.wantMax)
+			}
+		})
+	}
+}
+
+type deleteEntryService struct {
+	TradingJournalEntryService
+	soft, hard int
+	err        error
+}
+
+func (s *deleteEntryService) Delete(context.Context, uuid.UUID, uuid.UUID) error {
+	s.soft++
+	return s.err
+}
+
+func (s *deleteEntryService) HardDelete(context.Context, uuid.UUID, uuid.UUID) error {
+	s.hard++
+	return s.err
+}
+
+func TestDeleteEntryHandler(t *testing.T) {
+	journalID := uuid.New()
+	path := "/api/v1/journals/" + journalID.String() + "/entries/" + uuid.NewString()
+
+	tests := []struct {
+		name       string
+		query      string
+		err        error
+		wantStatus int
+		wantSoft   int
+		wantHard   int
+	}{
+		{"soft by default", "", nil, http.StatusOK, 1, 0},
+		{"hard=false", "?hard=false", nil, http.StatusOK, 1, 0},
+		{"hard=true", "?hard=true", nil, http.StatusOK, 0, 1},
+		{"not found", "?hard=true", entity.ErrNotFound, http.StatusNotFound, 0, 1},
+		{"locked journal", "", entity.ErrJournalLocked, http.StatusLocked, 1, 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			entries := &deleteEntryService{err: tt.err}
+			access := &fakeJournalAccess{owned: map[uuid.UUID]bool{journalID: true}}
+			router := newTestRouter(t, access, testServices{entries: entries})
+
+			rec := doRequest(router, http.MethodDelete, path+tt.query, "")
+			if rec.Code != tt.wantStatus {
+				t.Fatalf("status = %d, want %d; body %s", rec.Code, tt.wantStatus, rec.Body)
+			}
+			if entries.soft != tt.wantSoft || entries.hard != tt.wantHard {
+				t.Errorf("soft deletes = %d, hard deletes = %d, want %d, %d", entries.soft, entries.hard, tt.wantSoft, tt.wantHard)
+			}
+		})
+	}
+}
+
+// undoEntryService restores entry, or fails with err when it is set.
+type undoEntryService struct {
+	TradingJournalEntryService
+	entry *entity.TradingJournalEntry
+	err   error
+	calls int
+}
+
+func (s *undoEntryService) Undo(context.Context, uuid.UUID) (*entity.TradingJournalEntry, error) {
+	s.calls++
+	if s.err != nil {
+		return nil, s.err
+	}
+	return s.entry, nil
+}
+
+func TestUndoEntryHandler(t *testing.T) {
+	journalID := uuid.New()
+	entry := entity.NewTradingJournalEntry(
+		journalID, time.Date(2026, 3, 2, 0, 0, 0, 0, time.UTC), types.CurrencyPairEURUSD,
+		"https://charts.example.com/ltf", "https://charts.example.com/htf", nil,
+		types.TradingSessionLondon, types.TradeTypeIntraday, nil,
+		types.TradeDirectionBuy, types.EntryTypeLimit, 120, 2, types.TradeResultTakeProfit, "",
+	)
+	entry.ID = uuid.New()
+
+	tests := []struct {
+		name       string
+		owned      bool
+		err        error
+		wantStatus int
+		wantCalls  int
+	}{
+		{"restores the entry", true, nil, http.StatusOK, 1},
+		{"nothing to restore", true, errors.Wrap(entity.ErrNotFound, "recently deleted trading journal entry"), http.StatusNotFound, 1},
+		{"locked journal", true, entity.ErrJournalLocked, http.StatusLocked, 1},
+		{"not the owner", false, nil, http.StatusForbidden, 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			entries := &undoEntryService{entry: entry, err: tt.err}
+			access := &fakeJournalAccess{owned: map[uuid.UUID]bool{journalID: tt.owned}}
+			router := newTestRouter(t, access, testServices{entries: entries})
+
+			rec := doRequest(router, http.MethodPost, "/api/v1/journals/"+journalID.String()+"/entries/undo", "")
+			if rec.Code != tt.wantStatus {
+				t.Fatalf("status = %d, want %d; body %s", rec.Code, tt.wantStatus, rec.Body)
+			}
+			if entries.calls != tt.wantCalls {
+				t.Errorf("undo calls = %d, want %d", entries.calls, tt.wantCalls)
+			}
+			if rec.Code != http.StatusOK {
+				return
+			}
+
+			var response dto.TradingJournalEntryResponse
+			if err := json.Unmarshal(rec.Body.Bytes(), &response); err != nil {
+				t.Fatalf("decode response: %v", err)
+			}
+			if response.ID != entry.ID {
+				t.Errorf("restored entry %s, want %s", response.ID, entry.ID)
+			}
+		})
+	}
+}
+
+type calendarEntryService struct {
+	TradingJournalEntryService
+	called bool
+	year   int
+	loc    *time.Location
+}
+
+func (s *calendarEntryService) GetCalendar(_ context.Context, _ uuid.UUID, year int, loc *time.Location) ([]*entity.DailyStatistics, error) {
+	s.called = true
+	s.year = year
+	s.loc = loc
+	return []*entity.DailyStatistics{
+		{Day: time.Date(year, time.March, 2, 0, 0, 0, 0, time.UTC), Count: 3, NetRealized: 150},
+		{Day: time.Date(year, time.March, 5, 0, 0, 0, 0, time.UTC), Count: 1, NetRealized: -40},
+	}, nil
+}
+
+func TestGetCalendarHandler(t *testing.T) {
+	journalID := uuid.New()
+	access := &fakeJournalAccess{owned: map[uuid.UUID]bool{journalID: true}}
+
+	tests := []struct {
+		name     string
+		query    string
+		status   int
+		year     int
+		location string
+	}{
+		{name: "explicit year", query: "year=2025", status: http.StatusOK, year: 2025, location: "UTC"},
+		{name: "default year", query: "", status: http.StatusOK, year: time.Now().UTC().Year(), location: "UTC"},
+		{name: "time zone", query: "year=2025&timezone=Asia/Tokyo", status: http.StatusOK, year: 2025, location: "Asia/Tokyo"},
+		{name: "year not a number", query: "year=abc", status: http.StatusBadRequest},
+		{name: "year before range", query: "year=1969", status: http.StatusBadRequest},
+		{name: "year after range", query: "year=2101", status: http.StatusBadRequest},
+		{name: "unknown time zone", query: "year=2025&timezone=Mars/Base", status: http.StatusBadRequest},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			entries := &calendarEntryService{}
+			router := newTestRouter(t, access, testServices{entries: entries})
+
+			rec := doRequest(router, http.MethodGet, "/api/v1/journals/"+journalID.String()+"/entries/calendar?"+tt.query, "")
+			if rec.Code != tt.status {
+				t.Fatalf("status = %d, want %d; body %s", rec.Code, tt.status, rec.Body)
+			}
+			if tt.status != http.StatusOK {
+				if entries.called {
+					t.Error("service called for a rejected request")
+				}
+				return
+			}
+			if entries.year != tt.year || entries.loc.String() != tt.location {
+				t.Errorf("service got year %d in %s, want %d in %s", entries.year, entries.loc, tt.year, tt.location)
+			}
+
+			var response dto.CalendarResponse
+			if err := json.Unmarshal(rec.Body.Bytes(), &response); err != nil {
+				t.Fatalf("decode response: %v", err)
+			}
+			if response.Year != tt.year || len(response.Days) != 2 {
+				t.Fatalf("response = %+v, want year %d with two days", response, tt.year)
+			}
+			day := response.Days[fmt.Sprintf("%d-03-02", tt.year)]
+			if day == nil || day.Count != 3 || day.NetRealized != 150 {
+				t.Errorf("day bucket = %+v, want count 3 and net realized 150", day)
+			}
+		})
+	}
+}
+
+type syncEntryService struct {
+	TradingJournalEntryService
+	called bool
+	since  time.Time
+	cursor string
+	limit  int
+}
+
+func (s *syncEntryService) GetModifiedSince(_ context.Context, journalID uuid.UUID, since time.Time, cursor string, limit int) ([]*entity.TradingJournalEntry, string, error) {
+	s.called = true
+	s.since = since
+	s.cursor = cursor
+	s.limit = limit
+	if cursor == "bad" {
+		return nil, "", errors.Wrap(entity.ErrInvalidSyncCursor, "failed to get entries modified since")
+	}
+
+	kept := &entity.TradingJournalEntry{ID: uuid.New(), JournalID: journalID, UpdatedAt: since.Add(time.Minute)}
+	deleted := &entity.TradingJournalEntry{ID: uuid.New(), JournalID: journalID, UpdatedAt: since.Add(2 * time.Minute)}
+	deleted.DeletedAt = deleted.UpdatedAt
+	return []*entity.TradingJournalEntry{kept, deleted}, "next", nil
+}
+
+func TestListModifiedSince(t *testing.T) {
+	journalID := uuid.New()
+	access := &fakeJournalAccess{owned: map[uuid.UUID]bool{journalID: true}}
+
+	tests := []struct {
+		name   string
+		query  string
+		status int
+		cursor string
+	}{
+		{name: "first page", query: "modified_since=2026-03-01T12:00:00Z&limit=2", status: http.StatusOK},
+		{name: "next page", query: "modified_since=2026-03-01T12:00:00Z&limit=2&cursor=abc", status: http.StatusOK, cursor: "abc"},
+		{name: "invalid timestamp", query: "modified_since=yesterday", status: http.StatusBadRequest},
+		{name: "invalid cursor", query: "modified_since=2026-03-01T12:00:00Z&cursor=bad", status: http.StatusBadRequest, cursor: "bad"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			entries := &syncEntryService{}
+			router := newTestRouter(t, access, testServices{entries: entries})
+
+			rec := doRequest(router, http.MethodGet, "/api/v1/journals/"+journalID.String()+"/entries?"+tt.query, "")
+			if rec.Code != tt.status {
+				t.Fatalf("status = %d, want %d; body %s", rec.Code, tt.status, rec.Body)
+			}
+			if rec.Code == http.StatusBadRequest && tt.cursor == "" && entries.called {
+				t.Error("service called for an invalid timestamp")
+			}
+			if tt.status != http.StatusOK {
+				return
+			}
+
+			since := time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+			if !entries.since.Equal(since) || entries.cursor != tt.cursor || entries.limit != 2 {
+				t.Errorf("service got since %v, cursor %q, limit %d, want %v, %q, 2", entries.since, entries.cursor, entries.limit, since, tt.cursor)
+			}
+
+			var response dto.EntrySyncResponse
+			if err := json.Unmarshal(rec.Body.Bytes(), &response); err != nil {
+				t.Fatalf("decode response: %v", err)
+			}
+			if response.NextCursor != "next" || !response.HasMore {
+				t.Errorf("next cursor = %q, has more = %v, want %q, true", response.NextCursor, response.HasMore, "next")
+			}
+			if len(response.Entries) != 2 {
+				t.Fatalf("got %d entries, want 2", len(response.Entries))
+			}
+			if response.Entries[0].Deleted || response.Entries[0].DeletedAt != nil {
+				t.Errorf("entry 0 = deleted %v at %v, want kept", response.Entries[0].Deleted, response.Entries[0].DeletedAt)
+			}
+			if !response.Entries[1].Deleted || response.Entries[1].DeletedAt == nil {
+				t.Errorf("entry 1 = deleted %v at %v, want flagged deleted", response.Entries[1].Deleted, response.Entries[1].DeletedAt)
+			}
+		})
+	}
+}
+
+type correlationEntryService struct {
+	TradingJournalEntryService
+	loc *time.Location
+}
+
+func (s *correlationEntryService) GetAssetCorrelation(_ context.Context, _ uuid.UUID, loc *time.Location) (*entity.AssetCorrelation, error) {
+	s.loc = loc
+	return &entity.AssetCorrelation{
+		Assets: []*entity.AssetStatistics{
+			{Asset: types.CurrencyPairEURUSD, TotalTrades: 5, Wins: 3, WinRate: 60},
+			{Asset: types.CurrencyPairGBPUSD, TotalTrades: 5, Wins: 2, WinRate: 40},
+		},
+		Pairs: []*entity.AssetPairStatistics{
+			{AssetA: types.CurrencyPairEURUSD, AssetB: types.CurrencyPairGBPUSD, SharedDays: 3, TotalTrades: 10, Wins: 5, WinRate: 50},
+		},
+	}, nil
+}
+
+func TestGetAssetCorrelationHandler(t *testing.T) {
+	journalID := uuid.New()
+	access := &fakeJournalAccess{owned: map[uuid.UUID]bool{journalID: true}}
+	path := "/api/v1/journals/" + journalID.String() + "/entries/statistics/asset-correlation"
+
+	t.Run("matrix", func(t *testing.T) {
+		entries := &correlationEntryService{}
+		router := newTestRouter(t, access, testServices{entries: entries})
+
+		rec := doRequest(router, http.MethodGet, path+"?timezone=Europe/Berlin", "")
+		if rec.Code != http.StatusOK {
+			t.Fatalf("status = %d, want %d; body %s", rec.Code, http.StatusOK, rec.Body)
+		}
+		if entries.loc.String() != "Europe/Berlin" {
+			t.Errorf("service got time zone %s, want Europe/Berlin", entries.loc)
+		}
+
+		var response dto.AssetCorrelationResponse
+		if err := json.Unmarshal(rec.Body.Bytes(), &response); err != nil {
+			t.Fatalf("decode response: %v", err)
+		}
+		if len(response.Assets) != 2 || response.Assets[0].Asset != types.CurrencyPairEURUSD || response.Assets[0].WinRate != 60 {
+			t.Errorf("assets = %+v, want EURUSD first with a 60%% win rate", response.Assets)
+		}
+		if len(response.Pairs) != 1 || response.Pairs[0].SharedDays != 3 || response.Pairs[0].WinRate != 50 {
+			t.Errorf("pairs = %+v, want one pair over 3 shared days at 50%%", response.Pairs)
+		}
+	})
+
+	t.Run("unknown time zone", func(t *testing.T) {
+		router := newTestRouter(t, access, testServices{entries: &correlationEntryService{}})
+
+		if rec := doRequest(router, http.MethodGet, path+"?timezone=Mars/Base", ""); rec.Code != http.StatusBadRequest {
+			t.Errorf("status = %d, want %d", rec.Code, http.StatusBadRequest)
+		}
+	})
+}
+
+func TestListFiltersByReviewStatus(t *testing.T) {
+	tests := []struct {
+		query      string
+		wantStatus int
+		want       *types.ReviewStatus
+	}{
+		{"", http.StatusOK, nil},
+		{"review_status=unreviewed", http.StatusOK, ptrTo(types.ReviewStatusUnreviewed)},
+		{"review_status=flagged", http.StatusOK, ptrTo(types.ReviewStatusFlagged)},
+		{"review_status=done", http.StatusBadRequest, nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.query, func(t *testing.T) {
+			rec, entries := listEntries(t, tt.query)
+			if rec.Code != tt.wantStatus {
+				t.Fatalf("status = %d, want %d; body %s", rec.Code, tt.wantStatus, rec.Body)
+			}
+			if tt.wantStatus != http.StatusOK {
+				if entries.filter != nil {
+					t.Errorf("service reached with an invalid review status")
+				}
+				return
+			}
+
+			if got := entries.filter.ReviewStatus; !equalPtr(got, tt.want) {
+				t.Errorf("review status filter = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+type reviewEntryService struct {
+	TradingJournalEntryService
+	status types.ReviewStatus
+}
+
+func (s *reviewEntryService) SetReviewStatus(_ context.Context, id uuid.UUID, journalID uuid.UUID, status types.ReviewStatus) (*entity.TradingJournalEntry, error) {
+	if !status.IsValid() {
+		return nil, entity.ErrInvalidReviewStatus
+	}
+	s.status = status
+	return &entity.TradingJournalEntry{ID: id, JournalID: journalID, ReviewStatus: status}, nil
+}
+
+func (s *reviewEntryService) GetReviewProgress(context.Context, uuid.UUID) (*entity.ReviewProgress, error) {
+	return &entity.ReviewProgress{Total: 6, Unreviewed: 3, Reviewed: 2, Flagged: 1}, nil
+}
+
+func TestUpdateReviewStatusHandler(t *testing.T) {
+	journalID := uuid.New()
+	access := &fakeJournalAccess{owned: map[uuid.UUID]bool{journalID: true}}
+	path := "/api/v1/journals/" + journalID.String() + "/entries/" + uuid.NewString() + "/review"
+
+	tests := []struct {
+		name   string
+		body   string
+		status int
+		want   types.ReviewStatus
+	}{
+		{name: "reviewed", body: `{"review_status":"reviewed"}`, status: http.StatusOK, want: types.ReviewStatusReviewed},
+		{name: "flagged", body: `{"review_status":"flagged"}`, status: http.StatusOK, want: types.ReviewStatusFlagged},
+		{name: "unknown status", body: `{"review_status":"done"}`, status: http.StatusBadRequest},
+		{name: "missing status", body: `{}`, status: http.StatusBadRequest},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			entries := &reviewEntryService{}
+			router := newTestRouter(t, access, testServices{entries: entries})
+
+			rec := doRequest(router, http.MethodPatch, path, tt.body)
+			if rec.Code != tt.status {
+				t.Fatalf("status = %d, want %d; body %s", rec.Code, tt.status, rec.Body)
+			}
+			if entries.status != tt.want {
+				t.Errorf("service set %q, want %q", entries.status, tt.want)
+			}
+			if tt.status != http.StatusOK {
+				return
+			}
+
+			var response dto.TradingJournalEntryResponse
+			if err := json.Unmarshal(rec.Body.Bytes(), &response); err != nil {
+				t.Fatalf("decode response: %v", err)
+			}
+			if response.ReviewStatus != tt.want {
+				t.Errorf("response review status = %q, want %q", response.ReviewStatus, tt.want)
+			}
+		})
+	}
+}
+
+func TestGetReviewProgressHandler(t *testing.T) {
+	journalID := uuid.New()
+	access := &fakeJournalAccess{owned: map[uuid.UUID]bool{journalID: true}}
+	router := newTestRouter(t, access, testServices{entries: &reviewEntryService{}})
+
+	rec := doRequest(router, http.MethodGet, "/api/v1/journals/"+journalID.String()+"/entries/statistics/review-progress", "")
+	if rec.Code != http.StatusOK {
+		t.Fatalf("status = %d, want %d; body %s", rec.Code, http.StatusOK, rec.Body)
+	}
+
+	var response dto.ReviewProgressResponse
+	if err := json.Unmarshal(rec.Body.Bytes(), &response); err != nil {
+		t.Fatalf("decode response: %v", err)
+	}
+	want := dto.ReviewProgressResponse{Total: 6, Unreviewed: 3, Reviewed: 2, Flagged: 1}
+	if response != want {
+		t.Errorf("response = %+v, want %+v", response, want)
+	}
+}
+
+type adherenceEntryService struct {
+	TradingJournalEntryService
+}
+
+func (s *adherenceEntryService) GetAdherenceStatistics(context.Context, uuid.UUID) (*entity.AdherenceStatistics, error) {
+	return &entity.AdherenceStatistics{
+		InPlan:    &entity.PlanAdherenceStatistics{FollowedPlan: true, TotalTrades: 10, Wins: 6, Losses: 3, BreakEven: 1, TotalRealized: 500, WinRate: 60},
+		OutOfPlan: &entity.PlanAdherenceStatistics{TotalTrades: 4, Wins: 1, Losses: 3, TotalRealized: -300, WinRate: 25},
+	}, nil
+}
+
+func TestGetAdherenceStatisticsHandler(t *testing.T) {
+	journalID := uuid.New()
+	access := &fakeJournalAccess{owned: map[uuid.UUID]bool{journalID: true}}
+	router := newTestRouter(t, access, testServices{entries: &adherenceEntryService{}})
+
+	rec := doRequest(router, http.MethodGet, "/api/v1/journals/"+journalID.String()+"/entries/statistics/adherence", "")
+	if rec.Code != http.StatusOK {
+		t.Fatalf("status = %d, want %d; body %s", rec.Code, http.StatusOK, rec.Body)
+	}
+
+	var response dto.AdherenceStatisticsResponse
+	if err := json.Unmarshal(rec.Body.Bytes(), &response); err != nil {
+		t.Fatalf("decode response: %v", err)
+	}
+	wantInPlan := dto.PlanAdherenceBucketResponse{TotalTrades: 10, Wins: 6, Losses: 3, BreakEven: 1, WinRate: 60, TotalRealized: 500}
+	wantOutOfPlan := dto.PlanAdherenceBucketResponse{TotalTrades: 4, Wins: 1, Losses: 3, WinRate: 25, TotalRealized: -300}
+	if response.InPlan == nil || *response.InPlan != wantInPlan {
+		t.Errorf("in plan = %+v, want %+v", response.InPlan, wantInPlan)
+	}
+	if response.OutOfPlan == nil || *response.OutOfPlan != wantOutOfPlan {
+		t.Errorf("out of plan = %+v, want %+v", response.OutOfPlan, wantOutOfPlan)
+	}
+	if response.RealizedDifference != 800 {
+		t.Errorf("realized difference = %v, want 800", response.RealizedDifference)
+	}
+}
+
+type facetsEntryService struct {
+	TradingJournalEntryService
+}
+
+func (s *facetsEntryService) GetFacets(context.Context, uuid.UUID) (*entity.EntryFacets, error) {
+	return &entity.EntryFacets{
+		Assets:  []*entity.FacetValue{{Value: string(types.CurrencyPairEURUSD), Count: 3}},
+		Results: []*entity.FacetValue{{Value: string(types.TradeResultTakeProfit), Count: 2}, {Value: string(types.TradeResultStopLoss), Count: 1}},
+	}, nil
+}
+
+func TestGetFacetsHandler(t *testing.T) {
+	journalID := uuid.New()
+	access := &fakeJournalAccess{owned: map[uuid.UUID]bool{journalID: true}}
+	router := newTestRouter(t, access, testServices{entries: &facetsEntryService{}})
+
+	rec := doRequest(router, http.MethodGet, "/api/v1/journals/"+journalID.String()+"/entries/facets", "")
+	if rec.Code != http.StatusOK {
+		t.Fatalf("status = %d, want %d; body %s", rec.Code, http.StatusOK, rec.Body)
+	}
+
+	var response map[string][]dto.FacetValueResponse
+	if err := json.Unmarshal(rec.Body.Bytes(), &response); err != nil {
+		t.Fatalf("decode response: %v", err)
+	}
+	want := map[string][]dto.FacetValueResponse{
+		"assets":      {{Value: "EURUSD", Count: 3}},
+		"sessions":    {},
+		"results":     {{Value: "TP", Count: 2}, {Value: "SL", Count: 1}},
+		"trade_types": {},
+	}
+	for key, values := range want {
+		got, ok := response[key]
+		if !ok {
+			t.Errorf("response has no %q", key)
+			continue
+		}
+		if !slices.Equal(got, values) {
+			t.Errorf("%s = %+v, want %+v", key, got, values)
+		}
+	}
+}
+
+type statisticsEntryService struct {
+	TradingJournalEntryService
+	stats *entity.EntryStatistics
+}
+
+func (s *statisticsEntryService) GetStatistics(context.Context, uuid.UUID) (*entity.EntryStatistics, error) {
+	return s.stats, nil
+}
+
+func TestGetStatisticsPlannedVsAchievedRR(t *testing.T) {
+	journalID := uuid.New()
+	entries := &statisticsEntryService{stats: &entity.EntryStatistics{
+		TotalTrades:   4,
+		AvgPlannedRR:  3,
+		AvgAchievedRR: 1.75,
+		RRDifference:  -1.25,
+	}}
+	access := &fakeJournalAccess{owned: map[uuid.UUID]bool{journalID: true}}
+	router := newTestRouter(t, access, testServices{entries: entries})
+
+	rec := doRequest(router, http.MethodGet, "/api/v1/journals/"+journalID.String()+"/entries/statistics", "")
+	if rec.Code != http.StatusOK {
+		t.Fatalf("status = %d, want %d; body %s", rec.Code, http.StatusOK, rec.Body)
+	}
+
+	var response map[string]any
+	if err := json.Unmarshal(rec.Body.Bytes(), &response); err != nil {
+		t.Fatalf("decode response: %v", err)
+	}
+	for field, want := range map[string]float64{"avg_planned_rr": 3, "avg_achieved_rr": 1.75, "rr_difference": -1.25} {
+		if got := response[field]; got != want {
+			t.Errorf("%s = %v, want %v", field, got, want)
+		}
+	}
+}
+
+type confidenceEntryService struct {
+	TradingJournalEntryService
+}
+
+func (s *confidenceEntryService) GetStatisticsByConfidence(context.Context, uuid.UUID) ([]*entity.ConfidenceStatistics, error) {
+	return []*entity.ConfidenceStatistics{
+		{Confidence: 1, TotalTrades: 4, Wins: 1, Losses: 3, TotalRealized: -200, WinRate: 25},
+		{Confidence: 5, TotalTrades: 8, Wins: 6, Losses: 2, TotalRealized: 900, WinRate: 75},
+	}, nil
+}
+
+func TestGetStatisticsByConfidenceHandler(t *testing.T) {
+	journalID := uuid.New()
+	access := &fakeJournalAccess{owned: map[uuid.UUID]bool{journalID: true}}
+	router := newTestRouter(t, access, testServices{entries: &confidenceEntryService{}})
+
+	rec := doRequest(router, http.MethodGet, "/api/v1/journals/"+journalID.String()+"/entries/statistics/by-confidence", "")
+	if rec.Code != http.StatusOK {
+		t.Fatalf("status = %d, want %d; body %s", rec.Code, http.StatusOK, rec.Body)
+	}
+
+	var response dto.ConfidenceStatisticsListResponse
+	if err := json.Unmarshal(rec.Body.Bytes(), &response); err != nil {
+		t.Fatalf("decode response: %v", err)
+	}
+	if len(response.Levels) != 2 {
+		t.Fatalf("got %d levels, want 2", len(response.Levels))
+	}
+	if low := response.Levels[0]; low.Confidence != 1 || low.WinRate != 25 || low.TotalRealized != -200 {
+		t.Errorf("level 1 = %+v, want 25%% win rate and -200 realized", low)
+	}
+	if high := response.Levels[1]; high.Confidence != 5 || high.WinRate != 75 || high.TotalRealized != 900 {
+		t.Errorf("level 5 = %+v, want 75%% win rate and 900 realized", high)
+	}
+}
+
+// duplicateEntryService copies entry into a new one, or fails with err
+// when it is set.
+type duplicateEntryService struct {
+	TradingJournalEntryService
+	err   error
+	calls int
+	req   *dto.DuplicateTradingJournalEntryRequest
+}
+
+func (s *duplicateEntryService) Duplicate(_ context.Context, _ uuid.UUID, journalID uuid.UUID, req *dto.DuplicateTradingJournalEntryRequest) (*entity.TradingJournalEntry, error) {
+	s.calls++
+	s.req = req
+	if s.err != nil {
+		return nil, s.err
+	}
+	return &entity.TradingJournalEntry{ID: uuid.New(), JournalID: journalID}, nil
+}
+
+func TestDuplicateEntryHandler(t *testing.T) {
+	journalID := uuid.New()
+	path := "/api/v1/journals/" + journalID.String() + "/entries/" + uuid.NewString() + "/duplicate"
+
+	tests := []struct {
+		name       string
+		body       string
+		err        error
+		wantStatus int
+		wantCalls  int
+		wantNotes  *string
+	}{
+		{"no body", "", nil, http.StatusCreated, 1, nil},
+		{"empty overrides", "{}", nil, http.StatusCreated, 1, nil},
+		{"with overrides", `{"notes":"waited for the retest"}`, nil, http.StatusCreated, 1, ptrTo("waited for the retest")},
+		{"invalid override", `{"max_rr":0}`, nil, http.StatusBadRequest, 0, nil},
+		{"malformed body", `{"notes":`, nil, http.StatusBadRequest, 0, nil},
+		{"unknown entry", "", errors.Wrap(entity.ErrNotFound, "trading journal entry"), http.StatusNotFound, 1, nil},
+		{"locked journal", "", entity.ErrJournalLocked, http.StatusLocked, 1, nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			entries := &duplicateEntryService{err: tt.err}
+			access := &fakeJournalAccess{owned: map[uuid.UUID]bool{journalID: true}}
+			router := newTestRouter(t, access, testServices{entries: entries})
+
+			rec := doRequest(router, http.MethodPost, path, tt.body)
+			if rec.Code != tt.wantStatus {
+				t.Fatalf("status = %d, want %d; body %s", rec.Code, tt.wantStatus, rec.Body)
+			}
+			if entries.calls != tt.wantCalls {
+				t.Errorf("duplicate calls = %d, want %d", entries.calls, tt.wantCalls)
+			}
+			if entries.calls > 0 && !equalPtr(entries.req.Notes, tt.wantNotes) {
+				t.Errorf("notes override = %v, want %v", entries.req.Notes, tt.wantNotes)
+			}
+			if location := rec.Header().Get("Location"); (location != "") != (rec.Code == http.StatusCreated) {
+				t.Errorf("Location = %q for status %d", location, rec.Code)
+			}
+		})
+	}
+}
+
+// locationEntryService creates and duplicates entries with fresh IDs.
+type locationEntryService struct {
+	TradingJournalEntryService
+}
+
+func (s *locationEntryService) Create(_ context.Context, journalID uuid.UUID, _ *dto.CreateTradingJournalEntryRequest) (*entity.TradingJournalEntry, error) {
+	return &entity.TradingJournalEntry{ID: uuid.New(), JournalID: journalID}, nil
+}
+
+func (s *locationEntryService) Duplicate(_ context.Context, _ uuid.UUID, journalID uuid.UUID, _ *dto.DuplicateTradingJournalEntryRequest) (*entity.TradingJournalEntry, error) {
+	return &entity.TradingJournalEntry{ID: uuid.New(), JournalID: journalID}, nil
+}
+
+func TestCreateSetsLocation(t *testing.T) {
+	journalID := uuid.New()
+	access := &fakeJournalAccess{owned: map[uuid.UUID]bool{journalID: true}}
+	journalPath := "/api/v1/journals/" + journalID.String()
+	entryBody := `{"day":"2026-03-02T00:00:00Z","asset":"EURUSD","ltf":"https://example.com/ltf","htf":"https://example.com/htf",` +
+		`"session":"london","trade_type":"intraday","direction":"buy","entry_type":"market","realized":100,"max_rr":2,"result":"TP"}`
+
+	tests := []struct {
+		name     string
+		path     string
+		body     string
+		location func(id uuid.UUID) string
+	}{
+		{"journal", "/api/v1/journals", `{"name":"Swing"}`, func(id uuid.UUID) string {
+			return "/api/v1/journals/" + id.String()
+		}},
+		{"entry", journalPath + "/entries", entryBody, func(id uuid.UUID) string {
+			return journalPath + "/entries/" + id.String()
+		}},
+		{"duplicated entry", journalPath + "/entries/" + uuid.NewString() + "/duplicate", "", func(id uuid.UUID) string {
+			return journalPath + "/entries/" + id.String()
+		}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			router := newTestRouter(t, access, testServices{
+				journals: &dedupeJournalService{},
+				entries:  &locationEntryService{},
+			})
+
+			rec := doRequest(router, http.MethodPost, tt.path, tt.body)
+			if rec.Code != http.StatusCreated {
+				t.Fatalf("status = %d, want %d; body %s", rec.Code, http.StatusCreated, rec.Body)
+			}
+
+			var created struct {
+				ID uuid.UUID `json:"id"`
+			}
+			if err := json.Unmarshal(rec.Body.Bytes(), &created); err != nil {
+				t.Fatalf("decode response: %v", err)
+			}
+			if location := rec.Header().Get("Location"); location != tt.location(created.ID) {
+				t.Errorf("Location = %q, want %q", location, tt.location(created.ID))
+			}
+		})
+	}
+}
+
+type notesPolicyEntryService struct {
+	TradingJournalEntryService
+	err error
+}
+
+func (s *notesPolicyEntryService) Create(_ context.Context, journalID uuid.UUID, _ *dto.CreateTradingJournalEntryRequest) (*entity.TradingJournalEntry, error) {
+	if s.err != nil {
+		return nil, s.err
+	}
+	return &entity.TradingJournalEntry{ID: uuid.New(), JournalID: journalID}, nil
+}
+
+func TestCreateEntryNotesRequiredOnLoss(t *testing.T) {
+	journalID := uuid.New()
+	access := &fakeJournalAccess{owned: map[uuid.UUID]bool{journalID: true}}
+
+	tests := []struct {
+		name   string
+		err    error
+		status int
+	}{
+		{"policy enabled", errors.Wrap(entity.ErrNotesRequiredOnLoss, "invalid entry"), http.StatusBadRequest},
+		{"policy disabled", nil, http.StatusCreated},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			router := newTestRouter(t, access, testServices{entries: &notesPolicyEntryService{err: tt.err}})
+
+			body := `{"day":"2026-03-02T00:00:00Z","asset":"EURUSD","ltf":"https://example.com/ltf","htf":"https://example.com/htf",` +
+				`"session":"london","trade_type":"intraday","direction":"buy","entry_type":"market","realized":-100,"max_rr":2,"result":"SL"}`
+			rec := doRequest(router, http.MethodPost, "/api/v1/journals/"+journalID.String()+"/entries", body)
+			if rec.Code != tt.status {
+				t.Fatalf("status = %d, want %d; body %s", rec.Code, tt.status, rec.Body)
+			}
+			if tt.err == nil {
+				return
+			}
+			if code := decodeErrorCode(t, rec); code != CodeValidationFailed {
+				t.Errorf("code = %q, want %q", code, CodeValidationFailed)
+			}
+			if !strings.Contains(rec.Body.String(), "notes on losing trades") {
+				t.Errorf("body %s does not explain the notes policy", rec.Body)
+			}
+		})
+	}
+}
+
+type categoryEntryService struct {
+	TradingJournalEntryService
+}
+
+func (s *categoryEntryService) GetStatisticsByCategory(context.Context, uuid.UUID) ([]*entity.CategoryStatistics, error) {
+	return []*entity.CategoryStatistics{
+		{Category: types.AssetCategoryMajor, TotalTrades: 4, Wins: 2, Losses: 2, TotalRealized: 80, WinRate: 50},
+		{Category: types.AssetCategoryExotic, TotalTrades: 1, Losses: 1, TotalRealized: -40},
+	}, nil
+}
+
+func TestGetStatisticsByCategoryHandler(t *testing.T) {
+	journalID := uuid.New()
+
+	tests := []struct {
+		name       string
+		journalID  uuid.UUID
+		wantStatus int
+	}{
+		{"own journal", journalID, http.StatusOK},
+		{"foreign journal", uuid.New(), http.StatusForbidden},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			access := &fakeJournalAccess{owned: map[uuid.UUID]bool{journalID: true}}
+			router := newTestRouter(t, access, testServices{entries: &categoryEntryService{}})
+
+			rec := doRequest(router, http.MethodGet, "/api/v1/journals/"+tt.journalID.String()+"/entries/statistics/by-category", "")
+			if rec.Code != tt.wantStatus {
+				t.Fatalf("status = %d, want %d; body %s", rec.Code, tt.wantStatus, rec.Body)
+			}
+			if rec.Code != http.StatusOK {
+				return
+			}
+
+			var response dto.CategoryStatisticsListResponse
+			if err := json.Unmarshal(rec.Body.Bytes(), &response); err != nil {
+				t.Fatalf("decode response: %v", err)
+			}
+			if len(response.Categories) != 2 {
+				t.Fatalf("got %d categories, want 2", len(response.Categories))
+			}
+			major := response.Categories[0]
+			if major.Category != types.AssetCategoryMajor || major.TotalTrades != 4 || major.WinRate != 50 || major.TotalRealized != 80 {
+				t.Errorf("major = %+v, want the service totals", *major)
+			}
+		})
+	}
+}
+
+type gradeEntryService struct {
+	TradingJournalEntryService
+}
+
+func (s *gradeEntryService) GetStatisticsByGrade(context.Context, uuid.UUID) ([]*entity.GradeStatistics, error) {
+	return []*entity.GradeStatistics{
+		{Grade: types.TradeGradeA, TotalTrades: 4, Wins: 3, Losses: 1, TotalRealized: 200, WinRate: 75},
+		{Grade: types.TradeGradeF, TotalTrades: 2, Losses: 2, TotalRealized: -100},
+	}, nil
+}
+
+func TestGetStatisticsByGradeHandler(t *testing.T) {
+	journalID := uuid.New()
+	access := &fakeJournalAccess{owned: map[uuid.UUID]bool{journalID: true}}
+	router := newTestRouter(t, access, testServices{entries: &gradeEntryService{}})
+
+	rec := doRequest(router, http.MethodGet, "/api/v1/journals/"+journalID.String()+"/entries/statistics/by-grade", "")
+	if rec.Code != http.StatusOK {
+		t.Fatalf("status = %d, want %d; body %s", rec.Code, http.StatusOK, rec.Body)
+	}
+
+	var response dto.GradeStatisticsListResponse
+	if err := json.Unmarshal(rec.Body.Bytes(), &response); err != nil {
+		t.Fatalf("decode response: %v", err)
+	}
+	if len(response.Grades) != 2 {
+		t.Fatalf("got %d grades, want 2", len(response.Grades))
+	}
+	if a := response.Grades[0]; a.Grade != types.TradeGradeA || a.TotalTrades != 4 || a.WinRate != 75 || a.TotalRealized != 200 {
+		t.Errorf("grade A = %+v, want the service totals", *a)
+	}
+	if f := response.Grades[1]; f.Grade != types.TradeGradeF || f.Losses != 2 {
+		t.Errorf("grade F = %+v, want the service totals", *f)
+	}
+}
+
+func TestGetStatisticsMetadata(t *testing.T) {
+	journalID := uuid.New()
+	rangeStart := time.Date(2026, 1, 5, 0, 0, 0, 0, time.UTC)
+	rangeEnd := time.Date(2026, 3, 20, 0, 0, 0, 0, time.UTC)
+	generatedAt := time.Date(2026, 3, 21, 9, 30, 0, 0, time.UTC)
+
+	tests := []struct {
+		name      string
+		stats     *entity.EntryStatistics
+		wantStart *time.Time
+		wantEnd   *time.Time
+	}{
+		{
+			name:      "with entries",
+			stats:     &entity.EntryStatistics{TotalTrades: 3, RangeStart: &rangeStart, RangeEnd: &rangeEnd, GeneratedAt: generatedAt},
+			wantStart: &rangeStart,
+			wantEnd:   &rangeEnd,
+		},
+		{
+			name:  "empty journal",
+			stats: &entity.EntryStatistics{GeneratedAt: generatedAt},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			access := &fakeJournalAccess{owned: map[uuid.UUID]bool{journalID: true}}
+			router := newTestRouter(t, access, testServices{entries: &statisticsEntryService{stats: tt.stats}})
+
+			rec := doRequest(router, http.MethodGet, "/api/v1/journals/"+journalID.String()+"/entries/statistics", "")
+			if rec.Code != http.StatusOK {
+				t.Fatalf("status = %d, want %d; body %s", rec.Code, http.StatusOK, rec.Body)
+			}
+
+			var response dto.TradingJournalStatisticsResponse
+			if err := json.Unmarshal(rec.Body.Bytes(), &response); err != nil {
+				t.Fatalf("decode response: %v", err)
+			}
+			if !equalTimePtr(response.RangeStart, tt.wantStart) || !equalTimePtr(response.RangeEnd, tt.wantEnd) {
+				t.Errorf("range = %v to %v, want %v to %v", response.RangeStart, response.RangeEnd, tt.wantStart, tt.wantEnd)
+			}
+			if !response.GeneratedAt.Equal(generatedAt) {
+				t.Errorf("generated_at = %v, want %v", response.GeneratedAt, generatedAt)
+			}
+		})
+	}
+}
+
+func equalTimePtr(a, b *time.Time) bool {
+	return (a == nil && b == nil) || (a != nil && b != nil && a.Equal(*b))
+}
+
+type transferEntryService struct {
+	TradingJournalEntryService
+	err   error
+	calls int
+}
+
+func (s *transferEntryService) TransferEntries(_ context.Context, _ uuid.UUID, _ uuid.UUID, req *dto.TransferEntriesRequest) ([]*entity.TradingJournalEntry, error) {
+	s.calls++
+	if s.err != nil {
+		return nil, s.err
+	}
+	entries := make([]*entity.TradingJournalEntry, len(req.EntryIDs))
+	for i, id := range req.EntryIDs {
+		if req.Copy {
+			id = uuid.New()
+		}
+		entries[i] = &entity.TradingJournalEntry{ID: id, JournalID: req.TargetJournalID}
+	}
+	return entries, nil
+}
+
+func TestTransferEntriesHandler(t *testing.T) {
+	journalID := uuid.New()
+	targetID := uuid.New()
+	entryID := uuid.New()
+	access := &fakeJournalAccess{owned: map[uuid.UUID]bool{journalID: true}}
+
+	tests := []struct {
+		name       string
+		body       string
+		err        error
+		wantStatus int
+		wantCode   string
+		wantCalls  int
+	}{
+		{
+			name:       "move",
+			body:       `{"entry_ids":["` + entryID.String() + `"],"target_journal_id":"` + targetID.String() + `"}`,
+			wantStatus: http.StatusOK,
+			wantCalls:  1,
+		},
+		{
+			name:       "copy",
+			body:       `{"entry_ids":["` + entryID.String() + `"],"target_journal_id":"` + targetID.String() + `","copy":true}`,
+			wantStatus: http.StatusCreated,
+			wantCalls:  1,
+		},
+		{
+			name:       "no entries",
+			body:       `{"entry_ids":[],"target_journal_id":"` + targetID.String() + `"}`,
+			wantStatus: http.StatusBadRequest,
+			wantCode:   CodeValidationFailed,
+		},
+		{
+			name:       "no target",
+			body:       `{"entry_ids":["` + entryID.String() + `"]}`,
+			wantStatus: http.StatusBadRequest,
+			wantCode:   CodeValidationFailed,
+		},
+		{
+			name:       "same journal",
+			body:       `{"entry_ids":["` + entryID.String() + `"],"target_journal_id":"` + journalID.String() + `"}`,
+			err:        errors.Wrap(entity.ErrSameJournal, "transfer"),
+			wantStatus: http.StatusBadRequest,
+			wantCode:   CodeValidationFailed,
+			wantCalls:  1,
+		},
+		{
+			name:       "missing entries",
+			body:       `{"entry_ids":["` + entryID.String() + `"],"target_journal_id":"` + targetID.String() + `"}`,
+			err:        errors.Wrap(errors.Wrap(entity.ErrNotFound, "trading journal entry"), "failed to transfer"),
+			wantStatus: http.StatusNotFound,
+			wantCode:   CodeNotFound,
+			wantCalls:  1,
+		},
+		{
+			name:       "locked target",
+			body:       `{"entry_ids":["` + entryID.String() + `"],"target_journal_id":"` + targetID.String() + `"}`,
+			err:        errors.Wrap(entity.ErrJournalLocked, "failed to transfer"),
+			wantStatus: http.StatusLocked,
+			wantCode:   CodeJournalLocked,
+			wantCalls:  1,
+		},
+		{
+			name:       "target policy",
+			body:       `{"entry_ids":["` + entryID.String() + `"],"target_journal_id":"` + targetID.String() + `"}`,
+			err:        errors.Wrap(entity.ErrNotesRequiredOnLoss, "failed to transfer"),
+			wantStatus: http.StatusBadRequest,
+			wantCode:   CodeValidationFailed,
+			wantCalls:  1,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			entries := &transferEntryService{err: tt.err}
+			router := newTestRouter(t, access, testServices{entries: entries})
+
+			rec := doRequest(router, http.MethodPost, "/api/v1/journals/"+journalID.String()+"/entries/move", tt.body)
+			if rec.Code != tt.wantStatus {
+				t.Fatalf("status = %d, want %d; body %s", rec.Code, tt.wantStatus, rec.Body)
+			}
+			if entries.calls != tt.wantCalls {
+				t.Errorf("service called %d times, want %d", entries.calls, tt.wantCalls)
+			}
+			if tt.wantCode != "" {
+				if code := decodeErrorCode(t, rec); code != tt.wantCode {
+					t.Errorf("code = %q, want %q", code, tt.wantCode)
+				}
+				return
+			}
+
+			var response dto.TransferEntriesResponse
+			if err := json.Unmarshal(rec.Body.Bytes(), &response); err != nil {
+				t.Fatalf("decode response: %v", err)
+			}
+			copied := rec.Code == http.StatusCreated
+			if response.TargetJournalID != targetID || response.Copied != copied || len(response.Entries) != 1 {
+				t.Fatalf("response = %+v, want one entry in %s, copied %v", response, targetID, copied)
+			}
+			if moved := response.Entries[0].ID == entryID; moved == copied {
+				t.Errorf("entry id %s, copied %v", response.Entries[0].ID, copied)
+			}
+		})
+	}
+}
+
+func TestCreateEntryRejectedByValidationProfile(t *testing.T) {
+	journalID := uuid.New()
+	access := &fakeJournalAccess{owned: map[uuid.UUID]bool{journalID: true}}
+
+	for _, err := range []error{
+		entity.ErrFutureEntryDay,
+		entity.ErrResultContradictsRealized,
+		entity.ErrIdenticalTimeframeCharts,
+	} {
+		t.Run(err.Error(), func(t *testing.T) {
+			router := newTestRouter(t, access, testServices{entries: &notesPolicyEntryService{err: errors.Wrap(err, "invalid entry")}})
+
+			body := `{"day":"2026-03-02T00:00:00Z","asset":"EURUSD","ltf":"https://example.com/chart","htf":"https://example.com/chart",` +
+				`"session":"london","trade_type":"intraday","direction":"buy","entry_type":"market","realized":-100,"max_rr":2,"result":"TP"}`
+			rec := doRequest(router, http.MethodPost, "/api/v1/journals/"+journalID.String()+"/entries", body)
+			if rec.Code != http.StatusBadRequest {
+				t.Fatalf("status = %d, want %d; body %s", rec.Code, http.StatusBadRequest, rec.Body)
+			}
+			if code := decodeErrorCode(t, rec); code != CodeValidationFailed {
+				t.Errorf("code = %q, want %q", code, CodeValidationFailed)
+			}
+			if !strings.Contains(rec.Body.String(), err.Error()) {
+				t.Errorf("body %s does not explain %q", rec.Body, err)
+			}
+		})
+	}
+}
+
+// compareEntryService compares two fixed sets of statistics and records the
+// periods it was asked for.
+type compareEntryService struct {
+	TradingJournalEntryService
+	a, b  entity.Period
+	calls int
+}
+
+func (s *compareEntryService) ComparePeriods(_ context.Context, _ uuid.UUID, a, b entity.Period) (*entity.PeriodComparison, error) {
+	s.calls++
+	s.a, s.b = a, b
+	return &entity.PeriodComparison{
+		PeriodA:     a,
+		PeriodB:     b,
+		StatisticsA: &entity.EntryStatistics{TotalTrades: 10, Wins: 4},
+		StatisticsB: &entity.EntryStatistics{TotalTrades: 12, Wins: 7},
+		Delta:       entity.StatisticsDelta{TotalTrades: 2, Wins: 3},
+	}, nil
+}
+
+func TestCompareStatisticsHandler(t *testing.T) {
+	journalID := uuid.New()
+	access := &fakeJournalAccess{owned: map[uuid.UUID]bool{journalID: true}}
+	path := "/api/v1/journals/" + journalID.String() + "/entries/statistics/compare"
+
+	tests := []struct {
+		name       string
+		query      string
+		wantStatus int
+	}{
+		{"disjoint months", "?period_a_start=2026-01-01&period_a_end=2026-01-31&period_b_start=2026-02-01&period_b_end=2026-02-28", http.StatusOK},
+		{"overlapping periods", "?period_a_start=2026-01-01&period_a_end=2026-02-15&period_b_start=2026-02-01&period_b_end=2026-02-28", http.StatusOK},
+		{"missing period b", "?period_a_start=2026-01-01&period_a_end=2026-01-31", http.StatusBadRequest},
+		{"malformed day", "?period_a_start=2026-01-01&period_a_end=2026-01-31&period_b_start=2026-02-01&period_b_end=Feb", http.StatusBadRequest},
+		{"reversed period a", "?period_a_start=2026-01-31&period_a_end=2026-01-01&period_b_start=2026-02-01&period_b_end=2026-02-28", http.StatusBadRequest},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			entries := &compareEntryService{}
+			router := newTestRouter(t, access, testServices{entries: entries})
+
+			rec := doRequest(router, http.MethodGet, path+tt.query, "")
+			if rec.Code != tt.wantStatus {
+				t.Fatalf("status = %d, want %d; body %s", rec.Code, tt.wantStatus, rec.Body)
+			}
+			if tt.wantStatus != http.StatusOK {
+				if entries.calls != 0 {
+					t.Errorf("service called %d times, want 0", entries.calls)
+				}
+				decodeErrorCode(t, rec)
+				return
+			}
+
+			var response dto.StatisticsComparisonResponse
+			if err := json.Unmarshal(rec.Body.Bytes(), &response); err != nil {
+				t.Fatalf("decode response: %v", err)
+			}
+			if response.PeriodA.Statistics.TotalTrades != 10 || response.PeriodB.Statistics.TotalTrades != 12 {
+				t.Errorf("total trades = %d and %d, want 10 and 12", response.PeriodA.Statistics.TotalTrades, response.PeriodB.Statistics.TotalTrades)
+			}
+			if response.Delta.TotalTrades != 2 || response.Delta.Wins != 3 {
+				t.Errorf("delta = %+v, want 2 more trades and 3 more wins", response.Delta)
+			}
+			if !response.PeriodA.Start.Equal(entries.a.Start) || !response.PeriodB.End.Equal(entries.b.End) {
+				t.Errorf("periods %v to %v, want %v to %v", response.PeriodA.Start, response.PeriodB.End, entries.a.Start, entries.b.End)
+			}
+		})
+	}
+}
+
+type checklistEntryService struct {
+	TradingJournalEntryService
+}
+
+func (s *checklistEntryService) GetChecklistAdherence(context.Context, uuid.UUID) ([]*entity.ChecklistItemStatistics, error) {
+	return []*entity.ChecklistItemStatistics{
+		{Item: "HTF bias", TotalTrades: 10, Checked: 8, AdherenceRate: 80, CheckedWinRate: 75, UncheckedWinRate: 50},
+	}, nil
+}
+
+func TestGetChecklistAdherenceHandler(t *testing.T) {
+	journalID := uuid.New()
+	access := &fakeJournalAccess{owned: map[uuid.UUID]bool{journalID: true}}
+	router := newTestRouter(t, access, testServices{entries: &checklistEntryService{}})
+
+	rec := doRequest(router, http.MethodGet, "/api/v1/journals/"+journalID.String()+"/entries/statistics/checklist-adherence", "")
+	if rec.Code != http.StatusOK {
+		t.Fatalf("status = %d, want %d; body %s", rec.Code, http.StatusOK, rec.Body)
+	}
+
+	var response dto.ChecklistAdherenceResponse
+	if err := json.Unmarshal(rec.Body.Bytes(), &response); err != nil {
+		t.Fatalf("decode response: %v", err)
+	}
+	if len(response.Items) != 1 {
+		t.Fatalf("items = %+v, want one", response.Items)
+	}
+	if got := response.Items[0]; got.Item != "HTF bias" || got.AdherenceRate != 80 || got.WinRateDifference != 25 {
+		t.Errorf("item = %+v, want HTF bias at 80%% adherence with a 25 point win rate difference", got)
+	}
+}
+
+func TestCreateEntryValidatesChecklist(t *testing.T) {
+	journalID := uuid.New()
+	access := &fakeJournalAccess{owned: map[uuid.UUID]bool{journalID: true}}
+
+	tooMany := make([]string, 31)
+	for i := range tooMany {
+		tooMany[i] = fmt.Sprintf(`{"item":"item %d"}`, i)
+	}
+
+	tests := []struct {
+		name       string
+		checklist  string
+		wantStatus int
+	}{
+		{"no checklist", `null`, http.StatusCreated},
+		{"ticked and unticked items", `[{"item":"HTF bias","checked":true},{"item":"News checked","checked":false}]`, http.StatusCreated},
+		{"missing item name", `[{"checked":true}]`, http.StatusBadRequest},
+		{"item too long", `[{"item":"` + strings.Repeat("x", 201) + `"}]`, http.StatusBadRequest},
+		{"duplicate item", `[{"item":"HTF bias","checked":true},{"item":"HTF bias"}]`, http.StatusBadRequest},
+		{"too many items", `[` + strings.Join(tooMany, ",") + `]`, http.StatusBadRequest},
+		{"not an array", `{"item":"HTF bias"}`, http.StatusBadRequest},
+		{"checked is not a boolean", `[{"item":"HTF bias","checked":"yes"}]`, http.StatusBadRequest},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			entries := &createEntryService{}
+			router := newTestRouter(t, access, testServices{entries: entries})
+
+			body := `{"day":"2026-03-02T00:00:00Z","asset":"EURUSD","ltf":"https://example.com/ltf","htf":"https://example.com/htf",` +
+				`"session":"london","trade_type":"intraday","direction":"buy","entry_type":"market","realized":100,"max_rr":2,"result":"TP",` +
+				`"checklist":` + tt.checklist + `}`
+			rec := doRequest(router, http.MethodPost, "/api/v1/journals/"+journalID.String()+"/entries", body)
+			if rec.Code != tt.wantStatus {
+				t.Fatalf("status = %d, want %d; body %s", rec.Code, tt.wantStatus, rec.Body)
+			}
+			if tt.wantStatus != http.StatusCreated && entries.calls != 0 {
+				t.Errorf("service called %d times, want 0", entries.calls)
+			}
+		})
+	}
+}
+
+// linkEntryService links entries, failing with err when set.
+type linkEntryService struct {
+	TradingJournalEntryService
+	err       error
+	relatedID uuid.UUID
+}
+
+func (s *linkEntryService) LinkEntry(_ context.Context, id, journalID, relatedID uuid.UUID) (*entity.TradingJournalEntry, error) {
+	if s.err != nil {
+		return nil, s.err
+	}
+	s.relatedID = relatedID
+	return &entity.TradingJournalEntry{ID: id, JournalID: journalID, RelatedEntryIDs: []uuid.UUID{relatedID}}, nil
+}
+
+func (s *linkEntryService) UnlinkEntry(_ context.Context, id, journalID, relatedID uuid.UUID) (*entity.TradingJournalEntry, error) {
+	if s.err != nil {
+		return nil, s.err
+	}
+	s.relatedID = relatedID
+	return &entity.TradingJournalEntry{ID: id, JournalID: journalID}, nil
+}
+
+func (s *linkEntryService) GetRelated(_ context.Context, _, journalID uuid.UUID) ([]*entity.TradingJournalEntry, error) {
+	if s.err != nil {
+		return nil, s.err
+	}
+	return []*entity.TradingJournalEntry{{ID: s.relatedID, JournalID: journalID}}, nil
+}
+
+func TestLinkEntryHandler(t *testing.T) {
+	journalID := uuid.New()
+	entryID, relatedID := uuid.New(), uuid.New()
+	path := fmt.Sprintf("/api/v1/journals/%s/entries/%s/links", journalID, entryID)
+	body := fmt.Sprintf(`{"entry_id":%q}`, relatedID)
+
+	tests := []struct {
+		name       string
+		body       string
+		err        error
+		wantStatus int
+		wantCode   string
+	}{
+		{"linked", body, nil, http.StatusOK, ""},
+		{"missing entry id", `{}`, nil, http.StatusBadRequest, CodeValidationFailed},
+		{"self link", body, entity.ErrSelfLink, http.StatusBadRequest, CodeValidationFailed},
+		{"too many links", body, entity.ErrTooManyLinks, http.StatusBadRequest, CodeValidationFailed},
+		{"entry of another journal", body, errors.Wrap(entity.ErrNotFound, "link entries"), http.StatusNotFound, CodeNotFound},
+		{"locked journal", body, entity.ErrJournalLocked, http.StatusLocked, CodeJournalLocked},
+		{"storage failure", body, errors.New("connection refused"), http.StatusInternalServerError, CodeInternal},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			entries := &linkEntryService{err: tt.err}
+			router := newTestRouter(t, &fakeJournalAccess{owned: map[uuid.UUID]bool{journalID: true}}, testServices{entries: entries})
+
+			rec := doRequest(router, http.MethodPost, path, tt.body)
+			if rec.Code != tt.wantStatus {
+				t.Fatalf("status = %d, want %d; body %s", rec.Code, tt.wantStatus, rec.Body)
+			}
+			if tt.wantCode != "" {
+				if code := decodeErrorCode(t, rec); code != tt.wantCode {
+					t.Errorf("code = %q, want %q", code, tt.wantCode)
+				}
+				return
+			}
+
+			var got dto.TradingJournalEntryResponse
+			if err := json.Unmarshal(rec.Body.Bytes(), &got); err != nil {
+				t.Fatalf("decode body: %v", err)
+			}
+			if !slices.Equal(got.RelatedEntryIDs, []uuid.UUID{relatedID}) {
+				t.Errorf("related_entry_ids = %v, want [%s]", got.RelatedEntryIDs, relatedID)
+			}
+		})
+	}
+}
+
+func TestUnlinkAndListLinksHandlers(t *testing.T) {
+	journalID := uuid.New()
+	entryID, relatedID := uuid.New(), uuid.New()
+	path := fmt.Sprintf("/api/v1/journals/%s/entries/%s/links", journalID, entryID)
+	entries := &linkEntryService{}
+	router := newTestRouter(t, &fakeJournalAccess{owned: map[uuid.UUID]bool{journalID: true}}, testServices{entries: entries})
+
+	rec := doRequest(router, http.MethodDelete, path+"/"+relatedID.String(), "")
+	if rec.Code != http.StatusOK {
+		t.Fatalf("unlink status = %d, want %d; body %s", rec.Code, http.StatusOK, rec.Body)
+	}
+	if entries.relatedID != relatedID {
+		t.Errorf("unlinked %s, want %s", entries.relatedID, relatedID)
+	}
+
+	rec = doRequest(router, http.MethodGet, path, "")
+	if rec.Code != http.StatusOK {
+		t.Fatalf("list status = %d, want %d; body %s", rec.Code, http.StatusOK, rec.Body)
+	}
+	var got dto.RelatedEntriesResponse
+	if err := json.Unmarshal(rec.Body.Bytes(), &got); err != nil {
+		t.Fatalf("decode body: %v", err)
+	}
+	if len(got.Entries) != 1 || got.Entries[0].ID != relatedID {
+		t.Errorf("entries = %+v, want only %s", got.Entries, relatedID)
+	}
+
+	t.Run("missing entry", func(t *testing.T) {
+		entries.err = entity.ErrNotFound
+		for _, method := range []string{http.MethodGet, http.MethodDelete} {
+			target := path
+			if method == http.MethodDelete {
+				target += "/" + relatedID.String()
+			}
+			rec := doRequest(router, method, target, "")
+			if rec.Code != http.StatusNotFound {
+				t.Errorf("%s status = %d, want %d; body %s", method, rec.Code, http.StatusNotFound, rec.Body)
+			}
+		}
+	})
+}
+
+// previewBulkEntryService records the bulk update request and returns one
+// changed entry.
+type previewBulkEntryService struct {
+	TradingJournalEntryService
+	req *dto.BulkUpdateEntriesRequest
+}
+
+func (s *previewBulkEntryService) BulkUpdate(_ context.Context, journalID uuid.UUID, req *dto.BulkUpdateEntriesRequest) ([]*entity.TradingJournalEntry, error) {
+	s.req = req
+	return []*entity.TradingJournalEntry{{ID: uuid.New(), JournalID: journalID, Result: *req.Set.Result}}, nil
+}
+
+func TestBulkUpdateResponse(t *testing.T) {
+	journalID := uuid.New()
+	entries := &previewBulkEntryService{}
+	router := newTestRouter(t, &fakeJournalAccess{owned: map[uuid.UUID]bool{journalID: true}}, testServices{entries: entries})
+
+	rec := doRequest(router, http.MethodPatch, "/api/v1/journals/"+journalID.String()+"/entries/bulk",
+		`{"filter":{"result":"TP","start_date":"2026-01-01T00:00:00Z"},"set":{"result":"BE","realized":0},"dry_run":true}`)
+	if rec.Code != http.StatusOK {
+		t.Fatalf("status = %d, want %d; body %s", rec.Code, http.StatusOK, rec.Body)
+	}
+
+	req := entries.req
+	if req == nil || !req.DryRun || *req.Filter.Result != types.TradeResultTakeProfit || req.Filter.StartDate == nil ||
+		*req.Set.Result != types.TradeResultBreakEven || req.Set.Realized == nil || *req.Set.Realized != 0 {
+		t.Fatalf("service got %+v, want the dry run, filter and fields of the body", req)
+	}
+
+	var got dto.BulkUpdateEntriesResponse
+	if err := json.Unmarshal(rec.Body.Bytes(), &got); err != nil {
+		t.Fatalf("decode body: %v", err)
+	}
+	if !got.DryRun || got.Matched != 1 || len(got.Entries) != 1 || got.Entries[0].Result != types.TradeResultBreakEven {
+		t.Errorf("response = %+v, want a dry run with the one changed entry", got)
+	}
+}
@@ -0,0 +1,1100 @@
+package v1
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/cockroachdb/errors"
+	"github.com/google/uuid"
+	"github.com/user/normark/internal/dto"
+	"github.com/user/normark/internal/entity"
+	"github.com/user/normark/internal/types"
+)
+
+// dedupeJournalService reports an existing journal for the name "Swing".
+type dedupeJournalService struct {
+	TradingJournalService
+	creates      int
+	deduplicated int
+}
+
+func (s *dedupeJournalService) Create(_ context.Context, userID uuid.UUID, req *dto.CreateTradingJournalRequest) (*entity.TradingJournal, error) {
+	s.creates++
+	journal := entity.NewTradingJournal(userID, req.Name, req.Description)
+	journal.ID = uuid.New()
+	return journal, nil
+}
+
+func (s *dedupeJournalService) CreateDeduplicated(_ context.Context, userID uuid.UUID, req *dto.CreateTradingJournalRequest) (*entity.TradingJournal, bool, error) {
+	s.deduplicated++
+	journal := entity.NewTradingJournal(userID, req.Name, req.Description)
+	journal.ID = uuid.New()
+	return journal, req.Name != "Swing", nil
+}
+
+func TestCreateJournalDedupe(t *testing.T) {
+	tests := []struct {
+		name             string
+		query            string
+		idempotencyKey   string
+		journalName      string
+		wantStatus       int
+		wantDeduplicated bool
+	}{
+		{"plain create", "", "", "Swing", http.StatusCreated, false},
+		{"dedupe returns existing", "?dedupe=true", "", "Swing", http.StatusOK, true},
+		{"dedupe creates new", "?dedupe=true", "", "Scalping", http.StatusCreated, true},
+		{"idempotency key returns existing", "", "retry-1", "Swing", http.StatusOK, true},
+		{"dedupe=false", "?dedupe=false", "", "Swing", http.StatusCreated, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			journals := &dedupeJournalService{}
+			router := newTestRouter(t, &fakeJournalAccess{}, testServices{journals: journals})
+
+			req := httptest.NewRequest(http.MethodPost, "/api/v1/journals"+tt.query, strings.NewReader(`{"name":"`+tt.journalName+`"}`))
+			req.Header.Set("Authorization", "Bearer token")
+			req.Header.Set("Content-Type", "application/json")
+			if tt.idempotencyKey != "" {
+				req.Header.Set(headerIdempotencyKey, tt.idempotencyKey)
+			}
+			rec := httptest.NewRecorder()
+			router.ServeHTTP(rec, req)
+
+			if rec.Code != tt.wantStatus {
+				t.Fatalf("status = %d, want %d; body %s", rec.Code, tt.wantStatus, rec.Body)
+			}
+			if (journals.deduplicated == 1) != tt.wantDeduplicated || journals.creates+journals.deduplicated != 1 {
+				t.Errorf("Create calls = %d, CreateDeduplicated calls = %d, want deduplicated %v",
+					journals.creates, journals.deduplicated, tt.wantDeduplicated)
+			}
+			if location := rec.Header().Get("Location"); (location != "") != (tt.wantStatus == http.StatusCreated) {
+				t.Errorf("Location = %q for status %d", location, rec.Code)
+			}
+		})
+	}
+}
+
+// archiveJournalService lists journals and toggles the archived flag on the
+// journals in owned.
+type archiveJournalService struct {
+	TradingJournalService
+	owned           map[uuid.UUID]bool
+	includeArchived []bool
+	archived        *bool
+}
+
+func (s *archiveJournalService) GetUserJournals(_ context.Context, _ uuid.UUID, _, _ int, includeArchived bool, _ string) ([]*entity.TradingJournal, error) {
+	s.includeArchived = append(s.includeArchived, includeArchived)
+	return nil, nil
+}
+
+func (s *archiveJournalService) CountUserJournals(_ context.Context, _ uuid.UUID, includeArchived bool, _ string) (int, error) {
+	s.includeArchived = append(s.includeArchived, includeArchived)
+	return 0, nil
+}
+
+func (s *archiveJournalService) SetArchived(_ context.Context, id uuid.UUID, userID uuid.UUID, archived bool) (*entity.TradingJournal, error) {
+	if !s.owned[id] {
+		return nil, errors.Wrap(entity.ErrNotFound, "trading journal")
+	}
+	s.archived = &archived
+	journal := entity.NewTradingJournal(userID, "Swing", "")
+	journal.ID = id
+	journal.IsArchived = archived
+	return journal, nil
+}
+
+func TestListJournalsIncludeArchived(t *testing.T) {
+	tests := []struct {
+		query string
+		want  bool
+	}{
+		{"", false},
+		{"?include_archived=false", false},
+		{"?include_archived=true", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.query, func(t *testing.T) {
+			journals := &archiveJournalService{}
+			router := newTestRouter(t, &fakeJournalAccess{}, testServices{journals: journals})
+
+			rec := doRequest(router, http.MethodGet, "/api/v1/journals"+tt.query, "")
+			if rec.Code != http.StatusOK {
+				t.Fatalf("status = %d, want %d; body %s", rec.Code, http.StatusOK, rec.Body)
+			}
+			if len(journals.includeArchived) != 2 || journals.includeArchived[0] != tt.want || journals.includeArchived[1] != tt.want {
+				t.Errorf("include archived passed to list and count = %v, want %v", journals.includeArchived, tt.want)
+			}
+		})
+	}
+}
+
+func TestArchiveJournalHandler(t *testing.T) {
+	ownJournal := uuid.New()
+
+	tests := []struct {
+		name         string
+		journalID    uuid.UUID
+		action       string
+		wantStatus   int
+		wantArchived bool
+	}{
+		{"archive", ownJournal, "archive", http.StatusOK, true},
+		{"unarchive", ownJournal, "unarchive", http.StatusOK, false},
+		{"foreign journal", uuid.New(), "archive", http.StatusNotFound, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			journals := &archiveJournalService{owned: map[uuid.UUID]bool{ownJournal: true}}
+			router := newTestRouter(t, &fakeJournalAccess{}, testServices{journals: journals})
+
+			rec := doRequest(router, http.MethodPost, "/api/v1/journals/"+tt.journalID.String()+"/"+tt.action, "")
+			if rec.Code != tt.wantStatus {
+				t.Fatalf("status = %d, want %d; body %s", rec.Code, tt.wantStatus, rec.Body)
+			}
+			if rec.Code != http.StatusOK {
+				return
+			}
+
+			var response dto.TradingJournalResponse
+			if err := json.Unmarshal(rec.Body.Bytes(), &response); err != nil {
+				t.Fatalf("decode response: %v", err)
+			}
+			if response.IsArchived != tt.wantArchived || *journals.archived != tt.wantArchived {
+				t.Errorf("archived: response %v, service %v, want %v", response.IsArchived, *journals.archived, tt.wantArchived)
+			}
+		})
+	}
+}
+
+// lockJournalService holds a single journal and rejects writes to it while
+// it is locked, as the real service does.
+type lockJournalService struct {
+	TradingJournalService
+	journal *entity.TradingJournal
+	writes  int
+}
+
+func (s *lockJournalService) GetByID(_ context.Context, id uuid.UUID) (*entity.TradingJournal, error) {
+	if id != s.journal.ID {
+		return nil, errors.Wrap(entity.ErrNotFound, "trading journal")
+	}
+	journal := *s.journal
+	return &journal, nil
+}
+
+func (s *lockJournalService) CountEntries(context.Context, uuid.UUID) (int, error) {
+	return 0, nil
+}
+
+func (s *lockJournalService) SetLocked(_ context.Context, id uuid.UUID, _ uuid.UUID, locked bool) (*entity.TradingJournal, error) {
+	if id != s.journal.ID {
+		return nil, errors.Wrap(entity.ErrNotFound, "trading journal")
+	}
+	s.journal.IsLocked = locked
+	journal := *s.journal
+	return &journal, nil
+}
+
+func (s *lockJournalService) Update(context.Context, *entity.TradingJournal) error {
+	if s.journal.IsLocked {
+		return entity.ErrJournalLocked
+	}
+	s.writes++
+	return nil
+}
+
+func (s *lockJournalService) Delete(context.Context, uuid.UUID, uuid.UUID) error {
+	if s.journal.IsLocked {
+		return entity.ErrJournalLocked
+	}
+	s.writes++
+	return nil
+}
+
+func TestLockJournalHandler(t *testing.T) {
+	tests := []struct {
+		name       string
+		action     string
+		foreign    bool
+		wantStatus int
+		wantLocked bool
+	}{
+		{"lock", "lock", false, http.StatusOK, true},
+		{"unlock", "unlock", false, http.StatusOK, false},
+		{"foreign journal", "lock", true, http.StatusNotFound, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			journal := entity.NewTradingJournal(testUserID, "Challenge", "")
+			journal.ID = uuid.New()
+			journals := &lockJournalService{journal: journal}
+			router := newTestRouter(t, &fakeJournalAccess{}, testServices{journals: journals})
+
+			id := journal.ID
+			if tt.foreign {
+				id = uuid.New()
+			}
+			rec := doRequest(router, http.MethodPost, "/api/v1/journals/"+id.String()+"/"+tt.action, "")
+			if rec.Code != tt.wantStatus {
+				t.Fatalf("status = %d, want %d; body %s", rec.Code, tt.wantStatus, rec.Body)
+			}
+			if rec.Code != http.StatusOK {
+				return
+			}
+
+			var response dto.TradingJournalResponse
+			if err := json.Unmarshal(rec.Body.Bytes(), &response); err != nil {
+				t.Fatalf("decode response: %v", err)
+			}
+			if response.IsLocked != tt.wantLocked || journals.journal.IsLocked != tt.wantLocked {
+				t.Errorf("locked: response %v, service %v, want %v", response.IsLocked, journals.journal.IsLocked, tt.wantLocked)
+			}
+		})
+	}
+}
+
+func TestLockedJournalRejectsWritesOverHTTP(t *testing.T) {
+	journal := entity.NewTradingJournal(testUserID, "Challenge", "")
+	journal.ID = uuid.New()
+	journals := &lockJournalService{journal: journal}
+	router := newTestRouter(t, &fakeJournalAccess{}, testServices{journals: journals})
+	path := "/api/v1/journals/" + journal.ID.String()
+
+	if rec := doRequest(router, http.MethodPost, path+"/lock", ""); rec.Code != http.StatusOK {
+		t.Fatalf("lock: status = %d, want %d; body %s", rec.Code, http.StatusOK, rec.Body)
+	}
+
+	writes := []struct {
+		method string
+		body   string
+	}{
+		{http.MethodPut, `{"name":"Renamed"}`},
+		{http.MethodDelete, ""},
+	}
+	for _, w := range writes {
+		rec := doRequest(router, w.method, path, w.body)
+		if rec.Code != http.StatusLocked {
+			t.Errorf("%s: status = %d, want %d; body %s", w.method, rec.Code, http.StatusLocked, rec.Body)
+		}
+		if code := decodeErrorCode(t, rec); code != CodeJournalLocked {
+			t.Errorf("%s: error code = %q, want %q", w.method, code, CodeJournalLocked)
+		}
+	}
+	if journals.writes != 0 {
+		t.Errorf("%d writes went through on a locked journal", journals.writes)
+	}
+
+	rec := doRequest(router, http.MethodGet, path, "")
+	if rec.Code != http.StatusOK {
+		t.Fatalf("read: status = %d, want %d; body %s", rec.Code, http.StatusOK, rec.Body)
+	}
+	var response dto.TradingJournalResponse
+	if err := json.Unmarshal(rec.Body.Bytes(), &response); err != nil {
+		t.Fatalf("decode response: %v", err)
+	}
+	if !response.IsLocked {
+		t.Errorf("read response is_locked = false, want true")
+	}
+
+	if rec := doRequest(router, http.MethodPost, path+"/unlock", ""); rec.Code != http.StatusOK {
+		t.Fatalf("unlock: status = %d, want %d; body %s", rec.Code, http.StatusOK, rec.Body)
+	}
+	if rec := doRequest(router, http.MethodPut, path, `{"name":"Renamed"}`); rec.Code != http.StatusOK {
+		t.Errorf("update after unlock: status = %d, want %d; body %s", rec.Code, http.StatusOK, rec.Body)
+	}
+}
+
+// summaryJournalService recomputes the summary of the journals in owned.
+type summaryJournalService struct {
+	TradingJournalService
+	owned map[uuid.UUID]bool
+}
+
+func (s *summaryJournalService) RecomputeSummary(_ context.Context, id uuid.UUID, _ uuid.UUID) (*entity.JournalSummary, error) {
+	if !s.owned[id] {
+		return nil, errors.Wrap(entity.ErrNotFound, "trading journal")
+	}
+	return &entity.JournalSummary{JournalID: id, TotalTrades: 4, Wins: 3, WinRate: 75, NetRealized: 120.5, ComputedAt: time.Now()}, nil
+}
+
+func TestRecomputeSummaryHandler(t *testing.T) {
+	ownJournal := uuid.New()
+
+	tests := []struct {
+		name       string
+		journalID  uuid.UUID
+		wantStatus int
+	}{
+		{"own journal", ownJournal, http.StatusOK},
+		{"foreign journal", uuid.New(), http.StatusNotFound},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			journals := &summaryJournalService{owned: map[uuid.UUID]bool{ownJournal: true}}
+			router := newTestRouter(t, &fakeJournalAccess{}, testServices{journals: journals})
+
+			rec := doRequest(router, http.MethodPost, "/api/v1/journals/"+tt.journalID.String()+"/recompute-summary", "")
+			if rec.Code != tt.wantStatus {
+				t.Fatalf("status = %d, want %d; body %s", rec.Code, tt.wantStatus, rec.Body)
+			}
+			if rec.Code != http.StatusOK {
+				return
+			}
+
+			var response dto.JournalSummaryResponse
+			if err := json.Unmarshal(rec.Body.Bytes(), &response); err != nil {
+				t.Fatalf("decode response: %v", err)
+			}
+			if response.JournalID != ownJournal || response.TotalTrades != 4 || response.WinRate != 75 || response.NetRealized != 120.5 {
+				t.Errorf("summary = %+v, want the recomputed totals", response)
+			}
+		})
+	}
+}
+
+// platformImportService imports the rows it is given into the journals in
+// owned, skipping the rows that already failed.
+type platformImportService struct {
+	TradingJournalService
+	owned map[uuid.UUID]bool
+	reqs  []*dto.CreateTradingJournalEntryRequest
+}
+
+func (s *platformImportService) ImportEntries(_ context.Context, id uuid.UUID, _ uuid.UUID, reqs []*dto.CreateTradingJournalEntryRequest, rowErrs map[int]error) (*entity.EntryImport, error) {
+	if !s.owned[id] {
+		return nil, errors.Wrap(entity.ErrNotFound, "trading journal")
+	}
+	s.reqs = reqs
+
+	result := &entity.EntryImport{}
+	for i, req := range reqs {
+		if err := rowErrs[i]; err != nil {
+			result.Errors = append(result.Errors, entity.ImportRowError{Index: i, Err: err})
+			continue
+		}
+		entry := entity.NewTradingJournalEntry(id, req.Day, req.Asset, req.LTF, req.HTF, nil, req.Session, req.TradeType, nil, req.Direction, req.EntryType, req.Realized, req.MaxRR, req.Result, req.Notes)
+		entry.ID = uuid.New()
+		result.Entries = append(result.Entries, entry)
+	}
+	return result, nil
+}
+
+func TestImportEntriesFromPlatform(t *testing.T) {
+	ownJournal := uuid.New()
+	options := "&ltf=https://charts.example.com/ltf&htf=https://charts.example.com/htf&max_rr=2.5"
+	ctrader := "ID,Symbol,Opening Direction,Opening Time,Closing Time,Net USD\n" +
+		"1,EURUSD,Buy,03/02/2026 08:15,03/02/2026 10:40,125.40\n" +
+		"2,BTCUSD,Buy,03/02/2026 09:00,03/02/2026 09:30,12.00\n" +
+		"3,GBPUSD.m,Sell,04/02/2026 13:00,05/02/2026 09:00,-40.10\n"
+	tradingView := "Trade #,Type,Date/Time,Profit USD\n" +
+		"1,Exit Long,2026-02-02 09:30,15.00\n" +
+		"1,Entry Long,2026-02-02 08:00,\n"
+
+	tests := []struct {
+		name         string
+		journalID    uuid.UUID
+		query        string
+		body         string
+		wantStatus   int
+		wantImported int
+		wantUnmapped []int
+	}{
+		{"ctrader", ownJournal, "?source=ctrader" + options, ctrader, http.StatusCreated, 2, []int{3}},
+		{"tradingview with asset", ownJournal, "?source=tradingview&asset=eur/usd" + options, tradingView, http.StatusCreated, 1, []int{}},
+		{"tradingview without asset", ownJournal, "?source=tradingview" + options, tradingView, http.StatusCreated, 0, []int{2}},
+		{"unsupported source", ownJournal, "?source=metatrader" + options, ctrader, http.StatusBadRequest, 0, nil},
+		{"missing chart links", ownJournal, "?source=ctrader&max_rr=2.5", ctrader, http.StatusBadRequest, 0, nil},
+		{"invalid asset", ownJournal, "?source=tradingview&asset=gold" + options, tradingView, http.StatusBadRequest, 0, nil},
+		{"missing column", ownJournal, "?source=ctrader" + options, "Symbol,Opening Direction\nEURUSD,Buy\n", http.StatusBadRequest, 0, nil},
+		{"foreign journal", uuid.New(), "?source=ctrader" + options, ctrader, http.StatusNotFound, 0, nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			journals := &platformImportService{owned: map[uuid.UUID]bool{ownJournal: true}}
+			router := newTestRouter(t, &fakeJournalAccess{}, testServices{journals: journals})
+
+			rec := doRequest(router, http.MethodPost, "/api/v1/journals/"+tt.journalID.String()+"/import"+tt.query, tt.body)
+			if rec.Code != tt.wantStatus {
+				t.Fatalf("status = %d, want %d; body %s", rec.Code, tt.wantStatus, rec.Body)
+			}
+			if rec.Code != http.StatusCreated {
+				return
+			}
+
+			var response dto.PlatformImportResponse
+			if err := json.Unmarshal(rec.Body.Bytes(), &response); err != nil {
+				t.Fatalf("decode response: %v", err)
+			}
+			if len(response.Imported) != tt.wantImported {
+				t.Errorf("imported %d entries, want %d", len(response.Imported), tt.wantImported)
+			}
+			lines := make([]int, len(response.Unmapped))
+			for i, row := range response.Unmapped {
+				lines[i] = row.Line
+				if row.Error == "" {
+					t.Errorf("unmapped line %d has no error", row.Line)
+				}
+			}
+			if !slices.Equal(lines, tt.wantUnmapped) {
+				t.Errorf("unmapped lines = %v, want %v", lines, tt.wantUnmapped)
+			}
+			for _, req := range journals.reqs {
+				if req != nil && (req.MaxRR != 2.5 || req.LTF != "https://charts.example.com/ltf") {
+					t.Errorf("request max rr %v, ltf %q; want the query options", req.MaxRR, req.LTF)
+				}
+			}
+		})
+	}
+}
+
+type importJournalService struct {
+	TradingJournalService
+	calls    int
+	previews int
+	rowErrs  map[int]error
+}
+
+func (s *importJournalService) Import(_ context.Context, userID uuid.UUID, doc *dto.JournalExportDocument) (*entity.TradingJournal, error) {
+	s.calls++
+	if doc.Version != dto.JournalExportVersion {
+		return nil, errors.Wrapf(entity.ErrUnsupportedExportVersion, "version %d", doc.Version)
+	}
+	journal := entity.NewTradingJournal(userID, doc.Journal.Name, doc.Journal.Description)
+	journal.ID = uuid.New()
+	return journal, nil
+}
+
+func (s *importJournalService) PreviewImport(_ context.Context, _ uuid.UUID, doc *dto.JournalExportDocument, rowErrs map[int]error) (*entity.ImportPreview, error) {
+	s.previews++
+	s.rowErrs = rowErrs
+	if doc.Version != dto.JournalExportVersion {
+		return nil, errors.Wrapf(entity.ErrUnsupportedExportVersion, "version %d", doc.Version)
+	}
+	preview := &entity.ImportPreview{JournalName: doc.Journal.Name}
+	for i := range doc.Entries {
+		if err, ok := rowErrs[i]; ok {
+			preview.Errors = append(preview.Errors, entity.ImportRowError{Index: i, Err: err})
+			continue
+		}
+		preview.EntryCount++
+	}
+	return preview, nil
+}
+
+func TestImportJournalHandler(t *testing.T) {
+	tests := []struct {
+		name       string
+		body       string
+		wantStatus int
+		wantCalls  int
+	}{
+		{"imported", `{"version":1,"journal":{"name":"Swing"},"entries":[]}`, http.StatusCreated, 1},
+		{"unsupported version", `{"version":2,"journal":{"name":"Swing"},"entries":[]}`, http.StatusBadRequest, 1},
+		{"missing version", `{"journal":{"name":"Swing"},"entries":[]}`, http.StatusBadRequest, 0},
+		{"missing journal name", `{"version":1,"journal":{},"entries":[]}`, http.StatusBadRequest, 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			journals := &importJournalService{}
+			router := newTestRouter(t, &fakeJournalAccess{}, testServices{journals: journals})
+
+			rec := doRequest(router, http.MethodPost, "/api/v1/journals/import", tt.body)
+			if rec.Code != tt.wantStatus {
+				t.Fatalf("status = %d, want %d; body %s", rec.Code, tt.wantStatus, rec.Body)
+			}
+			if journals.calls != tt.wantCalls {
+				t.Errorf("service calls = %d, want %d", journals.calls, tt.wantCalls)
+			}
+			if location := rec.Header().Get("Location"); (location != "") != (rec.Code == http.StatusCreated) {
+				t.Errorf("Location = %q for status %d", location, rec.Code)
+			}
+		})
+	}
+}
+
+// failingJournalService fails every lookup with err.
+type failingJournalService struct {
+	TradingJournalService
+	err error
+}
+
+func (s *failingJournalService) GetByID(context.Context, uuid.UUID) (*entity.TradingJournal, error) {
+	return nil, s.err
+}
+
+func (s *failingJournalService) GetByIDWithEntries(context.Context, uuid.UUID, int, int) (*entity.TradingJournal, int, error) {
+	return nil, 0, s.err
+}
+
+func (s *failingJournalService) Delete(context.Context, uuid.UUID, uuid.UUID) error {
+	return s.err
+}
+
+func (s *failingJournalService) SetArchived(context.Context, uuid.UUID, uuid.UUID, bool) (*entity.TradingJournal, error) {
+	return nil, s.err
+}
+
+func TestJournalNotFoundVsInternalError(t *testing.T) {
+	journal := "/api/v1/journals/" + uuid.NewString()
+	missing := errors.Wrap(entity.ErrNotFound, "trading journal")
+	outage := errors.Wrap(errors.New("connection refused"), "failed to get trading journal by id")
+
+	routes := []struct {
+		name   string
+		method string
+		path   string
+		body   string
+	}{
+		{name: "get", method: http.MethodGet, path: journal},
+		{name: "get with entries", method: http.MethodGet, path: journal + "/with-entries"},
+		{name: "update", method: http.MethodPut, path: journal, body: `{"name":"Swing"}`},
+		{name: "delete", method: http.MethodDelete, path: journal},
+		{name: "archive", method: http.MethodPost, path: journal + "/archive"},
+	}
+
+	for _, route := range routes {
+		for _, tt := range []struct {
+			name   string
+			err    error
+			status int
+		}{
+			{name: "missing", err: missing, status: http.StatusNotFound},
+			{name: "database error", err: outage, status: http.StatusInternalServerError},
+		} {
+			t.Run(route.name+"/"+tt.name, func(t *testing.T) {
+				router := newTestRouter(t, &fakeJournalAccess{}, testServices{journals: &failingJournalService{err: tt.err}})
+
+				rec := doRequest(router, route.method, route.path, route.body)
+				if rec.Code != tt.status {
+					t.Errorf("status = %d, want %d; body %s", rec.Code, tt.status, rec.Body)
+				}
+			})
+		}
+	}
+}
+
+type spanJournalService struct {
+	TradingJournalService
+	journal *entity.TradingJournal
+}
+
+func (s *spanJournalService) GetByID(context.Context, uuid.UUID) (*entity.TradingJournal, error) {
+	return s.journal, nil
+}
+
+func (s *spanJournalService) CountEntries(context.Context, uuid.UUID) (int, error) {
+	return 0, nil
+}
+
+func TestGetJournalEntrySpan(t *testing.T) {
+	first := time.Date(2026, 1, 5, 0, 0, 0, 0, time.UTC)
+	last := time.Date(2026, 3, 20, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name      string
+		first     *time.Time
+		last      *time.Time
+		wantFirst string
+		wantLast  string
+	}{
+		{"no entries", nil, nil, "null", "null"},
+		{"with entries", &first, &last, `"2026-01-05T00:00:00Z"`, `"2026-03-20T00:00:00Z"`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			journal := entity.NewTradingJournal(testUserID, "Swing", "")
+			journal.ID = uuid.New()
+			journal.FirstEntryDate = tt.first
+			journal.LastEntryDate = tt.last
+			access := &fakeJournalAccess{owned: map[uuid.UUID]bool{journal.ID: true}}
+			router := newTestRouter(t, access, testServices{journals: &spanJournalService{journal: journal}})
+
+			rec := doRequest(router, http.MethodGet, "/api/v1/journals/"+journal.ID.String(), "")
+			if rec.Code != http.StatusOK {
+				t.Fatalf("status = %d, want %d; body %s", rec.Code, http.StatusOK, rec.Body)
+			}
+
+			var response map[string]json.RawMessage
+			if err := json.Unmarshal(rec.Body.Bytes(), &response); err != nil {
+				t.Fatalf("decode response: %v", err)
+			}
+			if got := string(response["first_entry_date"]); got != tt.wantFirst {
+				t.Errorf("first_entry_date = %s, want %s", got, tt.wantFirst)
+			}
+			if got := string(response["last_entry_date"]); got != tt.wantLast {
+				t.Errorf("last_entry_date = %s, want %s", got, tt.wantLast)
+			}
+		})
+	}
+}
+
+// takenNameJournalService rejects every name as taken by another journal.
+type takenNameJournalService struct {
+	TradingJournalService
+	journal *entity.TradingJournal
+}
+
+func (s *takenNameJournalService) Create(context.Context, uuid.UUID, *dto.CreateTradingJournalRequest) (*entity.TradingJournal, error) {
+	return nil, entity.ErrJournalNameTaken
+}
+
+func (s *takenNameJournalService) GetByID(context.Context, uuid.UUID) (*entity.TradingJournal, error) {
+	return s.journal, nil
+}
+
+func (s *takenNameJournalService) Update(context.Context, *entity.TradingJournal) error {
+	return errors.Wrap(entity.ErrJournalNameTaken, "failed to update trading journal")
+}
+
+func TestJournalNameTakenConflict(t *testing.T) {
+	journal := entity.NewTradingJournal(testUserID, "Swing", "")
+	journal.ID = uuid.New()
+
+	tests := []struct {
+		name   string
+		method string
+		path   string
+	}{
+		{"create", http.MethodPost, "/api/v1/journals"},
+		{"update", http.MethodPut, "/api/v1/journals/" + journal.ID.String()},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			access := &fakeJournalAccess{owned: map[uuid.UUID]bool{journal.ID: true}}
+			router := newTestRouter(t, access, testServices{journals: &takenNameJournalService{journal: journal}})
+
+			rec := doRequest(router, tt.method, tt.path, `{"name":"swing"}`)
+			if rec.Code != http.StatusConflict {
+				t.Fatalf("status = %d, want %d; body %s", rec.Code, http.StatusConflict, rec.Body)
+			}
+			if code := decodeErrorCode(t, rec); code != CodeConflict {
+				t.Errorf("code = %q, want %q", code, CodeConflict)
+			}
+		})
+	}
+}
+
+func TestImportJournalDryRun(t *testing.T) {
+	const entry = `{"day":"2026-03-02T00:00:00Z","asset":"EURUSD","ltf":"https://charts.example.com/ltf","htf":"https://charts.example.com/htf","session":"london","trade_type":"intraday","direction":"buy","entry_type":"limit","max_rr":2,"result":"take_profit"}`
+	invalid := strings.Replace(entry, `"https://charts.example.com/ltf"`, `"not a url"`, 1)
+
+	tests := []struct {
+		name         string
+		body         string
+		wantStatus   int
+		wantPreviews int
+		wantRows     []int
+		wantCount    int
+	}{
+		{"valid entries", `{"version":1,"journal":{"name":"Swing"},"entries":[` + entry + `,` + entry + `]}`, http.StatusOK, 1, nil, 2},
+		{"invalid entry reported by index", `{"version":1,"journal":{"name":"Swing"},"entries":[` + entry + `,` + invalid + `]}`, http.StatusOK, 1, []int{1}, 1},
+		{"unsupported version", `{"version":2,"journal":{"name":"Swing"},"entries":[]}`, http.StatusBadRequest, 1, nil, 0},
+		{"missing journal name", `{"version":1,"journal":{},"entries":[` + invalid + `]}`, http.StatusBadRequest, 0, nil, 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			journals := &importJournalService{}
+			router := newTestRouter(t, &fakeJournalAccess{}, testServices{journals: journals})
+
+			rec := doRequest(router, http.MethodPost, "/api/v1/journals/import?dry_run=true", tt.body)
+			if rec.Code != tt.wantStatus {
+				t.Fatalf("status = %d, want %d; body %s", rec.Code, tt.wantStatus, rec.Body)
+			}
+			if journals.calls != 0 || journals.previews != tt.wantPreviews {
+				t.Errorf("imports = %d, previews = %d; want 0 and %d", journals.calls, journals.previews, tt.wantPreviews)
+			}
+			if rec.Code != http.StatusOK {
+				return
+			}
+
+			var response dto.ImportPreviewResponse
+			if err := json.Unmarshal(rec.Body.Bytes(), &response); err != nil {
+				t.Fatalf("decode response: %v", err)
+			}
+			if response.JournalName != "Swing" || response.EntriesToCreate != tt.wantCount {
+				t.Errorf("response = %+v, want Swing with %d entries", response, tt.wantCount)
+			}
+			if len(response.Errors) != len(tt.wantRows) {
+				t.Fatalf("errors = %+v, want rows %v", response.Errors, tt.wantRows)
+			}
+			for i, row := range tt.wantRows {
+				if response.Errors[i].Index != row || response.Errors[i].Error == "" {
+					t.Errorf("error %d = %+v, want a message for row %d", i, response.Errors[i], row)
+				}
+			}
+		})
+	}
+}
+
+// pagedJournalEntriesService holds total entries of one journal and serves
+// the requested page of them.
+type pagedJournalEntriesService struct {
+	TradingJournalService
+	total         int
+	limit, offset int
+}
+
+func (s *pagedJournalEntriesService) GetByIDWithEntries(_ context.Context, id uuid.UUID, limit, offset int) (*entity.TradingJournal, int, error) {
+	s.limit, s.offset = limit, offset
+	journal := &entity.TradingJournal{ID: id, Name: "Swing"}
+	for range max(0, min(limit, s.total-offset)) {
+		journal.Entries = append(journal.Entries, &entity.TradingJournalEntry{ID: uuid.New(), JournalID: id})
+	}
+	return journal, s.total, nil
+}
+
+func TestGetJournalWithEntriesPaginates(t *testing.T) {
+	journalID := uuid.New()
+	access := &fakeJournalAccess{owned: map[uuid.UUID]bool{journalID: true}}
+
+	tests := []struct {
+		name        string
+		query       string
+		wantLimit   int
+		wantOffset  int
+		wantEntries int
+		wantNext    bool
+	}{
+		{"first page", "?limit=2", 2, 0, 2, true},
+		{"last page", "?limit=2&offset=4", 2, 4, 1, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			journals := &pagedJournalEntriesService{total: 5}
+			router := newTestRouter(t, access, testServices{journals: journals})
+
+			rec := doRequest(router, http.MethodGet, "/api/v1/journals/"+journalID.String()+"/with-entries"+tt.query, "")
+			if rec.Code != http.StatusOK {
+				t.Fatalf("status = %d, want %d; body %s", rec.Code, http.StatusOK, rec.Body)
+			}
+			if journals.limit != tt.wantLimit || journals.offset != tt.wantOffset {
+				t.Errorf("service got limit %d offset %d, want %d and %d", journals.limit, journals.offset, tt.wantLimit, tt.wantOffset)
+			}
+
+			var response dto.TradingJournalWithEntriesResponse
+			if err := json.Unmarshal(rec.Body.Bytes(), &response); err != nil {
+				t.Fatalf("decode response: %v", err)
+			}
+			if len(response.Entries) != tt.wantEntries {
+				t.Errorf("got %d entries, want %d", len(response.Entries), tt.wantEntries)
+			}
+			if response.Pagination == nil {
+				t.Fatal("response has no pagination")
+			}
+			if response.Pagination.Total != 5 || response.Pagination.HasNext != tt.wantNext {
+				t.Errorf("pagination = %+v, want total 5 and has_next %v", *response.Pagination, tt.wantNext)
+			}
+		})
+	}
+}
+
+// brokerJournalService records the broker the journal list filters by and
+// creates journals with the requested broker.
+type brokerJournalService struct {
+	TradingJournalService
+	listBroker  string
+	countBroker string
+	creates     int
+}
+
+func (s *brokerJournalService) GetUserJournals(_ context.Context, _ uuid.UUID, _, _ int, _ bool, broker string) ([]*entity.TradingJournal, error) {
+	s.listBroker = broker
+	return nil, nil
+}
+
+func (s *brokerJournalService) CountUserJournals(_ context.Context, _ uuid.UUID, _ bool, broker string) (int, error) {
+	s.countBroker = broker
+	return 0, nil
+}
+
+func (s *brokerJournalService) Create(_ context.Context, userID uuid.UUID, req *dto.CreateTradingJournalRequest) (*entity.TradingJournal, error) {
+	s.creates++
+	journal := entity.NewTradingJournal(userID, req.Name, req.Description)
+	journal.ID = uuid.New()
+	journal.Broker = req.Broker
+	journal.AccountNumber = req.AccountNumber
+	return journal, nil
+}
+
+func TestListJournalsFiltersByBroker(t *testing.T) {
+	tests := []struct {
+		name  string
+		query string
+		want  string
+	}{
+		{"no filter", "", ""},
+		{"broker", "?broker=IC%20Markets", "IC Markets"},
+		{"trimmed", "?broker=%20FTMO%20", "FTMO"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			journals := &brokerJournalService{}
+			router := newTestRouter(t, &fakeJournalAccess{}, testServices{journals: journals})
+
+			rec := doRequest(router, http.MethodGet, "/api/v1/journals"+tt.query, "")
+			if rec.Code != http.StatusOK {
+				t.Fatalf("status = %d, want %d; body %s", rec.Code, http.StatusOK, rec.Body)
+			}
+			if journals.listBroker != tt.want || journals.countBroker != tt.want {
+				t.Errorf("list broker = %q, count broker = %q, want %q", journals.listBroker, journals.countBroker, tt.want)
+			}
+		})
+	}
+}
+
+func TestCreateJournalBroker(t *testing.T) {
+	tests := []struct {
+		name        string
+		broker      string
+		account     string
+		wantStatus  int
+		wantCreates int
+	}{
+		{"broker and account", "IC Markets", "5012345", http.StatusCreated, 1},
+		{"broker too long", strings.Repeat("b", 101), "5012345", http.StatusBadRequest, 0},
+		{"account too long", "IC Markets", strings.Repeat("1", 51), http.StatusBadRequest, 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			journals := &brokerJournalService{}
+			router := newTestRouter(t, &fakeJournalAccess{}, testServices{journals: journals})
+
+			body := `{"name":"Funded","broker":"` + tt.broker + `","account_number":"` + tt.account + `"}`
+			rec := doRequest(router, http.MethodPost, "/api/v1/journals", body)
+			if rec.Code != tt.wantStatus {
+				t.Fatalf("status = %d, want %d; body %s", rec.Code, tt.wantStatus, rec.Body)
+			}
+			if journals.creates != tt.wantCreates {
+				t.Errorf("service called %d times, want %d", journals.creates, tt.wantCreates)
+			}
+			if rec.Code != http.StatusCreated {
+				return
+			}
+
+			var response dto.TradingJournalResponse
+			if err := json.Unmarshal(rec.Body.Bytes(), &response); err != nil {
+				t.Fatalf("decode response: %v", err)
+			}
+			if response.Broker == nil || *response.Broker != tt.broker || response.AccountNumber == nil || *response.AccountNumber != tt.account {
+				t.Errorf("response broker = %v account = %v, want %q and %q", response.Broker, response.AccountNumber, tt.broker, tt.account)
+			}
+		})
+	}
+}
+
+// profileJournalService holds one strict journal and records updates.
+type profileJournalService struct {
+	TradingJournalService
+	journal *entity.TradingJournal
+	updated *entity.TradingJournal
+}
+
+func (s *profileJournalService) GetByID(context.Context, uuid.UUID) (*entity.TradingJournal, error) {
+	journal := *s.journal
+	return &journal, nil
+}
+
+func (s *profileJournalService) Update(_ context.Context, journal *entity.TradingJournal) error {
+	s.updated = journal
+	return nil
+}
+
+func TestUpdateJournalValidationProfile(t *testing.T) {
+	journalID := uuid.New()
+	access := &fakeJournalAccess{owned: map[uuid.UUID]bool{journalID: true}}
+
+	tests := []struct {
+		name       string
+		profile    string
+		wantStatus int
+		want       types.ValidationProfile
+	}{
+		{"omitted keeps the profile", "", http.StatusOK, types.ValidationProfileStrict},
+		{"lenient", `,"validation_profile":"lenient"`, http.StatusOK, types.ValidationProfileLenient},
+		{"unknown profile", `,"validation_profile":"paranoid"`, http.StatusBadRequest, ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			journal := entity.NewTradingJournal(testUserID, "Swing", "")
+			journal.ID = journalID
+			journal.ValidationProfile = types.ValidationProfileStrict
+			journals := &profileJournalService{journal: journal}
+			router := newTestRouter(t, access, testServices{journals: journals})
+
+			rec := doRequest(router, http.MethodPut, "/api/v1/journals/"+journalID.String(), `{"name":"Swing"`+tt.profile+`}`)
+			if rec.Code != tt.wantStatus {
+				t.Fatalf("status = %d, want %d; body %s", rec.Code, tt.wantStatus, rec.Body)
+			}
+			if tt.wantStatus != http.StatusOK {
+				if code := decodeErrorCode(t, rec); code != CodeValidationFailed {
+					t.Errorf("code = %q, want %q", code, CodeValidationFailed)
+				}
+				if journals.updated != nil {
+					t.Error("journal updated despite the invalid profile")
+				}
+				return
+			}
+			if journals.updated == nil || journals.updated.ValidationProfile != tt.want {
+				t.Fatalf("updated journal %+v, want profile %q", journals.updated, tt.want)
+			}
+			if !strings.Contains(rec.Body.String(), `"validation_profile":"`+string(tt.want)+`"`) {
+				t.Errorf("body %s does not report profile %q", rec.Body, tt.want)
+			}
+		})
+	}
+}
+
+// streamJournalService streams a fixed number of entries in pages of two,
+// then fails with err.
+type streamJournalService struct {
+	TradingJournalService
+	entries int
+	err     error
+}
+
+func (s *streamJournalService) StreamExport(_ context.Context, _ uuid.UUID, _ uuid.UUID, w io.Writer) error {
+	if s.entries < 0 {
+		return s.err
+	}
+
+	_, _ = io.WriteString(w, `{"version":1,"entries":[`)
+	for i := range s.entries {
+		if i > 0 {
+			_, _ = io.WriteString(w, ",")
+		}
+		_, _ = io.WriteString(w, `{"asset":"EURUSD"}`)
+		if i%2 == 1 {
+			w.(http.Flusher).Flush()
+		}
+	}
+	if s.err != nil {
+		return s.err
+	}
+	_, _ = io.WriteString(w, "]}\n")
+	return nil
+}
+
+func TestExportJournalStreams(t *testing.T) {
+	journalID := uuid.New()
+	access := &fakeJournalAccess{owned: map[uuid.UUID]bool{journalID: true}}
+
+	tests := []struct {
+		name        string
+		service     *streamJournalService
+		wantStatus  int
+		wantEntries int
+		wantValid   bool
+	}{
+		{"streamed document", &streamJournalService{entries: 5}, http.StatusOK, 5, true},
+		{"empty journal", &streamJournalService{}, http.StatusOK, 0, true},
+		{"not found before writing", &streamJournalService{entries: -1, err: entity.ErrNotFound}, http.StatusNotFound, 0, true},
+		{"failure midway", &streamJournalService{entries: 3, err: errors.New("connection reset")}, http.StatusOK, 0, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			router := newTestRouter(t, access, testServices{journals: tt.service})
+
+			rec := doRequest(router, http.MethodGet, "/api/v1/journals/"+journalID.String()+"/export", "")
+			if rec.Code != tt.wantStatus {
+				t.Fatalf("status = %d, want %d; body %s", rec.Code, tt.wantStatus, rec.Body)
+			}
+			if json.Valid(rec.Body.Bytes()) != tt.wantValid {
+				t.Fatalf("body %s valid JSON = %v, want %v", rec.Body, !tt.wantValid, tt.wantValid)
+			}
+			if tt.wantStatus != http.StatusOK {
+				if code := decodeErrorCode(t, rec); code != CodeNotFound {
+					t.Errorf("code = %q, want %q", code, CodeNotFound)
+				}
+				if rec.Header().Get("Content-Disposition") != "" {
+					t.Error("error response is sent as an attachment")
+				}
+				return
+			}
+			if !tt.wantValid {
+				return
+			}
+
+			var doc dto.JournalExportDocument
+			if err := json.Unmarshal(rec.Body.Bytes(), &doc); err != nil {
+				t.Fatalf("decode export: %v", err)
+			}
+			if len(doc.Entries) != tt.wantEntries {
+				t.Errorf("decoded %d entries, want %d", len(doc.Entries), tt.wantEntries)
+			}
+			if strings.HasPrefix(rec.Body.String(), `{"data"`) {
+				t.Errorf("export %s is wrapped in an envelope", rec.Body)
+			}
+		})
+	}
+}
+
+func TestUpdateJournalPipValue(t *testing.T) {
+	journalID := uuid.New()
+	access := &fakeJournalAccess{owned: map[uuid.UUID]bool{journalID: true}}
+
+	tests := []struct {
+		name       string
+		pipValue   string
+		wantStatus int
+		want       *float64
+	}{
+		{"set", `,"pip_value":10`, http.StatusOK, func() *float64 { v := 10.0; return &v }()},
+		{"cleared", "", http.StatusOK, nil},
+		{"zero", `,"pip_value":0`, http.StatusBadRequest, nil},
+		{"negative", `,"pip_value":-1`, http.StatusBadRequest, nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			journal := entity.NewTradingJournal(testUserID, "Swing", "")
+			journal.ID = journalID
+			previous := 5.0
+			journal.PipValue = &previous
+			journals := &profileJournalService{journal: journal}
+			router := newTestRouter(t, access, testServices{journals: journals})
+
+			rec := doRequest(router, http.MethodPut, "/api/v1/journals/"+journalID.String(), `{"name":"Swing"`+tt.pipValue+`}`)
+			if rec.Code != tt.wantStatus {
+				t.Fatalf("status = %d, want %d; body %s", rec.Code, tt.wantStatus, rec.Body)
+			}
+			if tt.wantStatus != http.StatusOK {
+				if code := decodeErrorCode(t, rec); code != CodeValidationFailed {
+					t.Errorf("code = %q, want %q", code, CodeValidationFailed)
+				}
+				if journals.updated != nil {
+					t.Error("journal updated despite the invalid pip value")
+				}
+				return
+			}
+
+			got := journals.updated.PipValue
+			if (got == nil) != (tt.want == nil) || (got != nil && *got != *tt.want) {
+				t.Errorf("updated pip value = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -7,7 +7,9 @@ import (
 	"github.com/cockroachdb/errors"
 	"github.com/gin-gonic/gin"
 	"github.com/go-playground/validator/v10"
+	"github.com/google/uuid"
 	"github.com/user/normark/internal/dto"
+	"github.com/user/normark/internal/dto/mapper"
 	"github.com/user/normark/internal/entity"
 	"go.uber.org/zap"
 )
@@ -15,22 +17,23 @@ import (
 type UserService interface {
 	SignUp(ctx context.Context, req *dto.SignUpRequest) (*dto.AuthResponse, error)
 	SignIn(ctx context.Context, req *dto.SignInRequest) (*dto.AuthResponse, error)
+	SignInWithGoogle(ctx context.Context, req *dto.GoogleSignInRequest) (*dto.AuthResponse, error)
+	RefreshAccessToken(ctx context.Context, req *dto.RefreshTokenRequest) (*dto.RefreshTokenResponse, error)
+	GetProfile(ctx context.Context, userID uuid.UUID) (*entity.User, error)
+	SessionService
 }
 
 type UserHandler struct {
 	userService UserService
-	logger      *zap.Logger
 	validate    *validator.Validate
 }
 
 func NewUserHandler(
 	userService UserService,
-	logger *zap.Logger,
 	validate *validator.Validate,
 ) *UserHandler {
 	return &UserHandler{
 		userService: userService,
-		logger:      logger,
 		validate:    validate,
 	}
 }
@@ -38,6 +41,18 @@ func NewUserHandler(
 func (h *UserHandler) InitRoutes(group *gin.RouterGroup) {
 	group.POST("/sign-up", h.SignUp)
 	group.POST("/sign-in", h.SignIn)
+	group.POST("/oauth/google", h.SignInWithGoogle)
+	group.POST("/refresh", h.Refresh)
+}
+
+// InitAuthenticatedRoutes registers the /auth routes that need a valid
+// access token.
+func (h *UserHandler) InitAuthenticatedRoutes(group *gin.RouterGroup) {
+	group.POST("/logout", h.Logout)
+}
+
+func (h *UserHandler) InitProfileRoutes(group *gin.RouterGroup) {
+	group.GET("/me", h.Me)
 }
 
 // SignUp godoc
@@ -56,29 +71,34 @@ func (h *UserHandler) SignUp(c *gin.Context) {
 	var req dto.SignUpRequest
 
 	if err := c.ShouldBindJSON(&req); err != nil {
-		h.logger.Error("failed to bind request", zap.Error(err))
+		loggerFromContext(c).Error("failed to bind request", zap.Error(err))
 		newErrorResponse(c, http.StatusBadRequest, "invalid request body")
 		return
 	}
 
 	if err := h.validate.Struct(&req); err != nil {
-		h.logger.Error("validation failed", zap.Error(err))
-		newErrorResponse(c, http.StatusBadRequest, err.Error())
+		loggerFromContext(c).Error("validation failed", zap.Error(err))
+		newErrorResponseFromError(c, http.StatusBadRequest, err)
 		return
 	}
 
+	req.Device = dto.DeviceInfo{
+		UserAgent: c.Request.UserAgent(),
+		IPAddress: c.ClientIP(),
+	}
+
 	response, err := h.userService.SignUp(c.Request.Context(), &req)
 	if err != nil {
-		h.logger.Error("failed to sign up user", zap.Error(err))
-		if errors.Is(err, entity.ErrUserAlreadyExists) {
-			newErrorResponse(c, http.StatusConflict, err.Error())
+		loggerFromContext(c).Error("failed to sign up user", zap.Error(err))
+		if errors.Is(err, entity.ErrConflict) {
+			newErrorResponseFromError(c, http.StatusConflict, err)
 			return
 		}
 		newErrorResponse(c, http.StatusInternalServerError, err.Error())
 		return
 	}
 
-	c.JSON(http.StatusCreated, response)
+	respond(c, http.StatusCreated, response)
 }
 
 // SignIn godoc
@@ -97,27 +117,212 @@ func (h *UserHandler) SignIn(c *gin.Context) {
 	var req dto.SignInRequest
 
 	if err := c.ShouldBindJSON(&req); err != nil {
-		h.logger.Error("failed to bind request", zap.Error(err))
+		loggerFromContext(c).Error("failed to bind request", zap.Error(err))
 		newErrorResponse(c, http.StatusBadRequest, "invalid request body")
 		return
 	}
 
 	if err := h.validate.Struct(&req); err != nil {
-		h.logger.Error("validation failed", zap.Error(err))
-		newErrorResponse(c, http.StatusBadRequest, err.Error())
+		loggerFromContext(c).Error("validation failed", zap.Error(err))
+		newErrorResponseFromError(c, http.StatusBadRequest, err)
 		return
 	}
 
+	req.Device = dto.DeviceInfo{
+		UserAgent: c.Request.UserAgent(),
+		IPAddress: c.ClientIP(),
+	}
+
 	response, err := h.userService.SignIn(c.Request.Context(), &req)
 	if err != nil {
-		h.logger.Error("failed to sign in user", zap.Error(err))
+		loggerFromContext(c).Error("failed to sign in user", zap.Error(err))
 		if errors.Is(err, entity.ErrInvalidCredentials) {
-			newErrorResponse(c, http.StatusUnauthorized, err.Error())
+			newErrorResponseFromError(c, http.StatusUnauthorized, err)
 			return
 		}
 		newErrorResponse(c, http.StatusInternalServerError, err.Error())
 		return
 	}
 
-	c.JSON(http.StatusOK, response)
+	respond(c, http.StatusOK, response)
+}
+
+// SignInWithGoogle godoc
+// @Summary      Sign in with Google
+// @Description  Authenticate with a Google ID token issued to this app's client ID. The first sign-in creates a passwordless account with a username derived from the email; an existing account with the same email is signed in. Accounts created this way cannot use password sign-in.
+// @Tags         Authentication
+// @Accept       json
+// @Produce      json
+// @Param        request body dto.GoogleSignInRequest true "Google ID token"
+// @Success      200 {object} dto.AuthResponse "Successfully authenticated with access and refresh tokens"
+// @Failure      400 {object} ErrorResponse "Invalid request body or validation failed"
+// @Failure      401 {object} ErrorResponse "Invalid Google ID token or unverified email"
+// @Failure      500 {object} ErrorResponse "Internal server error"
+// @Failure      503 {object} ErrorResponse "Google sign-in is not configured"
+// @Router       /api/v1/auth/oauth/google [post]
+func (h *UserHandler) SignInWithGoogle(c *gin.Context) {
+	var req dto.GoogleSignInRequest
+
+	if err := c.ShouldBindJSON(&req); err != nil {
+		loggerFromContext(c).Error("failed to bind request", zap.Error(err))
+		newErrorResponse(c, http.StatusBadRequest, "invalid request body")
+		return
+	}
+
+	if err := h.validate.Struct(&req); err != nil {
+		loggerFromContext(c).Error("validation failed", zap.Error(err))
+		newErrorResponseFromError(c, http.StatusBadRequest, err)
+		return
+	}
+
+	req.Device = dto.DeviceInfo{
+		UserAgent: c.Request.UserAgent(),
+		IPAddress: c.ClientIP(),
+	}
+
+	response, err := h.userService.SignInWithGoogle(c.Request.Context(), &req)
+	if err != nil {
+		loggerFromContext(c).Error("failed to sign in with google", zap.Error(err))
+		if errors.Is(err, entity.ErrInvalidGoogleToken) {
+			newErrorResponseFromError(c, http.StatusUnauthorized, err)
+			return
+		}
+		if errors.Is(err, entity.ErrGoogleSignInDisabled) {
+			newErrorResponseFromError(c, http.StatusServiceUnavailable, err)
+			return
+		}
+		newErrorResponse(c, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	respond(c, http.StatusOK, response)
+}
+
+// Refresh godoc
+// @Summary      Refresh access token
+// @Description  Exchange a refresh token for a new access token. Fails if the refresh token's session has been revoked.
+// @Tags         Authentication
+// @Accept       json
+// @Produce      json
+// @Param        request body dto.RefreshTokenRequest true "Refresh token"
+// @Success      200 {object} dto.RefreshTokenResponse "New access token"
+// @Failure      400 {object} ErrorResponse "Invalid request body or validation failed"
+// @Failure      401 {object} ErrorResponse "Invalid, expired or revoked refresh token"
+// @Failure      500 {object} ErrorResponse "Internal server error"
+// @Router       /api/v1/auth/refresh [post]
+func (h *UserHandler) Refresh(c *gin.Context) {
+	var req dto.RefreshTokenRequest
+
+	if err := c.ShouldBindJSON(&req); err != nil {
+		loggerFromContext(c).Error("failed to bind request", zap.Error(err))
+		newErrorResponse(c, http.StatusBadRequest, "invalid request body")
+		return
+	}
+
+	if err := h.validate.Struct(&req); err != nil {
+		loggerFromContext(c).Error("validation failed", zap.Error(err))
+		newErrorResponseFromError(c, http.StatusBadRequest, err)
+		return
+	}
+
+	response, err := h.userService.RefreshAccessToken(c.Request.Context(), &req)
+	if err != nil {
+		loggerFromContext(c).Error("failed to refresh access token", zap.Error(err))
+		if errors.Is(err, entity.ErrInvalidRefreshToken) {
+			newErrorResponseFromError(c, http.StatusUnauthorized, err)
+			return
+		}
+		newErrorResponse(c, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	respond(c, http.StatusOK, response)
+}
+
+// Logout godoc
+// @Summary      Sign out
+// @Description  Revoke the session the access token was issued for, so its refresh token stops working. The access token itself stays valid until it expires.
+// @Tags         Authentication
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Success      200 {object} map[string]string "Successfully signed out"
+// @Failure      401 {object} ErrorResponse "Unauthorized - missing or invalid token"
+// @Failure      500 {object} ErrorResponse "Internal server error"
+// @Router       /api/v1/auth/logout [post]
+func (h *UserHandler) Logout(c *gin.Context) {
+	userID, exists := c.Get("userID")
+	if !exists {
+		loggerFromContext(c).Error("user id not found in context")
+		newErrorResponse(c, http.StatusUnauthorized, "unauthorized")
+		return
+	}
+
+	uid, ok := userID.(uuid.UUID)
+	if !ok {
+		loggerFromContext(c).Error("invalid user id type in context")
+		newErrorResponse(c, http.StatusInternalServerError, "internal server error")
+		return
+	}
+
+	sessionID, exists := c.Get("sessionID")
+	if !exists {
+		loggerFromContext(c).Error("session id not found in context")
+		newErrorResponse(c, http.StatusUnauthorized, "unauthorized")
+		return
+	}
+
+	sid, ok := sessionID.(uuid.UUID)
+	if !ok {
+		loggerFromContext(c).Error("invalid session id type in context")
+		newErrorResponse(c, http.StatusInternalServerError, "internal server error")
+		return
+	}
+
+	// Signing out twice, or with a token whose session is already gone, is
+	// not an error.
+	err := h.userService.RevokeSession(c.Request.Context(), sid, uid)
+	if err != nil && !errors.Is(err, entity.ErrSessionNotFound) {
+		loggerFromContext(c).Error("failed to sign out", zap.Error(err))
+		newErrorResponse(c, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	respond(c, http.StatusOK, gin.H{"message": "signed out successfully"})
+}
+
+// Me godoc
+// @Summary      Get current user
+// @Description  Retrieve the profile of the authenticated user
+// @Tags         Users
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Success      200 {object} dto.UserResponse "Successfully retrieved user"
+// @Failure      401 {object} ErrorResponse "Unauthorized - missing or invalid token"
+// @Failure      404 {object} ErrorResponse "User not found"
+// @Router       /api/v1/users/me [get]
+func (h *UserHandler) Me(c *gin.Context) {
+	userID, exists := c.Get("userID")
+	if !exists {
+		loggerFromContext(c).Error("user id not found in context")
+		newErrorResponse(c, http.StatusUnauthorized, "unauthorized")
+		return
+	}
+
+	uid, ok := userID.(uuid.UUID)
+	if !ok {
+		loggerFromContext(c).Error("invalid user id type in context")
+		newErrorResponse(c, http.StatusInternalServerError, "internal server error")
+		return
+	}
+
+	user, err := h.userService.GetProfile(c.Request.Context(), uid)
+	if err != nil {
+		loggerFromContext(c).Error("failed to get user profile", zap.Error(err))
+		newErrorResponse(c, http.StatusNotFound, "user not found")
+		return
+	}
+
+	respond(c, http.StatusOK, mapper.ToUserResponse(user))
 }
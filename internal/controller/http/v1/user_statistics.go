@@ -0,0 +1,69 @@
+package v1
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/user/normark/internal/dto/mapper"
+	"github.com/user/normark/internal/entity"
+	"go.uber.org/zap"
+)
+
+type UserStatisticsService interface {
+	GetUserStatistics(ctx context.Context, userID uuid.UUID) (*entity.UserStatistics, error)
+}
+
+type UserStatisticsHandler struct {
+	statisticsService UserStatisticsService
+}
+
+func NewUserStatisticsHandler(
+	statisticsService UserStatisticsService,
+) *UserStatisticsHandler {
+	return &UserStatisticsHandler{
+		statisticsService: statisticsService,
+	}
+}
+
+func (h *UserStatisticsHandler) InitRoutes(group *gin.RouterGroup) {
+	group.GET("/statistics", h.GetStatistics)
+}
+
+// GetStatistics godoc
+// @Summary      Get statistics across all of the user's journals
+// @Description  Retrieve aggregate trade counts, win rate and net realized across every journal owned by the authenticated user, with a per-journal summary
+// @Tags         Users
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Success      200 {object} dto.UserStatisticsResponse "Successfully retrieved user statistics"
+// @Failure      401 {object} ErrorResponse "Unauthorized - missing or invalid token"
+// @Failure      500 {object} ErrorResponse "Internal server error"
+// @Router       /api/v1/users/me/statistics [get]
+func (h *UserStatisticsHandler) GetStatistics(c *gin.Context) {
+	userID, exists := c.Get("userID")
+	if !exists {
+		loggerFromContext(c).Error("user id not found in context")
+		newErrorResponse(c, http.StatusUnauthorized, "unauthorized")
+		return
+	}
+
+	uid, ok := userID.(uuid.UUID)
+	if !ok {
+		loggerFromContext(c).Error("invalid user id type in context")
+		newErrorResponse(c, http.StatusInternalServerError, "internal server error")
+		return
+	}
+
+	stats, err := h.statisticsService.GetUserStatistics(c.Request.Context(), uid)
+	if err != nil {
+		loggerFromContext(c).Error("failed to get user statistics", zap.Error(err))
+		newErrorResponse(c, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	response := mapper.ToUserStatisticsResponse(stats)
+	respond(c, http.StatusOK, response)
+}
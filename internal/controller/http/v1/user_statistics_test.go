@@ -0,0 +1,56 @@
+package v1
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/user/normark/internal/dto"
+	"github.com/user/normark/internal/entity"
+)
+
+type userStatisticsEntryService struct {
+	TradingJournalEntryService
+	userID uuid.UUID
+}
+
+func (s *userStatisticsEntryService) GetUserStatistics(_ context.Context, userID uuid.UUID) (*entity.UserStatistics, error) {
+	s.userID = userID
+	return &entity.UserStatistics{
+		TotalJournals: 2,
+		TotalTrades:   10,
+		Wins:          5,
+		WinRate:       50,
+		TotalRealized: 99.9,
+		Journals: []*entity.JournalStatistics{
+			{JournalID: uuid.New(), JournalName: "Swing", TotalTrades: 4, Wins: 3},
+			{JournalID: uuid.New(), JournalName: "Scalping", TotalTrades: 6, Wins: 2},
+		},
+	}, nil
+}
+
+func TestGetUserStatisticsHandler(t *testing.T) {
+	entries := &userStatisticsEntryService{}
+	router := newTestRouter(t, &fakeJournalAccess{}, testServices{entries: entries})
+
+	rec := doRequest(router, http.MethodGet, "/api/v1/users/me/statistics", "")
+	if rec.Code != http.StatusOK {
+		t.Fatalf("status = %d, want %d; body %s", rec.Code, http.StatusOK, rec.Body)
+	}
+	if entries.userID != testUserID {
+		t.Errorf("statistics requested for %s, want the authenticated user %s", entries.userID, testUserID)
+	}
+
+	var response dto.UserStatisticsResponse
+	if err := json.Unmarshal(rec.Body.Bytes(), &response); err != nil {
+		t.Fatalf("decode response: %v", err)
+	}
+	if response.TotalJournals != 2 || response.TotalTrades != 10 || response.WinRate != 50 || response.TotalRealized != 99.9 {
+		t.Errorf("response = %+v, want the aggregated totals", response)
+	}
+	if len(response.Journals) != 2 || response.Journals[0].JournalName != "Swing" {
+		t.Errorf("journals = %+v, want both journal summaries in order", response.Journals)
+	}
+}
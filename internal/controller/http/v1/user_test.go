@@ -0,0 +1,220 @@
+package v1
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/cockroachdb/errors"
+	"github.com/google/uuid"
+	"github.com/user/normark/internal/config"
+	"github.com/user/normark/internal/dto"
+	"github.com/user/normark/internal/entity"
+	"go.uber.org/zap"
+)
+
+type routeUserService struct {
+	UserService
+	revokedSession uuid.UUID
+	revokedFor     uuid.UUID
+}
+
+func (s *routeUserService) RefreshAccessToken(context.Context, *dto.RefreshTokenRequest) (*dto.RefreshTokenResponse, error) {
+	return &dto.RefreshTokenResponse{AccessToken: "access", ExpiresAt: time.Now().Add(time.Hour)}, nil
+}
+
+func (s *routeUserService) GetProfile(_ context.Context, userID uuid.UUID) (*entity.User, error) {
+	return &entity.User{ID: userID, Email: "trader@example.com", Username: "trader"}, nil
+}
+
+func (s *routeUserService) ListSessions(context.Context, uuid.UUID) ([]*entity.Session, error) {
+	return nil, nil
+}
+
+func (s *routeUserService) RevokeSession(_ context.Context, id uuid.UUID, userID uuid.UUID) error {
+	s.revokedSession = id
+	s.revokedFor = userID
+	return nil
+}
+
+func TestUserRoutesAuthBoundary(t *testing.T) {
+	tests := []struct {
+		name        string
+		method      string
+		path        string
+		body        string
+		public      bool
+		wantWithJWT int
+	}{
+		{name: "refresh", method: http.MethodPost, path: "/api/v1/auth/refresh", body: `{"refresh_token":"refresh"}`, public: true, wantWithJWT: http.StatusOK},
+		{name: "logout", method: http.MethodPost, path: "/api/v1/auth/logout", wantWithJWT: http.StatusOK},
+		{name: "me", method: http.MethodGet, path: "/api/v1/users/me", wantWithJWT: http.StatusOK},
+		{name: "sessions", method: http.MethodGet, path: "/api/v1/users/me/sessions", wantWithJWT: http.StatusOK},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			router := newTestRouter(t, &fakeJournalAccess{}, testServices{users: &routeUserService{}})
+
+			if rec := doRequest(router, tt.method, tt.path, tt.body); rec.Code != tt.wantWithJWT {
+				t.Errorf("with token: status = %d, want %d; body %s", rec.Code, tt.wantWithJWT, rec.Body)
+			}
+
+			req := httptest.NewRequest(tt.method, tt.path, strings.NewReader(tt.body))
+			req.Header.Set("Content-Type", "application/json")
+			rec := httptest.NewRecorder()
+			router.ServeHTTP(rec, req)
+
+			wantWithout := http.StatusUnauthorized
+			if tt.public {
+				wantWithout = tt.wantWithJWT
+			}
+			if rec.Code != wantWithout {
+				t.Errorf("without token: status = %d, want %d; body %s", rec.Code, wantWithout, rec.Body)
+			}
+		})
+	}
+}
+
+func TestLogoutRevokesCurrentSession(t *testing.T) {
+	users := &routeUserService{}
+	router := newTestRouter(t, &fakeJournalAccess{}, testServices{users: users})
+
+	rec := doRequest(router, http.MethodPost, "/api/v1/auth/logout", "")
+	if rec.Code != http.StatusOK {
+		t.Fatalf("status = %d, want %d; body %s", rec.Code, http.StatusOK, rec.Body)
+	}
+	if users.revokedFor != testUserID || users.revokedSession == uuid.Nil {
+		t.Errorf("revoked session %s for %s, want the token's session for %s", users.revokedSession, users.revokedFor, testUserID)
+	}
+}
+
+type signUpUserService struct {
+	UserService
+	err error
+}
+
+func (s *signUpUserService) SignUp(context.Context, *dto.SignUpRequest) (*dto.AuthResponse, error) {
+	return nil, s.err
+}
+
+func TestSignUpDuplicateUser(t *testing.T) {
+	tests := []struct {
+		name       string
+		err        error
+		wantStatus int
+	}{
+		{"duplicate user", entity.ErrUserAlreadyExists, http.StatusConflict},
+		{"storage failure", errors.New("connection reset"), http.StatusInternalServerError},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			router := newTestRouter(t, &fakeJournalAccess{}, testServices{users: &signUpUserService{err: tt.err}})
+
+			rec := doRequest(router, http.MethodPost, "/api/v1/auth/sign-up", `{"email":"trader@example.com","username":"trader","password":"password123"}`)
+			if rec.Code != tt.wantStatus {
+				t.Errorf("status = %d, want %d; body %s", rec.Code, tt.wantStatus, rec.Body)
+			}
+		})
+	}
+}
+
+type googleUserService struct {
+	UserService
+	err error
+}
+
+func (s *googleUserService) SignInWithGoogle(context.Context, *dto.GoogleSignInRequest) (*dto.AuthResponse, error) {
+	if s.err != nil {
+		return nil, s.err
+	}
+	return &dto.AuthResponse{AccessToken: "access", RefreshToken: "refresh", ExpiresAt: time.Now().Add(time.Hour)}, nil
+}
+
+func TestSignInWithGoogle(t *testing.T) {
+	tests := []struct {
+		name       string
+		body       string
+		err        error
+		wantStatus int
+		wantCode   string
+	}{
+		{"signed in", `{"id_token":"id-token"}`, nil, http.StatusOK, ""},
+		{"missing token", `{}`, nil, http.StatusBadRequest, CodeValidationFailed},
+		{"invalid token", `{"id_token":"id-token"}`, entity.ErrInvalidGoogleToken, http.StatusUnauthorized, CodeInvalidCredentials},
+		{"not configured", `{"id_token":"id-token"}`, entity.ErrGoogleSignInDisabled, http.StatusServiceUnavailable, CodeServiceUnavailable},
+		{"verifier failure", `{"id_token":"id-token"}`, errors.New("tokeninfo unreachable"), http.StatusInternalServerError, CodeInternal},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			router := newTestRouter(t, &fakeJournalAccess{}, testServices{users: &googleUserService{err: tt.err}})
+
+			rec := doRequest(router, http.MethodPost, "/api/v1/auth/oauth/google", tt.body)
+			if rec.Code != tt.wantStatus {
+				t.Fatalf("status = %d, want %d; body %s", rec.Code, tt.wantStatus, rec.Body)
+			}
+			if tt.wantCode == "" {
+				if !strings.Contains(rec.Body.String(), `"access_token":"access"`) {
+					t.Errorf("body %s has no access token", rec.Body)
+				}
+				return
+			}
+			if code := decodeErrorCode(t, rec); code != tt.wantCode {
+				t.Errorf("code = %q, want %q", code, tt.wantCode)
+			}
+		})
+	}
+}
+
+// deviceUserService records the device of the last sign-in.
+type deviceUserService struct {
+	UserService
+	device dto.DeviceInfo
+}
+
+func (s *deviceUserService) SignIn(_ context.Context, req *dto.SignInRequest) (*dto.AuthResponse, error) {
+	s.device = req.Device
+	return &dto.AuthResponse{}, nil
+}
+
+func TestSessionIPFollowsTrustedProxies(t *testing.T) {
+	tests := []struct {
+		name         string
+		proxies      []string
+		remoteAddr   string
+		forwardedFor string
+		wantIP       string
+	}{
+		{"no trusted proxies", nil, "192.0.2.1:5000", "203.0.113.9", "192.0.2.1"},
+		{"untrusted peer", []string{"10.0.0.0/8"}, "192.0.2.1:5000", "203.0.113.9", "192.0.2.1"},
+		{"client behind trusted proxy", []string{"10.0.0.0/8"}, "10.0.0.5:5000", "203.0.113.9, 198.51.100.7", "198.51.100.7"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			users := &deviceUserService{}
+			h := newTestHandler(t, zap.NewNop(), "production", &fakeJournalAccess{}, testServices{users: users})
+			h.rateLimiter = NewRateLimiter(&config.RateLimit{RequestsPerSecond: 1000, Burst: 1000, TrustedProxies: tt.proxies}, zap.NewNop())
+			router := h.InitRoutes()
+
+			req := httptest.NewRequest(http.MethodPost, "/api/v1/auth/sign-in", strings.NewReader(`{"email":"trader@example.com","password":"password123"}`))
+			req.Header.Set("Content-Type", "application/json")
+			req.Header.Set(headerXForwardedFor, tt.forwardedFor)
+			req.RemoteAddr = tt.remoteAddr
+			rec := httptest.NewRecorder()
+			router.ServeHTTP(rec, req)
+
+			if rec.Code != http.StatusOK {
+				t.Fatalf("status = %d, want %d; body %s", rec.Code, http.StatusOK, rec.Body)
+			}
+			if users.device.IPAddress != tt.wantIP {
+				t.Errorf("session IP = %q, want %q", users.device.IPAddress, tt.wantIP)
+			}
+		})
+	}
+}
@@ -0,0 +1,30 @@
+package v1
+
+import (
+	"net/url"
+	"strings"
+
+	"github.com/go-playground/validator/v10"
+)
+
+// newValidator returns a validator with the project's custom tags registered.
+func newValidator() *validator.Validate {
+	validate := validator.New()
+
+	// weburl restricts a URL to the http and https schemes. The built-in url
+	// tag accepts any scheme, including javascript: and data:.
+	_ = validate.RegisterValidation("weburl", validateWebURL)
+
+	return validate
+}
+
+func validateWebURL(fl validator.FieldLevel) bool {
+	u, err := url.Parse(fl.Field().String())
+	if err != nil {
+		return false
+	}
+
+	scheme := strings.ToLower(u.Scheme)
+
+	return (scheme == "http" || scheme == "https") && u.Host != ""
+}
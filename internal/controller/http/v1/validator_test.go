@@ -0,0 +1,219 @@
+package v1
+
+import (
+	"context"
+	"net/http"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/user/normark/internal/dto"
+	"github.com/user/normark/internal/entity"
+	"github.com/user/normark/internal/types"
+)
+
+func TestValidateEntryCharts(t *testing.T) {
+	tooMany := make([]string, 11)
+	for i := range tooMany {
+		tooMany[i] = "https://www.tradingview.com/x/abc/"
+	}
+
+	tests := []struct {
+		name    string
+		charts  []string
+		wantErr bool
+	}{
+		{name: "none", charts: nil},
+		{name: "https links", charts: []string{"https://www.tradingview.com/x/abc/", "http://example.com/chart.png"}},
+		{name: "upper case scheme", charts: []string{"HTTPS://example.com/chart.png"}},
+		{name: "ten links", charts: tooMany[:10]},
+		{name: "javascript url", charts: []string{"javascript:alert(1)"}, wantErr: true},
+		{name: "data url", charts: []string{"data:text/html;base64,PHNjcmlwdD4="}, wantErr: true},
+		{name: "ftp url", charts: []string{"ftp://example.com/chart.png"}, wantErr: true},
+		{name: "scheme without host", charts: []string{"https:///chart.png"}, wantErr: true},
+		{name: "one bad link among good ones", charts: []string{"https://example.com/a.png", "javascript:void(0)"}, wantErr: true},
+		{name: "more than ten links", charts: tooMany, wantErr: true},
+	}
+
+	validate := newValidator()
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			requests := map[string]any{
+				"create": &dto.CreateTradingJournalEntryRequest{EntryCharts: tt.charts, SetupCharts: tt.charts},
+				"update": &dto.UpdateTradingJournalEntryRequest{EntryCharts: tt.charts, SetupCharts: tt.charts},
+			}
+			for kind, req := range requests {
+				err := validate.StructPartial(req, "EntryCharts", "SetupCharts")
+				if (err != nil) != tt.wantErr {
+					t.Errorf("%s: error = %v, wantErr %v", kind, err, tt.wantErr)
+				}
+				if err != nil && !strings.Contains(err.Error(), "EntryCharts") {
+					t.Errorf("%s: error = %v, want it to name EntryCharts", kind, err)
+				}
+			}
+		})
+	}
+}
+
+func TestValidateSetupChartsIndependently(t *testing.T) {
+	good := []string{"https://www.tradingview.com/x/abc/"}
+	bad := []string{"javascript:alert(1)"}
+	ten := make([]string, 10)
+	for i := range ten {
+		ten[i] = "https://www.tradingview.com/x/abc/"
+	}
+
+	tests := []struct {
+		name        string
+		entryCharts []string
+		setupCharts []string
+		wantFields  []string
+	}{
+		{name: "both valid", entryCharts: good, setupCharts: good},
+		{name: "ten of each", entryCharts: ten, setupCharts: ten},
+		{name: "bad setup chart", entryCharts: good, setupCharts: bad, wantFields: []string{"SetupCharts"}},
+		{name: "bad entry chart", entryCharts: bad, setupCharts: good, wantFields: []string{"EntryCharts"}},
+		{name: "too many setup charts", entryCharts: nil, setupCharts: append(ten, good...), wantFields: []string{"SetupCharts"}},
+		{name: "both bad", entryCharts: bad, setupCharts: bad, wantFields: []string{"EntryCharts", "SetupCharts"}},
+	}
+
+	validate := newValidator()
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			requests := map[string]any{
+				"create": &dto.CreateTradingJournalEntryRequest{EntryCharts: tt.entryCharts, SetupCharts: tt.setupCharts},
+				"update": &dto.UpdateTradingJournalEntryRequest{EntryCharts: tt.entryCharts, SetupCharts: tt.setupCharts},
+			}
+			for kind, req := range requests {
+				err := validate.StructPartial(req, "EntryCharts", "SetupCharts")
+				if (err != nil) != (len(tt.wantFields) > 0) {
+					t.Fatalf("%s: error = %v, want errors on %v", kind, err, tt.wantFields)
+				}
+				if err == nil {
+					continue
+				}
+				for _, field := range []string{"EntryCharts", "SetupCharts"} {
+					want := slices.Contains(tt.wantFields, field)
+					if strings.Contains(err.Error(), field) != want {
+						t.Errorf("%s: error = %v, want %s reported %v", kind, err, field, want)
+					}
+				}
+			}
+		})
+	}
+}
+
+type createEntryService struct {
+	TradingJournalEntryService
+	calls int
+	req   *dto.CreateTradingJournalEntryRequest
+}
+
+func (s *createEntryService) Create(_ context.Context, journalID uuid.UUID, req *dto.CreateTradingJournalEntryRequest) (*entity.TradingJournalEntry, error) {
+	s.calls++
+	s.req = req
+	return &entity.TradingJournalEntry{ID: uuid.New(), JournalID: journalID, EntryCharts: req.EntryCharts}, nil
+}
+
+func TestCreateEntryValidatesChartURLs(t *testing.T) {
+	journalID := uuid.New()
+	access := &fakeJournalAccess{owned: map[uuid.UUID]bool{journalID: true}}
+
+	tests := []struct {
+		name      string
+		chart     string
+		status    int
+		wantCalls int
+	}{
+		{name: "https link", chart: "https://www.tradingview.com/x/abc/", status: http.StatusCreated, wantCalls: 1},
+		{name: "javascript url", chart: "javascript:alert(1)", status: http.StatusBadRequest},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			entries := &createEntryService{}
+			router := newTestRouter(t, access, testServices{entries: entries})
+
+			body := `{"day":"2026-03-02T00:00:00Z","asset":"EURUSD","ltf":"https://example.com/ltf","htf":"https://example.com/htf",` +
+				`"entry_charts":["` + tt.chart + `"],"session":"london","trade_type":"intraday","direction":"buy",` +
+				`"entry_type":"market","realized":100,"max_rr":2,"result":"TP"}`
+			rec := doRequest(router, http.MethodPost, "/api/v1/journals/"+journalID.String()+"/entries", body)
+			if rec.Code != tt.status {
+				t.Fatalf("status = %d, want %d; body %s", rec.Code, tt.status, rec.Body)
+			}
+			if entries.calls != tt.wantCalls {
+				t.Errorf("service called %d times, want %d", entries.calls, tt.wantCalls)
+			}
+		})
+	}
+}
+
+func TestCreateEntryNormalizesAsset(t *testing.T) {
+	journalID := uuid.New()
+	access := &fakeJournalAccess{owned: map[uuid.UUID]bool{journalID: true}}
+
+	tests := []struct {
+		asset string
+		want  types.CurrencyPair
+	}{
+		{"EURUSD", types.CurrencyPairEURUSD},
+		{"eur/usd", types.CurrencyPairEURUSD},
+		{"Eur-Usd", types.CurrencyPairEURUSD},
+		{"fiber", types.CurrencyPairEURUSD},
+		{"cable", types.CurrencyPairGBPUSD},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.asset, func(t *testing.T) {
+			entries := &createEntryService{}
+			router := newTestRouter(t, access, testServices{entries: entries})
+
+			body := `{"day":"2026-03-02T00:00:00Z","asset":"` + tt.asset + `","ltf":"https://example.com/ltf","htf":"https://example.com/htf",` +
+				`"session":"london","trade_type":"intraday","direction":"buy","entry_type":"market","realized":100,"max_rr":2,"result":"TP"}`
+			rec := doRequest(router, http.MethodPost, "/api/v1/journals/"+journalID.String()+"/entries", body)
+			if rec.Code != http.StatusCreated {
+				t.Fatalf("status = %d, want %d; body %s", rec.Code, http.StatusCreated, rec.Body)
+			}
+			if entries.req.Asset != tt.want {
+				t.Errorf("asset = %q, want %q", entries.req.Asset, tt.want)
+			}
+		})
+	}
+}
+
+func TestCreateEntryValidatesConfidence(t *testing.T) {
+	journalID := uuid.New()
+	access := &fakeJournalAccess{owned: map[uuid.UUID]bool{journalID: true}}
+
+	tests := []struct {
+		name       string
+		confidence string
+		status     int
+		want       *int
+	}{
+		{"not recorded", "", http.StatusCreated, nil},
+		{"lowest", `,"confidence":1`, http.StatusCreated, ptrTo(1)},
+		{"highest", `,"confidence":5`, http.StatusCreated, ptrTo(5)},
+		{"zero", `,"confidence":0`, http.StatusBadRequest, nil},
+		{"above the scale", `,"confidence":6`, http.StatusBadRequest, nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			entries := &createEntryService{}
+			router := newTestRouter(t, access, testServices{entries: entries})
+
+			body := `{"day":"2026-03-02T00:00:00Z","asset":"EURUSD","ltf":"https://example.com/ltf","htf":"https://example.com/htf",` +
+				`"session":"london","trade_type":"intraday","direction":"buy","entry_type":"market","realized":100,"max_rr":2,"result":"TP"` +
+				tt.confidence + `}`
+			rec := doRequest(router, http.MethodPost, "/api/v1/journals/"+journalID.String()+"/entries", body)
+			if rec.Code != tt.status {
+				t.Fatalf("status = %d, want %d; body %s", rec.Code, tt.status, rec.Body)
+			}
+			if rec.Code == http.StatusCreated && !equalPtr(entries.req.Confidence, tt.want) {
+				t.Errorf("confidence passed to service = %v, want %v", entries.req.Confidence, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,21 @@
+package dto
+
+import "time"
+
+// AccountExportVersion is the version written into every account export.
+const AccountExportVersion = 1
+
+// AccountExportDocument is everything stored about a user, for data
+// portability. It is streamed rather than built in memory, so entries are
+// listed flat after the journals and point back to theirs by journal_id,
+// and entry notes and exits follow and point back to theirs by entry_id.
+type AccountExportDocument struct {
+	Version    int                            `json:"version"`
+	ExportedAt time.Time                      `json:"exported_at"`
+	User       *UserResponse                  `json:"user"`
+	Sessions   []*SessionResponse             `json:"sessions"`
+	Journals   []*TradingJournalResponse      `json:"journals"`
+	Entries    []*TradingJournalEntryResponse `json:"entries"`
+	EntryNotes []*EntryNoteResponse           `json:"entry_notes"`
+	EntryExits []*EntryExitResponse           `json:"entry_exits"`
+}
@@ -1,16 +1,71 @@
 package dto
 
-import "time"
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
 
 type SignUpRequest struct {
 	Email    string `json:"email" validate:"required,email"`
 	Username string `json:"username" validate:"required,min=3,max=50"`
 	Password string `json:"password" validate:"required,min=8"`
+
+	// Device describes the client the session is created for.
+	Device DeviceInfo `json:"-"`
 }
 
 type SignInRequest struct {
 	Email    string `json:"email" validate:"required,email"`
 	Password string `json:"password" validate:"required"`
+
+	// Device describes the client the session is created for.
+	Device DeviceInfo `json:"-"`
+}
+
+// GoogleSignInRequest carries the ID token the client got from Google
+// Sign-In for this app's client ID.
+type GoogleSignInRequest struct {
+	IDToken string `json:"id_token" validate:"required"`
+
+	// Device describes the client the session is created for.
+	Device DeviceInfo `json:"-"`
+}
+
+// DeviceInfo is taken from the request headers, not the body.
+type DeviceInfo struct {
+	UserAgent string
+	IPAddress string
+}
+
+type RefreshTokenRequest struct {
+	RefreshToken string `json:"refresh_token" validate:"required"`
+}
+
+type RefreshTokenResponse struct {
+	AccessToken string    `json:"access_token"`
+	ExpiresAt   time.Time `json:"expires_at"`
+}
+
+type SessionResponse struct {
+	ID         uuid.UUID `json:"id"`
+	UserAgent  string    `json:"user_agent"`
+	IPAddress  string    `json:"ip_address"`
+	LastUsedAt time.Time `json:"last_used_at"`
+	ExpiresAt  time.Time `json:"expires_at"`
+	CreatedAt  time.Time `json:"created_at"`
+}
+
+type SessionListResponse struct {
+	Sessions []*SessionResponse `json:"sessions"`
+}
+
+type UserResponse struct {
+	ID        uuid.UUID `json:"id"`
+	Email     string    `json:"email"`
+	Username  string    `json:"username"`
+	Provider  string    `json:"provider" enums:"password,google"`
+	CreatedAt time.Time `json:"created_at"`
 }
 
 type AuthResponse struct {
@@ -0,0 +1,109 @@
+package dto
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/user/normark/internal/types"
+)
+
+// JournalExportVersion is the version written into every export document.
+// Import rejects documents with any other version.
+const JournalExportVersion = 1
+
+type ExportJobResponse struct {
+	ID          uuid.UUID  `json:"id"`
+	JournalID   uuid.UUID  `json:"journal_id"`
+	Status      string     `json:"status" enums:"pending,running,ready,failed"`
+	Error       string     `json:"error,omitempty"`
+	CreatedAt   time.Time  `json:"created_at"`
+	CompletedAt *time.Time `json:"completed_at,omitempty"`
+	// DownloadURL is set once the job is ready.
+	DownloadURL string `json:"download_url,omitempty"`
+}
+
+type ImportPreviewResponse struct {
+	JournalName     string                   `json:"journal_name"`
+	EntriesToCreate int                      `json:"entries_to_create"`
+	Errors          []ImportRowErrorResponse `json:"errors"`
+}
+
+type ImportRowErrorResponse struct {
+	// Index is the entry's position in the document's entries array.
+	Index int    `json:"index"`
+	Error string `json:"error"`
+}
+
+// PlatformImportQuery holds the query parameters of an import from a
+// trading platform export.
+type PlatformImportQuery struct {
+	Source string  `validate:"required,oneof=ctrader tradingview"`
+	LTF    string  `validate:"required,url"`
+	HTF    string  `validate:"required,url"`
+	MaxRR  float64 `validate:"required,gt=0"`
+	// Asset is optional; it applies to rows without a symbol.
+	Asset types.CurrencyPair
+}
+
+type PlatformImportResponse struct {
+	Source   string                         `json:"source" enums:"ctrader,tradingview"`
+	Imported []*TradingJournalEntryResponse `json:"imported"`
+	Unmapped []UnmappedImportRowResponse    `json:"unmapped"`
+}
+
+type UnmappedImportRowResponse struct {
+	// Line is the 1-based line of the uploaded file the trade starts on.
+	Line  int    `json:"line"`
+	Error string `json:"error"`
+}
+
+type JournalExportDocument struct {
+	Version    int                   `json:"version" validate:"required"`
+	ExportedAt time.Time             `json:"exported_at"`
+	Journal    JournalExportJournal  `json:"journal"`
+	Entries    []*JournalExportEntry `json:"entries" validate:"dive"`
+}
+
+type JournalExportJournal struct {
+	Name               string                  `json:"name" validate:"required,min=1,max=255"`
+	Description        string                  `json:"description" validate:"omitempty,max=1000"`
+	DefaultAsset       *types.CurrencyPair     `json:"default_asset,omitempty" validate:"omitempty"`
+	DefaultSession     *types.TradingSession   `json:"default_session,omitempty" validate:"omitempty"`
+	Tags               []string                `json:"tags" validate:"omitempty,max=20,dive,min=1,max=50"`
+	IsArchived         bool                    `json:"is_archived"`
+	IsLocked           bool                    `json:"is_locked"`
+	RequireNotesOnLoss bool                    `json:"require_notes_on_loss"`
+	ValidationProfile  types.ValidationProfile `json:"validation_profile,omitempty" validate:"omitempty,oneof=lenient standard strict"`
+	Broker             *string                 `json:"broker,omitempty" validate:"omitempty,max=100"`
+	AccountNumber      *string                 `json:"account_number,omitempty" validate:"omitempty,max=50"`
+	PipValue           *float64                `json:"pip_value,omitempty" validate:"omitempty,gt=0"`
+}
+
+type JournalExportEntry struct {
+	Day             time.Time             `json:"day" validate:"required"`
+	Asset           types.CurrencyPair    `json:"asset" validate:"required"`
+	LTF             string                `json:"ltf" validate:"required,url"`
+	HTF             string                `json:"htf" validate:"required,url"`
+	EntryCharts     []string              `json:"entry_charts" validate:"omitempty,max=10,dive,url,weburl"`
+	SetupCharts     []string              `json:"setup_charts" validate:"omitempty,max=10,dive,url,weburl"`
+	Session         types.TradingSession  `json:"session" validate:"required"`
+	TradeType       types.TradeType       `json:"trade_type" validate:"required"`
+	Setup           *string               `json:"setup,omitempty" validate:"omitempty,max=500"`
+	Direction       types.TradeDirection  `json:"direction" validate:"required"`
+	EntryType       types.EntryType       `json:"entry_type" validate:"required"`
+	Realized        float64               `json:"realized"`
+	MaxRR           float64               `json:"max_rr" validate:"gt=0"`
+	Result          types.TradeResult     `json:"result" validate:"required"`
+	Notes           string                `json:"notes" validate:"omitempty,max=5000"`
+	IsPinned        bool                  `json:"is_pinned"`
+	Emotion         *types.Emotion        `json:"emotion,omitempty" validate:"omitempty"`
+	Confidence      *int                  `json:"confidence,omitempty" validate:"omitempty,min=1,max=5"`
+	ReviewStatus    types.ReviewStatus    `json:"review_status,omitempty" validate:"omitempty"`
+	FollowedPlan    *bool                 `json:"followed_plan,omitempty" validate:"omitempty"`
+	RiskPercent     float64               `json:"risk_percent" validate:"gte=0,lte=100"`
+	PositionSize    float64               `json:"position_size" validate:"gte=0"`
+	EntryPrice      *float64              `json:"entry_price,omitempty" validate:"omitempty,gt=0"`
+	StopLossPrice   *float64              `json:"stop_loss_price,omitempty" validate:"omitempty,gt=0"`
+	TakeProfitPrice *float64              `json:"take_profit_price,omitempty" validate:"omitempty,gt=0"`
+	Checklist       []types.ChecklistItem `json:"checklist,omitempty" validate:"omitempty,max=30,unique=Item,dive"`
+}
@@ -0,0 +1,35 @@
+package mapper
+
+import (
+	"github.com/user/normark/internal/dto"
+	"github.com/user/normark/internal/entity"
+)
+
+func ToUserResponse(user *entity.User) *dto.UserResponse {
+	return &dto.UserResponse{
+		ID:        user.ID,
+		Email:     user.Email,
+		Username:  user.Username,
+		Provider:  string(user.Provider),
+		CreatedAt: user.CreatedAt.UTC(),
+	}
+}
+
+func ToSessionResponse(session *entity.Session) *dto.SessionResponse {
+	return &dto.SessionResponse{
+		ID:         session.ID,
+		UserAgent:  session.UserAgent,
+		IPAddress:  session.IPAddress,
+		LastUsedAt: session.LastUsedAt.UTC(),
+		ExpiresAt:  session.ExpiresAt.UTC(),
+		CreatedAt:  session.CreatedAt.UTC(),
+	}
+}
+
+func ToSessionListResponse(sessions []*entity.Session) *dto.SessionListResponse {
+	responses := make([]*dto.SessionResponse, len(sessions))
+	for i, session := range sessions {
+		responses[i] = ToSessionResponse(session)
+	}
+	return &dto.SessionListResponse{Sessions: responses}
+}
@@ -0,0 +1,14 @@
+package mapper
+
+import "time"
+
+// utcPtr is time.Time.UTC for optional timestamps. Mappers return every
+// timestamp in UTC, so responses serialize them the same way whatever
+// offset they were stored or read with.
+func utcPtr(t *time.Time) *time.Time {
+	if t == nil {
+		return nil
+	}
+	utc := t.UTC()
+	return &utc
+}
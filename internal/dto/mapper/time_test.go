@@ -0,0 +1,76 @@
+package mapper
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/user/normark/internal/entity"
+)
+
+func TestUTCPtr(t *testing.T) {
+	local := time.Date(2026, 3, 2, 9, 30, 0, 0, time.FixedZone("CET", 3600))
+
+	if got := utcPtr(nil); got != nil {
+		t.Errorf("utcPtr(nil) = %v, want nil", got)
+	}
+
+	got := utcPtr(&local)
+	if got == nil || !got.Equal(local) || got.Location() != time.UTC {
+		t.Errorf("utcPtr(%v) = %v, want the same instant in UTC", local, got)
+	}
+	if local.Location() == time.UTC {
+		t.Error("utcPtr() changed the caller's time")
+	}
+}
+
+// TestResponsesSerializeTimestampsInUTC checks that timestamps read with a
+// non-UTC offset, as Postgres may return them, reach clients ending in "Z"
+// with their fractional seconds kept.
+func TestResponsesSerializeTimestampsInUTC(t *testing.T) {
+	offset := time.FixedZone("UTC+3", 3*3600)
+	at := time.Date(2026, 3, 2, 12, 30, 0, 123456000, offset)
+	const want = `"2026-03-02T09:30:00.123456Z"`
+
+	entry := &entity.TradingJournalEntry{ID: uuid.New(), Day: at, CreatedAt: at, UpdatedAt: at}
+	deleted := *entry
+	deleted.DeletedAt = at
+	journal := &entity.TradingJournal{ID: uuid.New(), CreatedAt: at, UpdatedAt: at, FirstEntryDate: &at, LastEntryDate: &at}
+	journal.Entries = []*entity.TradingJournalEntry{entry}
+
+	tests := []struct {
+		name       string
+		response   any
+		timestamps int
+	}{
+		{"entry", ToTradingJournalEntryResponse(entry), 3},
+		{"deleted sync entry", ToSyncEntryResponses([]*entity.TradingJournalEntry{&deleted}), 4},
+		{"journal", ToTradingJournalResponse(journal), 4},
+		{"journal with entries", ToTradingJournalWithEntriesResponse(journal), 7},
+		{"summary", ToJournalSummaryResponse(&entity.JournalSummary{ComputedAt: at}), 1},
+		{"export job", ToExportJobResponse(&entity.ExportJob{CreatedAt: at, CompletedAt: &at}, ""), 2},
+		{"export document", ToJournalExportDocument(&entity.TradingJournal{}, at), 1},
+		{"statistics", ToStatisticsResponse(&entity.EntryStatistics{RangeStart: &at, RangeEnd: &at, GeneratedAt: at}), 3},
+		{"note", ToEntryNoteResponse(&entity.EntryNote{CreatedAt: at}), 1},
+		{"exit", ToEntryExitResponse(&entity.EntryExit{ExitedAt: at, CreatedAt: at}), 2},
+		{"user", ToUserResponse(&entity.User{CreatedAt: at}), 1},
+		{"session", ToSessionResponse(&entity.Session{LastUsedAt: at, ExpiresAt: at, CreatedAt: at}), 3},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			body, err := json.Marshal(tt.response)
+			if err != nil {
+				t.Fatalf("marshal response: %v", err)
+			}
+			if got := strings.Count(string(body), want); got != tt.timestamps {
+				t.Errorf("response %s has %d timestamps %s, want %d", body, got, want, tt.timestamps)
+			}
+			if strings.Contains(string(body), "+03:00") {
+				t.Errorf("response %s keeps the read offset", body)
+			}
+		})
+	}
+}
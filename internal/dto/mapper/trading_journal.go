@@ -1,18 +1,48 @@
 package mapper
 
 import (
+	"time"
+
 	"github.com/user/normark/internal/dto"
 	"github.com/user/normark/internal/entity"
 )
 
 func ToTradingJournalResponse(journal *entity.TradingJournal) *dto.TradingJournalResponse {
 	return &dto.TradingJournalResponse{
-		ID:          journal.ID,
-		UserID:      journal.UserID,
-		Name:        journal.Name,
-		Description: journal.Description,
-		CreatedAt:   journal.CreatedAt,
-		UpdatedAt:   journal.UpdatedAt,
+		ID:                 journal.ID,
+		UserID:             journal.UserID,
+		Name:               journal.Name,
+		Description:        journal.Description,
+		IsArchived:         journal.IsArchived,
+		IsLocked:           journal.IsLocked,
+		RequireNotesOnLoss: journal.RequireNotesOnLoss,
+		ValidationProfile:  journal.ValidationProfile,
+		Broker:             journal.Broker,
+		AccountNumber:      journal.AccountNumber,
+		PipValue:           journal.PipValue,
+		DefaultAsset:       journal.DefaultAsset,
+		DefaultSession:     journal.DefaultSession,
+		Tags:               nonNilTags(journal.Tags),
+		FirstEntryDate:     utcPtr(journal.FirstEntryDate),
+		LastEntryDate:      utcPtr(journal.LastEntryDate),
+		CreatedAt:          journal.CreatedAt.UTC(),
+		UpdatedAt:          journal.UpdatedAt.UTC(),
+		Summary:            ToJournalSummaryResponse(journal.Summary),
+	}
+}
+
+func ToJournalSummaryResponse(summary *entity.JournalSummary) *dto.JournalSummaryResponse {
+	if summary == nil {
+		return nil
+	}
+
+	return &dto.JournalSummaryResponse{
+		JournalID:   summary.JournalID,
+		TotalTrades: summary.TotalTrades,
+		Wins:        summary.Wins,
+		WinRate:     summary.WinRate,
+		NetRealized: summary.NetRealized,
+		ComputedAt:  summary.ComputedAt.UTC(),
 	}
 }
 
@@ -33,12 +63,169 @@ func ToTradingJournalWithEntriesResponse(journal *entity.TradingJournal) *dto.Tr
 	}
 
 	return &dto.TradingJournalWithEntriesResponse{
-		ID:          journal.ID,
-		UserID:      journal.UserID,
-		Name:        journal.Name,
-		Description: journal.Description,
-		Entries:     entries,
-		CreatedAt:   journal.CreatedAt,
-		UpdatedAt:   journal.UpdatedAt,
+		ID:                 journal.ID,
+		UserID:             journal.UserID,
+		Name:               journal.Name,
+		Description:        journal.Description,
+		IsArchived:         journal.IsArchived,
+		IsLocked:           journal.IsLocked,
+		RequireNotesOnLoss: journal.RequireNotesOnLoss,
+		ValidationProfile:  journal.ValidationProfile,
+		Broker:             journal.Broker,
+		AccountNumber:      journal.AccountNumber,
+		PipValue:           journal.PipValue,
+		DefaultAsset:       journal.DefaultAsset,
+		DefaultSession:     journal.DefaultSession,
+		Tags:               nonNilTags(journal.Tags),
+		FirstEntryDate:     utcPtr(journal.FirstEntryDate),
+		LastEntryDate:      utcPtr(journal.LastEntryDate),
+		Entries:            entries,
+		CreatedAt:          journal.CreatedAt.UTC(),
+		UpdatedAt:          journal.UpdatedAt.UTC(),
+	}
+}
+
+func ToJournalTemplateResponse(template *entity.JournalTemplate) *dto.JournalTemplateResponse {
+	return &dto.JournalTemplateResponse{
+		ID:             template.ID,
+		UserID:         template.UserID,
+		Name:           template.Name,
+		Description:    template.Description,
+		DefaultAsset:   template.DefaultAsset,
+		DefaultSession: template.DefaultSession,
+		Tags:           nonNilTags(template.Tags),
+		CreatedAt:      template.CreatedAt.UTC(),
+		UpdatedAt:      template.UpdatedAt.UTC(),
+	}
+}
+
+func ToJournalTemplateResponses(templates []*entity.JournalTemplate) []*dto.JournalTemplateResponse {
+	responses := make([]*dto.JournalTemplateResponse, len(templates))
+	for i, template := range templates {
+		responses[i] = ToJournalTemplateResponse(template)
+	}
+	return responses
+}
+
+// nonNilTags keeps tags serialized as [] rather than null.
+func nonNilTags(tags []string) []string {
+	if tags == nil {
+		return []string{}
+	}
+	return tags
+}
+
+// ToExportJobResponse maps a job. downloadURL is only included once the job
+// is ready.
+func ToExportJobResponse(job *entity.ExportJob, downloadURL string) *dto.ExportJobResponse {
+	response := &dto.ExportJobResponse{
+		ID:          job.ID,
+		JournalID:   job.JournalID,
+		Status:      string(job.Status),
+		Error:       job.Error,
+		CreatedAt:   job.CreatedAt.UTC(),
+		CompletedAt: utcPtr(job.CompletedAt),
+	}
+
+	if job.Status == entity.ExportJobStatusReady {
+		response.DownloadURL = downloadURL
+	}
+
+	return response
+}
+
+func ToJournalExportDocument(journal *entity.TradingJournal, exportedAt time.Time) *dto.JournalExportDocument {
+	entries := make([]*dto.JournalExportEntry, 0, len(journal.Entries))
+	for _, entry := range journal.Entries {
+		entries = append(entries, ToJournalExportEntry(entry))
+	}
+
+	return &dto.JournalExportDocument{
+		Version:    dto.JournalExportVersion,
+		ExportedAt: exportedAt.UTC(),
+		Journal:    ToJournalExportJournal(journal),
+		Entries:    entries,
+	}
+}
+
+func ToJournalExportJournal(journal *entity.TradingJournal) dto.JournalExportJournal {
+	return dto.JournalExportJournal{
+		Name:               journal.Name,
+		Description:        journal.Description,
+		DefaultAsset:       journal.DefaultAsset,
+		DefaultSession:     journal.DefaultSession,
+		Tags:               nonNilTags(journal.Tags),
+		IsArchived:         journal.IsArchived,
+		IsLocked:           journal.IsLocked,
+		RequireNotesOnLoss: journal.RequireNotesOnLoss,
+		ValidationProfile:  journal.ValidationProfile,
+		Broker:             journal.Broker,
+		AccountNumber:      journal.AccountNumber,
+		PipValue:           journal.PipValue,
+	}
+}
+
+func ToJournalExportEntry(entry *entity.TradingJournalEntry) *dto.JournalExportEntry {
+	return &dto.JournalExportEntry{
+		Day:             entry.Day.UTC(),
+		Asset:           entry.Asset,
+		LTF:             entry.LTF,
+		HTF:             entry.HTF,
+		EntryCharts:     entry.EntryCharts,
+		SetupCharts:     entry.SetupCharts,
+		Session:         entry.Session,
+		TradeType:       entry.TradeType,
+		Setup:           entry.Setup,
+		Direction:       entry.Direction,
+		EntryType:       entry.EntryType,
+		Realized:        entry.Realized,
+		MaxRR:           entry.MaxRR,
+		Result:          entry.Result,
+		Notes:           entry.Notes,
+		IsPinned:        entry.IsPinned,
+		Emotion:         entry.Emotion,
+		Confidence:      entry.Confidence,
+		ReviewStatus:    entry.ReviewStatus,
+		FollowedPlan:    &entry.FollowedPlan,
+		RiskPercent:     entry.RiskPercent,
+		PositionSize:    entry.PositionSize,
+		EntryPrice:      entry.EntryPrice,
+		StopLossPrice:   entry.StopLossPrice,
+		TakeProfitPrice: entry.TakeProfitPrice,
+		Checklist:       entry.Checklist,
+	}
+}
+
+// ToPlatformImportResponse maps an entry import; lines holds the file line of
+// each row the import was given, by index.
+func ToPlatformImportResponse(source string, result *entity.EntryImport, lines []int) *dto.PlatformImportResponse {
+	unmapped := make([]dto.UnmappedImportRowResponse, 0, len(result.Errors))
+	for _, rowErr := range result.Errors {
+		unmapped = append(unmapped, dto.UnmappedImportRowResponse{
+			Line:  lines[rowErr.Index],
+			Error: rowErr.Err.Error(),
+		})
+	}
+
+	return &dto.PlatformImportResponse{
+		Source:   source,
+		Imported: ToTradingJournalEntryResponses(result.Entries),
+		Unmapped: unmapped,
+	}
+}
+
+func ToImportPreviewResponse(preview *entity.ImportPreview) *dto.ImportPreviewResponse {
+	errs := make([]dto.ImportRowErrorResponse, 0, len(preview.Errors))
+	for _, rowErr := range preview.Errors {
+		errs = append(errs, dto.ImportRowErrorResponse{
+			Index: rowErr.Index,
+			Error: rowErr.Err.Error(),
+		})
+	}
+
+	return &dto.ImportPreviewResponse{
+		JournalName:     preview.JournalName,
+		EntriesToCreate: preview.EntryCount,
+		Errors:          errs,
 	}
 }
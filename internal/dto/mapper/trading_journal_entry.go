@@ -1,30 +1,50 @@
 package mapper
 
 import (
+	"time"
+
+	"github.com/google/uuid"
 	"github.com/user/normark/internal/dto"
 	"github.com/user/normark/internal/entity"
+	"github.com/user/normark/internal/types"
 )
 
 func ToTradingJournalEntryResponse(entry *entity.TradingJournalEntry) *dto.TradingJournalEntryResponse {
 	return &dto.TradingJournalEntryResponse{
-		ID:          entry.ID,
-		JournalID:   entry.JournalID,
-		Day:         entry.Day,
-		Asset:       entry.Asset,
-		LTF:         entry.LTF,
-		HTF:         entry.HTF,
-		EntryCharts: entry.EntryCharts,
-		Session:     entry.Session,
-		TradeType:   entry.TradeType,
-		Setup:       entry.Setup,
-		Direction:   entry.Direction,
-		EntryType:   entry.EntryType,
-		Realized:    entry.Realized,
-		MaxRR:       entry.MaxRR,
-		Result:      entry.Result,
-		Notes:       entry.Notes,
-		CreatedAt:   entry.CreatedAt,
-		UpdatedAt:   entry.UpdatedAt,
+		ID:              entry.ID,
+		JournalID:       entry.JournalID,
+		Day:             entry.Day.UTC(),
+		Asset:           entry.Asset,
+		LTF:             entry.LTF,
+		HTF:             entry.HTF,
+		EntryCharts:     entry.EntryCharts,
+		SetupCharts:     entry.SetupCharts,
+		Session:         entry.Session,
+		TradeType:       entry.TradeType,
+		Setup:           entry.Setup,
+		Direction:       entry.Direction,
+		EntryType:       entry.EntryType,
+		Realized:        entry.Realized,
+		RealizedPips:    entry.RealizedPips,
+		MaxRR:           entry.MaxRR,
+		Result:          entry.Result,
+		Notes:           entry.Notes,
+		IsPinned:        entry.IsPinned,
+		Emotion:         entry.Emotion,
+		Confidence:      entry.Confidence,
+		ReviewStatus:    entry.ReviewStatus,
+		Grade:           entry.Grade,
+		FollowedPlan:    entry.FollowedPlan,
+		RiskPercent:     entry.RiskPercent,
+		PositionSize:    entry.PositionSize,
+		EntryPrice:      entry.EntryPrice,
+		StopLossPrice:   entry.StopLossPrice,
+		TakeProfitPrice: entry.TakeProfitPrice,
+		PlannedRR:       entry.PlannedRR(),
+		Checklist:       entry.Checklist,
+		RelatedEntryIDs: entry.RelatedEntryIDs,
+		CreatedAt:       entry.CreatedAt.UTC(),
+		UpdatedAt:       entry.UpdatedAt.UTC(),
 	}
 }
 
@@ -36,30 +56,354 @@ func ToTradingJournalEntryResponses(entries []*entity.TradingJournalEntry) []*dt
 	return responses
 }
 
-func ToStatisticsResponse(stats map[string]any) *dto.TradingJournalStatisticsResponse {
-	response := &dto.TradingJournalStatisticsResponse{}
+func ToTransferEntriesResponse(targetJournalID uuid.UUID, copied bool, entries []*entity.TradingJournalEntry) *dto.TransferEntriesResponse {
+	return &dto.TransferEntriesResponse{
+		TargetJournalID: targetJournalID,
+		Copied:          copied,
+		Entries:         ToTradingJournalEntryResponses(entries),
+	}
+}
+
+func ToStatisticsResponse(stats *entity.EntryStatistics) *dto.TradingJournalStatisticsResponse {
+	return &dto.TradingJournalStatisticsResponse{
+		TotalTrades:       stats.TotalTrades,
+		Wins:              stats.Wins,
+		Losses:            stats.Losses,
+		BreakEven:         stats.BreakEven,
+		WinRate:           stats.WinRate,
+		TotalRealized:     stats.TotalRealized,
+		AvgRiskReward:     stats.AvgRiskReward,
+		AvgRiskPercent:    stats.AvgRiskPercent,
+		AvgWin:            stats.AvgWin,
+		AvgLoss:           stats.AvgLoss,
+		AvgPlannedRR:      stats.AvgPlannedRR,
+		AvgAchievedRR:     stats.AvgAchievedRR,
+		RRDifference:      stats.RRDifference,
+		KellyFraction:     stats.KellyFraction,
+		RiskOfRuin:        stats.RiskOfRuin,
+		TotalRealizedPips: stats.TotalRealizedPips,
+		AvgWinPips:        stats.AvgWinPips,
+		AvgLossPips:       stats.AvgLossPips,
+		RangeStart:        utcPtr(stats.RangeStart),
+		RangeEnd:          utcPtr(stats.RangeEnd),
+		GeneratedAt:       stats.GeneratedAt.UTC(),
+	}
+}
+
+func ToStatisticsComparisonResponse(comparison *entity.PeriodComparison) *dto.StatisticsComparisonResponse {
+	delta := comparison.Delta
+	return &dto.StatisticsComparisonResponse{
+		PeriodA: &dto.PeriodStatisticsResponse{
+			Start:      comparison.PeriodA.Start.UTC(),
+			End:        comparison.PeriodA.End.UTC(),
+			Statistics: ToStatisticsResponse(comparison.StatisticsA),
+		},
+		PeriodB: &dto.PeriodStatisticsResponse{
+			Start:      comparison.PeriodB.Start.UTC(),
+			End:        comparison.PeriodB.End.UTC(),
+			Statistics: ToStatisticsResponse(comparison.StatisticsB),
+		},
+		Delta: dto.StatisticsDeltaResponse{
+			TotalTrades:   delta.TotalTrades,
+			Wins:          delta.Wins,
+			Losses:        delta.Losses,
+			BreakEven:     delta.BreakEven,
+			WinRate:       delta.WinRate,
+			TotalRealized: delta.TotalRealized,
+			AvgRiskReward: delta.AvgRiskReward,
+			AvgWin:        delta.AvgWin,
+			AvgLoss:       delta.AvgLoss,
+			AvgPlannedRR:  delta.AvgPlannedRR,
+			AvgAchievedRR: delta.AvgAchievedRR,
+			RRDifference:  delta.RRDifference,
+			KellyFraction: delta.KellyFraction,
+			RiskOfRuin:    delta.RiskOfRuin,
+		},
+	}
+}
+
+func ToEmotionStatisticsResponses(stats []*entity.EmotionStatistics) *dto.EmotionStatisticsListResponse {
+	responses := make([]*dto.EmotionStatisticsResponse, len(stats))
+	for i, stat := range stats {
+		responses[i] = &dto.EmotionStatisticsResponse{
+			Emotion:       stat.Emotion,
+			TotalTrades:   stat.TotalTrades,
+			Wins:          stat.Wins,
+			Losses:        stat.Losses,
+			BreakEven:     stat.BreakEven,
+			WinRate:       stat.WinRate,
+			TotalRealized: stat.TotalRealized,
+		}
+	}
+
+	return &dto.EmotionStatisticsListResponse{Emotions: responses}
+}
+
+func ToCategoryStatisticsResponses(stats []*entity.CategoryStatistics) *dto.CategoryStatisticsListResponse {
+	responses := make([]*dto.CategoryStatisticsResponse, len(stats))
+	for i, stat := range stats {
+		responses[i] = &dto.CategoryStatisticsResponse{
+			Category:      stat.Category,
+			TotalTrades:   stat.TotalTrades,
+			Wins:          stat.Wins,
+			Losses:        stat.Losses,
+			BreakEven:     stat.BreakEven,
+			WinRate:       stat.WinRate,
+			TotalRealized: stat.TotalRealized,
+		}
+	}
+
+	return &dto.CategoryStatisticsListResponse{Categories: responses}
+}
+
+func ToGradeStatisticsResponses(stats []*entity.GradeStatistics) *dto.GradeStatisticsListResponse {
+	responses := make([]*dto.GradeStatisticsResponse, len(stats))
+	for i, stat := range stats {
+		responses[i] = &dto.GradeStatisticsResponse{
+			Grade:         stat.Grade,
+			TotalTrades:   stat.TotalTrades,
+			Wins:          stat.Wins,
+			Losses:        stat.Losses,
+			BreakEven:     stat.BreakEven,
+			WinRate:       stat.WinRate,
+			TotalRealized: stat.TotalRealized,
+		}
+	}
+
+	return &dto.GradeStatisticsListResponse{Grades: responses}
+}
+
+func ToConfidenceStatisticsResponses(stats []*entity.ConfidenceStatistics) *dto.ConfidenceStatisticsListResponse {
+	responses := make([]*dto.ConfidenceStatisticsResponse, len(stats))
+	for i, stat := range stats {
+		responses[i] = &dto.ConfidenceStatisticsResponse{
+			Confidence:    stat.Confidence,
+			TotalTrades:   stat.TotalTrades,
+			Wins:          stat.Wins,
+			Losses:        stat.Losses,
+			BreakEven:     stat.BreakEven,
+			WinRate:       stat.WinRate,
+			TotalRealized: stat.TotalRealized,
+		}
+	}
+
+	return &dto.ConfidenceStatisticsListResponse{Levels: responses}
+}
+
+func ToChecklistAdherenceResponse(stats []*entity.ChecklistItemStatistics) *dto.ChecklistAdherenceResponse {
+	responses := make([]*dto.ChecklistItemStatisticsResponse, len(stats))
+	for i, stat := range stats {
+		responses[i] = &dto.ChecklistItemStatisticsResponse{
+			Item:              stat.Item,
+			TotalTrades:       stat.TotalTrades,
+			Checked:           stat.Checked,
+			AdherenceRate:     stat.AdherenceRate,
+			CheckedWinRate:    stat.CheckedWinRate,
+			UncheckedWinRate:  stat.UncheckedWinRate,
+			WinRateDifference: stat.CheckedWinRate - stat.UncheckedWinRate,
+		}
+	}
+
+	return &dto.ChecklistAdherenceResponse{Items: responses}
+}
+
+func ToAdherenceStatisticsResponse(stats *entity.AdherenceStatistics) *dto.AdherenceStatisticsResponse {
+	return &dto.AdherenceStatisticsResponse{
+		InPlan:             toPlanAdherenceBucketResponse(stats.InPlan),
+		OutOfPlan:          toPlanAdherenceBucketResponse(stats.OutOfPlan),
+		RealizedDifference: stats.InPlan.TotalRealized - stats.OutOfPlan.TotalRealized,
+	}
+}
+
+func toPlanAdherenceBucketResponse(stat *entity.PlanAdherenceStatistics) *dto.PlanAdherenceBucketResponse {
+	return &dto.PlanAdherenceBucketResponse{
+		TotalTrades:   stat.TotalTrades,
+		Wins:          stat.Wins,
+		Losses:        stat.Losses,
+		BreakEven:     stat.BreakEven,
+		WinRate:       stat.WinRate,
+		TotalRealized: stat.TotalRealized,
+	}
+}
+
+func ToEntryFacetsResponse(facets *entity.EntryFacets) *dto.EntryFacetsResponse {
+	return &dto.EntryFacetsResponse{
+		Assets:     toFacetValueResponses(facets.Assets),
+		Sessions:   toFacetValueResponses(facets.Sessions),
+		Results:    toFacetValueResponses(facets.Results),
+		TradeTypes: toFacetValueResponses(facets.TradeTypes),
+	}
+}
+
+func toFacetValueResponses(values []*entity.FacetValue) []*dto.FacetValueResponse {
+	responses := make([]*dto.FacetValueResponse, len(values))
+	for i, value := range values {
+		responses[i] = &dto.FacetValueResponse{
+			Value: value.Value,
+			Count: value.Count,
+		}
+	}
+	return responses
+}
+
+func ToUserStatisticsResponse(stats *entity.UserStatistics) *dto.UserStatisticsResponse {
+	journals := make([]*dto.JournalStatisticsSummaryResponse, len(stats.Journals))
+	for i, journal := range stats.Journals {
+		journals[i] = &dto.JournalStatisticsSummaryResponse{
+			JournalID:     journal.JournalID,
+			JournalName:   journal.JournalName,
+			TotalTrades:   journal.TotalTrades,
+			Wins:          journal.Wins,
+			Losses:        journal.Losses,
+			BreakEven:     journal.BreakEven,
+			WinRate:       journal.WinRate,
+			TotalRealized: journal.TotalRealized,
+		}
+	}
+
+	return &dto.UserStatisticsResponse{
+		TotalJournals: stats.TotalJournals,
+		TotalTrades:   stats.TotalTrades,
+		Wins:          stats.Wins,
+		Losses:        stats.Losses,
+		BreakEven:     stats.BreakEven,
+		WinRate:       stats.WinRate,
+		TotalRealized: stats.TotalRealized,
+		Journals:      journals,
+	}
+}
 
-	if v, ok := stats["total_trades"].(int); ok {
-		response.TotalTrades = v
+func ToEntryTemplateResponse(template *entity.EntryTemplate) *dto.EntryTemplateResponse {
+	return &dto.EntryTemplateResponse{
+		ID:        template.ID,
+		JournalID: template.JournalID,
+		Name:      template.Name,
+		Asset:     template.Asset,
+		Session:   template.Session,
+		TradeType: template.TradeType,
+		Direction: template.Direction,
+		MaxRR:     template.MaxRR,
+		CreatedAt: template.CreatedAt.UTC(),
+		UpdatedAt: template.UpdatedAt.UTC(),
 	}
-	if v, ok := stats["wins"].(int); ok {
-		response.Wins = v
+}
+
+func ToEntryTemplateResponses(templates []*entity.EntryTemplate) []*dto.EntryTemplateResponse {
+	responses := make([]*dto.EntryTemplateResponse, len(templates))
+	for i, template := range templates {
+		responses[i] = ToEntryTemplateResponse(template)
 	}
-	if v, ok := stats["losses"].(int); ok {
-		response.Losses = v
+	return responses
+}
+
+func ToEntryNoteResponse(note *entity.EntryNote) *dto.EntryNoteResponse {
+	return &dto.EntryNoteResponse{
+		ID:        note.ID,
+		EntryID:   note.EntryID,
+		Body:      note.Body,
+		CreatedAt: note.CreatedAt.UTC(),
 	}
-	if v, ok := stats["break_even"].(int); ok {
-		response.BreakEven = v
+}
+
+func ToEntryNoteResponses(notes []*entity.EntryNote) []*dto.EntryNoteResponse {
+	responses := make([]*dto.EntryNoteResponse, len(notes))
+	for i, note := range notes {
+		responses[i] = ToEntryNoteResponse(note)
 	}
-	if v, ok := stats["win_rate"].(float64); ok {
-		response.WinRate = v
+	return responses
+}
+
+func ToEntryExitResponse(exit *entity.EntryExit) *dto.EntryExitResponse {
+	return &dto.EntryExitResponse{
+		ID:        exit.ID,
+		EntryID:   exit.EntryID,
+		Size:      exit.Size,
+		Price:     exit.Price,
+		Realized:  exit.Realized,
+		ExitedAt:  exit.ExitedAt.UTC(),
+		CreatedAt: exit.CreatedAt.UTC(),
 	}
-	if v, ok := stats["total_realized"].(float64); ok {
-		response.TotalRealized = v
+}
+
+func ToEntryExitListResponse(exits []*entity.EntryExit) *dto.EntryExitListResponse {
+	responses := make([]*dto.EntryExitResponse, len(exits))
+	var totalRealized types.Cents
+	for i, exit := range exits {
+		responses[i] = ToEntryExitResponse(exit)
+		totalRealized += types.CentsFromFloat(exit.Realized)
 	}
-	if v, ok := stats["avg_risk_reward"].(float64); ok {
-		response.AvgRiskReward = v
+	return &dto.EntryExitListResponse{
+		Exits:         responses,
+		Total:         len(exits),
+		TotalRealized: totalRealized.Float64(),
+	}
+}
+
+func ToReviewProgressResponse(progress *entity.ReviewProgress) *dto.ReviewProgressResponse {
+	return &dto.ReviewProgressResponse{
+		Total:      progress.Total,
+		Unreviewed: progress.Unreviewed,
+		Reviewed:   progress.Reviewed,
+		Flagged:    progress.Flagged,
+	}
+}
+
+func ToAssetCorrelationResponse(correlation *entity.AssetCorrelation) *dto.AssetCorrelationResponse {
+	assets := make([]*dto.AssetStatisticsResponse, len(correlation.Assets))
+	for i, asset := range correlation.Assets {
+		assets[i] = &dto.AssetStatisticsResponse{
+			Asset:       asset.Asset,
+			TotalTrades: asset.TotalTrades,
+			Wins:        asset.Wins,
+			WinRate:     asset.WinRate,
+		}
+	}
+
+	pairs := make([]*dto.AssetPairStatisticsResponse, len(correlation.Pairs))
+	for i, pair := range correlation.Pairs {
+		pairs[i] = &dto.AssetPairStatisticsResponse{
+			AssetA:      pair.AssetA,
+			AssetB:      pair.AssetB,
+			SharedDays:  pair.SharedDays,
+			TotalTrades: pair.TotalTrades,
+			Wins:        pair.Wins,
+			WinRate:     pair.WinRate,
+		}
 	}
 
-	return response
+	return &dto.AssetCorrelationResponse{
+		Assets: assets,
+		Pairs:  pairs,
+	}
+}
+
+func ToCalendarResponse(year int, stats []*entity.DailyStatistics) *dto.CalendarResponse {
+	days := make(map[string]*dto.CalendarDayResponse, len(stats))
+	for _, stat := range stats {
+		days[stat.Day.Format(time.DateOnly)] = &dto.CalendarDayResponse{
+			Count:       stat.Count,
+			NetRealized: stat.NetRealized,
+		}
+	}
+
+	return &dto.CalendarResponse{
+		Year: year,
+		Days: days,
+	}
+}
+
+func ToSyncEntryResponses(entries []*entity.TradingJournalEntry) []*dto.SyncEntryResponse {
+	responses := make([]*dto.SyncEntryResponse, len(entries))
+	for i, entry := range entries {
+		response := &dto.SyncEntryResponse{
+			TradingJournalEntryResponse: *ToTradingJournalEntryResponse(entry),
+			Deleted:                     !entry.DeletedAt.IsZero(),
+		}
+		if response.Deleted {
+			deletedAt := entry.DeletedAt.UTC()
+			response.DeletedAt = &deletedAt
+		}
+		responses[i] = response
+	}
+	return responses
 }
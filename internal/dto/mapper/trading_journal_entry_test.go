@@ -0,0 +1,351 @@
+package mapper
+
+import (
+	"encoding/json"
+	"math"
+	"slices"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/user/normark/internal/entity"
+	"github.com/user/normark/internal/types"
+)
+
+func TestToStatisticsComparisonResponse(t *testing.T) {
+	berlin := time.FixedZone("CET", 3600)
+	comparison := &entity.PeriodComparison{
+		PeriodA:     entity.Period{Start: time.Date(2026, 1, 1, 0, 0, 0, 0, berlin), End: time.Date(2026, 1, 31, 0, 0, 0, 0, berlin)},
+		PeriodB:     entity.Period{Start: time.Date(2026, 2, 1, 0, 0, 0, 0, berlin), End: time.Date(2026, 2, 28, 0, 0, 0, 0, berlin)},
+		StatisticsA: &entity.EntryStatistics{},
+		StatisticsB: &entity.EntryStatistics{},
+		Delta: entity.StatisticsDelta{
+			AvgPlannedRR:  0.25,
+			AvgAchievedRR: 0.5,
+			RRDifference:  0.25,
+			KellyFraction: 0.1,
+			RiskOfRuin:    -0.2,
+		},
+	}
+
+	response := ToStatisticsComparisonResponse(comparison)
+
+	delta := response.Delta
+	if delta.AvgPlannedRR != 0.25 || delta.AvgAchievedRR != 0.5 || delta.RRDifference != 0.25 ||
+		delta.KellyFraction != 0.1 || delta.RiskOfRuin != -0.2 {
+		t.Errorf("delta = %+v, want the entity delta's values", delta)
+	}
+
+	if response.PeriodA.Start.Location() != time.UTC || response.PeriodB.End.Location() != time.UTC {
+		t.Errorf("periods are not in UTC: %v, %v", response.PeriodA.Start, response.PeriodB.End)
+	}
+	if !response.PeriodA.Start.Equal(comparison.PeriodA.Start) {
+		t.Errorf("period A start = %v, want %v", response.PeriodA.Start, comparison.PeriodA.Start)
+	}
+}
+
+func TestToTradingJournalEntryResponsePrices(t *testing.T) {
+	entryPrice, sl, tp := 1.1000, 1.0950, 1.1150
+
+	tests := []struct {
+		name          string
+		entry         *entity.TradingJournalEntry
+		wantPlannedRR bool
+	}{
+		{"prices recorded", &entity.TradingJournalEntry{EntryPrice: &entryPrice, StopLossPrice: &sl, TakeProfitPrice: &tp}, true},
+		{"prices missing", &entity.TradingJournalEntry{EntryPrice: &entryPrice}, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			response := ToTradingJournalEntryResponse(tt.entry)
+
+			if response.EntryPrice != tt.entry.EntryPrice || response.StopLossPrice != tt.entry.StopLossPrice ||
+				response.TakeProfitPrice != tt.entry.TakeProfitPrice {
+				t.Errorf("prices = %v/%v/%v, want the entity's", response.EntryPrice, response.StopLossPrice, response.TakeProfitPrice)
+			}
+			if (response.PlannedRR != nil) != tt.wantPlannedRR {
+				t.Fatalf("planned rr = %v, want set %v", response.PlannedRR, tt.wantPlannedRR)
+			}
+			if response.PlannedRR != nil && math.Abs(*response.PlannedRR-3) > 1e-9 {
+				t.Errorf("planned rr = %v, want 3", *response.PlannedRR)
+			}
+		})
+	}
+}
+
+func TestEntryMappingsKeepChartsApart(t *testing.T) {
+	entry := &entity.TradingJournalEntry{
+		EntryCharts: []string{"https://charts.example.com/fill.png"},
+		SetupCharts: []string{"https://charts.example.com/h4-bias.png", "https://charts.example.com/m15-zone.png"},
+	}
+
+	response := ToTradingJournalEntryResponse(entry)
+	if !slices.Equal(response.EntryCharts, entry.EntryCharts) || !slices.Equal(response.SetupCharts, entry.SetupCharts) {
+		t.Errorf("response charts = %v / %v, want %v / %v", response.EntryCharts, response.SetupCharts, entry.EntryCharts, entry.SetupCharts)
+	}
+
+	exported := ToJournalExportEntry(entry)
+	if !slices.Equal(exported.EntryCharts, entry.EntryCharts) || !slices.Equal(exported.SetupCharts, entry.SetupCharts) {
+		t.Errorf("export charts = %v / %v, want %v / %v", exported.EntryCharts, exported.SetupCharts, entry.EntryCharts, entry.SetupCharts)
+	}
+}
+
+func TestToStatisticsResponseRiskMetrics(t *testing.T) {
+	response := ToStatisticsResponse(&entity.EntryStatistics{KellyFraction: 0.4, RiskOfRuin: 0.0125})
+
+	if response.KellyFraction != 0.4 || response.RiskOfRuin != 0.0125 {
+		t.Errorf("kelly fraction = %v, risk of ruin = %v, want 0.4, 0.0125", response.KellyFraction, response.RiskOfRuin)
+	}
+}
+
+func TestToStatisticsResponse(t *testing.T) {
+	rangeStart := time.Date(2026, 1, 5, 9, 0, 0, 0, time.FixedZone("CET", 3600))
+	stats := &entity.EntryStatistics{
+		TotalTrades:    8,
+		Wins:           4,
+		Losses:         3,
+		BreakEven:      1,
+		WinRate:        50,
+		TotalRealized:  500,
+		AvgRiskReward:  2.5,
+		AvgRiskPercent: 1,
+		AvgWin:         200,
+		AvgLoss:        100,
+		AvgPlannedRR:   2,
+		AvgAchievedRR:  1.5,
+		RRDifference:   -0.5,
+		RangeStart:     &rangeStart,
+	}
+
+	response := ToStatisticsResponse(stats)
+
+	tests := []struct {
+		name string
+		got  float64
+		want float64
+	}{
+		{"total trades", float64(response.TotalTrades), 8},
+		{"wins", float64(response.Wins), 4},
+		{"losses", float64(response.Losses), 3},
+		{"break even", float64(response.BreakEven), 1},
+		{"win rate", response.WinRate, 50},
+		{"total realized", response.TotalRealized, 500},
+		{"avg risk reward", response.AvgRiskReward, 2.5},
+		{"avg risk percent", response.AvgRiskPercent, 1},
+		{"avg win", response.AvgWin, 200},
+		{"avg loss", response.AvgLoss, 100},
+		{"avg planned rr", response.AvgPlannedRR, 2},
+		{"avg achieved rr", response.AvgAchievedRR, 1.5},
+		{"rr difference", response.RRDifference, -0.5},
+	}
+
+	for _, tt := range tests {
+		if tt.got != tt.want {
+			t.Errorf("%s = %v, want %v", tt.name, tt.got, tt.want)
+		}
+	}
+
+	if response.RangeStart == nil || !response.RangeStart.Equal(rangeStart) || response.RangeStart.Location() != time.UTC {
+		t.Errorf("range start = %v, want %v in UTC", response.RangeStart, rangeStart)
+	}
+	if response.RangeEnd != nil {
+		t.Errorf("range end = %v, want nil when unset", response.RangeEnd)
+	}
+}
+
+func TestToAdherenceStatisticsResponse(t *testing.T) {
+	response := ToAdherenceStatisticsResponse(&entity.AdherenceStatistics{
+		InPlan:    &entity.PlanAdherenceStatistics{FollowedPlan: true, TotalTrades: 10, Wins: 6, Losses: 3, BreakEven: 1, TotalRealized: 500, WinRate: 60},
+		OutOfPlan: &entity.PlanAdherenceStatistics{TotalTrades: 4, Wins: 1, Losses: 3, TotalRealized: -300, WinRate: 25},
+	})
+
+	if response.InPlan.TotalTrades != 10 || response.InPlan.WinRate != 60 || response.InPlan.TotalRealized != 500 {
+		t.Errorf("in plan = %+v, want the in-plan bucket", response.InPlan)
+	}
+	if response.OutOfPlan.TotalTrades != 4 || response.OutOfPlan.WinRate != 25 || response.OutOfPlan.TotalRealized != -300 {
+		t.Errorf("out of plan = %+v, want the out-of-plan bucket", response.OutOfPlan)
+	}
+	if response.RealizedDifference != 800 {
+		t.Errorf("realized difference = %v, want 800", response.RealizedDifference)
+	}
+}
+
+func TestToEntryFacetsResponse(t *testing.T) {
+	response := ToEntryFacetsResponse(&entity.EntryFacets{
+		Assets:   []*entity.FacetValue{{Value: "EURUSD", Count: 7}, {Value: "GBPUSD", Count: 2}},
+		Sessions: []*entity.FacetValue{{Value: "london", Count: 9}},
+	})
+
+	if len(response.Assets) != 2 || response.Assets[0].Value != "EURUSD" || response.Assets[0].Count != 7 ||
+		response.Assets[1].Value != "GBPUSD" || response.Assets[1].Count != 2 {
+		t.Errorf("assets = %+v, want EURUSD:7, GBPUSD:2 in order", response.Assets)
+	}
+	if len(response.Sessions) != 1 || response.Sessions[0].Value != "london" || response.Sessions[0].Count != 9 {
+		t.Errorf("sessions = %+v, want london:9", response.Sessions)
+	}
+	if response.Results == nil || len(response.Results) != 0 || response.TradeTypes == nil || len(response.TradeTypes) != 0 {
+		t.Errorf("unused facets = %v, %v, want empty lists", response.Results, response.TradeTypes)
+	}
+}
+
+func TestToConfidenceStatisticsResponses(t *testing.T) {
+	response := ToConfidenceStatisticsResponses([]*entity.ConfidenceStatistics{
+		{Confidence: 2, TotalTrades: 4, Wins: 1, Losses: 3, TotalRealized: -200, WinRate: 25},
+		{Confidence: 5, TotalTrades: 8, Wins: 6, Losses: 1, BreakEven: 1, TotalRealized: 900, WinRate: 75},
+	})
+
+	if len(response.Levels) != 2 {
+		t.Fatalf("got %d levels, want 2", len(response.Levels))
+	}
+	low, high := response.Levels[0], response.Levels[1]
+	if low.Confidence != 2 || low.TotalTrades != 4 || low.Wins != 1 || low.Losses != 3 || low.WinRate != 25 || low.TotalRealized != -200 {
+		t.Errorf("level 2 = %+v, want the confidence 2 row", low)
+	}
+	if high.Confidence != 5 || high.BreakEven != 1 || high.WinRate != 75 || high.TotalRealized != 900 {
+		t.Errorf("level 5 = %+v, want the confidence 5 row", high)
+	}
+
+	if empty := ToConfidenceStatisticsResponses(nil); empty.Levels == nil {
+		t.Error("levels = nil for a journal without rated entries, want an empty list")
+	}
+}
+
+func TestToEntryExitListResponseTotalsExactly(t *testing.T) {
+	tests := []struct {
+		name     string
+		realized []float64
+		want     float64
+	}{
+		{"no exits", nil, 0},
+		{"mixed", []float64{120.10, -20.20, 0.05}, 99.95},
+		{"many small exits", repeatAmount(0.10, 1000), 100},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			exits := make([]*entity.EntryExit, len(tt.realized))
+			for i, realized := range tt.realized {
+				exits[i] = &entity.EntryExit{Realized: realized}
+			}
+
+			response := ToEntryExitListResponse(exits)
+			if response.TotalRealized != tt.want {
+				t.Errorf("total realized = %v, want exactly %v", response.TotalRealized, tt.want)
+			}
+			if response.Total != len(exits) {
+				t.Errorf("total = %d, want %d", response.Total, len(exits))
+			}
+		})
+	}
+}
+
+func repeatAmount(amount float64, n int) []float64 {
+	amounts := make([]float64, n)
+	for i := range amounts {
+		amounts[i] = amount
+	}
+	return amounts
+}
+
+func TestToChecklistAdherenceResponse(t *testing.T) {
+	response := ToChecklistAdherenceResponse([]*entity.ChecklistItemStatistics{
+		{Item: "HTF bias", TotalTrades: 10, Checked: 8, AdherenceRate: 80, CheckedWinRate: 75, UncheckedWinRate: 50},
+		{Item: "News checked", TotalTrades: 5, UncheckedWinRate: 20},
+	})
+
+	if len(response.Items) != 2 || response.Items[0].Item != "HTF bias" || response.Items[1].Item != "News checked" {
+		t.Fatalf("items = %+v, want both items in order", response.Items)
+	}
+	if got := response.Items[0]; got.Checked != 8 || got.AdherenceRate != 80 || got.WinRateDifference != 25 {
+		t.Errorf("first item = %+v, want 8 checked, 80%% adherence and a 25 point win rate difference", got)
+	}
+	if got := response.Items[1].WinRateDifference; got != -20 {
+		t.Errorf("second item win rate difference = %v, want -20", got)
+	}
+
+	if empty := ToChecklistAdherenceResponse(nil); empty.Items == nil {
+		t.Error("items of an empty journal = null, want []")
+	}
+}
+
+func TestEntryMappingsCarryChecklist(t *testing.T) {
+	entry := &entity.TradingJournalEntry{
+		Checklist: []types.ChecklistItem{{Item: "HTF bias", Checked: true}, {Item: "News checked"}},
+	}
+
+	if response := ToTradingJournalEntryResponse(entry); !slices.Equal(response.Checklist, entry.Checklist) {
+		t.Errorf("response checklist = %+v, want %+v", response.Checklist, entry.Checklist)
+	}
+	if exported := ToJournalExportEntry(entry); !slices.Equal(exported.Checklist, entry.Checklist) {
+		t.Errorf("export checklist = %+v, want %+v", exported.Checklist, entry.Checklist)
+	}
+}
+
+func TestPipFieldsOnlyWithPipValue(t *testing.T) {
+	pips := func(f float64) *float64 { return &f }
+	journal := entity.NewTradingJournal(uuid.New(), "Pips", "")
+	journal.PipValue = pips(10)
+
+	tests := []struct {
+		name     string
+		response any
+		want     []string
+		dontWant []string
+	}{
+		{
+			name:     "journal with pip value",
+			response: ToTradingJournalResponse(journal),
+			want:     []string{`"pip_value":10`},
+		},
+		{
+			name:     "journal without pip value",
+			response: ToTradingJournalResponse(entity.NewTradingJournal(uuid.New(), "Money", "")),
+			dontWant: []string{"pip_value"},
+		},
+		{
+			name:     "entry with realized pips",
+			response: ToTradingJournalEntryResponse(&entity.TradingJournalEntry{Realized: 150, RealizedPips: pips(15)}),
+			want:     []string{`"realized_pips":15`},
+		},
+		{
+			name:     "entry without realized pips",
+			response: ToTradingJournalEntryResponse(&entity.TradingJournalEntry{Realized: 150}),
+			dontWant: []string{"realized_pips"},
+		},
+		{
+			name: "statistics in pips",
+			response: ToStatisticsResponse(&entity.EntryStatistics{
+				TotalRealized: 500, TotalRealizedPips: pips(50),
+				AvgWin: 200, AvgWinPips: pips(20),
+				AvgLoss: 100, AvgLossPips: pips(10),
+			}),
+			want: []string{`"total_realized_pips":50`, `"avg_win_pips":20`, `"avg_loss_pips":10`},
+		},
+		{
+			name:     "statistics without pip value",
+			response: ToStatisticsResponse(&entity.EntryStatistics{TotalRealized: 500}),
+			dontWant: []string{"_pips"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			body, err := json.Marshal(tt.response)
+			if err != nil {
+				t.Fatalf("marshal response: %v", err)
+			}
+			for _, want := range tt.want {
+				if !strings.Contains(string(body), want) {
+					t.Errorf("response %s does not contain %s", body, want)
+				}
+			}
+			for _, field := range tt.dontWant {
+				if strings.Contains(string(body), field) {
+					t.Errorf("response %s carries %s", body, field)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,162 @@
+package mapper
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/user/normark/internal/entity"
+)
+
+func TestToTradingJournalResponseEntrySpan(t *testing.T) {
+	first := time.Date(2026, 1, 5, 0, 0, 0, 0, time.UTC)
+	last := time.Date(2026, 3, 20, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name      string
+		first     *time.Time
+		last      *time.Time
+		wantFirst *time.Time
+		wantLast  *time.Time
+	}{
+		{"no entries", nil, nil, nil, nil},
+		{"with entries", &first, &last, &first, &last},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			journal := entity.NewTradingJournal(uuid.New(), "Swing", "")
+			journal.FirstEntryDate = tt.first
+			journal.LastEntryDate = tt.last
+
+			response := ToTradingJournalResponse(journal)
+			if !equalTime(response.FirstEntryDate, tt.wantFirst) || !equalTime(response.LastEntryDate, tt.wantLast) {
+				t.Errorf("journal span = %v..%v, want %v..%v", response.FirstEntryDate, response.LastEntryDate, tt.wantFirst, tt.wantLast)
+			}
+
+			withEntries := ToTradingJournalWithEntriesResponse(journal)
+			if !equalTime(withEntries.FirstEntryDate, tt.wantFirst) || !equalTime(withEntries.LastEntryDate, tt.wantLast) {
+				t.Errorf("journal with entries span = %v..%v, want %v..%v", withEntries.FirstEntryDate, withEntries.LastEntryDate, tt.wantFirst, tt.wantLast)
+			}
+		})
+	}
+}
+
+func TestJournalMappingsCarryLockedFlag(t *testing.T) {
+	for _, locked := range []bool{false, true} {
+		journal := entity.NewTradingJournal(uuid.New(), "Challenge", "")
+		journal.IsLocked = locked
+
+		if got := ToTradingJournalResponse(journal).IsLocked; got != locked {
+			t.Errorf("response is_locked = %v, want %v", got, locked)
+		}
+		if got := ToTradingJournalWithEntriesResponse(journal).IsLocked; got != locked {
+			t.Errorf("with entries is_locked = %v, want %v", got, locked)
+		}
+		if got := ToJournalExportJournal(journal).IsLocked; got != locked {
+			t.Errorf("export is_locked = %v, want %v", got, locked)
+		}
+	}
+}
+
+func TestJournalMappingsCarryBroker(t *testing.T) {
+	journal := entity.NewTradingJournal(uuid.New(), "Funded", "")
+	broker, account := "FTMO", "1051234"
+	journal.Broker = &broker
+	journal.AccountNumber = &account
+
+	response := ToTradingJournalResponse(journal)
+	if *response.Broker != "FTMO" || *response.AccountNumber != "1051234" {
+		t.Errorf("response broker = %q account = %q, want FTMO and 1051234", *response.Broker, *response.AccountNumber)
+	}
+	withEntries := ToTradingJournalWithEntriesResponse(journal)
+	if *withEntries.Broker != "FTMO" || *withEntries.AccountNumber != "1051234" {
+		t.Errorf("with entries broker = %q account = %q, want FTMO and 1051234", *withEntries.Broker, *withEntries.AccountNumber)
+	}
+	exported := ToJournalExportJournal(journal)
+	if *exported.Broker != "FTMO" || *exported.AccountNumber != "1051234" {
+		t.Errorf("export broker = %q account = %q, want FTMO and 1051234", *exported.Broker, *exported.AccountNumber)
+	}
+
+	body, err := json.Marshal(ToTradingJournalResponse(entity.NewTradingJournal(uuid.New(), "Demo", "")))
+	if err != nil {
+		t.Fatalf("marshal response: %v", err)
+	}
+	if strings.Contains(string(body), "broker") || strings.Contains(string(body), "account_number") {
+		t.Errorf("response %s carries unset broker fields", body)
+	}
+}
+
+func equalTime(a, b *time.Time) bool {
+	return (a == nil && b == nil) || (a != nil && b != nil && a.Equal(*b))
+}
+
+func TestToImportPreviewResponse(t *testing.T) {
+	tests := []struct {
+		name    string
+		preview *entity.ImportPreview
+		want    string
+	}{
+		{
+			name:    "no errors",
+			preview: &entity.ImportPreview{JournalName: "Swing", EntryCount: 2},
+			want:    `{"journal_name":"Swing","entries_to_create":2,"errors":[]}`,
+		},
+		{
+			name: "row errors",
+			preview: &entity.ImportPreview{
+				JournalName: "Swing",
+				EntryCount:  1,
+				Errors:      []entity.ImportRowError{{Index: 1, Err: entity.ErrInvalidSession}},
+			},
+			want: `{"journal_name":"Swing","entries_to_create":1,"errors":[{"index":1,"error":"` + entity.ErrInvalidSession.Error() + `"}]}`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := json.Marshal(ToImportPreviewResponse(tt.preview))
+			if err != nil {
+				t.Fatalf("encode response: %v", err)
+			}
+			if string(got) != tt.want {
+				t.Errorf("response = %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestToJournalSummaryResponse(t *testing.T) {
+	if got := ToJournalSummaryResponse(nil); got != nil {
+		t.Errorf("ToJournalSummaryResponse(nil) = %+v, want nil", got)
+	}
+
+	berlin := time.FixedZone("CET", 3600)
+	summary := &entity.JournalSummary{
+		JournalID:   uuid.New(),
+		TotalTrades: 8,
+		Wins:        6,
+		WinRate:     75,
+		NetRealized: 410.35,
+		ComputedAt:  time.Date(2026, 4, 1, 12, 0, 0, 0, berlin),
+	}
+
+	got := ToJournalSummaryResponse(summary)
+	if got.JournalID != summary.JournalID || got.TotalTrades != 8 || got.Wins != 6 || got.WinRate != 75 || got.NetRealized != 410.35 {
+		t.Errorf("summary response = %+v, want the summary's totals", got)
+	}
+	if got.ComputedAt.Location() != time.UTC || !got.ComputedAt.Equal(summary.ComputedAt) {
+		t.Errorf("computed at = %v, want %v in UTC", got.ComputedAt, summary.ComputedAt)
+	}
+
+	journal := entity.NewTradingJournal(uuid.New(), "Swing", "")
+	if ToTradingJournalResponse(journal).Summary != nil {
+		t.Errorf("journal without a loaded summary has a summary in its response")
+	}
+	journal.Summary = summary
+	if response := ToTradingJournalResponse(journal); response.Summary == nil || response.Summary.TotalTrades != 8 {
+		t.Errorf("journal response summary = %+v, want the loaded summary", response.Summary)
+	}
+}
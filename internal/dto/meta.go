@@ -0,0 +1,14 @@
+package dto
+
+import "github.com/user/normark/internal/types"
+
+// EnumsResponse lists the valid values of every enum the API accepts.
+type EnumsResponse struct {
+	CurrencyPairs   []types.CurrencyPair   `json:"currency_pairs"`
+	TradingSessions []types.TradingSession `json:"trading_sessions"`
+	TradeTypes      []types.TradeType      `json:"trade_types"`
+	TradeDirections []types.TradeDirection `json:"trade_directions"`
+	EntryTypes      []types.EntryType      `json:"entry_types"`
+	TradeResults    []types.TradeResult    `json:"trade_results"`
+	TimeFrames      []types.TimeFrame      `json:"time_frames"`
+}
@@ -0,0 +1,45 @@
+package dto
+
+// Pagination is embedded in paginated list responses. Total, Limit and
+// Offset echo the query; the rest is derived so clients don't have to.
+type Pagination struct {
+	Total       int  `json:"total"`
+	Limit       int  `json:"limit"`
+	Offset      int  `json:"offset"`
+	TotalPages  int  `json:"total_pages"`
+	CurrentPage int  `json:"current_page"`
+	HasNext     bool `json:"has_next"`
+	HasPrev     bool `json:"has_prev"`
+}
+
+// Paged is implemented by paginated list responses, so the response envelope
+// can report the list and its pagination separately.
+type Paged interface {
+	Items() any
+	Page() Pagination
+}
+
+// Page returns the pagination; list responses get it by embedding.
+func (p Pagination) Page() Pagination {
+	return p
+}
+
+// NewPagination derives page metadata from an offset-based query. Pages are
+// 1-based; an empty result has zero pages and is on page 1.
+func NewPagination(total, limit, offset int) Pagination {
+	p := Pagination{
+		Total:       total,
+		Limit:       limit,
+		Offset:      offset,
+		CurrentPage: 1,
+		HasNext:     offset+limit < total,
+		HasPrev:     offset > 0,
+	}
+
+	if limit > 0 {
+		p.TotalPages = (total + limit - 1) / limit
+		p.CurrentPage = offset/limit + 1
+	}
+
+	return p
+}
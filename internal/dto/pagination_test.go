@@ -0,0 +1,57 @@
+package dto
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+)
+
+func TestNewPagination(t *testing.T) {
+	tests := []struct {
+		name   string
+		total  int
+		limit  int
+		offset int
+		want   Pagination
+	}{
+		{"empty", 0, 20, 0, Pagination{Limit: 20, CurrentPage: 1}},
+		{"single partial page", 5, 20, 0, Pagination{Total: 5, Limit: 20, TotalPages: 1, CurrentPage: 1}},
+		{"exact multiple, first page", 40, 20, 0, Pagination{Total: 40, Limit: 20, TotalPages: 2, CurrentPage: 1, HasNext: true}},
+		{"exact multiple, last page", 40, 20, 20, Pagination{Total: 40, Limit: 20, Offset: 20, TotalPages: 2, CurrentPage: 2, HasPrev: true}},
+		{"middle page", 45, 20, 20, Pagination{Total: 45, Limit: 20, Offset: 20, TotalPages: 3, CurrentPage: 2, HasNext: true, HasPrev: true}},
+		{"last partial page", 45, 20, 40, Pagination{Total: 45, Limit: 20, Offset: 40, TotalPages: 3, CurrentPage: 3, HasPrev: true}},
+		{"past the end", 45, 20, 60, Pagination{Total: 45, Limit: 20, Offset: 60, TotalPages: 3, CurrentPage: 4, HasPrev: true}},
+		{"offset inside a page", 45, 20, 10, Pagination{Total: 45, Limit: 20, Offset: 10, TotalPages: 3, CurrentPage: 1, HasNext: true, HasPrev: true}},
+		{"zero limit", 45, 0, 0, Pagination{Total: 45, CurrentPage: 1, HasNext: true}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := NewPagination(tt.total, tt.limit, tt.offset); got != tt.want {
+				t.Errorf("NewPagination(%d, %d, %d) = %+v, want %+v", tt.total, tt.limit, tt.offset, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestListResponsesKeepFlatPagination(t *testing.T) {
+	responses := map[string]any{
+		"journals": &TradingJournalListResponse{Pagination: NewPagination(40, 20, 20)},
+		"entries":  &TradingJournalEntryListResponse{Pagination: NewPagination(40, 20, 20)},
+	}
+
+	for name, response := range responses {
+		t.Run(name, func(t *testing.T) {
+			body, err := json.Marshal(response)
+			if err != nil {
+				t.Fatalf("marshal: %v", err)
+			}
+
+			for _, want := range []string{`"total":40`, `"limit":20`, `"offset":20`, `"total_pages":2`, `"current_page":2`, `"has_next":false`, `"has_prev":true`} {
+				if !strings.Contains(string(body), want) {
+					t.Errorf("%s does not contain %s", body, want)
+				}
+			}
+		})
+	}
+}
@@ -4,40 +4,132 @@ import (
 	"time"
 
 	"github.com/google/uuid"
+	"github.com/user/normark/internal/types"
 )
 
 type CreateTradingJournalRequest struct {
-	Name        string `json:"name" validate:"required,min=1,max=255"`
-	Description string `json:"description" validate:"omitempty,max=1000"`
+	Name               string                `json:"name" validate:"required,min=1,max=255"`
+	Description        string                `json:"description" validate:"omitempty,max=1000"`
+	DefaultAsset       *types.CurrencyPair   `json:"default_asset" validate:"omitempty"`
+	DefaultSession     *types.TradingSession `json:"default_session" validate:"omitempty"`
+	Tags               []string              `json:"tags" validate:"omitempty,max=20,dive,min=1,max=50"`
+	RequireNotesOnLoss bool                  `json:"require_notes_on_loss"`
+	// ValidationProfile defaults to standard on create and is left
+	// unchanged on update when empty.
+	ValidationProfile types.ValidationProfile `json:"validation_profile" validate:"omitempty,oneof=lenient standard strict"`
+	Broker            *string                 `json:"broker" validate:"omitempty,max=100"`
+	AccountNumber     *string                 `json:"account_number" validate:"omitempty,max=50"`
+	PipValue          *float64                `json:"pip_value" validate:"omitempty,gt=0"`
+
+	// TemplateID is set from the from_template query parameter.
+	TemplateID *uuid.UUID `json:"-"`
 }
 
 type UpdateTradingJournalRequest struct {
-	Name        string `json:"name" validate:"required,min=1,max=255"`
-	Description string `json:"description" validate:"omitempty,max=1000"`
+	Name               string                `json:"name" validate:"required,min=1,max=255"`
+	Description        string                `json:"description" validate:"omitempty,max=1000"`
+	DefaultAsset       *types.CurrencyPair   `json:"default_asset" validate:"omitempty"`
+	DefaultSession     *types.TradingSession `json:"default_session" validate:"omitempty"`
+	Tags               []string              `json:"tags" validate:"omitempty,max=20,dive,min=1,max=50"`
+	RequireNotesOnLoss bool                  `json:"require_notes_on_loss"`
+	// ValidationProfile defaults to standard on create and is left
+	// unchanged on update when empty.
+	ValidationProfile types.ValidationProfile `json:"validation_profile" validate:"omitempty,oneof=lenient standard strict"`
+	Broker            *string                 `json:"broker" validate:"omitempty,max=100"`
+	AccountNumber     *string                 `json:"account_number" validate:"omitempty,max=50"`
+	PipValue          *float64                `json:"pip_value" validate:"omitempty,gt=0"`
 }
 
 type TradingJournalResponse struct {
-	ID          uuid.UUID `json:"id"`
-	UserID      uuid.UUID `json:"user_id"`
-	Name        string    `json:"name"`
-	Description string    `json:"description"`
-	CreatedAt   time.Time `json:"created_at"`
-	UpdatedAt   time.Time `json:"updated_at"`
+	ID                 uuid.UUID               `json:"id"`
+	UserID             uuid.UUID               `json:"user_id"`
+	Name               string                  `json:"name"`
+	Description        string                  `json:"description"`
+	IsArchived         bool                    `json:"is_archived"`
+	IsLocked           bool                    `json:"is_locked"`
+	RequireNotesOnLoss bool                    `json:"require_notes_on_loss"`
+	ValidationProfile  types.ValidationProfile `json:"validation_profile"`
+	Broker             *string                 `json:"broker,omitempty"`
+	AccountNumber      *string                 `json:"account_number,omitempty"`
+	PipValue           *float64                `json:"pip_value,omitempty"`
+	DefaultAsset       *types.CurrencyPair     `json:"default_asset,omitempty"`
+	DefaultSession     *types.TradingSession   `json:"default_session,omitempty"`
+	Tags               []string                `json:"tags"`
+	FirstEntryDate     *time.Time              `json:"first_entry_date"`
+	LastEntryDate      *time.Time              `json:"last_entry_date"`
+	CreatedAt          time.Time               `json:"created_at"`
+	UpdatedAt          time.Time               `json:"updated_at"`
+
+	// Summary is only included in journal lists.
+	Summary *JournalSummaryResponse `json:"summary,omitempty"`
+}
+
+type JournalSummaryResponse struct {
+	JournalID   uuid.UUID `json:"journal_id"`
+	TotalTrades int       `json:"total_trades"`
+	Wins        int       `json:"wins"`
+	WinRate     float64   `json:"win_rate"`
+	NetRealized float64   `json:"net_realized"`
+	ComputedAt  time.Time `json:"computed_at"`
 }
 
 type TradingJournalWithEntriesResponse struct {
-	ID          uuid.UUID                    `json:"id"`
-	UserID      uuid.UUID                    `json:"user_id"`
-	Name        string                       `json:"name"`
-	Description string                       `json:"description"`
-	Entries     []TradingJournalEntryResponse `json:"entries"`
-	CreatedAt   time.Time                    `json:"created_at"`
-	UpdatedAt   time.Time                    `json:"updated_at"`
+	ID                 uuid.UUID                     `json:"id"`
+	UserID             uuid.UUID                     `json:"user_id"`
+	Name               string                        `json:"name"`
+	Description        string                        `json:"description"`
+	IsArchived         bool                          `json:"is_archived"`
+	IsLocked           bool                          `json:"is_locked"`
+	RequireNotesOnLoss bool                          `json:"require_notes_on_loss"`
+	ValidationProfile  types.ValidationProfile       `json:"validation_profile"`
+	Broker             *string                       `json:"broker,omitempty"`
+	AccountNumber      *string                       `json:"account_number,omitempty"`
+	PipValue           *float64                      `json:"pip_value,omitempty"`
+	DefaultAsset       *types.CurrencyPair           `json:"default_asset,omitempty"`
+	DefaultSession     *types.TradingSession         `json:"default_session,omitempty"`
+	Tags               []string                      `json:"tags"`
+	FirstEntryDate     *time.Time                    `json:"first_entry_date"`
+	LastEntryDate      *time.Time                    `json:"last_entry_date"`
+	Entries            []TradingJournalEntryResponse `json:"entries"`
+	Pagination         *Pagination                   `json:"pagination,omitempty"`
+	CreatedAt          time.Time                     `json:"created_at"`
+	UpdatedAt          time.Time                     `json:"updated_at"`
 }
 
 type TradingJournalListResponse struct {
 	Journals []*TradingJournalResponse `json:"journals"`
-	Total    int                       `json:"total"`
-	Limit    int                       `json:"limit"`
-	Offset   int                       `json:"offset"`
+	Pagination
+}
+
+func (r *TradingJournalListResponse) Items() any {
+	return r.Journals
+}
+
+type CreateJournalTemplateRequest struct {
+	Name           string                `json:"name" validate:"required,min=1,max=255"`
+	Description    string                `json:"description" validate:"omitempty,max=1000"`
+	DefaultAsset   *types.CurrencyPair   `json:"default_asset" validate:"omitempty"`
+	DefaultSession *types.TradingSession `json:"default_session" validate:"omitempty"`
+	Tags           []string              `json:"tags" validate:"omitempty,max=20,dive,min=1,max=50"`
+}
+
+type JournalTemplateResponse struct {
+	ID             uuid.UUID             `json:"id"`
+	UserID         uuid.UUID             `json:"user_id"`
+	Name           string                `json:"name"`
+	Description    string                `json:"description"`
+	DefaultAsset   *types.CurrencyPair   `json:"default_asset,omitempty"`
+	DefaultSession *types.TradingSession `json:"default_session,omitempty"`
+	Tags           []string              `json:"tags"`
+	CreatedAt      time.Time             `json:"created_at"`
+	UpdatedAt      time.Time             `json:"updated_at"`
+}
+
+type JournalTemplateListResponse struct {
+	Templates []*JournalTemplateResponse `json:"templates"`
+	Pagination
+}
+
+func (r *JournalTemplateListResponse) Items() any {
+	return r.Templates
 }
@@ -7,84 +7,512 @@ import (
 	"github.com/user/normark/internal/types"
 )
 
+// CreateTradingJournalEntryRequest creates an entry. Asset, session,
+// trade_type, direction and max_rr may be left out when the entry is
+// created from an entry template that sets them.
 type CreateTradingJournalEntryRequest struct {
-	Day         time.Time              `json:"day" validate:"required"`
-	Asset       types.CurrencyPair     `json:"asset" validate:"required"`
-	LTF         string                 `json:"ltf" validate:"required,url"`
-	HTF         string                 `json:"htf" validate:"required,url"`
-	EntryCharts []string               `json:"entry_charts" validate:"omitempty,dive,url"`
-	Session     types.TradingSession   `json:"session" validate:"required"`
-	TradeType   types.TradeType        `json:"trade_type" validate:"required"`
-	Setup       *string                `json:"setup" validate:"omitempty,max=500"`
-	Direction   types.TradeDirection   `json:"direction" validate:"required"`
-	EntryType   types.EntryType        `json:"entry_type" validate:"required"`
-	Realized    float64                `json:"realized" validate:"required"`
-	MaxRR       float64                `json:"max_rr" validate:"required,gt=0"`
-	Result      types.TradeResult      `json:"result" validate:"required"`
-	Notes       string                 `json:"notes" validate:"omitempty,max=5000"`
+	Day             time.Time             `json:"day" validate:"required"`
+	Asset           types.CurrencyPair    `json:"asset" validate:"required_without=TemplateID"`
+	LTF             string                `json:"ltf" validate:"required,url"`
+	HTF             string                `json:"htf" validate:"required,url"`
+	EntryCharts     []string              `json:"entry_charts" validate:"omitempty,max=10,dive,url,weburl"`
+	SetupCharts     []string              `json:"setup_charts" validate:"omitempty,max=10,dive,url,weburl"`
+	Session         types.TradingSession  `json:"session" validate:"required_without=TemplateID"`
+	TradeType       types.TradeType       `json:"trade_type" validate:"required_without=TemplateID"`
+	Setup           *string               `json:"setup" validate:"omitempty,max=500"`
+	Direction       types.TradeDirection  `json:"direction" validate:"required_without=TemplateID"`
+	EntryType       types.EntryType       `json:"entry_type" validate:"required"`
+	Realized        float64               `json:"realized" validate:"required"`
+	MaxRR           float64               `json:"max_rr" validate:"required_without=TemplateID,gte=0"`
+	Result          types.TradeResult     `json:"result" validate:"required"`
+	Notes           string                `json:"notes" validate:"omitempty,max=5000"`
+	Emotion         *types.Emotion        `json:"emotion" validate:"omitempty"`
+	Confidence      *int                  `json:"confidence" validate:"omitempty,min=1,max=5"`
+	FollowedPlan    *bool                 `json:"followed_plan" validate:"omitempty"`
+	RiskPercent     float64               `json:"risk_percent" validate:"gte=0,lte=100"`
+	PositionSize    float64               `json:"position_size" validate:"gte=0"`
+	EntryPrice      *float64              `json:"entry_price" validate:"omitempty,gt=0"`
+	StopLossPrice   *float64              `json:"stop_loss_price" validate:"omitempty,gt=0"`
+	TakeProfitPrice *float64              `json:"take_profit_price" validate:"omitempty,gt=0"`
+	Checklist       []types.ChecklistItem `json:"checklist" validate:"omitempty,max=30,unique=Item,dive"`
+
+	// TemplateID is set from the from_template query parameter.
+	TemplateID *uuid.UUID `json:"-"`
 }
 
 type UpdateTradingJournalEntryRequest struct {
-	Day         time.Time              `json:"day" validate:"required"`
-	Asset       types.CurrencyPair     `json:"asset" validate:"required"`
-	LTF         string                 `json:"ltf" validate:"required,url"`
-	HTF         string                 `json:"htf" validate:"required,url"`
-	EntryCharts []string               `json:"entry_charts" validate:"omitempty,dive,url"`
-	Session     types.TradingSession   `json:"session" validate:"required"`
-	TradeType   types.TradeType        `json:"trade_type" validate:"required"`
-	Setup       *string                `json:"setup" validate:"omitempty,max=500"`
-	Direction   types.TradeDirection   `json:"direction" validate:"required"`
-	EntryType   types.EntryType        `json:"entry_type" validate:"required"`
-	Realized    float64                `json:"realized" validate:"required"`
-	MaxRR       float64                `json:"max_rr" validate:"required,gt=0"`
-	Result      types.TradeResult      `json:"result" validate:"required"`
-	Notes       string                 `json:"notes" validate:"omitempty,max=5000"`
+	Day             time.Time             `json:"day" validate:"required"`
+	Asset           types.CurrencyPair    `json:"asset" validate:"required"`
+	LTF             string                `json:"ltf" validate:"required,url"`
+	HTF             string                `json:"htf" validate:"required,url"`
+	EntryCharts     []string              `json:"entry_charts" validate:"omitempty,max=10,dive,url,weburl"`
+	SetupCharts     []string              `json:"setup_charts" validate:"omitempty,max=10,dive,url,weburl"`
+	Session         types.TradingSession  `json:"session" validate:"required"`
+	TradeType       types.TradeType       `json:"trade_type" validate:"required"`
+	Setup           *string               `json:"setup" validate:"omitempty,max=500"`
+	Direction       types.TradeDirection  `json:"direction" validate:"required"`
+	EntryType       types.EntryType       `json:"entry_type" validate:"required"`
+	Realized        float64               `json:"realized" validate:"required"`
+	MaxRR           float64               `json:"max_rr" validate:"required,gt=0"`
+	Result          types.TradeResult     `json:"result" validate:"required"`
+	Notes           string                `json:"notes" validate:"omitempty,max=5000"`
+	Emotion         *types.Emotion        `json:"emotion" validate:"omitempty"`
+	Confidence      *int                  `json:"confidence" validate:"omitempty,min=1,max=5"`
+	FollowedPlan    *bool                 `json:"followed_plan" validate:"omitempty"`
+	RiskPercent     float64               `json:"risk_percent" validate:"gte=0,lte=100"`
+	PositionSize    float64               `json:"position_size" validate:"gte=0"`
+	EntryPrice      *float64              `json:"entry_price" validate:"omitempty,gt=0"`
+	StopLossPrice   *float64              `json:"stop_loss_price" validate:"omitempty,gt=0"`
+	TakeProfitPrice *float64              `json:"take_profit_price" validate:"omitempty,gt=0"`
+	Checklist       []types.ChecklistItem `json:"checklist" validate:"omitempty,max=30,unique=Item,dive"`
+}
+
+// DuplicateTradingJournalEntryRequest holds the optional overrides applied to
+// the copy. Fields left out keep the source entry's value, except Day, which
+// defaults to the time of duplication.
+type DuplicateTradingJournalEntryRequest struct {
+	Day      *time.Time          `json:"day" validate:"omitempty"`
+	Asset    *types.CurrencyPair `json:"asset" validate:"omitempty"`
+	Realized *float64            `json:"realized" validate:"omitempty"`
+	MaxRR    *float64            `json:"max_rr" validate:"omitempty,gt=0"`
+	Result   *types.TradeResult  `json:"result" validate:"omitempty"`
+	Notes    *string             `json:"notes" validate:"omitempty,max=5000"`
+}
+
+// TransferEntriesRequest moves entries of one journal to another journal of
+// the same user, or copies them if Copy is set.
+type TransferEntriesRequest struct {
+	EntryIDs        []uuid.UUID `json:"entry_ids" validate:"required,min=1,max=500,dive,required"`
+	TargetJournalID uuid.UUID   `json:"target_journal_id" validate:"required"`
+	Copy            bool        `json:"copy"`
+}
+
+// TransferEntriesResponse lists the entries as they are in the target
+// journal: the moved entries, or the new copies.
+type TransferEntriesResponse struct {
+	TargetJournalID uuid.UUID                      `json:"target_journal_id"`
+	Copied          bool                           `json:"copied"`
+	Entries         []*TradingJournalEntryResponse `json:"entries"`
+}
+
+// BulkUpdateEntriesRequest applies Set to every entry matching Filter, whose
+// limit and offset are ignored. With DryRun the changes are previewed but
+// not saved.
+type BulkUpdateEntriesRequest struct {
+	Filter FilterEntriesRequest `json:"filter"`
+	Set    BulkEntryUpdate      `json:"set"`
+	DryRun bool                 `json:"dry_run"`
+}
+
+// BulkEntryUpdate lists the fields a bulk update may change. Fields left out
+// keep their values.
+type BulkEntryUpdate struct {
+	Realized     *float64              `json:"realized" validate:"omitempty"`
+	MaxRR        *float64              `json:"max_rr" validate:"omitempty,gt=0"`
+	Result       *types.TradeResult    `json:"result" validate:"omitempty"`
+	Session      *types.TradingSession `json:"session" validate:"omitempty"`
+	TradeType    *types.TradeType      `json:"trade_type" validate:"omitempty"`
+	EntryType    *types.EntryType      `json:"entry_type" validate:"omitempty"`
+	FollowedPlan *bool                 `json:"followed_plan" validate:"omitempty"`
+	ReviewStatus *types.ReviewStatus   `json:"review_status" validate:"omitempty"`
+}
+
+func (u *BulkEntryUpdate) IsEmpty() bool {
+	return u.Realized == nil && u.MaxRR == nil && u.Result == nil && u.Session == nil &&
+		u.TradeType == nil && u.EntryType == nil && u.FollowedPlan == nil && u.ReviewStatus == nil
+}
+
+// BulkUpdateEntriesResponse lists the matching entries as they are after the
+// update, or would be for a dry run.
+type BulkUpdateEntriesResponse struct {
+	DryRun  bool                           `json:"dry_run"`
+	Matched int                            `json:"matched"`
+	Entries []*TradingJournalEntryResponse `json:"entries"`
+}
+
+type CreateEntryTemplateRequest struct {
+	Name      string                `json:"name" validate:"required,min=1,max=255"`
+	Asset     *types.CurrencyPair   `json:"asset" validate:"omitempty"`
+	Session   *types.TradingSession `json:"session" validate:"omitempty"`
+	TradeType *types.TradeType      `json:"trade_type" validate:"omitempty"`
+	Direction *types.TradeDirection `json:"direction" validate:"omitempty"`
+	MaxRR     *float64              `json:"max_rr" validate:"omitempty,gt=0"`
+}
+
+type EntryTemplateResponse struct {
+	ID        uuid.UUID             `json:"id"`
+	JournalID uuid.UUID             `json:"journal_id"`
+	Name      string                `json:"name"`
+	Asset     *types.CurrencyPair   `json:"asset,omitempty"`
+	Session   *types.TradingSession `json:"session,omitempty"`
+	TradeType *types.TradeType      `json:"trade_type,omitempty"`
+	Direction *types.TradeDirection `json:"direction,omitempty"`
+	MaxRR     *float64              `json:"max_rr,omitempty"`
+	CreatedAt time.Time             `json:"created_at"`
+	UpdatedAt time.Time             `json:"updated_at"`
+}
+
+type EntryTemplateListResponse struct {
+	Templates []*EntryTemplateResponse `json:"templates"`
+	Pagination
+}
+
+func (r *EntryTemplateListResponse) Items() any {
+	return r.Templates
 }
 
 type TradingJournalEntryResponse struct {
-	ID          uuid.UUID              `json:"id"`
-	JournalID   uuid.UUID              `json:"journal_id"`
-	Day         time.Time              `json:"day"`
-	Asset       types.CurrencyPair     `json:"asset"`
-	LTF         string                 `json:"ltf"`
-	HTF         string                 `json:"htf"`
-	EntryCharts []string               `json:"entry_charts"`
-	Session     types.TradingSession   `json:"session"`
-	TradeType   types.TradeType        `json:"trade_type"`
-	Setup       *string                `json:"setup,omitempty"`
-	Direction   types.TradeDirection   `json:"direction"`
-	EntryType   types.EntryType        `json:"entry_type"`
-	Realized    float64                `json:"realized"`
-	MaxRR       float64                `json:"max_rr"`
-	Result      types.TradeResult      `json:"result"`
-	Notes       string                 `json:"notes"`
-	CreatedAt   time.Time              `json:"created_at"`
-	UpdatedAt   time.Time              `json:"updated_at"`
+	ID              uuid.UUID             `json:"id"`
+	JournalID       uuid.UUID             `json:"journal_id"`
+	Day             time.Time             `json:"day"`
+	Asset           types.CurrencyPair    `json:"asset"`
+	LTF             string                `json:"ltf"`
+	HTF             string                `json:"htf"`
+	EntryCharts     []string              `json:"entry_charts"`
+	SetupCharts     []string              `json:"setup_charts"`
+	Session         types.TradingSession  `json:"session"`
+	TradeType       types.TradeType       `json:"trade_type"`
+	Setup           *string               `json:"setup,omitempty"`
+	Direction       types.TradeDirection  `json:"direction"`
+	EntryType       types.EntryType       `json:"entry_type"`
+	Realized        float64               `json:"realized"`
+	RealizedPips    *float64              `json:"realized_pips,omitempty"`
+	MaxRR           float64               `json:"max_rr"`
+	Result          types.TradeResult     `json:"result"`
+	Notes           string                `json:"notes"`
+	IsPinned        bool                  `json:"is_pinned"`
+	Emotion         *types.Emotion        `json:"emotion,omitempty"`
+	Confidence      *int                  `json:"confidence,omitempty"`
+	ReviewStatus    types.ReviewStatus    `json:"review_status"`
+	Grade           *types.TradeGrade     `json:"grade,omitempty"`
+	FollowedPlan    bool                  `json:"followed_plan"`
+	RiskPercent     float64               `json:"risk_percent"`
+	PositionSize    float64               `json:"position_size"`
+	EntryPrice      *float64              `json:"entry_price,omitempty"`
+	StopLossPrice   *float64              `json:"stop_loss_price,omitempty"`
+	TakeProfitPrice *float64              `json:"take_profit_price,omitempty"`
+	PlannedRR       *float64              `json:"planned_rr,omitempty"`
+	Checklist       []types.ChecklistItem `json:"checklist,omitempty"`
+	RelatedEntryIDs []uuid.UUID           `json:"related_entry_ids,omitempty"`
+	CreatedAt       time.Time             `json:"created_at"`
+	UpdatedAt       time.Time             `json:"updated_at"`
+}
+
+// RelatedEntriesResponse lists the entries linked to an entry.
+type RelatedEntriesResponse struct {
+	Entries []*TradingJournalEntryResponse `json:"entries"`
 }
 
 type TradingJournalEntryListResponse struct {
 	Entries []*TradingJournalEntryResponse `json:"entries"`
-	Total   int                            `json:"total"`
-	Limit   int                            `json:"limit"`
-	Offset  int                            `json:"offset"`
+	Pagination
+}
+
+func (r *TradingJournalEntryListResponse) Items() any {
+	return r.Entries
+}
+
+// SyncEntryResponse is an entry as seen by incremental sync. Deleted entries
+// are included so clients can remove their local copy.
+type SyncEntryResponse struct {
+	TradingJournalEntryResponse
+	Deleted   bool       `json:"deleted"`
+	DeletedAt *time.Time `json:"deleted_at,omitempty"`
+}
+
+type EntrySyncResponse struct {
+	Entries []*SyncEntryResponse `json:"entries"`
+	// NextCursor fetches the next page of changes; empty when there are none.
+	NextCursor string `json:"next_cursor,omitempty"`
+	HasMore    bool   `json:"has_more"`
+}
+
+type CreateEntryNoteRequest struct {
+	Body string `json:"body" validate:"required,min=1,max=5000"`
+}
+
+type EntryNoteResponse struct {
+	ID        uuid.UUID `json:"id"`
+	EntryID   uuid.UUID `json:"entry_id"`
+	Body      string    `json:"body"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+type EntryNoteListResponse struct {
+	Notes []*EntryNoteResponse `json:"notes"`
+	Total int                  `json:"total"`
+}
+
+type CreateEntryExitRequest struct {
+	Size     float64 `json:"size" validate:"required,gt=0"`
+	Price    float64 `json:"price" validate:"required,gt=0"`
+	Realized float64 `json:"realized"`
+	// ExitedAt defaults to the time the exit is recorded.
+	ExitedAt *time.Time `json:"exited_at" validate:"omitempty"`
+}
+
+type EntryExitResponse struct {
+	ID        uuid.UUID `json:"id"`
+	EntryID   uuid.UUID `json:"entry_id"`
+	Size      float64   `json:"size"`
+	Price     float64   `json:"price"`
+	Realized  float64   `json:"realized"`
+	ExitedAt  time.Time `json:"exited_at"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+type EntryExitListResponse struct {
+	Exits []*EntryExitResponse `json:"exits"`
+	Total int                  `json:"total"`
+	// TotalRealized is the entry's effective realized: the sum of its exits.
+	TotalRealized float64 `json:"total_realized"`
 }
 
 type TradingJournalStatisticsResponse struct {
-	TotalTrades     int     `json:"total_trades"`
-	Wins            int     `json:"wins"`
-	Losses          int     `json:"losses"`
-	BreakEven       int     `json:"break_even"`
-	WinRate         float64 `json:"win_rate"`
-	TotalRealized   float64 `json:"total_realized"`
-	AvgRiskReward   float64 `json:"avg_risk_reward"`
+	TotalTrades    int     `json:"total_trades"`
+	Wins           int     `json:"wins"`
+	Losses         int     `json:"losses"`
+	BreakEven      int     `json:"break_even"`
+	WinRate        float64 `json:"win_rate"`
+	TotalRealized  float64 `json:"total_realized"`
+	AvgRiskReward  float64 `json:"avg_risk_reward"`
+	AvgRiskPercent float64 `json:"avg_risk_percent"`
+	AvgWin         float64 `json:"avg_win"`
+	AvgLoss        float64 `json:"avg_loss"`
+	AvgPlannedRR   float64 `json:"avg_planned_rr"`
+	AvgAchievedRR  float64 `json:"avg_achieved_rr"`
+	RRDifference   float64 `json:"rr_difference"`
+	KellyFraction  float64 `json:"kelly_fraction"`
+	RiskOfRuin     float64 `json:"risk_of_ruin"`
+
+	// The pip figures are only set when the journal has a pip value.
+	TotalRealizedPips *float64 `json:"total_realized_pips,omitempty"`
+	AvgWinPips        *float64 `json:"avg_win_pips,omitempty"`
+	AvgLossPips       *float64 `json:"avg_loss_pips,omitempty"`
+
+	// RangeStart and RangeEnd are the days of the first and last entry
+	// covered, null when the journal has no entries.
+	RangeStart  *time.Time `json:"range_start"`
+	RangeEnd    *time.Time `json:"range_end"`
+	GeneratedAt time.Time  `json:"generated_at"`
+}
+
+type PeriodStatisticsResponse struct {
+	Start      time.Time                         `json:"start"`
+	End        time.Time                         `json:"end"`
+	Statistics *TradingJournalStatisticsResponse `json:"statistics"`
+}
+
+// StatisticsDeltaResponse is period B minus period A.
+type StatisticsDeltaResponse struct {
+	TotalTrades   int     `json:"total_trades"`
+	Wins          int     `json:"wins"`
+	Losses        int     `json:"losses"`
+	BreakEven     int     `json:"break_even"`
+	WinRate       float64 `json:"win_rate"`
+	TotalRealized float64 `json:"total_realized"`
+	AvgRiskReward float64 `json:"avg_risk_reward"`
+	AvgWin        float64 `json:"avg_win"`
+	AvgLoss       float64 `json:"avg_loss"`
+	AvgPlannedRR  float64 `json:"avg_planned_rr"`
+	AvgAchievedRR float64 `json:"avg_achieved_rr"`
+	RRDifference  float64 `json:"rr_difference"`
+	KellyFraction float64 `json:"kelly_fraction"`
+	RiskOfRuin    float64 `json:"risk_of_ruin"`
+}
+
+type StatisticsComparisonResponse struct {
+	PeriodA *PeriodStatisticsResponse `json:"period_a"`
+	PeriodB *PeriodStatisticsResponse `json:"period_b"`
+	Delta   StatisticsDeltaResponse   `json:"delta"`
+}
+
+type EmotionStatisticsResponse struct {
+	Emotion       types.Emotion `json:"emotion"`
+	TotalTrades   int           `json:"total_trades"`
+	Wins          int           `json:"wins"`
+	Losses        int           `json:"losses"`
+	BreakEven     int           `json:"break_even"`
+	WinRate       float64       `json:"win_rate"`
+	TotalRealized float64       `json:"total_realized"`
+}
+
+type EmotionStatisticsListResponse struct {
+	Emotions []*EmotionStatisticsResponse `json:"emotions"`
+}
+
+type CategoryStatisticsResponse struct {
+	Category      types.AssetCategory `json:"category"`
+	TotalTrades   int                 `json:"total_trades"`
+	Wins          int                 `json:"wins"`
+	Losses        int                 `json:"losses"`
+	BreakEven     int                 `json:"break_even"`
+	WinRate       float64             `json:"win_rate"`
+	TotalRealized float64             `json:"total_realized"`
+}
+
+type CategoryStatisticsListResponse struct {
+	Categories []*CategoryStatisticsResponse `json:"categories"`
+}
+
+type GradeStatisticsResponse struct {
+	Grade         types.TradeGrade `json:"grade"`
+	TotalTrades   int              `json:"total_trades"`
+	Wins          int              `json:"wins"`
+	Losses        int              `json:"losses"`
+	BreakEven     int              `json:"break_even"`
+	WinRate       float64          `json:"win_rate"`
+	TotalRealized float64          `json:"total_realized"`
+}
+
+type GradeStatisticsListResponse struct {
+	Grades []*GradeStatisticsResponse `json:"grades"`
+}
+
+type ConfidenceStatisticsResponse struct {
+	Confidence    int     `json:"confidence"`
+	TotalTrades   int     `json:"total_trades"`
+	Wins          int     `json:"wins"`
+	Losses        int     `json:"losses"`
+	BreakEven     int     `json:"break_even"`
+	WinRate       float64 `json:"win_rate"`
+	TotalRealized float64 `json:"total_realized"`
+}
+
+type ConfidenceStatisticsListResponse struct {
+	Levels []*ConfidenceStatisticsResponse `json:"levels"`
+}
+
+type PlanAdherenceBucketResponse struct {
+	TotalTrades   int     `json:"total_trades"`
+	Wins          int     `json:"wins"`
+	Losses        int     `json:"losses"`
+	BreakEven     int     `json:"break_even"`
+	WinRate       float64 `json:"win_rate"`
+	TotalRealized float64 `json:"total_realized"`
+}
+
+type ChecklistItemStatisticsResponse struct {
+	Item        string `json:"item"`
+	TotalTrades int    `json:"total_trades"`
+	Checked     int    `json:"checked"`
+	// AdherenceRate is the percentage of trades the item was checked on.
+	AdherenceRate    float64 `json:"adherence_rate"`
+	CheckedWinRate   float64 `json:"checked_win_rate"`
+	UncheckedWinRate float64 `json:"unchecked_win_rate"`
+	// WinRateDifference is the checked minus the unchecked win rate; the
+	// larger it is, the more the item goes with winning trades.
+	WinRateDifference float64 `json:"win_rate_difference"`
+}
+
+type ChecklistAdherenceResponse struct {
+	Items []*ChecklistItemStatisticsResponse `json:"items"`
+}
+
+type AdherenceStatisticsResponse struct {
+	InPlan    *PlanAdherenceBucketResponse `json:"in_plan"`
+	OutOfPlan *PlanAdherenceBucketResponse `json:"out_of_plan"`
+	// RealizedDifference is in-plan minus out-of-plan net realized.
+	RealizedDifference float64 `json:"realized_difference"`
+}
+
+type FacetValueResponse struct {
+	Value string `json:"value"`
+	Count int    `json:"count"`
+}
+
+type EntryFacetsResponse struct {
+	Assets     []*FacetValueResponse `json:"assets"`
+	Sessions   []*FacetValueResponse `json:"sessions"`
+	Results    []*FacetValueResponse `json:"results"`
+	TradeTypes []*FacetValueResponse `json:"trade_types"`
+}
+
+type UpdateReviewStatusRequest struct {
+	ReviewStatus types.ReviewStatus `json:"review_status" validate:"required"`
+}
+
+// LinkEntryRequest names the entry of the same journal to link to.
+type LinkEntryRequest struct {
+	EntryID uuid.UUID `json:"entry_id" validate:"required"`
+}
+
+type ReviewProgressResponse struct {
+	Total      int `json:"total"`
+	Unreviewed int `json:"unreviewed"`
+	Reviewed   int `json:"reviewed"`
+	Flagged    int `json:"flagged"`
+}
+
+type AssetStatisticsResponse struct {
+	Asset       types.CurrencyPair `json:"asset"`
+	TotalTrades int                `json:"total_trades"`
+	Wins        int                `json:"wins"`
+	WinRate     float64            `json:"win_rate"`
+}
+
+type AssetPairStatisticsResponse struct {
+	AssetA      types.CurrencyPair `json:"asset_a"`
+	AssetB      types.CurrencyPair `json:"asset_b"`
+	SharedDays  int                `json:"shared_days"`
+	TotalTrades int                `json:"total_trades"`
+	Wins        int                `json:"wins"`
+	WinRate     float64            `json:"win_rate"`
+}
+
+type AssetCorrelationResponse struct {
+	Assets []*AssetStatisticsResponse     `json:"assets"`
+	Pairs  []*AssetPairStatisticsResponse `json:"pairs"`
+}
+
+type CalendarDayResponse struct {
+	Count       int     `json:"count"`
+	NetRealized float64 `json:"net_realized"`
+}
+
+type CalendarResponse struct {
+	Year int `json:"year"`
+	// Days is keyed by date (YYYY-MM-DD). Days without entries are omitted.
+	Days map[string]*CalendarDayResponse `json:"days"`
+}
+
+type JournalStatisticsSummaryResponse struct {
+	JournalID     uuid.UUID `json:"journal_id"`
+	JournalName   string    `json:"journal_name"`
+	TotalTrades   int       `json:"total_trades"`
+	Wins          int       `json:"wins"`
+	Losses        int       `json:"losses"`
+	BreakEven     int       `json:"break_even"`
+	WinRate       float64   `json:"win_rate"`
+	TotalRealized float64   `json:"total_realized"`
+}
+
+type UserStatisticsResponse struct {
+	TotalJournals int                                 `json:"total_journals"`
+	TotalTrades   int                                 `json:"total_trades"`
+	Wins          int                                 `json:"wins"`
+	Losses        int                                 `json:"losses"`
+	BreakEven     int                                 `json:"break_even"`
+	WinRate       float64                             `json:"win_rate"`
+	TotalRealized float64                             `json:"total_realized"`
+	Journals      []*JournalStatisticsSummaryResponse `json:"journals"`
 }
 
 type FilterEntriesRequest struct {
-	Asset     *types.CurrencyPair   `json:"asset" validate:"omitempty"`
-	Session   *types.TradingSession `json:"session" validate:"omitempty"`
-	Result    *types.TradeResult    `json:"result" validate:"omitempty"`
-	StartDate *time.Time            `json:"start_date" validate:"omitempty"`
-	EndDate   *time.Time            `json:"end_date" validate:"omitempty"`
-	Limit     int                   `json:"limit" validate:"omitempty,min=1,max=100"`
-	Offset    int                   `json:"offset" validate:"omitempty,min=0"`
+	Asset        *types.CurrencyPair   `json:"asset" validate:"omitempty"`
+	Session      *types.TradingSession `json:"session" validate:"omitempty"`
+	Result       *types.TradeResult    `json:"result" validate:"omitempty"`
+	StartDate    *time.Time            `json:"start_date" validate:"omitempty"`
+	EndDate      *time.Time            `json:"end_date" validate:"omitempty"`
+	Pinned       *bool                 `json:"pinned" validate:"omitempty"`
+	ReviewStatus *types.ReviewStatus   `json:"review_status" validate:"omitempty"`
+	FollowedPlan *bool                 `json:"followed_plan" validate:"omitempty"`
+	TradeType    *types.TradeType      `json:"trade_type" validate:"omitempty"`
+	EntryType    *types.EntryType      `json:"entry_type" validate:"omitempty"`
+	MinRR        *float64              `json:"min_rr" validate:"omitempty,gte=0"`
+	MaxRR        *float64              `json:"max_rr" validate:"omitempty,gte=0"`
+	MinRealized  *float64              `json:"min_realized" validate:"omitempty"`
+	MaxRealized  *float64              `json:"max_realized" validate:"omitempty"`
+	Limit        int                   `json:"limit" validate:"omitempty,min=1,max=100"`
+	Offset       int                   `json:"offset" validate:"omitempty,min=0"`
 }
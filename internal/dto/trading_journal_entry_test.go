@@ -0,0 +1,33 @@
+package dto
+
+import (
+	"testing"
+
+	"github.com/user/normark/internal/types"
+)
+
+func TestBulkEntryUpdateIsEmpty(t *testing.T) {
+	realized, followed := 0.0, false
+	result := types.TradeResultBreakEven
+	review := types.ReviewStatusReviewed
+
+	tests := []struct {
+		name   string
+		update BulkEntryUpdate
+		want   bool
+	}{
+		{"nothing set", BulkEntryUpdate{}, true},
+		{"zero realized", BulkEntryUpdate{Realized: &realized}, false},
+		{"false followed plan", BulkEntryUpdate{FollowedPlan: &followed}, false},
+		{"result", BulkEntryUpdate{Result: &result}, false},
+		{"review status", BulkEntryUpdate{ReviewStatus: &review}, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.update.IsEmpty(); got != tt.want {
+				t.Errorf("IsEmpty() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
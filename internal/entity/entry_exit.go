@@ -0,0 +1,48 @@
+package entity
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/uptrace/bun"
+)
+
+// EntryExit is one tranche of a position closed out. Once an entry has exits,
+// its Realized is the sum of theirs.
+type EntryExit struct {
+	bun.BaseModel `bun:"table:entry_exits,alias:ee"`
+
+	ID        uuid.UUID `bun:"id,pk,type:uuid,default:gen_random_uuid()"`
+	EntryID   uuid.UUID `bun:"entry_id,notnull,type:uuid"`
+	Size      float64   `bun:"size,type:decimal(14,4),notnull"`
+	Price     float64   `bun:"price,type:decimal(18,6),notnull"`
+	Realized  float64   `bun:"realized,type:decimal(10,2),notnull"`
+	ExitedAt  time.Time `bun:"exited_at,nullzero,notnull,default:current_timestamp"`
+	CreatedAt time.Time `bun:"created_at,nullzero,notnull,default:current_timestamp"`
+}
+
+func NewEntryExit(entryID uuid.UUID, size, price, realized float64, exitedAt time.Time) *EntryExit {
+	return &EntryExit{
+		EntryID:  entryID,
+		Size:     size,
+		Price:    price,
+		Realized: realized,
+		ExitedAt: exitedAt,
+	}
+}
+
+func (ee *EntryExit) Validate() error {
+	if ee.EntryID == uuid.Nil {
+		return ErrInvalidEntryID
+	}
+
+	if ee.Size <= 0 {
+		return ErrInvalidExitSize
+	}
+
+	if ee.Price <= 0 {
+		return ErrInvalidPrice
+	}
+
+	return nil
+}
@@ -0,0 +1,35 @@
+package entity
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+func TestEntryExitValidate(t *testing.T) {
+	entryID := uuid.New()
+	exitedAt := time.Date(2026, 1, 5, 14, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name    string
+		exit    *EntryExit
+		wantErr error
+	}{
+		{"valid", NewEntryExit(entryID, 0.5, 1.0850, 120, exitedAt), nil},
+		{"losing exit", NewEntryExit(entryID, 0.5, 1.0790, -80, exitedAt), nil},
+		{"missing entry", NewEntryExit(uuid.Nil, 0.5, 1.0850, 120, exitedAt), ErrInvalidEntryID},
+		{"zero size", NewEntryExit(entryID, 0, 1.0850, 120, exitedAt), ErrInvalidExitSize},
+		{"negative size", NewEntryExit(entryID, -1, 1.0850, 120, exitedAt), ErrInvalidExitSize},
+		{"zero price", NewEntryExit(entryID, 0.5, 0, 120, exitedAt), ErrInvalidPrice},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := tt.exit.Validate(); !errors.Is(err, tt.wantErr) {
+				t.Errorf("Validate() error = %v, want %v", err, tt.wantErr)
+			}
+		})
+	}
+}
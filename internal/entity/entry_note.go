@@ -0,0 +1,39 @@
+package entity
+
+import (
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/uptrace/bun"
+)
+
+// EntryNote is a dated review note appended to a trade after the fact. Notes
+// are append-only; the entry's own Notes field keeps the original rationale.
+type EntryNote struct {
+	bun.BaseModel `bun:"table:entry_notes,alias:en"`
+
+	ID        uuid.UUID `bun:"id,pk,type:uuid,default:gen_random_uuid()"`
+	EntryID   uuid.UUID `bun:"entry_id,notnull,type:uuid"`
+	Body      string    `bun:"body,notnull,type:text"`
+	CreatedAt time.Time `bun:"created_at,nullzero,notnull,default:current_timestamp"`
+}
+
+func NewEntryNote(entryID uuid.UUID, body string) *EntryNote {
+	return &EntryNote{
+		EntryID: entryID,
+		Body:    body,
+	}
+}
+
+func (en *EntryNote) Validate() error {
+	if en.EntryID == uuid.Nil {
+		return ErrInvalidEntryID
+	}
+
+	if strings.TrimSpace(en.Body) == "" {
+		return ErrInvalidNoteBody
+	}
+
+	return nil
+}
@@ -0,0 +1,29 @@
+package entity
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/google/uuid"
+)
+
+func TestEntryNoteValidate(t *testing.T) {
+	tests := []struct {
+		name    string
+		note    *EntryNote
+		wantErr error
+	}{
+		{"valid", NewEntryNote(uuid.New(), "moved stop to break-even too early"), nil},
+		{"missing entry", NewEntryNote(uuid.Nil, "note"), ErrInvalidEntryID},
+		{"empty body", NewEntryNote(uuid.New(), ""), ErrInvalidNoteBody},
+		{"blank body", NewEntryNote(uuid.New(), " \n\t"), ErrInvalidNoteBody},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := tt.note.Validate(); !errors.Is(err, tt.wantErr) {
+				t.Errorf("Validate() error = %v, want %v", err, tt.wantErr)
+			}
+		})
+	}
+}
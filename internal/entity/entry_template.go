@@ -0,0 +1,98 @@
+package entity
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/uptrace/bun"
+	"github.com/user/normark/internal/types"
+)
+
+// EntryTemplate holds the setup fields of a trade a journal records often,
+// so entries created from it only need their outcome.
+type EntryTemplate struct {
+	bun.BaseModel `bun:"table:entry_templates,alias:et"`
+
+	ID        uuid.UUID             `bun:"id,pk,type:uuid,default:gen_random_uuid()"`
+	JournalID uuid.UUID             `bun:"journal_id,notnull,type:uuid"`
+	Name      string                `bun:"name,notnull"`
+	Asset     *types.CurrencyPair   `bun:"asset"`
+	Session   *types.TradingSession `bun:"session"`
+	TradeType *types.TradeType      `bun:"trade_type"`
+	Direction *types.TradeDirection `bun:"direction"`
+	MaxRR     *float64              `bun:"max_rr,type:decimal(10,2)"`
+	CreatedAt time.Time             `bun:"created_at,nullzero,notnull,default:current_timestamp"`
+	UpdatedAt time.Time             `bun:"updated_at,nullzero,notnull,default:current_timestamp"`
+	DeletedAt time.Time             `bun:"deleted_at,soft_delete,nullzero"`
+}
+
+func NewEntryTemplate(journalID uuid.UUID, name string) *EntryTemplate {
+	return &EntryTemplate{
+		JournalID: journalID,
+		Name:      name,
+	}
+}
+
+func (et *EntryTemplate) Validate() error {
+	if et.JournalID == uuid.Nil {
+		return ErrInvalidJournalID
+	}
+
+	if et.Name == "" {
+		return ErrInvalidEntryTemplateName
+	}
+
+	if et.Asset != nil && !et.Asset.IsValid() {
+		return ErrInvalidAsset
+	}
+
+	if et.Session != nil && !et.Session.IsValid() {
+		return ErrInvalidSession
+	}
+
+	if et.TradeType != nil && !et.TradeType.IsValid() {
+		return ErrInvalidTradeType
+	}
+
+	if et.Direction != nil && !et.Direction.IsValid() {
+		return ErrInvalidDirection
+	}
+
+	if et.MaxRR != nil && *et.MaxRR <= 0 {
+		return ErrInvalidMaxRR
+	}
+
+	return nil
+}
+
+// ApplyTo copies the template defaults into the entry for every field the
+// entry does not already set. A MaxRR of 0 counts as unset, since a
+// request cannot tell it apart from an omitted one. It returns
+// ErrIncompleteTemplatedEntry if a field is set by neither.
+func (et *EntryTemplate) ApplyTo(entry *TradingJournalEntry) error {
+	if entry.Asset == "" && et.Asset != nil {
+		entry.Asset = *et.Asset
+	}
+
+	if entry.Session == "" && et.Session != nil {
+		entry.Session = *et.Session
+	}
+
+	if entry.TradeType == "" && et.TradeType != nil {
+		entry.TradeType = *et.TradeType
+	}
+
+	if entry.Direction == "" && et.Direction != nil {
+		entry.Direction = *et.Direction
+	}
+
+	if entry.MaxRR == 0 && et.MaxRR != nil {
+		entry.MaxRR = *et.MaxRR
+	}
+
+	if entry.Asset == "" || entry.Session == "" || entry.TradeType == "" || entry.Direction == "" || entry.MaxRR == 0 {
+		return ErrIncompleteTemplatedEntry
+	}
+
+	return nil
+}
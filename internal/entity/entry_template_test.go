@@ -0,0 +1,124 @@
+package entity
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/user/normark/internal/types"
+)
+
+func ptr[T any](v T) *T {
+	return &v
+}
+
+func TestEntryTemplateValidate(t *testing.T) {
+	journalID := uuid.New()
+
+	tests := []struct {
+		name     string
+		template EntryTemplate
+		wantErr  error
+	}{
+		{"name only", EntryTemplate{JournalID: journalID, Name: "London open"}, nil},
+		{"all fields", EntryTemplate{
+			JournalID: journalID,
+			Name:      "London open",
+			Asset:     ptr(types.CurrencyPairEURUSD),
+			Session:   ptr(types.TradingSessionLondon),
+			TradeType: ptr(types.TradeTypeIntraday),
+			Direction: ptr(types.TradeDirectionBuy),
+			MaxRR:     ptr(2.5),
+		}, nil},
+		{"missing journal", EntryTemplate{Name: "London open"}, ErrInvalidJournalID},
+		{"missing name", EntryTemplate{JournalID: journalID}, ErrInvalidEntryTemplateName},
+		{"invalid asset", EntryTemplate{JournalID: journalID, Name: "x", Asset: ptr(types.CurrencyPair("XXXYYY"))}, ErrInvalidAsset},
+		{"invalid session", EntryTemplate{JournalID: journalID, Name: "x", Session: ptr(types.TradingSession("sydney"))}, ErrInvalidSession},
+		{"invalid trade type", EntryTemplate{JournalID: journalID, Name: "x", TradeType: ptr(types.TradeType("scalp"))}, ErrInvalidTradeType},
+		{"invalid direction", EntryTemplate{JournalID: journalID, Name: "x", Direction: ptr(types.TradeDirection("up"))}, ErrInvalidDirection},
+		{"zero max rr", EntryTemplate{JournalID: journalID, Name: "x", MaxRR: ptr(0.0)}, ErrInvalidMaxRR},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := tt.template.Validate(); !errors.Is(err, tt.wantErr) {
+				t.Errorf("Validate() error = %v, want %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestEntryTemplateApplyTo(t *testing.T) {
+	full := EntryTemplate{
+		Asset:     ptr(types.CurrencyPairEURUSD),
+		Session:   ptr(types.TradingSessionLondon),
+		TradeType: ptr(types.TradeTypeIntraday),
+		Direction: ptr(types.TradeDirectionBuy),
+		MaxRR:     ptr(3.0),
+	}
+
+	tests := []struct {
+		name     string
+		template EntryTemplate
+		entry    TradingJournalEntry
+		want     TradingJournalEntry
+		wantErr  error
+	}{
+		{
+			name:     "fills unset fields",
+			template: full,
+			want: TradingJournalEntry{
+				Asset:     types.CurrencyPairEURUSD,
+				Session:   types.TradingSessionLondon,
+				TradeType: types.TradeTypeIntraday,
+				Direction: types.TradeDirectionBuy,
+				MaxRR:     3,
+			},
+		},
+		{
+			name:     "keeps set fields",
+			template: full,
+			entry: TradingJournalEntry{
+				Asset:     types.CurrencyPairGBPUSD,
+				Direction: types.TradeDirectionSell,
+				MaxRR:     1.5,
+			},
+			want: TradingJournalEntry{
+				Asset:     types.CurrencyPairGBPUSD,
+				Session:   types.TradingSessionLondon,
+				TradeType: types.TradeTypeIntraday,
+				Direction: types.TradeDirectionSell,
+				MaxRR:     1.5,
+			},
+		},
+		{
+			name:     "field set by neither",
+			template: EntryTemplate{Asset: ptr(types.CurrencyPairEURUSD)},
+			entry: TradingJournalEntry{
+				Session:   types.TradingSessionLondon,
+				TradeType: types.TradeTypeIntraday,
+				Direction: types.TradeDirectionBuy,
+			},
+			wantErr: ErrIncompleteTemplatedEntry,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			entry := tt.entry
+			err := tt.template.ApplyTo(&entry)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("ApplyTo() error = %v, want %v", err, tt.wantErr)
+			}
+			if err != nil {
+				return
+			}
+
+			if entry.Asset != tt.want.Asset || entry.Session != tt.want.Session ||
+				entry.TradeType != tt.want.TradeType || entry.Direction != tt.want.Direction ||
+				entry.MaxRR != tt.want.MaxRR {
+				t.Errorf("ApplyTo() entry = %+v, want %+v", entry, tt.want)
+			}
+		})
+	}
+}
@@ -3,19 +3,86 @@ package entity
 import "github.com/cockroachdb/errors"
 
 var (
-	ErrInvalidUserID      = errors.New("invalid user ID")
-	ErrInvalidJournalID   = errors.New("invalid journal ID")
-	ErrInvalidJournalName = errors.New("invalid journal name")
-	ErrInvalidAsset       = errors.New("invalid currency pair asset")
-	ErrInvalidLTF         = errors.New("invalid lower timeframe (LTF) URL")
-	ErrInvalidHTF         = errors.New("invalid higher timeframe (HTF) URL")
-	ErrInvalidSession     = errors.New("invalid trading session")
-	ErrInvalidTradeType   = errors.New("invalid trade type")
-	ErrInvalidDirection   = errors.New("invalid trade direction")
-	ErrInvalidEntryType   = errors.New("invalid entry type")
-	ErrInvalidResult      = errors.New("invalid trade result")
+	// ErrNotFound and ErrConflict classify errors for the transport layer:
+	// wrap or mark an error with one of them and handlers map it to 404 or
+	// 409 with errors.Is. Anything unclassified is an internal error.
+	// Resources owned by someone else are reported as not found so their
+	// existence isn't leaked.
+	ErrNotFound = errors.New("not found")
+	ErrConflict = errors.New("conflict")
+
+	ErrInvalidUserID       = errors.New("invalid user ID")
+	ErrInvalidJournalID    = errors.New("invalid journal ID")
+	ErrInvalidJournalName  = errors.New("invalid journal name")
+	ErrInvalidEntryID      = errors.New("invalid entry ID")
+	ErrInvalidNoteBody     = errors.New("note body must not be empty")
+	ErrInvalidTemplateName = errors.New("invalid journal template name")
+	ErrInvalidAsset        = errors.New("invalid currency pair asset")
+	ErrInvalidLTF          = errors.New("invalid lower timeframe (LTF) URL")
+	ErrInvalidHTF          = errors.New("invalid higher timeframe (HTF) URL")
+	ErrInvalidSession      = errors.New("invalid trading session")
+	ErrInvalidTradeType    = errors.New("invalid trade type")
+	ErrInvalidDirection    = errors.New("invalid trade direction")
+	ErrInvalidEntryType    = errors.New("invalid entry type")
+	ErrInvalidResult       = errors.New("invalid trade result")
+	ErrInvalidEmotion      = errors.New("invalid emotion")
+	ErrInvalidConfidence   = errors.New("confidence must be between 1 and 5")
+	ErrInvalidReviewStatus = errors.New("invalid review status")
+	ErrInvalidRiskPercent  = errors.New("risk percent must be between 0 and 100")
+	ErrInvalidPositionSize = errors.New("position size must not be negative")
+	ErrInvalidExitSize     = errors.New("exit size must be greater than zero")
+	ErrInvalidPrice        = errors.New("prices must be greater than zero")
+	ErrInvalidStopLoss     = errors.New("stop loss price is on the wrong side of the entry price")
+	ErrInvalidTakeProfit   = errors.New("take profit price is on the wrong side of the entry price")
+	ErrSelfLink            = errors.New("an entry cannot be linked to itself")
+	ErrTooManyLinks        = errors.New("an entry can be linked to at most 20 other entries")
+	ErrEmptyBulkUpdate     = errors.New("bulk update sets no fields")
+	ErrBulkUpdateTooLarge  = errors.New("bulk update matches more than 1000 entries")
+	ErrInvalidBulkUpdate   = errors.New("bulk update would leave an entry invalid")
+	ErrInvalidChecklist    = errors.New("checklist items must be unique, non-empty and at most 200 characters, with at most 30 items")
+	ErrInvalidMaxRR        = errors.New("max RR must be greater than zero")
+
+	// Entry template errors
+	ErrInvalidEntryTemplateName = errors.New("invalid entry template name")
+	ErrEntryTemplateNotFound    = errors.Mark(errors.New("entry template not found"), ErrNotFound)
+	ErrIncompleteTemplatedEntry = errors.New("asset, session, trade_type, direction and max_rr must be set by the request or the entry template")
+
+	// Journal errors
+	ErrJournalNameTaken = errors.Mark(errors.New("a journal with this name already exists"), ErrConflict)
+	ErrJournalLocked    = errors.New("journal is locked and cannot be modified")
+	ErrSameJournal      = errors.New("target journal must differ from the source journal")
+
+	ErrInvalidValidationProfile = errors.New("invalid validation profile")
+	ErrInvalidPipValue          = errors.New("pip value must be greater than zero")
+
+	// ErrEntryPolicy classifies entries rejected by their journal's
+	// validation profile or notes requirement.
+	ErrEntryPolicy               = errors.New("entry violates journal policy")
+	ErrNotesRequiredOnLoss       = errors.Mark(errors.New("this journal requires notes on losing trades"), ErrEntryPolicy)
+	ErrFutureEntryDay            = errors.Mark(errors.New("entry day is in the future"), ErrEntryPolicy)
+	ErrResultContradictsRealized = errors.Mark(errors.New("result contradicts the realized profit"), ErrEntryPolicy)
+	ErrIdenticalTimeframeCharts  = errors.Mark(errors.New("this journal requires different LTF and HTF charts"), ErrEntryPolicy)
+
+	// Export errors
+	ErrUnsupportedExportVersion = errors.New("unsupported journal export version")
+
+	// Import errors
+	ErrUnsupportedImportSource = errors.New("unsupported import source")
+	ErrInvalidImportFile       = errors.New("invalid import file")
+
+	ErrExportJobNotFound   = errors.Mark(errors.New("export job not found"), ErrNotFound)
+	ErrExportJobNotReady   = errors.Mark(errors.New("export job is not ready"), ErrConflict)
+	ErrAsyncExportDisabled = errors.New("async export is unavailable without redis")
+	ErrExportQueueFull     = errors.New("too many exports are queued, try again later")
+
+	// Sync errors
+	ErrInvalidSyncCursor = errors.New("invalid sync cursor")
 
 	// Authentication errors
-	ErrUserAlreadyExists  = errors.New("user with this email or username already exists")
-	ErrInvalidCredentials = errors.New("invalid email or password")
+	ErrUserAlreadyExists    = errors.Mark(errors.New("user with this email or username already exists"), ErrConflict)
+	ErrInvalidCredentials   = errors.New("invalid email or password")
+	ErrInvalidRefreshToken  = errors.New("invalid or revoked refresh token")
+	ErrInvalidGoogleToken   = errors.New("invalid google id token or unverified email")
+	ErrGoogleSignInDisabled = errors.New("google sign-in is not configured")
+	ErrSessionNotFound      = errors.Mark(errors.New("session not found"), ErrNotFound)
 )
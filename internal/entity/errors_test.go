@@ -0,0 +1,34 @@
+package entity
+
+import (
+	"testing"
+
+	"github.com/cockroachdb/errors"
+)
+
+func TestErrorClassification(t *testing.T) {
+	tests := []struct {
+		name         string
+		err          error
+		wantNotFound bool
+		wantConflict bool
+	}{
+		{name: "session not found", err: ErrSessionNotFound, wantNotFound: true},
+		{name: "wrapped session not found", err: errors.Wrap(ErrSessionNotFound, "failed to revoke session"), wantNotFound: true},
+		{name: "user already exists", err: ErrUserAlreadyExists, wantConflict: true},
+		{name: "wrapped not found", err: errors.Wrap(ErrNotFound, "trading journal"), wantNotFound: true},
+		{name: "invalid credentials", err: ErrInvalidCredentials},
+		{name: "unclassified", err: errors.New("connection refused")},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := errors.Is(tt.err, ErrNotFound); got != tt.wantNotFound {
+				t.Errorf("errors.Is(err, ErrNotFound) = %v, want %v", got, tt.wantNotFound)
+			}
+			if got := errors.Is(tt.err, ErrConflict); got != tt.wantConflict {
+				t.Errorf("errors.Is(err, ErrConflict) = %v, want %v", got, tt.wantConflict)
+			}
+		})
+	}
+}
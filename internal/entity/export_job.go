@@ -0,0 +1,38 @@
+package entity
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type ExportJobStatus string
+
+const (
+	ExportJobStatusPending ExportJobStatus = "pending"
+	ExportJobStatusRunning ExportJobStatus = "running"
+	ExportJobStatusReady   ExportJobStatus = "ready"
+	ExportJobStatusFailed  ExportJobStatus = "failed"
+)
+
+// ExportJob tracks a journal export built in the background. Jobs live in
+// Redis, not Postgres, and expire together with their result.
+type ExportJob struct {
+	ID          uuid.UUID       `json:"id"`
+	UserID      uuid.UUID       `json:"user_id"`
+	JournalID   uuid.UUID       `json:"journal_id"`
+	Status      ExportJobStatus `json:"status"`
+	Error       string          `json:"error,omitempty"`
+	CreatedAt   time.Time       `json:"created_at"`
+	CompletedAt *time.Time      `json:"completed_at,omitempty"`
+}
+
+func NewExportJob(userID, journalID uuid.UUID) *ExportJob {
+	return &ExportJob{
+		ID:        uuid.New(),
+		UserID:    userID,
+		JournalID: journalID,
+		Status:    ExportJobStatusPending,
+		CreatedAt: time.Now().UTC(),
+	}
+}
@@ -0,0 +1,79 @@
+package entity
+
+import (
+	"math"
+
+	"github.com/user/normark/internal/types"
+)
+
+// GradeWeights sets how much each part of the grading rubric counts. Only
+// the ratios matter.
+type GradeWeights struct {
+	// FollowedPlan rewards sticking to the trading plan.
+	FollowedPlan float64
+	// RR rewards a winner for the share of its planned RR it reached.
+	RR float64
+	// Outcome rewards the result: full for TP, half for BE, none for SL.
+	Outcome float64
+}
+
+// DefaultGradeWeights count discipline as much as RR and outcome together,
+// so a planned trade that lost still grades C.
+var DefaultGradeWeights = GradeWeights{FollowedPlan: 50, RR: 25, Outcome: 25}
+
+// gradeThresholds are the lowest scores, out of 100, for each grade but F.
+var gradeThresholds = []struct {
+	score float64
+	grade types.TradeGrade
+}{
+	{90, types.TradeGradeA},
+	{75, types.TradeGradeB},
+	{50, types.TradeGradeC},
+	{25, types.TradeGradeD},
+}
+
+// ComputeGrade scores the entry out of 100 with the weighted rubric and
+// converts the score to a letter. The RR part compares MaxRR with the RR
+// planned from the entry, stop loss and take profit prices; it is left out,
+// and the other weights scaled up, when those prices are not all set.
+func (tje *TradingJournalEntry) ComputeGrade(w GradeWeights) types.TradeGrade {
+	var score, total float64
+
+	if tje.FollowedPlan {
+		score += w.FollowedPlan
+	}
+	total += w.FollowedPlan
+
+	switch tje.Result {
+	case types.TradeResultTakeProfit:
+		score += w.Outcome
+	case types.TradeResultBreakEven:
+		score += w.Outcome / 2
+	}
+	total += w.Outcome
+
+	if planned := tje.PlannedRR(); planned != nil && *planned > 0 {
+		if tje.Result == types.TradeResultTakeProfit {
+			score += w.RR * math.Min(tje.MaxRR / *planned, 1)
+		}
+		total += w.RR
+	}
+
+	if total > 0 {
+		score = score / total * 100
+	}
+
+	for _, t := range gradeThresholds {
+		if score >= t.score {
+			return t.grade
+		}
+	}
+
+	return types.TradeGradeF
+}
+
+// AssignGrade stores the entry's grade, computed with w.
+func (tje *TradingJournalEntry) AssignGrade(w GradeWeights) {
+	grade := tje.ComputeGrade(w)
+	tje.Grade = &grade
+}
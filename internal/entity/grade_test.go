@@ -0,0 +1,64 @@
+package entity
+
+import (
+	"testing"
+
+	"github.com/user/normark/internal/types"
+)
+
+func TestComputeGrade(t *testing.T) {
+	// An entry at 100 with its stop at 90 and target at 120 plans a 2R trade.
+	withPrices := func(entry *TradingJournalEntry) {
+		entry.EntryPrice = ptr(100.0)
+		entry.StopLossPrice = ptr(90.0)
+		entry.TakeProfitPrice = ptr(120.0)
+	}
+
+	tests := []struct {
+		name         string
+		weights      GradeWeights
+		followedPlan bool
+		result       types.TradeResult
+		prices       bool
+		maxRR        float64
+		want         types.TradeGrade
+	}{
+		{"planned win", DefaultGradeWeights, true, types.TradeResultTakeProfit, false, 0, types.TradeGradeA},
+		{"planned break even", DefaultGradeWeights, true, types.TradeResultBreakEven, false, 0, types.TradeGradeB},
+		{"planned loss", DefaultGradeWeights, true, types.TradeResultStopLoss, false, 0, types.TradeGradeC},
+		{"unplanned win", DefaultGradeWeights, false, types.TradeResultTakeProfit, false, 0, types.TradeGradeD},
+		{"unplanned loss", DefaultGradeWeights, false, types.TradeResultStopLoss, false, 0, types.TradeGradeF},
+		{"planned win reaching planned RR", DefaultGradeWeights, true, types.TradeResultTakeProfit, true, 3, types.TradeGradeA},
+		{"planned win reaching half the planned RR", DefaultGradeWeights, true, types.TradeResultTakeProfit, true, 1, types.TradeGradeB},
+		{"planned loss with prices", DefaultGradeWeights, true, types.TradeResultStopLoss, true, 0, types.TradeGradeC},
+		{"outcome only weights ignore the plan", GradeWeights{Outcome: 1}, true, types.TradeResultStopLoss, false, 0, types.TradeGradeF},
+		{"plan only weights ignore the outcome", GradeWeights{FollowedPlan: 1}, true, types.TradeResultStopLoss, false, 0, types.TradeGradeA},
+		{"no weights", GradeWeights{}, true, types.TradeResultTakeProfit, false, 0, types.TradeGradeF},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			entry := newValidEntry()
+			entry.FollowedPlan = tt.followedPlan
+			entry.Result = tt.result
+			entry.MaxRR = tt.maxRR
+			if tt.prices {
+				withPrices(entry)
+			}
+
+			if got := entry.ComputeGrade(tt.weights); got != tt.want {
+				t.Errorf("ComputeGrade() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestAssignGrade(t *testing.T) {
+	entry := newValidEntry()
+	entry.FollowedPlan = true
+	entry.AssignGrade(DefaultGradeWeights)
+
+	if entry.Grade == nil || *entry.Grade != types.TradeGradeA {
+		t.Fatalf("Grade = %v, want %q", entry.Grade, types.TradeGradeA)
+	}
+}
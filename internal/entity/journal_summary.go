@@ -0,0 +1,23 @@
+package entity
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/uptrace/bun"
+)
+
+// JournalSummary holds a journal's running totals over its live entries.
+// Every entry write adjusts it in the same transaction, so journal lists can
+// show it without aggregating entries. ComputedAt is the time of the last
+// adjustment or full recompute.
+type JournalSummary struct {
+	bun.BaseModel `bun:"table:journal_summaries,alias:js"`
+
+	JournalID   uuid.UUID `bun:"journal_id,pk,type:uuid"`
+	TotalTrades int       `bun:"total_trades,notnull"`
+	Wins        int       `bun:"wins,notnull"`
+	NetRealized float64   `bun:"net_realized,type:decimal(14,2),notnull"`
+	ComputedAt  time.Time `bun:"computed_at,nullzero,notnull,default:current_timestamp"`
+	WinRate     float64   `bun:"-"`
+}
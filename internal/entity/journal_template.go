@@ -0,0 +1,74 @@
+package entity
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/uptrace/bun"
+	"github.com/user/normark/internal/types"
+)
+
+// JournalTemplate holds reusable journal defaults that are copied into
+// journals created from it.
+type JournalTemplate struct {
+	bun.BaseModel `bun:"table:journal_templates,alias:jt"`
+
+	ID             uuid.UUID             `bun:"id,pk,type:uuid,default:gen_random_uuid()"`
+	UserID         uuid.UUID             `bun:"user_id,notnull,type:uuid"`
+	Name           string                `bun:"name,notnull"`
+	Description    string                `bun:"description,type:text"`
+	DefaultAsset   *types.CurrencyPair   `bun:"default_asset"`
+	DefaultSession *types.TradingSession `bun:"default_session"`
+	Tags           []string              `bun:"tags,array,type:text[]"`
+	CreatedAt      time.Time             `bun:"created_at,nullzero,notnull,default:current_timestamp"`
+	UpdatedAt      time.Time             `bun:"updated_at,nullzero,notnull,default:current_timestamp"`
+	DeletedAt      time.Time             `bun:"deleted_at,soft_delete,nullzero"`
+}
+
+func NewJournalTemplate(userID uuid.UUID, name, description string) *JournalTemplate {
+	return &JournalTemplate{
+		UserID:      userID,
+		Name:        name,
+		Description: description,
+	}
+}
+
+func (jt *JournalTemplate) Validate() error {
+	if jt.UserID == uuid.Nil {
+		return ErrInvalidUserID
+	}
+
+	if jt.Name == "" {
+		return ErrInvalidTemplateName
+	}
+
+	if jt.DefaultAsset != nil && !jt.DefaultAsset.IsValid() {
+		return ErrInvalidAsset
+	}
+
+	if jt.DefaultSession != nil && !jt.DefaultSession.IsValid() {
+		return ErrInvalidSession
+	}
+
+	return nil
+}
+
+// ApplyTo copies the template defaults into the journal for every field the
+// journal does not already set.
+func (jt *JournalTemplate) ApplyTo(journal *TradingJournal) {
+	if journal.Description == "" {
+		journal.Description = jt.Description
+	}
+
+	if journal.DefaultAsset == nil {
+		journal.DefaultAsset = jt.DefaultAsset
+	}
+
+	if journal.DefaultSession == nil {
+		journal.DefaultSession = jt.DefaultSession
+	}
+
+	if len(journal.Tags) == 0 {
+		journal.Tags = append([]string(nil), jt.Tags...)
+	}
+}
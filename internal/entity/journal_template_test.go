@@ -0,0 +1,107 @@
+package entity
+
+import (
+	"errors"
+	"slices"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/user/normark/internal/types"
+)
+
+func TestJournalTemplateValidate(t *testing.T) {
+	userID := uuid.New()
+
+	tests := []struct {
+		name     string
+		template JournalTemplate
+		wantErr  error
+	}{
+		{"name only", JournalTemplate{UserID: userID, Name: "Swing"}, nil},
+		{"all fields", JournalTemplate{
+			UserID:         userID,
+			Name:           "Swing",
+			DefaultAsset:   ptr(types.CurrencyPairEURUSD),
+			DefaultSession: ptr(types.TradingSessionLondon),
+			Tags:           []string{"majors"},
+		}, nil},
+		{"missing user", JournalTemplate{Name: "Swing"}, ErrInvalidUserID},
+		{"missing name", JournalTemplate{UserID: userID}, ErrInvalidTemplateName},
+		{"invalid asset", JournalTemplate{UserID: userID, Name: "x", DefaultAsset: ptr(types.CurrencyPair("XXXYYY"))}, ErrInvalidAsset},
+		{"invalid session", JournalTemplate{UserID: userID, Name: "x", DefaultSession: ptr(types.TradingSession("sydney"))}, ErrInvalidSession},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := tt.template.Validate(); !errors.Is(err, tt.wantErr) {
+				t.Errorf("Validate() error = %v, want %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestJournalTemplateApplyTo(t *testing.T) {
+	template := JournalTemplate{
+		Description:    "Higher timeframe setups",
+		DefaultAsset:   ptr(types.CurrencyPairEURUSD),
+		DefaultSession: ptr(types.TradingSessionLondon),
+		Tags:           []string{"majors", "swing"},
+	}
+
+	tests := []struct {
+		name    string
+		journal TradingJournal
+		want    TradingJournal
+	}{
+		{
+			name: "inherits defaults",
+			want: TradingJournal{
+				Description:    "Higher timeframe setups",
+				DefaultAsset:   template.DefaultAsset,
+				DefaultSession: template.DefaultSession,
+				Tags:           []string{"majors", "swing"},
+			},
+		},
+		{
+			name: "keeps own values",
+			journal: TradingJournal{
+				Description:    "Own description",
+				DefaultAsset:   ptr(types.CurrencyPairGBPUSD),
+				DefaultSession: ptr(types.TradingSessionNewYork),
+				Tags:           []string{"cable"},
+			},
+			want: TradingJournal{
+				Description:    "Own description",
+				DefaultAsset:   ptr(types.CurrencyPairGBPUSD),
+				DefaultSession: ptr(types.TradingSessionNewYork),
+				Tags:           []string{"cable"},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			journal := tt.journal
+			template.ApplyTo(&journal)
+
+			if journal.Description != tt.want.Description ||
+				*journal.DefaultAsset != *tt.want.DefaultAsset ||
+				*journal.DefaultSession != *tt.want.DefaultSession ||
+				!slices.Equal(journal.Tags, tt.want.Tags) {
+				t.Errorf("ApplyTo() journal = %+v, want %+v", journal, tt.want)
+			}
+		})
+	}
+}
+
+func TestJournalTemplateApplyToCopiesTags(t *testing.T) {
+	template := JournalTemplate{Tags: []string{"majors"}}
+	var journal TradingJournal
+
+	template.ApplyTo(&journal)
+	journal.Tags[0] = "changed"
+
+	if template.Tags[0] != "majors" {
+		t.Errorf("template tags = %v, changing the journal's tags changed the template's", template.Tags)
+	}
+}
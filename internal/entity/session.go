@@ -0,0 +1,38 @@
+package entity
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/uptrace/bun"
+)
+
+// Session is a signed-in device. Its ID is embedded in the refresh token, so
+// deleting the session revokes that device's ability to refresh.
+type Session struct {
+	bun.BaseModel `bun:"table:sessions,alias:s"`
+
+	ID         uuid.UUID `bun:"id,pk,type:uuid,default:gen_random_uuid()"`
+	UserID     uuid.UUID `bun:"user_id,notnull,type:uuid"`
+	UserAgent  string    `bun:"user_agent,notnull"`
+	IPAddress  string    `bun:"ip_address,notnull"`
+	LastUsedAt time.Time `bun:"last_used_at,nullzero,notnull,default:current_timestamp"`
+	ExpiresAt  time.Time `bun:"expires_at,notnull"`
+	CreatedAt  time.Time `bun:"created_at,nullzero,notnull,default:current_timestamp"`
+}
+
+// NewSession assigns the ID up front so it can be embedded in the tokens
+// before the session is stored.
+func NewSession(userID uuid.UUID, userAgent, ipAddress string) *Session {
+	return &Session{
+		ID:         uuid.New(),
+		UserID:     userID,
+		UserAgent:  userAgent,
+		IPAddress:  ipAddress,
+		LastUsedAt: time.Now().UTC(),
+	}
+}
+
+func (s *Session) IsExpired() bool {
+	return time.Now().After(s.ExpiresAt)
+}
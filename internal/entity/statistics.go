@@ -0,0 +1,246 @@
+package entity
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/user/normark/internal/types"
+)
+
+// EntryStatistics summarizes a single journal. The fields without a bun
+// column are derived in the service layer. RangeStart and RangeEnd are the
+// days of the first and last entry covered, nil when there are none.
+type EntryStatistics struct {
+	TotalTrades    int     `bun:"total_trades"`
+	Wins           int     `bun:"wins"`
+	Losses         int     `bun:"losses"`
+	BreakEven      int     `bun:"break_even"`
+	TotalRealized  float64 `bun:"total_realized"`
+	AvgRiskReward  float64 `bun:"avg_risk_reward"`
+	AvgRiskPercent float64 `bun:"avg_risk_percent"`
+	AvgWin         float64 `bun:"avg_win"`
+	AvgLoss        float64 `bun:"avg_loss"`
+	AvgPlannedRR   float64 `bun:"avg_planned_rr"`
+	AvgAchievedRR  float64 `bun:"avg_achieved_rr"`
+	RRDifference   float64 `bun:"-"`
+	WinRate        float64 `bun:"-"`
+	KellyFraction  float64 `bun:"-"`
+	RiskOfRuin     float64 `bun:"-"`
+
+	// TotalRealizedPips, AvgWinPips and AvgLossPips are the money figures in
+	// pips of the journal's pip value, nil when the journal has none.
+	TotalRealizedPips *float64 `bun:"-"`
+	AvgWinPips        *float64 `bun:"-"`
+	AvgLossPips       *float64 `bun:"-"`
+
+	RangeStart  *time.Time `bun:"range_start"`
+	RangeEnd    *time.Time `bun:"range_end"`
+	GeneratedAt time.Time  `bun:"-"`
+}
+
+type EmotionStatistics struct {
+	Emotion       types.Emotion `bun:"emotion"`
+	TotalTrades   int           `bun:"total_trades"`
+	Wins          int           `bun:"wins"`
+	Losses        int           `bun:"losses"`
+	BreakEven     int           `bun:"break_even"`
+	TotalRealized float64       `bun:"total_realized"`
+	WinRate       float64       `bun:"-"`
+}
+
+// AssetTradeStatistics is the performance of the entries on one asset.
+type AssetTradeStatistics struct {
+	Asset         types.CurrencyPair `bun:"asset"`
+	TotalTrades   int                `bun:"total_trades"`
+	Wins          int                `bun:"wins"`
+	Losses        int                `bun:"losses"`
+	BreakEven     int                `bun:"break_even"`
+	TotalRealized float64            `bun:"total_realized"`
+}
+
+// CategoryStatistics is the performance of the entries on the assets of one
+// category.
+type CategoryStatistics struct {
+	Category      types.AssetCategory
+	TotalTrades   int
+	Wins          int
+	Losses        int
+	BreakEven     int
+	TotalRealized float64
+	WinRate       float64
+}
+
+type GradeStatistics struct {
+	Grade         types.TradeGrade `bun:"grade"`
+	TotalTrades   int              `bun:"total_trades"`
+	Wins          int              `bun:"wins"`
+	Losses        int              `bun:"losses"`
+	BreakEven     int              `bun:"break_even"`
+	TotalRealized float64          `bun:"total_realized"`
+	WinRate       float64          `bun:"-"`
+}
+
+type ConfidenceStatistics struct {
+	Confidence    int     `bun:"confidence"`
+	TotalTrades   int     `bun:"total_trades"`
+	Wins          int     `bun:"wins"`
+	Losses        int     `bun:"losses"`
+	BreakEven     int     `bun:"break_even"`
+	TotalRealized float64 `bun:"total_realized"`
+	WinRate       float64 `bun:"-"`
+}
+
+type JournalStatistics struct {
+	JournalID     uuid.UUID `bun:"journal_id"`
+	JournalName   string    `bun:"journal_name"`
+	TotalTrades   int       `bun:"total_trades"`
+	Wins          int       `bun:"wins"`
+	Losses        int       `bun:"losses"`
+	BreakEven     int       `bun:"break_even"`
+	TotalRealized float64   `bun:"total_realized"`
+	WinRate       float64   `bun:"-"`
+}
+
+// PlanAdherenceStatistics is one side of the plan adherence comparison:
+// either the trades that followed the plan or the ones that didn't.
+type PlanAdherenceStatistics struct {
+	FollowedPlan  bool    `bun:"followed_plan"`
+	TotalTrades   int     `bun:"total_trades"`
+	Wins          int     `bun:"wins"`
+	Losses        int     `bun:"losses"`
+	BreakEven     int     `bun:"break_even"`
+	TotalRealized float64 `bun:"total_realized"`
+	WinRate       float64 `bun:"-"`
+}
+
+// ChecklistItemStatistics summarizes one checklist item over the entries
+// whose checklist lists it.
+type ChecklistItemStatistics struct {
+	Item          string `bun:"item"`
+	TotalTrades   int    `bun:"total_trades"`
+	Checked       int    `bun:"checked"`
+	CheckedWins   int    `bun:"checked_wins"`
+	UncheckedWins int    `bun:"unchecked_wins"`
+	// AdherenceRate is the percentage of trades the item was checked on.
+	AdherenceRate    float64 `bun:"-"`
+	CheckedWinRate   float64 `bun:"-"`
+	UncheckedWinRate float64 `bun:"-"`
+}
+
+// AdherenceStatistics compares in-plan with out-of-plan trades. Both sides
+// are always present, zeroed when the journal has no such trades.
+type AdherenceStatistics struct {
+	InPlan    *PlanAdherenceStatistics
+	OutOfPlan *PlanAdherenceStatistics
+}
+
+// FacetValue is one distinct value of an entry column and how many of the
+// journal's entries use it.
+type FacetValue struct {
+	Value string `bun:"value"`
+	Count int    `bun:"count"`
+}
+
+// EntryFacets lists the values actually used in a journal, for building
+// filters. Each list is ordered by count, most used first.
+type EntryFacets struct {
+	Assets     []*FacetValue
+	Sessions   []*FacetValue
+	Results    []*FacetValue
+	TradeTypes []*FacetValue
+}
+
+// ReviewProgress counts a journal's entries per review status.
+type ReviewProgress struct {
+	Total      int `bun:"total"`
+	Unreviewed int `bun:"unreviewed"`
+	Reviewed   int `bun:"reviewed"`
+	Flagged    int `bun:"flagged"`
+}
+
+// DailyStatistics is one day's bucket in the entry calendar heatmap.
+type DailyStatistics struct {
+	Day         time.Time `bun:"day"`
+	Count       int       `bun:"count"`
+	NetRealized float64   `bun:"net_realized"`
+}
+
+// UserStatistics aggregates performance across every journal a user owns.
+type UserStatistics struct {
+	TotalJournals int
+	TotalTrades   int
+	Wins          int
+	Losses        int
+	BreakEven     int
+	WinRate       float64
+	// TotalRealized is a plain sum of each journal's net realized P&L.
+	// Journals carry no currency, so the figures are assumed to share one.
+	TotalRealized float64
+	Journals      []*JournalStatistics
+}
+
+// DailyAssetStatistics is one asset's results on one day. It is the input
+// for the asset correlation matrix.
+type DailyAssetStatistics struct {
+	Day         time.Time          `bun:"day"`
+	Asset       types.CurrencyPair `bun:"asset"`
+	TotalTrades int                `bun:"total_trades"`
+	Wins        int                `bun:"wins"`
+}
+
+type AssetStatistics struct {
+	Asset       types.CurrencyPair
+	TotalTrades int
+	Wins        int
+	WinRate     float64
+}
+
+// AssetPairStatistics describes the combined results of two assets on the
+// days both were traded.
+type AssetPairStatistics struct {
+	AssetA      types.CurrencyPair
+	AssetB      types.CurrencyPair
+	SharedDays  int
+	TotalTrades int
+	Wins        int
+	WinRate     float64
+}
+
+type AssetCorrelation struct {
+	Assets []*AssetStatistics
+	Pairs  []*AssetPairStatistics
+}
+
+// Period is an inclusive range of entry days.
+type Period struct {
+	Start time.Time
+	End   time.Time
+}
+
+// PeriodComparison holds a journal's statistics for two periods and how
+// period B differs from period A.
+type PeriodComparison struct {
+	PeriodA     Period
+	PeriodB     Period
+	StatisticsA *EntryStatistics
+	StatisticsB *EntryStatistics
+	Delta       StatisticsDelta
+}
+
+// StatisticsDelta is period B minus period A for each compared statistic.
+type StatisticsDelta struct {
+	TotalTrades   int
+	Wins          int
+	Losses        int
+	BreakEven     int
+	WinRate       float64
+	TotalRealized float64
+	AvgRiskReward float64
+	AvgWin        float64
+	AvgLoss       float64
+	AvgPlannedRR  float64
+	AvgAchievedRR float64
+	RRDifference  float64
+	KellyFraction float64
+	RiskOfRuin    float64
+}
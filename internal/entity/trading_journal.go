@@ -1,33 +1,101 @@
 package entity
 
 import (
+	"strings"
 	"time"
 
 	"github.com/google/uuid"
 	"github.com/uptrace/bun"
+	"github.com/user/normark/internal/types"
 )
 
 type TradingJournal struct {
 	bun.BaseModel `bun:"table:trading_journals,alias:tj"`
 
-	ID          uuid.UUID `bun:"id,pk,type:uuid,default:gen_random_uuid()"`
-	UserID      uuid.UUID `bun:"user_id,notnull,type:uuid"`
-	Name        string    `bun:"name,notnull"`
-	Description string    `bun:"description,type:text"`
-	CreatedAt   time.Time `bun:"created_at,nullzero,notnull,default:current_timestamp"`
-	UpdatedAt   time.Time `bun:"updated_at,nullzero,notnull,default:current_timestamp"`
-	DeletedAt   time.Time `bun:"deleted_at,soft_delete,nullzero"`
+	ID                 uuid.UUID               `bun:"id,pk,type:uuid,default:gen_random_uuid()"`
+	UserID             uuid.UUID               `bun:"user_id,notnull,type:uuid"`
+	Name               string                  `bun:"name,notnull"`
+	Description        string                  `bun:"description,type:text"`
+	IsArchived         bool                    `bun:"is_archived,notnull"`
+	IsLocked           bool                    `bun:"is_locked,notnull"`
+	RequireNotesOnLoss bool                    `bun:"require_notes_on_loss,notnull"`
+	ValidationProfile  types.ValidationProfile `bun:"validation_profile,notnull,default:'standard'"`
+	Broker             *string                 `bun:"broker"`
+	AccountNumber      *string                 `bun:"account_number"`
+	PipValue           *float64                `bun:"pip_value,type:decimal(18,6)"`
+	DefaultAsset       *types.CurrencyPair     `bun:"default_asset"`
+	DefaultSession     *types.TradingSession   `bun:"default_session"`
+	Tags               []string                `bun:"tags,array,type:text[]"`
+	CreatedAt          time.Time               `bun:"created_at,nullzero,notnull,default:current_timestamp"`
+	UpdatedAt          time.Time               `bun:"updated_at,nullzero,notnull,default:current_timestamp"`
+	DeletedAt          time.Time               `bun:"deleted_at,soft_delete,nullzero"`
+
+	// FirstEntryDate and LastEntryDate span the journal's entries. They are
+	// computed on read and nil when the journal has no entries.
+	FirstEntryDate *time.Time `bun:"first_entry_date,scanonly"`
+	LastEntryDate  *time.Time `bun:"last_entry_date,scanonly"`
 
 	User    *User                  `bun:"rel:belongs-to,join:user_id=id"`
 	Entries []*TradingJournalEntry `bun:"rel:has-many,join:id=journal_id"`
+	Summary *JournalSummary        `bun:"rel:has-one,join:id=journal_id"`
 }
 
 func NewTradingJournal(userID uuid.UUID, name, description string) *TradingJournal {
 	return &TradingJournal{
-		UserID:      userID,
-		Name:        name,
-		Description: description,
+		UserID:            userID,
+		Name:              name,
+		Description:       description,
+		ValidationProfile: types.ValidationProfileStandard,
+	}
+}
+
+// futureDayTolerance is how far ahead of the server clock an entry's day may
+// be, so traders in time zones east of UTC can log the current day.
+const futureDayTolerance = 24 * time.Hour
+
+// CheckEntryPolicy reports whether entry satisfies the journal's rules for
+// its entries. Which consistency checks run depends on the validation
+// profile:
+//
+//   - lenient: none.
+//   - standard: the day is not in the future, and the result agrees with
+//     the realized profit: a take profit did not lose money and a stop loss
+//     did not make any.
+//   - strict: the standard checks, notes on every losing trade, and
+//     distinct LTF and HTF charts.
+//
+// With RequireNotesOnLoss, an entry that hit its stop loss or lost money
+// must have notes under any profile.
+func (tj *TradingJournal) CheckEntryPolicy(entry *TradingJournalEntry) error {
+	profile := tj.ValidationProfile
+	if profile == "" {
+		profile = types.ValidationProfileStandard
+	}
+
+	lost := entry.Result == types.TradeResultStopLoss || entry.IsLoss()
+	notesRequired := tj.RequireNotesOnLoss || profile == types.ValidationProfileStrict
+	if notesRequired && lost && strings.TrimSpace(entry.Notes) == "" {
+		return ErrNotesRequiredOnLoss
+	}
+
+	if profile == types.ValidationProfileLenient {
+		return nil
 	}
+
+	if entry.Day.After(time.Now().Add(futureDayTolerance)) {
+		return ErrFutureEntryDay
+	}
+
+	if (entry.Result == types.TradeResultTakeProfit && entry.Realized < 0) ||
+		(entry.Result == types.TradeResultStopLoss && entry.Realized > 0) {
+		return ErrResultContradictsRealized
+	}
+
+	if profile == types.ValidationProfileStrict && entry.LTF == entry.HTF {
+		return ErrIdenticalTimeframeCharts
+	}
+
+	return nil
 }
 
 func (tj *TradingJournal) Validate() error {
@@ -39,5 +107,53 @@ func (tj *TradingJournal) Validate() error {
 		return ErrInvalidJournalName
 	}
 
+	if tj.DefaultAsset != nil && !tj.DefaultAsset.IsValid() {
+		return ErrInvalidAsset
+	}
+
+	if tj.DefaultSession != nil && !tj.DefaultSession.IsValid() {
+		return ErrInvalidSession
+	}
+
+	if tj.ValidationProfile != "" && !tj.ValidationProfile.IsValid() {
+		return ErrInvalidValidationProfile
+	}
+
+	if tj.PipValue != nil && *tj.PipValue <= 0 {
+		return ErrInvalidPipValue
+	}
+
 	return nil
 }
+
+// ToPips converts an amount in the account currency to pips of the journal's
+// pip value, the account currency value of one pip. It returns nil when the
+// journal has no pip value.
+func (tj *TradingJournal) ToPips(amount float64) *float64 {
+	if tj.PipValue == nil || *tj.PipValue <= 0 {
+		return nil
+	}
+
+	pips := amount / *tj.PipValue
+	return &pips
+}
+
+// ImportPreview describes what importing a document would create.
+type ImportPreview struct {
+	JournalName string
+	// EntryCount is the number of entries that passed validation.
+	EntryCount int
+	Errors     []ImportRowError
+}
+
+type ImportRowError struct {
+	Index int
+	Err   error
+}
+
+// EntryImport is the outcome of importing entries into an existing journal.
+type EntryImport struct {
+	Entries []*TradingJournalEntry
+	// Errors holds the rows that were skipped.
+	Errors []ImportRowError
+}
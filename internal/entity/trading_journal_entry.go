@@ -1,6 +1,8 @@
 package entity
 
 import (
+	"math"
+	"strings"
 	"time"
 
 	"github.com/google/uuid"
@@ -8,28 +10,67 @@ import (
 	"github.com/user/normark/internal/types"
 )
 
+// Confidence is an optional pre-trade conviction score on a 1-5 scale.
+const (
+	MinConfidence = 1
+	MaxConfidence = 5
+)
+
+// MaxRelatedEntries caps how many entries one entry can be linked to.
+const MaxRelatedEntries = 20
+
+// MaxBulkUpdateEntries caps how many entries one bulk update may change.
+const MaxBulkUpdateEntries = 1000
+
+// Limits of an entry's pre-trade checklist.
+const (
+	MaxChecklistItems      = 30
+	MaxChecklistItemLength = 200
+)
+
 type TradingJournalEntry struct {
 	bun.BaseModel `bun:"table:trading_journal_entries,alias:tje"`
 
-	ID          uuid.UUID            `bun:"id,pk,type:uuid,default:gen_random_uuid()"`
-	JournalID   uuid.UUID            `bun:"journal_id,notnull,type:uuid"`
-	Day         time.Time            `bun:"day,notnull"`
-	Asset       types.CurrencyPair   `bun:"asset,notnull"`
-	LTF         string               `bun:"ltf,notnull"`
-	HTF         string               `bun:"htf,notnull"`
-	EntryCharts []string             `bun:"entry_charts,array,type:text[]"`
-	Session     types.TradingSession `bun:"session,notnull"`
-	TradeType   types.TradeType      `bun:"trade_type,notnull"`
-	Setup       *string              `bun:"setup,nullzero"`
-	Direction   types.TradeDirection `bun:"direction,notnull"`
-	EntryType   types.EntryType      `bun:"entry_type,notnull"`
-	Realized    float64              `bun:"realized,type:decimal(10,2),notnull"`
-	MaxRR       float64              `bun:"max_rr,type:decimal(10,2),notnull"`
-	Result      types.TradeResult    `bun:"result,notnull"`
-	Notes       string               `bun:"notes,type:text"`
-	CreatedAt   time.Time            `bun:"created_at,nullzero,notnull,default:current_timestamp"`
-	UpdatedAt   time.Time            `bun:"updated_at,nullzero,notnull,default:current_timestamp"`
-	DeletedAt   time.Time            `bun:"deleted_at,soft_delete,nullzero"`
+	ID              uuid.UUID             `bun:"id,pk,type:uuid,default:gen_random_uuid()"`
+	JournalID       uuid.UUID             `bun:"journal_id,notnull,type:uuid"`
+	Day             time.Time             `bun:"day,notnull"`
+	Asset           types.CurrencyPair    `bun:"asset,notnull"`
+	LTF             string                `bun:"ltf,notnull"`
+	HTF             string                `bun:"htf,notnull"`
+	EntryCharts     []string              `bun:"entry_charts,array,type:text[]"`
+	SetupCharts     []string              `bun:"setup_charts,array,type:text[]"`
+	Session         types.TradingSession  `bun:"session,notnull"`
+	TradeType       types.TradeType       `bun:"trade_type,notnull"`
+	Setup           *string               `bun:"setup,nullzero"`
+	Direction       types.TradeDirection  `bun:"direction,notnull"`
+	EntryType       types.EntryType       `bun:"entry_type,notnull"`
+	Realized        float64               `bun:"realized,type:decimal(10,2),notnull"`
+	MaxRR           float64               `bun:"max_rr,type:decimal(10,2),notnull"`
+	Result          types.TradeResult     `bun:"result,notnull"`
+	Notes           string                `bun:"notes,type:text"`
+	IsPinned        bool                  `bun:"is_pinned,notnull"`
+	Emotion         *types.Emotion        `bun:"emotion,nullzero"`
+	Confidence      *int                  `bun:"confidence,nullzero"`
+	ReviewStatus    types.ReviewStatus    `bun:"review_status,notnull"`
+	Grade           *types.TradeGrade     `bun:"grade,nullzero"`
+	FollowedPlan    bool                  `bun:"followed_plan,notnull"`
+	RiskPercent     float64               `bun:"risk_percent,type:decimal(5,2),notnull"`
+	PositionSize    float64               `bun:"position_size,type:decimal(14,4),notnull"`
+	EntryPrice      *float64              `bun:"entry_price,type:decimal(18,6)"`
+	StopLossPrice   *float64              `bun:"stop_loss_price,type:decimal(18,6)"`
+	TakeProfitPrice *float64              `bun:"take_profit_price,type:decimal(18,6)"`
+	Checklist       []types.ChecklistItem `bun:"checklist,type:jsonb,nullzero"`
+	// RelatedEntryIDs links correlated positions of the same journal, such
+	// as hedges or pyramided adds. Links are symmetric and only change
+	// through LinkEntries and UnlinkEntries.
+	RelatedEntryIDs []uuid.UUID `bun:"related_entry_ids,array,type:uuid[],nullzero"`
+	CreatedAt       time.Time   `bun:"created_at,nullzero,notnull,default:current_timestamp"`
+	UpdatedAt       time.Time   `bun:"updated_at,nullzero,notnull,default:current_timestamp"`
+	DeletedAt       time.Time   `bun:"deleted_at,soft_delete,nullzero"`
+
+	// RealizedPips is Realized in pips of the journal's pip value. It is
+	// computed on read and nil when the journal has no pip value.
+	RealizedPips *float64 `bun:"realized_pips,scanonly"`
 
 	Journal *TradingJournal `bun:"rel:belongs-to,join:journal_id=id"`
 }
@@ -50,21 +91,23 @@ func NewTradingJournalEntry(
 	notes string,
 ) *TradingJournalEntry {
 	return &TradingJournalEntry{
-		JournalID:   journalID,
-		Day:         day,
-		Asset:       asset,
-		LTF:         ltf,
-		HTF:         htf,
-		EntryCharts: entryCharts,
-		Session:     session,
-		TradeType:   tradeType,
-		Setup:       setup,
-		Direction:   direction,
-		EntryType:   entryType,
-		Realized:    realized,
-		MaxRR:       maxRR,
-		Result:      result,
-		Notes:       notes,
+		JournalID:    journalID,
+		Day:          day,
+		Asset:        asset,
+		LTF:          ltf,
+		HTF:          htf,
+		EntryCharts:  entryCharts,
+		Session:      session,
+		TradeType:    tradeType,
+		Setup:        setup,
+		Direction:    direction,
+		EntryType:    entryType,
+		Realized:     realized,
+		MaxRR:        maxRR,
+		Result:       result,
+		Notes:        notes,
+		ReviewStatus: types.ReviewStatusUnreviewed,
+		FollowedPlan: true,
 	}
 }
 
@@ -105,9 +148,102 @@ func (tje *TradingJournalEntry) Validate() error {
 		return ErrInvalidResult
 	}
 
+	if tje.Emotion != nil && !tje.Emotion.IsValid() {
+		return ErrInvalidEmotion
+	}
+
+	if tje.Confidence != nil && (*tje.Confidence < MinConfidence || *tje.Confidence > MaxConfidence) {
+		return ErrInvalidConfidence
+	}
+
+	if !tje.ReviewStatus.IsValid() {
+		return ErrInvalidReviewStatus
+	}
+
+	if tje.RiskPercent < 0 || tje.RiskPercent > 100 {
+		return ErrInvalidRiskPercent
+	}
+
+	if tje.PositionSize < 0 {
+		return ErrInvalidPositionSize
+	}
+
+	if err := tje.validateChecklist(); err != nil {
+		return err
+	}
+
+	return tje.validatePrices()
+}
+
+// validateChecklist checks that the checklist is within its limits and that
+// every item is named once, so per-item statistics count each entry once.
+func (tje *TradingJournalEntry) validateChecklist() error {
+	if len(tje.Checklist) > MaxChecklistItems {
+		return ErrInvalidChecklist
+	}
+
+	seen := make(map[string]bool, len(tje.Checklist))
+	for _, item := range tje.Checklist {
+		if strings.TrimSpace(item.Item) == "" || len(item.Item) > MaxChecklistItemLength || seen[item.Item] {
+			return ErrInvalidChecklist
+		}
+		seen[item.Item] = true
+	}
+
 	return nil
 }
 
+// validatePrices checks that the recorded price levels are positive and that
+// stop loss and take profit sit on the correct side of the entry for the
+// trade direction. Each check only runs when the prices it needs are set.
+func (tje *TradingJournalEntry) validatePrices() error {
+	for _, price := range []*float64{tje.EntryPrice, tje.StopLossPrice, tje.TakeProfitPrice} {
+		if price != nil && *price <= 0 {
+			return ErrInvalidPrice
+		}
+	}
+
+	if tje.EntryPrice == nil {
+		return nil
+	}
+
+	entry := *tje.EntryPrice
+
+	if tje.StopLossPrice != nil {
+		sl := *tje.StopLossPrice
+		if (tje.Direction == types.TradeDirectionBuy && sl >= entry) ||
+			(tje.Direction == types.TradeDirectionSell && sl <= entry) {
+			return ErrInvalidStopLoss
+		}
+	}
+
+	if tje.TakeProfitPrice != nil {
+		tp := *tje.TakeProfitPrice
+		if (tje.Direction == types.TradeDirectionBuy && tp <= entry) ||
+			(tje.Direction == types.TradeDirectionSell && tp >= entry) {
+			return ErrInvalidTakeProfit
+		}
+	}
+
+	return nil
+}
+
+// PlannedRR returns the reward-to-risk ratio implied by the entry, stop loss
+// and take profit prices, or nil when any of them is missing.
+func (tje *TradingJournalEntry) PlannedRR() *float64 {
+	if tje.EntryPrice == nil || tje.StopLossPrice == nil || tje.TakeProfitPrice == nil {
+		return nil
+	}
+
+	risk := math.Abs(*tje.EntryPrice - *tje.StopLossPrice)
+	if risk == 0 {
+		return nil
+	}
+
+	rr := math.Abs(*tje.TakeProfitPrice-*tje.EntryPrice) / risk
+	return &rr
+}
+
 func (tje *TradingJournalEntry) IsProfit() bool {
 	return tje.Realized > 0
 }
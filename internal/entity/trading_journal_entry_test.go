@@ -0,0 +1,242 @@
+package entity
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/user/normark/internal/types"
+)
+
+// newValidEntry returns an entry that passes Validate, for tests to break
+// one field at a time.
+func newValidEntry() *TradingJournalEntry {
+	return NewTradingJournalEntry(
+		uuid.New(),
+		time.Date(2026, 1, 5, 0, 0, 0, 0, time.UTC),
+		types.CurrencyPairEURUSD,
+		"https://charts.example.com/ltf",
+		"https://charts.example.com/htf",
+		nil,
+		types.TradingSessionLondon,
+		types.TradeTypeIntraday,
+		nil,
+		types.TradeDirectionBuy,
+		types.EntryTypeMarket,
+		150, 3,
+		types.TradeResultTakeProfit,
+		"",
+	)
+}
+
+func TestEntryValidateEmotion(t *testing.T) {
+	tests := []struct {
+		name    string
+		emotion *types.Emotion
+		wantErr error
+	}{
+		{"not recorded", nil, nil},
+		{"calm", ptr(types.EmotionCalm), nil},
+		{"greedy", ptr(types.EmotionGreedy), nil},
+		{"unknown", ptr(types.Emotion("bored")), ErrInvalidEmotion},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			entry := newValidEntry()
+			entry.Emotion = tt.emotion
+
+			if err := entry.Validate(); !errors.Is(err, tt.wantErr) {
+				t.Errorf("Validate() error = %v, want %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestEntryValidateConfidence(t *testing.T) {
+	tests := []struct {
+		name       string
+		confidence *int
+		wantErr    error
+	}{
+		{"not recorded", nil, nil},
+		{"lowest", ptr(MinConfidence), nil},
+		{"highest", ptr(MaxConfidence), nil},
+		{"zero", ptr(0), ErrInvalidConfidence},
+		{"above the scale", ptr(6), ErrInvalidConfidence},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			entry := newValidEntry()
+			entry.Confidence = tt.confidence
+
+			if err := entry.Validate(); !errors.Is(err, tt.wantErr) {
+				t.Errorf("Validate() error = %v, want %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestEntryValidateReviewStatus(t *testing.T) {
+	if got := newValidEntry().ReviewStatus; got != types.ReviewStatusUnreviewed {
+		t.Errorf("new entry review status = %q, want %q", got, types.ReviewStatusUnreviewed)
+	}
+
+	tests := []struct {
+		name    string
+		status  types.ReviewStatus
+		wantErr error
+	}{
+		{"unreviewed", types.ReviewStatusUnreviewed, nil},
+		{"reviewed", types.ReviewStatusReviewed, nil},
+		{"flagged", types.ReviewStatusFlagged, nil},
+		{"empty", "", ErrInvalidReviewStatus},
+		{"unknown", types.ReviewStatus("done"), ErrInvalidReviewStatus},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			entry := newValidEntry()
+			entry.ReviewStatus = tt.status
+
+			if err := entry.Validate(); !errors.Is(err, tt.wantErr) {
+				t.Errorf("Validate() error = %v, want %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestEntryValidateRiskAndSize(t *testing.T) {
+	tests := []struct {
+		name         string
+		riskPercent  float64
+		positionSize float64
+		wantErr      error
+	}{
+		{"not recorded", 0, 0, nil},
+		{"recorded", 1.5, 0.75, nil},
+		{"whole account", 100, 1, nil},
+		{"negative risk", -0.5, 1, ErrInvalidRiskPercent},
+		{"risk above 100", 100.01, 1, ErrInvalidRiskPercent},
+		{"negative size", 1, -0.1, ErrInvalidPositionSize},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			entry := newValidEntry()
+			entry.RiskPercent = tt.riskPercent
+			entry.PositionSize = tt.positionSize
+
+			if err := entry.Validate(); !errors.Is(err, tt.wantErr) {
+				t.Errorf("Validate() error = %v, want %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestEntryValidatePrices(t *testing.T) {
+	tests := []struct {
+		name      string
+		direction types.TradeDirection
+		entry     *float64
+		sl        *float64
+		tp        *float64
+		wantErr   error
+	}{
+		{"not recorded", types.TradeDirectionBuy, nil, nil, nil, nil},
+		{"buy", types.TradeDirectionBuy, ptr(1.10), ptr(1.09), ptr(1.13), nil},
+		{"sell", types.TradeDirectionSell, ptr(1.10), ptr(1.11), ptr(1.07), nil},
+		{"levels without entry", types.TradeDirectionBuy, nil, ptr(1.20), ptr(1.00), nil},
+		{"zero price", types.TradeDirectionBuy, ptr(1.10), ptr(0.0), nil, ErrInvalidPrice},
+		{"negative price", types.TradeDirectionBuy, nil, nil, ptr(-1.0), ErrInvalidPrice},
+		{"buy stop above entry", types.TradeDirectionBuy, ptr(1.10), ptr(1.11), nil, ErrInvalidStopLoss},
+		{"buy stop at entry", types.TradeDirectionBuy, ptr(1.10), ptr(1.10), nil, ErrInvalidStopLoss},
+		{"buy target below entry", types.TradeDirectionBuy, ptr(1.10), nil, ptr(1.09), ErrInvalidTakeProfit},
+		{"sell stop below entry", types.TradeDirectionSell, ptr(1.10), ptr(1.09), nil, ErrInvalidStopLoss},
+		{"sell target above entry", types.TradeDirectionSell, ptr(1.10), nil, ptr(1.11), ErrInvalidTakeProfit},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			entry := newValidEntry()
+			entry.Direction = tt.direction
+			entry.EntryPrice = tt.entry
+			entry.StopLossPrice = tt.sl
+			entry.TakeProfitPrice = tt.tp
+
+			if err := entry.Validate(); !errors.Is(err, tt.wantErr) {
+				t.Errorf("Validate() error = %v, want %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestEntryPlannedRR(t *testing.T) {
+	tests := []struct {
+		name  string
+		entry *float64
+		sl    *float64
+		tp    *float64
+		want  *float64
+	}{
+		{"buy", ptr(1.1000), ptr(1.0950), ptr(1.1150), ptr(3.0)},
+		{"sell", ptr(1.1000), ptr(1.1020), ptr(1.0970), ptr(1.5)},
+		{"missing take profit", ptr(1.1000), ptr(1.0950), nil, nil},
+		{"missing entry", nil, ptr(1.0950), ptr(1.1150), nil},
+		{"zero risk", ptr(1.1000), ptr(1.1000), ptr(1.1150), nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			entry := newValidEntry()
+			entry.EntryPrice = tt.entry
+			entry.StopLossPrice = tt.sl
+			entry.TakeProfitPrice = tt.tp
+
+			got := entry.PlannedRR()
+			if (got == nil) != (tt.want == nil) {
+				t.Fatalf("PlannedRR() = %v, want %v", got, tt.want)
+			}
+			if got != nil && math.Abs(*got-*tt.want) > 1e-9 {
+				t.Errorf("PlannedRR() = %v, want %v", *got, *tt.want)
+			}
+		})
+	}
+}
+
+func TestEntryValidateChecklist(t *testing.T) {
+	tooMany := make([]types.ChecklistItem, MaxChecklistItems+1)
+	for i := range tooMany {
+		tooMany[i] = types.ChecklistItem{Item: fmt.Sprintf("item %d", i)}
+	}
+
+	tests := []struct {
+		name      string
+		checklist []types.ChecklistItem
+		wantErr   error
+	}{
+		{"no checklist", nil, nil},
+		{"ticked and unticked items", []types.ChecklistItem{{Item: "HTF bias", Checked: true}, {Item: "News checked"}}, nil},
+		{"item at the length limit", []types.ChecklistItem{{Item: strings.Repeat("x", MaxChecklistItemLength)}}, nil},
+		{"blank item", []types.ChecklistItem{{Item: "  ", Checked: true}}, ErrInvalidChecklist},
+		{"item too long", []types.ChecklistItem{{Item: strings.Repeat("x", MaxChecklistItemLength+1)}}, ErrInvalidChecklist},
+		{"duplicate item", []types.ChecklistItem{{Item: "HTF bias", Checked: true}, {Item: "HTF bias"}}, ErrInvalidChecklist},
+		{"too many items", tooMany, ErrInvalidChecklist},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			entry := newValidEntry()
+			entry.Checklist = tt.checklist
+
+			if err := entry.Validate(); !errors.Is(err, tt.wantErr) {
+				t.Errorf("Validate() error = %v, want %v", err, tt.wantErr)
+			}
+		})
+	}
+}
@@ -0,0 +1,230 @@
+package entity
+
+import (
+	"math"
+	"testing"
+	"time"
+
+	"github.com/cockroachdb/errors"
+	"github.com/google/uuid"
+	"github.com/user/normark/internal/types"
+)
+
+func TestCheckEntryPolicyNotesOnLoss(t *testing.T) {
+	stopLoss := func(notes string) *TradingJournalEntry {
+		entry := newValidEntry()
+		entry.Result = types.TradeResultStopLoss
+		entry.Realized = -100
+		entry.Notes = notes
+		return entry
+	}
+	negativeBreakeven := func(notes string) *TradingJournalEntry {
+		entry := newValidEntry()
+		entry.Result = types.TradeResultBreakEven
+		entry.Realized = -5
+		entry.Notes = notes
+		return entry
+	}
+
+	tests := []struct {
+		name     string
+		required bool
+		profile  types.ValidationProfile
+		entry    *TradingJournalEntry
+		wantErr  bool
+	}{
+		{"stop loss without notes", true, types.ValidationProfileStandard, stopLoss(""), true},
+		{"negative realized without notes", true, types.ValidationProfileStandard, negativeBreakeven(""), true},
+		{"whitespace notes", true, types.ValidationProfileStandard, stopLoss("  \n\t"), true},
+		{"loss with notes", true, types.ValidationProfileStandard, stopLoss("moved stop too early"), false},
+		{"win without notes", true, types.ValidationProfileStandard, newValidEntry(), false},
+		{"lenient profile still requires notes", true, types.ValidationProfileLenient, stopLoss(""), true},
+		{"disabled", false, types.ValidationProfileStandard, stopLoss(""), false},
+		{"disabled lenient", false, types.ValidationProfileLenient, negativeBreakeven(""), false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			journal := NewTradingJournal(uuid.New(), "Journal", "")
+			journal.ValidationProfile = tt.profile
+			journal.RequireNotesOnLoss = tt.required
+
+			err := journal.CheckEntryPolicy(tt.entry)
+			if got := errors.Is(err, ErrNotesRequiredOnLoss); got != tt.wantErr {
+				t.Fatalf("CheckEntryPolicy() = %v, want ErrNotesRequiredOnLoss %v", err, tt.wantErr)
+			}
+			if !tt.wantErr && err != nil {
+				t.Fatalf("CheckEntryPolicy() = %v, want nil", err)
+			}
+		})
+	}
+}
+
+// TestCheckEntryPolicyProfiles runs the same borderline entries through
+// every validation profile.
+func TestCheckEntryPolicyProfiles(t *testing.T) {
+	futureDay := newValidEntry()
+	futureDay.Day = time.Now().Add(48 * time.Hour)
+
+	contradicting := newValidEntry()
+	contradicting.Realized = -20
+
+	identicalCharts := newValidEntry()
+	identicalCharts.HTF = identicalCharts.LTF
+
+	lossWithoutNotes := newValidEntry()
+	lossWithoutNotes.Result = types.TradeResultStopLoss
+	lossWithoutNotes.Realized = -100
+
+	entries := []struct {
+		name  string
+		entry *TradingJournalEntry
+		want  map[types.ValidationProfile]error
+	}{
+		{"clean entry", newValidEntry(), map[types.ValidationProfile]error{}},
+		{"future day", futureDay, map[types.ValidationProfile]error{
+			types.ValidationProfileStandard: ErrFutureEntryDay,
+			types.ValidationProfileStrict:   ErrFutureEntryDay,
+		}},
+		{"take profit with a loss", contradicting, map[types.ValidationProfile]error{
+			types.ValidationProfileStandard: ErrResultContradictsRealized,
+			types.ValidationProfileStrict:   ErrResultContradictsRealized,
+		}},
+		{"identical charts", identicalCharts, map[types.ValidationProfile]error{
+			types.ValidationProfileStrict: ErrIdenticalTimeframeCharts,
+		}},
+		{"loss without notes", lossWithoutNotes, map[types.ValidationProfile]error{
+			types.ValidationProfileStrict: ErrNotesRequiredOnLoss,
+		}},
+	}
+	profiles := []types.ValidationProfile{
+		types.ValidationProfileLenient,
+		types.ValidationProfileStandard,
+		types.ValidationProfileStrict,
+	}
+
+	for _, tt := range entries {
+		for _, profile := range profiles {
+			t.Run(tt.name+"/"+string(profile), func(t *testing.T) {
+				journal := NewTradingJournal(uuid.New(), "Journal", "")
+				journal.ValidationProfile = profile
+
+				err := journal.CheckEntryPolicy(tt.entry)
+				want := tt.want[profile]
+				if want == nil {
+					if err != nil {
+						t.Fatalf("CheckEntryPolicy() = %v, want nil", err)
+					}
+					return
+				}
+				if !errors.Is(err, want) {
+					t.Fatalf("CheckEntryPolicy() = %v, want %v", err, want)
+				}
+				if !errors.Is(err, ErrEntryPolicy) {
+					t.Errorf("CheckEntryPolicy() = %v, not classified as ErrEntryPolicy", err)
+				}
+			})
+		}
+	}
+
+	t.Run("unset profile is standard", func(t *testing.T) {
+		journal := NewTradingJournal(uuid.New(), "Journal", "")
+		journal.ValidationProfile = ""
+
+		if err := journal.CheckEntryPolicy(contradicting); !errors.Is(err, ErrResultContradictsRealized) {
+			t.Errorf("CheckEntryPolicy() = %v, want %v", err, ErrResultContradictsRealized)
+		}
+		if err := journal.CheckEntryPolicy(identicalCharts); err != nil {
+			t.Errorf("CheckEntryPolicy() = %v, want nil", err)
+		}
+	})
+}
+
+func TestTradingJournalValidateProfile(t *testing.T) {
+	tests := []struct {
+		profile types.ValidationProfile
+		wantErr error
+	}{
+		{"", nil},
+		{types.ValidationProfileLenient, nil},
+		{types.ValidationProfileStandard, nil},
+		{types.ValidationProfileStrict, nil},
+		{"paranoid", ErrInvalidValidationProfile},
+	}
+
+	for _, tt := range tests {
+		t.Run(string(tt.profile), func(t *testing.T) {
+			journal := NewTradingJournal(uuid.New(), "Journal", "")
+			journal.ValidationProfile = tt.profile
+
+			if err := journal.Validate(); !errors.Is(err, tt.wantErr) {
+				t.Errorf("Validate() = %v, want %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestNewTradingJournalDefaultsToStandardProfile(t *testing.T) {
+	journal := NewTradingJournal(uuid.New(), "Journal", "")
+	if journal.ValidationProfile != types.ValidationProfileStandard {
+		t.Errorf("ValidationProfile = %q, want %q", journal.ValidationProfile, types.ValidationProfileStandard)
+	}
+}
+
+func TestTradingJournalValidatePipValue(t *testing.T) {
+	tests := []struct {
+		name     string
+		pipValue *float64
+		wantErr  error
+	}{
+		{"unset", nil, nil},
+		{"positive", ptr(10.0), nil},
+		{"zero", ptr(0.0), ErrInvalidPipValue},
+		{"negative", ptr(-1.0), ErrInvalidPipValue},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			journal := NewTradingJournal(uuid.New(), "Journal", "")
+			journal.PipValue = tt.pipValue
+
+			if err := journal.Validate(); !errors.Is(err, tt.wantErr) {
+				t.Errorf("Validate() = %v, want %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestTradingJournalToPips(t *testing.T) {
+	tests := []struct {
+		name     string
+		pipValue *float64
+		amount   float64
+		want     *float64
+	}{
+		{"profit", ptr(10.0), 150, ptr(15.0)},
+		{"loss", ptr(10.0), -45, ptr(-4.5)},
+		{"fractional pip value", ptr(0.1), 2, ptr(20.0)},
+		{"no pip value", nil, 150, nil},
+		{"zero pip value", ptr(0.0), 150, nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			journal := NewTradingJournal(uuid.New(), "Journal", "")
+			journal.PipValue = tt.pipValue
+
+			got := journal.ToPips(tt.amount)
+			if (got == nil) != (tt.want == nil) || (got != nil && math.Abs(*got-*tt.want) > 1e-9) {
+				t.Errorf("ToPips(%v) = %v, want %v", tt.amount, deref(got), deref(tt.want))
+			}
+		})
+	}
+}
+
+func deref(f *float64) any {
+	if f == nil {
+		return nil
+	}
+	return *f
+}
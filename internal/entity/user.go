@@ -10,16 +10,26 @@ import (
 	"golang.org/x/crypto/bcrypt"
 )
 
+// AuthProvider is how a user proves their identity. Accounts created through
+// an OAuth provider have no password.
+type AuthProvider string
+
+const (
+	AuthProviderPassword AuthProvider = "password"
+	AuthProviderGoogle   AuthProvider = "google"
+)
+
 type User struct {
 	bun.BaseModel `bun:"table:users,alias:u"`
 
-	ID        uuid.UUID `bun:"id,pk,type:uuid,default:gen_random_uuid()"`
-	Email     string    `bun:"email,notnull,unique"`
-	Username  string    `bun:"username,notnull,unique"`
-	Password  string    `bun:"password,notnull"`
-	CreatedAt time.Time `bun:"created_at,nullzero,notnull,default:current_timestamp"`
-	UpdatedAt time.Time `bun:"updated_at,nullzero,notnull,default:current_timestamp"`
-	DeletedAt time.Time `bun:"deleted_at,soft_delete,nullzero"`
+	ID        uuid.UUID    `bun:"id,pk,type:uuid,default:gen_random_uuid()"`
+	Email     string       `bun:"email,notnull,unique"`
+	Username  string       `bun:"username,notnull,unique"`
+	Password  string       `bun:"password,notnull"`
+	Provider  AuthProvider `bun:"provider,notnull,default:'password'"`
+	CreatedAt time.Time    `bun:"created_at,nullzero,notnull,default:current_timestamp"`
+	UpdatedAt time.Time    `bun:"updated_at,nullzero,notnull,default:current_timestamp"`
+	DeletedAt time.Time    `bun:"deleted_at,soft_delete,nullzero"`
 }
 
 func NewUserFromSignUp(req *dto.SignUpRequest) (*User, error) {
@@ -32,16 +42,31 @@ func NewUserFromSignUp(req *dto.SignUpRequest) (*User, error) {
 		Email:    req.Email,
 		Username: req.Username,
 		Password: string(hashedPassword),
+		Provider: AuthProviderPassword,
 	}
 
 	return user, nil
 }
 
+// NewUserFromOAuth creates an account for a user signing in through
+// provider. It has no password, so password sign-in is refused for it.
+func NewUserFromOAuth(provider AuthProvider, email, username string) *User {
+	return &User{
+		Email:    email,
+		Username: username,
+		Provider: provider,
+	}
+}
+
 func (u *User) ComparePassword(password string) error {
+	if u.Provider != "" && u.Provider != AuthProviderPassword {
+		return errors.Newf("account signs in with %s", u.Provider)
+	}
+
 	err := bcrypt.CompareHashAndPassword([]byte(u.Password), []byte(password))
 	if err != nil {
 		return errors.Wrap(err, "invalid password")
 	}
-	
+
 	return nil
 }
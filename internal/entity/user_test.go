@@ -0,0 +1,51 @@
+package entity
+
+import (
+	"testing"
+
+	"github.com/user/normark/internal/dto"
+)
+
+func TestNewUserFromOAuth(t *testing.T) {
+	user := NewUserFromOAuth(AuthProviderGoogle, "trader@example.com", "trader")
+
+	if user.Provider != AuthProviderGoogle {
+		t.Errorf("Provider = %q, want %q", user.Provider, AuthProviderGoogle)
+	}
+	if user.Email != "trader@example.com" || user.Username != "trader" {
+		t.Errorf("Email, Username = %q, %q, want %q, %q", user.Email, user.Username, "trader@example.com", "trader")
+	}
+	if user.Password != "" {
+		t.Errorf("Password = %q, want empty", user.Password)
+	}
+}
+
+func TestComparePassword(t *testing.T) {
+	passwordUser, err := NewUserFromSignUp(&dto.SignUpRequest{Email: "trader@example.com", Username: "trader", Password: "password123"})
+	if err != nil {
+		t.Fatalf("NewUserFromSignUp() error = %v", err)
+	}
+	legacyUser := *passwordUser
+	legacyUser.Provider = ""
+
+	tests := []struct {
+		name     string
+		user     *User
+		password string
+		wantErr  bool
+	}{
+		{"password account", passwordUser, "password123", false},
+		{"wrong password", passwordUser, "password124", true},
+		{"account from before providers", &legacyUser, "password123", false},
+		{"google account", NewUserFromOAuth(AuthProviderGoogle, "trader@example.com", "trader"), "", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.user.ComparePassword(tt.password)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("ComparePassword() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
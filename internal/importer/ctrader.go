@@ -0,0 +1,99 @@
+package importer
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/cockroachdb/errors"
+)
+
+// cTrader writes times as day/month/year, with "." instead of "/" in some
+// locales and with or without milliseconds.
+var ctraderTimeLayouts = []string{
+	"02/01/2006 15:04:05.000",
+	"02/01/2006 15:04:05",
+	"02/01/2006 15:04",
+	"02.01.2006 15:04:05.000",
+	"02.01.2006 15:04:05",
+	"02.01.2006 15:04",
+}
+
+// parseCTrader reads a cTrader position history export, one closed position
+// per row. Headers carry the account currency and time zone ("Net USD",
+// "Opening time (UTC+0)"), which column matching ignores; times are taken to
+// be UTC, the platform default.
+func parseCTrader(r io.Reader, opts Options) ([]Row, error) {
+	f, err := openCSV(r)
+	if err != nil {
+		return nil, err
+	}
+
+	cols, err := f.columns(
+		[]string{"symbol"},
+		[]string{"opening direction", "direction"},
+		[]string{"opening time", "open time"},
+		[]string{"closing time", "close time"},
+		[]string{"net", "net profit"},
+	)
+	if err != nil {
+		return nil, err
+	}
+	symbolCol, directionCol, openedCol, closedCol, netCol := cols[0], cols[1], cols[2], cols[3], cols[4]
+	idCol := f.column("id", "position id")
+	quantityCol := f.column("closing quantity", "quantity", "volume")
+	entryPriceCol := f.column("entry price", "opening price")
+
+	var rows []Row
+	for {
+		record, line, err := f.next()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		row := Row{Line: line}
+		row.Err = func() error {
+			asset, err := parseSymbol(field(record, symbolCol))
+			if err != nil {
+				return err
+			}
+			direction, err := parseDirection(field(record, directionCol))
+			if err != nil {
+				return err
+			}
+			openedAt, err := parseTime(field(record, openedCol), ctraderTimeLayouts)
+			if err != nil {
+				return errors.Wrap(err, "opening time")
+			}
+			closedAt, err := parseTime(field(record, closedCol), ctraderTimeLayouts)
+			if err != nil {
+				return errors.Wrap(err, "closing time")
+			}
+			net, err := parseNumber(field(record, netCol))
+			if err != nil {
+				return errors.Wrap(err, "net profit")
+			}
+
+			req := newRequest(opts, asset, direction, openedAt, closedAt, net)
+			if quantity, err := parseNumber(field(record, quantityCol)); err == nil {
+				req.PositionSize = quantity
+			}
+			if price, err := parseNumber(field(record, entryPriceCol)); err == nil && price > 0 {
+				req.EntryPrice = &price
+			}
+			req.Notes = "Imported from cTrader"
+			if id := field(record, idCol); id != "" {
+				req.Notes = fmt.Sprintf("Imported from cTrader (position %s)", id)
+			}
+
+			row.Request = req
+			return nil
+		}()
+
+		rows = append(rows, row)
+	}
+
+	return rows, nil
+}
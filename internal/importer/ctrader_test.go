@@ -0,0 +1,104 @@
+package importer
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/cockroachdb/errors"
+	"github.com/user/normark/internal/entity"
+	"github.com/user/normark/internal/types"
+)
+
+const ctraderExport = `ID,Symbol,Opening Direction,Opening Time (UTC+0),Closing Time (UTC+0),Entry price,Closing Quantity,Net USD
+1001,EURUSD,Buy,03/02/2026 08:15:00.000,03/02/2026 10:40:12.000,1.08512,0.50 Lots,"1,250.40"
+1002,GBPJPY.m,Sell,04/02/2026 22:05,05/02/2026 03:00,190.115,1.00 Lots,-75.10
+1003,BTCUSD,Buy,05/02/2026 09:00,05/02/2026 09:30,60000,0.10 Lots,12.00
+1004,EURUSD,Buy,2026-02-06 09:00,06/02/2026 10:00,1.08,0.10 Lots,5.00
+`
+
+func TestParseCTrader(t *testing.T) {
+	rows, err := Parse(SourceCTrader, strings.NewReader(ctraderExport), testOptions)
+	if err != nil {
+		t.Fatalf("Parse() error = %v", err)
+	}
+	if len(rows) != 4 {
+		t.Fatalf("got %d rows, want 4", len(rows))
+	}
+
+	first := rows[0]
+	if first.Err != nil || first.Line != 2 {
+		t.Fatalf("row 1: line %d, error %v; want line 2 mapped", first.Line, first.Err)
+	}
+	req := first.Request
+	if req.Asset != types.CurrencyPairEURUSD || req.Direction != types.TradeDirectionBuy {
+		t.Errorf("row 1: asset %s, direction %s; want EURUSD buy", req.Asset, req.Direction)
+	}
+	if want := time.Date(2026, 2, 3, 8, 15, 0, 0, time.UTC); !req.Day.Equal(want) {
+		t.Errorf("row 1: day %v, want %v (day/month/year)", req.Day, want)
+	}
+	if req.Realized != 1250.40 || req.Result != types.TradeResultTakeProfit {
+		t.Errorf("row 1: realized %v, result %s; want 1250.40 TP", req.Realized, req.Result)
+	}
+	if req.PositionSize != 0.5 || req.EntryPrice == nil || *req.EntryPrice != 1.08512 {
+		t.Errorf("row 1: size %v, entry price %v; want 0.5 at 1.08512", req.PositionSize, req.EntryPrice)
+	}
+	if req.Session != types.TradingSessionLondon || req.TradeType != types.TradeTypeIntraday {
+		t.Errorf("row 1: session %s, type %s; want london intraday", req.Session, req.TradeType)
+	}
+	if req.Notes != "Imported from cTrader (position 1001)" {
+		t.Errorf("row 1: notes %q", req.Notes)
+	}
+
+	second := rows[1].Request
+	if rows[1].Err != nil || second.Asset != types.CurrencyPairGBPJPY || second.Direction != types.TradeDirectionSell {
+		t.Fatalf("row 2: %+v, error %v; want a GBPJPY sell", second, rows[1].Err)
+	}
+	if second.TradeType != types.TradeTypeSwing || second.Result != types.TradeResultStopLoss || second.Session != types.TradingSessionAsia {
+		t.Errorf("row 2: type %s, result %s, session %s; want swing SL asia", second.TradeType, second.Result, second.Session)
+	}
+
+	for i, wantErr := range map[int]string{2: "unsupported symbol", 3: "opening time"} {
+		row := rows[i]
+		if row.Request != nil || row.Err == nil || !strings.Contains(row.Err.Error(), wantErr) {
+			t.Errorf("row %d: request %v, error %v; want an error mentioning %q", i+1, row.Request, row.Err, wantErr)
+		}
+		if row.Line != i+2 {
+			t.Errorf("row %d: line %d, want %d", i+1, row.Line, i+2)
+		}
+	}
+}
+
+func TestParseCTraderSemicolonSeparated(t *testing.T) {
+	export := "Symbol;Direction;Open time;Close time;Net profit\n" +
+		"EURUSD;Sell;03.02.2026 14:00:00;03.02.2026 15:00:00;-1,5\n" +
+		"GBPUSD;Buy;04.02.2026 07:30;04.02.2026 08:00;20\n"
+
+	rows, err := Parse(SourceCTrader, strings.NewReader(export), testOptions)
+	if err != nil {
+		t.Fatalf("Parse() error = %v", err)
+	}
+	if len(rows) != 2 {
+		t.Fatalf("got %d rows, want 2", len(rows))
+	}
+	for i, row := range rows {
+		if row.Err != nil {
+			t.Fatalf("row %d: error %v", i+1, row.Err)
+		}
+	}
+	if rows[1].Request.Asset != types.CurrencyPairGBPUSD || rows[1].Request.Realized != 20 {
+		t.Errorf("row 2: %s %v, want GBPUSD 20", rows[1].Request.Asset, rows[1].Request.Realized)
+	}
+	if rows[0].Request.Notes != "Imported from cTrader" {
+		t.Errorf("row 1 notes = %q, want no position number", rows[0].Request.Notes)
+	}
+}
+
+func TestParseCTraderMissingColumn(t *testing.T) {
+	export := "Symbol,Opening Direction,Opening Time,Closing Time\nEURUSD,Buy,03/02/2026 08:15,03/02/2026 09:15\n"
+
+	_, err := Parse(SourceCTrader, strings.NewReader(export), testOptions)
+	if !errors.Is(err, entity.ErrInvalidImportFile) || !strings.Contains(err.Error(), `"net"`) {
+		t.Errorf("Parse() error = %v, want ErrInvalidImportFile naming the net column", err)
+	}
+}
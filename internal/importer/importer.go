@@ -0,0 +1,261 @@
+// Package importer maps trade history exported by trading platforms to entry
+// create requests. Platform exports carry no chart links or planned RR, so
+// those come from Options and apply to every row; the rest is derived from
+// each row.
+package importer
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/csv"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/cockroachdb/errors"
+	"github.com/user/normark/internal/dto"
+	"github.com/user/normark/internal/entity"
+	"github.com/user/normark/internal/types"
+)
+
+// Source identifies the platform an export comes from.
+type Source string
+
+const (
+	SourceCTrader     Source = "ctrader"
+	SourceTradingView Source = "tradingview"
+)
+
+// Options holds the entry fields platform exports don't have.
+type Options struct {
+	LTF   string
+	HTF   string
+	MaxRR float64
+	// Asset is used for rows without a symbol; TradingView strategy exports
+	// name the symbol only in the file name.
+	Asset types.CurrencyPair
+}
+
+// Row is one trade of an export. Exactly one of Request and Err is set.
+type Row struct {
+	// Line is the 1-based line of the file the trade starts on.
+	Line    int
+	Request *dto.CreateTradingJournalEntryRequest
+	// Err explains why the trade could not be mapped.
+	Err error
+}
+
+// Parse reads a CSV export from source. Trades that cannot be mapped are
+// returned with Err set; an error is returned only if the file as a whole is
+// unusable.
+func Parse(source Source, r io.Reader, opts Options) ([]Row, error) {
+	switch source {
+	case SourceCTrader:
+		return parseCTrader(r, opts)
+	case SourceTradingView:
+		return parseTradingView(r, opts)
+	}
+
+	return nil, errors.Wrapf(entity.ErrUnsupportedImportSource, "%q", source)
+}
+
+// newRequest fills the fields common to every source. Session and trade type
+// follow from the open and close times, and the result from the sign of the
+// net profit.
+func newRequest(opts Options, asset types.CurrencyPair, direction types.TradeDirection, openedAt, closedAt time.Time, profit float64) *dto.CreateTradingJournalEntryRequest {
+	tradeType := types.TradeTypeSwing
+	if openedAt.Format(time.DateOnly) == closedAt.Format(time.DateOnly) {
+		tradeType = types.TradeTypeIntraday
+	}
+
+	return &dto.CreateTradingJournalEntryRequest{
+		Day:       openedAt,
+		Asset:     asset,
+		LTF:       opts.LTF,
+		HTF:       opts.HTF,
+		Session:   sessionAt(openedAt),
+		TradeType: tradeType,
+		Direction: direction,
+		EntryType: types.EntryTypeMarket,
+		Realized:  profit,
+		MaxRR:     opts.MaxRR,
+		Result:    resultOf(profit),
+	}
+}
+
+// sessionAt returns the session a trade opened at t (UTC) belongs to. The
+// London/New York overlap counts as New York.
+func sessionAt(t time.Time) types.TradingSession {
+	switch h := t.Hour(); {
+	case h >= 7 && h < 12:
+		return types.TradingSessionLondon
+	case h >= 12 && h < 21:
+		return types.TradingSessionNewYork
+	}
+
+	return types.TradingSessionAsia
+}
+
+func resultOf(profit float64) types.TradeResult {
+	switch {
+	case profit > 0:
+		return types.TradeResultTakeProfit
+	case profit < 0:
+		return types.TradeResultStopLoss
+	}
+
+	return types.TradeResultBreakEven
+}
+
+// parseSymbol maps a platform symbol to a currency pair. It drops exchange
+// prefixes ("OANDA:EURUSD") and broker suffixes ("EURUSD.m", "EURUSDpro").
+func parseSymbol(s string) (types.CurrencyPair, error) {
+	if i := strings.LastIndexByte(s, ':'); i >= 0 {
+		s = s[i+1:]
+	}
+
+	pair := types.NormalizeCurrencyPair(s)
+	if !pair.IsValid() && len(pair) > 6 {
+		pair = pair[:6]
+	}
+
+	if !pair.IsValid() {
+		return "", errors.Newf("unsupported symbol %q", s)
+	}
+
+	return pair, nil
+}
+
+func parseDirection(s string) (types.TradeDirection, error) {
+	switch strings.ToLower(strings.TrimSpace(s)) {
+	case "buy", "long":
+		return types.TradeDirectionBuy, nil
+	case "sell", "short":
+		return types.TradeDirectionSell, nil
+	}
+
+	return "", errors.Newf("unknown direction %q", s)
+}
+
+// parseTime parses s with the first matching layout, as UTC.
+func parseTime(s string, layouts []string) (time.Time, error) {
+	s = strings.TrimSpace(s)
+	for _, layout := range layouts {
+		if t, err := time.ParseInLocation(layout, s, time.UTC); err == nil {
+			return t.UTC(), nil
+		}
+	}
+
+	return time.Time{}, errors.Newf("unrecognized date %q", s)
+}
+
+// parseNumber parses amounts such as "1,234.56", "-12.30" or "0.10 Lots".
+func parseNumber(s string) (float64, error) {
+	fields := strings.Fields(strings.ReplaceAll(s, ",", ""))
+	if len(fields) == 0 {
+		return 0, errors.New("missing number")
+	}
+
+	n, err := strconv.ParseFloat(fields[0], 64)
+	if err != nil {
+		return 0, errors.Newf("invalid number %q", s)
+	}
+
+	return n, nil
+}
+
+// csvFile is a CSV export with its header already read.
+type csvFile struct {
+	reader  *csv.Reader
+	headers []string
+}
+
+// openCSV reads the header of an export. Some platforms write semicolon
+// separated files depending on locale, so the delimiter is taken from the
+// header line.
+func openCSV(r io.Reader) (*csvFile, error) {
+	br := bufio.NewReader(r)
+	first, err := br.Peek(br.Size())
+	if err != nil && !errors.Is(err, io.EOF) && !errors.Is(err, bufio.ErrBufferFull) {
+		return nil, errors.Wrap(entity.ErrInvalidImportFile, err.Error())
+	}
+	if i := bytes.IndexByte(first, '\n'); i >= 0 {
+		first = first[:i]
+	}
+
+	reader := csv.NewReader(br)
+	reader.FieldsPerRecord = -1
+	reader.TrimLeadingSpace = true
+	if bytes.Count(first, []byte{';'}) > bytes.Count(first, []byte{','}) {
+		reader.Comma = ';'
+	}
+
+	headers, err := reader.Read()
+	if err != nil {
+		return nil, errors.Wrap(entity.ErrInvalidImportFile, "missing header row")
+	}
+
+	for i, h := range headers {
+		headers[i] = strings.ToLower(strings.TrimSpace(strings.TrimPrefix(h, "\ufeff")))
+	}
+
+	return &csvFile{reader: reader, headers: headers}, nil
+}
+
+// column returns the index of the first header matching one of names, or -1.
+// A header matches when it equals the name or extends it with a unit or time
+// zone, so "net" matches "Net USD" and "opening time" matches
+// "Opening time (UTC+0)", but "profit" does not match "Profit %".
+func (f *csvFile) column(names ...string) int {
+	for _, name := range names {
+		for i, h := range f.headers {
+			if h == name {
+				return i
+			}
+			if rest, ok := strings.CutPrefix(h, name+" "); ok && rest != "%" {
+				return i
+			}
+		}
+	}
+
+	return -1
+}
+
+// columns resolves required columns, each given as a list of alternative
+// names, and fails naming the first one that is missing.
+func (f *csvFile) columns(required ...[]string) ([]int, error) {
+	idx := make([]int, len(required))
+	for i, names := range required {
+		idx[i] = f.column(names...)
+		if idx[i] < 0 {
+			return nil, errors.Wrapf(entity.ErrInvalidImportFile, "missing column %q", names[0])
+		}
+	}
+
+	return idx, nil
+}
+
+// next returns the next record and the line it starts on, or io.EOF.
+func (f *csvFile) next() ([]string, int, error) {
+	record, err := f.reader.Read()
+	if err != nil {
+		if errors.Is(err, io.EOF) {
+			return nil, 0, io.EOF
+		}
+		return nil, 0, errors.Wrap(entity.ErrInvalidImportFile, err.Error())
+	}
+
+	line, _ := f.reader.FieldPos(0)
+	return record, line, nil
+}
+
+// field returns the trimmed value of column i, or "" if the record is short
+// or the column is absent.
+func field(record []string, i int) string {
+	if i < 0 || i >= len(record) {
+		return ""
+	}
+
+	return strings.TrimSpace(record[i])
+}
@@ -0,0 +1,160 @@
+package importer
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/cockroachdb/errors"
+	"github.com/user/normark/internal/entity"
+	"github.com/user/normark/internal/types"
+)
+
+var testOptions = Options{
+	LTF:   "https://charts.example.com/ltf",
+	HTF:   "https://charts.example.com/htf",
+	MaxRR: 3,
+}
+
+func TestParseRejectsUnknownSource(t *testing.T) {
+	_, err := Parse(Source("metatrader"), strings.NewReader("Symbol\n"), testOptions)
+	if !errors.Is(err, entity.ErrUnsupportedImportSource) {
+		t.Errorf("Parse() error = %v, want ErrUnsupportedImportSource", err)
+	}
+}
+
+func TestParseSymbol(t *testing.T) {
+	tests := []struct {
+		symbol  string
+		want    types.CurrencyPair
+		wantErr bool
+	}{
+		{"EURUSD", types.CurrencyPairEURUSD, false},
+		{"OANDA:EURUSD", types.CurrencyPairEURUSD, false},
+		{"FX:GBPUSD", types.CurrencyPairGBPUSD, false},
+		{"EURUSD.m", types.CurrencyPairEURUSD, false},
+		{"GBPJPYpro", types.CurrencyPairGBPJPY, false},
+		{"EUR/USD", types.CurrencyPairEURUSD, false},
+		{"BTCUSD", "", true},
+		{"", "", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.symbol, func(t *testing.T) {
+			got, err := parseSymbol(tt.symbol)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("parseSymbol(%q) error = %v, want error %v", tt.symbol, err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("parseSymbol(%q) = %q, want %q", tt.symbol, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestParseDirection(t *testing.T) {
+	tests := []struct {
+		input   string
+		want    types.TradeDirection
+		wantErr bool
+	}{
+		{"Buy", types.TradeDirectionBuy, false},
+		{" long ", types.TradeDirectionBuy, false},
+		{"SELL", types.TradeDirectionSell, false},
+		{"Short", types.TradeDirectionSell, false},
+		{"hedge", "", true},
+	}
+
+	for _, tt := range tests {
+		got, err := parseDirection(tt.input)
+		if (err != nil) != tt.wantErr || got != tt.want {
+			t.Errorf("parseDirection(%q) = %q, %v; want %q, error %v", tt.input, got, err, tt.want, tt.wantErr)
+		}
+	}
+}
+
+func TestParseNumber(t *testing.T) {
+	tests := []struct {
+		input   string
+		want    float64
+		wantErr bool
+	}{
+		{"12.30", 12.30, false},
+		{"-12.30", -12.30, false},
+		{"1,234.56", 1234.56, false},
+		{"0.10 Lots", 0.10, false},
+		{"", 0, true},
+		{"n/a", 0, true},
+	}
+
+	for _, tt := range tests {
+		got, err := parseNumber(tt.input)
+		if (err != nil) != tt.wantErr || got != tt.want {
+			t.Errorf("parseNumber(%q) = %v, %v; want %v, error %v", tt.input, got, err, tt.want, tt.wantErr)
+		}
+	}
+}
+
+func TestNewRequestDerivesSessionTypeAndResult(t *testing.T) {
+	day := func(d, h int) time.Time { return time.Date(2026, 3, d, h, 30, 0, 0, time.UTC) }
+
+	tests := []struct {
+		name        string
+		opened      time.Time
+		closed      time.Time
+		profit      float64
+		wantSession types.TradingSession
+		wantType    types.TradeType
+		wantResult  types.TradeResult
+	}{
+		{"asia intraday win", day(2, 3), day(2, 5), 40, types.TradingSessionAsia, types.TradeTypeIntraday, types.TradeResultTakeProfit},
+		{"london swing loss", day(2, 8), day(4, 10), -25.5, types.TradingSessionLondon, types.TradeTypeSwing, types.TradeResultStopLoss},
+		{"overlap counts as new york", day(2, 13), day(2, 14), 0, types.TradingSessionNewYork, types.TradeTypeIntraday, types.TradeResultBreakEven},
+		{"late evening is asia", day(2, 22), day(3, 1), 10, types.TradingSessionAsia, types.TradeTypeSwing, types.TradeResultTakeProfit},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := newRequest(testOptions, types.CurrencyPairEURUSD, types.TradeDirectionBuy, tt.opened, tt.closed, tt.profit)
+			if req.Session != tt.wantSession || req.TradeType != tt.wantType || req.Result != tt.wantResult {
+				t.Errorf("session, type, result = %s, %s, %s; want %s, %s, %s",
+					req.Session, req.TradeType, req.Result, tt.wantSession, tt.wantType, tt.wantResult)
+			}
+			if req.LTF != testOptions.LTF || req.HTF != testOptions.HTF || req.MaxRR != testOptions.MaxRR {
+				t.Errorf("options not applied: ltf %q, htf %q, max rr %v", req.LTF, req.HTF, req.MaxRR)
+			}
+			if !req.Day.Equal(tt.opened) || req.Realized != tt.profit || req.EntryType != types.EntryTypeMarket {
+				t.Errorf("day %v, realized %v, entry type %s; want %v, %v, market", req.Day, req.Realized, req.EntryType, tt.opened, tt.profit)
+			}
+		})
+	}
+}
+
+func TestColumnMatchesUnitsButNotPercentages(t *testing.T) {
+	f, err := openCSV(strings.NewReader("\ufeffProfit %,Net USD,Opening time (UTC+0),Profit\n"))
+	if err != nil {
+		t.Fatalf("openCSV() error = %v", err)
+	}
+
+	tests := []struct {
+		name string
+		want int
+	}{
+		{"net", 1},
+		{"opening time", 2},
+		{"profit", 3},
+		{"symbol", -1},
+	}
+	for _, tt := range tests {
+		if got := f.column(tt.name); got != tt.want {
+			t.Errorf("column(%q) = %d, want %d", tt.name, got, tt.want)
+		}
+	}
+}
+
+func TestOpenCSVRejectsEmptyFile(t *testing.T) {
+	_, err := openCSV(strings.NewReader(""))
+	if !errors.Is(err, entity.ErrInvalidImportFile) {
+		t.Errorf("openCSV() error = %v, want ErrInvalidImportFile", err)
+	}
+}
@@ -0,0 +1,147 @@
+package importer
+
+import (
+	"fmt"
+	"io"
+	"strings"
+	"time"
+
+	"github.com/cockroachdb/errors"
+)
+
+var tradingViewTimeLayouts = []string{
+	"2006-01-02 15:04",
+	"2006-01-02 15:04:05",
+	time.RFC3339,
+}
+
+// tradingViewTrade collects the entry and exit rows of one trade.
+type tradingViewTrade struct {
+	number string
+	line   int
+	entry  []string
+	exit   []string
+}
+
+// parseTradingView reads the "List of trades" export of the TradingView
+// strategy tester. Every trade spans two rows sharing a trade number, typed
+// "Entry Long"/"Exit Long" (or Short); the exit row carries the profit. The
+// export has no symbol column, so rows fall back to opts.Asset unless one is
+// present.
+func parseTradingView(r io.Reader, opts Options) ([]Row, error) {
+	f, err := openCSV(r)
+	if err != nil {
+		return nil, err
+	}
+
+	cols, err := f.columns(
+		[]string{"trade #", "trade"},
+		[]string{"type"},
+		[]string{"date/time", "date and time"},
+		[]string{"profit", "net p&l"},
+	)
+	if err != nil {
+		return nil, err
+	}
+	numberCol, typeCol, timeCol, profitCol := cols[0], cols[1], cols[2], cols[3]
+	symbolCol := f.column("symbol")
+	priceCol := f.column("price")
+	quantityCol := f.column("contracts", "position size", "quantity", "qty")
+
+	// Exports list trades newest first with the exit row before the entry
+	// row, so rows are grouped by trade number before mapping.
+	var trades []*tradingViewTrade
+	byNumber := make(map[string]*tradingViewTrade)
+	for {
+		record, line, err := f.next()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		number := field(record, numberCol)
+		trade, ok := byNumber[number]
+		if !ok {
+			trade = &tradingViewTrade{number: number, line: line}
+			byNumber[number] = trade
+			trades = append(trades, trade)
+		}
+
+		kind := strings.ToLower(field(record, typeCol))
+		switch {
+		case strings.HasPrefix(kind, "entry"):
+			trade.entry = record
+		case strings.HasPrefix(kind, "exit"):
+			trade.exit = record
+		}
+	}
+
+	rows := make([]Row, 0, len(trades))
+	for _, trade := range trades {
+		row := Row{Line: trade.line}
+		row.Err = func() error {
+			if trade.number == "" {
+				return errors.New("missing trade number")
+			}
+			if trade.entry == nil {
+				return errors.New("missing entry row")
+			}
+			if trade.exit == nil {
+				return errors.New("trade is still open")
+			}
+
+			asset := opts.Asset
+			if symbol := field(trade.entry, symbolCol); symbol != "" {
+				pair, err := parseSymbol(symbol)
+				if err != nil {
+					return err
+				}
+				asset = pair
+			}
+			if asset == "" {
+				return errors.New("missing symbol")
+			}
+
+			// "Entry Long" -> "long"
+			kind := strings.Fields(field(trade.entry, typeCol))
+			if len(kind) < 2 {
+				return errors.Newf("unknown trade type %q", field(trade.entry, typeCol))
+			}
+			direction, err := parseDirection(kind[len(kind)-1])
+			if err != nil {
+				return err
+			}
+
+			openedAt, err := parseTime(field(trade.entry, timeCol), tradingViewTimeLayouts)
+			if err != nil {
+				return errors.Wrap(err, "entry time")
+			}
+			closedAt, err := parseTime(field(trade.exit, timeCol), tradingViewTimeLayouts)
+			if err != nil {
+				return errors.Wrap(err, "exit time")
+			}
+			profit, err := parseNumber(field(trade.exit, profitCol))
+			if err != nil {
+				return errors.Wrap(err, "profit")
+			}
+
+			req := newRequest(opts, asset, direction, openedAt, closedAt, profit)
+			if quantity, err := parseNumber(field(trade.entry, quantityCol)); err == nil {
+				req.PositionSize = quantity
+			}
+			if price, err := parseNumber(field(trade.entry, priceCol)); err == nil && price > 0 {
+				req.EntryPrice = &price
+			}
+			req.Notes = fmt.Sprintf("Imported from TradingView (trade #%s)", trade.number)
+
+			row.Request = req
+			return nil
+		}()
+
+		rows = append(rows, row)
+	}
+
+	return rows, nil
+}
@@ -0,0 +1,122 @@
+package importer
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/cockroachdb/errors"
+	"github.com/user/normark/internal/entity"
+	"github.com/user/normark/internal/types"
+)
+
+// Newest first, exit row before entry row, as the strategy tester writes it.
+const tradingViewExport = `Trade #,Type,Signal,Date/Time,Price USD,Contracts,Profit USD,Profit %
+3,Exit Long,Open,2026-02-05 10:00,1.0900,10000,,
+3,Entry Long,Long,2026-02-05 09:00,1.0880,10000,,
+2,Exit Short,Close,2026-02-04 16:45,1.0850,20000,-42.50,-0.4
+2,Entry Short,Short,2026-02-03 13:10,1.0830,20000,,
+1,Exit Long,TP,2026-02-02 09:30,1.0820,10000,"1,010.00",1.2
+1,Entry Long,Long,2026-02-02 08:00,1.0800,10000,,
+`
+
+func TestParseTradingView(t *testing.T) {
+	opts := testOptions
+	opts.Asset = types.CurrencyPairEURUSD
+
+	rows, err := Parse(SourceTradingView, strings.NewReader(tradingViewExport), opts)
+	if err != nil {
+		t.Fatalf("Parse() error = %v", err)
+	}
+	if len(rows) != 3 {
+		t.Fatalf("got %d rows, want one per trade number", len(rows))
+	}
+
+	// Trade 3 has an exit row without a profit, so it can't be mapped.
+	if rows[0].Err == nil || rows[0].Line != 2 {
+		t.Errorf("trade 3: line %d, error %v; want line 2 unmapped", rows[0].Line, rows[0].Err)
+	}
+
+	short := rows[1]
+	if short.Err != nil {
+		t.Fatalf("trade 2: error %v", short.Err)
+	}
+	if short.Line != 4 || short.Request.Direction != types.TradeDirectionSell || short.Request.Result != types.TradeResultStopLoss {
+		t.Errorf("trade 2: line %d, %s %s; want line 4 sell SL", short.Line, short.Request.Direction, short.Request.Result)
+	}
+	if want := time.Date(2026, 2, 3, 13, 10, 0, 0, time.UTC); !short.Request.Day.Equal(want) || short.Request.TradeType != types.TradeTypeSwing {
+		t.Errorf("trade 2: day %v, type %s; want the entry time %v as a swing", short.Request.Day, short.Request.TradeType, want)
+	}
+
+	long := rows[2].Request
+	if rows[2].Err != nil {
+		t.Fatalf("trade 1: error %v", rows[2].Err)
+	}
+	if long.Asset != types.CurrencyPairEURUSD || long.Realized != 1010 || long.PositionSize != 10000 {
+		t.Errorf("trade 1: %s, realized %v, size %v; want EURUSD 1010 on 10000", long.Asset, long.Realized, long.PositionSize)
+	}
+	if long.EntryPrice == nil || *long.EntryPrice != 1.08 || long.Session != types.TradingSessionLondon {
+		t.Errorf("trade 1: entry price %v, session %s; want 1.08 london", long.EntryPrice, long.Session)
+	}
+	if long.Notes != "Imported from TradingView (trade #1)" {
+		t.Errorf("trade 1: notes %q", long.Notes)
+	}
+}
+
+func TestParseTradingViewUnmappedTrades(t *testing.T) {
+	tests := []struct {
+		name    string
+		export  string
+		asset   types.CurrencyPair
+		wantErr string
+	}{
+		{
+			name:    "no symbol and no asset option",
+			export:  "Trade #,Type,Date/Time,Profit\n1,Exit Long,2026-02-02 09:30,10\n1,Entry Long,2026-02-02 08:00,\n",
+			wantErr: "missing symbol",
+		},
+		{
+			name:    "open trade",
+			export:  "Trade #,Type,Date/Time,Profit\n1,Entry Long,2026-02-02 08:00,\n",
+			asset:   types.CurrencyPairEURUSD,
+			wantErr: "still open",
+		},
+		{
+			name:    "exit without entry",
+			export:  "Trade #,Type,Date/Time,Profit\n1,Exit Long,2026-02-02 09:30,10\n",
+			asset:   types.CurrencyPairEURUSD,
+			wantErr: "missing entry row",
+		},
+		{
+			name:    "symbol column overrides the option",
+			export:  "Trade #,Type,Date/Time,Profit,Symbol\n1,Exit Long,2026-02-02 09:30,10,COINBASE:BTCUSD\n1,Entry Long,2026-02-02 08:00,,COINBASE:BTCUSD\n",
+			asset:   types.CurrencyPairEURUSD,
+			wantErr: "unsupported symbol",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			opts := testOptions
+			opts.Asset = tt.asset
+
+			rows, err := Parse(SourceTradingView, strings.NewReader(tt.export), opts)
+			if err != nil {
+				t.Fatalf("Parse() error = %v", err)
+			}
+			if len(rows) != 1 {
+				t.Fatalf("got %d rows, want 1", len(rows))
+			}
+			if rows[0].Request != nil || rows[0].Err == nil || !strings.Contains(rows[0].Err.Error(), tt.wantErr) {
+				t.Errorf("row: request %v, error %v; want an error mentioning %q", rows[0].Request, rows[0].Err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestParseTradingViewMissingColumn(t *testing.T) {
+	_, err := Parse(SourceTradingView, strings.NewReader("Trade #,Type,Date/Time\n"), testOptions)
+	if !errors.Is(err, entity.ErrInvalidImportFile) {
+		t.Errorf("Parse() error = %v, want ErrInvalidImportFile", err)
+	}
+}
@@ -0,0 +1,159 @@
+package service
+
+import (
+	"context"
+	"io"
+	"slices"
+	"time"
+
+	"github.com/cockroachdb/errors"
+	"github.com/google/uuid"
+	"github.com/user/normark/internal/dto"
+	"github.com/user/normark/internal/dto/mapper"
+	"go.uber.org/zap"
+)
+
+type AccountExportService struct {
+	userStorage    UserStorage
+	sessionStorage SessionStorage
+	journalStorage TradingJournalStorage
+	noteStorage    EntryNoteStorage
+	exitStorage    EntryExitStorage
+	logger         *zap.Logger
+}
+
+func NewAccountExportService(
+	userStorage UserStorage,
+	sessionStorage SessionStorage,
+	journalStorage TradingJournalStorage,
+	noteStorage EntryNoteStorage,
+	exitStorage EntryExitStorage,
+	logger *zap.Logger,
+) *AccountExportService {
+	return &AccountExportService{
+		userStorage:    userStorage,
+		sessionStorage: sessionStorage,
+		journalStorage: journalStorage,
+		noteStorage:    noteStorage,
+		exitStorage:    exitStorage,
+		logger:         logger,
+	}
+}
+
+// Export writes the user's dto.AccountExportDocument to w: the profile
+// without the password hash, every device session including expired ones,
+// every journal including archived ones, every entry, and the entries'
+// review notes and partial exits. Everything but the sessions is loaded in
+// batches and written as it arrives, so memory use grows only with the
+// entry IDs kept to look up notes and exits. Nothing is written if the user
+// cannot be loaded; a later error leaves w truncated.
+func (s *AccountExportService) Export(ctx context.Context, userID uuid.UUID, w io.Writer) error {
+	user, err := s.userStorage.GetByID(ctx, userID)
+	if err != nil {
+		s.logger.Error("failed to get user for export", zap.Error(err), zap.String("user_id", userID.String()))
+		return errors.Wrap(err, "failed to get user for export")
+	}
+
+	out := &exportWriter{w: w}
+	out.raw(`{"version":`)
+	out.json(dto.AccountExportVersion)
+	out.raw(`,"exported_at":`)
+	out.json(time.Now().UTC())
+	out.raw(`,"user":`)
+	out.json(mapper.ToUserResponse(user))
+
+	sessions, err := s.sessionStorage.GetByUserID(ctx, userID)
+	if err != nil {
+		return s.exportFailed(err, userID)
+	}
+	out.openArray(`,"sessions":[`)
+	for _, session := range sessions {
+		out.item(mapper.ToSessionResponse(session))
+	}
+
+	out.openArray(`],"journals":[`)
+	var journalIDs []uuid.UUID
+	for offset := 0; out.err == nil; offset += exportBatchSize {
+		journals, err := s.journalStorage.GetByUserID(ctx, userID, exportBatchSize, offset, true, "")
+		if err != nil {
+			return s.exportFailed(err, userID)
+		}
+
+		for _, journal := range journals {
+			out.item(mapper.ToTradingJournalResponse(journal))
+			journalIDs = append(journalIDs, journal.ID)
+		}
+		out.flush()
+
+		if len(journals) < exportBatchSize {
+			break
+		}
+	}
+
+	out.openArray(`],"entries":[`)
+	var entryIDs []uuid.UUID
+	for _, journalID := range journalIDs {
+		for offset := 0; out.err == nil; offset += exportBatchSize {
+			entries, err := s.journalStorage.GetEntries(ctx, journalID, exportBatchSize, offset)
+			if err != nil {
+				return s.exportFailed(err, userID)
+			}
+
+			for _, entry := range entries {
+				out.item(mapper.ToTradingJournalEntryResponse(entry))
+				entryIDs = append(entryIDs, entry.ID)
+			}
+			out.flush()
+
+			if len(entries) < exportBatchSize {
+				break
+			}
+		}
+	}
+
+	out.openArray(`],"entry_notes":[`)
+	for batch := range slices.Chunk(entryIDs, exportBatchSize) {
+		if out.err != nil {
+			break
+		}
+
+		notes, err := s.noteStorage.GetByEntryIDs(ctx, batch)
+		if err != nil {
+			return s.exportFailed(err, userID)
+		}
+
+		for _, note := range notes {
+			out.item(mapper.ToEntryNoteResponse(note))
+		}
+		out.flush()
+	}
+
+	out.openArray(`],"entry_exits":[`)
+	for batch := range slices.Chunk(entryIDs, exportBatchSize) {
+		if out.err != nil {
+			break
+		}
+
+		exits, err := s.exitStorage.GetByEntryIDs(ctx, batch)
+		if err != nil {
+			return s.exportFailed(err, userID)
+		}
+
+		for _, exit := range exits {
+			out.item(mapper.ToEntryExitResponse(exit))
+		}
+		out.flush()
+	}
+	out.raw("]}\n")
+
+	if out.err != nil {
+		return s.exportFailed(out.err, userID)
+	}
+
+	return nil
+}
+
+func (s *AccountExportService) exportFailed(err error, userID uuid.UUID) error {
+	s.logger.Error("failed to export account", zap.Error(err), zap.String("user_id", userID.String()))
+	return errors.Wrap(err, "failed to export account")
+}
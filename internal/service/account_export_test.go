@@ -0,0 +1,206 @@
+package service
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/cockroachdb/errors"
+	"github.com/google/uuid"
+	"github.com/user/normark/internal/dto"
+	"github.com/user/normark/internal/entity"
+	"go.uber.org/zap"
+)
+
+type exportUserStorage struct {
+	UserStorage
+	user *entity.User
+}
+
+func (s *exportUserStorage) GetByID(context.Context, uuid.UUID) (*entity.User, error) {
+	if s.user == nil {
+		return nil, entity.ErrNotFound
+	}
+	return s.user, nil
+}
+
+type exportSessionStorage struct {
+	SessionStorage
+	sessions []*entity.Session
+}
+
+func (s *exportSessionStorage) GetByUserID(context.Context, uuid.UUID) ([]*entity.Session, error) {
+	return s.sessions, nil
+}
+
+type exportJournalStorage struct {
+	TradingJournalStorage
+	journals []*entity.TradingJournal
+	entries  map[uuid.UUID][]*entity.TradingJournalEntry
+}
+
+func (s *exportJournalStorage) GetByUserID(_ context.Context, _ uuid.UUID, limit, offset int, _ bool, _ string) ([]*entity.TradingJournal, error) {
+	return page(s.journals, limit, offset), nil
+}
+
+func (s *exportJournalStorage) GetEntries(_ context.Context, journalID uuid.UUID, limit, offset int) ([]*entity.TradingJournalEntry, error) {
+	return page(s.entries[journalID], limit, offset), nil
+}
+
+type exportNoteStorage struct {
+	EntryNoteStorage
+	notes   map[uuid.UUID][]*entity.EntryNote
+	batches []int
+}
+
+func (s *exportNoteStorage) GetByEntryIDs(_ context.Context, entryIDs []uuid.UUID) ([]*entity.EntryNote, error) {
+	s.batches = append(s.batches, len(entryIDs))
+	var notes []*entity.EntryNote
+	for _, id := range entryIDs {
+		notes = append(notes, s.notes[id]...)
+	}
+	return notes, nil
+}
+
+type exportExitStorage struct {
+	EntryExitStorage
+	exits   map[uuid.UUID][]*entity.EntryExit
+	batches []int
+}
+
+func (s *exportExitStorage) GetByEntryIDs(_ context.Context, entryIDs []uuid.UUID) ([]*entity.EntryExit, error) {
+	s.batches = append(s.batches, len(entryIDs))
+	var exits []*entity.EntryExit
+	for _, id := range entryIDs {
+		exits = append(exits, s.exits[id]...)
+	}
+	return exits, nil
+}
+
+func page[T any](items []T, limit, offset int) []T {
+	if offset >= len(items) {
+		return nil
+	}
+	return items[offset:min(offset+limit, len(items))]
+}
+
+func TestAccountExportIncludesSessionsNotesAndExits(t *testing.T) {
+	user := &entity.User{ID: uuid.New(), Email: "trader@example.com", Username: "trader"}
+	journal := &entity.TradingJournal{ID: uuid.New(), UserID: user.ID, Name: "Main"}
+
+	// One more entry than a batch, so notes and exits are looked up twice.
+	entries := make([]*entity.TradingJournalEntry, exportBatchSize+1)
+	for i := range entries {
+		entries[i] = &entity.TradingJournalEntry{ID: uuid.New(), JournalID: journal.ID}
+	}
+	first, last := entries[0].ID, entries[len(entries)-1].ID
+
+	notes := &exportNoteStorage{notes: map[uuid.UUID][]*entity.EntryNote{
+		first: {entity.NewEntryNote(first, "moved stop too early")},
+		last:  {entity.NewEntryNote(last, "clean setup")},
+	}}
+	exits := &exportExitStorage{exits: map[uuid.UUID][]*entity.EntryExit{
+		last: {{ID: uuid.New(), EntryID: last, Size: 1, Price: 1.1, Realized: 50}},
+	}}
+
+	s := NewAccountExportService(
+		&exportUserStorage{user: user},
+		&exportSessionStorage{sessions: []*entity.Session{entity.NewSession(user.ID, "test-agent", "127.0.0.1")}},
+		&exportJournalStorage{
+			journals: []*entity.TradingJournal{journal},
+			entries:  map[uuid.UUID][]*entity.TradingJournalEntry{journal.ID: entries},
+		},
+		notes,
+		exits,
+		zap.NewNop(),
+	)
+
+	var buf bytes.Buffer
+	if err := s.Export(context.Background(), user.ID, &buf); err != nil {
+		t.Fatalf("Export() error = %v", err)
+	}
+
+	var doc dto.AccountExportDocument
+	if err := json.Unmarshal(buf.Bytes(), &doc); err != nil {
+		t.Fatalf("export is not valid JSON: %v", err)
+	}
+
+	if len(doc.Sessions) != 1 || doc.Sessions[0].UserAgent != "test-agent" {
+		t.Errorf("sessions = %+v, want the user's one session", doc.Sessions)
+	}
+	if len(doc.Journals) != 1 || len(doc.Entries) != len(entries) {
+		t.Errorf("got %d journals and %d entries, want 1 and %d", len(doc.Journals), len(doc.Entries), len(entries))
+	}
+	if len(doc.EntryNotes) != 2 || doc.EntryNotes[0].EntryID != first || doc.EntryNotes[1].EntryID != last {
+		t.Errorf("entry_notes = %+v, want the notes of the first and last entries", doc.EntryNotes)
+	}
+	if len(doc.EntryExits) != 1 || doc.EntryExits[0].EntryID != last {
+		t.Errorf("entry_exits = %+v, want the exit of the last entry", doc.EntryExits)
+	}
+
+	wantBatches := []int{exportBatchSize, 1}
+	for name, got := range map[string][]int{"notes": notes.batches, "exits": exits.batches} {
+		if len(got) != len(wantBatches) || got[0] != wantBatches[0] || got[1] != wantBatches[1] {
+			t.Errorf("%s looked up in batches %v, want %v", name, got, wantBatches)
+		}
+	}
+}
+
+func TestAccountExportIncludesJournalsAndEntriesButNotPassword(t *testing.T) {
+	user, err := entity.NewUserFromSignUp(&dto.SignUpRequest{Email: "trader@example.com", Username: "trader", Password: "password123"})
+	if err != nil {
+		t.Fatalf("NewUserFromSignUp() error = %v", err)
+	}
+	user.ID = uuid.New()
+	journal := &entity.TradingJournal{ID: uuid.New(), UserID: user.ID, Name: "Main"}
+	entry := &entity.TradingJournalEntry{ID: uuid.New(), JournalID: journal.ID}
+
+	s := NewAccountExportService(
+		&exportUserStorage{user: user},
+		&exportSessionStorage{},
+		&exportJournalStorage{
+			journals: []*entity.TradingJournal{journal},
+			entries:  map[uuid.UUID][]*entity.TradingJournalEntry{journal.ID: {entry}},
+		},
+		&exportNoteStorage{},
+		&exportExitStorage{},
+		zap.NewNop(),
+	)
+
+	var buf bytes.Buffer
+	if err := s.Export(context.Background(), user.ID, &buf); err != nil {
+		t.Fatalf("Export() error = %v", err)
+	}
+
+	var doc dto.AccountExportDocument
+	if err := json.Unmarshal(buf.Bytes(), &doc); err != nil {
+		t.Fatalf("export is not valid JSON: %v", err)
+	}
+	if doc.User == nil || doc.User.Email != user.Email {
+		t.Errorf("user = %+v, want the profile of %s", doc.User, user.Email)
+	}
+	if len(doc.Journals) != 1 || doc.Journals[0].ID != journal.ID {
+		t.Errorf("journals = %+v, want journal %s", doc.Journals, journal.ID)
+	}
+	if len(doc.Entries) != 1 || doc.Entries[0].ID != entry.ID {
+		t.Errorf("entries = %+v, want entry %s", doc.Entries, entry.ID)
+	}
+	if strings.Contains(buf.String(), user.Password) || strings.Contains(buf.String(), `"password":`) {
+		t.Errorf("export %s contains the password hash", buf.String())
+	}
+}
+
+func TestAccountExportOfMissingUserWritesNothing(t *testing.T) {
+	s := NewAccountExportService(&exportUserStorage{}, &exportSessionStorage{}, &exportJournalStorage{}, &exportNoteStorage{}, &exportExitStorage{}, zap.NewNop())
+
+	var buf bytes.Buffer
+	err := s.Export(context.Background(), uuid.New(), &buf)
+	if !errors.Is(err, entity.ErrNotFound) {
+		t.Fatalf("Export() error = %v, want %v", err, entity.ErrNotFound)
+	}
+	if buf.Len() != 0 {
+		t.Errorf("Export() wrote %q, want nothing", buf.String())
+	}
+}
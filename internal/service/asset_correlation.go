@@ -0,0 +1,116 @@
+package service
+
+import (
+	"sort"
+	"time"
+
+	"github.com/user/normark/internal/entity"
+	"github.com/user/normark/internal/types"
+)
+
+const (
+	// assetCorrelationMinTrades is the number of trades an asset needs before
+	// it is included; below that its win rate is mostly noise.
+	assetCorrelationMinTrades = 5
+	// assetCorrelationMaxAssets caps the matrix at the most traded assets so
+	// the pair count stays at most n*(n-1)/2 = 45.
+	assetCorrelationMaxAssets = 10
+)
+
+// buildAssetCorrelation turns per-day, per-asset results into a pairwise
+// co-occurrence matrix. For every two assets traded on the same day, the
+// pair accumulates the shared day and both assets' trades and wins for that
+// day, so a pair's win rate shows how the account did on days it traded both.
+//
+// Assets with fewer than assetCorrelationMinTrades trades are skipped, and
+// only the assetCorrelationMaxAssets most traded assets are kept.
+func buildAssetCorrelation(days []*entity.DailyAssetStatistics) *entity.AssetCorrelation {
+	totals := make(map[types.CurrencyPair]*entity.AssetStatistics)
+	for _, day := range days {
+		asset, ok := totals[day.Asset]
+		if !ok {
+			asset = &entity.AssetStatistics{Asset: day.Asset}
+			totals[day.Asset] = asset
+		}
+		asset.TotalTrades += day.TotalTrades
+		asset.Wins += day.Wins
+	}
+
+	assets := make([]*entity.AssetStatistics, 0, len(totals))
+	for _, asset := range totals {
+		if asset.TotalTrades < assetCorrelationMinTrades {
+			continue
+		}
+		asset.WinRate = float64(asset.Wins) / float64(asset.TotalTrades) * 100
+		assets = append(assets, asset)
+	}
+
+	sort.Slice(assets, func(i, j int) bool {
+		if assets[i].TotalTrades != assets[j].TotalTrades {
+			return assets[i].TotalTrades > assets[j].TotalTrades
+		}
+		return assets[i].Asset < assets[j].Asset
+	})
+	if len(assets) > assetCorrelationMaxAssets {
+		assets = assets[:assetCorrelationMaxAssets]
+	}
+
+	included := make(map[types.CurrencyPair]bool, len(assets))
+	for _, asset := range assets {
+		included[asset.Asset] = true
+	}
+
+	byDay := make(map[string][]*entity.DailyAssetStatistics)
+	for _, day := range days {
+		if included[day.Asset] {
+			key := day.Day.Format(time.DateOnly)
+			byDay[key] = append(byDay[key], day)
+		}
+	}
+
+	type pairKey struct{ a, b types.CurrencyPair }
+	pairs := make(map[pairKey]*entity.AssetPairStatistics)
+
+	for _, traded := range byDay {
+		for i := 0; i < len(traded); i++ {
+			for j := i + 1; j < len(traded); j++ {
+				a, b := traded[i], traded[j]
+				if a.Asset > b.Asset {
+					a, b = b, a
+				}
+
+				key := pairKey{a.Asset, b.Asset}
+				pair, ok := pairs[key]
+				if !ok {
+					pair = &entity.AssetPairStatistics{AssetA: a.Asset, AssetB: b.Asset}
+					pairs[key] = pair
+				}
+				pair.SharedDays++
+				pair.TotalTrades += a.TotalTrades + b.TotalTrades
+				pair.Wins += a.Wins + b.Wins
+			}
+		}
+	}
+
+	result := &entity.AssetCorrelation{
+		Assets: assets,
+		Pairs:  make([]*entity.AssetPairStatistics, 0, len(pairs)),
+	}
+	for _, pair := range pairs {
+		pair.WinRate = float64(pair.Wins) / float64(pair.TotalTrades) * 100
+		result.Pairs = append(result.Pairs, pair)
+	}
+
+	sort.Slice(result.Pairs, func(i, j int) bool {
+		pi, pj := result.Pairs[i], result.Pairs[j]
+		if pi.SharedDays != pj.SharedDays {
+			return pi.SharedDays > pj.SharedDays
+		}
+		if pi.AssetA != pj.AssetA {
+			return pi.AssetA < pj.AssetA
+		}
+		return pi.AssetB < pj.AssetB
+	})
+
+	return result
+}
@@ -0,0 +1,91 @@
+package service
+
+import (
+	"testing"
+	"time"
+
+	"github.com/user/normark/internal/entity"
+	"github.com/user/normark/internal/types"
+)
+
+func assetDay(day int, asset types.CurrencyPair, trades, wins int) *entity.DailyAssetStatistics {
+	return &entity.DailyAssetStatistics{
+		Day:         time.Date(2026, 3, day, 0, 0, 0, 0, time.UTC),
+		Asset:       asset,
+		TotalTrades: trades,
+		Wins:        wins,
+	}
+}
+
+func TestBuildAssetCorrelation(t *testing.T) {
+	days := []*entity.DailyAssetStatistics{
+		assetDay(2, types.CurrencyPairEURUSD, 2, 1),
+		assetDay(2, types.CurrencyPairGBPUSD, 1, 1),
+		assetDay(2, types.CurrencyPairUSDJPY, 1, 0),
+		assetDay(3, types.CurrencyPairEURUSD, 2, 2),
+		assetDay(3, types.CurrencyPairGBPUSD, 2, 0),
+		assetDay(4, types.CurrencyPairEURUSD, 1, 0),
+		assetDay(4, types.CurrencyPairGBPUSD, 2, 1),
+		assetDay(4, types.CurrencyPairUSDJPY, 1, 0),
+		assetDay(5, types.CurrencyPairAUDUSD, 1, 1),
+	}
+
+	correlation := buildAssetCorrelation(days)
+
+	// USDJPY and AUDUSD trade fewer than assetCorrelationMinTrades times.
+	wantAssets := []entity.AssetStatistics{
+		{Asset: types.CurrencyPairEURUSD, TotalTrades: 5, Wins: 3, WinRate: 60},
+		{Asset: types.CurrencyPairGBPUSD, TotalTrades: 5, Wins: 2, WinRate: 40},
+	}
+	if len(correlation.Assets) != len(wantAssets) {
+		t.Fatalf("got %d assets, want %d", len(correlation.Assets), len(wantAssets))
+	}
+	for i, want := range wantAssets {
+		if got := *correlation.Assets[i]; got != want {
+			t.Errorf("asset %d = %+v, want %+v", i, got, want)
+		}
+	}
+
+	wantPair := entity.AssetPairStatistics{
+		AssetA:      types.CurrencyPairEURUSD,
+		AssetB:      types.CurrencyPairGBPUSD,
+		SharedDays:  3,
+		TotalTrades: 10,
+		Wins:        5,
+		WinRate:     50,
+	}
+	if len(correlation.Pairs) != 1 || *correlation.Pairs[0] != wantPair {
+		t.Errorf("pairs = %+v, want only %+v", correlation.Pairs, wantPair)
+	}
+}
+
+func TestBuildAssetCorrelationCapsAssets(t *testing.T) {
+	// Eleven assets traded on the same day; the least traded is dropped.
+	var days []*entity.DailyAssetStatistics
+	for i, asset := range types.CurrencyPairs[:assetCorrelationMaxAssets+1] {
+		days = append(days, assetDay(2, asset, assetCorrelationMinTrades+assetCorrelationMaxAssets-i, 1))
+	}
+
+	correlation := buildAssetCorrelation(days)
+
+	if len(correlation.Assets) != assetCorrelationMaxAssets {
+		t.Fatalf("got %d assets, want %d", len(correlation.Assets), assetCorrelationMaxAssets)
+	}
+	dropped := types.CurrencyPairs[assetCorrelationMaxAssets]
+	for _, asset := range correlation.Assets {
+		if asset.Asset == dropped {
+			t.Errorf("least traded asset %s kept", dropped)
+		}
+	}
+	if want := assetCorrelationMaxAssets * (assetCorrelationMaxAssets - 1) / 2; len(correlation.Pairs) != want {
+		t.Errorf("got %d pairs, want %d", len(correlation.Pairs), want)
+	}
+}
+
+func TestBuildAssetCorrelationEmpty(t *testing.T) {
+	correlation := buildAssetCorrelation(nil)
+
+	if len(correlation.Assets) != 0 || correlation.Pairs == nil || len(correlation.Pairs) != 0 {
+		t.Errorf("correlation = %+v, want no assets and an empty pair list", correlation)
+	}
+}
@@ -0,0 +1,136 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	"github.com/cockroachdb/errors"
+	"github.com/google/uuid"
+	"github.com/user/normark/internal/dto"
+	"github.com/user/normark/internal/entity"
+	"github.com/user/normark/internal/types"
+	"go.uber.org/zap"
+)
+
+type EntryExitStorage interface {
+	Create(ctx context.Context, exit *entity.EntryExit) error
+	GetByEntryID(ctx context.Context, entryID uuid.UUID) ([]*entity.EntryExit, error)
+	GetByEntryIDs(ctx context.Context, entryIDs []uuid.UUID) ([]*entity.EntryExit, error)
+}
+
+type EntryExitService struct {
+	storage        EntryExitStorage
+	entryStorage   TradingJournalEntryStorage
+	journalStorage TradingJournalStorage
+	logger         *zap.Logger
+}
+
+func NewEntryExitService(
+	storage EntryExitStorage,
+	entryStorage TradingJournalEntryStorage,
+	journalStorage TradingJournalStorage,
+	logger *zap.Logger,
+) *EntryExitService {
+	return &EntryExitService{
+		storage:        storage,
+		entryStorage:   entryStorage,
+		journalStorage: journalStorage,
+		logger:         logger,
+	}
+}
+
+// Add records a partial exit. The entry's realized becomes the sum of all of
+// its exits, replacing whatever was entered by hand, so the entry with that
+// realized must still satisfy the journal's policy.
+func (s *EntryExitService) Add(ctx context.Context, entryID uuid.UUID, journalID uuid.UUID, req *dto.CreateEntryExitRequest) (*entity.EntryExit, error) {
+	if err := s.verifyEntryAccess(ctx, entryID, journalID); err != nil {
+		return nil, err
+	}
+
+	if err := ensureJournalUnlocked(ctx, s.journalStorage, journalID); err != nil {
+		return nil, err
+	}
+
+	exitedAt := time.Now()
+	if req.ExitedAt != nil {
+		exitedAt = *req.ExitedAt
+	}
+
+	exit := entity.NewEntryExit(entryID, req.Size, req.Price, req.Realized, exitedAt)
+
+	if err := exit.Validate(); err != nil {
+		s.logger.Error("invalid entry exit data", zap.Error(err))
+		return nil, errors.Wrap(err, "invalid entry exit data")
+	}
+
+	if err := s.checkJournalPolicy(ctx, exit, journalID); err != nil {
+		return nil, err
+	}
+
+	if err := s.storage.Create(ctx, exit); err != nil {
+		s.logger.Error("failed to create entry exit", zap.Error(err), zap.String("entry_id", entryID.String()))
+		return nil, errors.Wrap(err, "failed to create entry exit")
+	}
+
+	return exit, nil
+}
+
+func (s *EntryExitService) List(ctx context.Context, entryID uuid.UUID, journalID uuid.UUID) ([]*entity.EntryExit, error) {
+	if err := s.verifyEntryAccess(ctx, entryID, journalID); err != nil {
+		return nil, err
+	}
+
+	exits, err := s.storage.GetByEntryID(ctx, entryID)
+	if err != nil {
+		s.logger.Error("failed to get entry exits", zap.Error(err), zap.String("entry_id", entryID.String()))
+		return nil, errors.Wrap(err, "failed to get entry exits")
+	}
+
+	return exits, nil
+}
+
+// checkJournalPolicy enforces the journal's rules on the entry as it will be
+// once exit is recorded, with its realized the sum of all of its exits.
+func (s *EntryExitService) checkJournalPolicy(ctx context.Context, exit *entity.EntryExit, journalID uuid.UUID) error {
+	entry, err := s.entryStorage.GetByID(ctx, exit.EntryID)
+	if err != nil {
+		s.logger.Error("failed to get trading journal entry", zap.Error(err), zap.String("entry_id", exit.EntryID.String()))
+		return errors.Wrap(err, "failed to get trading journal entry")
+	}
+
+	exits, err := s.storage.GetByEntryID(ctx, exit.EntryID)
+	if err != nil {
+		s.logger.Error("failed to get entry exits", zap.Error(err), zap.String("entry_id", exit.EntryID.String()))
+		return errors.Wrap(err, "failed to get entry exits")
+	}
+
+	journal, err := s.journalStorage.GetByID(ctx, journalID)
+	if err != nil {
+		s.logger.Error("failed to get journal policy", zap.Error(err), zap.String("journal_id", journalID.String()))
+		return errors.Wrap(err, "failed to get journal policy")
+	}
+
+	realized := types.CentsFromFloat(exit.Realized)
+	for _, prior := range exits {
+		realized += types.CentsFromFloat(prior.Realized)
+	}
+
+	updated := *entry
+	updated.Realized = realized.Float64()
+
+	return journal.CheckEntryPolicy(&updated)
+}
+
+func (s *EntryExitService) verifyEntryAccess(ctx context.Context, entryID uuid.UUID, journalID uuid.UUID) error {
+	exists, err := s.entryStorage.Exists(ctx, entryID, journalID)
+	if err != nil {
+		s.logger.Error("failed to check entry ownership", zap.Error(err))
+		return errors.Wrap(err, "failed to verify entry ownership")
+	}
+
+	if !exists {
+		return errors.Wrap(entity.ErrNotFound, "trading journal entry")
+	}
+
+	return nil
+}
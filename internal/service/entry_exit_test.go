@@ -0,0 +1,197 @@
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/cockroachdb/errors"
+	"github.com/google/uuid"
+	"github.com/user/normark/internal/dto"
+	"github.com/user/normark/internal/entity"
+	"github.com/user/normark/internal/types"
+	"go.uber.org/zap"
+)
+
+type fakeExitStorage struct {
+	EntryExitStorage
+	existing []*entity.EntryExit
+	created  []*entity.EntryExit
+}
+
+func (s *fakeExitStorage) GetByEntryID(context.Context, uuid.UUID) ([]*entity.EntryExit, error) {
+	return s.existing, nil
+}
+
+func (s *fakeExitStorage) Create(_ context.Context, exit *entity.EntryExit) error {
+	exit.ID = uuid.New()
+	s.created = append(s.created, exit)
+	return nil
+}
+
+func TestEntryExitAdd(t *testing.T) {
+	journal := newTestJournal()
+	entry := newTestEntry(journal.ID, types.TradeResultTakeProfit, 0)
+	exitedAt := time.Date(2026, 1, 5, 14, 30, 0, 0, time.UTC)
+
+	tests := []struct {
+		name      string
+		entryID   uuid.UUID
+		journalID uuid.UUID
+		locked    bool
+		req       dto.CreateEntryExitRequest
+		wantErr   error
+	}{
+		{
+			name:      "added",
+			entryID:   entry.ID,
+			journalID: journal.ID,
+			req:       dto.CreateEntryExitRequest{Size: 0.5, Price: 1.0850, Realized: 120, ExitedAt: &exitedAt},
+		},
+		{
+			name:      "defaults exit time",
+			entryID:   entry.ID,
+			journalID: journal.ID,
+			req:       dto.CreateEntryExitRequest{Size: 0.5, Price: 1.0850, Realized: 120},
+		},
+		{
+			name:      "entry of another journal",
+			entryID:   entry.ID,
+			journalID: uuid.New(),
+			req:       dto.CreateEntryExitRequest{Size: 0.5, Price: 1.0850},
+			wantErr:   entity.ErrNotFound,
+		},
+		{
+			name:      "locked journal",
+			entryID:   entry.ID,
+			journalID: journal.ID,
+			locked:    true,
+			req:       dto.CreateEntryExitRequest{Size: 0.5, Price: 1.0850},
+			wantErr:   entity.ErrJournalLocked,
+		},
+		{
+			name:      "invalid exit",
+			entryID:   entry.ID,
+			journalID: journal.ID,
+			req:       dto.CreateEntryExitRequest{Size: 0, Price: 1.0850},
+			wantErr:   entity.ErrInvalidExitSize,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			exits := &fakeExitStorage{}
+			svc := NewEntryExitService(
+				exits,
+				&fakeEntryStorage{entries: []*entity.TradingJournalEntry{entry}},
+				&fakeJournalStorage{journal: journal, locked: tt.locked},
+				zap.NewNop(),
+			)
+
+			before := time.Now()
+			exit, err := svc.Add(context.Background(), tt.entryID, tt.journalID, &tt.req)
+			if tt.wantErr != nil {
+				if !errors.Is(err, tt.wantErr) {
+					t.Fatalf("Add() error = %v, want %v", err, tt.wantErr)
+				}
+				if len(exits.created) != 0 {
+					t.Errorf("Add() stored %d exits, want none", len(exits.created))
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Add() error = %v", err)
+			}
+
+			if len(exits.created) != 1 || exits.created[0] != exit {
+				t.Fatalf("Add() did not store the returned exit")
+			}
+			if exit.EntryID != entry.ID || exit.Size != tt.req.Size || exit.Price != tt.req.Price || exit.Realized != tt.req.Realized {
+				t.Errorf("Add() exit = %+v, want the request's values", exit)
+			}
+			if tt.req.ExitedAt != nil && !exit.ExitedAt.Equal(*tt.req.ExitedAt) {
+				t.Errorf("exited at = %v, want %v", exit.ExitedAt, *tt.req.ExitedAt)
+			}
+			if tt.req.ExitedAt == nil && exit.ExitedAt.Before(before) {
+				t.Errorf("exited at = %v, want the time of the request", exit.ExitedAt)
+			}
+		})
+	}
+}
+
+func TestEntryExitAddFollowsJournalPolicy(t *testing.T) {
+	tests := []struct {
+		name         string
+		result       types.TradeResult
+		notes        string
+		requireNotes bool
+		existing     []float64
+		realized     float64
+		wantErr      error
+	}{
+		{
+			name:     "take profit stays positive",
+			result:   types.TradeResultTakeProfit,
+			existing: []float64{120},
+			realized: -40,
+		},
+		{
+			name:     "take profit turns negative",
+			result:   types.TradeResultTakeProfit,
+			existing: []float64{30},
+			realized: -40,
+			wantErr:  entity.ErrResultContradictsRealized,
+		},
+		{
+			name:         "loss without notes",
+			result:       types.TradeResultStopLoss,
+			requireNotes: true,
+			realized:     -25,
+			wantErr:      entity.ErrNotesRequiredOnLoss,
+		},
+		{
+			name:         "loss with notes",
+			result:       types.TradeResultStopLoss,
+			notes:        "entered before the news",
+			requireNotes: true,
+			realized:     -25,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			journal := newTestJournal()
+			journal.RequireNotesOnLoss = tt.requireNotes
+			entry := newTestEntry(journal.ID, tt.result, 0)
+			entry.Notes = tt.notes
+
+			exits := &fakeExitStorage{}
+			for _, realized := range tt.existing {
+				exits.existing = append(exits.existing, entity.NewEntryExit(entry.ID, 0.5, 1.0850, realized, time.Now()))
+			}
+			svc := NewEntryExitService(
+				exits,
+				&fakeEntryStorage{entries: []*entity.TradingJournalEntry{entry}},
+				&fakeJournalStorage{journal: journal},
+				zap.NewNop(),
+			)
+
+			_, err := svc.Add(context.Background(), entry.ID, journal.ID, &dto.CreateEntryExitRequest{Size: 0.5, Price: 1.0850, Realized: tt.realized})
+			if tt.wantErr == nil {
+				if err != nil {
+					t.Fatalf("Add() error = %v", err)
+				}
+				return
+			}
+			if !errors.Is(err, tt.wantErr) || !errors.Is(err, entity.ErrEntryPolicy) {
+				t.Fatalf("Add() error = %v, want %v", err, tt.wantErr)
+			}
+			if len(exits.created) != 0 {
+				t.Errorf("Add() stored %d exits, want none", len(exits.created))
+			}
+			if entry.Realized != 0 {
+				t.Errorf("entry realized = %v, want it untouched", entry.Realized)
+			}
+		})
+	}
+}
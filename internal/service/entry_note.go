@@ -0,0 +1,89 @@
+package service
+
+import (
+	"context"
+
+	"github.com/cockroachdb/errors"
+	"github.com/google/uuid"
+	"github.com/user/normark/internal/entity"
+	"go.uber.org/zap"
+)
+
+type EntryNoteStorage interface {
+	Create(ctx context.Context, note *entity.EntryNote) error
+	GetByEntryID(ctx context.Context, entryID uuid.UUID) ([]*entity.EntryNote, error)
+	GetByEntryIDs(ctx context.Context, entryIDs []uuid.UUID) ([]*entity.EntryNote, error)
+}
+
+type EntryNoteService struct {
+	storage        EntryNoteStorage
+	entryStorage   TradingJournalEntryStorage
+	journalStorage TradingJournalStorage
+	logger         *zap.Logger
+}
+
+func NewEntryNoteService(
+	storage EntryNoteStorage,
+	entryStorage TradingJournalEntryStorage,
+	journalStorage TradingJournalStorage,
+	logger *zap.Logger,
+) *EntryNoteService {
+	return &EntryNoteService{
+		storage:        storage,
+		entryStorage:   entryStorage,
+		journalStorage: journalStorage,
+		logger:         logger,
+	}
+}
+
+func (s *EntryNoteService) Add(ctx context.Context, entryID uuid.UUID, journalID uuid.UUID, body string) (*entity.EntryNote, error) {
+	if err := s.verifyEntryAccess(ctx, entryID, journalID); err != nil {
+		return nil, err
+	}
+
+	if err := ensureJournalUnlocked(ctx, s.journalStorage, journalID); err != nil {
+		return nil, err
+	}
+
+	note := entity.NewEntryNote(entryID, body)
+
+	if err := note.Validate(); err != nil {
+		s.logger.Error("invalid entry note data", zap.Error(err))
+		return nil, errors.Wrap(err, "invalid entry note data")
+	}
+
+	if err := s.storage.Create(ctx, note); err != nil {
+		s.logger.Error("failed to create entry note", zap.Error(err), zap.String("entry_id", entryID.String()))
+		return nil, errors.Wrap(err, "failed to create entry note")
+	}
+
+	return note, nil
+}
+
+func (s *EntryNoteService) List(ctx context.Context, entryID uuid.UUID, journalID uuid.UUID) ([]*entity.EntryNote, error) {
+	if err := s.verifyEntryAccess(ctx, entryID, journalID); err != nil {
+		return nil, err
+	}
+
+	notes, err := s.storage.GetByEntryID(ctx, entryID)
+	if err != nil {
+		s.logger.Error("failed to get entry notes", zap.Error(err), zap.String("entry_id", entryID.String()))
+		return nil, errors.Wrap(err, "failed to get entry notes")
+	}
+
+	return notes, nil
+}
+
+func (s *EntryNoteService) verifyEntryAccess(ctx context.Context, entryID uuid.UUID, journalID uuid.UUID) error {
+	exists, err := s.entryStorage.Exists(ctx, entryID, journalID)
+	if err != nil {
+		s.logger.Error("failed to check entry ownership", zap.Error(err))
+		return errors.Wrap(err, "failed to verify entry ownership")
+	}
+
+	if !exists {
+		return errors.Wrap(entity.ErrNotFound, "trading journal entry")
+	}
+
+	return nil
+}
@@ -0,0 +1,120 @@
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/cockroachdb/errors"
+	"github.com/google/uuid"
+	"github.com/user/normark/internal/entity"
+	"github.com/user/normark/internal/types"
+	"go.uber.org/zap"
+)
+
+// fakeNoteStorage stamps notes one minute apart and lists them in order.
+type fakeNoteStorage struct {
+	EntryNoteStorage
+	notes []*entity.EntryNote
+	clock time.Time
+}
+
+func (s *fakeNoteStorage) Create(_ context.Context, note *entity.EntryNote) error {
+	s.clock = s.clock.Add(time.Minute)
+	note.ID = uuid.New()
+	note.CreatedAt = s.clock
+	s.notes = append(s.notes, note)
+	return nil
+}
+
+func (s *fakeNoteStorage) GetByEntryID(_ context.Context, entryID uuid.UUID) ([]*entity.EntryNote, error) {
+	var notes []*entity.EntryNote
+	for _, note := range s.notes {
+		if note.EntryID == entryID {
+			notes = append(notes, note)
+		}
+	}
+	return notes, nil
+}
+
+func TestEntryNotesAppendAndList(t *testing.T) {
+	journal := newTestJournal()
+	entry := newTestEntry(journal.ID, types.TradeResultTakeProfit, 120)
+	other := newTestEntry(journal.ID, types.TradeResultStopLoss, -50)
+	notes := &fakeNoteStorage{clock: time.Date(2026, 1, 6, 9, 0, 0, 0, time.UTC)}
+	svc := NewEntryNoteService(notes, &fakeEntryStorage{entries: []*entity.TradingJournalEntry{entry, other}}, &fakeJournalStorage{journal: journal}, zap.NewNop())
+
+	bodies := []string{"entry was early", "should have scaled out", "fine on review"}
+	for _, body := range bodies {
+		if _, err := svc.Add(context.Background(), entry.ID, journal.ID, body); err != nil {
+			t.Fatalf("Add(%q) error = %v", body, err)
+		}
+	}
+	if _, err := svc.Add(context.Background(), other.ID, journal.ID, "another trade"); err != nil {
+		t.Fatalf("Add() error = %v", err)
+	}
+
+	listed, err := svc.List(context.Background(), entry.ID, journal.ID)
+	if err != nil {
+		t.Fatalf("List() error = %v", err)
+	}
+
+	if len(listed) != len(bodies) {
+		t.Fatalf("listed %d notes, want %d", len(listed), len(bodies))
+	}
+	for i, note := range listed {
+		if note.Body != bodies[i] || note.EntryID != entry.ID {
+			t.Errorf("note %d = %q on %s, want %q on %s", i, note.Body, note.EntryID, bodies[i], entry.ID)
+		}
+		if i > 0 && !note.CreatedAt.After(listed[i-1].CreatedAt) {
+			t.Errorf("note %d created %v, not after note %d at %v", i, note.CreatedAt, i-1, listed[i-1].CreatedAt)
+		}
+	}
+	if entry.Notes != "" {
+		t.Errorf("entry notes = %q, appending review notes must not change them", entry.Notes)
+	}
+}
+
+func TestEntryNoteAddRejected(t *testing.T) {
+	journal := newTestJournal()
+	entry := newTestEntry(journal.ID, types.TradeResultTakeProfit, 120)
+
+	tests := []struct {
+		name      string
+		entryID   uuid.UUID
+		journalID uuid.UUID
+		locked    bool
+		body      string
+		wantErr   error
+	}{
+		{"entry of another journal", entry.ID, uuid.New(), false, "note", entity.ErrNotFound},
+		{"unknown entry", uuid.New(), journal.ID, false, "note", entity.ErrNotFound},
+		{"locked journal", entry.ID, journal.ID, true, "note", entity.ErrJournalLocked},
+		{"blank body", entry.ID, journal.ID, false, "  ", entity.ErrInvalidNoteBody},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			notes := &fakeNoteStorage{}
+			entries := &fakeEntryStorage{entries: []*entity.TradingJournalEntry{entry}}
+			svc := NewEntryNoteService(notes, entries, &fakeJournalStorage{journal: journal, locked: tt.locked}, zap.NewNop())
+
+			if _, err := svc.Add(context.Background(), tt.entryID, tt.journalID, tt.body); !errors.Is(err, tt.wantErr) {
+				t.Fatalf("Add() error = %v, want %v", err, tt.wantErr)
+			}
+			if len(notes.notes) != 0 {
+				t.Errorf("stored %d notes, want none", len(notes.notes))
+			}
+		})
+	}
+}
+
+func TestEntryNoteListRequiresEntryAccess(t *testing.T) {
+	journal := newTestJournal()
+	entry := newTestEntry(journal.ID, types.TradeResultTakeProfit, 120)
+	svc := NewEntryNoteService(&fakeNoteStorage{}, &fakeEntryStorage{entries: []*entity.TradingJournalEntry{entry}}, &fakeJournalStorage{journal: journal}, zap.NewNop())
+
+	if _, err := svc.List(context.Background(), entry.ID, uuid.New()); !errors.Is(err, entity.ErrNotFound) {
+		t.Errorf("List() error = %v, want %v", err, entity.ErrNotFound)
+	}
+}
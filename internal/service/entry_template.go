@@ -0,0 +1,92 @@
+package service
+
+import (
+	"context"
+
+	"github.com/cockroachdb/errors"
+	"github.com/google/uuid"
+	"github.com/user/normark/internal/dto"
+	"github.com/user/normark/internal/entity"
+	"go.uber.org/zap"
+)
+
+type EntryTemplateStorage interface {
+	Create(ctx context.Context, template *entity.EntryTemplate) error
+	GetByID(ctx context.Context, id uuid.UUID, journalID uuid.UUID) (*entity.EntryTemplate, error)
+	GetByJournalID(ctx context.Context, journalID uuid.UUID, limit, offset int) ([]*entity.EntryTemplate, error)
+	CountByJournalID(ctx context.Context, journalID uuid.UUID) (int, error)
+	Delete(ctx context.Context, id uuid.UUID, journalID uuid.UUID) error
+}
+
+type EntryTemplateService struct {
+	storage        EntryTemplateStorage
+	journalStorage TradingJournalStorage
+	logger         *zap.Logger
+}
+
+func NewEntryTemplateService(
+	storage EntryTemplateStorage,
+	journalStorage TradingJournalStorage,
+	logger *zap.Logger,
+) *EntryTemplateService {
+	return &EntryTemplateService{
+		storage:        storage,
+		journalStorage: journalStorage,
+		logger:         logger,
+	}
+}
+
+func (s *EntryTemplateService) Create(ctx context.Context, journalID uuid.UUID, req *dto.CreateEntryTemplateRequest) (*entity.EntryTemplate, error) {
+	if _, err := s.journalStorage.GetByID(ctx, journalID); err != nil {
+		s.logger.Error("failed to verify journal existence", zap.Error(err), zap.String("journal_id", journalID.String()))
+		return nil, errors.Wrap(err, "failed to verify journal existence")
+	}
+
+	template := entity.NewEntryTemplate(journalID, req.Name)
+	template.Asset = req.Asset
+	template.Session = req.Session
+	template.TradeType = req.TradeType
+	template.Direction = req.Direction
+	template.MaxRR = req.MaxRR
+
+	if err := template.Validate(); err != nil {
+		s.logger.Error("invalid entry template data", zap.Error(err))
+		return nil, errors.Wrap(err, "invalid entry template data")
+	}
+
+	if err := s.storage.Create(ctx, template); err != nil {
+		s.logger.Error("failed to create entry template", zap.Error(err))
+		return nil, errors.Wrap(err, "failed to create entry template")
+	}
+
+	return template, nil
+}
+
+func (s *EntryTemplateService) GetJournalTemplates(ctx context.Context, journalID uuid.UUID, limit, offset int) ([]*entity.EntryTemplate, error) {
+	templates, err := s.storage.GetByJournalID(ctx, journalID, limit, offset)
+	if err != nil {
+		s.logger.Error("failed to get journal entry templates", zap.Error(err), zap.String("journal_id", journalID.String()))
+		return nil, errors.Wrap(err, "failed to get journal entry templates")
+	}
+
+	return templates, nil
+}
+
+func (s *EntryTemplateService) CountJournalTemplates(ctx context.Context, journalID uuid.UUID) (int, error) {
+	count, err := s.storage.CountByJournalID(ctx, journalID)
+	if err != nil {
+		s.logger.Error("failed to count journal entry templates", zap.Error(err), zap.String("journal_id", journalID.String()))
+		return 0, errors.Wrap(err, "failed to count journal entry templates")
+	}
+
+	return count, nil
+}
+
+func (s *EntryTemplateService) Delete(ctx context.Context, id uuid.UUID, journalID uuid.UUID) error {
+	if err := s.storage.Delete(ctx, id, journalID); err != nil {
+		s.logger.Error("failed to delete entry template", zap.Error(err), zap.String("id", id.String()))
+		return errors.Wrap(err, "failed to delete entry template")
+	}
+
+	return nil
+}
@@ -0,0 +1,127 @@
+package service
+
+import (
+	"context"
+	"testing"
+
+	"github.com/cockroachdb/errors"
+	"github.com/google/uuid"
+	"github.com/user/normark/internal/dto"
+	"github.com/user/normark/internal/entity"
+	"github.com/user/normark/internal/types"
+	"go.uber.org/zap"
+)
+
+// memoryTemplateStorage keeps entry templates per journal.
+type memoryTemplateStorage struct {
+	EntryTemplateStorage
+	templates []*entity.EntryTemplate
+}
+
+func (s *memoryTemplateStorage) Create(_ context.Context, template *entity.EntryTemplate) error {
+	template.ID = uuid.New()
+	s.templates = append(s.templates, template)
+	return nil
+}
+
+func (s *memoryTemplateStorage) Delete(_ context.Context, id uuid.UUID, journalID uuid.UUID) error {
+	for i, template := range s.templates {
+		if template.ID == id && template.JournalID == journalID {
+			s.templates = append(s.templates[:i], s.templates[i+1:]...)
+			return nil
+		}
+	}
+	return entity.ErrEntryTemplateNotFound
+}
+
+func TestCreateEntryTemplate(t *testing.T) {
+	journal := newTestJournal()
+	asset := types.CurrencyPairEURUSD
+	session := types.TradingSessionLondon
+	tradeType := types.TradeTypeIntraday
+	direction := types.TradeDirectionBuy
+
+	tests := []struct {
+		name      string
+		journalID uuid.UUID
+		req       dto.CreateEntryTemplateRequest
+		wantErr   error
+	}{
+		{
+			name:      "full setup",
+			journalID: journal.ID,
+			req:       dto.CreateEntryTemplateRequest{Name: "London breakout", Asset: &asset, Session: &session, TradeType: &tradeType, Direction: &direction, MaxRR: ptr(3.0)},
+		},
+		{
+			name:      "name only",
+			journalID: journal.ID,
+			req:       dto.CreateEntryTemplateRequest{Name: "Anything goes"},
+		},
+		{
+			name:      "missing journal",
+			journalID: uuid.New(),
+			req:       dto.CreateEntryTemplateRequest{Name: "London breakout"},
+			wantErr:   entity.ErrNotFound,
+		},
+		{
+			name:      "unknown asset",
+			journalID: journal.ID,
+			req:       dto.CreateEntryTemplateRequest{Name: "London breakout", Asset: ptr(types.CurrencyPair("DOGEUSD"))},
+			wantErr:   entity.ErrInvalidAsset,
+		},
+		{
+			name:      "non-positive max rr",
+			journalID: journal.ID,
+			req:       dto.CreateEntryTemplateRequest{Name: "London breakout", MaxRR: ptr(0.0)},
+			wantErr:   entity.ErrInvalidMaxRR,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			storage := &memoryTemplateStorage{}
+			svc := NewEntryTemplateService(storage, &fakeJournalStorage{journal: journal}, zap.NewNop())
+
+			template, err := svc.Create(context.Background(), tt.journalID, &tt.req)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("Create() error = %v, want %v", err, tt.wantErr)
+			}
+			if tt.wantErr != nil {
+				if len(storage.templates) != 0 {
+					t.Errorf("stored %d templates, want none", len(storage.templates))
+				}
+				return
+			}
+
+			if len(storage.templates) != 1 || storage.templates[0] != template {
+				t.Fatal("Create() did not store the returned template")
+			}
+			if template.JournalID != journal.ID || template.Name != tt.req.Name || template.Asset != tt.req.Asset ||
+				template.Session != tt.req.Session || template.TradeType != tt.req.TradeType ||
+				template.Direction != tt.req.Direction || template.MaxRR != tt.req.MaxRR {
+				t.Errorf("template = %+v, want the request's fields in journal %s", template, journal.ID)
+			}
+		})
+	}
+}
+
+func TestDeleteEntryTemplateOfAnotherJournal(t *testing.T) {
+	journal := newTestJournal()
+	storage := &memoryTemplateStorage{}
+	svc := NewEntryTemplateService(storage, &fakeJournalStorage{journal: journal}, zap.NewNop())
+
+	template, err := svc.Create(context.Background(), journal.ID, &dto.CreateEntryTemplateRequest{Name: "London breakout"})
+	if err != nil {
+		t.Fatalf("Create() error = %v", err)
+	}
+
+	if err := svc.Delete(context.Background(), template.ID, uuid.New()); !errors.Is(err, entity.ErrNotFound) {
+		t.Errorf("Delete() from another journal error = %v, want %v", err, entity.ErrNotFound)
+	}
+	if err := svc.Delete(context.Background(), template.ID, journal.ID); err != nil {
+		t.Errorf("Delete() error = %v", err)
+	}
+	if len(storage.templates) != 0 {
+		t.Errorf("%d templates left, want none", len(storage.templates))
+	}
+}
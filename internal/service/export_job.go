@@ -0,0 +1,277 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/cockroachdb/errors"
+	"github.com/google/uuid"
+	"github.com/redis/go-redis/v9"
+	"github.com/user/normark/internal/dto/mapper"
+	"github.com/user/normark/internal/entity"
+	"github.com/user/normark/pkg/fieldcrypt"
+	"go.uber.org/zap"
+)
+
+const (
+	// exportJobTTL is how long a job and its result stay downloadable.
+	exportJobTTL = time.Hour
+	// exportJobTimeout bounds a single export, well past the HTTP write
+	// timeout that synchronous exports are subject to.
+	exportJobTimeout = 5 * time.Minute
+	// maxConcurrentExports limits how many exports build at once; further
+	// jobs stay pending until a slot frees up.
+	maxConcurrentExports = 2
+	// maxQueuedExports limits how many jobs may be pending or running at
+	// once; Start refuses new jobs beyond it rather than piling up workers.
+	maxQueuedExports = 20
+	// exportSlotTimeout bounds how long a pending job waits for a slot
+	// before it fails.
+	exportSlotTimeout = 10 * time.Minute
+)
+
+type JournalExporter interface {
+	Export(ctx context.Context, id uuid.UUID, userID uuid.UUID) (*entity.TradingJournal, error)
+	VerifyAccess(ctx context.Context, journalID uuid.UUID, userID uuid.UUID) (bool, error)
+}
+
+// ExportJobService builds journal exports in the background and keeps jobs
+// and their results in the cache. Without a cache it refuses new jobs.
+type ExportJobService struct {
+	exporter    JournalExporter
+	cache       Cache
+	cipher      *fieldcrypt.Cipher
+	logger      *zap.Logger
+	slots       chan struct{}
+	queue       chan struct{}
+	slotTimeout time.Duration
+}
+
+func NewExportJobService(exporter JournalExporter, logger *zap.Logger) *ExportJobService {
+	return &ExportJobService{
+		exporter:    exporter,
+		logger:      logger,
+		slots:       make(chan struct{}, maxConcurrentExports),
+		queue:       make(chan struct{}, maxQueuedExports),
+		slotTimeout: exportSlotTimeout,
+	}
+}
+
+func (s *ExportJobService) WithCache(cache Cache) *ExportJobService {
+	s.cache = cache
+	return s
+}
+
+// WithCipher encrypts export results while they wait in the cache. They hold
+// the journal's decrypted notes, so they are sealed with the notes key.
+func (s *ExportJobService) WithCipher(cipher *fieldcrypt.Cipher) *ExportJobService {
+	s.cipher = cipher
+	return s
+}
+
+// Start queues an export of the journal and returns the pending job. It
+// returns entity.ErrExportQueueFull when too many jobs are already queued.
+func (s *ExportJobService) Start(ctx context.Context, journalID uuid.UUID, userID uuid.UUID) (*entity.ExportJob, error) {
+	if s.cache == nil {
+		return nil, entity.ErrAsyncExportDisabled
+	}
+
+	hasAccess, err := s.exporter.VerifyAccess(ctx, journalID, userID)
+	if err != nil {
+		s.logger.Error("failed to check journal ownership", zap.Error(err))
+		return nil, errors.Wrap(err, "failed to verify journal ownership")
+	}
+
+	if !hasAccess {
+		return nil, errors.Wrap(entity.ErrNotFound, "journal")
+	}
+
+	select {
+	case s.queue <- struct{}{}:
+	default:
+		return nil, entity.ErrExportQueueFull
+	}
+
+	job := entity.NewExportJob(userID, journalID)
+
+	if err := s.saveJob(ctx, job); err != nil {
+		<-s.queue
+		s.logger.Error("failed to save export job", zap.Error(err), zap.String("journal_id", journalID.String()))
+		return nil, errors.Wrap(err, "failed to save export job")
+	}
+
+	// The worker gets its own copy; the caller keeps the pending snapshot.
+	go s.run(*job)
+
+	return job, nil
+}
+
+// Get returns the user's job. Jobs of other users are reported as not found;
+// a failing cache is reported as an error, not as a missing job.
+func (s *ExportJobService) Get(ctx context.Context, jobID uuid.UUID, userID uuid.UUID) (*entity.ExportJob, error) {
+	if s.cache == nil {
+		return nil, entity.ErrAsyncExportDisabled
+	}
+
+	cached, err := s.cache.Get(ctx, exportJobKey(jobID))
+	if err != nil {
+		if errors.Is(err, redis.Nil) {
+			return nil, entity.ErrExportJobNotFound
+		}
+		s.logger.Error("failed to get export job", zap.Error(err), zap.String("job_id", jobID.String()))
+		return nil, errors.Wrap(err, "failed to get export job")
+	}
+
+	var job entity.ExportJob
+	if err := json.Unmarshal([]byte(cached), &job); err != nil {
+		s.logger.Error("failed to decode export job", zap.Error(err), zap.String("job_id", jobID.String()))
+		return nil, errors.Wrap(err, "failed to decode export job")
+	}
+
+	if job.UserID != userID {
+		return nil, entity.ErrExportJobNotFound
+	}
+
+	return &job, nil
+}
+
+// GetResult returns the export document of a ready job as JSON.
+func (s *ExportJobService) GetResult(ctx context.Context, jobID uuid.UUID, userID uuid.UUID) (*entity.ExportJob, []byte, error) {
+	job, err := s.Get(ctx, jobID, userID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if job.Status != entity.ExportJobStatusReady {
+		return nil, nil, entity.ErrExportJobNotReady
+	}
+
+	result, err := s.cache.Get(ctx, exportResultKey(jobID))
+	if err != nil {
+		if errors.Is(err, redis.Nil) {
+			// The result expired between reading the job and reading it.
+			return nil, nil, entity.ErrExportJobNotFound
+		}
+		s.logger.Error("failed to get export result", zap.Error(err), zap.String("job_id", jobID.String()))
+		return nil, nil, errors.Wrap(err, "failed to get export result")
+	}
+
+	if s.cipher != nil {
+		if result, err = s.cipher.Decrypt(result); err != nil {
+			s.logger.Error("failed to decrypt export result", zap.Error(err), zap.String("job_id", jobID.String()))
+			return nil, nil, errors.Wrap(err, "failed to decrypt export result")
+		}
+	}
+
+	return job, []byte(result), nil
+}
+
+func (s *ExportJobService) run(job entity.ExportJob) {
+	defer func() { <-s.queue }()
+
+	if !s.acquireSlot(&job) {
+		return
+	}
+	defer func() { <-s.slots }()
+
+	ctx, cancel := context.WithTimeout(context.Background(), exportJobTimeout)
+	defer cancel()
+
+	job.Status = entity.ExportJobStatusRunning
+	if err := s.saveJob(ctx, &job); err != nil {
+		s.logger.Warn("failed to mark export job running", zap.Error(err), zap.String("job_id", job.ID.String()))
+	}
+
+	result, err := s.build(ctx, &job)
+
+	completedAt := time.Now().UTC()
+	job.CompletedAt = &completedAt
+
+	if err == nil {
+		err = s.cache.Set(ctx, exportResultKey(job.ID), s.seal(result), exportJobTTL)
+	}
+
+	if err != nil {
+		s.logger.Error("export job failed", zap.Error(err), zap.String("job_id", job.ID.String()))
+		job.Status = entity.ExportJobStatusFailed
+		job.Error = "export failed"
+	} else {
+		job.Status = entity.ExportJobStatusReady
+	}
+
+	if err := s.saveJob(ctx, &job); err != nil {
+		s.logger.Error("failed to save finished export job", zap.Error(err), zap.String("job_id", job.ID.String()))
+	}
+}
+
+// acquireSlot waits for a free export slot. If none frees up within the slot
+// timeout, it marks the job failed and returns false.
+func (s *ExportJobService) acquireSlot(job *entity.ExportJob) bool {
+	timer := time.NewTimer(s.slotTimeout)
+	defer timer.Stop()
+
+	select {
+	case s.slots <- struct{}{}:
+		return true
+	case <-timer.C:
+	}
+
+	s.logger.Error("export job timed out waiting for a slot", zap.String("job_id", job.ID.String()))
+
+	completedAt := time.Now().UTC()
+	job.CompletedAt = &completedAt
+	job.Status = entity.ExportJobStatusFailed
+	job.Error = "export timed out in the queue"
+
+	ctx, cancel := context.WithTimeout(context.Background(), exportJobTimeout)
+	defer cancel()
+
+	if err := s.saveJob(ctx, job); err != nil {
+		s.logger.Error("failed to save timed out export job", zap.Error(err), zap.String("job_id", job.ID.String()))
+	}
+
+	return false
+}
+
+func (s *ExportJobService) build(ctx context.Context, job *entity.ExportJob) ([]byte, error) {
+	journal, err := s.exporter.Export(ctx, job.JournalID, job.UserID)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to export journal")
+	}
+
+	data, err := json.Marshal(mapper.ToJournalExportDocument(journal, time.Now().UTC()))
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to encode export document")
+	}
+
+	return data, nil
+}
+
+// seal returns the export result as stored in the cache: encrypted when a
+// cipher is configured.
+func (s *ExportJobService) seal(result []byte) string {
+	if s.cipher == nil {
+		return string(result)
+	}
+
+	return s.cipher.Encrypt(string(result))
+}
+
+func (s *ExportJobService) saveJob(ctx context.Context, job *entity.ExportJob) error {
+	data, err := json.Marshal(job)
+	if err != nil {
+		return errors.Wrap(err, "failed to encode export job")
+	}
+
+	return s.cache.Set(ctx, exportJobKey(job.ID), string(data), exportJobTTL)
+}
+
+func exportJobKey(id uuid.UUID) string {
+	return fmt.Sprintf("export_job:%s", id.String())
+}
+
+func exportResultKey(id uuid.UUID) string {
+	return fmt.Sprintf("export_job:%s:result", id.String())
+}
@@ -0,0 +1,308 @@
+package service
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/cockroachdb/errors"
+	"github.com/google/uuid"
+	"github.com/redis/go-redis/v9"
+	"github.com/user/normark/internal/dto"
+	"github.com/user/normark/internal/entity"
+	"github.com/user/normark/internal/types"
+	"github.com/user/normark/pkg/fieldcrypt"
+	"go.uber.org/zap"
+)
+
+// memoryCache is a Cache safe for the export workers to use concurrently.
+type memoryCache struct {
+	mu     sync.Mutex
+	values map[string]string
+}
+
+func newMemoryCache() *memoryCache {
+	return &memoryCache{values: make(map[string]string)}
+}
+
+func (c *memoryCache) Get(_ context.Context, key string) (string, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	value, ok := c.values[key]
+	if !ok {
+		return "", errors.Wrap(redis.Nil, "key not found")
+	}
+	return value, nil
+}
+
+func (c *memoryCache) Set(_ context.Context, key string, value any, _ time.Duration) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.values[key] = value.(string)
+	return nil
+}
+
+func (c *memoryCache) Delete(_ context.Context, keys ...string) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	for _, key := range keys {
+		delete(c.values, key)
+	}
+	return nil
+}
+
+// failingCache fails every read, as an unreachable Redis does.
+type failingCache struct {
+	Cache
+}
+
+func (failingCache) Get(context.Context, string) (string, error) {
+	return "", errors.New("connection refused")
+}
+
+// fakeJournalExporter exports journal. With release set, exports block until
+// it is closed.
+type fakeJournalExporter struct {
+	journal *entity.TradingJournal
+	err     error
+	release chan struct{}
+}
+
+func (e *fakeJournalExporter) Export(context.Context, uuid.UUID, uuid.UUID) (*entity.TradingJournal, error) {
+	if e.release != nil {
+		<-e.release
+	}
+	return e.journal, e.err
+}
+
+func (e *fakeJournalExporter) VerifyAccess(_ context.Context, journalID uuid.UUID, userID uuid.UUID) (bool, error) {
+	return journalID == e.journal.ID && userID == e.journal.UserID, nil
+}
+
+// waitForJob polls the job until it leaves the pending and running states.
+func waitForJob(t *testing.T, svc *ExportJobService, jobID uuid.UUID, userID uuid.UUID) *entity.ExportJob {
+	t.Helper()
+
+	deadline := time.Now().Add(5 * time.Second)
+	for time.Now().Before(deadline) {
+		job, err := svc.Get(context.Background(), jobID, userID)
+		if err != nil {
+			t.Fatalf("Get() error = %v", err)
+		}
+		if job.Status == entity.ExportJobStatusReady || job.Status == entity.ExportJobStatusFailed {
+			return job
+		}
+		time.Sleep(time.Millisecond)
+	}
+
+	t.Fatalf("export job %s did not finish", jobID)
+	return nil
+}
+
+func TestExportJob(t *testing.T) {
+	tests := []struct {
+		name       string
+		exportErr  error
+		wantStatus entity.ExportJobStatus
+	}{
+		{"export succeeds", nil, entity.ExportJobStatusReady},
+		{"export fails", errors.New("connection reset"), entity.ExportJobStatusFailed},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			journal := newTestJournal()
+			journal.Name = "Swing"
+			svc := NewExportJobService(&fakeJournalExporter{journal: journal, err: tt.exportErr}, zap.NewNop()).
+				WithCache(newMemoryCache())
+
+			job, err := svc.Start(context.Background(), journal.ID, journal.UserID)
+			if err != nil {
+				t.Fatalf("Start() error = %v", err)
+			}
+			if job.Status != entity.ExportJobStatusPending {
+				t.Errorf("started job status = %s, want %s", job.Status, entity.ExportJobStatusPending)
+			}
+
+			finished := waitForJob(t, svc, job.ID, journal.UserID)
+			if finished.Status != tt.wantStatus || finished.CompletedAt == nil {
+				t.Fatalf("finished job = %+v, want status %s with a completion time", finished, tt.wantStatus)
+			}
+
+			_, result, err := svc.GetResult(context.Background(), job.ID, journal.UserID)
+			if tt.wantStatus == entity.ExportJobStatusFailed {
+				if !errors.Is(err, entity.ErrExportJobNotReady) {
+					t.Errorf("GetResult() error = %v, want %v", err, entity.ErrExportJobNotReady)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("GetResult() error = %v", err)
+			}
+
+			var doc dto.JournalExportDocument
+			if err := json.Unmarshal(result, &doc); err != nil {
+				t.Fatalf("decode export document: %v", err)
+			}
+			if doc.Journal.Name != journal.Name {
+				t.Errorf("exported journal = %q, want %q", doc.Journal.Name, journal.Name)
+			}
+		})
+	}
+}
+
+func TestExportJobErrors(t *testing.T) {
+	journal := newTestJournal()
+	stranger := uuid.New()
+
+	t.Run("without cache", func(t *testing.T) {
+		svc := NewExportJobService(&fakeJournalExporter{journal: journal}, zap.NewNop())
+		if _, err := svc.Start(context.Background(), journal.ID, journal.UserID); !errors.Is(err, entity.ErrAsyncExportDisabled) {
+			t.Errorf("Start() error = %v, want %v", err, entity.ErrAsyncExportDisabled)
+		}
+	})
+
+	t.Run("journal of another user", func(t *testing.T) {
+		svc := NewExportJobService(&fakeJournalExporter{journal: journal}, zap.NewNop()).WithCache(newMemoryCache())
+		if _, err := svc.Start(context.Background(), journal.ID, stranger); !errors.Is(err, entity.ErrNotFound) {
+			t.Errorf("Start() error = %v, want %v", err, entity.ErrNotFound)
+		}
+	})
+
+	t.Run("job of another user", func(t *testing.T) {
+		svc := NewExportJobService(&fakeJournalExporter{journal: journal}, zap.NewNop()).WithCache(newMemoryCache())
+		job, err := svc.Start(context.Background(), journal.ID, journal.UserID)
+		if err != nil {
+			t.Fatalf("Start() error = %v", err)
+		}
+		waitForJob(t, svc, job.ID, journal.UserID)
+
+		if _, err := svc.Get(context.Background(), job.ID, stranger); !errors.Is(err, entity.ErrExportJobNotFound) {
+			t.Errorf("Get() error = %v, want %v", err, entity.ErrExportJobNotFound)
+		}
+		if _, _, err := svc.GetResult(context.Background(), job.ID, stranger); !errors.Is(err, entity.ErrExportJobNotFound) {
+			t.Errorf("GetResult() error = %v, want %v", err, entity.ErrExportJobNotFound)
+		}
+	})
+
+	t.Run("unknown job", func(t *testing.T) {
+		svc := NewExportJobService(&fakeJournalExporter{journal: journal}, zap.NewNop()).WithCache(newMemoryCache())
+		if _, err := svc.Get(context.Background(), uuid.New(), journal.UserID); !errors.Is(err, entity.ErrExportJobNotFound) {
+			t.Errorf("Get() error = %v, want %v", err, entity.ErrExportJobNotFound)
+		}
+	})
+}
+
+func TestExportJobQueueLimit(t *testing.T) {
+	journal := newTestJournal()
+	exporter := &fakeJournalExporter{journal: journal, release: make(chan struct{})}
+	svc := NewExportJobService(exporter, zap.NewNop()).WithCache(newMemoryCache())
+	svc.queue = make(chan struct{}, 1)
+
+	queued, err := svc.Start(context.Background(), journal.ID, journal.UserID)
+	if err != nil {
+		t.Fatalf("Start() error = %v", err)
+	}
+	if _, err := svc.Start(context.Background(), journal.ID, journal.UserID); !errors.Is(err, entity.ErrExportQueueFull) {
+		t.Fatalf("Start() with a full queue error = %v, want %v", err, entity.ErrExportQueueFull)
+	}
+
+	close(exporter.release)
+	waitForJob(t, svc, queued.ID, journal.UserID)
+
+	// The finished job's place frees up once its worker returns.
+	deadline := time.Now().Add(5 * time.Second)
+	for {
+		_, err := svc.Start(context.Background(), journal.ID, journal.UserID)
+		if err == nil {
+			break
+		}
+		if !errors.Is(err, entity.ErrExportQueueFull) || time.Now().After(deadline) {
+			t.Fatalf("Start() after the queue drained error = %v", err)
+		}
+		time.Sleep(time.Millisecond)
+	}
+}
+
+func TestExportJobSlotTimeout(t *testing.T) {
+	journal := newTestJournal()
+	svc := NewExportJobService(&fakeJournalExporter{journal: journal}, zap.NewNop()).WithCache(newMemoryCache())
+	svc.slotTimeout = 10 * time.Millisecond
+	for range maxConcurrentExports {
+		svc.slots <- struct{}{}
+	}
+
+	job, err := svc.Start(context.Background(), journal.ID, journal.UserID)
+	if err != nil {
+		t.Fatalf("Start() error = %v", err)
+	}
+
+	finished := waitForJob(t, svc, job.ID, journal.UserID)
+	if finished.Status != entity.ExportJobStatusFailed || finished.CompletedAt == nil || finished.Error == "" {
+		t.Errorf("job = %+v, want it failed with an error and a completion time", finished)
+	}
+}
+
+func TestExportJobCacheFailure(t *testing.T) {
+	journal := newTestJournal()
+	svc := NewExportJobService(&fakeJournalExporter{journal: journal}, zap.NewNop()).WithCache(failingCache{})
+
+	_, err := svc.Get(context.Background(), uuid.New(), journal.UserID)
+	if err == nil || errors.Is(err, entity.ErrNotFound) {
+		t.Errorf("Get() error = %v, want a failure other than not found", err)
+	}
+}
+
+func TestExportJobSealsCachedResult(t *testing.T) {
+	const notes = "moved stop too early"
+	cipher, err := fieldcrypt.New(bytes.Repeat([]byte{7}, fieldcrypt.KeySize))
+	if err != nil {
+		t.Fatalf("fieldcrypt.New() error = %v", err)
+	}
+
+	journal := newTestJournal()
+	entry := newTestEntry(journal.ID, types.TradeResultStopLoss, -40)
+	entry.Notes = notes
+	journal.Entries = []*entity.TradingJournalEntry{entry}
+	cache := newMemoryCache()
+	svc := NewExportJobService(&fakeJournalExporter{journal: journal}, zap.NewNop()).WithCache(cache).WithCipher(cipher)
+
+	job, err := svc.Start(context.Background(), journal.ID, journal.UserID)
+	if err != nil {
+		t.Fatalf("Start() error = %v", err)
+	}
+	waitForJob(t, svc, job.ID, journal.UserID)
+
+	stored, err := cache.Get(context.Background(), exportResultKey(job.ID))
+	if err != nil {
+		t.Fatalf("cached result: %v", err)
+	}
+	if !strings.HasPrefix(stored, "enc:v1:") || strings.Contains(stored, notes) {
+		t.Errorf("cached result %q is not sealed", stored)
+	}
+
+	_, result, err := svc.GetResult(context.Background(), job.ID, journal.UserID)
+	if err != nil {
+		t.Fatalf("GetResult() error = %v", err)
+	}
+	var doc dto.JournalExportDocument
+	if err := json.Unmarshal(result, &doc); err != nil {
+		t.Fatalf("decode export document: %v", err)
+	}
+	if len(doc.Entries) != 1 || doc.Entries[0].Notes != notes {
+		t.Errorf("exported entries = %+v, want the entry with its notes", doc.Entries)
+	}
+
+	// A result sealed under another key cannot be served.
+	_ = cache.Set(context.Background(), exportResultKey(job.ID), "enc:v1:AAAA", time.Hour)
+	if _, _, err := svc.GetResult(context.Background(), job.ID, journal.UserID); !errors.Is(err, fieldcrypt.ErrMalformed) {
+		t.Errorf("GetResult() of a tampered result error = %v, want %v", err, fieldcrypt.ErrMalformed)
+	}
+}
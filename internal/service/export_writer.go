@@ -0,0 +1,64 @@
+package service
+
+import (
+	"encoding/json"
+	"io"
+)
+
+// exportBatchSize is how many journals or entries are loaded per query while
+// streaming an export.
+const exportBatchSize = 500
+
+// exportWriter writes a JSON document piece by piece, keeping the first
+// error so callers can check once at the end.
+type exportWriter struct {
+	w   io.Writer
+	err error
+	// first is set until the open array has an element.
+	first bool
+}
+
+func (e *exportWriter) raw(s string) {
+	if e.err != nil {
+		return
+	}
+	_, e.err = io.WriteString(e.w, s)
+}
+
+// openArray writes s, which must end by opening an array.
+func (e *exportWriter) openArray(s string) {
+	e.raw(s)
+	e.first = true
+}
+
+func (e *exportWriter) json(v any) {
+	if e.err != nil {
+		return
+	}
+	b, err := json.Marshal(v)
+	if err != nil {
+		e.err = err
+		return
+	}
+	_, e.err = e.w.Write(b)
+}
+
+// item writes v as the next element of the open array.
+func (e *exportWriter) item(v any) {
+	if !e.first {
+		e.raw(",")
+	}
+	e.json(v)
+	e.first = false
+}
+
+// flush sends what has been written so far on to the client when w buffers
+// it, as HTTP response writers do.
+func (e *exportWriter) flush() {
+	if e.err != nil {
+		return
+	}
+	if f, ok := e.w.(interface{ Flush() }); ok {
+		f.Flush()
+	}
+}
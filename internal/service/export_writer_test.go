@@ -0,0 +1,92 @@
+package service
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+)
+
+// flushBuffer counts the flushes of the written document.
+type flushBuffer struct {
+	bytes.Buffer
+	flushes int
+}
+
+func (b *flushBuffer) Flush() { b.flushes++ }
+
+// failingWriter fails every write after the first n bytes.
+type failingWriter struct {
+	n   int
+	buf bytes.Buffer
+}
+
+var errWriteFailed = errors.New("connection reset")
+
+func (w *failingWriter) Write(p []byte) (int, error) {
+	if w.buf.Len()+len(p) > w.n {
+		return 0, errWriteFailed
+	}
+	return w.buf.Write(p)
+}
+
+func TestExportWriter(t *testing.T) {
+	tests := []struct {
+		name  string
+		items []any
+		want  string
+	}{
+		{"empty array", nil, `{"items":[]}`},
+		{"one item", []any{1}, `{"items":[1]}`},
+		{"items are comma separated", []any{1, "two", map[string]int{"three": 3}}, `{"items":[1,"two",{"three":3}]}`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var buf flushBuffer
+			out := &exportWriter{w: &buf}
+			out.openArray(`{"items":[`)
+			for _, item := range tt.items {
+				out.item(item)
+			}
+			out.flush()
+			out.raw("]}")
+
+			if out.err != nil {
+				t.Fatalf("err = %v", out.err)
+			}
+			if buf.String() != tt.want {
+				t.Errorf("wrote %s, want %s", buf.String(), tt.want)
+			}
+			if buf.flushes != 1 {
+				t.Errorf("flushed %d times, want 1", buf.flushes)
+			}
+		})
+	}
+
+	t.Run("keeps the first error", func(t *testing.T) {
+		w := &failingWriter{n: len(`{"items":[`)}
+		out := &exportWriter{w: w}
+		out.openArray(`{"items":[`)
+		out.item(1)
+		out.item(func() {})
+		out.raw("]}")
+
+		if !errors.Is(out.err, errWriteFailed) {
+			t.Errorf("err = %v, want %v", out.err, errWriteFailed)
+		}
+		if w.buf.String() != `{"items":[` {
+			t.Errorf("wrote %s after the error", w.buf.String())
+		}
+	})
+
+	t.Run("unencodable item", func(t *testing.T) {
+		var buf bytes.Buffer
+		out := &exportWriter{w: &buf}
+		out.openArray(`[`)
+		out.item(func() {})
+
+		if out.err == nil {
+			t.Error("err = nil, want an encoding error")
+		}
+	})
+}
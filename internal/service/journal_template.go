@@ -0,0 +1,93 @@
+package service
+
+import (
+	"context"
+
+	"github.com/cockroachdb/errors"
+	"github.com/google/uuid"
+	"github.com/user/normark/internal/dto"
+	"github.com/user/normark/internal/entity"
+	"go.uber.org/zap"
+)
+
+type JournalTemplateStorage interface {
+	Create(ctx context.Context, template *entity.JournalTemplate) error
+	GetByID(ctx context.Context, id uuid.UUID) (*entity.JournalTemplate, error)
+	GetByUserID(ctx context.Context, userID uuid.UUID, limit, offset int) ([]*entity.JournalTemplate, error)
+	CountByUserID(ctx context.Context, userID uuid.UUID) (int, error)
+	Delete(ctx context.Context, id uuid.UUID) error
+	Exists(ctx context.Context, id uuid.UUID, userID uuid.UUID) (bool, error)
+}
+
+type JournalTemplateService struct {
+	storage JournalTemplateStorage
+	logger  *zap.Logger
+}
+
+func NewJournalTemplateService(
+	storage JournalTemplateStorage,
+	logger *zap.Logger,
+) *JournalTemplateService {
+	return &JournalTemplateService{
+		storage: storage,
+		logger:  logger,
+	}
+}
+
+func (s *JournalTemplateService) Create(ctx context.Context, userID uuid.UUID, req *dto.CreateJournalTemplateRequest) (*entity.JournalTemplate, error) {
+	template := entity.NewJournalTemplate(userID, req.Name, req.Description)
+	template.DefaultAsset = req.DefaultAsset
+	template.DefaultSession = req.DefaultSession
+	template.Tags = req.Tags
+
+	if err := template.Validate(); err != nil {
+		s.logger.Error("invalid journal template data", zap.Error(err))
+		return nil, errors.Wrap(err, "invalid journal template data")
+	}
+
+	if err := s.storage.Create(ctx, template); err != nil {
+		s.logger.Error("failed to create journal template", zap.Error(err))
+		return nil, errors.Wrap(err, "failed to create journal template")
+	}
+
+	return template, nil
+}
+
+func (s *JournalTemplateService) GetUserTemplates(ctx context.Context, userID uuid.UUID, limit, offset int) ([]*entity.JournalTemplate, error) {
+	templates, err := s.storage.GetByUserID(ctx, userID, limit, offset)
+	if err != nil {
+		s.logger.Error("failed to get user journal templates", zap.Error(err), zap.String("user_id", userID.String()))
+		return nil, errors.Wrap(err, "failed to get user journal templates")
+	}
+
+	return templates, nil
+}
+
+func (s *JournalTemplateService) CountUserTemplates(ctx context.Context, userID uuid.UUID) (int, error) {
+	count, err := s.storage.CountByUserID(ctx, userID)
+	if err != nil {
+		s.logger.Error("failed to count user journal templates", zap.Error(err), zap.String("user_id", userID.String()))
+		return 0, errors.Wrap(err, "failed to count user journal templates")
+	}
+
+	return count, nil
+}
+
+func (s *JournalTemplateService) Delete(ctx context.Context, id uuid.UUID, userID uuid.UUID) error {
+	exists, err := s.storage.Exists(ctx, id, userID)
+	if err != nil {
+		s.logger.Error("failed to check journal template ownership", zap.Error(err))
+		return errors.Wrap(err, "failed to verify journal template ownership")
+	}
+
+	if !exists {
+		return errors.Wrap(entity.ErrNotFound, "journal template")
+	}
+
+	if err := s.storage.Delete(ctx, id); err != nil {
+		s.logger.Error("failed to delete journal template", zap.Error(err), zap.String("id", id.String()))
+		return errors.Wrap(err, "failed to delete journal template")
+	}
+
+	return nil
+}
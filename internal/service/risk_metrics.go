@@ -0,0 +1,54 @@
+package service
+
+import "math"
+
+// kellyFraction returns the Kelly criterion f* = W - (1-W)/R, where W is the
+// win probability and R the payoff ratio avgWin/avgLoss. winRate is a
+// percentage (0-100); avgWin and avgLoss are positive amounts.
+//
+// The formula assumes independent trades with fixed average win and loss
+// sizes. It returns 0 when there are no wins or no losses to derive R from,
+// and is clamped to [0, 1]: a negative edge means "don't trade", and no
+// sizing above the full bankroll makes sense.
+func kellyFraction(winRate, avgWin, avgLoss float64) float64 {
+	if winRate <= 0 || avgWin <= 0 || avgLoss <= 0 {
+		return 0
+	}
+
+	w := winRate / 100
+	r := avgWin / avgLoss
+
+	return clamp01(w - (1-w)/r)
+}
+
+// riskOfRuin estimates the probability of losing the whole account using the
+// classic approximation ((1-E)/(1+E))^U, where E = W*R - (1-W) is the
+// expectancy per unit risked and U = 100/riskPercent is the number of
+// average-sized losses the account can absorb.
+//
+// It assumes a constant risk per trade equal to the journal's average risk
+// percent. It returns 0 when the inputs are insufficient (no wins, no losses
+// or no recorded risk) and 1 when the edge is not positive.
+func riskOfRuin(winRate, avgWin, avgLoss, riskPercent float64) float64 {
+	if winRate <= 0 || avgWin <= 0 || avgLoss <= 0 || riskPercent <= 0 {
+		return 0
+	}
+
+	w := winRate / 100
+	edge := w*(avgWin/avgLoss) - (1 - w)
+
+	if edge <= 0 {
+		return 1
+	}
+	if edge >= 1 {
+		return 0
+	}
+
+	units := 100 / riskPercent
+
+	return clamp01(math.Pow((1-edge)/(1+edge), units))
+}
+
+func clamp01(v float64) float64 {
+	return math.Max(0, math.Min(1, v))
+}
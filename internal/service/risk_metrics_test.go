@@ -0,0 +1,117 @@
+package service
+
+import (
+	"math"
+	"testing"
+
+	"github.com/user/normark/internal/entity"
+)
+
+const metricTolerance = 1e-9
+
+func TestKellyFraction(t *testing.T) {
+	tests := []struct {
+		name    string
+		winRate float64
+		avgWin  float64
+		avgLoss float64
+		want    float64
+	}{
+		{"positive edge", 60, 200, 100, 0.4},
+		{"uneven payoff", 55, 150, 100, 0.25},
+		{"break-even edge", 50, 100, 100, 0},
+		{"negative edge clamps to zero", 40, 100, 100, 0},
+		{"every trade wins", 100, 150, 100, 1},
+		{"no wins", 0, 0, 100, 0},
+		{"no losses", 100, 150, 0, 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := kellyFraction(tt.winRate, tt.avgWin, tt.avgLoss); math.Abs(got-tt.want) > metricTolerance {
+				t.Errorf("kellyFraction() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRiskOfRuin(t *testing.T) {
+	tests := []struct {
+		name        string
+		winRate     float64
+		avgWin      float64
+		avgLoss     float64
+		riskPercent float64
+		want        float64
+	}{
+		// E = 0.5*1.2 - 0.5 = 0.1, U = 10: (0.9/1.1)^10.
+		{"thin edge", 50, 120, 100, 10, 0.13443063274931186},
+		// E = 0.6*2 - 0.4 = 0.8, U = 2: (0.2/1.8)^2.
+		{"large risk per trade", 60, 200, 100, 50, 1.0 / 81},
+		{"no edge", 50, 100, 100, 1, 1},
+		{"negative edge", 40, 100, 100, 1, 1},
+		{"edge of one or more", 80, 200, 100, 1, 0},
+		{"no risk recorded", 60, 200, 100, 0, 0},
+		{"no losses", 100, 200, 0, 1, 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := riskOfRuin(tt.winRate, tt.avgWin, tt.avgLoss, tt.riskPercent); math.Abs(got-tt.want) > metricTolerance {
+				t.Errorf("riskOfRuin() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestCompleteStatisticsRiskMetrics(t *testing.T) {
+	// 6 of 10 trades won, winners average twice the losers, risking 50%.
+	stats := &entity.EntryStatistics{
+		TotalTrades:    10,
+		Wins:           6,
+		AvgWin:         200,
+		AvgLoss:        100,
+		AvgRiskPercent: 50,
+	}
+
+	completeStatistics(stats)
+
+	if stats.WinRate != 60 {
+		t.Errorf("win rate = %v, want 60", stats.WinRate)
+	}
+	if math.Abs(stats.KellyFraction-0.4) > metricTolerance {
+		t.Errorf("kelly fraction = %v, want 0.4", stats.KellyFraction)
+	}
+	if math.Abs(stats.RiskOfRuin-1.0/81) > metricTolerance {
+		t.Errorf("risk of ruin = %v, want %v", stats.RiskOfRuin, 1.0/81)
+	}
+}
+
+func TestCompleteStatisticsWinRate(t *testing.T) {
+	tests := []struct {
+		name  string
+		stats entity.EntryStatistics
+		want  float64
+	}{
+		{name: "losses only", stats: entity.EntryStatistics{TotalTrades: 3, Losses: 3, AvgLoss: 100}, want: 0},
+		{name: "no trades", stats: entity.EntryStatistics{}, want: 0},
+		{name: "wins losses and break even", stats: entity.EntryStatistics{TotalTrades: 4, Wins: 1, Losses: 2, BreakEven: 1}, want: 25},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			stats := tt.stats
+			completeStatistics(&stats)
+
+			if stats.WinRate != tt.want {
+				t.Errorf("win rate = %v, want %v", stats.WinRate, tt.want)
+			}
+			if stats.Losses != tt.stats.Losses {
+				t.Errorf("losses = %d, want %d", stats.Losses, tt.stats.Losses)
+			}
+			if math.IsNaN(stats.KellyFraction) || math.IsNaN(stats.RiskOfRuin) {
+				t.Errorf("kelly fraction = %v, risk of ruin = %v, want numbers", stats.KellyFraction, stats.RiskOfRuin)
+			}
+		})
+	}
+}
@@ -3,6 +3,8 @@ package service
 import (
 	"context"
 	"time"
+
+	"github.com/user/normark/internal/types"
 )
 
 type Cache interface {
@@ -10,3 +12,11 @@ type Cache interface {
 	Set(ctx context.Context, key string, value any, expiration time.Duration) error
 	Delete(ctx context.Context, keys ...string) error
 }
+
+// Metrics records business events. Services call it only after the event
+// has been persisted.
+type Metrics interface {
+	UserSignedUp()
+	JournalCreated()
+	EntryCreated(asset types.CurrencyPair)
+}
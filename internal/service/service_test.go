@@ -0,0 +1,132 @@
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/user/normark/internal/dto"
+	"github.com/user/normark/internal/entity"
+	"github.com/user/normark/internal/types"
+	"github.com/user/normark/pkg/auth"
+	"go.uber.org/zap"
+)
+
+// countingMetrics counts the business events recorded with it.
+type countingMetrics struct {
+	signUps  int
+	journals int
+	entries  map[types.CurrencyPair]int
+}
+
+func newCountingMetrics() *countingMetrics {
+	return &countingMetrics{entries: make(map[types.CurrencyPair]int)}
+}
+
+func (m *countingMetrics) UserSignedUp()   { m.signUps++ }
+func (m *countingMetrics) JournalCreated() { m.journals++ }
+
+func (m *countingMetrics) EntryCreated(asset types.CurrencyPair) {
+	m.entries[asset]++
+}
+
+func TestSignUpRecordsMetric(t *testing.T) {
+	tests := []struct {
+		name      string
+		createErr error
+		want      int
+	}{
+		{"signed up", nil, 1},
+		{"insert fails", entity.ErrUserAlreadyExists, 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			jwtManager, err := auth.NewJWTManager(testJWTSecret, 15, 60)
+			if err != nil {
+				t.Fatalf("NewJWTManager() error = %v", err)
+			}
+			metrics := newCountingMetrics()
+			svc := NewUserService(&racingUserStorage{createErr: tt.createErr}, newMemorySessionStorage(), jwtManager, zap.NewNop()).
+				WithMetrics(metrics)
+
+			_, _ = svc.SignUp(context.Background(), &dto.SignUpRequest{Email: "trader@example.com", Username: "trader", Password: "password123"})
+			if metrics.signUps != tt.want {
+				t.Errorf("sign-ups = %d, want %d", metrics.signUps, tt.want)
+			}
+		})
+	}
+}
+
+func TestCreateJournalRecordsMetric(t *testing.T) {
+	tests := []struct {
+		name        string
+		journalName string
+		want        int
+	}{
+		{"created", "Swing", 1},
+		{"invalid journal", "", 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			metrics := newCountingMetrics()
+			storage := &namedJournalStorage{journals: make(map[string]*entity.TradingJournal)}
+			svc := NewTradingJournalService(storage, nil, zap.NewNop()).WithMetrics(metrics)
+
+			_, _ = svc.Create(context.Background(), uuid.New(), &dto.CreateTradingJournalRequest{Name: tt.journalName})
+			if metrics.journals != tt.want {
+				t.Errorf("journals created = %d, want %d", metrics.journals, tt.want)
+			}
+		})
+	}
+}
+
+func TestCreateEntryRecordsMetricByAsset(t *testing.T) {
+	tests := []struct {
+		name   string
+		locked bool
+		assets []types.CurrencyPair
+		want   map[types.CurrencyPair]int
+	}{
+		{
+			name:   "counted per asset",
+			assets: []types.CurrencyPair{types.CurrencyPairEURUSD, types.CurrencyPairGBPUSD, types.CurrencyPairEURUSD},
+			want:   map[types.CurrencyPair]int{types.CurrencyPairEURUSD: 2, types.CurrencyPairGBPUSD: 1},
+		},
+		{
+			name:   "locked journal",
+			locked: true,
+			assets: []types.CurrencyPair{types.CurrencyPairEURUSD},
+			want:   map[types.CurrencyPair]int{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			journal := newTestJournal()
+			metrics := newCountingMetrics()
+			svc := NewTradingJournalEntryService(&fakeEntryStorage{}, &fakeJournalStorage{journal: journal, locked: tt.locked}, nil, zap.NewNop()).
+				WithMetrics(metrics)
+
+			for _, asset := range tt.assets {
+				_, _ = svc.Create(context.Background(), journal.ID, &dto.CreateTradingJournalEntryRequest{
+					Day: time.Date(2026, 1, 5, 0, 0, 0, 0, time.UTC), Asset: asset,
+					LTF: "https://charts.example.com/ltf", HTF: "https://charts.example.com/htf",
+					Session: types.TradingSessionLondon, TradeType: types.TradeTypeIntraday, Direction: types.TradeDirectionBuy,
+					EntryType: types.EntryTypeMarket, Realized: 100, MaxRR: 2, Result: types.TradeResultTakeProfit,
+				})
+			}
+
+			if len(metrics.entries) != len(tt.want) {
+				t.Fatalf("entries created = %v, want %v", metrics.entries, tt.want)
+			}
+			for asset, want := range tt.want {
+				if got := metrics.entries[asset]; got != want {
+					t.Errorf("entries created for %s = %d, want %d", asset, got, want)
+				}
+			}
+		})
+	}
+}
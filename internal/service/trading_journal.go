@@ -4,41 +4,62 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"io"
 	"time"
 
 	"github.com/cockroachdb/errors"
 	"github.com/google/uuid"
 	"github.com/user/normark/internal/dto"
+	"github.com/user/normark/internal/dto/mapper"
 	"github.com/user/normark/internal/entity"
+	bunstorage "github.com/user/normark/internal/storage/bun"
 	"go.uber.org/zap"
 )
 
 type TradingJournalStorage interface {
 	Create(ctx context.Context, journal *entity.TradingJournal) error
+	CreateUnlessNameExists(ctx context.Context, journal *entity.TradingJournal) (*entity.TradingJournal, bool, error)
+	CreateWithEntries(ctx context.Context, journal *entity.TradingJournal, entries []*entity.TradingJournalEntry) error
+	AddEntries(ctx context.Context, journalID uuid.UUID, entries []*entity.TradingJournalEntry) error
 	GetByID(ctx context.Context, id uuid.UUID) (*entity.TradingJournal, error)
 	GetByIDWithEntries(ctx context.Context, id uuid.UUID) (*entity.TradingJournal, error)
-	GetByUserID(ctx context.Context, userID uuid.UUID, limit, offset int) ([]*entity.TradingJournal, error)
+	GetEntries(ctx context.Context, journalID uuid.UUID, limit, offset int) ([]*entity.TradingJournalEntry, error)
+	GetByName(ctx context.Context, userID uuid.UUID, name string) (*entity.TradingJournal, error)
+	GetByUserID(ctx context.Context, userID uuid.UUID, limit, offset int, includeArchived bool, broker string) ([]*entity.TradingJournal, error)
 	Update(ctx context.Context, journal *entity.TradingJournal) error
+	SetArchived(ctx context.Context, id uuid.UUID, archived bool) error
+	SetLocked(ctx context.Context, id uuid.UUID, locked bool) error
+	IsLocked(ctx context.Context, id uuid.UUID) (bool, error)
+	RecomputeSummary(ctx context.Context, id uuid.UUID) (*entity.JournalSummary, error)
 	Delete(ctx context.Context, id uuid.UUID) error
 	List(ctx context.Context, limit, offset int) ([]*entity.TradingJournal, error)
 	Count(ctx context.Context) (int, error)
-	CountByUserID(ctx context.Context, userID uuid.UUID) (int, error)
+	CountByUserID(ctx context.Context, userID uuid.UUID, includeArchived bool, broker string) (int, error)
+	CountEntries(ctx context.Context, journalID uuid.UUID) (int, error)
 	Exists(ctx context.Context, id uuid.UUID, userID uuid.UUID) (bool, error)
+	NameTaken(ctx context.Context, userID uuid.UUID, name string, excludeID uuid.UUID) (bool, error)
 }
 
 type TradingJournalService struct {
-	storage TradingJournalStorage
-	cache   Cache
-	logger  *zap.Logger
+	storage         TradingJournalStorage
+	templateStorage JournalTemplateStorage
+	cache           Cache
+	metrics         Metrics
+	uniqueNames     bool
+	gradeWeights    entity.GradeWeights
+	logger          *zap.Logger
 }
 
 func NewTradingJournalService(
 	storage TradingJournalStorage,
+	templateStorage JournalTemplateStorage,
 	logger *zap.Logger,
 ) *TradingJournalService {
 	return &TradingJournalService{
-		storage: storage,
-		logger:  logger,
+		storage:         storage,
+		templateStorage: templateStorage,
+		gradeWeights:    entity.DefaultGradeWeights,
+		logger:          logger,
 	}
 }
 
@@ -47,12 +68,57 @@ func (s *TradingJournalService) WithCache(cache Cache) *TradingJournalService {
 	return s
 }
 
+func (s *TradingJournalService) WithMetrics(metrics Metrics) *TradingJournalService {
+	s.metrics = metrics
+	return s
+}
+
+// WithUniqueNames rejects creating or renaming a journal to a name the user
+// already uses, ignoring case.
+func (s *TradingJournalService) WithUniqueNames() *TradingJournalService {
+	s.uniqueNames = true
+	return s
+}
+
+// WithGradeWeights grades imported entries with w instead of
+// entity.DefaultGradeWeights.
+func (s *TradingJournalService) WithGradeWeights(w entity.GradeWeights) *TradingJournalService {
+	s.gradeWeights = w
+	return s
+}
+
+// checkNameAvailable returns entity.ErrJournalNameTaken when unique names
+// are enforced and another of the user's journals has the journal's name.
+// The check runs before the write, not in its transaction, so two concurrent
+// creates or renames to the same name can both pass it; the schema does not
+// enforce unique names because they are optional. CreateDeduplicated is not
+// affected, as it looks the name up under a lock.
+func (s *TradingJournalService) checkNameAvailable(ctx context.Context, journal *entity.TradingJournal) error {
+	if !s.uniqueNames {
+		return nil
+	}
+
+	taken, err := s.storage.NameTaken(ctx, journal.UserID, journal.Name, journal.ID)
+	if err != nil {
+		s.logger.Error("failed to check journal name availability", zap.Error(err))
+		return errors.Wrap(err, "failed to check journal name availability")
+	}
+
+	if taken {
+		return entity.ErrJournalNameTaken
+	}
+
+	return nil
+}
+
 func (s *TradingJournalService) Create(ctx context.Context, userID uuid.UUID, req *dto.CreateTradingJournalRequest) (*entity.TradingJournal, error) {
-	journal := entity.NewTradingJournal(userID, req.Name, req.Description)
+	journal, err := s.newJournal(ctx, userID, req)
+	if err != nil {
+		return nil, err
+	}
 
-	if err := journal.Validate(); err != nil {
-		s.logger.Error("invalid trading journal data", zap.Error(err))
-		return nil, errors.Wrap(err, "invalid trading journal data")
+	if err := s.checkNameAvailable(ctx, journal); err != nil {
+		return nil, err
 	}
 
 	if err := s.storage.Create(ctx, journal); err != nil {
@@ -60,9 +126,73 @@ func (s *TradingJournalService) Create(ctx context.Context, userID uuid.UUID, re
 		return nil, errors.Wrap(err, "failed to create trading journal")
 	}
 
+	if s.metrics != nil {
+		s.metrics.JournalCreated()
+	}
+
 	return journal, nil
 }
 
+// newJournal builds and validates a journal from a create request, applying
+// its template if it names one.
+func (s *TradingJournalService) newJournal(ctx context.Context, userID uuid.UUID, req *dto.CreateTradingJournalRequest) (*entity.TradingJournal, error) {
+	journal := entity.NewTradingJournal(userID, req.Name, req.Description)
+	journal.DefaultAsset = req.DefaultAsset
+	journal.DefaultSession = req.DefaultSession
+	journal.Tags = req.Tags
+	journal.RequireNotesOnLoss = req.RequireNotesOnLoss
+	if req.ValidationProfile != "" {
+		journal.ValidationProfile = req.ValidationProfile
+	}
+	journal.Broker = req.Broker
+	journal.AccountNumber = req.AccountNumber
+	journal.PipValue = req.PipValue
+
+	if req.TemplateID != nil {
+		template, err := s.templateStorage.GetByID(ctx, *req.TemplateID)
+		if err != nil {
+			s.logger.Error("failed to get journal template", zap.Error(err), zap.String("template_id", req.TemplateID.String()))
+			return nil, errors.Wrap(err, "failed to get journal template")
+		}
+
+		if template.UserID != userID {
+			return nil, errors.Wrap(entity.ErrNotFound, "journal template")
+		}
+
+		template.ApplyTo(journal)
+	}
+
+	if err := journal.Validate(); err != nil {
+		s.logger.Error("invalid trading journal data", zap.Error(err))
+		return nil, errors.Wrap(err, "invalid trading journal data")
+	}
+
+	return journal, nil
+}
+
+// CreateDeduplicated returns the user's existing journal with the same name
+// instead of creating a duplicate. The boolean reports whether a new journal
+// was created. The lookup and the insert happen in one storage call, so
+// concurrent retries of the same request create a single journal.
+func (s *TradingJournalService) CreateDeduplicated(ctx context.Context, userID uuid.UUID, req *dto.CreateTradingJournalRequest) (*entity.TradingJournal, bool, error) {
+	journal, err := s.newJournal(ctx, userID, req)
+	if err != nil {
+		return nil, false, err
+	}
+
+	journal, created, err := s.storage.CreateUnlessNameExists(ctx, journal)
+	if err != nil {
+		s.logger.Error("failed to create deduplicated trading journal", zap.Error(err), zap.String("user_id", userID.String()))
+		return nil, false, errors.Wrap(err, "failed to create deduplicated trading journal")
+	}
+
+	if created && s.metrics != nil {
+		s.metrics.JournalCreated()
+	}
+
+	return journal, created, nil
+}
+
 func (s *TradingJournalService) GetByID(ctx context.Context, id uuid.UUID) (*entity.TradingJournal, error) {
 	cacheKey := fmt.Sprintf("journal:%s", id.String())
 
@@ -96,32 +226,65 @@ func (s *TradingJournalService) GetByID(ctx context.Context, id uuid.UUID) (*ent
 	return journal, nil
 }
 
-func (s *TradingJournalService) GetByIDWithEntries(ctx context.Context, id uuid.UUID) (*entity.TradingJournal, error) {
-	journal, err := s.storage.GetByIDWithEntries(ctx, id)
+// GetByIDWithEntries returns the journal with one page of its entries, newest
+// first, and the total number of entries.
+func (s *TradingJournalService) GetByIDWithEntries(ctx context.Context, id uuid.UUID, limit, offset int) (*entity.TradingJournal, int, error) {
+	journal, err := s.storage.GetByID(ctx, id)
 	if err != nil {
 		s.logger.Error("failed to get trading journal by id with entries", zap.Error(err), zap.String("id", id.String()))
-		return nil, errors.Wrap(err, "failed to get trading journal with entries")
+		return nil, 0, errors.Wrap(err, "failed to get trading journal with entries")
 	}
 
-	return journal, nil
+	entries, err := s.storage.GetEntries(ctx, id, limit, offset)
+	if err != nil {
+		s.logger.Error("failed to get trading journal entries", zap.Error(err), zap.String("id", id.String()))
+		return nil, 0, errors.Wrap(err, "failed to get trading journal with entries")
+	}
+
+	total, err := s.storage.CountEntries(ctx, id)
+	if err != nil {
+		s.logger.Error("failed to count trading journal entries", zap.Error(err), zap.String("id", id.String()))
+		return nil, 0, errors.Wrap(err, "failed to get trading journal with entries")
+	}
+
+	journal.Entries = entries
+	return journal, total, nil
 }
 
-func (s *TradingJournalService) GetUserJournals(ctx context.Context, userID uuid.UUID, limit, offset int) ([]*entity.TradingJournal, error) {
-	journals, err := s.storage.GetByUserID(ctx, userID, limit, offset)
+// GetUserJournals returns a page of the user's journals. A non-empty broker
+// keeps only the journals of that broker, ignoring case.
+func (s *TradingJournalService) GetUserJournals(ctx context.Context, userID uuid.UUID, limit, offset int, includeArchived bool, broker string) ([]*entity.TradingJournal, error) {
+	ctx = bunstorage.WithReplicaReads(ctx)
+
+	journals, err := s.storage.GetByUserID(ctx, userID, limit, offset, includeArchived, broker)
 	if err != nil {
 		s.logger.Error("failed to get user journals", zap.Error(err), zap.String("user_id", userID.String()))
 		return nil, errors.Wrap(err, "failed to get user journals")
 	}
 
+	for _, journal := range journals {
+		if journal.Summary != nil {
+			setSummaryWinRate(journal.Summary)
+		}
+	}
+
 	return journals, nil
 }
 
 func (s *TradingJournalService) Update(ctx context.Context, journal *entity.TradingJournal) error {
+	if err := ensureJournalUnlocked(ctx, s.storage, journal.ID); err != nil {
+		return err
+	}
+
 	if err := journal.Validate(); err != nil {
 		s.logger.Error("invalid trading journal data", zap.Error(err))
 		return errors.Wrap(err, "invalid trading journal data")
 	}
 
+	if err := s.checkNameAvailable(ctx, journal); err != nil {
+		return err
+	}
+
 	if err := s.storage.Update(ctx, journal); err != nil {
 		s.logger.Error("failed to update trading journal", zap.Error(err), zap.String("id", journal.ID.String()))
 		return errors.Wrap(err, "failed to update trading journal")
@@ -137,6 +300,393 @@ func (s *TradingJournalService) Update(ctx context.Context, journal *entity.Trad
 	return nil
 }
 
+func (s *TradingJournalService) SetArchived(ctx context.Context, id uuid.UUID, userID uuid.UUID, archived bool) (*entity.TradingJournal, error) {
+	exists, err := s.storage.Exists(ctx, id, userID)
+	if err != nil {
+		s.logger.Error("failed to check journal ownership", zap.Error(err))
+		return nil, errors.Wrap(err, "failed to verify journal ownership")
+	}
+
+	if !exists {
+		return nil, errors.Wrap(entity.ErrNotFound, "trading journal")
+	}
+
+	journal, err := s.storage.GetByID(ctx, id)
+	if err != nil {
+		s.logger.Error("failed to get trading journal by id", zap.Error(err), zap.String("id", id.String()))
+		return nil, errors.Wrap(err, "failed to get trading journal")
+	}
+
+	if err := s.storage.SetArchived(ctx, id, archived); err != nil {
+		s.logger.Error("failed to set journal archived flag", zap.Error(err), zap.String("id", id.String()), zap.Bool("archived", archived))
+		return nil, errors.Wrap(err, "failed to set journal archived flag")
+	}
+
+	if s.cache != nil {
+		cacheKey := fmt.Sprintf("journal:%s", id.String())
+		if err := s.cache.Delete(ctx, cacheKey); err != nil {
+			s.logger.Warn("failed to invalidate cache after archive", zap.Error(err))
+		}
+	}
+
+	journal.IsArchived = archived
+	return journal, nil
+}
+
+// SetLocked makes the journal read-only, or writable again. While locked,
+// the journal and its entries reject every write with ErrJournalLocked.
+func (s *TradingJournalService) SetLocked(ctx context.Context, id uuid.UUID, userID uuid.UUID, locked bool) (*entity.TradingJournal, error) {
+	exists, err := s.storage.Exists(ctx, id, userID)
+	if err != nil {
+		s.logger.Error("failed to check journal ownership", zap.Error(err))
+		return nil, errors.Wrap(err, "failed to verify journal ownership")
+	}
+
+	if !exists {
+		return nil, errors.Wrap(entity.ErrNotFound, "trading journal")
+	}
+
+	journal, err := s.storage.GetByID(ctx, id)
+	if err != nil {
+		s.logger.Error("failed to get trading journal by id", zap.Error(err), zap.String("id", id.String()))
+		return nil, errors.Wrap(err, "failed to get trading journal")
+	}
+
+	if err := s.storage.SetLocked(ctx, id, locked); err != nil {
+		s.logger.Error("failed to set journal locked flag", zap.Error(err), zap.String("id", id.String()), zap.Bool("locked", locked))
+		return nil, errors.Wrap(err, "failed to set journal locked flag")
+	}
+
+	if s.cache != nil {
+		cacheKey := fmt.Sprintf("journal:%s", id.String())
+		if err := s.cache.Delete(ctx, cacheKey); err != nil {
+			s.logger.Warn("failed to invalidate cache after lock", zap.Error(err))
+		}
+	}
+
+	journal.IsLocked = locked
+	return journal, nil
+}
+
+// RecomputeSummary rebuilds the journal's stored summary from its entries,
+// repairing any drift in the incrementally maintained totals.
+func (s *TradingJournalService) RecomputeSummary(ctx context.Context, id uuid.UUID, userID uuid.UUID) (*entity.JournalSummary, error) {
+	exists, err := s.storage.Exists(ctx, id, userID)
+	if err != nil {
+		s.logger.Error("failed to check journal ownership", zap.Error(err))
+		return nil, errors.Wrap(err, "failed to verify journal ownership")
+	}
+
+	if !exists {
+		return nil, errors.Wrap(entity.ErrNotFound, "trading journal")
+	}
+
+	summary, err := s.storage.RecomputeSummary(ctx, id)
+	if err != nil {
+		s.logger.Error("failed to recompute journal summary", zap.Error(err), zap.String("id", id.String()))
+		return nil, errors.Wrap(err, "failed to recompute journal summary")
+	}
+
+	setSummaryWinRate(summary)
+	return summary, nil
+}
+
+func setSummaryWinRate(summary *entity.JournalSummary) {
+	if summary.TotalTrades > 0 {
+		summary.WinRate = float64(summary.Wins) / float64(summary.TotalTrades) * 100
+	}
+}
+
+// ensureJournalUnlocked returns ErrJournalLocked if the journal is locked.
+// Every service that writes to a journal or its entries calls it first.
+func ensureJournalUnlocked(ctx context.Context, storage TradingJournalStorage, journalID uuid.UUID) error {
+	locked, err := storage.IsLocked(ctx, journalID)
+	if err != nil {
+		return errors.Wrap(err, "failed to check journal lock")
+	}
+
+	if locked {
+		return entity.ErrJournalLocked
+	}
+
+	return nil
+}
+
+// Export returns the journal with all its entries, provided the user owns it.
+func (s *TradingJournalService) Export(ctx context.Context, id uuid.UUID, userID uuid.UUID) (*entity.TradingJournal, error) {
+	exists, err := s.storage.Exists(ctx, id, userID)
+	if err != nil {
+		s.logger.Error("failed to check journal ownership", zap.Error(err))
+		return nil, errors.Wrap(err, "failed to verify journal ownership")
+	}
+
+	if !exists {
+		return nil, errors.Wrap(entity.ErrNotFound, "journal")
+	}
+
+	journal, err := s.storage.GetByIDWithEntries(ctx, id)
+	if err != nil {
+		s.logger.Error("failed to get trading journal for export", zap.Error(err), zap.String("id", id.String()))
+		return nil, errors.Wrap(err, "failed to get trading journal for export")
+	}
+
+	return journal, nil
+}
+
+// StreamExport writes the journal's dto.JournalExportDocument to w, the same
+// document Export's result maps to. Entries are loaded and written a batch
+// at a time, flushing after each, so memory use doesn't grow with the
+// journal. Nothing is written if the journal is not the user's; a later
+// error leaves w truncated.
+func (s *TradingJournalService) StreamExport(ctx context.Context, id uuid.UUID, userID uuid.UUID, w io.Writer) error {
+	exists, err := s.storage.Exists(ctx, id, userID)
+	if err != nil {
+		s.logger.Error("failed to check journal ownership", zap.Error(err))
+		return errors.Wrap(err, "failed to verify journal ownership")
+	}
+
+	if !exists {
+		return errors.Wrap(entity.ErrNotFound, "journal")
+	}
+
+	journal, err := s.storage.GetByID(ctx, id)
+	if err != nil {
+		s.logger.Error("failed to get trading journal for export", zap.Error(err), zap.String("id", id.String()))
+		return errors.Wrap(err, "failed to get trading journal for export")
+	}
+
+	out := &exportWriter{w: w}
+	out.raw(`{"version":`)
+	out.json(dto.JournalExportVersion)
+	out.raw(`,"exported_at":`)
+	out.json(time.Now().UTC())
+	out.raw(`,"journal":`)
+	out.json(mapper.ToJournalExportJournal(journal))
+
+	out.openArray(`,"entries":[`)
+	for offset := 0; out.err == nil; offset += exportBatchSize {
+		entries, err := s.storage.GetEntries(ctx, id, exportBatchSize, offset)
+		if err != nil {
+			s.logger.Error("failed to get entries for export", zap.Error(err), zap.String("id", id.String()))
+			return errors.Wrap(err, "failed to get entries for export")
+		}
+
+		for _, entry := range entries {
+			out.item(mapper.ToJournalExportEntry(entry))
+		}
+		out.flush()
+
+		if len(entries) < exportBatchSize {
+			break
+		}
+	}
+	out.raw("]}\n")
+
+	if out.err != nil {
+		s.logger.Error("failed to write journal export", zap.Error(out.err), zap.String("id", id.String()))
+		return errors.Wrap(out.err, "failed to write journal export")
+	}
+
+	return nil
+}
+
+// Import recreates an exported journal and its entries for the user with new
+// IDs. Everything is validated before anything is written.
+func (s *TradingJournalService) Import(ctx context.Context, userID uuid.UUID, doc *dto.JournalExportDocument) (*entity.TradingJournal, error) {
+	journal, err := s.importJournal(ctx, userID, doc)
+	if err != nil {
+		return nil, err
+	}
+
+	entries := make([]*entity.TradingJournalEntry, 0, len(doc.Entries))
+	for i, e := range doc.Entries {
+		entry, err := importEntry(journal.ID, e)
+		if err != nil {
+			s.logger.Error("invalid imported entry data", zap.Error(err), zap.Int("index", i))
+			return nil, errors.Wrapf(err, "invalid imported entry at index %d", i)
+		}
+
+		entry.AssignGrade(s.gradeWeights)
+		entries = append(entries, entry)
+	}
+
+	if err := s.storage.CreateWithEntries(ctx, journal, entries); err != nil {
+		s.logger.Error("failed to import trading journal", zap.Error(err))
+		return nil, errors.Wrap(err, "failed to import trading journal")
+	}
+
+	journal.Entries = entries
+
+	return journal, nil
+}
+
+// PreviewImport runs the same checks as Import without writing anything.
+// Journal-level problems are returned as errors, as Import would; invalid
+// entries are collected per row instead. rowErrs holds errors the caller
+// already found for entries by index, which are reported as is.
+func (s *TradingJournalService) PreviewImport(ctx context.Context, userID uuid.UUID, doc *dto.JournalExportDocument, rowErrs map[int]error) (*entity.ImportPreview, error) {
+	journal, err := s.importJournal(ctx, userID, doc)
+	if err != nil {
+		return nil, err
+	}
+
+	preview := &entity.ImportPreview{JournalName: journal.Name}
+	for i, e := range doc.Entries {
+		err := rowErrs[i]
+		if err == nil {
+			_, err = importEntry(journal.ID, e)
+		}
+
+		if err != nil {
+			preview.Errors = append(preview.Errors, entity.ImportRowError{Index: i, Err: err})
+			continue
+		}
+
+		preview.EntryCount++
+	}
+
+	return preview, nil
+}
+
+// ImportEntries adds entries mapped from a platform export to the user's
+// journal. Rows in rowErrs, or that fail validation or the journal's entry
+// policy, are skipped and reported by index into reqs; the rest are inserted
+// together.
+func (s *TradingJournalService) ImportEntries(ctx context.Context, id uuid.UUID, userID uuid.UUID, reqs []*dto.CreateTradingJournalEntryRequest, rowErrs map[int]error) (*entity.EntryImport, error) {
+	journal, err := s.storage.GetByID(ctx, id)
+	if err != nil {
+		s.logger.Error("failed to get trading journal by id", zap.Error(err), zap.String("id", id.String()))
+		return nil, errors.Wrap(err, "failed to verify journal ownership")
+	}
+
+	if journal.UserID != userID {
+		return nil, errors.Wrap(entity.ErrNotFound, "trading journal")
+	}
+
+	if err := ensureJournalUnlocked(ctx, s.storage, id); err != nil {
+		return nil, err
+	}
+
+	result := &entity.EntryImport{}
+	for i, req := range reqs {
+		err := rowErrs[i]
+		if err == nil {
+			entry := newEntryFromRequest(id, req)
+			if err = entry.Validate(); err == nil {
+				err = journal.CheckEntryPolicy(entry)
+			}
+			if err == nil {
+				entry.AssignGrade(s.gradeWeights)
+				result.Entries = append(result.Entries, entry)
+				continue
+			}
+		}
+
+		result.Errors = append(result.Errors, entity.ImportRowError{Index: i, Err: err})
+	}
+
+	if len(result.Entries) == 0 {
+		return result, nil
+	}
+
+	if err := s.storage.AddEntries(ctx, id, result.Entries); err != nil {
+		s.logger.Error("failed to import trading journal entries", zap.Error(err))
+		return nil, errors.Wrap(err, "failed to import trading journal entries")
+	}
+
+	if s.metrics != nil {
+		for _, entry := range result.Entries {
+			s.metrics.EntryCreated(entry.Asset)
+		}
+	}
+
+	if s.cache != nil {
+		cacheKey := fmt.Sprintf("journal:%s", id.String())
+		if err := s.cache.Delete(ctx, cacheKey); err != nil {
+			s.logger.Warn("failed to invalidate cache after entry import", zap.Error(err))
+		}
+	}
+
+	return result, nil
+}
+
+// importJournal builds and checks the journal of an import document.
+func (s *TradingJournalService) importJournal(ctx context.Context, userID uuid.UUID, doc *dto.JournalExportDocument) (*entity.TradingJournal, error) {
+	if doc.Version != dto.JournalExportVersion {
+		return nil, errors.Wrapf(entity.ErrUnsupportedExportVersion, "version %d", doc.Version)
+	}
+
+	journal := entity.NewTradingJournal(userID, doc.Journal.Name, doc.Journal.Description)
+	// Assign the ID up front so entries can reference it before insert.
+	journal.ID = uuid.New()
+	journal.DefaultAsset = doc.Journal.DefaultAsset
+	journal.DefaultSession = doc.Journal.DefaultSession
+	journal.Tags = doc.Journal.Tags
+	journal.IsArchived = doc.Journal.IsArchived
+	journal.IsLocked = doc.Journal.IsLocked
+	journal.RequireNotesOnLoss = doc.Journal.RequireNotesOnLoss
+	if doc.Journal.ValidationProfile != "" {
+		journal.ValidationProfile = doc.Journal.ValidationProfile
+	}
+	journal.Broker = doc.Journal.Broker
+	journal.AccountNumber = doc.Journal.AccountNumber
+	journal.PipValue = doc.Journal.PipValue
+
+	if err := journal.Validate(); err != nil {
+		s.logger.Error("invalid imported journal data", zap.Error(err))
+		return nil, errors.Wrap(err, "invalid imported journal data")
+	}
+
+	if err := s.checkNameAvailable(ctx, journal); err != nil {
+		return nil, err
+	}
+
+	return journal, nil
+}
+
+// importEntry builds and validates one entry of an import document.
+func importEntry(journalID uuid.UUID, e *dto.JournalExportEntry) (*entity.TradingJournalEntry, error) {
+	entry := entity.NewTradingJournalEntry(
+		journalID,
+		e.Day,
+		e.Asset,
+		e.LTF,
+		e.HTF,
+		e.EntryCharts,
+		e.Session,
+		e.TradeType,
+		e.Setup,
+		e.Direction,
+		e.EntryType,
+		e.Realized,
+		e.MaxRR,
+		e.Result,
+		e.Notes,
+	)
+	entry.SetupCharts = e.SetupCharts
+	entry.IsPinned = e.IsPinned
+	entry.Emotion = e.Emotion
+	entry.Confidence = e.Confidence
+	if e.ReviewStatus != "" {
+		entry.ReviewStatus = e.ReviewStatus
+	}
+	if e.FollowedPlan != nil {
+		entry.FollowedPlan = *e.FollowedPlan
+	}
+	entry.RiskPercent = e.RiskPercent
+	entry.PositionSize = e.PositionSize
+	entry.EntryPrice = e.EntryPrice
+	entry.StopLossPrice = e.StopLossPrice
+	entry.TakeProfitPrice = e.TakeProfitPrice
+	entry.Checklist = e.Checklist
+
+	if err := entry.Validate(); err != nil {
+		return nil, err
+	}
+
+	return entry, nil
+}
+
 func (s *TradingJournalService) Delete(ctx context.Context, id uuid.UUID, userID uuid.UUID) error {
 	exists, err := s.storage.Exists(ctx, id, userID)
 	if err != nil {
@@ -145,7 +695,11 @@ func (s *TradingJournalService) Delete(ctx context.Context, id uuid.UUID, userID
 	}
 
 	if !exists {
-		return errors.New("trading journal not found or access denied")
+		return errors.Wrap(entity.ErrNotFound, "trading journal")
+	}
+
+	if err := ensureJournalUnlocked(ctx, s.storage, id); err != nil {
+		return err
 	}
 
 	if err := s.storage.Delete(ctx, id); err != nil {
@@ -163,8 +717,10 @@ func (s *TradingJournalService) Delete(ctx context.Context, id uuid.UUID, userID
 	return nil
 }
 
-func (s *TradingJournalService) CountUserJournals(ctx context.Context, userID uuid.UUID) (int, error) {
-	count, err := s.storage.CountByUserID(ctx, userID)
+func (s *TradingJournalService) CountUserJournals(ctx context.Context, userID uuid.UUID, includeArchived bool, broker string) (int, error) {
+	ctx = bunstorage.WithReplicaReads(ctx)
+
+	count, err := s.storage.CountByUserID(ctx, userID, includeArchived, broker)
 	if err != nil {
 		s.logger.Error("failed to count user journals", zap.Error(err), zap.String("user_id", userID.String()))
 		return 0, errors.Wrap(err, "failed to count user journals")
@@ -173,6 +729,16 @@ func (s *TradingJournalService) CountUserJournals(ctx context.Context, userID uu
 	return count, nil
 }
 
+func (s *TradingJournalService) CountEntries(ctx context.Context, id uuid.UUID) (int, error) {
+	count, err := s.storage.CountEntries(ctx, id)
+	if err != nil {
+		s.logger.Error("failed to count journal entries", zap.Error(err), zap.String("id", id.String()))
+		return 0, errors.Wrap(err, "failed to count journal entries")
+	}
+
+	return count, nil
+}
+
 func (s *TradingJournalService) VerifyAccess(ctx context.Context, journalID uuid.UUID, userID uuid.UUID) (bool, error) {
 	exists, err := s.storage.Exists(ctx, journalID, userID)
 	if err != nil {
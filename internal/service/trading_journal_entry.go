@@ -2,6 +2,9 @@ package service
 
 import (
 	"context"
+	"encoding/base64"
+	"fmt"
+	"strings"
 	"time"
 
 	"github.com/cockroachdb/errors"
@@ -13,49 +16,178 @@ import (
 	"go.uber.org/zap"
 )
 
+const (
+	defaultDateRangePageSize = 20
+	maxDateRangePageSize     = 100
+
+	// undoWindow is how long after a soft delete the entry can still be
+	// restored by Undo.
+	undoWindow = 10 * time.Minute
+)
+
 type TradingJournalEntryStorage interface {
 	Create(ctx context.Context, entry *entity.TradingJournalEntry) error
 	GetByID(ctx context.Context, id uuid.UUID) (*entity.TradingJournalEntry, error)
 	GetByIDWithJournal(ctx context.Context, id uuid.UUID) (*entity.TradingJournalEntry, error)
 	GetByJournalID(ctx context.Context, params bunstorage.GetByJournalIDParams) ([]*entity.TradingJournalEntry, error)
 	GetByDateRange(ctx context.Context, params bunstorage.GetByDateRangeParams) ([]*entity.TradingJournalEntry, error)
+	CountByDateRange(ctx context.Context, params bunstorage.GetByDateRangeParams) (int, error)
 	GetByAsset(ctx context.Context, params bunstorage.GetByAssetParams) ([]*entity.TradingJournalEntry, error)
 	GetBySession(ctx context.Context, params bunstorage.GetBySessionParams) ([]*entity.TradingJournalEntry, error)
 	GetByResult(ctx context.Context, params bunstorage.GetByResultParams) ([]*entity.TradingJournalEntry, error)
+	GetByTradeType(ctx context.Context, params bunstorage.GetByTradeTypeParams) ([]*entity.TradingJournalEntry, error)
+	Filter(ctx context.Context, params bunstorage.FilterParams) ([]*entity.TradingJournalEntry, error)
+	GetModifiedSince(ctx context.Context, params bunstorage.GetModifiedSinceParams) ([]*entity.TradingJournalEntry, error)
+	CountFiltered(ctx context.Context, params bunstorage.FilterParams) (int, error)
 	Update(ctx context.Context, entry *entity.TradingJournalEntry) error
+	SetPinned(ctx context.Context, id uuid.UUID, pinned bool) error
+	SetReviewStatus(ctx context.Context, id uuid.UUID, status types.ReviewStatus) error
+	LinkEntries(ctx context.Context, journalID, entryID, relatedID uuid.UUID) error
+	UnlinkEntries(ctx context.Context, journalID, entryID, relatedID uuid.UUID) error
+	GetByIDs(ctx context.Context, journalID uuid.UUID, ids []uuid.UUID) ([]*entity.TradingJournalEntry, error)
 	Delete(ctx context.Context, id uuid.UUID) error
+	HardDelete(ctx context.Context, id uuid.UUID) error
+	RestoreLastDeleted(ctx context.Context, journalID uuid.UUID, deletedAfter time.Time) (*entity.TradingJournalEntry, error)
+	TransferEntries(ctx context.Context, params bunstorage.TransferEntriesParams) ([]*entity.TradingJournalEntry, error)
+	BulkUpdate(ctx context.Context, params bunstorage.BulkUpdateParams) ([]*entity.TradingJournalEntry, error)
 	List(ctx context.Context, limit, offset int) ([]*entity.TradingJournalEntry, error)
 	Count(ctx context.Context) (int, error)
 	CountByJournalID(ctx context.Context, journalID uuid.UUID) (int, error)
 	Exists(ctx context.Context, id uuid.UUID, journalID uuid.UUID) (bool, error)
-	GetStatistics(ctx context.Context, journalID uuid.UUID) (map[string]any, error)
+	ExistsWithDeleted(ctx context.Context, id uuid.UUID, journalID uuid.UUID) (bool, error)
+	GetStatistics(ctx context.Context, journalID uuid.UUID) (*entity.EntryStatistics, error)
+	GetStatisticsByDateRange(ctx context.Context, params bunstorage.GetByDateRangeParams) (*entity.EntryStatistics, error)
+	GetStatisticsByAsset(ctx context.Context, journalID uuid.UUID) ([]*entity.AssetTradeStatistics, error)
+	GetStatisticsByEmotion(ctx context.Context, journalID uuid.UUID) ([]*entity.EmotionStatistics, error)
+	GetStatisticsByGrade(ctx context.Context, journalID uuid.UUID) ([]*entity.GradeStatistics, error)
+	GetStatisticsByConfidence(ctx context.Context, journalID uuid.UUID) ([]*entity.ConfidenceStatistics, error)
+	GetChecklistStatistics(ctx context.Context, journalID uuid.UUID) ([]*entity.ChecklistItemStatistics, error)
+	GetStatisticsByPlanAdherence(ctx context.Context, journalID uuid.UUID) ([]*entity.PlanAdherenceStatistics, error)
+	GetReviewProgress(ctx context.Context, journalID uuid.UUID) (*entity.ReviewProgress, error)
+	GetFacets(ctx context.Context, journalID uuid.UUID) (*entity.EntryFacets, error)
+	GetDailyStatistics(ctx context.Context, journalID uuid.UUID, year int, loc *time.Location) ([]*entity.DailyStatistics, error)
+	GetDailyAssetStatistics(ctx context.Context, journalID uuid.UUID, loc *time.Location) ([]*entity.DailyAssetStatistics, error)
+	GetUserJournalStatistics(ctx context.Context, userID uuid.UUID) ([]*entity.JournalStatistics, error)
 }
 
 type TradingJournalEntryService struct {
-	storage        TradingJournalEntryStorage
-	journalStorage TradingJournalStorage
-	logger         *zap.Logger
+	storage         TradingJournalEntryStorage
+	journalStorage  TradingJournalStorage
+	templateStorage EntryTemplateStorage
+	cache           Cache
+	metrics         Metrics
+	gradeWeights    entity.GradeWeights
+	logger          *zap.Logger
 }
 
 func NewTradingJournalEntryService(
 	storage TradingJournalEntryStorage,
 	journalStorage TradingJournalStorage,
+	templateStorage EntryTemplateStorage,
 	logger *zap.Logger,
 ) *TradingJournalEntryService {
 	return &TradingJournalEntryService{
-		storage:        storage,
-		journalStorage: journalStorage,
-		logger:         logger,
+		storage:         storage,
+		journalStorage:  journalStorage,
+		templateStorage: templateStorage,
+		gradeWeights:    entity.DefaultGradeWeights,
+		logger:          logger,
+	}
+}
+
+// WithCache lets entry writes invalidate the cached journal, whose entry
+// date span they can change.
+func (s *TradingJournalEntryService) WithCache(cache Cache) *TradingJournalEntryService {
+	s.cache = cache
+	return s
+}
+
+func (s *TradingJournalEntryService) WithMetrics(metrics Metrics) *TradingJournalEntryService {
+	s.metrics = metrics
+	return s
+}
+
+// WithGradeWeights grades entries with w instead of
+// entity.DefaultGradeWeights.
+func (s *TradingJournalEntryService) WithGradeWeights(w entity.GradeWeights) *TradingJournalEntryService {
+	s.gradeWeights = w
+	return s
+}
+
+func (s *TradingJournalEntryService) invalidateJournalCache(ctx context.Context, journalID uuid.UUID) {
+	if s.cache == nil {
+		return
+	}
+
+	cacheKey := fmt.Sprintf("journal:%s", journalID.String())
+	if err := s.cache.Delete(ctx, cacheKey); err != nil {
+		s.logger.Warn("failed to invalidate journal cache after entry change", zap.Error(err))
 	}
 }
 
 func (s *TradingJournalEntryService) Create(ctx context.Context, journalID uuid.UUID, req *dto.CreateTradingJournalEntryRequest) (*entity.TradingJournalEntry, error) {
-	_, err := s.journalStorage.GetByID(ctx, journalID)
+	journal, err := s.journalStorage.GetByID(ctx, journalID)
 	if err != nil {
 		s.logger.Error("failed to verify journal existence", zap.Error(err), zap.String("journal_id", journalID.String()))
-		return nil, errors.Wrap(err, "journal not found")
+		return nil, errors.Wrap(err, "failed to verify journal existence")
+	}
+
+	if err := ensureJournalUnlocked(ctx, s.journalStorage, journalID); err != nil {
+		return nil, err
+	}
+
+	entry := newEntryFromRequest(journalID, req)
+
+	if req.TemplateID != nil {
+		template, err := s.templateStorage.GetByID(ctx, *req.TemplateID, journalID)
+		if err != nil {
+			s.logger.Error("failed to get entry template", zap.Error(err), zap.String("template_id", req.TemplateID.String()))
+			return nil, errors.Wrap(err, "failed to get entry template")
+		}
+
+		if err := template.ApplyTo(entry); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := entry.Validate(); err != nil {
+		s.logger.Error("invalid trading journal entry data", zap.Error(err))
+		return nil, errors.Wrap(err, "invalid trading journal entry data")
+	}
+
+	if err := journal.CheckEntryPolicy(entry); err != nil {
+		return nil, err
+	}
+
+	entry.AssignGrade(s.gradeWeights)
+
+	if err := s.storage.Create(ctx, entry); err != nil {
+		s.logger.Error("failed to create trading journal entry", zap.Error(err))
+		return nil, errors.Wrap(err, "failed to create trading journal entry")
+	}
+
+	if s.metrics != nil {
+		s.metrics.EntryCreated(entry.Asset)
 	}
 
+	s.invalidateJournalCache(ctx, journalID)
+
+	return entry, nil
+}
+
+// checkJournalPolicy enforces the parent journal's rules on entry.
+func (s *TradingJournalEntryService) checkJournalPolicy(ctx context.Context, entry *entity.TradingJournalEntry) error {
+	journal, err := s.journalStorage.GetByID(ctx, entry.JournalID)
+	if err != nil {
+		s.logger.Error("failed to get journal policy", zap.Error(err), zap.String("journal_id", entry.JournalID.String()))
+		return errors.Wrap(err, "failed to get journal policy")
+	}
+
+	return journal.CheckEntryPolicy(entry)
+}
+
+// newEntryFromRequest builds an unvalidated entry from a create request.
+func newEntryFromRequest(journalID uuid.UUID, req *dto.CreateTradingJournalEntryRequest) *entity.TradingJournalEntry {
 	entry := entity.NewTradingJournalEntry(
 		journalID,
 		req.Day,
@@ -73,18 +205,98 @@ func (s *TradingJournalEntryService) Create(ctx context.Context, journalID uuid.
 		req.Result,
 		req.Notes,
 	)
+	entry.SetupCharts = req.SetupCharts
+	entry.Emotion = req.Emotion
+	entry.Confidence = req.Confidence
+	if req.FollowedPlan != nil {
+		entry.FollowedPlan = *req.FollowedPlan
+	}
+	entry.RiskPercent = req.RiskPercent
+	entry.PositionSize = req.PositionSize
+	entry.EntryPrice = req.EntryPrice
+	entry.StopLossPrice = req.StopLossPrice
+	entry.TakeProfitPrice = req.TakeProfitPrice
+	entry.Checklist = req.Checklist
+
+	return entry
+}
+
+// Duplicate copies an existing entry into a new one in the same journal. The
+// copy gets a new ID and timestamps, Day defaults to now, and any field set in
+// req overrides the source value. Partial exits are not copied.
+func (s *TradingJournalEntryService) Duplicate(ctx context.Context, id uuid.UUID, journalID uuid.UUID, req *dto.DuplicateTradingJournalEntryRequest) (*entity.TradingJournalEntry, error) {
+	exists, err := s.storage.Exists(ctx, id, journalID)
+	if err != nil {
+		s.logger.Error("failed to check entry ownership", zap.Error(err))
+		return nil, errors.Wrap(err, "failed to verify entry ownership")
+	}
+
+	if !exists {
+		return nil, errors.Wrap(entity.ErrNotFound, "trading journal entry")
+	}
+
+	if err := ensureJournalUnlocked(ctx, s.journalStorage, journalID); err != nil {
+		return nil, err
+	}
+
+	source, err := s.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	entry := *source
+	entry.ID = uuid.Nil
+	entry.Day = time.Now().UTC()
+	entry.EntryCharts = append([]string(nil), source.EntryCharts...)
+	entry.SetupCharts = append([]string(nil), source.SetupCharts...)
+	entry.CreatedAt = time.Time{}
+	entry.UpdatedAt = time.Time{}
+	entry.DeletedAt = time.Time{}
+	entry.Journal = nil
+	entry.RelatedEntryIDs = nil
+
+	if req.Day != nil {
+		entry.Day = *req.Day
+	}
+	if req.Asset != nil {
+		entry.Asset = *req.Asset
+	}
+	if req.Realized != nil {
+		entry.Realized = *req.Realized
+	}
+	if req.MaxRR != nil {
+		entry.MaxRR = *req.MaxRR
+	}
+	if req.Result != nil {
+		entry.Result = *req.Result
+	}
+	if req.Notes != nil {
+		entry.Notes = *req.Notes
+	}
 
 	if err := entry.Validate(); err != nil {
 		s.logger.Error("invalid trading journal entry data", zap.Error(err))
 		return nil, errors.Wrap(err, "invalid trading journal entry data")
 	}
 
-	if err := s.storage.Create(ctx, entry); err != nil {
-		s.logger.Error("failed to create trading journal entry", zap.Error(err))
-		return nil, errors.Wrap(err, "failed to create trading journal entry")
+	if err := s.checkJournalPolicy(ctx, &entry); err != nil {
+		return nil, err
 	}
 
-	return entry, nil
+	entry.AssignGrade(s.gradeWeights)
+
+	if err := s.storage.Create(ctx, &entry); err != nil {
+		s.logger.Error("failed to duplicate trading journal entry", zap.Error(err), zap.String("id", id.String()))
+		return nil, errors.Wrap(err, "failed to duplicate trading journal entry")
+	}
+
+	if s.metrics != nil {
+		s.metrics.EntryCreated(entry.Asset)
+	}
+
+	s.invalidateJournalCache(ctx, journalID)
+
+	return &entry, nil
 }
 
 func (s *TradingJournalEntryService) GetByID(ctx context.Context, id uuid.UUID) (*entity.TradingJournalEntry, error) {
@@ -108,6 +320,8 @@ func (s *TradingJournalEntryService) GetByIDWithJournal(ctx context.Context, id
 }
 
 func (s *TradingJournalEntryService) GetJournalEntries(ctx context.Context, journalID uuid.UUID, limit, offset int) ([]*entity.TradingJournalEntry, error) {
+	ctx = bunstorage.WithReplicaReads(ctx)
+
 	entries, err := s.storage.GetByJournalID(ctx, bunstorage.GetByJournalIDParams{
 		JournalID: journalID,
 		Limit:     limit,
@@ -121,11 +335,26 @@ func (s *TradingJournalEntryService) GetJournalEntries(ctx context.Context, jour
 	return entries, nil
 }
 
-func (s *TradingJournalEntryService) GetByDateRange(ctx context.Context, journalID uuid.UUID, startDate, endDate time.Time) ([]*entity.TradingJournalEntry, error) {
+// GetByDateRange returns one page of the entries between startDate and
+// endDate, newest first. A limit outside 1..maxDateRangePageSize falls back to
+// defaultDateRangePageSize so a wide range can't load every entry at once.
+func (s *TradingJournalEntryService) GetByDateRange(ctx context.Context, journalID uuid.UUID, startDate, endDate time.Time, limit, offset int) ([]*entity.TradingJournalEntry, error) {
+	ctx = bunstorage.WithReplicaReads(ctx)
+
+	if limit <= 0 || limit > maxDateRangePageSize {
+		limit = defaultDateRangePageSize
+	}
+
+	if offset < 0 {
+		offset = 0
+	}
+
 	entries, err := s.storage.GetByDateRange(ctx, bunstorage.GetByDateRangeParams{
 		JournalID: journalID,
 		StartDate: startDate,
 		EndDate:   endDate,
+		Limit:     limit,
+		Offset:    offset,
 	})
 	if err != nil {
 		s.logger.Error("failed to get entries by date range", zap.Error(err), zap.String("journal_id", journalID.String()))
@@ -135,7 +364,25 @@ func (s *TradingJournalEntryService) GetByDateRange(ctx context.Context, journal
 	return entries, nil
 }
 
+func (s *TradingJournalEntryService) CountByDateRange(ctx context.Context, journalID uuid.UUID, startDate, endDate time.Time) (int, error) {
+	ctx = bunstorage.WithReplicaReads(ctx)
+
+	count, err := s.storage.CountByDateRange(ctx, bunstorage.GetByDateRangeParams{
+		JournalID: journalID,
+		StartDate: startDate,
+		EndDate:   endDate,
+	})
+	if err != nil {
+		s.logger.Error("failed to count entries by date range", zap.Error(err), zap.String("journal_id", journalID.String()))
+		return 0, errors.Wrap(err, "failed to count entries by date range")
+	}
+
+	return count, nil
+}
+
 func (s *TradingJournalEntryService) GetByAsset(ctx context.Context, journalID uuid.UUID, asset types.CurrencyPair, limit, offset int) ([]*entity.TradingJournalEntry, error) {
+	ctx = bunstorage.WithReplicaReads(ctx)
+
 	entries, err := s.storage.GetByAsset(ctx, bunstorage.GetByAssetParams{
 		JournalID: journalID,
 		Asset:     asset,
@@ -151,6 +398,8 @@ func (s *TradingJournalEntryService) GetByAsset(ctx context.Context, journalID u
 }
 
 func (s *TradingJournalEntryService) GetBySession(ctx context.Context, journalID uuid.UUID, session types.TradingSession, limit, offset int) ([]*entity.TradingJournalEntry, error) {
+	ctx = bunstorage.WithReplicaReads(ctx)
+
 	entries, err := s.storage.GetBySession(ctx, bunstorage.GetBySessionParams{
 		JournalID: journalID,
 		Session:   session,
@@ -166,6 +415,8 @@ func (s *TradingJournalEntryService) GetBySession(ctx context.Context, journalID
 }
 
 func (s *TradingJournalEntryService) GetByResult(ctx context.Context, journalID uuid.UUID, result types.TradeResult, limit, offset int) ([]*entity.TradingJournalEntry, error) {
+	ctx = bunstorage.WithReplicaReads(ctx)
+
 	entries, err := s.storage.GetByResult(ctx, bunstorage.GetByResultParams{
 		JournalID: journalID,
 		Result:    result,
@@ -180,20 +431,397 @@ func (s *TradingJournalEntryService) GetByResult(ctx context.Context, journalID
 	return entries, nil
 }
 
+func (s *TradingJournalEntryService) GetByTradeType(ctx context.Context, journalID uuid.UUID, tradeType types.TradeType, limit, offset int) ([]*entity.TradingJournalEntry, error) {
+	ctx = bunstorage.WithReplicaReads(ctx)
+
+	entries, err := s.storage.GetByTradeType(ctx, bunstorage.GetByTradeTypeParams{
+		JournalID: journalID,
+		TradeType: tradeType,
+		Limit:     limit,
+		Offset:    offset,
+	})
+	if err != nil {
+		s.logger.Error("failed to get entries by trade type", zap.Error(err), zap.String("journal_id", journalID.String()), zap.String("trade_type", string(tradeType)))
+		return nil, errors.Wrap(err, "failed to get entries by trade type")
+	}
+
+	return entries, nil
+}
+
+func (s *TradingJournalEntryService) FilterEntries(ctx context.Context, journalID uuid.UUID, filter *dto.FilterEntriesRequest) ([]*entity.TradingJournalEntry, error) {
+	ctx = bunstorage.WithReplicaReads(ctx)
+
+	entries, err := s.storage.Filter(ctx, toFilterParams(journalID, filter))
+	if err != nil {
+		s.logger.Error("failed to filter journal entries", zap.Error(err), zap.String("journal_id", journalID.String()))
+		return nil, errors.Wrap(err, "failed to filter journal entries")
+	}
+
+	return entries, nil
+}
+
+func (s *TradingJournalEntryService) CountFilteredEntries(ctx context.Context, journalID uuid.UUID, filter *dto.FilterEntriesRequest) (int, error) {
+	ctx = bunstorage.WithReplicaReads(ctx)
+
+	count, err := s.storage.CountFiltered(ctx, toFilterParams(journalID, filter))
+	if err != nil {
+		s.logger.Error("failed to count filtered journal entries", zap.Error(err), zap.String("journal_id", journalID.String()))
+		return 0, errors.Wrap(err, "failed to count filtered journal entries")
+	}
+
+	return count, nil
+}
+
+func toFilterParams(journalID uuid.UUID, filter *dto.FilterEntriesRequest) bunstorage.FilterParams {
+	return bunstorage.FilterParams{
+		JournalID:    journalID,
+		Asset:        filter.Asset,
+		Session:      filter.Session,
+		Result:       filter.Result,
+		StartDate:    filter.StartDate,
+		EndDate:      filter.EndDate,
+		Pinned:       filter.Pinned,
+		ReviewStatus: filter.ReviewStatus,
+		FollowedPlan: filter.FollowedPlan,
+		TradeType:    filter.TradeType,
+		EntryType:    filter.EntryType,
+		MinRR:        filter.MinRR,
+		MaxRR:        filter.MaxRR,
+		MinRealized:  filter.MinRealized,
+		MaxRealized:  filter.MaxRealized,
+		Limit:        filter.Limit,
+		Offset:       filter.Offset,
+	}
+}
+
+// GetModifiedSince returns up to limit entries changed after since, oldest
+// change first, including soft-deleted entries. cursor is the value returned
+// by the previous call, or empty for the first page. The returned cursor is
+// empty once there are no more changes.
+func (s *TradingJournalEntryService) GetModifiedSince(ctx context.Context, journalID uuid.UUID, since time.Time, cursor string, limit int) ([]*entity.TradingJournalEntry, string, error) {
+	params := bunstorage.GetModifiedSinceParams{
+		JournalID: journalID,
+		Since:     since.UTC(),
+		Limit:     limit + 1,
+	}
+
+	if cursor != "" {
+		afterUpdatedAt, afterID, err := decodeSyncCursor(cursor)
+		if err != nil {
+			return nil, "", err
+		}
+		params.AfterUpdatedAt = &afterUpdatedAt
+		params.AfterID = afterID
+	}
+
+	entries, err := s.storage.GetModifiedSince(ctx, params)
+	if err != nil {
+		s.logger.Error("failed to get entries modified since", zap.Error(err), zap.String("journal_id", journalID.String()))
+		return nil, "", errors.Wrap(err, "failed to get entries modified since")
+	}
+
+	if len(entries) <= limit {
+		return entries, "", nil
+	}
+
+	entries = entries[:limit]
+	return entries, encodeSyncCursor(entries[len(entries)-1]), nil
+}
+
+func encodeSyncCursor(entry *entity.TradingJournalEntry) string {
+	raw := entry.UpdatedAt.UTC().Format(time.RFC3339Nano) + "|" + entry.ID.String()
+	return base64.RawURLEncoding.EncodeToString([]byte(raw))
+}
+
+func decodeSyncCursor(cursor string) (time.Time, uuid.UUID, error) {
+	raw, err := base64.RawURLEncoding.DecodeString(cursor)
+	if err != nil {
+		return time.Time{}, uuid.Nil, entity.ErrInvalidSyncCursor
+	}
+
+	updatedAtStr, idStr, ok := strings.Cut(string(raw), "|")
+	if !ok {
+		return time.Time{}, uuid.Nil, entity.ErrInvalidSyncCursor
+	}
+
+	updatedAt, err := time.Parse(time.RFC3339Nano, updatedAtStr)
+	if err != nil {
+		return time.Time{}, uuid.Nil, entity.ErrInvalidSyncCursor
+	}
+
+	id, err := uuid.Parse(idStr)
+	if err != nil {
+		return time.Time{}, uuid.Nil, entity.ErrInvalidSyncCursor
+	}
+
+	return updatedAt, id, nil
+}
+
 func (s *TradingJournalEntryService) Update(ctx context.Context, entry *entity.TradingJournalEntry) error {
+	if err := ensureJournalUnlocked(ctx, s.journalStorage, entry.JournalID); err != nil {
+		return err
+	}
+
 	if err := entry.Validate(); err != nil {
 		s.logger.Error("invalid trading journal entry data", zap.Error(err))
 		return errors.Wrap(err, "invalid trading journal entry data")
 	}
 
+	if err := s.checkJournalPolicy(ctx, entry); err != nil {
+		return err
+	}
+
+	// Any field the rubric reads may have changed, so the grade is always
+	// recomputed.
+	entry.AssignGrade(s.gradeWeights)
+
 	if err := s.storage.Update(ctx, entry); err != nil {
 		s.logger.Error("failed to update trading journal entry", zap.Error(err), zap.String("id", entry.ID.String()))
 		return errors.Wrap(err, "failed to update trading journal entry")
 	}
 
+	s.invalidateJournalCache(ctx, entry.JournalID)
+
 	return nil
 }
 
+// BulkUpdate applies req.Set to every entry of the journal matching
+// req.Filter, up to entity.MaxBulkUpdateEntries, in one transaction. Each
+// changed entry is validated against the entry rules and the journal policy
+// and regraded; if any fails, nothing is saved. With req.DryRun the changed
+// entries are returned without saving them.
+func (s *TradingJournalEntryService) BulkUpdate(ctx context.Context, journalID uuid.UUID, req *dto.BulkUpdateEntriesRequest) ([]*entity.TradingJournalEntry, error) {
+	if req.Set.IsEmpty() {
+		return nil, entity.ErrEmptyBulkUpdate
+	}
+
+	if err := ensureJournalUnlocked(ctx, s.journalStorage, journalID); err != nil {
+		return nil, err
+	}
+
+	journal, err := s.journalStorage.GetByID(ctx, journalID)
+	if err != nil {
+		s.logger.Error("failed to get journal policy", zap.Error(err), zap.String("journal_id", journalID.String()))
+		return nil, errors.Wrap(err, "failed to get journal policy")
+	}
+
+	// rejected is the first entry the update would leave invalid, reported
+	// without the storage's wrapping.
+	var rejected error
+	entries, err := s.storage.BulkUpdate(ctx, bunstorage.BulkUpdateParams{
+		Filter: toFilterParams(journalID, &req.Filter),
+		Max:    entity.MaxBulkUpdateEntries,
+		DryRun: req.DryRun,
+		Apply: func(entry *entity.TradingJournalEntry) error {
+			applyBulkEntryUpdate(entry, &req.Set)
+
+			if err := entry.Validate(); err != nil {
+				rejected = errors.Mark(errors.Wrapf(err, "entry %s", entry.ID), entity.ErrInvalidBulkUpdate)
+				return rejected
+			}
+			if err := journal.CheckEntryPolicy(entry); err != nil {
+				rejected = errors.Wrapf(err, "entry %s", entry.ID)
+				return rejected
+			}
+
+			entry.AssignGrade(s.gradeWeights)
+			return nil
+		},
+	})
+	if err != nil {
+		if rejected != nil {
+			return nil, rejected
+		}
+		if errors.Is(err, entity.ErrBulkUpdateTooLarge) {
+			return nil, entity.ErrBulkUpdateTooLarge
+		}
+		s.logger.Error("failed to bulk update entries", zap.Error(err), zap.String("journal_id", journalID.String()))
+		return nil, errors.Wrap(err, "failed to bulk update entries")
+	}
+
+	if !req.DryRun && len(entries) > 0 {
+		s.invalidateJournalCache(ctx, journalID)
+	}
+
+	return entries, nil
+}
+
+func applyBulkEntryUpdate(entry *entity.TradingJournalEntry, set *dto.BulkEntryUpdate) {
+	if set.Realized != nil {
+		entry.Realized = *set.Realized
+	}
+	if set.MaxRR != nil {
+		entry.MaxRR = *set.MaxRR
+	}
+	if set.Result != nil {
+		entry.Result = *set.Result
+	}
+	if set.Session != nil {
+		entry.Session = *set.Session
+	}
+	if set.TradeType != nil {
+		entry.TradeType = *set.TradeType
+	}
+	if set.EntryType != nil {
+		entry.EntryType = *set.EntryType
+	}
+	if set.FollowedPlan != nil {
+		entry.FollowedPlan = *set.FollowedPlan
+	}
+	if set.ReviewStatus != nil {
+		entry.ReviewStatus = *set.ReviewStatus
+	}
+}
+
+func (s *TradingJournalEntryService) SetPinned(ctx context.Context, id uuid.UUID, journalID uuid.UUID, pinned bool) (*entity.TradingJournalEntry, error) {
+	exists, err := s.storage.Exists(ctx, id, journalID)
+	if err != nil {
+		s.logger.Error("failed to check entry ownership", zap.Error(err))
+		return nil, errors.Wrap(err, "failed to verify entry ownership")
+	}
+
+	if !exists {
+		return nil, errors.Wrap(entity.ErrNotFound, "trading journal entry")
+	}
+
+	if err := ensureJournalUnlocked(ctx, s.journalStorage, journalID); err != nil {
+		return nil, err
+	}
+
+	entry, err := s.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.storage.SetPinned(ctx, id, pinned); err != nil {
+		s.logger.Error("failed to set entry pinned flag", zap.Error(err), zap.String("id", id.String()), zap.Bool("pinned", pinned))
+		return nil, errors.Wrap(err, "failed to set entry pinned flag")
+	}
+
+	entry.IsPinned = pinned
+	return entry, nil
+}
+
+func (s *TradingJournalEntryService) SetReviewStatus(ctx context.Context, id uuid.UUID, journalID uuid.UUID, status types.ReviewStatus) (*entity.TradingJournalEntry, error) {
+	if !status.IsValid() {
+		return nil, entity.ErrInvalidReviewStatus
+	}
+
+	exists, err := s.storage.Exists(ctx, id, journalID)
+	if err != nil {
+		s.logger.Error("failed to check entry ownership", zap.Error(err))
+		return nil, errors.Wrap(err, "failed to verify entry ownership")
+	}
+
+	if !exists {
+		return nil, errors.Wrap(entity.ErrNotFound, "trading journal entry")
+	}
+
+	if err := ensureJournalUnlocked(ctx, s.journalStorage, journalID); err != nil {
+		return nil, err
+	}
+
+	entry, err := s.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.storage.SetReviewStatus(ctx, id, status); err != nil {
+		s.logger.Error("failed to set entry review status", zap.Error(err), zap.String("id", id.String()), zap.String("review_status", string(status)))
+		return nil, errors.Wrap(err, "failed to set entry review status")
+	}
+
+	entry.ReviewStatus = status
+	return entry, nil
+}
+
+// LinkEntry links the entry to relatedID, another entry of the same journal,
+// and returns the updated entry. Links go both ways.
+func (s *TradingJournalEntryService) LinkEntry(ctx context.Context, id uuid.UUID, journalID uuid.UUID, relatedID uuid.UUID) (*entity.TradingJournalEntry, error) {
+	if id == relatedID {
+		return nil, entity.ErrSelfLink
+	}
+
+	exists, err := s.storage.Exists(ctx, id, journalID)
+	if err != nil {
+		s.logger.Error("failed to check entry ownership", zap.Error(err))
+		return nil, errors.Wrap(err, "failed to verify entry ownership")
+	}
+
+	if !exists {
+		return nil, errors.Wrap(entity.ErrNotFound, "trading journal entry")
+	}
+
+	if err := ensureJournalUnlocked(ctx, s.journalStorage, journalID); err != nil {
+		return nil, err
+	}
+
+	if err := s.storage.LinkEntries(ctx, journalID, id, relatedID); err != nil {
+		if errors.Is(err, entity.ErrNotFound) || errors.Is(err, entity.ErrTooManyLinks) {
+			return nil, err
+		}
+		s.logger.Error("failed to link entries", zap.Error(err), zap.String("id", id.String()), zap.String("related_id", relatedID.String()))
+		return nil, errors.Wrap(err, "failed to link entries")
+	}
+
+	s.invalidateJournalCache(ctx, journalID)
+
+	return s.GetByID(ctx, id)
+}
+
+// UnlinkEntry removes the link between the entry and relatedID, from both
+// sides, and returns the updated entry.
+func (s *TradingJournalEntryService) UnlinkEntry(ctx context.Context, id uuid.UUID, journalID uuid.UUID, relatedID uuid.UUID) (*entity.TradingJournalEntry, error) {
+	exists, err := s.storage.Exists(ctx, id, journalID)
+	if err != nil {
+		s.logger.Error("failed to check entry ownership", zap.Error(err))
+		return nil, errors.Wrap(err, "failed to verify entry ownership")
+	}
+
+	if !exists {
+		return nil, errors.Wrap(entity.ErrNotFound, "trading journal entry")
+	}
+
+	if err := ensureJournalUnlocked(ctx, s.journalStorage, journalID); err != nil {
+		return nil, err
+	}
+
+	if err := s.storage.UnlinkEntries(ctx, journalID, id, relatedID); err != nil {
+		s.logger.Error("failed to unlink entries", zap.Error(err), zap.String("id", id.String()), zap.String("related_id", relatedID.String()))
+		return nil, errors.Wrap(err, "failed to unlink entries")
+	}
+
+	s.invalidateJournalCache(ctx, journalID)
+
+	return s.GetByID(ctx, id)
+}
+
+// GetRelated returns the entries linked to the entry, newest first.
+func (s *TradingJournalEntryService) GetRelated(ctx context.Context, id uuid.UUID, journalID uuid.UUID) ([]*entity.TradingJournalEntry, error) {
+	exists, err := s.storage.Exists(ctx, id, journalID)
+	if err != nil {
+		s.logger.Error("failed to check entry ownership", zap.Error(err))
+		return nil, errors.Wrap(err, "failed to verify entry ownership")
+	}
+
+	if !exists {
+		return nil, errors.Wrap(entity.ErrNotFound, "trading journal entry")
+	}
+
+	entry, err := s.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	entries, err := s.storage.GetByIDs(ctx, journalID, entry.RelatedEntryIDs)
+	if err != nil {
+		s.logger.Error("failed to get related entries", zap.Error(err), zap.String("id", id.String()))
+		return nil, errors.Wrap(err, "failed to get related entries")
+	}
+
+	return entries, nil
+}
+
 func (s *TradingJournalEntryService) Delete(ctx context.Context, id uuid.UUID, journalID uuid.UUID) error {
 	exists, err := s.storage.Exists(ctx, id, journalID)
 	if err != nil {
@@ -202,7 +830,11 @@ func (s *TradingJournalEntryService) Delete(ctx context.Context, id uuid.UUID, j
 	}
 
 	if !exists {
-		return errors.New("trading journal entry not found or access denied")
+		return errors.Wrap(entity.ErrNotFound, "trading journal entry")
+	}
+
+	if err := ensureJournalUnlocked(ctx, s.journalStorage, journalID); err != nil {
+		return err
 	}
 
 	if err := s.storage.Delete(ctx, id); err != nil {
@@ -210,10 +842,113 @@ func (s *TradingJournalEntryService) Delete(ctx context.Context, id uuid.UUID, j
 		return errors.Wrap(err, "failed to delete trading journal entry")
 	}
 
+	s.invalidateJournalCache(ctx, journalID)
+
 	return nil
 }
 
+// HardDelete permanently removes the entry, including one that was already
+// soft-deleted by Delete.
+func (s *TradingJournalEntryService) HardDelete(ctx context.Context, id uuid.UUID, journalID uuid.UUID) error {
+	exists, err := s.storage.ExistsWithDeleted(ctx, id, journalID)
+	if err != nil {
+		s.logger.Error("failed to check entry ownership", zap.Error(err))
+		return errors.Wrap(err, "failed to verify entry ownership")
+	}
+
+	if !exists {
+		return errors.Wrap(entity.ErrNotFound, "trading journal entry")
+	}
+
+	if err := ensureJournalUnlocked(ctx, s.journalStorage, journalID); err != nil {
+		return err
+	}
+
+	if err := s.storage.HardDelete(ctx, id); err != nil {
+		s.logger.Error("failed to hard delete trading journal entry", zap.Error(err), zap.String("id", id.String()))
+		return errors.Wrap(err, "failed to hard delete trading journal entry")
+	}
+
+	s.invalidateJournalCache(ctx, journalID)
+
+	return nil
+}
+
+// Undo restores the journal's most recently soft-deleted entry if it was
+// deleted within undoWindow.
+func (s *TradingJournalEntryService) Undo(ctx context.Context, journalID uuid.UUID) (*entity.TradingJournalEntry, error) {
+	if err := ensureJournalUnlocked(ctx, s.journalStorage, journalID); err != nil {
+		return nil, err
+	}
+
+	entry, err := s.storage.RestoreLastDeleted(ctx, journalID, time.Now().Add(-undoWindow))
+	if err != nil {
+		s.logger.Error("failed to restore trading journal entry", zap.Error(err), zap.String("journal_id", journalID.String()))
+		return nil, errors.Wrap(err, "failed to restore trading journal entry")
+	}
+
+	s.invalidateJournalCache(ctx, journalID)
+
+	return entry, nil
+}
+
+// TransferEntries moves the given entries of sourceJournalID to another
+// journal of the same user, or copies them if req.Copy is set. Moved or
+// copied entries must satisfy the target journal's policy. Entries are
+// reported as not found unless userID owns both journals and every entry
+// belongs to the source journal.
+func (s *TradingJournalEntryService) TransferEntries(ctx context.Context, userID uuid.UUID, sourceJournalID uuid.UUID, req *dto.TransferEntriesRequest) ([]*entity.TradingJournalEntry, error) {
+	if req.TargetJournalID == sourceJournalID {
+		return nil, entity.ErrSameJournal
+	}
+
+	target, err := s.journalStorage.GetByID(ctx, req.TargetJournalID)
+	if err != nil {
+		s.logger.Error("failed to get target journal", zap.Error(err), zap.String("journal_id", req.TargetJournalID.String()))
+		return nil, errors.Wrap(err, "failed to get target journal")
+	}
+
+	entryIDs := make([]uuid.UUID, 0, len(req.EntryIDs))
+	seen := make(map[uuid.UUID]bool, len(req.EntryIDs))
+	for _, id := range req.EntryIDs {
+		if !seen[id] {
+			seen[id] = true
+			entryIDs = append(entryIDs, id)
+		}
+	}
+
+	entries, err := s.storage.TransferEntries(ctx, bunstorage.TransferEntriesParams{
+		UserID:          userID,
+		SourceJournalID: sourceJournalID,
+		TargetJournalID: req.TargetJournalID,
+		EntryIDs:        entryIDs,
+		Copy:            req.Copy,
+		Check:           target.CheckEntryPolicy,
+	})
+	if err != nil {
+		s.logger.Error("failed to transfer trading journal entries", zap.Error(err),
+			zap.String("source_journal_id", sourceJournalID.String()),
+			zap.String("target_journal_id", req.TargetJournalID.String()),
+			zap.Bool("copy", req.Copy),
+		)
+		return nil, errors.Wrap(err, "failed to transfer trading journal entries")
+	}
+
+	if req.Copy && s.metrics != nil {
+		for _, entry := range entries {
+			s.metrics.EntryCreated(entry.Asset)
+		}
+	}
+
+	s.invalidateJournalCache(ctx, sourceJournalID)
+	s.invalidateJournalCache(ctx, req.TargetJournalID)
+
+	return entries, nil
+}
+
 func (s *TradingJournalEntryService) CountJournalEntries(ctx context.Context, journalID uuid.UUID) (int, error) {
+	ctx = bunstorage.WithReplicaReads(ctx)
+
 	count, err := s.storage.CountByJournalID(ctx, journalID)
 	if err != nil {
 		s.logger.Error("failed to count journal entries", zap.Error(err), zap.String("journal_id", journalID.String()))
@@ -223,22 +958,364 @@ func (s *TradingJournalEntryService) CountJournalEntries(ctx context.Context, jo
 	return count, nil
 }
 
-func (s *TradingJournalEntryService) GetStatistics(ctx context.Context, journalID uuid.UUID) (map[string]any, error) {
+func (s *TradingJournalEntryService) GetStatistics(ctx context.Context, journalID uuid.UUID) (*entity.EntryStatistics, error) {
+	ctx = bunstorage.WithReplicaReads(ctx)
+
 	stats, err := s.storage.GetStatistics(ctx, journalID)
 	if err != nil {
 		s.logger.Error("failed to get journal statistics", zap.Error(err), zap.String("journal_id", journalID.String()))
 		return nil, errors.Wrap(err, "failed to get journal statistics")
 	}
 
-	if totalTrades, ok := stats["total_trades"].(int); ok && totalTrades > 0 {
-		wins := 0
-		if w, ok := stats["wins"].(int); ok {
-			wins = w
+	journal, err := s.getPipJournal(ctx, journalID)
+	if err != nil {
+		return nil, err
+	}
+
+	completeStatistics(stats)
+	fillPipStatistics(journal, stats)
+	return stats, nil
+}
+
+// ComparePeriods returns the journal's statistics for periods a and b side
+// by side, with the change from a to b.
+func (s *TradingJournalEntryService) ComparePeriods(ctx context.Context, journalID uuid.UUID, a, b entity.Period) (*entity.PeriodComparison, error) {
+	ctx = bunstorage.WithReplicaReads(ctx)
+
+	statsA, err := s.getStatisticsForPeriod(ctx, journalID, a)
+	if err != nil {
+		return nil, err
+	}
+
+	statsB, err := s.getStatisticsForPeriod(ctx, journalID, b)
+	if err != nil {
+		return nil, err
+	}
+
+	journal, err := s.getPipJournal(ctx, journalID)
+	if err != nil {
+		return nil, err
+	}
+	fillPipStatistics(journal, statsA)
+	fillPipStatistics(journal, statsB)
+
+	return &entity.PeriodComparison{
+		PeriodA:     a,
+		PeriodB:     b,
+		StatisticsA: statsA,
+		StatisticsB: statsB,
+		Delta: entity.StatisticsDelta{
+			TotalTrades:   statsB.TotalTrades - statsA.TotalTrades,
+			Wins:          statsB.Wins - statsA.Wins,
+			Losses:        statsB.Losses - statsA.Losses,
+			BreakEven:     statsB.BreakEven - statsA.BreakEven,
+			WinRate:       statsB.WinRate - statsA.WinRate,
+			TotalRealized: statsB.TotalRealized - statsA.TotalRealized,
+			AvgRiskReward: statsB.AvgRiskReward - statsA.AvgRiskReward,
+			AvgWin:        statsB.AvgWin - statsA.AvgWin,
+			AvgLoss:       statsB.AvgLoss - statsA.AvgLoss,
+			AvgPlannedRR:  statsB.AvgPlannedRR - statsA.AvgPlannedRR,
+			AvgAchievedRR: statsB.AvgAchievedRR - statsA.AvgAchievedRR,
+			RRDifference:  statsB.RRDifference - statsA.RRDifference,
+			KellyFraction: statsB.KellyFraction - statsA.KellyFraction,
+			RiskOfRuin:    statsB.RiskOfRuin - statsA.RiskOfRuin,
+		},
+	}, nil
+}
+
+func (s *TradingJournalEntryService) getStatisticsForPeriod(ctx context.Context, journalID uuid.UUID, period entity.Period) (*entity.EntryStatistics, error) {
+	stats, err := s.storage.GetStatisticsByDateRange(ctx, bunstorage.GetByDateRangeParams{
+		JournalID: journalID,
+		StartDate: period.Start,
+		EndDate:   period.End,
+	})
+	if err != nil {
+		s.logger.Error("failed to get journal statistics by date range", zap.Error(err), zap.String("journal_id", journalID.String()))
+		return nil, errors.Wrap(err, "failed to get journal statistics by date range")
+	}
+
+	completeStatistics(stats)
+	return stats, nil
+}
+
+// completeStatistics fills in the statistics derived from the aggregates.
+func completeStatistics(stats *entity.EntryStatistics) {
+	if stats.TotalTrades > 0 {
+		stats.WinRate = float64(stats.Wins) / float64(stats.TotalTrades) * 100
+	}
+
+	// Negative when trades give back part of the reward they were planned for.
+	stats.RRDifference = stats.AvgAchievedRR - stats.AvgPlannedRR
+	stats.KellyFraction = kellyFraction(stats.WinRate, stats.AvgWin, stats.AvgLoss)
+	stats.RiskOfRuin = riskOfRuin(stats.WinRate, stats.AvgWin, stats.AvgLoss, stats.AvgRiskPercent)
+	stats.GeneratedAt = time.Now().UTC()
+}
+
+// getPipJournal loads the journal whose pip value the statistics are
+// converted with.
+func (s *TradingJournalEntryService) getPipJournal(ctx context.Context, journalID uuid.UUID) (*entity.TradingJournal, error) {
+	journal, err := s.journalStorage.GetByID(ctx, journalID)
+	if err != nil {
+		s.logger.Error("failed to get journal pip value", zap.Error(err), zap.String("journal_id", journalID.String()))
+		return nil, errors.Wrap(err, "failed to get journal pip value")
+	}
+
+	return journal, nil
+}
+
+// fillPipStatistics converts the money statistics to pips of the journal's
+// pip value; they stay nil when the journal has none.
+func fillPipStatistics(journal *entity.TradingJournal, stats *entity.EntryStatistics) {
+	stats.TotalRealizedPips = journal.ToPips(stats.TotalRealized)
+	stats.AvgWinPips = journal.ToPips(stats.AvgWin)
+	stats.AvgLossPips = journal.ToPips(stats.AvgLoss)
+}
+
+func (s *TradingJournalEntryService) GetStatisticsByEmotion(ctx context.Context, journalID uuid.UUID) ([]*entity.EmotionStatistics, error) {
+	ctx = bunstorage.WithReplicaReads(ctx)
+
+	stats, err := s.storage.GetStatisticsByEmotion(ctx, journalID)
+	if err != nil {
+		s.logger.Error("failed to get journal statistics by emotion", zap.Error(err), zap.String("journal_id", journalID.String()))
+		return nil, errors.Wrap(err, "failed to get journal statistics by emotion")
+	}
+
+	for _, stat := range stats {
+		if stat.TotalTrades > 0 {
+			stat.WinRate = float64(stat.Wins) / float64(stat.TotalTrades) * 100
+		}
+	}
+
+	return stats, nil
+}
+
+// GetStatisticsByCategory groups the journal's per-asset statistics by asset
+// category. Categories without trades are left out.
+func (s *TradingJournalEntryService) GetStatisticsByCategory(ctx context.Context, journalID uuid.UUID) ([]*entity.CategoryStatistics, error) {
+	ctx = bunstorage.WithReplicaReads(ctx)
+
+	assets, err := s.storage.GetStatisticsByAsset(ctx, journalID)
+	if err != nil {
+		s.logger.Error("failed to get journal statistics by asset", zap.Error(err), zap.String("journal_id", journalID.String()))
+		return nil, errors.Wrap(err, "failed to get journal statistics by category")
+	}
+
+	return aggregateByCategory(assets), nil
+}
+
+func aggregateByCategory(assets []*entity.AssetTradeStatistics) []*entity.CategoryStatistics {
+	byCategory := make(map[types.AssetCategory]*entity.CategoryStatistics)
+	for _, asset := range assets {
+		category := asset.Asset.Category()
+		stat, ok := byCategory[category]
+		if !ok {
+			stat = &entity.CategoryStatistics{Category: category}
+			byCategory[category] = stat
+		}
+
+		stat.TotalTrades += asset.TotalTrades
+		stat.Wins += asset.Wins
+		stat.Losses += asset.Losses
+		stat.BreakEven += asset.BreakEven
+		stat.TotalRealized += asset.TotalRealized
+	}
+
+	stats := make([]*entity.CategoryStatistics, 0, len(byCategory))
+	for _, category := range types.AssetCategories {
+		stat, ok := byCategory[category]
+		if !ok {
+			continue
 		}
-		winRate := float64(wins) / float64(totalTrades) * 100
-		stats["win_rate"] = winRate
-	} else {
-		stats["win_rate"] = 0.0
+		if stat.TotalTrades > 0 {
+			stat.WinRate = float64(stat.Wins) / float64(stat.TotalTrades) * 100
+		}
+		stats = append(stats, stat)
+	}
+
+	return stats
+}
+
+func (s *TradingJournalEntryService) GetStatisticsByGrade(ctx context.Context, journalID uuid.UUID) ([]*entity.GradeStatistics, error) {
+	ctx = bunstorage.WithReplicaReads(ctx)
+
+	stats, err := s.storage.GetStatisticsByGrade(ctx, journalID)
+	if err != nil {
+		s.logger.Error("failed to get journal statistics by grade", zap.Error(err), zap.String("journal_id", journalID.String()))
+		return nil, errors.Wrap(err, "failed to get journal statistics by grade")
+	}
+
+	for _, stat := range stats {
+		if stat.TotalTrades > 0 {
+			stat.WinRate = float64(stat.Wins) / float64(stat.TotalTrades) * 100
+		}
+	}
+
+	return stats, nil
+}
+
+func (s *TradingJournalEntryService) GetStatisticsByConfidence(ctx context.Context, journalID uuid.UUID) ([]*entity.ConfidenceStatistics, error) {
+	ctx = bunstorage.WithReplicaReads(ctx)
+
+	stats, err := s.storage.GetStatisticsByConfidence(ctx, journalID)
+	if err != nil {
+		s.logger.Error("failed to get journal statistics by confidence", zap.Error(err), zap.String("journal_id", journalID.String()))
+		return nil, errors.Wrap(err, "failed to get journal statistics by confidence")
+	}
+
+	for _, stat := range stats {
+		if stat.TotalTrades > 0 {
+			stat.WinRate = float64(stat.Wins) / float64(stat.TotalTrades) * 100
+		}
+	}
+
+	return stats, nil
+}
+
+// GetChecklistAdherence reports, per checklist item, how often it was
+// checked and the win rate of trades with and without it checked.
+func (s *TradingJournalEntryService) GetChecklistAdherence(ctx context.Context, journalID uuid.UUID) ([]*entity.ChecklistItemStatistics, error) {
+	ctx = bunstorage.WithReplicaReads(ctx)
+
+	stats, err := s.storage.GetChecklistStatistics(ctx, journalID)
+	if err != nil {
+		s.logger.Error("failed to get checklist statistics", zap.Error(err), zap.String("journal_id", journalID.String()))
+		return nil, errors.Wrap(err, "failed to get checklist statistics")
+	}
+
+	for _, stat := range stats {
+		fillChecklistRates(stat)
+	}
+
+	return stats, nil
+}
+
+func fillChecklistRates(stat *entity.ChecklistItemStatistics) {
+	if stat.TotalTrades > 0 {
+		stat.AdherenceRate = float64(stat.Checked) / float64(stat.TotalTrades) * 100
+	}
+	if stat.Checked > 0 {
+		stat.CheckedWinRate = float64(stat.CheckedWins) / float64(stat.Checked) * 100
+	}
+	if unchecked := stat.TotalTrades - stat.Checked; unchecked > 0 {
+		stat.UncheckedWinRate = float64(stat.UncheckedWins) / float64(unchecked) * 100
+	}
+}
+
+func (s *TradingJournalEntryService) GetAdherenceStatistics(ctx context.Context, journalID uuid.UUID) (*entity.AdherenceStatistics, error) {
+	ctx = bunstorage.WithReplicaReads(ctx)
+
+	rows, err := s.storage.GetStatisticsByPlanAdherence(ctx, journalID)
+	if err != nil {
+		s.logger.Error("failed to get journal plan adherence statistics", zap.Error(err), zap.String("journal_id", journalID.String()))
+		return nil, errors.Wrap(err, "failed to get journal plan adherence statistics")
+	}
+
+	return buildAdherenceStatistics(rows), nil
+}
+
+func buildAdherenceStatistics(rows []*entity.PlanAdherenceStatistics) *entity.AdherenceStatistics {
+	stats := &entity.AdherenceStatistics{
+		InPlan:    &entity.PlanAdherenceStatistics{FollowedPlan: true},
+		OutOfPlan: &entity.PlanAdherenceStatistics{FollowedPlan: false},
+	}
+
+	for _, row := range rows {
+		if row.TotalTrades > 0 {
+			row.WinRate = float64(row.Wins) / float64(row.TotalTrades) * 100
+		}
+
+		if row.FollowedPlan {
+			stats.InPlan = row
+		} else {
+			stats.OutOfPlan = row
+		}
+	}
+
+	return stats
+}
+
+func (s *TradingJournalEntryService) GetReviewProgress(ctx context.Context, journalID uuid.UUID) (*entity.ReviewProgress, error) {
+	ctx = bunstorage.WithReplicaReads(ctx)
+
+	progress, err := s.storage.GetReviewProgress(ctx, journalID)
+	if err != nil {
+		s.logger.Error("failed to get journal review progress", zap.Error(err), zap.String("journal_id", journalID.String()))
+		return nil, errors.Wrap(err, "failed to get journal review progress")
+	}
+
+	return progress, nil
+}
+
+func (s *TradingJournalEntryService) GetFacets(ctx context.Context, journalID uuid.UUID) (*entity.EntryFacets, error) {
+	ctx = bunstorage.WithReplicaReads(ctx)
+
+	facets, err := s.storage.GetFacets(ctx, journalID)
+	if err != nil {
+		s.logger.Error("failed to get journal entry facets", zap.Error(err), zap.String("journal_id", journalID.String()))
+		return nil, errors.Wrap(err, "failed to get journal entry facets")
+	}
+
+	return facets, nil
+}
+
+// GetCalendar buckets entries into the days of loc, so a late-UTC trade
+// lands on the trader's local day.
+func (s *TradingJournalEntryService) GetCalendar(ctx context.Context, journalID uuid.UUID, year int, loc *time.Location) ([]*entity.DailyStatistics, error) {
+	ctx = bunstorage.WithReplicaReads(ctx)
+
+	stats, err := s.storage.GetDailyStatistics(ctx, journalID, year, loc)
+	if err != nil {
+		s.logger.Error("failed to get journal calendar", zap.Error(err), zap.String("journal_id", journalID.String()), zap.Int("year", year))
+		return nil, errors.Wrap(err, "failed to get journal calendar")
+	}
+
+	return stats, nil
+}
+
+func (s *TradingJournalEntryService) GetAssetCorrelation(ctx context.Context, journalID uuid.UUID, loc *time.Location) (*entity.AssetCorrelation, error) {
+	ctx = bunstorage.WithReplicaReads(ctx)
+
+	days, err := s.storage.GetDailyAssetStatistics(ctx, journalID, loc)
+	if err != nil {
+		s.logger.Error("failed to get asset correlation", zap.Error(err), zap.String("journal_id", journalID.String()))
+		return nil, errors.Wrap(err, "failed to get asset correlation")
+	}
+
+	return buildAssetCorrelation(days), nil
+}
+
+func (s *TradingJournalEntryService) GetUserStatistics(ctx context.Context, userID uuid.UUID) (*entity.UserStatistics, error) {
+	ctx = bunstorage.WithReplicaReads(ctx)
+
+	journals, err := s.storage.GetUserJournalStatistics(ctx, userID)
+	if err != nil {
+		s.logger.Error("failed to get user statistics", zap.Error(err), zap.String("user_id", userID.String()))
+		return nil, errors.Wrap(err, "failed to get user statistics")
+	}
+
+	stats := &entity.UserStatistics{
+		TotalJournals: len(journals),
+		Journals:      journals,
+	}
+
+	// Per-journal totals are exact decimal sums from the database; add them
+	// up in cents so many journals don't accumulate float error.
+	var totalRealized types.Cents
+	for _, journal := range journals {
+		if journal.TotalTrades > 0 {
+			journal.WinRate = float64(journal.Wins) / float64(journal.TotalTrades) * 100
+		}
+
+		stats.TotalTrades += journal.TotalTrades
+		stats.Wins += journal.Wins
+		stats.Losses += journal.Losses
+		stats.BreakEven += journal.BreakEven
+		totalRealized += types.CentsFromFloat(journal.TotalRealized)
+	}
+	stats.TotalRealized = totalRealized.Float64()
+
+	if stats.TotalTrades > 0 {
+		stats.WinRate = float64(stats.Wins) / float64(stats.TotalTrades) * 100
 	}
 
 	return stats, nil
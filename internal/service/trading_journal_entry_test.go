@@ -0,0 +1,2201 @@
+package service
+
+import (
+	"context"
+	"encoding/base64"
+	"reflect"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/cockroachdb/errors"
+	"github.com/google/uuid"
+	"github.com/user/normark/internal/dto"
+	"github.com/user/normark/internal/entity"
+	bunstorage "github.com/user/normark/internal/storage/bun"
+	"github.com/user/normark/internal/types"
+	"go.uber.org/zap"
+)
+
+type fakeEntryStorage struct {
+	TradingJournalEntryStorage
+	entries    []*entity.TradingJournalEntry
+	statistics map[time.Time]*entity.EntryStatistics
+}
+
+func (s *fakeEntryStorage) Create(_ context.Context, entry *entity.TradingJournalEntry) error {
+	entry.ID = uuid.New()
+	s.entries = append(s.entries, entry)
+	return nil
+}
+
+func (s *fakeEntryStorage) BulkUpdate(_ context.Context, params bunstorage.BulkUpdateParams) ([]*entity.TradingJournalEntry, error) {
+	updated := make([]*entity.TradingJournalEntry, 0, len(s.entries))
+	for _, entry := range s.entries {
+		entry := *entry
+		if err := params.Apply(&entry); err != nil {
+			return nil, errors.Wrap(err, "failed to apply bulk update")
+		}
+		updated = append(updated, &entry)
+	}
+	if !params.DryRun {
+		s.entries = updated
+	}
+	return updated, nil
+}
+
+func (s *fakeEntryStorage) find(id uuid.UUID) *entity.TradingJournalEntry {
+	for _, entry := range s.entries {
+		if entry.ID == id {
+			return entry
+		}
+	}
+	return nil
+}
+
+func (s *fakeEntryStorage) GetByID(_ context.Context, id uuid.UUID) (*entity.TradingJournalEntry, error) {
+	entry := s.find(id)
+	if entry == nil {
+		return nil, errors.Wrap(entity.ErrNotFound, "trading journal entry")
+	}
+	cp := *entry
+	return &cp, nil
+}
+
+func (s *fakeEntryStorage) SetPinned(_ context.Context, id uuid.UUID, pinned bool) error {
+	s.find(id).IsPinned = pinned
+	return nil
+}
+
+func (s *fakeEntryStorage) Exists(_ context.Context, id uuid.UUID, journalID uuid.UUID) (bool, error) {
+	for _, entry := range s.entries {
+		if entry.ID == id && entry.JournalID == journalID {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// GetStatisticsByDateRange returns the statistics keyed by the range's start.
+func (s *fakeEntryStorage) GetStatisticsByDateRange(_ context.Context, params bunstorage.GetByDateRangeParams) (*entity.EntryStatistics, error) {
+	stats := *s.statistics[params.StartDate]
+	return &stats, nil
+}
+
+type fakeJournalStorage struct {
+	TradingJournalStorage
+	journal *entity.TradingJournal
+	locked  bool
+}
+
+func (s *fakeJournalStorage) IsLocked(context.Context, uuid.UUID) (bool, error) {
+	return s.locked, nil
+}
+
+func (s *fakeJournalStorage) GetByID(_ context.Context, id uuid.UUID) (*entity.TradingJournal, error) {
+	if s.journal == nil || s.journal.ID != id {
+		return nil, entity.ErrNotFound
+	}
+	return s.journal, nil
+}
+
+type fakeTemplateStorage struct {
+	EntryTemplateStorage
+	template *entity.EntryTemplate
+}
+
+func (s *fakeTemplateStorage) GetByID(_ context.Context, id uuid.UUID, journalID uuid.UUID) (*entity.EntryTemplate, error) {
+	if s.template == nil || s.template.ID != id || s.template.JournalID != journalID {
+		return nil, entity.ErrEntryTemplateNotFound
+	}
+	return s.template, nil
+}
+
+func newTestJournal() *entity.TradingJournal {
+	journal := entity.NewTradingJournal(uuid.New(), "Journal", "")
+	journal.ID = uuid.New()
+	return journal
+}
+
+func newTestEntry(journalID uuid.UUID, result types.TradeResult, realized float64) *entity.TradingJournalEntry {
+	entry := entity.NewTradingJournalEntry(
+		journalID,
+		time.Date(2026, 1, 5, 0, 0, 0, 0, time.UTC),
+		types.CurrencyPairEURUSD,
+		"M5", "H4",
+		nil,
+		types.TradingSessionLondon,
+		types.TradeTypeIntraday,
+		nil,
+		types.TradeDirectionBuy,
+		types.EntryTypeMarket,
+		realized, 3,
+		result,
+		"",
+	)
+	entry.ID = uuid.New()
+	return entry
+}
+
+func ptr[T any](v T) *T {
+	return &v
+}
+
+func TestBulkUpdate(t *testing.T) {
+	journal := newTestJournal()
+
+	tests := []struct {
+		name    string
+		locked  bool
+		set     dto.BulkEntryUpdate
+		dryRun  bool
+		wantErr error
+	}{
+		{
+			name:    "empty update",
+			wantErr: entity.ErrEmptyBulkUpdate,
+		},
+		{
+			name:    "locked journal",
+			locked:  true,
+			set:     dto.BulkEntryUpdate{FollowedPlan: ptr(false)},
+			wantErr: entity.ErrJournalLocked,
+		},
+		{
+			name:    "invalid field",
+			set:     dto.BulkEntryUpdate{Result: ptr(types.TradeResult("XX"))},
+			wantErr: entity.ErrInvalidBulkUpdate,
+		},
+		{
+			name:    "policy violation",
+			set:     dto.BulkEntryUpdate{Result: ptr(types.TradeResultStopLoss)},
+			wantErr: entity.ErrEntryPolicy,
+		},
+		{
+			name: "update",
+			set:  dto.BulkEntryUpdate{ReviewStatus: ptr(types.ReviewStatusReviewed)},
+		},
+		{
+			name:   "dry run",
+			set:    dto.BulkEntryUpdate{ReviewStatus: ptr(types.ReviewStatusReviewed)},
+			dryRun: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			entryStorage := &fakeEntryStorage{entries: []*entity.TradingJournalEntry{
+				newTestEntry(journal.ID, types.TradeResultTakeProfit, 120),
+				newTestEntry(journal.ID, types.TradeResultTakeProfit, 80),
+			}}
+			journalStorage := &fakeJournalStorage{journal: journal, locked: tt.locked}
+			svc := NewTradingJournalEntryService(entryStorage, journalStorage, nil, zap.NewNop())
+
+			entries, err := svc.BulkUpdate(context.Background(), journal.ID, &dto.BulkUpdateEntriesRequest{
+				Set:    tt.set,
+				DryRun: tt.dryRun,
+			})
+			if tt.wantErr != nil {
+				if !errors.Is(err, tt.wantErr) {
+					t.Fatalf("BulkUpdate() error = %v, want %v", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("BulkUpdate() error = %v", err)
+			}
+
+			if len(entries) != 2 {
+				t.Fatalf("BulkUpdate() returned %d entries, want 2", len(entries))
+			}
+			for _, entry := range entries {
+				if entry.ReviewStatus != types.ReviewStatusReviewed {
+					t.Errorf("returned entry review status = %q, want %q", entry.ReviewStatus, types.ReviewStatusReviewed)
+				}
+			}
+
+			wantStored := types.ReviewStatusReviewed
+			if tt.dryRun {
+				wantStored = types.ReviewStatusUnreviewed
+			}
+			for _, entry := range entryStorage.entries {
+				if entry.ReviewStatus != wantStored {
+					t.Errorf("stored entry review status = %q, want %q", entry.ReviewStatus, wantStored)
+				}
+			}
+		})
+	}
+}
+
+func TestCreateFromTemplate(t *testing.T) {
+	journal := newTestJournal()
+	template := entity.NewEntryTemplate(journal.ID, "London breakout")
+	template.ID = uuid.New()
+	template.Asset = ptr(types.CurrencyPairGBPUSD)
+	template.Session = ptr(types.TradingSessionLondon)
+	template.TradeType = ptr(types.TradeTypeIntraday)
+	template.Direction = ptr(types.TradeDirectionSell)
+	template.MaxRR = ptr(4.0)
+
+	newRequest := func() *dto.CreateTradingJournalEntryRequest {
+		return &dto.CreateTradingJournalEntryRequest{
+			Day:        time.Date(2026, 1, 5, 0, 0, 0, 0, time.UTC),
+			LTF:        "https://charts.example.com/ltf",
+			HTF:        "https://charts.example.com/htf",
+			EntryType:  types.EntryTypeMarket,
+			Realized:   150,
+			Result:     types.TradeResultTakeProfit,
+			TemplateID: &template.ID,
+		}
+	}
+
+	tests := []struct {
+		name    string
+		modify  func(req *dto.CreateTradingJournalEntryRequest)
+		want    *entity.TradingJournalEntry
+		wantErr error
+	}{
+		{
+			name: "inherits template defaults",
+			want: &entity.TradingJournalEntry{
+				Asset:     types.CurrencyPairGBPUSD,
+				Session:   types.TradingSessionLondon,
+				TradeType: types.TradeTypeIntraday,
+				Direction: types.TradeDirectionSell,
+				MaxRR:     4,
+			},
+		},
+		{
+			name: "request overrides template",
+			modify: func(req *dto.CreateTradingJournalEntryRequest) {
+				req.Asset = types.CurrencyPairEURUSD
+				req.TradeType = types.TradeTypeSwing
+				req.MaxRR = 2
+			},
+			want: &entity.TradingJournalEntry{
+				Asset:     types.CurrencyPairEURUSD,
+				Session:   types.TradingSessionLondon,
+				TradeType: types.TradeTypeSwing,
+				Direction: types.TradeDirectionSell,
+				MaxRR:     2,
+			},
+		},
+		{
+			name: "template of another journal",
+			modify: func(req *dto.CreateTradingJournalEntryRequest) {
+				req.TemplateID = ptr(uuid.New())
+			},
+			wantErr: entity.ErrEntryTemplateNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			entryStorage := &fakeEntryStorage{}
+			svc := NewTradingJournalEntryService(
+				entryStorage,
+				&fakeJournalStorage{journal: journal},
+				&fakeTemplateStorage{template: template},
+				zap.NewNop(),
+			)
+
+			req := newRequest()
+			if tt.modify != nil {
+				tt.modify(req)
+			}
+
+			entry, err := svc.Create(context.Background(), journal.ID, req)
+			if tt.wantErr != nil {
+				if !errors.Is(err, tt.wantErr) {
+					t.Fatalf("Create() error = %v, want %v", err, tt.wantErr)
+				}
+				if len(entryStorage.entries) != 0 {
+					t.Errorf("Create() stored %d entries, want none", len(entryStorage.entries))
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Create() error = %v", err)
+			}
+
+			if entry.Asset != tt.want.Asset || entry.Session != tt.want.Session ||
+				entry.TradeType != tt.want.TradeType || entry.Direction != tt.want.Direction ||
+				entry.MaxRR != tt.want.MaxRR {
+				t.Errorf("Create() entry = %+v, want fields of %+v", entry, tt.want)
+			}
+			if len(entryStorage.entries) != 1 || entryStorage.entries[0] != entry {
+				t.Errorf("Create() did not store the returned entry")
+			}
+		})
+	}
+}
+
+func TestComparePeriods(t *testing.T) {
+	journal := newTestJournal()
+	a := entity.Period{Start: time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC), End: time.Date(2026, 1, 31, 0, 0, 0, 0, time.UTC)}
+	b := entity.Period{Start: time.Date(2026, 2, 1, 0, 0, 0, 0, time.UTC), End: time.Date(2026, 2, 28, 0, 0, 0, 0, time.UTC)}
+
+	entryStorage := &fakeEntryStorage{statistics: map[time.Time]*entity.EntryStatistics{
+		a.Start: {
+			TotalTrades: 10, Wins: 4, Losses: 6, TotalRealized: -200,
+			AvgWin: 100, AvgLoss: 100, AvgRiskPercent: 1,
+			AvgPlannedRR: 2, AvgAchievedRR: 1.5,
+		},
+		b.Start: {
+			TotalTrades: 10, Wins: 6, Losses: 4, TotalRealized: 800,
+			AvgWin: 200, AvgLoss: 100, AvgRiskPercent: 1,
+			AvgPlannedRR: 2, AvgAchievedRR: 2.5,
+		},
+	}}
+	svc := NewTradingJournalEntryService(entryStorage, &fakeJournalStorage{journal: journal}, nil, zap.NewNop())
+
+	comparison, err := svc.ComparePeriods(context.Background(), journal.ID, a, b)
+	if err != nil {
+		t.Fatalf("ComparePeriods() error = %v", err)
+	}
+
+	statsA, statsB, delta := comparison.StatisticsA, comparison.StatisticsB, comparison.Delta
+	tests := []struct {
+		name string
+		got  float64
+		want float64
+	}{
+		{"total trades", float64(delta.TotalTrades), 0},
+		{"wins", float64(delta.Wins), 2},
+		{"win rate", delta.WinRate, 20},
+		{"total realized", delta.TotalRealized, 1000},
+		{"avg planned rr", delta.AvgPlannedRR, 0},
+		{"avg achieved rr", delta.AvgAchievedRR, 1},
+		{"rr difference", delta.RRDifference, 1},
+		{"kelly fraction", delta.KellyFraction, statsB.KellyFraction - statsA.KellyFraction},
+		{"risk of ruin", delta.RiskOfRuin, statsB.RiskOfRuin - statsA.RiskOfRuin},
+	}
+
+	for _, tt := range tests {
+		if tt.got != tt.want {
+			t.Errorf("delta %s = %v, want %v", tt.name, tt.got, tt.want)
+		}
+	}
+
+	// Period A has no edge and period B has one, so both derived risk
+	// figures must actually change.
+	if delta.KellyFraction <= 0 {
+		t.Errorf("delta kelly fraction = %v, want an increase", delta.KellyFraction)
+	}
+	if delta.RiskOfRuin >= 0 {
+		t.Errorf("delta risk of ruin = %v, want a decrease", delta.RiskOfRuin)
+	}
+}
+
+func TestSetPinned(t *testing.T) {
+	journal := newTestJournal()
+
+	tests := []struct {
+		name      string
+		journalID uuid.UUID
+		locked    bool
+		pinned    bool
+		wantErr   error
+	}{
+		{"pin", journal.ID, false, true, nil},
+		{"unpin", journal.ID, false, false, nil},
+		{"entry of another journal", uuid.New(), false, true, entity.ErrNotFound},
+		{"locked journal", journal.ID, true, true, entity.ErrJournalLocked},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			entry := newTestEntry(journal.ID, types.TradeResultTakeProfit, 100)
+			entry.IsPinned = !tt.pinned
+			entryStorage := &fakeEntryStorage{entries: []*entity.TradingJournalEntry{entry}}
+			svc := NewTradingJournalEntryService(entryStorage, &fakeJournalStorage{journal: journal, locked: tt.locked}, nil, zap.NewNop())
+
+			got, err := svc.SetPinned(context.Background(), entry.ID, tt.journalID, tt.pinned)
+			if tt.wantErr != nil {
+				if !errors.Is(err, tt.wantErr) {
+					t.Fatalf("SetPinned() error = %v, want %v", err, tt.wantErr)
+				}
+				if entry.IsPinned == tt.pinned {
+					t.Errorf("SetPinned() changed the stored flag despite failing")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("SetPinned() error = %v", err)
+			}
+
+			if got.IsPinned != tt.pinned || entry.IsPinned != tt.pinned {
+				t.Errorf("pinned: returned %v, stored %v, want %v", got.IsPinned, entry.IsPinned, tt.pinned)
+			}
+		})
+	}
+}
+
+type emotionEntryStorage struct {
+	TradingJournalEntryStorage
+	stats []*entity.EmotionStatistics
+}
+
+func (s *emotionEntryStorage) GetStatisticsByEmotion(context.Context, uuid.UUID) ([]*entity.EmotionStatistics, error) {
+	return s.stats, nil
+}
+
+func TestGetStatisticsByEmotion(t *testing.T) {
+	storage := &emotionEntryStorage{stats: []*entity.EmotionStatistics{
+		{Emotion: types.EmotionCalm, TotalTrades: 4, Wins: 3, Losses: 1},
+		{Emotion: types.EmotionGreedy, TotalTrades: 5, Wins: 1, Losses: 4},
+		{Emotion: types.EmotionFearful},
+	}}
+	svc := NewTradingJournalEntryService(storage, nil, nil, zap.NewNop())
+
+	stats, err := svc.GetStatisticsByEmotion(context.Background(), uuid.New())
+	if err != nil {
+		t.Fatalf("GetStatisticsByEmotion() error = %v", err)
+	}
+
+	want := map[types.Emotion]float64{
+		types.EmotionCalm:    75,
+		types.EmotionGreedy:  20,
+		types.EmotionFearful: 0,
+	}
+	for _, stat := range stats {
+		if stat.WinRate != want[stat.Emotion] {
+			t.Errorf("%s win rate = %v, want %v", stat.Emotion, stat.WinRate, want[stat.Emotion])
+		}
+	}
+}
+
+type confidenceEntryStorage struct {
+	TradingJournalEntryStorage
+	stats []*entity.ConfidenceStatistics
+}
+
+func (s *confidenceEntryStorage) GetStatisticsByConfidence(context.Context, uuid.UUID) ([]*entity.ConfidenceStatistics, error) {
+	return s.stats, nil
+}
+
+func TestGetStatisticsByConfidence(t *testing.T) {
+	storage := &confidenceEntryStorage{stats: []*entity.ConfidenceStatistics{
+		{Confidence: 1, TotalTrades: 4, Wins: 1, Losses: 3, TotalRealized: -200},
+		{Confidence: 3, TotalTrades: 5, Wins: 2, Losses: 2, BreakEven: 1, TotalRealized: 50},
+		{Confidence: 5, TotalTrades: 8, Wins: 6, Losses: 2, TotalRealized: 900},
+	}}
+	svc := NewTradingJournalEntryService(storage, nil, nil, zap.NewNop())
+
+	stats, err := svc.GetStatisticsByConfidence(context.Background(), uuid.New())
+	if err != nil {
+		t.Fatalf("GetStatisticsByConfidence() error = %v", err)
+	}
+
+	want := map[int]float64{1: 25, 3: 40, 5: 75}
+	if len(stats) != len(want) {
+		t.Fatalf("got %d levels, want %d", len(stats), len(want))
+	}
+	for _, stat := range stats {
+		if stat.WinRate != want[stat.Confidence] {
+			t.Errorf("confidence %d win rate = %v, want %v", stat.Confidence, stat.WinRate, want[stat.Confidence])
+		}
+	}
+}
+
+type userStatisticsStorage struct {
+	TradingJournalEntryStorage
+	journals []*entity.JournalStatistics
+}
+
+func (s *userStatisticsStorage) GetUserJournalStatistics(context.Context, uuid.UUID) ([]*entity.JournalStatistics, error) {
+	return s.journals, nil
+}
+
+func TestGetUserStatistics(t *testing.T) {
+	tests := []struct {
+		name        string
+		journals    []*entity.JournalStatistics
+		wantTrades  int
+		wantWins    int
+		wantWinRate float64
+		wantNet     float64
+	}{
+		{
+			name: "two journals",
+			journals: []*entity.JournalStatistics{
+				{JournalName: "Swing", TotalTrades: 4, Wins: 3, Losses: 1, TotalRealized: 120.10},
+				{JournalName: "Scalping", TotalTrades: 6, Wins: 2, Losses: 3, BreakEven: 1, TotalRealized: -20.20},
+			},
+			wantTrades:  10,
+			wantWins:    5,
+			wantWinRate: 50,
+			wantNet:     99.90,
+		},
+		{
+			name: "journal without entries",
+			journals: []*entity.JournalStatistics{
+				{JournalName: "Swing", TotalTrades: 2, Wins: 2, TotalRealized: 50},
+				{JournalName: "Empty"},
+			},
+			wantTrades:  2,
+			wantWins:    2,
+			wantWinRate: 100,
+			wantNet:     50,
+		},
+		{name: "no journals"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			svc := NewTradingJournalEntryService(&userStatisticsStorage{journals: tt.journals}, nil, nil, zap.NewNop())
+
+			stats, err := svc.GetUserStatistics(context.Background(), uuid.New())
+			if err != nil {
+				t.Fatalf("GetUserStatistics() error = %v", err)
+			}
+
+			if stats.TotalJournals != len(tt.journals) || len(stats.Journals) != len(tt.journals) {
+				t.Errorf("journals = %d (%d listed), want %d", stats.TotalJournals, len(stats.Journals), len(tt.journals))
+			}
+			if stats.TotalTrades != tt.wantTrades || stats.Wins != tt.wantWins {
+				t.Errorf("trades = %d, wins = %d, want %d, %d", stats.TotalTrades, stats.Wins, tt.wantTrades, tt.wantWins)
+			}
+			if stats.WinRate != tt.wantWinRate {
+				t.Errorf("win rate = %v, want %v", stats.WinRate, tt.wantWinRate)
+			}
+			if stats.TotalRealized != tt.wantNet {
+				t.Errorf("total realized = %v, want %v", stats.TotalRealized, tt.wantNet)
+			}
+			for _, journal := range stats.Journals {
+				if journal.TotalTrades == 0 && journal.WinRate != 0 {
+					t.Errorf("%s win rate = %v for a journal without trades", journal.JournalName, journal.WinRate)
+				}
+			}
+		})
+	}
+}
+
+// deleteEntryStorage tracks soft- and hard-deleted entries.
+type deleteEntryStorage struct {
+	fakeEntryStorage
+	softDeleted map[uuid.UUID]bool
+	hardDeleted map[uuid.UUID]bool
+}
+
+func (s *deleteEntryStorage) Exists(ctx context.Context, id uuid.UUID, journalID uuid.UUID) (bool, error) {
+	exists, err := s.fakeEntryStorage.Exists(ctx, id, journalID)
+	return exists && !s.softDeleted[id] && !s.hardDeleted[id], err
+}
+
+func (s *deleteEntryStorage) ExistsWithDeleted(ctx context.Context, id uuid.UUID, journalID uuid.UUID) (bool, error) {
+	exists, err := s.fakeEntryStorage.Exists(ctx, id, journalID)
+	return exists && !s.hardDeleted[id], err
+}
+
+func (s *deleteEntryStorage) Delete(_ context.Context, id uuid.UUID) error {
+	s.softDeleted[id] = true
+	return nil
+}
+
+func (s *deleteEntryStorage) HardDelete(_ context.Context, id uuid.UUID) error {
+	s.hardDeleted[id] = true
+	return nil
+}
+
+func TestDeleteEntry(t *testing.T) {
+	journal := newTestJournal()
+	live := newTestEntry(journal.ID, types.TradeResultTakeProfit, 120)
+	trashed := newTestEntry(journal.ID, types.TradeResultStopLoss, -50)
+
+	tests := []struct {
+		name     string
+		entryID  uuid.UUID
+		hard     bool
+		locked   bool
+		wantErr  error
+		wantSoft bool
+		wantHard bool
+	}{
+		{"soft delete", live.ID, false, false, nil, true, false},
+		{"hard delete", live.ID, true, false, nil, false, true},
+		{"hard delete a soft-deleted entry", trashed.ID, true, false, nil, true, true},
+		{"soft delete a soft-deleted entry", trashed.ID, false, false, entity.ErrNotFound, true, false},
+		{"unknown entry", uuid.New(), true, false, entity.ErrNotFound, false, false},
+		{"locked journal", live.ID, false, true, entity.ErrJournalLocked, false, false},
+		{"hard delete in a locked journal", live.ID, true, true, entity.ErrJournalLocked, false, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			storage := &deleteEntryStorage{
+				fakeEntryStorage: fakeEntryStorage{entries: []*entity.TradingJournalEntry{live, trashed}},
+				softDeleted:      map[uuid.UUID]bool{trashed.ID: true},
+				hardDeleted:      map[uuid.UUID]bool{},
+			}
+			svc := NewTradingJournalEntryService(storage, &fakeJournalStorage{journal: journal, locked: tt.locked}, nil, zap.NewNop())
+
+			var err error
+			if tt.hard {
+				err = svc.HardDelete(context.Background(), tt.entryID, journal.ID)
+			} else {
+				err = svc.Delete(context.Background(), tt.entryID, journal.ID)
+			}
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("error = %v, want %v", err, tt.wantErr)
+			}
+
+			if storage.softDeleted[tt.entryID] != tt.wantSoft || storage.hardDeleted[tt.entryID] != tt.wantHard {
+				t.Errorf("soft deleted %v, hard deleted %v, want %v, %v",
+					storage.softDeleted[tt.entryID], storage.hardDeleted[tt.entryID], tt.wantSoft, tt.wantHard)
+			}
+		})
+	}
+}
+
+type statisticsEntryStorage struct {
+	TradingJournalEntryStorage
+	statistics entity.EntryStatistics
+}
+
+func (s *statisticsEntryStorage) GetStatistics(context.Context, uuid.UUID) (*entity.EntryStatistics, error) {
+	stats := s.statistics
+	return &stats, nil
+}
+
+func TestGetStatisticsOfEmptyJournal(t *testing.T) {
+	journal := newTestJournal()
+	svc := NewTradingJournalEntryService(&statisticsEntryStorage{}, &fakeJournalStorage{journal: journal}, nil, zap.NewNop())
+
+	before := time.Now().UTC()
+	stats, err := svc.GetStatistics(context.Background(), journal.ID)
+	if err != nil {
+		t.Fatalf("GetStatistics() error = %v", err)
+	}
+
+	if stats.RangeStart != nil || stats.RangeEnd != nil {
+		t.Errorf("range = %v to %v, want none", stats.RangeStart, stats.RangeEnd)
+	}
+	if stats.GeneratedAt.Before(before) || stats.GeneratedAt.After(time.Now().UTC()) || stats.GeneratedAt.Location() != time.UTC {
+		t.Errorf("generated at = %v, want the current time in UTC", stats.GeneratedAt)
+	}
+}
+
+func TestGetStatistics(t *testing.T) {
+	journal := newTestJournal()
+	journal.PipValue = ptr(10.0)
+	rangeStart := time.Date(2026, 1, 5, 0, 0, 0, 0, time.UTC)
+	rangeEnd := time.Date(2026, 3, 20, 0, 0, 0, 0, time.UTC)
+
+	entryStorage := &statisticsEntryStorage{statistics: entity.EntryStatistics{
+		TotalTrades:    8,
+		Wins:           4,
+		Losses:         3,
+		BreakEven:      1,
+		TotalRealized:  500,
+		AvgRiskReward:  2.5,
+		AvgRiskPercent: 1,
+		AvgWin:         200,
+		AvgLoss:        100,
+		AvgPlannedRR:   2,
+		AvgAchievedRR:  1.5,
+		RangeStart:     &rangeStart,
+		RangeEnd:       &rangeEnd,
+	}}
+	svc := NewTradingJournalEntryService(entryStorage, &fakeJournalStorage{journal: journal}, nil, zap.NewNop())
+
+	stats, err := svc.GetStatistics(context.Background(), journal.ID)
+	if err != nil {
+		t.Fatalf("GetStatistics() error = %v", err)
+	}
+
+	tests := []struct {
+		name string
+		got  float64
+		want float64
+	}{
+		{"total trades", float64(stats.TotalTrades), 8},
+		{"wins", float64(stats.Wins), 4},
+		{"losses", float64(stats.Losses), 3},
+		{"break even", float64(stats.BreakEven), 1},
+		{"total realized", stats.TotalRealized, 500},
+		{"avg risk reward", stats.AvgRiskReward, 2.5},
+		{"avg risk percent", stats.AvgRiskPercent, 1},
+		{"avg win", stats.AvgWin, 200},
+		{"avg loss", stats.AvgLoss, 100},
+		{"avg planned rr", stats.AvgPlannedRR, 2},
+		{"avg achieved rr", stats.AvgAchievedRR, 1.5},
+		{"win rate", stats.WinRate, 50},
+		{"rr difference", stats.RRDifference, -0.5},
+	}
+
+	for _, tt := range tests {
+		if tt.got != tt.want {
+			t.Errorf("%s = %v, want %v", tt.name, tt.got, tt.want)
+		}
+	}
+
+	if stats.RangeStart == nil || !stats.RangeStart.Equal(rangeStart) || stats.RangeEnd == nil || !stats.RangeEnd.Equal(rangeEnd) {
+		t.Errorf("range = %v to %v, want %v to %v", stats.RangeStart, stats.RangeEnd, rangeStart, rangeEnd)
+	}
+	if stats.GeneratedAt.IsZero() {
+		t.Error("generated at not set")
+	}
+	if stats.TotalRealizedPips == nil || *stats.TotalRealizedPips != 50 {
+		t.Errorf("total realized pips = %v, want 50", stats.TotalRealizedPips)
+	}
+}
+
+// syncEntryStorage holds entries in (updated_at, id) order and pages them
+// like the storage's sync query.
+type syncEntryStorage struct {
+	TradingJournalEntryStorage
+	entries []*entity.TradingJournalEntry
+}
+
+func (s *syncEntryStorage) GetModifiedSince(_ context.Context, params bunstorage.GetModifiedSinceParams) ([]*entity.TradingJournalEntry, error) {
+	var entries []*entity.TradingJournalEntry
+	for _, entry := range s.entries {
+		if entry.JournalID != params.JournalID || !entry.UpdatedAt.After(params.Since) {
+			continue
+		}
+		if params.AfterUpdatedAt != nil && !entry.UpdatedAt.After(*params.AfterUpdatedAt) {
+			continue
+		}
+		if len(entries) == params.Limit {
+			break
+		}
+		entries = append(entries, entry)
+	}
+	return entries, nil
+}
+
+func TestGetModifiedSince(t *testing.T) {
+	journalID := uuid.New()
+	since := time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+
+	unchanged := newTestEntry(journalID, types.TradeResultTakeProfit, 100)
+	unchanged.ID = uuid.New()
+	unchanged.UpdatedAt = since.Add(-time.Hour)
+
+	created := newTestEntry(journalID, types.TradeResultTakeProfit, 100)
+	created.ID = uuid.New()
+	created.CreatedAt = since.Add(time.Minute)
+	created.UpdatedAt = created.CreatedAt
+
+	updated := newTestEntry(journalID, types.TradeResultStopLoss, -50)
+	updated.ID = uuid.New()
+	updated.CreatedAt = since.Add(-24 * time.Hour)
+	updated.UpdatedAt = since.Add(2 * time.Minute)
+
+	deleted := newTestEntry(journalID, types.TradeResultBreakEven, 0)
+	deleted.ID = uuid.New()
+	deleted.UpdatedAt = since.Add(3 * time.Minute)
+	deleted.DeletedAt = deleted.UpdatedAt
+
+	svc := NewTradingJournalEntryService(
+		&syncEntryStorage{entries: []*entity.TradingJournalEntry{unchanged, created, updated, deleted}},
+		&fakeJournalStorage{}, nil, zap.NewNop(),
+	)
+
+	tests := []struct {
+		name  string
+		limit int
+		pages [][]uuid.UUID
+	}{
+		{name: "one page", limit: 10, pages: [][]uuid.UUID{{created.ID, updated.ID, deleted.ID}}},
+		{name: "cursor pages", limit: 2, pages: [][]uuid.UUID{{created.ID, updated.ID}, {deleted.ID}}},
+		{name: "exact page size", limit: 3, pages: [][]uuid.UUID{{created.ID, updated.ID, deleted.ID}}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cursor := ""
+			for i, want := range tt.pages {
+				entries, next, err := svc.GetModifiedSince(context.Background(), journalID, since, cursor, tt.limit)
+				if err != nil {
+					t.Fatalf("page %d: GetModifiedSince() error = %v", i, err)
+				}
+
+				got := make([]uuid.UUID, len(entries))
+				for j, entry := range entries {
+					got[j] = entry.ID
+				}
+				if !slices.Equal(got, want) {
+					t.Errorf("page %d = %v, want %v", i, got, want)
+				}
+
+				last := i == len(tt.pages)-1
+				if last != (next == "") {
+					t.Fatalf("page %d: next cursor = %q, want one only before the last page", i, next)
+				}
+				cursor = next
+			}
+		})
+	}
+}
+
+func TestGetModifiedSinceRejectsInvalidCursor(t *testing.T) {
+	svc := NewTradingJournalEntryService(&syncEntryStorage{}, &fakeJournalStorage{}, nil, zap.NewNop())
+
+	tests := []struct {
+		name   string
+		cursor string
+	}{
+		{name: "not base64", cursor: "!!!"},
+		{name: "missing separator", cursor: base64.RawURLEncoding.EncodeToString([]byte("2026-03-01T12:00:00Z"))},
+		{name: "bad timestamp", cursor: base64.RawURLEncoding.EncodeToString([]byte("yesterday|" + uuid.NewString()))},
+		{name: "bad id", cursor: base64.RawURLEncoding.EncodeToString([]byte("2026-03-01T12:00:00Z|not-a-uuid"))},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, _, err := svc.GetModifiedSince(context.Background(), uuid.New(), time.Time{}, tt.cursor, 10)
+			if !errors.Is(err, entity.ErrInvalidSyncCursor) {
+				t.Errorf("error = %v, want ErrInvalidSyncCursor", err)
+			}
+		})
+	}
+}
+
+func TestGetUserStatisticsSumsRealizedInCents(t *testing.T) {
+	journals := make([]*entity.JournalStatistics, 1000)
+	for i := range journals {
+		journals[i] = &entity.JournalStatistics{JournalName: "Challenge", TotalTrades: 1, Wins: 1, TotalRealized: 0.10}
+	}
+	svc := NewTradingJournalEntryService(&userStatisticsStorage{journals: journals}, nil, nil, zap.NewNop())
+
+	stats, err := svc.GetUserStatistics(context.Background(), uuid.New())
+	if err != nil {
+		t.Fatalf("GetUserStatistics() error = %v", err)
+	}
+
+	if stats.TotalRealized != 100 {
+		t.Errorf("total realized = %v, want exactly 100", stats.TotalRealized)
+	}
+}
+
+// Journals carry no currency, so user totals are a plain sum and each
+// journal's figure is reported unconverted.
+func TestGetUserStatisticsDoesNotConvertJournalTotals(t *testing.T) {
+	journals := []*entity.JournalStatistics{
+		{JournalName: "Forex", TotalTrades: 3, Wins: 2, TotalRealized: 1000.50},
+		{JournalName: "Indices", TotalTrades: 2, Losses: 2, TotalRealized: -250.25},
+	}
+	svc := NewTradingJournalEntryService(&userStatisticsStorage{journals: journals}, nil, nil, zap.NewNop())
+
+	stats, err := svc.GetUserStatistics(context.Background(), uuid.New())
+	if err != nil {
+		t.Fatalf("GetUserStatistics() error = %v", err)
+	}
+
+	if stats.TotalRealized != 750.25 {
+		t.Errorf("total realized = %v, want the plain sum 750.25", stats.TotalRealized)
+	}
+	for i, want := range []float64{1000.50, -250.25} {
+		if got := stats.Journals[i].TotalRealized; got != want {
+			t.Errorf("%s total realized = %v, want %v as stored", stats.Journals[i].JournalName, got, want)
+		}
+	}
+}
+
+type reviewEntryStorage struct {
+	fakeEntryStorage
+}
+
+func (s *reviewEntryStorage) SetReviewStatus(_ context.Context, id uuid.UUID, status types.ReviewStatus) error {
+	s.find(id).ReviewStatus = status
+	return nil
+}
+
+func TestSetReviewStatus(t *testing.T) {
+	journal := newTestJournal()
+
+	tests := []struct {
+		name      string
+		journalID uuid.UUID
+		locked    bool
+		status    types.ReviewStatus
+		wantErr   error
+	}{
+		{"reviewed", journal.ID, false, types.ReviewStatusReviewed, nil},
+		{"flagged", journal.ID, false, types.ReviewStatusFlagged, nil},
+		{"back to unreviewed", journal.ID, false, types.ReviewStatusUnreviewed, nil},
+		{"unknown status", journal.ID, false, types.ReviewStatus("done"), entity.ErrInvalidReviewStatus},
+		{"entry of another journal", uuid.New(), false, types.ReviewStatusReviewed, entity.ErrNotFound},
+		{"locked journal", journal.ID, true, types.ReviewStatusReviewed, entity.ErrJournalLocked},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			entry := newTestEntry(journal.ID, types.TradeResultTakeProfit, 100)
+			entry.ID = uuid.New()
+			if tt.status == types.ReviewStatusUnreviewed {
+				entry.ReviewStatus = types.ReviewStatusFlagged
+			}
+			before := entry.ReviewStatus
+			entryStorage := &reviewEntryStorage{fakeEntryStorage: fakeEntryStorage{entries: []*entity.TradingJournalEntry{entry}}}
+			svc := NewTradingJournalEntryService(entryStorage, &fakeJournalStorage{journal: journal, locked: tt.locked}, nil, zap.NewNop())
+
+			got, err := svc.SetReviewStatus(context.Background(), entry.ID, tt.journalID, tt.status)
+			if tt.wantErr != nil {
+				if !errors.Is(err, tt.wantErr) {
+					t.Fatalf("SetReviewStatus() error = %v, want %v", err, tt.wantErr)
+				}
+				if entry.ReviewStatus != before {
+					t.Errorf("stored status = %s despite failing, want %s", entry.ReviewStatus, before)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("SetReviewStatus() error = %v", err)
+			}
+
+			if got.ReviewStatus != tt.status || entry.ReviewStatus != tt.status {
+				t.Errorf("review status: returned %s, stored %s, want %s", got.ReviewStatus, entry.ReviewStatus, tt.status)
+			}
+		})
+	}
+}
+
+// dateRangeEntryStorage records the params of the last range query.
+type dateRangeEntryStorage struct {
+	TradingJournalEntryStorage
+	params bunstorage.GetByDateRangeParams
+}
+
+func (s *dateRangeEntryStorage) GetByDateRange(_ context.Context, params bunstorage.GetByDateRangeParams) ([]*entity.TradingJournalEntry, error) {
+	s.params = params
+	return nil, nil
+}
+
+func TestGetByDateRangePaginates(t *testing.T) {
+	tests := []struct {
+		name       string
+		limit      int
+		offset     int
+		wantLimit  int
+		wantOffset int
+	}{
+		{"explicit page", 50, 100, 50, 100},
+		{"max page size", maxDateRangePageSize, 0, maxDateRangePageSize, 0},
+		{"zero limit", 0, 0, defaultDateRangePageSize, 0},
+		{"negative limit", -1, 0, defaultDateRangePageSize, 0},
+		{"limit above max", maxDateRangePageSize + 1, 0, defaultDateRangePageSize, 0},
+		{"negative offset", 10, -5, 10, 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			storage := &dateRangeEntryStorage{}
+			svc := NewTradingJournalEntryService(storage, &fakeJournalStorage{}, nil, zap.NewNop())
+
+			start := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+			end := time.Date(2025, 12, 31, 0, 0, 0, 0, time.UTC)
+			if _, err := svc.GetByDateRange(context.Background(), uuid.New(), start, end, tt.limit, tt.offset); err != nil {
+				t.Fatalf("GetByDateRange() error = %v", err)
+			}
+
+			if storage.params.Limit != tt.wantLimit || storage.params.Offset != tt.wantOffset {
+				t.Errorf("limit, offset = %d, %d, want %d, %d", storage.params.Limit, storage.params.Offset, tt.wantLimit, tt.wantOffset)
+			}
+			if !storage.params.StartDate.Equal(start) || !storage.params.EndDate.Equal(end) {
+				t.Errorf("range = %v..%v, want %v..%v", storage.params.StartDate, storage.params.EndDate, start, end)
+			}
+		})
+	}
+}
+
+func TestNewEntryFromRequestFollowedPlan(t *testing.T) {
+	tests := []struct {
+		name         string
+		followedPlan *bool
+		want         bool
+	}{
+		{"not given", nil, true},
+		{"followed", ptr(true), true},
+		{"impulsive", ptr(false), false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			entry := newEntryFromRequest(uuid.New(), &dto.CreateTradingJournalEntryRequest{FollowedPlan: tt.followedPlan})
+			if entry.FollowedPlan != tt.want {
+				t.Errorf("FollowedPlan = %v, want %v", entry.FollowedPlan, tt.want)
+			}
+		})
+	}
+}
+
+type adherenceEntryStorage struct {
+	TradingJournalEntryStorage
+	rows []*entity.PlanAdherenceStatistics
+}
+
+func (s *adherenceEntryStorage) GetStatisticsByPlanAdherence(context.Context, uuid.UUID) ([]*entity.PlanAdherenceStatistics, error) {
+	return s.rows, nil
+}
+
+func TestGetAdherenceStatistics(t *testing.T) {
+	inPlan := func() *entity.PlanAdherenceStatistics {
+		return &entity.PlanAdherenceStatistics{FollowedPlan: true, TotalTrades: 10, Wins: 6, Losses: 3, BreakEven: 1, TotalRealized: 500}
+	}
+	outOfPlan := func() *entity.PlanAdherenceStatistics {
+		return &entity.PlanAdherenceStatistics{FollowedPlan: false, TotalTrades: 4, Wins: 1, Losses: 3, TotalRealized: -300}
+	}
+
+	tests := []struct {
+		name          string
+		rows          []*entity.PlanAdherenceStatistics
+		wantInPlan    entity.PlanAdherenceStatistics
+		wantOutOfPlan entity.PlanAdherenceStatistics
+	}{
+		{
+			name:          "both buckets",
+			rows:          []*entity.PlanAdherenceStatistics{outOfPlan(), inPlan()},
+			wantInPlan:    entity.PlanAdherenceStatistics{FollowedPlan: true, TotalTrades: 10, Wins: 6, Losses: 3, BreakEven: 1, TotalRealized: 500, WinRate: 60},
+			wantOutOfPlan: entity.PlanAdherenceStatistics{FollowedPlan: false, TotalTrades: 4, Wins: 1, Losses: 3, TotalRealized: -300, WinRate: 25},
+		},
+		{
+			name:          "only in plan",
+			rows:          []*entity.PlanAdherenceStatistics{inPlan()},
+			wantInPlan:    entity.PlanAdherenceStatistics{FollowedPlan: true, TotalTrades: 10, Wins: 6, Losses: 3, BreakEven: 1, TotalRealized: 500, WinRate: 60},
+			wantOutOfPlan: entity.PlanAdherenceStatistics{FollowedPlan: false},
+		},
+		{
+			name:          "no trades",
+			wantInPlan:    entity.PlanAdherenceStatistics{FollowedPlan: true},
+			wantOutOfPlan: entity.PlanAdherenceStatistics{FollowedPlan: false},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			svc := NewTradingJournalEntryService(&adherenceEntryStorage{rows: tt.rows}, &fakeJournalStorage{}, nil, zap.NewNop())
+
+			stats, err := svc.GetAdherenceStatistics(context.Background(), uuid.New())
+			if err != nil {
+				t.Fatalf("GetAdherenceStatistics() error = %v", err)
+			}
+			if *stats.InPlan != tt.wantInPlan {
+				t.Errorf("in plan = %+v, want %+v", *stats.InPlan, tt.wantInPlan)
+			}
+			if *stats.OutOfPlan != tt.wantOutOfPlan {
+				t.Errorf("out of plan = %+v, want %+v", *stats.OutOfPlan, tt.wantOutOfPlan)
+			}
+		})
+	}
+}
+
+// recordingCache records the keys deleted from it.
+type recordingCache struct {
+	Cache
+	deleted []string
+}
+
+func (c *recordingCache) Delete(_ context.Context, keys ...string) error {
+	c.deleted = append(c.deleted, keys...)
+	return nil
+}
+
+func TestEntryWritesInvalidateJournalCache(t *testing.T) {
+	journal := newTestJournal()
+	journalKey := "journal:" + journal.ID.String()
+
+	tests := []struct {
+		name   string
+		locked bool
+		write  func(svc *TradingJournalEntryService, entry *entity.TradingJournalEntry) error
+		want   []string
+	}{
+		{
+			name: "create",
+			write: func(svc *TradingJournalEntryService, _ *entity.TradingJournalEntry) error {
+				_, err := svc.Create(context.Background(), journal.ID, &dto.CreateTradingJournalEntryRequest{
+					Day: time.Date(2026, 1, 5, 0, 0, 0, 0, time.UTC), Asset: types.CurrencyPairEURUSD,
+					LTF: "https://charts.example.com/ltf", HTF: "https://charts.example.com/htf",
+					Session: types.TradingSessionLondon, TradeType: types.TradeTypeIntraday, Direction: types.TradeDirectionBuy,
+					EntryType: types.EntryTypeMarket, Realized: 100, MaxRR: 2, Result: types.TradeResultTakeProfit,
+				})
+				return err
+			},
+			want: []string{journalKey},
+		},
+		{
+			name: "soft delete",
+			write: func(svc *TradingJournalEntryService, entry *entity.TradingJournalEntry) error {
+				return svc.Delete(context.Background(), entry.ID, journal.ID)
+			},
+			want: []string{journalKey},
+		},
+		{
+			name: "hard delete",
+			write: func(svc *TradingJournalEntryService, entry *entity.TradingJournalEntry) error {
+				return svc.HardDelete(context.Background(), entry.ID, journal.ID)
+			},
+			want: []string{journalKey},
+		},
+		{
+			name:   "rejected write",
+			locked: true,
+			write: func(svc *TradingJournalEntryService, entry *entity.TradingJournalEntry) error {
+				return svc.Delete(context.Background(), entry.ID, journal.ID)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			entry := newTestEntry(journal.ID, types.TradeResultTakeProfit, 120)
+			storage := &deleteEntryStorage{
+				fakeEntryStorage: fakeEntryStorage{entries: []*entity.TradingJournalEntry{entry}},
+				softDeleted:      map[uuid.UUID]bool{},
+				hardDeleted:      map[uuid.UUID]bool{},
+			}
+			cache := &recordingCache{}
+			svc := NewTradingJournalEntryService(storage, &fakeJournalStorage{journal: journal, locked: tt.locked}, nil, zap.NewNop()).
+				WithCache(cache)
+
+			err := tt.write(svc, entry)
+			if (err != nil) != tt.locked {
+				t.Fatalf("write error = %v", err)
+			}
+			if !slices.Equal(cache.deleted, tt.want) {
+				t.Errorf("invalidated %v, want %v", cache.deleted, tt.want)
+			}
+		})
+	}
+}
+
+// undoEntryStorage restores entry, if set, and records the cutoff it was
+// asked for.
+type undoEntryStorage struct {
+	fakeEntryStorage
+	entry        *entity.TradingJournalEntry
+	deletedAfter time.Time
+}
+
+func (s *undoEntryStorage) RestoreLastDeleted(_ context.Context, _ uuid.UUID, deletedAfter time.Time) (*entity.TradingJournalEntry, error) {
+	s.deletedAfter = deletedAfter
+	if s.entry == nil {
+		return nil, errors.Wrap(entity.ErrNotFound, "recently deleted trading journal entry")
+	}
+	return s.entry, nil
+}
+
+func TestUndo(t *testing.T) {
+	journal := newTestJournal()
+	journalKey := "journal:" + journal.ID.String()
+	deleted := newTestEntry(journal.ID, types.TradeResultStopLoss, -50)
+
+	tests := []struct {
+		name           string
+		entry          *entity.TradingJournalEntry
+		locked         bool
+		wantErr        error
+		wantInvalidate []string
+	}{
+		{"restores the entry", deleted, false, nil, []string{journalKey}},
+		{"nothing to restore", nil, false, entity.ErrNotFound, nil},
+		{"locked journal", deleted, true, entity.ErrJournalLocked, nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			storage := &undoEntryStorage{entry: tt.entry}
+			cache := &recordingCache{}
+			svc := NewTradingJournalEntryService(storage, &fakeJournalStorage{journal: journal, locked: tt.locked}, nil, zap.NewNop()).
+				WithCache(cache)
+
+			before := time.Now()
+			entry, err := svc.Undo(context.Background(), journal.ID)
+			after := time.Now()
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("Undo() error = %v, want %v", err, tt.wantErr)
+			}
+			if tt.locked {
+				if entry != nil || !storage.deletedAfter.IsZero() {
+					t.Errorf("Undo() restored %v in a locked journal", entry)
+				}
+			} else if entry != tt.entry {
+				t.Errorf("Undo() = %v, want %v", entry, tt.entry)
+			} else if storage.deletedAfter.Before(before.Add(-undoWindow)) || storage.deletedAfter.After(after.Add(-undoWindow)) {
+				t.Errorf("restore cutoff %v, want %v before the call", storage.deletedAfter, undoWindow)
+			}
+			if !slices.Equal(cache.deleted, tt.wantInvalidate) {
+				t.Errorf("invalidated %v, want %v", cache.deleted, tt.wantInvalidate)
+			}
+		})
+	}
+}
+
+func TestGetStatisticsRRDifference(t *testing.T) {
+	tests := []struct {
+		name     string
+		planned  float64
+		achieved float64
+		want     float64
+	}{
+		{"early exits", 3, 1.75, -1.25},
+		{"ran past target", 2, 2.5, 0.5},
+		{"as planned", 2, 2, 0},
+		{"no priced entries", 0, 0, 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			journal := newTestJournal()
+			storage := &statisticsEntryStorage{statistics: entity.EntryStatistics{
+				TotalTrades:   4,
+				AvgPlannedRR:  tt.planned,
+				AvgAchievedRR: tt.achieved,
+			}}
+			svc := NewTradingJournalEntryService(storage, &fakeJournalStorage{journal: journal}, nil, zap.NewNop())
+
+			stats, err := svc.GetStatistics(context.Background(), journal.ID)
+			if err != nil {
+				t.Fatalf("GetStatistics() error = %v", err)
+			}
+			if stats.RRDifference != tt.want {
+				t.Errorf("rr difference = %v, want %v", stats.RRDifference, tt.want)
+			}
+		})
+	}
+}
+
+func TestDuplicateEntry(t *testing.T) {
+	journal := newTestJournal()
+	day := time.Date(2026, 3, 9, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name    string
+		entryID func(source *entity.TradingJournalEntry) uuid.UUID
+		locked  bool
+		req     dto.DuplicateTradingJournalEntryRequest
+		apply   func(want *entity.TradingJournalEntry)
+		wantErr error
+	}{
+		{name: "copies the source"},
+		{
+			name: "applies overrides",
+			req: dto.DuplicateTradingJournalEntryRequest{
+				Day: &day, Asset: ptr(types.CurrencyPairGBPUSD), Realized: ptr(80.0),
+				MaxRR: ptr(4.0), Result: ptr(types.TradeResultTakeProfit), Notes: ptr("waited for the retest"),
+			},
+			apply: func(want *entity.TradingJournalEntry) {
+				want.Day = day
+				want.Asset = types.CurrencyPairGBPUSD
+				want.Realized = 80
+				want.MaxRR = 4
+				want.Result = types.TradeResultTakeProfit
+				want.Notes = "waited for the retest"
+			},
+		},
+		{
+			name:    "unknown entry",
+			entryID: func(*entity.TradingJournalEntry) uuid.UUID { return uuid.New() },
+			wantErr: entity.ErrNotFound,
+		},
+		{name: "locked journal", locked: true, wantErr: entity.ErrJournalLocked},
+		{
+			name:    "invalid override",
+			req:     dto.DuplicateTradingJournalEntryRequest{Asset: ptr(types.CurrencyPair("EURXYZ"))},
+			wantErr: entity.ErrInvalidAsset,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			source := newTestEntry(journal.ID, types.TradeResultStopLoss, -50)
+			source.Setup = ptr("london breakout")
+			source.Notes = "chased the move"
+			source.EntryCharts = []string{"https://charts.example.com/entry"}
+			source.Emotion = ptr(types.EmotionGreedy)
+			source.Confidence = ptr(2)
+			source.CreatedAt = time.Date(2026, 1, 5, 10, 0, 0, 0, time.UTC)
+			source.UpdatedAt = source.CreatedAt
+			storage := &fakeEntryStorage{entries: []*entity.TradingJournalEntry{source}}
+			svc := NewTradingJournalEntryService(storage, &fakeJournalStorage{journal: journal, locked: tt.locked}, nil, zap.NewNop())
+
+			entryID := source.ID
+			if tt.entryID != nil {
+				entryID = tt.entryID(source)
+			}
+
+			before := time.Now().UTC()
+			entry, err := svc.Duplicate(context.Background(), entryID, journal.ID, &tt.req)
+			after := time.Now().UTC()
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("Duplicate() error = %v, want %v", err, tt.wantErr)
+			}
+			if tt.wantErr != nil {
+				if len(storage.entries) != 1 {
+					t.Errorf("stored %d entries, want only the source", len(storage.entries))
+				}
+				return
+			}
+
+			if entry.ID == uuid.Nil || entry.ID == source.ID {
+				t.Errorf("copy ID = %s, want a new ID", entry.ID)
+			}
+			if len(storage.entries) != 2 || storage.entries[1] != entry {
+				t.Fatalf("stored %d entries, want the source and the copy", len(storage.entries))
+			}
+			if tt.req.Day == nil && (entry.Day.Before(before) || entry.Day.After(after)) {
+				t.Errorf("copy day = %v, want now", entry.Day)
+			}
+			if !entry.CreatedAt.IsZero() || !entry.UpdatedAt.IsZero() {
+				t.Errorf("copy timestamps = %v, %v, want them left to the database", entry.CreatedAt, entry.UpdatedAt)
+			}
+
+			want := *source
+			want.ID = entry.ID
+			want.Day = entry.Day
+			want.CreatedAt, want.UpdatedAt = entry.CreatedAt, entry.UpdatedAt
+			// The copy is graded afresh, as any new entry is.
+			want.Grade = entry.Grade
+			if tt.apply != nil {
+				tt.apply(&want)
+			}
+			if !reflect.DeepEqual(*entry, want) {
+				t.Errorf("copy = %+v, want %+v", *entry, want)
+			}
+
+			entry.EntryCharts[0] = "https://charts.example.com/other"
+			if source.EntryCharts[0] != "https://charts.example.com/entry" {
+				t.Error("copy shares its entry charts with the source")
+			}
+		})
+	}
+}
+
+func TestNewEntryFromRequestKeepsChartsApart(t *testing.T) {
+	req := &dto.CreateTradingJournalEntryRequest{
+		EntryCharts: []string{"https://charts.example.com/fill.png"},
+		SetupCharts: []string{"https://charts.example.com/h4-bias.png", "https://charts.example.com/m15-zone.png"},
+	}
+
+	entry := newEntryFromRequest(uuid.New(), req)
+	if !slices.Equal(entry.EntryCharts, req.EntryCharts) {
+		t.Errorf("entry charts = %v, want %v", entry.EntryCharts, req.EntryCharts)
+	}
+	if !slices.Equal(entry.SetupCharts, req.SetupCharts) {
+		t.Errorf("setup charts = %v, want %v", entry.SetupCharts, req.SetupCharts)
+	}
+}
+
+// dailyEntryStorage records the time zone day-bucketed queries are run in.
+type dailyEntryStorage struct {
+	TradingJournalEntryStorage
+	year int
+	locs []*time.Location
+}
+
+func (s *dailyEntryStorage) GetDailyStatistics(_ context.Context, _ uuid.UUID, year int, loc *time.Location) ([]*entity.DailyStatistics, error) {
+	s.year = year
+	s.locs = append(s.locs, loc)
+	return nil, nil
+}
+
+func (s *dailyEntryStorage) GetDailyAssetStatistics(_ context.Context, _ uuid.UUID, loc *time.Location) ([]*entity.DailyAssetStatistics, error) {
+	s.locs = append(s.locs, loc)
+	return nil, nil
+}
+
+func TestDayBucketedStatisticsUseRequestedTimeZone(t *testing.T) {
+	tokyo, err := time.LoadLocation("Asia/Tokyo")
+	if err != nil {
+		t.Fatalf("load location: %v", err)
+	}
+
+	storage := &dailyEntryStorage{}
+	svc := NewTradingJournalEntryService(storage, nil, nil, zap.NewNop())
+
+	if _, err := svc.GetCalendar(context.Background(), uuid.New(), 2026, tokyo); err != nil {
+		t.Fatalf("GetCalendar() error = %v", err)
+	}
+	if _, err := svc.GetAssetCorrelation(context.Background(), uuid.New(), tokyo); err != nil {
+		t.Fatalf("GetAssetCorrelation() error = %v", err)
+	}
+
+	if storage.year != 2026 {
+		t.Errorf("calendar year = %d, want 2026", storage.year)
+	}
+	if len(storage.locs) != 2 || storage.locs[0] != tokyo || storage.locs[1] != tokyo {
+		t.Errorf("queries ran in %v, want Asia/Tokyo for both", storage.locs)
+	}
+}
+
+type updateEntryStorage struct {
+	fakeEntryStorage
+	updated []*entity.TradingJournalEntry
+}
+
+func (s *updateEntryStorage) Update(_ context.Context, entry *entity.TradingJournalEntry) error {
+	s.updated = append(s.updated, entry)
+	return nil
+}
+
+func TestNotesRequiredOnLoss(t *testing.T) {
+	tests := []struct {
+		name     string
+		required bool
+		notes    string
+		wantErr  error
+	}{
+		{name: "enabled rejects loss without notes", required: true, wantErr: entity.ErrNotesRequiredOnLoss},
+		{name: "enabled accepts loss with notes", required: true, notes: "chased the entry"},
+		{name: "disabled accepts loss without notes"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			journal := newTestJournal()
+			journal.RequireNotesOnLoss = tt.required
+			entryStorage := &updateEntryStorage{}
+			svc := NewTradingJournalEntryService(
+				entryStorage,
+				&fakeJournalStorage{journal: journal},
+				&fakeTemplateStorage{},
+				zap.NewNop(),
+			)
+
+			_, err := svc.Create(context.Background(), journal.ID, &dto.CreateTradingJournalEntryRequest{
+				Day:       time.Date(2026, 1, 5, 0, 0, 0, 0, time.UTC),
+				Asset:     types.CurrencyPairEURUSD,
+				LTF:       "https://charts.example.com/ltf",
+				HTF:       "https://charts.example.com/htf",
+				Session:   types.TradingSessionLondon,
+				TradeType: types.TradeTypeIntraday,
+				Direction: types.TradeDirectionBuy,
+				EntryType: types.EntryTypeMarket,
+				Realized:  -100,
+				MaxRR:     3,
+				Result:    types.TradeResultStopLoss,
+				Notes:     tt.notes,
+			})
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("Create() error = %v, want %v", err, tt.wantErr)
+			}
+			if tt.wantErr != nil && len(entryStorage.entries) != 0 {
+				t.Fatalf("stored %d entries, want none", len(entryStorage.entries))
+			}
+
+			entry := newTestEntry(journal.ID, types.TradeResultTakeProfit, -20)
+			entry.LTF = "https://charts.example.com/ltf"
+			entry.HTF = "https://charts.example.com/htf"
+			entry.Result = types.TradeResultBreakEven
+			entry.Notes = tt.notes
+			err = svc.Update(context.Background(), entry)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("Update() error = %v, want %v", err, tt.wantErr)
+			}
+			if tt.wantErr != nil && len(entryStorage.updated) != 0 {
+				t.Fatalf("updated %d entries, want none", len(entryStorage.updated))
+			}
+		})
+	}
+}
+
+type assetStatisticsStorage struct {
+	TradingJournalEntryStorage
+	assets []*entity.AssetTradeStatistics
+}
+
+func (s *assetStatisticsStorage) GetStatisticsByAsset(context.Context, uuid.UUID) ([]*entity.AssetTradeStatistics, error) {
+	return s.assets, nil
+}
+
+func TestGetStatisticsByCategory(t *testing.T) {
+	tests := []struct {
+		name   string
+		assets []*entity.AssetTradeStatistics
+		want   []*entity.CategoryStatistics
+	}{
+		{
+			name: "no trades",
+			want: []*entity.CategoryStatistics{},
+		},
+		{
+			name: "sums assets per category in category order",
+			assets: []*entity.AssetTradeStatistics{
+				{Asset: types.CurrencyPairUSDTRY, TotalTrades: 1, Losses: 1, TotalRealized: -40},
+				{Asset: types.CurrencyPairEURUSD, TotalTrades: 3, Wins: 2, Losses: 1, TotalRealized: 150},
+				{Asset: types.CurrencyPairGBPUSD, TotalTrades: 1, BreakEven: 1},
+				{Asset: "BTCUSD", TotalTrades: 2, Wins: 1, Losses: 1, TotalRealized: 10},
+			},
+			want: []*entity.CategoryStatistics{
+				{Category: types.AssetCategoryMajor, TotalTrades: 4, Wins: 2, Losses: 1, BreakEven: 1, TotalRealized: 150, WinRate: 50},
+				{Category: types.AssetCategoryExotic, TotalTrades: 1, Losses: 1, TotalRealized: -40},
+				{Category: types.AssetCategoryOther, TotalTrades: 2, Wins: 1, Losses: 1, TotalRealized: 10, WinRate: 50},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			svc := NewTradingJournalEntryService(&assetStatisticsStorage{assets: tt.assets}, nil, nil, zap.NewNop())
+
+			got, err := svc.GetStatisticsByCategory(context.Background(), uuid.New())
+			if err != nil {
+				t.Fatalf("GetStatisticsByCategory() error = %v", err)
+			}
+			if len(got) != len(tt.want) {
+				t.Fatalf("got %d categories, want %d", len(got), len(tt.want))
+			}
+			for i := range got {
+				if *got[i] != *tt.want[i] {
+					t.Errorf("category %d = %+v, want %+v", i, *got[i], *tt.want[i])
+				}
+			}
+		})
+	}
+}
+
+func TestEntryWritesAssignGrade(t *testing.T) {
+	newRequest := func(followedPlan bool, result types.TradeResult, realized float64) *dto.CreateTradingJournalEntryRequest {
+		return &dto.CreateTradingJournalEntryRequest{
+			Day:          time.Date(2026, 1, 5, 0, 0, 0, 0, time.UTC),
+			Asset:        types.CurrencyPairEURUSD,
+			LTF:          "https://charts.example.com/ltf",
+			HTF:          "https://charts.example.com/htf",
+			Session:      types.TradingSessionLondon,
+			TradeType:    types.TradeTypeIntraday,
+			Direction:    types.TradeDirectionBuy,
+			EntryType:    types.EntryTypeMarket,
+			Realized:     realized,
+			MaxRR:        3,
+			Result:       result,
+			Notes:        "review",
+			FollowedPlan: &followedPlan,
+		}
+	}
+
+	tests := []struct {
+		name    string
+		weights *entity.GradeWeights
+		req     *dto.CreateTradingJournalEntryRequest
+		want    types.TradeGrade
+	}{
+		{"planned win", nil, newRequest(true, types.TradeResultTakeProfit, 100), types.TradeGradeA},
+		{"planned loss", nil, newRequest(true, types.TradeResultStopLoss, -50), types.TradeGradeC},
+		{"unplanned loss", nil, newRequest(false, types.TradeResultStopLoss, -50), types.TradeGradeF},
+		{"configured weights", &entity.GradeWeights{Outcome: 1}, newRequest(true, types.TradeResultStopLoss, -50), types.TradeGradeF},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			journal := newTestJournal()
+			svc := NewTradingJournalEntryService(&fakeEntryStorage{}, &fakeJournalStorage{journal: journal}, &fakeTemplateStorage{}, zap.NewNop())
+			if tt.weights != nil {
+				svc.WithGradeWeights(*tt.weights)
+			}
+
+			entry, err := svc.Create(context.Background(), journal.ID, tt.req)
+			if err != nil {
+				t.Fatalf("Create() error = %v", err)
+			}
+			if entry.Grade == nil || *entry.Grade != tt.want {
+				t.Errorf("Grade = %v, want %q", entry.Grade, tt.want)
+			}
+		})
+	}
+
+	t.Run("update recomputes a stale grade", func(t *testing.T) {
+		journal := newTestJournal()
+		entryStorage := &updateEntryStorage{}
+		svc := NewTradingJournalEntryService(entryStorage, &fakeJournalStorage{journal: journal}, &fakeTemplateStorage{}, zap.NewNop())
+
+		entry := newTestEntry(journal.ID, types.TradeResultStopLoss, -50)
+		entry.LTF = "https://charts.example.com/ltf"
+		entry.HTF = "https://charts.example.com/htf"
+		entry.FollowedPlan = false
+		entry.Grade = ptr(types.TradeGradeA)
+
+		if err := svc.Update(context.Background(), entry); err != nil {
+			t.Fatalf("Update() error = %v", err)
+		}
+		if len(entryStorage.updated) != 1 || *entryStorage.updated[0].Grade != types.TradeGradeF {
+			t.Errorf("stored grade = %v, want %q", *entry.Grade, types.TradeGradeF)
+		}
+	})
+}
+
+type gradeStatisticsStorage struct {
+	TradingJournalEntryStorage
+	stats []*entity.GradeStatistics
+}
+
+func (s *gradeStatisticsStorage) GetStatisticsByGrade(context.Context, uuid.UUID) ([]*entity.GradeStatistics, error) {
+	return s.stats, nil
+}
+
+func TestGetStatisticsByGrade(t *testing.T) {
+	storage := &gradeStatisticsStorage{stats: []*entity.GradeStatistics{
+		{Grade: types.TradeGradeA, TotalTrades: 4, Wins: 3, Losses: 1},
+		{Grade: types.TradeGradeF, TotalTrades: 0},
+	}}
+	svc := NewTradingJournalEntryService(storage, nil, nil, zap.NewNop())
+
+	stats, err := svc.GetStatisticsByGrade(context.Background(), uuid.New())
+	if err != nil {
+		t.Fatalf("GetStatisticsByGrade() error = %v", err)
+	}
+	if stats[0].WinRate != 75 {
+		t.Errorf("grade A win rate = %v, want 75", stats[0].WinRate)
+	}
+	if stats[1].WinRate != 0 {
+		t.Errorf("empty grade win rate = %v, want 0", stats[1].WinRate)
+	}
+}
+
+// transferEntryStorage moves or copies its entries between journals in
+// memory, checking every entry before changing any.
+type transferEntryStorage struct {
+	fakeEntryStorage
+	params bunstorage.TransferEntriesParams
+}
+
+func (s *transferEntryStorage) TransferEntries(_ context.Context, params bunstorage.TransferEntriesParams) ([]*entity.TradingJournalEntry, error) {
+	s.params = params
+
+	var selected []*entity.TradingJournalEntry
+	for _, id := range params.EntryIDs {
+		entry := s.find(id)
+		if entry == nil || entry.JournalID != params.SourceJournalID {
+			return nil, errors.Wrap(entity.ErrNotFound, "trading journal entry")
+		}
+		if err := params.Check(entry); err != nil {
+			return nil, err
+		}
+		selected = append(selected, entry)
+	}
+
+	transferred := make([]*entity.TradingJournalEntry, len(selected))
+	for i, entry := range selected {
+		if params.Copy {
+			cp := *entry
+			cp.ID = uuid.New()
+			cp.JournalID = params.TargetJournalID
+			s.entries = append(s.entries, &cp)
+			transferred[i] = &cp
+			continue
+		}
+		entry.JournalID = params.TargetJournalID
+		transferred[i] = entry
+	}
+	return transferred, nil
+}
+
+func (s *transferEntryStorage) journalEntries(journalID uuid.UUID) int {
+	count := 0
+	for _, entry := range s.entries {
+		if entry.JournalID == journalID {
+			count++
+		}
+	}
+	return count
+}
+
+func TestTransferEntries(t *testing.T) {
+	sourceID := uuid.New()
+	userID := uuid.New()
+
+	tests := []struct {
+		name       string
+		copy       bool
+		notesRule  bool
+		target     func(target uuid.UUID) uuid.UUID
+		ids        func(win, loss uuid.UUID) []uuid.UUID
+		wantErr    error
+		wantSource int
+		wantTarget int
+	}{
+		{
+			name:       "move",
+			ids:        func(win, loss uuid.UUID) []uuid.UUID { return []uuid.UUID{win, loss} },
+			wantSource: 0,
+			wantTarget: 2,
+		},
+		{
+			name:       "copy",
+			copy:       true,
+			ids:        func(win, loss uuid.UUID) []uuid.UUID { return []uuid.UUID{win} },
+			wantSource: 2,
+			wantTarget: 1,
+		},
+		{
+			name:       "duplicate ids move once",
+			ids:        func(win, loss uuid.UUID) []uuid.UUID { return []uuid.UUID{win, win} },
+			wantSource: 1,
+			wantTarget: 1,
+		},
+		{
+			name:       "same journal",
+			target:     func(uuid.UUID) uuid.UUID { return sourceID },
+			ids:        func(win, loss uuid.UUID) []uuid.UUID { return []uuid.UUID{win} },
+			wantErr:    entity.ErrSameJournal,
+			wantSource: 2,
+		},
+		{
+			name:       "missing target",
+			target:     func(uuid.UUID) uuid.UUID { return uuid.New() },
+			ids:        func(win, loss uuid.UUID) []uuid.UUID { return []uuid.UUID{win} },
+			wantErr:    entity.ErrNotFound,
+			wantSource: 2,
+		},
+		{
+			name:       "entry of another journal",
+			ids:        func(win, loss uuid.UUID) []uuid.UUID { return []uuid.UUID{win, uuid.New()} },
+			wantErr:    entity.ErrNotFound,
+			wantSource: 2,
+		},
+		{
+			name:       "target policy rejects an entry",
+			notesRule:  true,
+			ids:        func(win, loss uuid.UUID) []uuid.UUID { return []uuid.UUID{win, loss} },
+			wantErr:    entity.ErrNotesRequiredOnLoss,
+			wantSource: 2,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			target := newTestJournal()
+			target.RequireNotesOnLoss = tt.notesRule
+			win := newTestEntry(sourceID, types.TradeResultTakeProfit, 100)
+			loss := newTestEntry(sourceID, types.TradeResultStopLoss, -50)
+			entryStorage := &transferEntryStorage{fakeEntryStorage: fakeEntryStorage{entries: []*entity.TradingJournalEntry{win, loss}}}
+			cache := &recordingCache{}
+			svc := NewTradingJournalEntryService(entryStorage, &fakeJournalStorage{journal: target}, nil, zap.NewNop()).WithCache(cache)
+
+			targetID := target.ID
+			if tt.target != nil {
+				targetID = tt.target(target.ID)
+			}
+			entries, err := svc.TransferEntries(context.Background(), userID, sourceID, &dto.TransferEntriesRequest{
+				EntryIDs:        tt.ids(win.ID, loss.ID),
+				TargetJournalID: targetID,
+				Copy:            tt.copy,
+			})
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("TransferEntries() error = %v, want %v", err, tt.wantErr)
+			}
+
+			if got := entryStorage.journalEntries(sourceID); got != tt.wantSource {
+				t.Errorf("source has %d entries, want %d", got, tt.wantSource)
+			}
+			if got := entryStorage.journalEntries(target.ID); got != tt.wantTarget {
+				t.Errorf("target has %d entries, want %d", got, tt.wantTarget)
+			}
+			if tt.wantErr != nil {
+				return
+			}
+
+			if len(entries) != tt.wantTarget {
+				t.Errorf("returned %d entries, want %d", len(entries), tt.wantTarget)
+			}
+			if entryStorage.params.UserID != userID || entryStorage.params.Copy != tt.copy {
+				t.Errorf("storage params = %+v, want user %s and copy %v", entryStorage.params, userID, tt.copy)
+			}
+			for _, entry := range entries {
+				if entry.JournalID != target.ID {
+					t.Errorf("returned entry %s in journal %s, want %s", entry.ID, entry.JournalID, target.ID)
+				}
+				if tt.copy && (entry.ID == win.ID || entry.ID == loss.ID) {
+					t.Errorf("copy kept the source id %s", entry.ID)
+				}
+			}
+			wantKeys := []string{"journal:" + sourceID.String(), "journal:" + target.ID.String()}
+			if !slices.Equal(cache.deleted, wantKeys) {
+				t.Errorf("invalidated %v, want %v", cache.deleted, wantKeys)
+			}
+		})
+	}
+}
+
+func TestEntryWritesFollowValidationProfile(t *testing.T) {
+	tests := []struct {
+		profile types.ValidationProfile
+		wantErr error
+	}{
+		{types.ValidationProfileLenient, nil},
+		{types.ValidationProfileStandard, nil},
+		{types.ValidationProfileStrict, entity.ErrIdenticalTimeframeCharts},
+	}
+
+	for _, tt := range tests {
+		t.Run(string(tt.profile), func(t *testing.T) {
+			journal := newTestJournal()
+			journal.ValidationProfile = tt.profile
+			entryStorage := &updateEntryStorage{}
+			svc := NewTradingJournalEntryService(
+				entryStorage,
+				&fakeJournalStorage{journal: journal},
+				&fakeTemplateStorage{},
+				zap.NewNop(),
+			)
+
+			// The same chart for both timeframes is borderline: only the
+			// strict profile rejects it.
+			_, err := svc.Create(context.Background(), journal.ID, &dto.CreateTradingJournalEntryRequest{
+				Day:       time.Date(2026, 1, 5, 0, 0, 0, 0, time.UTC),
+				Asset:     types.CurrencyPairEURUSD,
+				LTF:       "https://charts.example.com/chart",
+				HTF:       "https://charts.example.com/chart",
+				Session:   types.TradingSessionLondon,
+				TradeType: types.TradeTypeIntraday,
+				Direction: types.TradeDirectionBuy,
+				EntryType: types.EntryTypeMarket,
+				Realized:  150,
+				MaxRR:     3,
+				Result:    types.TradeResultTakeProfit,
+			})
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("Create() error = %v, want %v", err, tt.wantErr)
+			}
+			wantStored := 1
+			if tt.wantErr != nil {
+				wantStored = 0
+			}
+			if len(entryStorage.entries) != wantStored {
+				t.Errorf("stored %d entries, want %d", len(entryStorage.entries), wantStored)
+			}
+		})
+	}
+}
+
+type checklistEntryStorage struct {
+	TradingJournalEntryStorage
+	rows []*entity.ChecklistItemStatistics
+}
+
+func (s *checklistEntryStorage) GetChecklistStatistics(context.Context, uuid.UUID) ([]*entity.ChecklistItemStatistics, error) {
+	return s.rows, nil
+}
+
+func TestGetChecklistAdherence(t *testing.T) {
+	tests := []struct {
+		name string
+		row  entity.ChecklistItemStatistics
+		want entity.ChecklistItemStatistics
+	}{
+		{
+			name: "mostly checked",
+			row:  entity.ChecklistItemStatistics{Item: "HTF bias", TotalTrades: 10, Checked: 8, CheckedWins: 6, UncheckedWins: 0},
+			want: entity.ChecklistItemStatistics{Item: "HTF bias", TotalTrades: 10, Checked: 8, CheckedWins: 6, AdherenceRate: 80, CheckedWinRate: 75},
+		},
+		{
+			name: "always checked",
+			row:  entity.ChecklistItemStatistics{Item: "Stop placed", TotalTrades: 4, Checked: 4, CheckedWins: 2},
+			want: entity.ChecklistItemStatistics{Item: "Stop placed", TotalTrades: 4, Checked: 4, CheckedWins: 2, AdherenceRate: 100, CheckedWinRate: 50},
+		},
+		{
+			name: "never checked",
+			row:  entity.ChecklistItemStatistics{Item: "News checked", TotalTrades: 5, UncheckedWins: 1},
+			want: entity.ChecklistItemStatistics{Item: "News checked", TotalTrades: 5, UncheckedWins: 1, UncheckedWinRate: 20},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			row := tt.row
+			svc := NewTradingJournalEntryService(&checklistEntryStorage{rows: []*entity.ChecklistItemStatistics{&row}}, &fakeJournalStorage{}, nil, zap.NewNop())
+
+			stats, err := svc.GetChecklistAdherence(context.Background(), uuid.New())
+			if err != nil {
+				t.Fatalf("GetChecklistAdherence() error = %v", err)
+			}
+			if len(stats) != 1 || *stats[0] != tt.want {
+				t.Errorf("stats = %+v, want %+v", stats[0], tt.want)
+			}
+		})
+	}
+}
+
+func TestCreateStoresChecklist(t *testing.T) {
+	journal := newTestJournal()
+	entryStorage := &updateEntryStorage{}
+	svc := NewTradingJournalEntryService(entryStorage, &fakeJournalStorage{journal: journal}, &fakeTemplateStorage{}, zap.NewNop())
+	checklist := []types.ChecklistItem{{Item: "HTF bias", Checked: true}, {Item: "News checked"}}
+
+	entry, err := svc.Create(context.Background(), journal.ID, &dto.CreateTradingJournalEntryRequest{
+		Day:       time.Date(2026, 1, 5, 0, 0, 0, 0, time.UTC),
+		Asset:     types.CurrencyPairEURUSD,
+		LTF:       "https://charts.example.com/ltf",
+		HTF:       "https://charts.example.com/htf",
+		Session:   types.TradingSessionLondon,
+		TradeType: types.TradeTypeIntraday,
+		Direction: types.TradeDirectionBuy,
+		EntryType: types.EntryTypeMarket,
+		Realized:  150,
+		MaxRR:     3,
+		Result:    types.TradeResultTakeProfit,
+		Checklist: checklist,
+	})
+	if err != nil {
+		t.Fatalf("Create() error = %v", err)
+	}
+	if !slices.Equal(entry.Checklist, checklist) {
+		t.Errorf("checklist = %+v, want %+v", entry.Checklist, checklist)
+	}
+}
+
+// linkEntryStorage links entries in memory, both ways, like the storage.
+type linkEntryStorage struct {
+	*fakeEntryStorage
+}
+
+func (s *linkEntryStorage) LinkEntries(_ context.Context, journalID, entryID, relatedID uuid.UUID) error {
+	entry, related := s.find(entryID), s.find(relatedID)
+	if entry == nil || related == nil || entry.JournalID != journalID || related.JournalID != journalID {
+		return errors.Wrap(entity.ErrNotFound, "trading journal entry")
+	}
+	for _, pair := range [][2]*entity.TradingJournalEntry{{entry, related}, {related, entry}} {
+		if slices.Contains(pair[0].RelatedEntryIDs, pair[1].ID) {
+			continue
+		}
+		if len(pair[0].RelatedEntryIDs) >= entity.MaxRelatedEntries {
+			return entity.ErrTooManyLinks
+		}
+		pair[0].RelatedEntryIDs = append(pair[0].RelatedEntryIDs, pair[1].ID)
+	}
+	return nil
+}
+
+func (s *linkEntryStorage) UnlinkEntries(_ context.Context, _, entryID, relatedID uuid.UUID) error {
+	if entry := s.find(entryID); entry != nil {
+		entry.RelatedEntryIDs = slices.DeleteFunc(entry.RelatedEntryIDs, func(id uuid.UUID) bool { return id == relatedID })
+	}
+	if related := s.find(relatedID); related != nil {
+		related.RelatedEntryIDs = slices.DeleteFunc(related.RelatedEntryIDs, func(id uuid.UUID) bool { return id == entryID })
+	}
+	return nil
+}
+
+func (s *linkEntryStorage) GetByIDs(_ context.Context, journalID uuid.UUID, ids []uuid.UUID) ([]*entity.TradingJournalEntry, error) {
+	entries := []*entity.TradingJournalEntry{}
+	for _, id := range ids {
+		if entry := s.find(id); entry != nil && entry.JournalID == journalID {
+			entries = append(entries, entry)
+		}
+	}
+	return entries, nil
+}
+
+func TestLinkEntries(t *testing.T) {
+	journal := newTestJournal()
+	first := newTestEntry(journal.ID, types.TradeResultTakeProfit, 100)
+	hedge := newTestEntry(journal.ID, types.TradeResultStopLoss, -50)
+	add := newTestEntry(journal.ID, types.TradeResultTakeProfit, 80)
+	foreign := newTestEntry(uuid.New(), types.TradeResultTakeProfit, 10)
+	storage := &linkEntryStorage{&fakeEntryStorage{entries: []*entity.TradingJournalEntry{first, hedge, add, foreign}}}
+	journals := &fakeJournalStorage{journal: journal}
+	svc := NewTradingJournalEntryService(storage, journals, nil, zap.NewNop())
+	ctx := context.Background()
+
+	linked, err := svc.LinkEntry(ctx, first.ID, journal.ID, hedge.ID)
+	if err != nil {
+		t.Fatalf("LinkEntry() error = %v", err)
+	}
+	if !slices.Equal(linked.RelatedEntryIDs, []uuid.UUID{hedge.ID}) {
+		t.Errorf("linked entry related = %v, want [%s]", linked.RelatedEntryIDs, hedge.ID)
+	}
+	if _, err := svc.LinkEntry(ctx, first.ID, journal.ID, add.ID); err != nil {
+		t.Fatalf("LinkEntry() error = %v", err)
+	}
+	if _, err := svc.LinkEntry(ctx, first.ID, journal.ID, hedge.ID); err != nil {
+		t.Fatalf("linking again: LinkEntry() error = %v", err)
+	}
+
+	group, err := svc.GetRelated(ctx, first.ID, journal.ID)
+	if err != nil {
+		t.Fatalf("GetRelated() error = %v", err)
+	}
+	if len(group) != 2 || group[0].ID != hedge.ID || group[1].ID != add.ID {
+		t.Errorf("group = %v, want the hedge and the add once each", group)
+	}
+
+	// Links go both ways.
+	back, err := svc.GetRelated(ctx, hedge.ID, journal.ID)
+	if err != nil {
+		t.Fatalf("GetRelated() error = %v", err)
+	}
+	if len(back) != 1 || back[0].ID != first.ID {
+		t.Errorf("hedge group = %v, want the first entry", back)
+	}
+
+	unlinked, err := svc.UnlinkEntry(ctx, hedge.ID, journal.ID, first.ID)
+	if err != nil {
+		t.Fatalf("UnlinkEntry() error = %v", err)
+	}
+	if len(unlinked.RelatedEntryIDs) != 0 || !slices.Equal(first.RelatedEntryIDs, []uuid.UUID{add.ID}) {
+		t.Errorf("after unlink: hedge related %v, first related %v, want none and [%s]", unlinked.RelatedEntryIDs, first.RelatedEntryIDs, add.ID)
+	}
+}
+
+func TestLinkEntryRejections(t *testing.T) {
+	journal := newTestJournal()
+	entry := newTestEntry(journal.ID, types.TradeResultTakeProfit, 100)
+	other := newTestEntry(journal.ID, types.TradeResultTakeProfit, 100)
+	foreign := newTestEntry(uuid.New(), types.TradeResultTakeProfit, 10)
+	full := newTestEntry(journal.ID, types.TradeResultTakeProfit, 100)
+	for range entity.MaxRelatedEntries {
+		full.RelatedEntryIDs = append(full.RelatedEntryIDs, uuid.New())
+	}
+
+	tests := []struct {
+		name      string
+		id        uuid.UUID
+		relatedID uuid.UUID
+		locked    bool
+		wantErr   error
+	}{
+		{"itself", entry.ID, entry.ID, false, entity.ErrSelfLink},
+		{"entry of another journal", entry.ID, foreign.ID, false, entity.ErrNotFound},
+		{"unknown entry", uuid.New(), entry.ID, false, entity.ErrNotFound},
+		{"too many links", full.ID, entry.ID, false, entity.ErrTooManyLinks},
+		{"locked journal", entry.ID, other.ID, true, entity.ErrJournalLocked},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			storage := &linkEntryStorage{&fakeEntryStorage{entries: []*entity.TradingJournalEntry{entry, other, foreign, full}}}
+			svc := NewTradingJournalEntryService(storage, &fakeJournalStorage{journal: journal, locked: tt.locked}, nil, zap.NewNop())
+
+			_, err := svc.LinkEntry(context.Background(), tt.id, journal.ID, tt.relatedID)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("LinkEntry() error = %v, want %v", err, tt.wantErr)
+			}
+			if len(entry.RelatedEntryIDs) != 0 {
+				t.Errorf("entry linked to %v despite the error", entry.RelatedEntryIDs)
+			}
+		})
+	}
+}
+
+func TestPipStatistics(t *testing.T) {
+	a := entity.Period{Start: time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC), End: time.Date(2026, 1, 31, 0, 0, 0, 0, time.UTC)}
+	b := entity.Period{Start: time.Date(2026, 2, 1, 0, 0, 0, 0, time.UTC), End: time.Date(2026, 2, 28, 0, 0, 0, 0, time.UTC)}
+	statistics := entity.EntryStatistics{TotalTrades: 4, Wins: 2, Losses: 2, TotalRealized: 200, AvgWin: 150, AvgLoss: 50}
+
+	tests := []struct {
+		name      string
+		pipValue  *float64
+		wantTotal *float64
+		wantWin   *float64
+		wantLoss  *float64
+	}{
+		{"pip value of ten", ptr(10.0), ptr(20.0), ptr(15.0), ptr(5.0)},
+		{"fractional pip value", ptr(0.5), ptr(400.0), ptr(300.0), ptr(100.0)},
+		{"no pip value", nil, nil, nil, nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			journal := newTestJournal()
+			journal.PipValue = tt.pipValue
+			journalStorage := &fakeJournalStorage{journal: journal}
+
+			stats, err := NewTradingJournalEntryService(&statisticsEntryStorage{statistics: statistics}, journalStorage, nil, zap.NewNop()).
+				GetStatistics(context.Background(), journal.ID)
+			if err != nil {
+				t.Fatalf("GetStatistics() error = %v", err)
+			}
+
+			entryStorage := &fakeEntryStorage{statistics: map[time.Time]*entity.EntryStatistics{a.Start: &statistics, b.Start: &statistics}}
+			comparison, err := NewTradingJournalEntryService(entryStorage, journalStorage, nil, zap.NewNop()).
+				ComparePeriods(context.Background(), journal.ID, a, b)
+			if err != nil {
+				t.Fatalf("ComparePeriods() error = %v", err)
+			}
+
+			for name, got := range map[string]*entity.EntryStatistics{
+				"statistics": stats,
+				"period a":   comparison.StatisticsA,
+				"period b":   comparison.StatisticsB,
+			} {
+				if !equalPips(got.TotalRealizedPips, tt.wantTotal) || !equalPips(got.AvgWinPips, tt.wantWin) || !equalPips(got.AvgLossPips, tt.wantLoss) {
+					t.Errorf("%s pips = %v, %v, %v, want %v, %v, %v", name,
+						got.TotalRealizedPips, got.AvgWinPips, got.AvgLossPips, tt.wantTotal, tt.wantWin, tt.wantLoss)
+				}
+			}
+		})
+	}
+
+	t.Run("missing journal", func(t *testing.T) {
+		svc := NewTradingJournalEntryService(&statisticsEntryStorage{statistics: statistics}, &fakeJournalStorage{}, nil, zap.NewNop())
+		if _, err := svc.GetStatistics(context.Background(), uuid.New()); !errors.Is(err, entity.ErrNotFound) {
+			t.Errorf("GetStatistics() error = %v, want %v", err, entity.ErrNotFound)
+		}
+	})
+}
+
+func equalPips(got, want *float64) bool {
+	return (got == nil && want == nil) || (got != nil && want != nil && *got == *want)
+}
+
+// bulkParamsEntryStorage records the bulk update it is asked for and fails
+// with err.
+type bulkParamsEntryStorage struct {
+	TradingJournalEntryStorage
+	params bunstorage.BulkUpdateParams
+	err    error
+}
+
+func (s *bulkParamsEntryStorage) BulkUpdate(_ context.Context, params bunstorage.BulkUpdateParams) ([]*entity.TradingJournalEntry, error) {
+	s.params = params
+	return nil, s.err
+}
+
+func TestBulkUpdateScope(t *testing.T) {
+	journal := newTestJournal()
+	asset := types.CurrencyPairEURUSD
+	session := types.TradingSessionLondon
+	result := types.TradeResultBreakEven
+	start := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	end := time.Date(2026, 1, 31, 0, 0, 0, 0, time.UTC)
+	req := &dto.BulkUpdateEntriesRequest{
+		Filter: dto.FilterEntriesRequest{Asset: &asset, Session: &session, Result: &result, StartDate: &start, EndDate: &end, Limit: 5, Offset: 10},
+		Set:    dto.BulkEntryUpdate{Realized: ptr(0.0)},
+		DryRun: true,
+	}
+
+	storage := &bulkParamsEntryStorage{}
+	svc := NewTradingJournalEntryService(storage, &fakeJournalStorage{journal: journal}, nil, zap.NewNop())
+	if _, err := svc.BulkUpdate(context.Background(), journal.ID, req); err != nil {
+		t.Fatalf("BulkUpdate() error = %v", err)
+	}
+
+	filter := storage.params.Filter
+	if filter.JournalID != journal.ID || filter.Asset != &asset || filter.Session != &session || filter.Result != &result ||
+		filter.StartDate != &start || filter.EndDate != &end {
+		t.Errorf("filter = %+v, want the request's journal, asset, session, result and dates", filter)
+	}
+	if storage.params.Max != entity.MaxBulkUpdateEntries {
+		t.Errorf("max = %d, want %d", storage.params.Max, entity.MaxBulkUpdateEntries)
+	}
+	if !storage.params.DryRun {
+		t.Error("dry run not passed to the storage")
+	}
+
+	tests := []struct {
+		name         string
+		err          error
+		wantTooLarge bool
+	}{
+		{"too many entries", errors.Wrap(entity.ErrBulkUpdateTooLarge, "failed to bulk update trading journal entries"), true},
+		{"storage failure", errors.New("connection refused"), false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			storage := &bulkParamsEntryStorage{err: tt.err}
+			svc := NewTradingJournalEntryService(storage, &fakeJournalStorage{journal: journal}, nil, zap.NewNop())
+
+			_, err := svc.BulkUpdate(context.Background(), journal.ID, req)
+			if err == nil {
+				t.Fatal("BulkUpdate() error = nil")
+			}
+			// The size limit is reported bare so its message reaches the client.
+			if tooLarge := err == entity.ErrBulkUpdateTooLarge; tooLarge != tt.wantTooLarge {
+				t.Errorf("BulkUpdate() error = %v, want the bare size limit error %v", err, tt.wantTooLarge)
+			}
+		})
+	}
+}
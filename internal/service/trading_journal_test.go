@@ -0,0 +1,1148 @@
+package service
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"slices"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/cockroachdb/errors"
+	"github.com/google/uuid"
+	"github.com/user/normark/internal/dto"
+	"github.com/user/normark/internal/entity"
+	"github.com/user/normark/internal/types"
+	"go.uber.org/zap"
+)
+
+type summaryJournalStorage struct {
+	TradingJournalStorage
+	ownerID    uuid.UUID
+	journalID  uuid.UUID
+	summary    *entity.JournalSummary
+	recomputed int
+}
+
+func (s *summaryJournalStorage) Exists(_ context.Context, id uuid.UUID, userID uuid.UUID) (bool, error) {
+	return id == s.journalID && userID == s.ownerID, nil
+}
+
+func (s *summaryJournalStorage) RecomputeSummary(context.Context, uuid.UUID) (*entity.JournalSummary, error) {
+	s.recomputed++
+	summary := *s.summary
+	return &summary, nil
+}
+
+func TestRecomputeSummary(t *testing.T) {
+	ownerID := uuid.New()
+	journalID := uuid.New()
+
+	tests := []struct {
+		name        string
+		userID      uuid.UUID
+		summary     entity.JournalSummary
+		wantErr     error
+		wantWinRate float64
+	}{
+		{"owner", ownerID, entity.JournalSummary{TotalTrades: 8, Wins: 6, NetRealized: 420}, nil, 75},
+		{"empty journal", ownerID, entity.JournalSummary{}, nil, 0},
+		{"not the owner", uuid.New(), entity.JournalSummary{}, entity.ErrNotFound, 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			storage := &summaryJournalStorage{ownerID: ownerID, journalID: journalID, summary: &tt.summary}
+			svc := NewTradingJournalService(storage, nil, zap.NewNop())
+
+			summary, err := svc.RecomputeSummary(context.Background(), journalID, tt.userID)
+			if tt.wantErr != nil {
+				if !errors.Is(err, tt.wantErr) {
+					t.Fatalf("RecomputeSummary() error = %v, want %v", err, tt.wantErr)
+				}
+				if storage.recomputed != 0 {
+					t.Errorf("summary recomputed for a user who does not own the journal")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("RecomputeSummary() error = %v", err)
+			}
+
+			if summary.WinRate != tt.wantWinRate {
+				t.Errorf("win rate = %v, want %v", summary.WinRate, tt.wantWinRate)
+			}
+			if summary.TotalTrades != tt.summary.TotalTrades || summary.NetRealized != tt.summary.NetRealized {
+				t.Errorf("summary = %+v, want the recomputed totals %+v", summary, tt.summary)
+			}
+		})
+	}
+}
+
+// namedJournalStorage keeps journals by owner and name.
+type namedJournalStorage struct {
+	TradingJournalStorage
+	journals map[string]*entity.TradingJournal
+	created  int
+}
+
+// key ignores the case of name, like the storage name lookups.
+func (s *namedJournalStorage) key(userID uuid.UUID, name string) string {
+	return userID.String() + "/" + strings.ToLower(name)
+}
+
+func (s *namedJournalStorage) CreateUnlessNameExists(ctx context.Context, journal *entity.TradingJournal) (*entity.TradingJournal, bool, error) {
+	if existing, ok := s.journals[s.key(journal.UserID, journal.Name)]; ok {
+		return existing, false, nil
+	}
+	return journal, true, s.Create(ctx, journal)
+}
+
+func (s *namedJournalStorage) GetByName(_ context.Context, userID uuid.UUID, name string) (*entity.TradingJournal, error) {
+	journal, ok := s.journals[s.key(userID, name)]
+	if !ok {
+		return nil, entity.ErrNotFound
+	}
+	return journal, nil
+}
+
+func (s *namedJournalStorage) Create(_ context.Context, journal *entity.TradingJournal) error {
+	s.created++
+	journal.ID = uuid.New()
+	s.journals[s.key(journal.UserID, journal.Name)] = journal
+	return nil
+}
+
+// NameTaken matches names ignoring case, like the storage query.
+func (s *namedJournalStorage) NameTaken(_ context.Context, userID uuid.UUID, name string, excludeID uuid.UUID) (bool, error) {
+	for _, journal := range s.journals {
+		if journal.UserID == userID && journal.ID != excludeID && strings.EqualFold(journal.Name, name) {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+func (s *namedJournalStorage) IsLocked(context.Context, uuid.UUID) (bool, error) {
+	return false, nil
+}
+
+func (s *namedJournalStorage) Update(context.Context, *entity.TradingJournal) error {
+	return nil
+}
+
+func TestUniqueJournalNames(t *testing.T) {
+	userID := uuid.New()
+
+	tests := []struct {
+		name        string
+		unique      bool
+		userID      uuid.UUID
+		journalName string
+		rename      bool
+		wantErr     error
+	}{
+		{name: "duplicates allowed by default", userID: userID, journalName: "swing"},
+		{name: "rename allowed by default", userID: userID, journalName: "SWING", rename: true},
+		{name: "new name", unique: true, userID: userID, journalName: "Scalping"},
+		{name: "same name in another case", unique: true, userID: userID, journalName: "swing", wantErr: entity.ErrJournalNameTaken},
+		{name: "same name for another user", unique: true, userID: uuid.New(), journalName: "Swing"},
+		{name: "rename to a taken name", unique: true, userID: userID, journalName: "SWING", rename: true, wantErr: entity.ErrJournalNameTaken},
+		{name: "rename to a free name", unique: true, userID: userID, journalName: "Position", rename: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			existing := entity.NewTradingJournal(userID, "Swing", "")
+			existing.ID = uuid.New()
+			other := entity.NewTradingJournal(userID, "Intraday", "")
+			other.ID = uuid.New()
+
+			storage := &namedJournalStorage{journals: map[string]*entity.TradingJournal{}}
+			storage.journals[storage.key(userID, existing.Name)] = existing
+			storage.journals[storage.key(userID, other.Name)] = other
+			svc := NewTradingJournalService(storage, nil, zap.NewNop())
+			if tt.unique {
+				svc = svc.WithUniqueNames()
+			}
+
+			var err error
+			if tt.rename {
+				renamed := *other
+				renamed.Name = tt.journalName
+				err = svc.Update(context.Background(), &renamed)
+			} else {
+				_, err = svc.Create(context.Background(), tt.userID, &dto.CreateTradingJournalRequest{Name: tt.journalName})
+			}
+
+			if tt.wantErr != nil {
+				if !errors.Is(err, tt.wantErr) || !errors.Is(err, entity.ErrConflict) {
+					t.Fatalf("error = %v, want %v", err, tt.wantErr)
+				}
+				if storage.created != 0 {
+					t.Errorf("journals stored = %d, want none", storage.created)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("error = %v", err)
+			}
+		})
+	}
+}
+
+func TestKeepingOwnNameIsNotTaken(t *testing.T) {
+	userID := uuid.New()
+	existing := entity.NewTradingJournal(userID, "Swing", "")
+	existing.ID = uuid.New()
+
+	storage := &namedJournalStorage{journals: map[string]*entity.TradingJournal{}}
+	storage.journals[storage.key(userID, existing.Name)] = existing
+	svc := NewTradingJournalService(storage, nil, zap.NewNop()).WithUniqueNames()
+
+	updated := *existing
+	updated.Name = "SWING"
+	updated.Description = "renamed in place"
+	if err := svc.Update(context.Background(), &updated); err != nil {
+		t.Errorf("Update() of the journal's own name error = %v", err)
+	}
+}
+
+func TestCreateDeduplicated(t *testing.T) {
+	userID := uuid.New()
+	existing := entity.NewTradingJournal(userID, "Swing", "")
+	existing.ID = uuid.New()
+
+	tests := []struct {
+		name        string
+		userID      uuid.UUID
+		journalName string
+		uniqueNames bool
+		wantCreated bool
+	}{
+		{"existing name", userID, "Swing", false, false},
+		{"existing name in another case", userID, "SWING", false, false},
+		{"another case with unique names", userID, "swing", true, false},
+		{"new name", userID, "Scalping", false, true},
+		{"new name with unique names", userID, "Scalping", true, true},
+		{"same name for another user", uuid.New(), "Swing", false, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			storage := &namedJournalStorage{journals: map[string]*entity.TradingJournal{}}
+			storage.journals[storage.key(userID, existing.Name)] = existing
+			svc := NewTradingJournalService(storage, nil, zap.NewNop())
+			if tt.uniqueNames {
+				svc.WithUniqueNames()
+			}
+
+			journal, created, err := svc.CreateDeduplicated(context.Background(), tt.userID, &dto.CreateTradingJournalRequest{Name: tt.journalName})
+			if err != nil {
+				t.Fatalf("CreateDeduplicated() error = %v", err)
+			}
+
+			if created != tt.wantCreated {
+				t.Errorf("created = %v, want %v", created, tt.wantCreated)
+			}
+			if !tt.wantCreated && journal.ID != existing.ID {
+				t.Errorf("journal = %s, want the existing %s", journal.ID, existing.ID)
+			}
+			if tt.wantCreated && (journal.Name != tt.journalName || journal.UserID != tt.userID) {
+				t.Errorf("journal = %q owned by %s, want %q owned by %s", journal.Name, journal.UserID, tt.journalName, tt.userID)
+			}
+
+			wantStored := 0
+			if tt.wantCreated {
+				wantStored = 1
+			}
+			if storage.created != wantStored {
+				t.Errorf("journals stored = %d, want %d", storage.created, wantStored)
+			}
+		})
+	}
+}
+
+// archiveJournalStorage holds a single journal owned by ownerID.
+type archiveJournalStorage struct {
+	TradingJournalStorage
+	ownerID uuid.UUID
+	journal *entity.TradingJournal
+	writes  int
+}
+
+func (s *archiveJournalStorage) Exists(_ context.Context, id uuid.UUID, userID uuid.UUID) (bool, error) {
+	return id == s.journal.ID && userID == s.ownerID, nil
+}
+
+func (s *archiveJournalStorage) GetByID(context.Context, uuid.UUID) (*entity.TradingJournal, error) {
+	journal := *s.journal
+	return &journal, nil
+}
+
+func (s *archiveJournalStorage) SetArchived(_ context.Context, _ uuid.UUID, archived bool) error {
+	s.writes++
+	s.journal.IsArchived = archived
+	return nil
+}
+
+func TestSetArchived(t *testing.T) {
+	ownerID := uuid.New()
+
+	tests := []struct {
+		name         string
+		userID       uuid.UUID
+		wasArchived  bool
+		archived     bool
+		wantErr      error
+		wantArchived bool
+	}{
+		{"archive", ownerID, false, true, nil, true},
+		{"unarchive", ownerID, true, false, nil, false},
+		{"archive again", ownerID, true, true, nil, true},
+		{"not the owner", uuid.New(), false, true, entity.ErrNotFound, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			journal := entity.NewTradingJournal(ownerID, "Swing", "")
+			journal.ID = uuid.New()
+			journal.IsArchived = tt.wasArchived
+			storage := &archiveJournalStorage{ownerID: ownerID, journal: journal}
+			svc := NewTradingJournalService(storage, nil, zap.NewNop())
+
+			got, err := svc.SetArchived(context.Background(), journal.ID, tt.userID, tt.archived)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("SetArchived() error = %v, want %v", err, tt.wantErr)
+			}
+			if err != nil {
+				if storage.writes != 0 {
+					t.Errorf("archived flag written for a user who does not own the journal")
+				}
+				return
+			}
+
+			if got.IsArchived != tt.wantArchived || storage.journal.IsArchived != tt.wantArchived {
+				t.Errorf("archived: returned %v, stored %v, want %v", got.IsArchived, storage.journal.IsArchived, tt.wantArchived)
+			}
+		})
+	}
+}
+
+// lockJournalStorage holds a single journal owned by ownerID and counts
+// the writes that reach it.
+type lockJournalStorage struct {
+	TradingJournalStorage
+	ownerID uuid.UUID
+	journal *entity.TradingJournal
+	writes  int
+}
+
+func (s *lockJournalStorage) Exists(_ context.Context, id uuid.UUID, userID uuid.UUID) (bool, error) {
+	return id == s.journal.ID && userID == s.ownerID, nil
+}
+
+func (s *lockJournalStorage) GetByID(context.Context, uuid.UUID) (*entity.TradingJournal, error) {
+	journal := *s.journal
+	return &journal, nil
+}
+
+func (s *lockJournalStorage) IsLocked(context.Context, uuid.UUID) (bool, error) {
+	return s.journal.IsLocked, nil
+}
+
+func (s *lockJournalStorage) SetLocked(_ context.Context, _ uuid.UUID, locked bool) error {
+	s.writes++
+	s.journal.IsLocked = locked
+	return nil
+}
+
+func (s *lockJournalStorage) Update(context.Context, *entity.TradingJournal) error {
+	s.writes++
+	return nil
+}
+
+func (s *lockJournalStorage) Delete(context.Context, uuid.UUID) error {
+	s.writes++
+	return nil
+}
+
+func TestSetLocked(t *testing.T) {
+	ownerID := uuid.New()
+
+	tests := []struct {
+		name       string
+		userID     uuid.UUID
+		wasLocked  bool
+		locked     bool
+		wantErr    error
+		wantLocked bool
+	}{
+		{"lock", ownerID, false, true, nil, true},
+		{"unlock", ownerID, true, false, nil, false},
+		{"lock again", ownerID, true, true, nil, true},
+		{"not the owner", uuid.New(), false, true, entity.ErrNotFound, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			journal := entity.NewTradingJournal(ownerID, "Challenge", "")
+			journal.ID = uuid.New()
+			journal.IsLocked = tt.wasLocked
+			storage := &lockJournalStorage{ownerID: ownerID, journal: journal}
+			svc := NewTradingJournalService(storage, nil, zap.NewNop())
+
+			got, err := svc.SetLocked(context.Background(), journal.ID, tt.userID, tt.locked)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("SetLocked() error = %v, want %v", err, tt.wantErr)
+			}
+			if err != nil {
+				if storage.writes != 0 {
+					t.Errorf("locked flag written for a user who does not own the journal")
+				}
+				return
+			}
+
+			if got.IsLocked != tt.wantLocked || storage.journal.IsLocked != tt.wantLocked {
+				t.Errorf("locked: returned %v, stored %v, want %v", got.IsLocked, storage.journal.IsLocked, tt.wantLocked)
+			}
+		})
+	}
+}
+
+func TestLockedJournalRejectsWritesButServesReads(t *testing.T) {
+	ownerID := uuid.New()
+	ctx := context.Background()
+
+	tests := []struct {
+		name    string
+		locked  bool
+		wantErr error
+	}{
+		{"unlocked", false, nil},
+		{"locked", true, entity.ErrJournalLocked},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			journal := entity.NewTradingJournal(ownerID, "Challenge", "")
+			journal.ID = uuid.New()
+			journal.IsLocked = tt.locked
+			storage := &lockJournalStorage{ownerID: ownerID, journal: journal}
+			svc := NewTradingJournalService(storage, nil, zap.NewNop())
+
+			update := *journal
+			update.Name = "Renamed"
+			if err := svc.Update(ctx, &update); !errors.Is(err, tt.wantErr) {
+				t.Errorf("Update() error = %v, want %v", err, tt.wantErr)
+			}
+			if err := svc.Delete(ctx, journal.ID, ownerID); !errors.Is(err, tt.wantErr) {
+				t.Errorf("Delete() error = %v, want %v", err, tt.wantErr)
+			}
+			if tt.locked && storage.writes != 0 {
+				t.Errorf("%d writes reached storage for a locked journal", storage.writes)
+			}
+
+			got, err := svc.GetByID(ctx, journal.ID)
+			if err != nil {
+				t.Fatalf("GetByID() error = %v", err)
+			}
+			if got.IsLocked != tt.locked {
+				t.Errorf("GetByID().IsLocked = %v, want %v", got.IsLocked, tt.locked)
+			}
+		})
+	}
+}
+
+// entryImportStorage holds a journal owned by ownerID and records the
+// entries added to it.
+type entryImportStorage struct {
+	TradingJournalStorage
+	ownerID   uuid.UUID
+	journalID uuid.UUID
+	profile   types.ValidationProfile
+	locked    bool
+	added     []*entity.TradingJournalEntry
+}
+
+func (s *entryImportStorage) GetByID(_ context.Context, id uuid.UUID) (*entity.TradingJournal, error) {
+	if id != s.journalID {
+		return nil, entity.ErrNotFound
+	}
+	journal := entity.NewTradingJournal(s.ownerID, "cTrader", "")
+	journal.ID = s.journalID
+	journal.ValidationProfile = s.profile
+	return journal, nil
+}
+
+func (s *entryImportStorage) IsLocked(context.Context, uuid.UUID) (bool, error) {
+	return s.locked, nil
+}
+
+func (s *entryImportStorage) AddEntries(_ context.Context, _ uuid.UUID, entries []*entity.TradingJournalEntry) error {
+	s.added = append(s.added, entries...)
+	return nil
+}
+
+func newImportRequest(asset types.CurrencyPair, realized float64) *dto.CreateTradingJournalEntryRequest {
+	result := types.TradeResultTakeProfit
+	if realized < 0 {
+		result = types.TradeResultStopLoss
+	}
+	return &dto.CreateTradingJournalEntryRequest{
+		Day:       time.Date(2026, 2, 3, 8, 15, 0, 0, time.UTC),
+		Asset:     asset,
+		LTF:       "https://charts.example.com/ltf",
+		HTF:       "https://charts.example.com/htf",
+		Session:   types.TradingSessionLondon,
+		TradeType: types.TradeTypeIntraday,
+		Direction: types.TradeDirectionBuy,
+		EntryType: types.EntryTypeMarket,
+		Realized:  realized,
+		MaxRR:     3,
+		Result:    result,
+		Notes:     "Imported from cTrader",
+	}
+}
+
+func TestImportEntries(t *testing.T) {
+	ownerID := uuid.New()
+	journalID := uuid.New()
+	unmappable := errors.New("unsupported symbol \"BTCUSD\"")
+
+	tests := []struct {
+		name        string
+		userID      uuid.UUID
+		locked      bool
+		reqs        []*dto.CreateTradingJournalEntryRequest
+		rowErrs     map[int]error
+		wantErr     error
+		wantAdded   []float64
+		wantErrRows []int
+	}{
+		{
+			name:      "all rows mapped",
+			userID:    ownerID,
+			reqs:      []*dto.CreateTradingJournalEntryRequest{newImportRequest(types.CurrencyPairEURUSD, 120), newImportRequest(types.CurrencyPairGBPUSD, -40)},
+			wantAdded: []float64{120, -40},
+		},
+		{
+			name:        "parse errors and invalid rows are reported",
+			userID:      ownerID,
+			reqs:        []*dto.CreateTradingJournalEntryRequest{nil, newImportRequest(types.CurrencyPairEURUSD, 50), newImportRequest("XAUUSD", 10)},
+			rowErrs:     map[int]error{0: unmappable},
+			wantAdded:   []float64{50},
+			wantErrRows: []int{0, 2},
+		},
+		{
+			name:        "nothing to add",
+			userID:      ownerID,
+			reqs:        []*dto.CreateTradingJournalEntryRequest{nil},
+			rowErrs:     map[int]error{0: unmappable},
+			wantErrRows: []int{0},
+		},
+		{
+			name:    "locked journal",
+			userID:  ownerID,
+			locked:  true,
+			reqs:    []*dto.CreateTradingJournalEntryRequest{newImportRequest(types.CurrencyPairEURUSD, 120)},
+			wantErr: entity.ErrJournalLocked,
+		},
+		{
+			name:    "not the owner",
+			userID:  uuid.New(),
+			reqs:    []*dto.CreateTradingJournalEntryRequest{newImportRequest(types.CurrencyPairEURUSD, 120)},
+			wantErr: entity.ErrNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			storage := &entryImportStorage{ownerID: ownerID, journalID: journalID, locked: tt.locked}
+			svc := NewTradingJournalService(storage, nil, zap.NewNop())
+
+			result, err := svc.ImportEntries(context.Background(), journalID, tt.userID, tt.reqs, tt.rowErrs)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("ImportEntries() error = %v, want %v", err, tt.wantErr)
+			}
+			if err != nil {
+				if len(storage.added) != 0 {
+					t.Errorf("%d entries added despite the error", len(storage.added))
+				}
+				return
+			}
+
+			if len(storage.added) != len(tt.wantAdded) || len(result.Entries) != len(tt.wantAdded) {
+				t.Fatalf("added %d entries (%d returned), want %d", len(storage.added), len(result.Entries), len(tt.wantAdded))
+			}
+			for i, want := range tt.wantAdded {
+				entry := storage.added[i]
+				if entry.Realized != want || entry.JournalID != journalID || entry.Grade == nil {
+					t.Errorf("entry %d: realized %v in %s, graded %v; want %v in %s and graded", i, entry.Realized, entry.JournalID, entry.Grade != nil, want, journalID)
+				}
+			}
+
+			if len(result.Errors) != len(tt.wantErrRows) {
+				t.Fatalf("row errors = %v, want rows %v", result.Errors, tt.wantErrRows)
+			}
+			for i, row := range tt.wantErrRows {
+				if result.Errors[i].Index != row || result.Errors[i].Err == nil {
+					t.Errorf("row error %d = %+v, want row %d with an error", i, result.Errors[i], row)
+				}
+			}
+			if len(tt.rowErrs) > 0 && !errors.Is(result.Errors[0].Err, unmappable) {
+				t.Errorf("row 0 error = %v, want the parse error passed in", result.Errors[0].Err)
+			}
+		})
+	}
+}
+
+type fakeJournalTemplateStorage struct {
+	JournalTemplateStorage
+	template *entity.JournalTemplate
+}
+
+func (s *fakeJournalTemplateStorage) GetByID(_ context.Context, id uuid.UUID) (*entity.JournalTemplate, error) {
+	if s.template == nil || id != s.template.ID {
+		return nil, entity.ErrNotFound
+	}
+	return s.template, nil
+}
+
+func TestCreateFromJournalTemplate(t *testing.T) {
+	ownerID := uuid.New()
+	template := entity.NewJournalTemplate(ownerID, "Swing", "Higher timeframe setups")
+	template.ID = uuid.New()
+	template.DefaultAsset = ptr(types.CurrencyPairEURUSD)
+	template.DefaultSession = ptr(types.TradingSessionLondon)
+	template.Tags = []string{"majors"}
+
+	tests := []struct {
+		name        string
+		userID      uuid.UUID
+		req         dto.CreateTradingJournalRequest
+		wantErr     error
+		wantAsset   types.CurrencyPair
+		wantSession types.TradingSession
+		wantTags    []string
+	}{
+		{
+			name:        "inherits defaults",
+			userID:      ownerID,
+			req:         dto.CreateTradingJournalRequest{Name: "2026"},
+			wantAsset:   types.CurrencyPairEURUSD,
+			wantSession: types.TradingSessionLondon,
+			wantTags:    []string{"majors"},
+		},
+		{
+			name:        "request overrides defaults",
+			userID:      ownerID,
+			req:         dto.CreateTradingJournalRequest{Name: "2026", DefaultAsset: ptr(types.CurrencyPairGBPUSD), Tags: []string{"cable"}},
+			wantAsset:   types.CurrencyPairGBPUSD,
+			wantSession: types.TradingSessionLondon,
+			wantTags:    []string{"cable"},
+		},
+		{
+			name:    "template of another user",
+			userID:  uuid.New(),
+			req:     dto.CreateTradingJournalRequest{Name: "2026"},
+			wantErr: entity.ErrNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			storage := &namedJournalStorage{journals: map[string]*entity.TradingJournal{}}
+			svc := NewTradingJournalService(storage, &fakeJournalTemplateStorage{template: template}, zap.NewNop())
+
+			req := tt.req
+			req.TemplateID = &template.ID
+			journal, err := svc.Create(context.Background(), tt.userID, &req)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("Create() error = %v, want %v", err, tt.wantErr)
+			}
+			if err != nil {
+				if storage.created != 0 {
+					t.Errorf("journal created from another user's template")
+				}
+				return
+			}
+
+			if journal.Description != template.Description {
+				t.Errorf("description = %q, want the template's %q", journal.Description, template.Description)
+			}
+			if *journal.DefaultAsset != tt.wantAsset || *journal.DefaultSession != tt.wantSession {
+				t.Errorf("defaults = %s/%s, want %s/%s", *journal.DefaultAsset, *journal.DefaultSession, tt.wantAsset, tt.wantSession)
+			}
+			if !slices.Equal(journal.Tags, tt.wantTags) {
+				t.Errorf("tags = %v, want %v", journal.Tags, tt.wantTags)
+			}
+		})
+	}
+}
+
+// backupJournalStorage serves one journal for export and records what an
+// import creates.
+type backupJournalStorage struct {
+	TradingJournalStorage
+	ownerID         uuid.UUID
+	journal         *entity.TradingJournal
+	imported        *entity.TradingJournal
+	importedEntries []*entity.TradingJournalEntry
+}
+
+func (s *backupJournalStorage) Exists(_ context.Context, id uuid.UUID, userID uuid.UUID) (bool, error) {
+	return id == s.journal.ID && userID == s.ownerID, nil
+}
+
+func (s *backupJournalStorage) GetByID(context.Context, uuid.UUID) (*entity.TradingJournal, error) {
+	return s.journal, nil
+}
+
+func (s *backupJournalStorage) GetEntries(_ context.Context, _ uuid.UUID, limit, offset int) ([]*entity.TradingJournalEntry, error) {
+	entries := s.journal.Entries
+	if offset >= len(entries) {
+		return nil, nil
+	}
+	return entries[offset:min(offset+limit, len(entries))], nil
+}
+
+func (s *backupJournalStorage) CreateWithEntries(_ context.Context, journal *entity.TradingJournal, entries []*entity.TradingJournalEntry) error {
+	s.imported = journal
+	s.importedEntries = entries
+	return nil
+}
+
+func newExportedJournal(ownerID uuid.UUID) *entity.TradingJournal {
+	journal := entity.NewTradingJournal(ownerID, "Swing", "Higher timeframe setups")
+	journal.ID = uuid.New()
+	journal.DefaultAsset = ptr(types.CurrencyPairEURUSD)
+	journal.Tags = []string{"majors"}
+	journal.RequireNotesOnLoss = true
+	journal.Broker = ptr("IC Markets")
+	journal.AccountNumber = ptr("5012345")
+
+	win := entity.NewTradingJournalEntry(
+		journal.ID, time.Date(2026, 3, 2, 0, 0, 0, 0, time.UTC), types.CurrencyPairEURUSD,
+		"https://charts.example.com/ltf", "https://charts.example.com/htf", nil,
+		types.TradingSessionLondon, types.TradeTypeSwing, ptr("breakout"),
+		types.TradeDirectionBuy, types.EntryTypeLimit, 250.5, 3, types.TradeResultTakeProfit, "clean break",
+	)
+	win.ID = uuid.New()
+	win.EntryPrice = ptr(1.1000)
+	win.StopLossPrice = ptr(1.0950)
+	win.TakeProfitPrice = ptr(1.1150)
+	win.Emotion = ptr(types.EmotionCalm)
+	win.EntryCharts = []string{"https://charts.example.com/fill.png"}
+	win.SetupCharts = []string{"https://charts.example.com/h4-bias.png", "https://charts.example.com/m15-zone.png"}
+
+	loss := entity.NewTradingJournalEntry(
+		journal.ID, time.Date(2026, 3, 3, 0, 0, 0, 0, time.UTC), types.CurrencyPairGBPUSD,
+		"https://charts.example.com/ltf", "https://charts.example.com/htf", nil,
+		types.TradingSessionNewYork, types.TradeTypeIntraday, nil,
+		types.TradeDirectionSell, types.EntryTypeMarket, -100, 2, types.TradeResultStopLoss, "chased the move",
+	)
+	loss.ID = uuid.New()
+	loss.RiskPercent = 1
+
+	journal.Entries = []*entity.TradingJournalEntry{win, loss}
+	return journal
+}
+
+func TestExportImportRoundTrip(t *testing.T) {
+	ownerID := uuid.New()
+	importerID := uuid.New()
+	journal := newExportedJournal(ownerID)
+	storage := &backupJournalStorage{ownerID: ownerID, journal: journal}
+	svc := NewTradingJournalService(storage, nil, zap.NewNop())
+
+	var buf bytes.Buffer
+	if err := svc.StreamExport(context.Background(), journal.ID, ownerID, &buf); err != nil {
+		t.Fatalf("StreamExport() error = %v", err)
+	}
+
+	var doc dto.JournalExportDocument
+	if err := json.Unmarshal(buf.Bytes(), &doc); err != nil {
+		t.Fatalf("decode export: %v", err)
+	}
+
+	imported, err := svc.Import(context.Background(), importerID, &doc)
+	if err != nil {
+		t.Fatalf("Import() error = %v", err)
+	}
+
+	if imported.ID == journal.ID || imported.UserID != importerID {
+		t.Errorf("imported journal %s owned by %s, want a new ID owned by %s", imported.ID, imported.UserID, importerID)
+	}
+	if imported.Name != journal.Name || imported.Description != journal.Description ||
+		*imported.DefaultAsset != *journal.DefaultAsset || !slices.Equal(imported.Tags, journal.Tags) ||
+		imported.RequireNotesOnLoss != journal.RequireNotesOnLoss ||
+		!equalPtr(imported.Broker, journal.Broker) || !equalPtr(imported.AccountNumber, journal.AccountNumber) {
+		t.Errorf("imported journal = %+v, want the fields of %+v", imported, journal)
+	}
+
+	if storage.imported != imported || len(storage.importedEntries) != len(journal.Entries) {
+		t.Fatalf("stored %d entries, want %d in one write", len(storage.importedEntries), len(journal.Entries))
+	}
+	for i, got := range storage.importedEntries {
+		want := journal.Entries[i]
+		if got.ID == want.ID || got.JournalID != imported.ID {
+			t.Errorf("entry %d: id %s in journal %s, want a new id in %s", i, got.ID, got.JournalID, imported.ID)
+		}
+		if !got.Day.Equal(want.Day) || got.Asset != want.Asset || got.Session != want.Session ||
+			got.TradeType != want.TradeType || !equalPtr(got.Setup, want.Setup) ||
+			got.Direction != want.Direction || got.EntryType != want.EntryType ||
+			got.Realized != want.Realized || got.MaxRR != want.MaxRR || got.Result != want.Result ||
+			got.Notes != want.Notes || got.RiskPercent != want.RiskPercent ||
+			!equalPtr(got.EntryPrice, want.EntryPrice) || !equalPtr(got.StopLossPrice, want.StopLossPrice) ||
+			!equalPtr(got.TakeProfitPrice, want.TakeProfitPrice) || !equalPtr(got.Emotion, want.Emotion) ||
+			!slices.Equal(got.EntryCharts, want.EntryCharts) || !slices.Equal(got.SetupCharts, want.SetupCharts) {
+			t.Errorf("entry %d = %+v, want the fields of %+v", i, got, want)
+		}
+	}
+}
+
+func TestExportRequiresOwnership(t *testing.T) {
+	ownerID := uuid.New()
+	journal := newExportedJournal(ownerID)
+	svc := NewTradingJournalService(&backupJournalStorage{ownerID: ownerID, journal: journal}, nil, zap.NewNop())
+
+	var buf bytes.Buffer
+	err := svc.StreamExport(context.Background(), journal.ID, uuid.New(), &buf)
+	if !errors.Is(err, entity.ErrNotFound) {
+		t.Fatalf("StreamExport() error = %v, want %v", err, entity.ErrNotFound)
+	}
+	if buf.Len() != 0 {
+		t.Errorf("wrote %d bytes of another user's journal", buf.Len())
+	}
+}
+
+func TestImportRejectsUnsupportedVersion(t *testing.T) {
+	storage := &backupJournalStorage{}
+	svc := NewTradingJournalService(storage, nil, zap.NewNop())
+
+	for _, version := range []int{0, dto.JournalExportVersion + 1} {
+		doc := &dto.JournalExportDocument{Version: version, Journal: dto.JournalExportJournal{Name: "Swing"}}
+		if _, err := svc.Import(context.Background(), uuid.New(), doc); !errors.Is(err, entity.ErrUnsupportedExportVersion) {
+			t.Errorf("version %d: Import() error = %v, want %v", version, err, entity.ErrUnsupportedExportVersion)
+		}
+	}
+	if storage.imported != nil {
+		t.Errorf("journal stored from an unsupported document")
+	}
+}
+
+func equalPtr[T comparable](a, b *T) bool {
+	return (a == nil && b == nil) || (a != nil && b != nil && *a == *b)
+}
+
+func TestPreviewImport(t *testing.T) {
+	ownerID := uuid.New()
+	rowErr := errors.New("Key: 'JournalExportEntry.LTF' Error:Field validation for 'LTF' failed on the 'url' tag")
+
+	tests := []struct {
+		name      string
+		edit      func(doc *dto.JournalExportDocument)
+		rowErrs   map[int]error
+		wantCount int
+		wantErrs  []entity.ImportRowError
+		wantErr   error
+	}{
+		{name: "valid document", wantCount: 2},
+		{
+			name:      "invalid entry",
+			edit:      func(doc *dto.JournalExportDocument) { doc.Entries[1].Session = "moon" },
+			wantCount: 1,
+			wantErrs:  []entity.ImportRowError{{Index: 1, Err: entity.ErrInvalidSession}},
+		},
+		{
+			name:      "caller row error",
+			rowErrs:   map[int]error{0: rowErr},
+			wantCount: 1,
+			wantErrs:  []entity.ImportRowError{{Index: 0, Err: rowErr}},
+		},
+		{
+			name: "every entry invalid",
+			edit: func(doc *dto.JournalExportDocument) {
+				doc.Entries[0].Result = "maybe"
+				doc.Entries[1].Session = "moon"
+			},
+			wantErrs: []entity.ImportRowError{
+				{Index: 0, Err: entity.ErrInvalidResult},
+				{Index: 1, Err: entity.ErrInvalidSession},
+			},
+		},
+		{
+			name:    "unsupported version",
+			edit:    func(doc *dto.JournalExportDocument) { doc.Version++ },
+			wantErr: entity.ErrUnsupportedExportVersion,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			journal := newExportedJournal(ownerID)
+			storage := &backupJournalStorage{ownerID: ownerID, journal: journal}
+			svc := NewTradingJournalService(storage, nil, zap.NewNop())
+
+			var buf bytes.Buffer
+			if err := svc.StreamExport(context.Background(), journal.ID, ownerID, &buf); err != nil {
+				t.Fatalf("StreamExport() error = %v", err)
+			}
+			var doc dto.JournalExportDocument
+			if err := json.Unmarshal(buf.Bytes(), &doc); err != nil {
+				t.Fatalf("decode export: %v", err)
+			}
+			if tt.edit != nil {
+				tt.edit(&doc)
+			}
+
+			preview, err := svc.PreviewImport(context.Background(), uuid.New(), &doc, tt.rowErrs)
+			if storage.imported != nil {
+				t.Error("PreviewImport() stored the journal")
+			}
+			if tt.wantErr != nil {
+				if !errors.Is(err, tt.wantErr) {
+					t.Fatalf("PreviewImport() error = %v, want %v", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("PreviewImport() error = %v", err)
+			}
+
+			if preview.JournalName != journal.Name || preview.EntryCount != tt.wantCount {
+				t.Errorf("preview = %q with %d entries, want %q with %d", preview.JournalName, preview.EntryCount, journal.Name, tt.wantCount)
+			}
+			if len(preview.Errors) != len(tt.wantErrs) {
+				t.Fatalf("row errors = %v, want %v", preview.Errors, tt.wantErrs)
+			}
+			for i, want := range tt.wantErrs {
+				if got := preview.Errors[i]; got.Index != want.Index || !errors.Is(got.Err, want.Err) {
+					t.Errorf("row error %d = %+v, want %+v", i, got, want)
+				}
+			}
+		})
+	}
+}
+
+type pagedEntriesJournalStorage struct {
+	TradingJournalStorage
+	journal *entity.TradingJournal
+	entries []*entity.TradingJournalEntry
+}
+
+func (s *pagedEntriesJournalStorage) GetByID(_ context.Context, id uuid.UUID) (*entity.TradingJournal, error) {
+	if id != s.journal.ID {
+		return nil, entity.ErrNotFound
+	}
+	journal := *s.journal
+	return &journal, nil
+}
+
+func (s *pagedEntriesJournalStorage) GetEntries(_ context.Context, _ uuid.UUID, limit, offset int) ([]*entity.TradingJournalEntry, error) {
+	start := min(offset, len(s.entries))
+	end := min(start+limit, len(s.entries))
+	return s.entries[start:end], nil
+}
+
+func (s *pagedEntriesJournalStorage) CountEntries(context.Context, uuid.UUID) (int, error) {
+	return len(s.entries), nil
+}
+
+func TestGetByIDWithEntriesReturnsOnePage(t *testing.T) {
+	journal := newTestJournal()
+	entries := make([]*entity.TradingJournalEntry, 5)
+	for i := range entries {
+		entries[i] = newTestEntry(journal.ID, types.TradeResultTakeProfit, 10)
+	}
+
+	tests := []struct {
+		name          string
+		limit, offset int
+		want          []*entity.TradingJournalEntry
+	}{
+		{"first page", 2, 0, entries[:2]},
+		{"middle page", 2, 2, entries[2:4]},
+		{"last partial page", 2, 4, entries[4:]},
+		{"past the end", 2, 10, nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			storage := &pagedEntriesJournalStorage{journal: journal, entries: entries}
+			svc := NewTradingJournalService(storage, nil, zap.NewNop())
+
+			got, total, err := svc.GetByIDWithEntries(context.Background(), journal.ID, tt.limit, tt.offset)
+			if err != nil {
+				t.Fatalf("GetByIDWithEntries() error = %v", err)
+			}
+			if total != len(entries) {
+				t.Errorf("total = %d, want %d", total, len(entries))
+			}
+			if len(got.Entries) != len(tt.want) {
+				t.Fatalf("got %d entries, want %d", len(got.Entries), len(tt.want))
+			}
+			for i, entry := range got.Entries {
+				if entry.ID != tt.want[i].ID {
+					t.Errorf("entry %d = %s, want %s", i, entry.ID, tt.want[i].ID)
+				}
+			}
+		})
+	}
+
+	t.Run("missing journal", func(t *testing.T) {
+		svc := NewTradingJournalService(&pagedEntriesJournalStorage{journal: journal}, nil, zap.NewNop())
+		if _, _, err := svc.GetByIDWithEntries(context.Background(), uuid.New(), 10, 0); !errors.Is(err, entity.ErrNotFound) {
+			t.Errorf("GetByIDWithEntries() error = %v, want %v", err, entity.ErrNotFound)
+		}
+	})
+}
+
+func TestCreateValidationProfile(t *testing.T) {
+	tests := []struct {
+		name    string
+		profile types.ValidationProfile
+		want    types.ValidationProfile
+	}{
+		{"defaults to standard", "", types.ValidationProfileStandard},
+		{"lenient", types.ValidationProfileLenient, types.ValidationProfileLenient},
+		{"strict", types.ValidationProfileStrict, types.ValidationProfileStrict},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			storage := &namedJournalStorage{journals: map[string]*entity.TradingJournal{}}
+			svc := NewTradingJournalService(storage, nil, zap.NewNop())
+
+			journal, err := svc.Create(context.Background(), uuid.New(), &dto.CreateTradingJournalRequest{Name: "Swing", ValidationProfile: tt.profile})
+			if err != nil {
+				t.Fatalf("Create() error = %v", err)
+			}
+			if journal.ValidationProfile != tt.want {
+				t.Errorf("ValidationProfile = %q, want %q", journal.ValidationProfile, tt.want)
+			}
+		})
+	}
+}
+
+func TestStreamExportLargeJournal(t *testing.T) {
+	tests := []struct {
+		name        string
+		entries     int
+		wantFlushes int
+	}{
+		{"no entries", 0, 1},
+		{"partial batch", 3, 1},
+		{"exact batches", 2 * exportBatchSize, 3},
+		{"batches and a remainder", 2*exportBatchSize + 7, 3},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ownerID := uuid.New()
+			journal := newExportedJournal(ownerID)
+			template := journal.Entries[0]
+			journal.Entries = make([]*entity.TradingJournalEntry, tt.entries)
+			for i := range journal.Entries {
+				entry := *template
+				entry.ID = uuid.New()
+				journal.Entries[i] = &entry
+			}
+			svc := NewTradingJournalService(&backupJournalStorage{ownerID: ownerID, journal: journal}, nil, zap.NewNop())
+
+			var buf flushBuffer
+			if err := svc.StreamExport(context.Background(), journal.ID, ownerID, &buf); err != nil {
+				t.Fatalf("StreamExport() error = %v", err)
+			}
+
+			var doc dto.JournalExportDocument
+			if err := json.Unmarshal(buf.Bytes(), &doc); err != nil {
+				t.Fatalf("decode export: %v", err)
+			}
+			if len(doc.Entries) != tt.entries {
+				t.Errorf("decoded %d entries, want %d", len(doc.Entries), tt.entries)
+			}
+			if buf.flushes != tt.wantFlushes {
+				t.Errorf("flushed %d times, want %d", buf.flushes, tt.wantFlushes)
+			}
+		})
+	}
+}
+
+func TestStreamExportWriteFailure(t *testing.T) {
+	ownerID := uuid.New()
+	journal := newExportedJournal(ownerID)
+	svc := NewTradingJournalService(&backupJournalStorage{ownerID: ownerID, journal: journal}, nil, zap.NewNop())
+
+	w := &failingWriter{n: 64}
+	err := svc.StreamExport(context.Background(), journal.ID, ownerID, w)
+	if !errors.Is(err, errWriteFailed) {
+		t.Fatalf("StreamExport() error = %v, want %v", err, errWriteFailed)
+	}
+	if json.Valid(w.buf.Bytes()) {
+		t.Errorf("truncated export %s is valid JSON", w.buf.String())
+	}
+}
+
+func TestImportEntriesFollowValidationProfile(t *testing.T) {
+	ownerID := uuid.New()
+	journalID := uuid.New()
+
+	sameCharts := newImportRequest(types.CurrencyPairEURUSD, 120)
+	sameCharts.HTF = sameCharts.LTF
+	future := newImportRequest(types.CurrencyPairEURUSD, 80)
+	future.Day = time.Now().AddDate(0, 0, 7)
+
+	tests := []struct {
+		name        string
+		profile     types.ValidationProfile
+		wantAdded   int
+		wantErrRows map[int]error
+	}{
+		{
+			name:        "strict",
+			profile:     types.ValidationProfileStrict,
+			wantAdded:   1,
+			wantErrRows: map[int]error{0: entity.ErrIdenticalTimeframeCharts, 1: entity.ErrFutureEntryDay},
+		},
+		{
+			name:        "standard",
+			profile:     types.ValidationProfileStandard,
+			wantAdded:   2,
+			wantErrRows: map[int]error{1: entity.ErrFutureEntryDay},
+		},
+		{
+			name:      "lenient",
+			profile:   types.ValidationProfileLenient,
+			wantAdded: 3,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			storage := &entryImportStorage{ownerID: ownerID, journalID: journalID, profile: tt.profile}
+			svc := NewTradingJournalService(storage, nil, zap.NewNop())
+
+			reqs := []*dto.CreateTradingJournalEntryRequest{sameCharts, future, newImportRequest(types.CurrencyPairGBPUSD, -40)}
+			result, err := svc.ImportEntries(context.Background(), journalID, ownerID, reqs, nil)
+			if err != nil {
+				t.Fatalf("ImportEntries() error = %v", err)
+			}
+
+			if len(storage.added) != tt.wantAdded {
+				t.Errorf("added %d entries, want %d", len(storage.added), tt.wantAdded)
+			}
+			if len(result.Errors) != len(tt.wantErrRows) {
+				t.Fatalf("row errors = %v, want %d", result.Errors, len(tt.wantErrRows))
+			}
+			for _, rowErr := range result.Errors {
+				want, ok := tt.wantErrRows[rowErr.Index]
+				if !ok || !errors.Is(rowErr.Err, want) || !errors.Is(rowErr.Err, entity.ErrEntryPolicy) {
+					t.Errorf("row %d error = %v, want %v", rowErr.Index, rowErr.Err, want)
+				}
+			}
+		})
+	}
+}
@@ -3,6 +3,7 @@ package service
 import (
 	"context"
 	"fmt"
+	"strings"
 	"time"
 
 	"github.com/cockroachdb/errors"
@@ -25,22 +26,48 @@ type UserStorage interface {
 	Exists(ctx context.Context, email, username string) (bool, error)
 }
 
+type SessionStorage interface {
+	Create(ctx context.Context, session *entity.Session) error
+	GetByID(ctx context.Context, id uuid.UUID) (*entity.Session, error)
+	GetActiveByUserID(ctx context.Context, userID uuid.UUID) ([]*entity.Session, error)
+	GetByUserID(ctx context.Context, userID uuid.UUID) ([]*entity.Session, error)
+	Touch(ctx context.Context, id uuid.UUID, lastUsedAt time.Time) error
+	Delete(ctx context.Context, id uuid.UUID, userID uuid.UUID) error
+}
+
+// GoogleVerifier verifies Google ID tokens. Verify wraps
+// auth.ErrInvalidGoogleToken for tokens that are not valid for this app.
+type GoogleVerifier interface {
+	Verify(ctx context.Context, idToken string) (*auth.GoogleIdentity, error)
+}
+
+const (
+	minUsernameLen        = 3
+	maxDerivedUsernameLen = 40
+	maxUsernameAttempts   = 5
+)
+
 type UserService struct {
-	storage    UserStorage
-	cache      Cache
-	jwtManager *auth.JWTManager
-	logger     *zap.Logger
+	storage        UserStorage
+	sessionStorage SessionStorage
+	cache          Cache
+	metrics        Metrics
+	googleVerifier GoogleVerifier
+	jwtManager     *auth.JWTManager
+	logger         *zap.Logger
 }
 
 func NewUserService(
 	storage UserStorage,
+	sessionStorage SessionStorage,
 	jwtManager *auth.JWTManager,
 	logger *zap.Logger,
 ) *UserService {
 	return &UserService{
-		storage:    storage,
-		jwtManager: jwtManager,
-		logger:     logger,
+		storage:        storage,
+		sessionStorage: sessionStorage,
+		jwtManager:     jwtManager,
+		logger:         logger,
 	}
 }
 
@@ -49,6 +76,17 @@ func (s *UserService) WithCache(cache Cache) *UserService {
 	return s
 }
 
+func (s *UserService) WithMetrics(metrics Metrics) *UserService {
+	s.metrics = metrics
+	return s
+}
+
+// WithGoogleVerifier enables Google sign-in.
+func (s *UserService) WithGoogleVerifier(verifier GoogleVerifier) *UserService {
+	s.googleVerifier = verifier
+	return s
+}
+
 func (s *UserService) SignUp(ctx context.Context, req *dto.SignUpRequest) (*dto.AuthResponse, error) {
 	exists, err := s.storage.Exists(ctx, req.Email, req.Username)
 	if err != nil {
@@ -66,33 +104,21 @@ func (s *UserService) SignUp(ctx context.Context, req *dto.SignUpRequest) (*dto.
 		return nil, errors.Wrap(err, "failed to create user entity")
 	}
 
+	// A concurrent sign-up can pass the Exists check too; the unique index
+	// rejects the second insert and storage reports it as already existing.
 	if err := s.storage.Create(ctx, user); err != nil {
+		if errors.Is(err, entity.ErrUserAlreadyExists) {
+			return nil, err
+		}
 		s.logger.Error("failed to create user in database", zap.Error(err))
 		return nil, errors.Wrap(err, "failed to create user")
 	}
 
-	tokens, err := s.jwtManager.GenerateTokenPair(
-		user.ID,
-		user.Email,
-		user.Username,
-	)
-	if err != nil {
-		s.logger.Error("failed to generate tokens", zap.Error(err))
-		return nil, errors.Wrap(err, "failed to generate tokens")
-	}
-
-	if s.cache != nil {
-		cacheKey := fmt.Sprintf("token:access:%s", user.ID.String())
-		if err := s.cache.Set(ctx, cacheKey, tokens.AccessToken, time.Until(tokens.ExpiresAt)); err != nil {
-			s.logger.Warn("failed to cache access token", zap.Error(err))
-		}
+	if s.metrics != nil {
+		s.metrics.UserSignedUp()
 	}
 
-	return &dto.AuthResponse{
-		AccessToken:  tokens.AccessToken,
-		RefreshToken: tokens.RefreshToken,
-		ExpiresAt:    tokens.ExpiresAt,
-	}, nil
+	return s.signIn(ctx, user, req.Device)
 }
 
 func (s *UserService) SignIn(ctx context.Context, req *dto.SignInRequest) (*dto.AuthResponse, error) {
@@ -107,10 +133,117 @@ func (s *UserService) SignIn(ctx context.Context, req *dto.SignInRequest) (*dto.
 		return nil, entity.ErrInvalidCredentials
 	}
 
-	tokens, err := s.jwtManager.GenerateTokenPair(user.ID, user.Email, user.Username)
+	return s.signIn(ctx, user, req.Device)
+}
+
+// SignInWithGoogle signs in the user whose email a Google ID token vouches
+// for, creating an account on first sign-in. An existing account with the
+// same email, including a password account, is signed in as is, since
+// Google has verified the address.
+func (s *UserService) SignInWithGoogle(ctx context.Context, req *dto.GoogleSignInRequest) (*dto.AuthResponse, error) {
+	if s.googleVerifier == nil {
+		return nil, entity.ErrGoogleSignInDisabled
+	}
+
+	identity, err := s.googleVerifier.Verify(ctx, req.IDToken)
 	if err != nil {
-		s.logger.Error("failed to generate tokens", zap.Error(err))
-		return nil, errors.Wrap(err, "failed to generate tokens")
+		if errors.Is(err, auth.ErrInvalidGoogleToken) {
+			s.logger.Warn("invalid google id token", zap.Error(err))
+			return nil, entity.ErrInvalidGoogleToken
+		}
+		s.logger.Error("failed to verify google id token", zap.Error(err))
+		return nil, errors.Wrap(err, "failed to verify google id token")
+	}
+
+	if !identity.EmailVerified {
+		return nil, entity.ErrInvalidGoogleToken
+	}
+
+	user, err := s.storage.GetByEmail(ctx, identity.Email)
+	if errors.Is(err, entity.ErrNotFound) {
+		user, err = s.createOAuthUser(ctx, entity.AuthProviderGoogle, identity.Email)
+	}
+	if err != nil {
+		s.logger.Error("failed to find or create google user", zap.Error(err))
+		return nil, errors.Wrap(err, "failed to find or create google user")
+	}
+
+	return s.signIn(ctx, user, req.Device)
+}
+
+// createOAuthUser creates a passwordless account, deriving a free username
+// from the email.
+func (s *UserService) createOAuthUser(ctx context.Context, provider entity.AuthProvider, email string) (*entity.User, error) {
+	username, err := s.freeUsername(ctx, email)
+	if err != nil {
+		return nil, err
+	}
+
+	user := entity.NewUserFromOAuth(provider, email, username)
+	if err := s.storage.Create(ctx, user); err != nil {
+		// A concurrent first sign-in may have created the account.
+		if errors.Is(err, entity.ErrUserAlreadyExists) {
+			return s.storage.GetByEmail(ctx, email)
+		}
+		return nil, errors.Wrap(err, "failed to create user")
+	}
+
+	if s.metrics != nil {
+		s.metrics.UserSignedUp()
+	}
+
+	return user, nil
+}
+
+// freeUsername derives a username from the local part of email, adding a
+// random suffix if it is taken.
+func (s *UserService) freeUsername(ctx context.Context, email string) (string, error) {
+	base := usernameFromEmail(email)
+
+	candidate := base
+	for range maxUsernameAttempts {
+		_, err := s.storage.GetByUsername(ctx, candidate)
+		if errors.Is(err, entity.ErrNotFound) {
+			return candidate, nil
+		}
+		if err != nil {
+			return "", errors.Wrap(err, "failed to check username")
+		}
+
+		candidate = fmt.Sprintf("%s-%s", base, uuid.NewString()[:6])
+	}
+
+	return "", errors.New("failed to find a free username")
+}
+
+// usernameFromEmail keeps the letters, digits and "._-" of the local part,
+// bounded to fit the sign-up limits with room for a suffix.
+func usernameFromEmail(email string) string {
+	local, _, _ := strings.Cut(email, "@")
+
+	var b strings.Builder
+	for _, r := range strings.ToLower(local) {
+		if r >= 'a' && r <= 'z' || r >= '0' && r <= '9' || strings.ContainsRune("._-", r) {
+			b.WriteRune(r)
+		}
+	}
+
+	username := b.String()
+	if len(username) > maxDerivedUsernameLen {
+		username = username[:maxDerivedUsernameLen]
+	}
+	if len(username) < minUsernameLen {
+		username = "user" + username
+	}
+
+	return username
+}
+
+// signIn starts a session for an authenticated user and returns its tokens.
+func (s *UserService) signIn(ctx context.Context, user *entity.User, device dto.DeviceInfo) (*dto.AuthResponse, error) {
+	tokens, err := s.startSession(ctx, user, device)
+	if err != nil {
+		return nil, err
 	}
 
 	if s.cache != nil {
@@ -123,6 +256,91 @@ func (s *UserService) SignIn(ctx context.Context, req *dto.SignInRequest) (*dto.
 	return &dto.AuthResponse{
 		AccessToken:  tokens.AccessToken,
 		RefreshToken: tokens.RefreshToken,
-		ExpiresAt:    tokens.ExpiresAt,
+		ExpiresAt:    tokens.ExpiresAt.UTC(),
 	}, nil
 }
+
+// startSession records a new device session and issues tokens bound to it.
+func (s *UserService) startSession(ctx context.Context, user *entity.User, device dto.DeviceInfo) (*auth.TokenPair, error) {
+	session := entity.NewSession(user.ID, device.UserAgent, device.IPAddress)
+
+	tokens, err := s.jwtManager.GenerateTokenPair(user.ID, session.ID, user.Email, user.Username)
+	if err != nil {
+		s.logger.Error("failed to generate tokens", zap.Error(err))
+		return nil, errors.Wrap(err, "failed to generate tokens")
+	}
+
+	session.ExpiresAt = tokens.RefreshExpiresAt.UTC()
+
+	if err := s.sessionStorage.Create(ctx, session); err != nil {
+		s.logger.Error("failed to create session", zap.Error(err), zap.String("user_id", user.ID.String()))
+		return nil, errors.Wrap(err, "failed to create session")
+	}
+
+	return tokens, nil
+}
+
+// RefreshAccessToken issues a new access token if the refresh token is valid
+// and its session has not been revoked.
+func (s *UserService) RefreshAccessToken(ctx context.Context, req *dto.RefreshTokenRequest) (*dto.RefreshTokenResponse, error) {
+	claims, err := s.jwtManager.ValidateToken(req.RefreshToken)
+	if err != nil {
+		s.logger.Warn("invalid refresh token", zap.Error(err))
+		return nil, entity.ErrInvalidRefreshToken
+	}
+
+	session, err := s.sessionStorage.GetByID(ctx, claims.SessionID)
+	if err != nil {
+		s.logger.Warn("refresh token session not found", zap.Error(err), zap.String("session_id", claims.SessionID.String()))
+		return nil, entity.ErrInvalidRefreshToken
+	}
+
+	if session.UserID != claims.UserID || session.IsExpired() {
+		return nil, entity.ErrInvalidRefreshToken
+	}
+
+	accessToken, expiresAt, err := s.jwtManager.RefreshAccessToken(req.RefreshToken)
+	if err != nil {
+		s.logger.Error("failed to refresh access token", zap.Error(err))
+		return nil, errors.Wrap(err, "failed to refresh access token")
+	}
+
+	if err := s.sessionStorage.Touch(ctx, session.ID, time.Now().UTC()); err != nil {
+		s.logger.Warn("failed to update session last used time", zap.Error(err))
+	}
+
+	return &dto.RefreshTokenResponse{
+		AccessToken: accessToken,
+		ExpiresAt:   expiresAt.UTC(),
+	}, nil
+}
+
+func (s *UserService) GetProfile(ctx context.Context, userID uuid.UUID) (*entity.User, error) {
+	user, err := s.storage.GetByID(ctx, userID)
+	if err != nil {
+		s.logger.Error("failed to get user profile", zap.Error(err), zap.String("user_id", userID.String()))
+		return nil, errors.Wrap(err, "failed to get user profile")
+	}
+
+	return user, nil
+}
+
+func (s *UserService) ListSessions(ctx context.Context, userID uuid.UUID) ([]*entity.Session, error) {
+	sessions, err := s.sessionStorage.GetActiveByUserID(ctx, userID)
+	if err != nil {
+		s.logger.Error("failed to list sessions", zap.Error(err), zap.String("user_id", userID.String()))
+		return nil, errors.Wrap(err, "failed to list sessions")
+	}
+
+	return sessions, nil
+}
+
+// RevokeSession signs one device out; the user's other sessions keep working.
+func (s *UserService) RevokeSession(ctx context.Context, id uuid.UUID, userID uuid.UUID) error {
+	if err := s.sessionStorage.Delete(ctx, id, userID); err != nil {
+		s.logger.Error("failed to revoke session", zap.Error(err), zap.String("session_id", id.String()))
+		return errors.Wrap(err, "failed to revoke session")
+	}
+
+	return nil
+}
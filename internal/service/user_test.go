@@ -0,0 +1,440 @@
+package service
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/cockroachdb/errors"
+	"github.com/google/uuid"
+	"github.com/user/normark/internal/dto"
+	"github.com/user/normark/internal/entity"
+	"github.com/user/normark/pkg/auth"
+	"go.uber.org/zap"
+)
+
+const testJWTSecret = "test-secret-key-that-is-at-least-32-chars"
+
+type fakeUserStorage struct {
+	UserStorage
+	user *entity.User
+}
+
+func (s *fakeUserStorage) GetByEmail(_ context.Context, email string) (*entity.User, error) {
+	if s.user == nil || s.user.Email != email {
+		return nil, entity.ErrNotFound
+	}
+	return s.user, nil
+}
+
+type memorySessionStorage struct {
+	sessions map[uuid.UUID]*entity.Session
+}
+
+func newMemorySessionStorage() *memorySessionStorage {
+	return &memorySessionStorage{sessions: make(map[uuid.UUID]*entity.Session)}
+}
+
+func (s *memorySessionStorage) Create(_ context.Context, session *entity.Session) error {
+	s.sessions[session.ID] = session
+	return nil
+}
+
+func (s *memorySessionStorage) GetByID(_ context.Context, id uuid.UUID) (*entity.Session, error) {
+	session, ok := s.sessions[id]
+	if !ok {
+		return nil, errors.Wrap(entity.ErrNotFound, "session")
+	}
+	return session, nil
+}
+
+func (s *memorySessionStorage) GetActiveByUserID(_ context.Context, userID uuid.UUID) ([]*entity.Session, error) {
+	var sessions []*entity.Session
+	for _, session := range s.sessions {
+		if session.UserID == userID && !session.IsExpired() {
+			sessions = append(sessions, session)
+		}
+	}
+	return sessions, nil
+}
+
+func (s *memorySessionStorage) GetByUserID(ctx context.Context, userID uuid.UUID) ([]*entity.Session, error) {
+	return s.GetActiveByUserID(ctx, userID)
+}
+
+func (s *memorySessionStorage) Touch(_ context.Context, id uuid.UUID, lastUsedAt time.Time) error {
+	s.sessions[id].LastUsedAt = lastUsedAt
+	return nil
+}
+
+func (s *memorySessionStorage) Delete(_ context.Context, id uuid.UUID, userID uuid.UUID) error {
+	session, ok := s.sessions[id]
+	if !ok || session.UserID != userID {
+		return entity.ErrSessionNotFound
+	}
+	delete(s.sessions, id)
+	return nil
+}
+
+func newTestUserService(t *testing.T) (*UserService, *entity.User, *memorySessionStorage) {
+	t.Helper()
+
+	user, err := entity.NewUserFromSignUp(&dto.SignUpRequest{Email: "trader@example.com", Username: "trader", Password: "password123"})
+	if err != nil {
+		t.Fatalf("NewUserFromSignUp() error = %v", err)
+	}
+	user.ID = uuid.New()
+
+	jwtManager, err := auth.NewJWTManager(testJWTSecret, 15, 60)
+	if err != nil {
+		t.Fatalf("NewJWTManager() error = %v", err)
+	}
+
+	sessions := newMemorySessionStorage()
+	return NewUserService(&fakeUserStorage{user: user}, sessions, jwtManager, zap.NewNop()), user, sessions
+}
+
+func signInDevice(t *testing.T, svc *UserService, userAgent string) *dto.AuthResponse {
+	t.Helper()
+
+	resp, err := svc.SignIn(context.Background(), &dto.SignInRequest{
+		Email:    "trader@example.com",
+		Password: "password123",
+		Device:   dto.DeviceInfo{UserAgent: userAgent, IPAddress: "203.0.113.7"},
+	})
+	if err != nil {
+		t.Fatalf("SignIn() error = %v", err)
+	}
+	return resp
+}
+
+func sessionIDOf(t *testing.T, token string) uuid.UUID {
+	t.Helper()
+
+	jwtManager, _ := auth.NewJWTManager(testJWTSecret, 15, 60)
+	claims, err := jwtManager.ValidateToken(token)
+	if err != nil {
+		t.Fatalf("ValidateToken() error = %v", err)
+	}
+	return claims.SessionID
+}
+
+func TestRevokeSessionKeepsOtherDevices(t *testing.T) {
+	ctx := context.Background()
+	svc, user, _ := newTestUserService(t)
+
+	phone := signInDevice(t, svc, "phone")
+	laptop := signInDevice(t, svc, "laptop")
+
+	sessions, err := svc.ListSessions(ctx, user.ID)
+	if err != nil {
+		t.Fatalf("ListSessions() error = %v", err)
+	}
+	if len(sessions) != 2 {
+		t.Fatalf("got %d sessions, want one per device", len(sessions))
+	}
+
+	if err := svc.RevokeSession(ctx, sessionIDOf(t, phone.RefreshToken), user.ID); err != nil {
+		t.Fatalf("RevokeSession() error = %v", err)
+	}
+
+	if _, err := svc.RefreshAccessToken(ctx, &dto.RefreshTokenRequest{RefreshToken: phone.RefreshToken}); !errors.Is(err, entity.ErrInvalidRefreshToken) {
+		t.Errorf("refresh on revoked device error = %v, want ErrInvalidRefreshToken", err)
+	}
+	if _, err := svc.RefreshAccessToken(ctx, &dto.RefreshTokenRequest{RefreshToken: laptop.RefreshToken}); err != nil {
+		t.Errorf("refresh on other device error = %v, want nil", err)
+	}
+
+	sessions, err = svc.ListSessions(ctx, user.ID)
+	if err != nil {
+		t.Fatalf("ListSessions() error = %v", err)
+	}
+	if len(sessions) != 1 || sessions[0].UserAgent != "laptop" {
+		t.Errorf("sessions = %+v, want only the laptop", sessions)
+	}
+}
+
+func TestRefreshAccessTokenChecksSession(t *testing.T) {
+	ctx := context.Background()
+
+	tests := []struct {
+		name    string
+		prepare func(session *entity.Session)
+		wantErr error
+	}{
+		{name: "active session", prepare: func(*entity.Session) {}},
+		{name: "expired session", prepare: func(session *entity.Session) {
+			session.ExpiresAt = time.Now().Add(-time.Minute)
+		}, wantErr: entity.ErrInvalidRefreshToken},
+		{name: "session of another user", prepare: func(session *entity.Session) {
+			session.UserID = uuid.New()
+		}, wantErr: entity.ErrInvalidRefreshToken},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			svc, _, sessions := newTestUserService(t)
+			resp := signInDevice(t, svc, "phone")
+			session := sessions.sessions[sessionIDOf(t, resp.RefreshToken)]
+			tt.prepare(session)
+			lastUsedAt := session.LastUsedAt
+
+			_, err := svc.RefreshAccessToken(ctx, &dto.RefreshTokenRequest{RefreshToken: resp.RefreshToken})
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("RefreshAccessToken() error = %v, want %v", err, tt.wantErr)
+			}
+			if tt.wantErr == nil && !session.LastUsedAt.After(lastUsedAt) {
+				t.Errorf("last used at = %v, want it moved past %v", session.LastUsedAt, lastUsedAt)
+			}
+		})
+	}
+}
+
+func TestRevokeSessionOfAnotherUser(t *testing.T) {
+	svc, _, _ := newTestUserService(t)
+	resp := signInDevice(t, svc, "phone")
+
+	err := svc.RevokeSession(context.Background(), sessionIDOf(t, resp.RefreshToken), uuid.New())
+	if !errors.Is(err, entity.ErrSessionNotFound) {
+		t.Errorf("RevokeSession() error = %v, want ErrSessionNotFound", err)
+	}
+}
+
+// racingUserStorage passes the Exists check and then fails the insert, as
+// when a concurrent sign-up wins the race.
+type racingUserStorage struct {
+	UserStorage
+	createErr error
+}
+
+func (s *racingUserStorage) Exists(context.Context, string, string) (bool, error) {
+	return false, nil
+}
+
+func (s *racingUserStorage) Create(context.Context, *entity.User) error {
+	return s.createErr
+}
+
+func TestSignUpDuplicateInsert(t *testing.T) {
+	tests := []struct {
+		name         string
+		createErr    error
+		wantConflict bool
+	}{
+		{"unique index rejects the insert", entity.ErrUserAlreadyExists, true},
+		{"insert fails", errors.New("connection reset"), false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			jwtManager, err := auth.NewJWTManager(testJWTSecret, 15, 60)
+			if err != nil {
+				t.Fatalf("NewJWTManager() error = %v", err)
+			}
+			svc := NewUserService(&racingUserStorage{createErr: tt.createErr}, newMemorySessionStorage(), jwtManager, zap.NewNop())
+
+			_, err = svc.SignUp(context.Background(), &dto.SignUpRequest{Email: "trader@example.com", Username: "trader", Password: "password123"})
+			if err == nil {
+				t.Fatal("SignUp() error = nil, want an error")
+			}
+			if got := errors.Is(err, entity.ErrUserAlreadyExists); got != tt.wantConflict {
+				t.Errorf("SignUp() error = %v, is ErrUserAlreadyExists: %v, want %v", err, got, tt.wantConflict)
+			}
+		})
+	}
+}
+
+type stubGoogleVerifier struct {
+	identity *auth.GoogleIdentity
+	err      error
+}
+
+func (v *stubGoogleVerifier) Verify(context.Context, string) (*auth.GoogleIdentity, error) {
+	return v.identity, v.err
+}
+
+// oauthUserStorage keeps users in memory by email and username.
+type oauthUserStorage struct {
+	UserStorage
+	users   []*entity.User
+	created []*entity.User
+}
+
+func (s *oauthUserStorage) GetByEmail(_ context.Context, email string) (*entity.User, error) {
+	for _, user := range s.users {
+		if user.Email == email {
+			return user, nil
+		}
+	}
+	return nil, entity.ErrNotFound
+}
+
+func (s *oauthUserStorage) GetByUsername(_ context.Context, username string) (*entity.User, error) {
+	for _, user := range s.users {
+		if user.Username == username {
+			return user, nil
+		}
+	}
+	return nil, entity.ErrNotFound
+}
+
+func (s *oauthUserStorage) Create(_ context.Context, user *entity.User) error {
+	user.ID = uuid.New()
+	s.users = append(s.users, user)
+	s.created = append(s.created, user)
+	return nil
+}
+
+func TestSignInWithGoogle(t *testing.T) {
+	verified := &auth.GoogleIdentity{Subject: "1234", Email: "new.trader@example.com", EmailVerified: true}
+
+	tests := []struct {
+		name         string
+		existing     []*entity.User
+		verifier     GoogleVerifier
+		wantErr      error
+		wantCreated  bool
+		wantUsername string
+		wantSuffix   bool
+		wantProvider entity.AuthProvider
+	}{
+		{
+			name:         "first sign-in creates an account",
+			verifier:     &stubGoogleVerifier{identity: verified},
+			wantCreated:  true,
+			wantUsername: "new.trader",
+			wantProvider: entity.AuthProviderGoogle,
+		},
+		{
+			name:         "existing password account is signed in",
+			existing:     []*entity.User{{ID: uuid.New(), Email: "new.trader@example.com", Username: "trader", Provider: entity.AuthProviderPassword}},
+			verifier:     &stubGoogleVerifier{identity: verified},
+			wantUsername: "trader",
+			wantProvider: entity.AuthProviderPassword,
+		},
+		{
+			name:         "taken username gets a suffix",
+			existing:     []*entity.User{{ID: uuid.New(), Email: "other@example.com", Username: "new.trader"}},
+			verifier:     &stubGoogleVerifier{identity: verified},
+			wantCreated:  true,
+			wantUsername: "new.trader",
+			wantSuffix:   true,
+			wantProvider: entity.AuthProviderGoogle,
+		},
+		{
+			name:     "unverified email",
+			verifier: &stubGoogleVerifier{identity: &auth.GoogleIdentity{Subject: "1234", Email: "new.trader@example.com"}},
+			wantErr:  entity.ErrInvalidGoogleToken,
+		},
+		{
+			name:     "invalid token",
+			verifier: &stubGoogleVerifier{err: errors.Wrap(auth.ErrInvalidGoogleToken, "token issued to another client")},
+			wantErr:  entity.ErrInvalidGoogleToken,
+		},
+		{
+			name:    "not configured",
+			wantErr: entity.ErrGoogleSignInDisabled,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			jwtManager, err := auth.NewJWTManager(testJWTSecret, 15, 60)
+			if err != nil {
+				t.Fatalf("NewJWTManager() error = %v", err)
+			}
+			storage := &oauthUserStorage{users: tt.existing}
+			sessions := newMemorySessionStorage()
+			svc := NewUserService(storage, sessions, jwtManager, zap.NewNop())
+			if tt.verifier != nil {
+				svc.WithGoogleVerifier(tt.verifier)
+			}
+
+			resp, err := svc.SignInWithGoogle(context.Background(), &dto.GoogleSignInRequest{IDToken: "id-token"})
+			if tt.wantErr != nil {
+				if !errors.Is(err, tt.wantErr) {
+					t.Fatalf("SignInWithGoogle() error = %v, want %v", err, tt.wantErr)
+				}
+				if len(storage.created) != 0 {
+					t.Errorf("created %d users, want none", len(storage.created))
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("SignInWithGoogle() error = %v", err)
+			}
+
+			if created := len(storage.created) == 1; created != tt.wantCreated {
+				t.Fatalf("created %d users, want account created %v", len(storage.created), tt.wantCreated)
+			}
+			user, _ := storage.GetByEmail(context.Background(), verified.Email)
+			username, suffix, suffixed := strings.Cut(user.Username, "-")
+			if username != tt.wantUsername || suffixed != tt.wantSuffix || tt.wantSuffix && len(suffix) != 6 {
+				t.Errorf("Username = %q, want %q with suffix %v", user.Username, tt.wantUsername, tt.wantSuffix)
+			}
+			if user.Provider != tt.wantProvider {
+				t.Errorf("Provider = %q, want %q", user.Provider, tt.wantProvider)
+			}
+			if session := sessions.sessions[sessionIDOf(t, resp.AccessToken)]; session == nil || session.UserID != user.ID {
+				t.Errorf("session %+v does not belong to user %s", session, user.ID)
+			}
+		})
+	}
+}
+
+func TestSignInRefusesPasswordForGoogleAccount(t *testing.T) {
+	jwtManager, err := auth.NewJWTManager(testJWTSecret, 15, 60)
+	if err != nil {
+		t.Fatalf("NewJWTManager() error = %v", err)
+	}
+	user := entity.NewUserFromOAuth(entity.AuthProviderGoogle, "trader@example.com", "trader")
+	svc := NewUserService(&fakeUserStorage{user: user}, newMemorySessionStorage(), jwtManager, zap.NewNop())
+
+	_, err = svc.SignIn(context.Background(), &dto.SignInRequest{Email: "trader@example.com", Password: ""})
+	if !errors.Is(err, entity.ErrInvalidCredentials) {
+		t.Errorf("SignIn() error = %v, want %v", err, entity.ErrInvalidCredentials)
+	}
+}
+
+func TestUsernameFromEmail(t *testing.T) {
+	tests := []struct {
+		email string
+		want  string
+	}{
+		{"trader@example.com", "trader"},
+		{"John.Doe+fx@example.com", "john.doefx"},
+		{"a_b-c@example.com", "a_b-c"},
+		{"jo@example.com", "userjo"},
+		{"ü@example.com", "user"},
+		{strings.Repeat("x", 50) + "@example.com", strings.Repeat("x", 40)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.email, func(t *testing.T) {
+			if got := usernameFromEmail(tt.email); got != tt.want {
+				t.Errorf("usernameFromEmail(%q) = %q, want %q", tt.email, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestTokenExpiriesInUTC(t *testing.T) {
+	svc, _, sessions := newTestUserService(t)
+
+	resp := signInDevice(t, svc, "phone")
+	if resp.ExpiresAt.Location() != time.UTC {
+		t.Errorf("sign in expires at = %v, want UTC", resp.ExpiresAt)
+	}
+	if session := sessions.sessions[sessionIDOf(t, resp.RefreshToken)]; session.ExpiresAt.Location() != time.UTC {
+		t.Errorf("session expires at = %v, want UTC", session.ExpiresAt)
+	}
+
+	refreshed, err := svc.RefreshAccessToken(context.Background(), &dto.RefreshTokenRequest{RefreshToken: resp.RefreshToken})
+	if err != nil {
+		t.Fatalf("RefreshAccessToken() error = %v", err)
+	}
+	if refreshed.ExpiresAt.Location() != time.UTC {
+		t.Errorf("refresh expires at = %v, want UTC", refreshed.ExpiresAt)
+	}
+}
@@ -0,0 +1,67 @@
+package bun
+
+import (
+	"github.com/cockroachdb/errors"
+	"github.com/user/normark/internal/entity"
+	"github.com/user/normark/pkg/fieldcrypt"
+)
+
+// sealEntries encrypts the entries' notes and setups in place before they
+// are written and returns a function that puts the plaintext back, so
+// callers keep working with readable entries. Without a cipher it does
+// nothing.
+func sealEntries(c *fieldcrypt.Cipher, entries ...*entity.TradingJournalEntry) (restore func()) {
+	if c == nil {
+		return func() {}
+	}
+
+	type plaintext struct {
+		notes string
+		setup *string
+	}
+
+	saved := make([]plaintext, len(entries))
+	for i, entry := range entries {
+		saved[i] = plaintext{notes: entry.Notes, setup: entry.Setup}
+
+		entry.Notes = c.Encrypt(entry.Notes)
+		if entry.Setup != nil {
+			setup := c.Encrypt(*entry.Setup)
+			entry.Setup = &setup
+		}
+	}
+
+	return func() {
+		for i, entry := range entries {
+			entry.Notes = saved[i].notes
+			entry.Setup = saved[i].setup
+		}
+	}
+}
+
+// openEntries decrypts the entries' notes and setups in place after they
+// are read. Values written while encryption was off are plaintext and kept
+// as they are.
+func openEntries(c *fieldcrypt.Cipher, entries ...*entity.TradingJournalEntry) error {
+	if c == nil {
+		return nil
+	}
+
+	for _, entry := range entries {
+		notes, err := c.Decrypt(entry.Notes)
+		if err != nil {
+			return errors.Wrapf(err, "failed to decrypt notes of entry %s", entry.ID)
+		}
+		entry.Notes = notes
+
+		if entry.Setup != nil {
+			setup, err := c.Decrypt(*entry.Setup)
+			if err != nil {
+				return errors.Wrapf(err, "failed to decrypt setup of entry %s", entry.ID)
+			}
+			entry.Setup = &setup
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,143 @@
+package bun
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/cockroachdb/errors"
+	"github.com/google/uuid"
+	"github.com/uptrace/bun"
+	"github.com/user/normark/internal/entity"
+	"github.com/user/normark/pkg/fieldcrypt"
+)
+
+func TestSealEntries(t *testing.T) {
+	cipher := newTestCipher(t)
+	setup := "London breakout"
+	entries := []*entity.TradingJournalEntry{
+		{ID: uuid.New(), Notes: "moved stop too early", Setup: &setup},
+		{ID: uuid.New(), Notes: "clean trade"},
+	}
+
+	restore := sealEntries(cipher, entries...)
+
+	for i, entry := range entries {
+		if !strings.HasPrefix(entry.Notes, "enc:v1:") {
+			t.Errorf("entry %d notes = %q, want ciphertext", i, entry.Notes)
+		}
+	}
+	if entries[0].Setup == &setup || !strings.HasPrefix(*entries[0].Setup, "enc:v1:") {
+		t.Errorf("setup = %q, want ciphertext in a new string", *entries[0].Setup)
+	}
+	if entries[1].Setup != nil {
+		t.Errorf("unset setup sealed to %q", *entries[1].Setup)
+	}
+	if setup != "London breakout" {
+		t.Errorf("caller's setup overwritten with %q", setup)
+	}
+
+	restore()
+
+	if entries[0].Notes != "moved stop too early" || entries[1].Notes != "clean trade" {
+		t.Errorf("notes after restore = %q, %q, want the plaintext", entries[0].Notes, entries[1].Notes)
+	}
+	if entries[0].Setup != &setup {
+		t.Errorf("setup after restore = %v, want the caller's pointer", entries[0].Setup)
+	}
+}
+
+func TestSealEntriesWithoutCipher(t *testing.T) {
+	entry := &entity.TradingJournalEntry{Notes: "clean trade"}
+
+	sealEntries(nil, entry)()
+
+	if entry.Notes != "clean trade" {
+		t.Errorf("notes = %q, want them untouched", entry.Notes)
+	}
+}
+
+func TestOpenEntries(t *testing.T) {
+	cipher := newTestCipher(t)
+
+	t.Run("encrypted and plaintext", func(t *testing.T) {
+		setup := cipher.Encrypt("London breakout")
+		legacySetup := "Asia range"
+		entries := []*entity.TradingJournalEntry{
+			{ID: uuid.New(), Notes: cipher.Encrypt("moved stop too early"), Setup: &setup},
+			{ID: uuid.New(), Notes: "written before encryption", Setup: &legacySetup},
+		}
+
+		if err := openEntries(cipher, entries...); err != nil {
+			t.Fatalf("openEntries() error = %v", err)
+		}
+
+		if entries[0].Notes != "moved stop too early" || *entries[0].Setup != "London breakout" {
+			t.Errorf("encrypted entry opened to %q, %q", entries[0].Notes, *entries[0].Setup)
+		}
+		if entries[1].Notes != "written before encryption" || *entries[1].Setup != "Asia range" {
+			t.Errorf("plaintext entry opened to %q, %q", entries[1].Notes, *entries[1].Setup)
+		}
+	})
+
+	tests := []struct {
+		name  string
+		entry *entity.TradingJournalEntry
+		want  string
+	}{
+		{"tampered notes", &entity.TradingJournalEntry{ID: uuid.New(), Notes: "enc:v1:AAAA"}, "notes"},
+		{"tampered setup", &entity.TradingJournalEntry{ID: uuid.New(), Setup: func() *string { s := "enc:v1:AAAA"; return &s }()}, "setup"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := openEntries(cipher, tt.entry)
+			if !errors.Is(err, fieldcrypt.ErrMalformed) {
+				t.Fatalf("openEntries() error = %v, want %v", err, fieldcrypt.ErrMalformed)
+			}
+			if !strings.Contains(err.Error(), tt.want+" of entry "+tt.entry.ID.String()) {
+				t.Errorf("error %q does not name the %s of the entry", err, tt.want)
+			}
+		})
+	}
+}
+
+func TestEntryWritesStoreCiphertext(t *testing.T) {
+	const notes = "stopped out by the NFP spike"
+	setup := "London breakout"
+
+	tests := []struct {
+		name  string
+		write func(db *bun.DB, cipher *fieldcrypt.Cipher, entry *entity.TradingJournalEntry)
+	}{
+		{"create", func(db *bun.DB, cipher *fieldcrypt.Cipher, entry *entity.TradingJournalEntry) {
+			_ = NewTradingJournalEntryStorage(db).WithCipher(cipher).Create(context.Background(), entry)
+		}},
+		{"add to journal", func(db *bun.DB, cipher *fieldcrypt.Cipher, entry *entity.TradingJournalEntry) {
+			_ = NewTradingJournalStorage(db).WithCipher(cipher).AddEntries(context.Background(), entry.JournalID, []*entity.TradingJournalEntry{entry})
+		}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			log, db := newFakeDB()
+			entry := &entity.TradingJournalEntry{ID: uuid.New(), JournalID: uuid.New(), Notes: notes, Setup: &setup}
+
+			tt.write(db, newTestCipher(t), entry)
+
+			queries := log.Queries()
+			if len(queries) == 0 {
+				t.Fatal("sent no queries")
+			}
+			if strings.Contains(queries[0], notes) || strings.Contains(queries[0], setup) {
+				t.Errorf("insert %q contains plaintext", queries[0])
+			}
+			if strings.Count(queries[0], "enc:v1:") != 2 {
+				t.Errorf("insert %q does not encrypt both notes and setup", queries[0])
+			}
+			if entry.Notes != notes || entry.Setup != &setup {
+				t.Errorf("entry after write = %q, %v, want the plaintext back", entry.Notes, entry.Setup)
+			}
+		})
+	}
+}
@@ -0,0 +1,96 @@
+package bun
+
+import (
+	"context"
+
+	"github.com/cockroachdb/errors"
+	"github.com/google/uuid"
+	"github.com/uptrace/bun"
+	"github.com/user/normark/internal/entity"
+	"github.com/user/normark/internal/types"
+)
+
+type EntryExitStorage struct {
+	db *bun.DB
+}
+
+func NewEntryExitStorage(db *bun.DB) *EntryExitStorage {
+	return &EntryExitStorage{
+		db: db,
+	}
+}
+
+// Create inserts the exit and, in the same transaction, sets the entry's
+// realized to the sum of all its exits and moves the journal summary by the
+// difference.
+func (s *EntryExitStorage) Create(ctx context.Context, exit *entity.EntryExit) error {
+	err := s.db.RunInTx(ctx, nil, func(ctx context.Context, tx bun.Tx) error {
+		old, err := lockSummaryEntry(ctx, tx, exit.EntryID)
+		if err != nil {
+			return errors.Wrap(err, "failed to get entry")
+		}
+
+		if _, err := tx.NewInsert().Model(exit).Exec(ctx); err != nil {
+			return errors.Wrap(err, "failed to create entry exit")
+		}
+
+		var realized float64
+		err = tx.NewUpdate().
+			Model((*entity.TradingJournalEntry)(nil)).
+			Set("realized = (SELECT SUM(ee.realized) FROM entry_exits AS ee WHERE ee.entry_id = ?)", exit.EntryID).
+			Where("id = ?", exit.EntryID).
+			Returning("realized").
+			Scan(ctx, &realized)
+		if err != nil {
+			return errors.Wrap(err, "failed to update entry realized")
+		}
+
+		if !old.DeletedAt.IsZero() {
+			return nil
+		}
+
+		delta := summaryDelta{realized: types.CentsFromFloat(realized) - types.CentsFromFloat(old.Realized)}
+		return applySummaryDelta(ctx, tx, old.JournalID, delta)
+	})
+
+	if err != nil {
+		return errors.Wrap(err, "failed to create entry exit")
+	}
+
+	return nil
+}
+
+// GetByEntryID returns the entry's exits in the order they were taken.
+func (s *EntryExitStorage) GetByEntryID(ctx context.Context, entryID uuid.UUID) ([]*entity.EntryExit, error) {
+	var exits []*entity.EntryExit
+
+	err := s.db.NewSelect().
+		Model(&exits).
+		Where("entry_id = ?", entryID).
+		Order("exited_at ASC", "id ASC").
+		Scan(ctx)
+
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get entry exits by entry id")
+	}
+
+	return exits, nil
+}
+
+// GetByEntryIDs returns the exits of all the entries, grouped by entry and
+// in the order they were taken within each.
+func (s *EntryExitStorage) GetByEntryIDs(ctx context.Context, entryIDs []uuid.UUID) ([]*entity.EntryExit, error) {
+	var exits []*entity.EntryExit
+
+	err := s.db.NewSelect().
+		Model(&exits).
+		Where("entry_id IN (?)", bun.In(entryIDs)).
+		Order("entry_id ASC", "exited_at ASC", "id ASC").
+		Scan(ctx)
+
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get entry exits by entry ids")
+	}
+
+	return exits, nil
+}
@@ -0,0 +1,51 @@
+package bun
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/google/uuid"
+)
+
+func TestEntryExitQueries(t *testing.T) {
+	entryID := uuid.New()
+	otherID := uuid.New()
+
+	tests := []struct {
+		name  string
+		query func(s *EntryExitStorage)
+		want  []string
+	}{
+		{
+			name:  "by entry",
+			query: func(s *EntryExitStorage) { _, _ = s.GetByEntryID(context.Background(), entryID) },
+			want:  []string{"entry_id = '" + entryID.String() + "'", `ORDER BY "exited_at" ASC, "id" ASC`},
+		},
+		{
+			name:  "by entries",
+			query: func(s *EntryExitStorage) { _, _ = s.GetByEntryIDs(context.Background(), []uuid.UUID{entryID, otherID}) },
+			want: []string{
+				"entry_id IN ('" + entryID.String() + "', '" + otherID.String() + "')",
+				`ORDER BY "entry_id" ASC, "exited_at" ASC, "id" ASC`,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			log, db := newFakeDB()
+			tt.query(NewEntryExitStorage(db))
+
+			queries := log.Queries()
+			if len(queries) != 1 {
+				t.Fatalf("sent %d queries, want 1", len(queries))
+			}
+			for _, want := range tt.want {
+				if !strings.Contains(queries[0], want) {
+					t.Errorf("query %q does not contain %q", queries[0], want)
+				}
+			}
+		})
+	}
+}
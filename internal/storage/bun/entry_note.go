@@ -0,0 +1,108 @@
+package bun
+
+import (
+	"context"
+
+	"github.com/cockroachdb/errors"
+	"github.com/google/uuid"
+	"github.com/uptrace/bun"
+	"github.com/user/normark/internal/entity"
+	"github.com/user/normark/pkg/fieldcrypt"
+)
+
+type EntryNoteStorage struct {
+	db     *bun.DB
+	cipher *fieldcrypt.Cipher
+}
+
+func NewEntryNoteStorage(db *bun.DB) *EntryNoteStorage {
+	return &EntryNoteStorage{
+		db: db,
+	}
+}
+
+// WithCipher encrypts note bodies at rest, like the entries' own notes.
+// Notes written without it stay readable.
+func (s *EntryNoteStorage) WithCipher(cipher *fieldcrypt.Cipher) *EntryNoteStorage {
+	s.cipher = cipher
+	return s
+}
+
+func (s *EntryNoteStorage) Create(ctx context.Context, note *entity.EntryNote) error {
+	body := note.Body
+	if s.cipher != nil {
+		note.Body = s.cipher.Encrypt(body)
+	}
+
+	_, err := s.db.NewInsert().
+		Model(note).
+		Exec(ctx)
+	note.Body = body
+
+	if err != nil {
+		return errors.Wrap(err, "failed to create entry note")
+	}
+
+	return nil
+}
+
+// GetByEntryID returns the entry's notes oldest first.
+func (s *EntryNoteStorage) GetByEntryID(ctx context.Context, entryID uuid.UUID) ([]*entity.EntryNote, error) {
+	var notes []*entity.EntryNote
+
+	err := s.db.NewSelect().
+		Model(&notes).
+		Where("entry_id = ?", entryID).
+		Order("created_at ASC", "id ASC").
+		Scan(ctx)
+
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get entry notes by entry id")
+	}
+
+	if err := s.open(notes); err != nil {
+		return nil, err
+	}
+
+	return notes, nil
+}
+
+// GetByEntryIDs returns the notes of all the entries, grouped by entry and
+// oldest first within each.
+func (s *EntryNoteStorage) GetByEntryIDs(ctx context.Context, entryIDs []uuid.UUID) ([]*entity.EntryNote, error) {
+	var notes []*entity.EntryNote
+
+	err := s.db.NewSelect().
+		Model(&notes).
+		Where("entry_id IN (?)", bun.In(entryIDs)).
+		Order("entry_id ASC", "created_at ASC", "id ASC").
+		Scan(ctx)
+
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get entry notes by entry ids")
+	}
+
+	if err := s.open(notes); err != nil {
+		return nil, err
+	}
+
+	return notes, nil
+}
+
+// open decrypts the notes' bodies in place. Bodies written while encryption
+// was off are plaintext and kept as they are.
+func (s *EntryNoteStorage) open(notes []*entity.EntryNote) error {
+	if s.cipher == nil {
+		return nil
+	}
+
+	for _, note := range notes {
+		body, err := s.cipher.Decrypt(note.Body)
+		if err != nil {
+			return errors.Wrapf(err, "failed to decrypt body of entry note %s", note.ID)
+		}
+		note.Body = body
+	}
+
+	return nil
+}
@@ -0,0 +1,118 @@
+package bun
+
+import (
+	"bytes"
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/user/normark/internal/entity"
+	"github.com/user/normark/pkg/fieldcrypt"
+)
+
+func newTestCipher(t *testing.T) *fieldcrypt.Cipher {
+	t.Helper()
+	c, err := fieldcrypt.New(bytes.Repeat([]byte{7}, fieldcrypt.KeySize))
+	if err != nil {
+		t.Fatalf("fieldcrypt.New() error = %v", err)
+	}
+	return c
+}
+
+func TestEntryNoteCreateStoresCiphertext(t *testing.T) {
+	const body = "stopped out by the NFP spike"
+	log, db := newFakeDB()
+	s := NewEntryNoteStorage(db).WithCipher(newTestCipher(t))
+
+	note := entity.NewEntryNote(uuid.New(), body)
+	_ = s.Create(context.Background(), note)
+
+	queries := log.Queries()
+	if len(queries) != 1 {
+		t.Fatalf("Create() sent %d queries, want 1", len(queries))
+	}
+	if strings.Contains(queries[0], body) {
+		t.Errorf("insert %q contains the plaintext body", queries[0])
+	}
+	if !strings.Contains(queries[0], "enc:v1:") {
+		t.Errorf("insert %q does not contain an encrypted body", queries[0])
+	}
+	if note.Body != body {
+		t.Errorf("note body after Create() = %q, want the plaintext back", note.Body)
+	}
+}
+
+func TestEntryNoteCreateWithoutCipherStoresPlaintext(t *testing.T) {
+	const body = "stopped out by the NFP spike"
+	log, db := newFakeDB()
+	s := NewEntryNoteStorage(db)
+
+	_ = s.Create(context.Background(), entity.NewEntryNote(uuid.New(), body))
+
+	if queries := log.Queries(); len(queries) != 1 || !strings.Contains(queries[0], body) {
+		t.Errorf("Create() queries = %q, want one insert of the plaintext body", queries)
+	}
+}
+
+func TestEntryNoteOpenDecryptsBodies(t *testing.T) {
+	cipher := newTestCipher(t)
+	_, db := newFakeDB()
+	s := NewEntryNoteStorage(db).WithCipher(cipher)
+
+	notes := []*entity.EntryNote{
+		{ID: uuid.New(), Body: cipher.Encrypt("encrypted note")},
+		{ID: uuid.New(), Body: "note written before encryption"},
+	}
+	if err := s.open(notes); err != nil {
+		t.Fatalf("open() error = %v", err)
+	}
+
+	if notes[0].Body != "encrypted note" {
+		t.Errorf("encrypted body opened to %q", notes[0].Body)
+	}
+	if notes[1].Body != "note written before encryption" {
+		t.Errorf("plaintext body opened to %q", notes[1].Body)
+	}
+}
+
+func TestEntryNotesListChronologically(t *testing.T) {
+	log, db := newFakeDB()
+	entryID := uuid.New()
+
+	_, _ = NewEntryNoteStorage(db).GetByEntryID(context.Background(), entryID)
+
+	queries := log.Queries()
+	if len(queries) != 1 {
+		t.Fatalf("sent %d queries, want 1", len(queries))
+	}
+	for _, want := range []string{
+		"entry_id = '" + entryID.String() + "'",
+		`ORDER BY "created_at" ASC, "id" ASC`,
+	} {
+		if !strings.Contains(queries[0], want) {
+			t.Errorf("query %q does not contain %q", queries[0], want)
+		}
+	}
+}
+
+func TestEntryNotesOfEntriesGroupByEntry(t *testing.T) {
+	log, db := newFakeDB()
+	entryID := uuid.New()
+	otherID := uuid.New()
+
+	_, _ = NewEntryNoteStorage(db).GetByEntryIDs(context.Background(), []uuid.UUID{entryID, otherID})
+
+	queries := log.Queries()
+	if len(queries) != 1 {
+		t.Fatalf("sent %d queries, want 1", len(queries))
+	}
+	for _, want := range []string{
+		"entry_id IN ('" + entryID.String() + "', '" + otherID.String() + "')",
+		`ORDER BY "entry_id" ASC, "created_at" ASC, "id" ASC`,
+	} {
+		if !strings.Contains(queries[0], want) {
+			t.Errorf("query %q does not contain %q", queries[0], want)
+		}
+	}
+}
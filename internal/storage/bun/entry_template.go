@@ -0,0 +1,105 @@
+package bun
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/cockroachdb/errors"
+	"github.com/google/uuid"
+	"github.com/uptrace/bun"
+	"github.com/user/normark/internal/entity"
+)
+
+type EntryTemplateStorage struct {
+	db *bun.DB
+}
+
+func NewEntryTemplateStorage(db *bun.DB) *EntryTemplateStorage {
+	return &EntryTemplateStorage{
+		db: db,
+	}
+}
+
+func (s *EntryTemplateStorage) Create(ctx context.Context, template *entity.EntryTemplate) error {
+	_, err := s.db.NewInsert().
+		Model(template).
+		Exec(ctx)
+
+	if err != nil {
+		return errors.Wrap(err, "failed to create entry template")
+	}
+
+	return nil
+}
+
+// GetByID returns the template if it belongs to the journal.
+func (s *EntryTemplateStorage) GetByID(ctx context.Context, id uuid.UUID, journalID uuid.UUID) (*entity.EntryTemplate, error) {
+	template := new(entity.EntryTemplate)
+
+	err := s.db.NewSelect().
+		Model(template).
+		Where("id = ? AND journal_id = ?", id, journalID).
+		Scan(ctx)
+
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, entity.ErrEntryTemplateNotFound
+		}
+		return nil, errors.Wrap(err, "failed to get entry template by id")
+	}
+
+	return template, nil
+}
+
+func (s *EntryTemplateStorage) GetByJournalID(ctx context.Context, journalID uuid.UUID, limit, offset int) ([]*entity.EntryTemplate, error) {
+	var templates []*entity.EntryTemplate
+
+	err := s.db.NewSelect().
+		Model(&templates).
+		Where("journal_id = ?", journalID).
+		Limit(limit).
+		Offset(offset).
+		Order("name ASC", "id ASC").
+		Scan(ctx)
+
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get entry templates by journal id")
+	}
+
+	return templates, nil
+}
+
+func (s *EntryTemplateStorage) CountByJournalID(ctx context.Context, journalID uuid.UUID) (int, error) {
+	count, err := s.db.NewSelect().
+		Model((*entity.EntryTemplate)(nil)).
+		Where("journal_id = ?", journalID).
+		Count(ctx)
+
+	if err != nil {
+		return 0, errors.Wrap(err, "failed to count entry templates by journal id")
+	}
+
+	return count, nil
+}
+
+func (s *EntryTemplateStorage) Delete(ctx context.Context, id uuid.UUID, journalID uuid.UUID) error {
+	result, err := s.db.NewDelete().
+		Model((*entity.EntryTemplate)(nil)).
+		Where("id = ? AND journal_id = ?", id, journalID).
+		Exec(ctx)
+
+	if err != nil {
+		return errors.Wrap(err, "failed to delete entry template")
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return errors.Wrap(err, "failed to get rows affected")
+	}
+
+	if rowsAffected == 0 {
+		return entity.ErrEntryTemplateNotFound
+	}
+
+	return nil
+}
@@ -0,0 +1,74 @@
+package bun
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/cockroachdb/errors"
+	"github.com/google/uuid"
+	"github.com/user/normark/internal/entity"
+)
+
+func TestEntryTemplateQueriesScopedToJournal(t *testing.T) {
+	ctx := context.Background()
+	journalID := uuid.New()
+	templateID := uuid.New()
+
+	tests := []struct {
+		name  string
+		query func(s *EntryTemplateStorage)
+		want  []string
+	}{
+		{
+			name:  "by id",
+			query: func(s *EntryTemplateStorage) { _, _ = s.GetByID(ctx, templateID, journalID) },
+			want:  []string{"id = '" + templateID.String() + "' AND journal_id = '" + journalID.String() + "'", `"et"."deleted_at" IS NULL`},
+		},
+		{
+			name:  "of journal",
+			query: func(s *EntryTemplateStorage) { _, _ = s.GetByJournalID(ctx, journalID, 20, 40) },
+			want:  []string{"journal_id = '" + journalID.String() + "'", `ORDER BY "name" ASC, "id" ASC`, "LIMIT 20 OFFSET 40"},
+		},
+		{
+			name:  "count",
+			query: func(s *EntryTemplateStorage) { _, _ = s.CountByJournalID(ctx, journalID) },
+			want:  []string{"count(*)", "journal_id = '" + journalID.String() + "'", `"et"."deleted_at" IS NULL`},
+		},
+		{
+			name:  "delete is soft",
+			query: func(s *EntryTemplateStorage) { _ = s.Delete(ctx, templateID, journalID) },
+			want:  []string{`UPDATE "entry_templates"`, `SET "deleted_at" =`, "id = '" + templateID.String() + "' AND journal_id = '" + journalID.String() + "'"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			log, db := newFakeDB()
+			tt.query(NewEntryTemplateStorage(db))
+
+			queries := log.Queries()
+			if len(queries) != 1 {
+				t.Fatalf("sent %d queries, want 1", len(queries))
+			}
+			for _, want := range tt.want {
+				if !strings.Contains(queries[0], want) {
+					t.Errorf("query %q does not contain %q", queries[0], want)
+				}
+			}
+		})
+	}
+}
+
+func TestEntryTemplateOfAnotherJournalNotFound(t *testing.T) {
+	ctx := context.Background()
+	_, db := newEmptyDB()
+	s := NewEntryTemplateStorage(db)
+
+	if _, err := s.GetByID(ctx, uuid.New(), uuid.New()); !errors.Is(err, entity.ErrEntryTemplateNotFound) {
+		t.Errorf("GetByID() error = %v, want %v", err, entity.ErrEntryTemplateNotFound)
+	}
+	if err := s.Delete(ctx, uuid.New(), uuid.New()); !errors.Is(err, entity.ErrEntryTemplateNotFound) {
+		t.Errorf("Delete() error = %v, want %v", err, entity.ErrEntryTemplateNotFound)
+	}
+}
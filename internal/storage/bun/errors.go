@@ -0,0 +1,25 @@
+package bun
+
+import (
+	"github.com/cockroachdb/errors"
+	"github.com/uptrace/bun/driver/pgdriver"
+)
+
+// pgUniqueViolation is the SQLSTATE for unique_violation.
+const pgUniqueViolation = "23505"
+
+// pgError is the part of pgdriver.Error used to read the SQLSTATE.
+type pgError interface {
+	Field(k byte) string
+}
+
+var _ pgError = pgdriver.Error{}
+
+func isUniqueViolation(err error) bool {
+	var pgErr pgError
+	if errors.As(err, &pgErr) {
+		return pgErr.Field('C') == pgUniqueViolation
+	}
+
+	return false
+}
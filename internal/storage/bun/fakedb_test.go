@@ -0,0 +1,107 @@
+package bun
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"io"
+	"sync"
+
+	"github.com/uptrace/bun"
+	"github.com/uptrace/bun/dialect/pgdialect"
+)
+
+// errFakeDB is what statements sent to a fakeDB fail with by default.
+var errFakeDB = errors.New("fake db")
+
+// fakeDB is a connection that records the SQL it is sent and fails every
+// statement, for tests that only care about which connection a query used
+// and what it said.
+type fakeDB struct {
+	mu      sync.Mutex
+	queries []string
+	// empty makes statements succeed without matching any row instead.
+	empty bool
+	// err, if set, is what statements fail with instead of errFakeDB.
+	err error
+}
+
+func newFakeDB() (*fakeDB, *bun.DB) {
+	f := &fakeDB{}
+	return f, bun.NewDB(sql.OpenDB(f), pgdialect.New())
+}
+
+// newFailingDB returns a fakeDB whose statements fail with err.
+func newFailingDB(err error) (*fakeDB, *bun.DB) {
+	f := &fakeDB{err: err}
+	return f, bun.NewDB(sql.OpenDB(f), pgdialect.New())
+}
+
+// newEmptyDB returns a fakeDB whose queries return no rows and whose
+// statements affect none, as if every row looked for were missing.
+func newEmptyDB() (*fakeDB, *bun.DB) {
+	f := &fakeDB{empty: true}
+	return f, bun.NewDB(sql.OpenDB(f), pgdialect.New())
+}
+
+func (f *fakeDB) Queries() []string {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return append([]string(nil), f.queries...)
+}
+
+func (f *fakeDB) record(query string) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.queries = append(f.queries, query)
+}
+
+func (f *fakeDB) fail() error {
+	if f.err != nil {
+		return f.err
+	}
+	return errFakeDB
+}
+
+func (f *fakeDB) Connect(context.Context) (driver.Conn, error) { return fakeConn{f}, nil }
+func (f *fakeDB) Driver() driver.Driver                        { return nil }
+
+type fakeConn struct{ db *fakeDB }
+
+func (c fakeConn) Prepare(query string) (driver.Stmt, error) {
+	c.db.record(query)
+	return nil, c.db.fail()
+}
+
+func (c fakeConn) QueryContext(_ context.Context, query string, _ []driver.NamedValue) (driver.Rows, error) {
+	c.db.record(query)
+	if c.db.empty {
+		return emptyRows{}, nil
+	}
+	return nil, c.db.fail()
+}
+
+func (c fakeConn) ExecContext(_ context.Context, query string, _ []driver.NamedValue) (driver.Result, error) {
+	c.db.record(query)
+	if c.db.empty {
+		return driver.RowsAffected(0), nil
+	}
+	return nil, c.db.fail()
+}
+
+func (c fakeConn) Close() error              { return nil }
+func (c fakeConn) Begin() (driver.Tx, error) { return fakeTx{}, nil }
+
+// fakeTx lets transactions start so the statements inside them are
+// recorded; those statements still fail or match nothing as usual.
+type fakeTx struct{}
+
+func (fakeTx) Commit() error   { return nil }
+func (fakeTx) Rollback() error { return nil }
+
+type emptyRows struct{}
+
+func (emptyRows) Columns() []string         { return nil }
+func (emptyRows) Close() error              { return nil }
+func (emptyRows) Next([]driver.Value) error { return io.EOF }
@@ -0,0 +1,136 @@
+package bun
+
+import (
+	"context"
+	"time"
+
+	"github.com/cockroachdb/errors"
+	"github.com/google/uuid"
+	"github.com/uptrace/bun"
+	"github.com/user/normark/internal/entity"
+	"github.com/user/normark/internal/types"
+)
+
+// summaryDelta is the change one entry write makes to its journal's summary.
+// Realized is kept in cents so repeated adjustments don't drift.
+type summaryDelta struct {
+	trades   int
+	wins     int
+	realized types.Cents
+}
+
+// entryContribution is what a live entry adds to its journal's summary.
+func entryContribution(result types.TradeResult, realized float64) summaryDelta {
+	d := summaryDelta{trades: 1, realized: types.CentsFromFloat(realized)}
+	if result == types.TradeResultTakeProfit {
+		d.wins = 1
+	}
+	return d
+}
+
+func (d summaryDelta) plus(o summaryDelta) summaryDelta {
+	return summaryDelta{
+		trades:   d.trades + o.trades,
+		wins:     d.wins + o.wins,
+		realized: d.realized + o.realized,
+	}
+}
+
+func (d summaryDelta) minus(o summaryDelta) summaryDelta {
+	return summaryDelta{
+		trades:   d.trades - o.trades,
+		wins:     d.wins - o.wins,
+		realized: d.realized - o.realized,
+	}
+}
+
+// summaryEntry is the part of an entry row that feeds the summary.
+type summaryEntry struct {
+	JournalID uuid.UUID         `bun:"journal_id"`
+	Result    types.TradeResult `bun:"result"`
+	Realized  float64           `bun:"realized"`
+	DeletedAt time.Time         `bun:"deleted_at,nullzero"`
+}
+
+func (e *summaryEntry) contribution() summaryDelta {
+	return entryContribution(e.Result, e.Realized)
+}
+
+// lockSummaryEntry reads and row-locks the entry, including a soft-deleted
+// one, so its old contribution can be taken back before it changes.
+func lockSummaryEntry(ctx context.Context, db bun.IDB, id uuid.UUID) (*summaryEntry, error) {
+	entry := new(summaryEntry)
+
+	err := db.NewSelect().
+		Model((*entity.TradingJournalEntry)(nil)).
+		Column("journal_id", "result", "realized", "deleted_at").
+		WhereAllWithDeleted().
+		Where("id = ?", id).
+		For("UPDATE").
+		Scan(ctx, entry)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return entry, nil
+}
+
+// applySummaryDelta adds d to the journal's summary, creating the row if the
+// journal doesn't have one yet.
+func applySummaryDelta(ctx context.Context, db bun.IDB, journalID uuid.UUID, d summaryDelta) error {
+	if d == (summaryDelta{}) {
+		return nil
+	}
+
+	summary := &entity.JournalSummary{
+		JournalID:   journalID,
+		TotalTrades: d.trades,
+		Wins:        d.wins,
+		NetRealized: d.realized.Float64(),
+	}
+
+	_, err := db.NewInsert().
+		Model(summary).
+		On("CONFLICT (journal_id) DO UPDATE").
+		Set("total_trades = js.total_trades + EXCLUDED.total_trades").
+		Set("wins = js.wins + EXCLUDED.wins").
+		Set("net_realized = js.net_realized + EXCLUDED.net_realized").
+		Set("computed_at = CURRENT_TIMESTAMP").
+		Exec(ctx)
+
+	if err != nil {
+		return errors.Wrap(err, "failed to update journal summary")
+	}
+
+	return nil
+}
+
+// recomputeSummary rebuilds the journal's summary from its live entries.
+func recomputeSummary(ctx context.Context, db bun.IDB, journalID uuid.UUID) (*entity.JournalSummary, error) {
+	totals := db.NewSelect().
+		Model((*entity.TradingJournalEntry)(nil)).
+		ColumnExpr("?::uuid", journalID).
+		ColumnExpr("COUNT(*)").
+		ColumnExpr("COUNT(*) FILTER (WHERE result = ?)", types.TradeResultTakeProfit).
+		ColumnExpr("COALESCE(SUM(realized), 0)").
+		ColumnExpr("CURRENT_TIMESTAMP").
+		Where("journal_id = ?", journalID)
+
+	summary := new(entity.JournalSummary)
+
+	err := db.NewRaw(
+		"INSERT INTO journal_summaries AS js (journal_id, total_trades, wins, net_realized, computed_at) (?) "+
+			"ON CONFLICT (journal_id) DO UPDATE SET "+
+			"total_trades = EXCLUDED.total_trades, wins = EXCLUDED.wins, "+
+			"net_realized = EXCLUDED.net_realized, computed_at = EXCLUDED.computed_at "+
+			"RETURNING *",
+		totals,
+	).Scan(ctx, summary)
+
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to recompute journal summary")
+	}
+
+	return summary, nil
+}
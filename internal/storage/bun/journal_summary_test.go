@@ -0,0 +1,173 @@
+package bun
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/user/normark/internal/types"
+)
+
+func TestEntryContribution(t *testing.T) {
+	tests := []struct {
+		name     string
+		result   types.TradeResult
+		realized float64
+		want     summaryDelta
+	}{
+		{"win", types.TradeResultTakeProfit, 150.25, summaryDelta{trades: 1, wins: 1, realized: 15025}},
+		{"loss", types.TradeResultStopLoss, -99.99, summaryDelta{trades: 1, realized: -9999}},
+		{"break even", types.TradeResultBreakEven, 0, summaryDelta{trades: 1}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := entryContribution(tt.result, tt.realized); got != tt.want {
+				t.Errorf("entryContribution() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSummaryDeltaSwapsContributions(t *testing.T) {
+	// Editing an entry from a 0.10 loss to a 0.20 win takes back the old
+	// contribution and adds the new one, leaving the trade count alone.
+	old := entryContribution(types.TradeResultStopLoss, -0.1)
+	updated := entryContribution(types.TradeResultTakeProfit, 0.2)
+
+	got := updated.minus(old)
+	want := summaryDelta{wins: 1, realized: 30}
+	if got != want {
+		t.Errorf("delta = %+v, want %+v", got, want)
+	}
+
+	// Summed in cents, ten 0.10 entries add up to exactly 1.00.
+	var total summaryDelta
+	for range 10 {
+		total = total.plus(entryContribution(types.TradeResultTakeProfit, 0.1))
+	}
+	if total.realized != 100 || total.trades != 10 || total.wins != 10 {
+		t.Errorf("total = %+v, want 10 trades, 10 wins and 100 cents", total)
+	}
+}
+
+func TestApplySummaryDelta(t *testing.T) {
+	journalID := uuid.New()
+
+	t.Run("zero delta", func(t *testing.T) {
+		log, db := newFakeDB()
+		if err := applySummaryDelta(context.Background(), db, journalID, summaryDelta{}); err != nil {
+			t.Fatalf("applySummaryDelta() error = %v", err)
+		}
+		if queries := log.Queries(); len(queries) != 0 {
+			t.Errorf("applySummaryDelta() sent %q, want nothing", queries)
+		}
+	})
+
+	t.Run("upsert", func(t *testing.T) {
+		log, db := newFakeDB()
+		_ = applySummaryDelta(context.Background(), db, journalID, summaryDelta{trades: 1, wins: 1, realized: 1050})
+
+		queries := log.Queries()
+		if len(queries) != 1 {
+			t.Fatalf("applySummaryDelta() sent %d queries, want 1", len(queries))
+		}
+		for _, want := range []string{
+			"INSERT INTO \"journal_summaries\"",
+			journalID.String(),
+			"10.5",
+			"ON CONFLICT (journal_id) DO UPDATE",
+			"total_trades = js.total_trades + EXCLUDED.total_trades",
+			"net_realized = js.net_realized + EXCLUDED.net_realized",
+		} {
+			if !strings.Contains(queries[0], want) {
+				t.Errorf("query %q does not contain %q", queries[0], want)
+			}
+		}
+	})
+}
+
+func TestRecomputeSummaryReplacesTotals(t *testing.T) {
+	journalID := uuid.New()
+	log, db := newFakeDB()
+
+	if _, err := recomputeSummary(context.Background(), db, journalID); err == nil {
+		t.Fatal("recomputeSummary() error = nil, want the fake db error")
+	}
+
+	queries := log.Queries()
+	if len(queries) != 1 {
+		t.Fatalf("recomputeSummary() sent %d queries, want 1", len(queries))
+	}
+	for _, want := range []string{
+		"INSERT INTO journal_summaries",
+		"FILTER (WHERE result = 'TP')",
+		"journal_id = '" + journalID.String() + "'",
+		"total_trades = EXCLUDED.total_trades",
+		"deleted_at\" IS NULL",
+	} {
+		if !strings.Contains(queries[0], want) {
+			t.Errorf("query %q does not contain %q", queries[0], want)
+		}
+	}
+}
+
+// An entry's edits, deletion and restore each apply the delta the storage
+// computes; the running total must end where a full recompute over the
+// surviving entries would.
+func TestIncrementalSummaryMatchesRecompute(t *testing.T) {
+	type entry struct {
+		result   types.TradeResult
+		realized float64
+		live     bool
+	}
+	entries := map[int]*entry{}
+	var running summaryDelta
+
+	create := func(id int, result types.TradeResult, realized float64) {
+		entries[id] = &entry{result, realized, true}
+		running = running.plus(entryContribution(result, realized))
+	}
+	update := func(id int, result types.TradeResult, realized float64) {
+		e := entries[id]
+		old := entryContribution(e.result, e.realized)
+		e.result, e.realized = result, realized
+		running = running.plus(entryContribution(result, realized).minus(old))
+	}
+	remove := func(id int) {
+		e := entries[id]
+		e.live = false
+		running = running.minus(entryContribution(e.result, e.realized))
+	}
+	restore := func(id int) {
+		e := entries[id]
+		e.live = true
+		running = running.plus(entryContribution(e.result, e.realized))
+	}
+
+	for i := range 300 {
+		create(i, types.TradeResultTakeProfit, 0.10)
+	}
+	for i := 0; i < 300; i += 3 {
+		update(i, types.TradeResultStopLoss, -0.35)
+	}
+	for i := 1; i < 300; i += 5 {
+		remove(i)
+	}
+	for i := 1; i < 150; i += 10 {
+		restore(i)
+	}
+	update(2, types.TradeResultBreakEven, 0)
+
+	var recomputed summaryDelta
+	for _, e := range entries {
+		if e.live {
+			recomputed = recomputed.plus(entryContribution(e.result, e.realized))
+		}
+	}
+
+	if running != recomputed {
+		t.Errorf("incremental summary = %+v, recompute = %+v", running, recomputed)
+	}
+}
@@ -0,0 +1,117 @@
+package bun
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/cockroachdb/errors"
+	"github.com/google/uuid"
+	"github.com/uptrace/bun"
+	"github.com/user/normark/internal/entity"
+)
+
+type JournalTemplateStorage struct {
+	db *bun.DB
+}
+
+func NewJournalTemplateStorage(db *bun.DB) *JournalTemplateStorage {
+	return &JournalTemplateStorage{
+		db: db,
+	}
+}
+
+func (s *JournalTemplateStorage) Create(ctx context.Context, template *entity.JournalTemplate) error {
+	_, err := s.db.NewInsert().
+		Model(template).
+		Exec(ctx)
+
+	if err != nil {
+		return errors.Wrap(err, "failed to create journal template")
+	}
+
+	return nil
+}
+
+func (s *JournalTemplateStorage) GetByID(ctx context.Context, id uuid.UUID) (*entity.JournalTemplate, error) {
+	template := new(entity.JournalTemplate)
+
+	err := s.db.NewSelect().
+		Model(template).
+		Where("id = ?", id).
+		Scan(ctx)
+
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, errors.Wrap(entity.ErrNotFound, "journal template")
+		}
+		return nil, errors.Wrap(err, "failed to get journal template by id")
+	}
+
+	return template, nil
+}
+
+func (s *JournalTemplateStorage) GetByUserID(ctx context.Context, userID uuid.UUID, limit, offset int) ([]*entity.JournalTemplate, error) {
+	var templates []*entity.JournalTemplate
+
+	err := s.db.NewSelect().
+		Model(&templates).
+		Where("user_id = ?", userID).
+		Limit(limit).
+		Offset(offset).
+		Order("created_at DESC", "id DESC").
+		Scan(ctx)
+
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get journal templates by user id")
+	}
+
+	return templates, nil
+}
+
+func (s *JournalTemplateStorage) CountByUserID(ctx context.Context, userID uuid.UUID) (int, error) {
+	count, err := s.db.NewSelect().
+		Model((*entity.JournalTemplate)(nil)).
+		Where("user_id = ?", userID).
+		Count(ctx)
+
+	if err != nil {
+		return 0, errors.Wrap(err, "failed to count journal templates by user id")
+	}
+
+	return count, nil
+}
+
+func (s *JournalTemplateStorage) Delete(ctx context.Context, id uuid.UUID) error {
+	result, err := s.db.NewDelete().
+		Model((*entity.JournalTemplate)(nil)).
+		Where("id = ?", id).
+		Exec(ctx)
+
+	if err != nil {
+		return errors.Wrap(err, "failed to delete journal template")
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return errors.Wrap(err, "failed to get rows affected")
+	}
+
+	if rowsAffected == 0 {
+		return errors.Wrap(entity.ErrNotFound, "journal template")
+	}
+
+	return nil
+}
+
+func (s *JournalTemplateStorage) Exists(ctx context.Context, id uuid.UUID, userID uuid.UUID) (bool, error) {
+	count, err := s.db.NewSelect().
+		Model((*entity.JournalTemplate)(nil)).
+		Where("id = ? AND user_id = ?", id, userID).
+		Count(ctx)
+
+	if err != nil {
+		return false, errors.Wrap(err, "failed to check if journal template exists")
+	}
+
+	return count > 0, nil
+}
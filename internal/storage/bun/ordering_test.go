@@ -0,0 +1,91 @@
+package bun
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/uptrace/bun"
+	"github.com/user/normark/internal/types"
+)
+
+// TestListQueriesBreakTiesByID checks that every paged list orders by id
+// after its non-unique sort column, so rows sharing a day or timestamp keep
+// their order across pages instead of repeating or going missing.
+func TestListQueriesBreakTiesByID(t *testing.T) {
+	ctx := context.Background()
+	journalID := uuid.New()
+	userID := uuid.New()
+
+	tests := []struct {
+		name  string
+		query func(db *bun.DB)
+		want  string
+	}{
+		{"entries of journal", func(db *bun.DB) {
+			_, _ = NewTradingJournalEntryStorage(db).GetByJournalID(ctx, GetByJournalIDParams{JournalID: journalID, Limit: 20})
+		}, `ORDER BY "day" DESC, "id" DESC`},
+		{"entries by date range", func(db *bun.DB) {
+			_, _ = NewTradingJournalEntryStorage(db).GetByDateRange(ctx, GetByDateRangeParams{JournalID: journalID, Limit: 20})
+		}, `ORDER BY "day" DESC, "id" DESC`},
+		{"entries by asset", func(db *bun.DB) {
+			_, _ = NewTradingJournalEntryStorage(db).GetByAsset(ctx, GetByAssetParams{JournalID: journalID, Asset: types.CurrencyPairEURUSD, Limit: 20})
+		}, `ORDER BY "day" DESC, "id" DESC`},
+		{"entries by session", func(db *bun.DB) {
+			_, _ = NewTradingJournalEntryStorage(db).GetBySession(ctx, GetBySessionParams{JournalID: journalID, Session: types.TradingSessionLondon, Limit: 20})
+		}, `ORDER BY "day" DESC, "id" DESC`},
+		{"entries by result", func(db *bun.DB) {
+			_, _ = NewTradingJournalEntryStorage(db).GetByResult(ctx, GetByResultParams{JournalID: journalID, Result: types.TradeResultTakeProfit, Limit: 20})
+		}, `ORDER BY "day" DESC, "id" DESC`},
+		{"entries by trade type", func(db *bun.DB) {
+			_, _ = NewTradingJournalEntryStorage(db).GetByTradeType(ctx, GetByTradeTypeParams{JournalID: journalID, TradeType: types.TradeTypeIntraday, Limit: 20})
+		}, `ORDER BY "day" DESC, "id" DESC`},
+		{"filtered entries", func(db *bun.DB) {
+			_, _ = NewTradingJournalEntryStorage(db).Filter(ctx, FilterParams{JournalID: journalID, Limit: 20})
+		}, `"day" DESC, "id" DESC`},
+		{"all entries", func(db *bun.DB) {
+			_, _ = NewTradingJournalEntryStorage(db).List(ctx, 20, 0)
+		}, `ORDER BY "day" DESC, "id" DESC`},
+		{"last deleted entry", func(db *bun.DB) {
+			_, _ = NewTradingJournalEntryStorage(db).RestoreLastDeleted(ctx, journalID, time.Now().Add(-time.Hour))
+		}, `ORDER BY deleted_at DESC, id DESC`},
+		{"user journal statistics", func(db *bun.DB) {
+			_, _ = NewTradingJournalEntryStorage(db).GetUserJournalStatistics(ctx, userID)
+		}, `ORDER BY tj.created_at DESC, tj.id DESC`},
+		{"user journals", func(db *bun.DB) {
+			_, _ = NewTradingJournalStorage(db).GetByUserID(ctx, userID, 20, 0, false, "")
+		}, `"created_at" DESC, "id" DESC`},
+		{"all journals", func(db *bun.DB) {
+			_, _ = NewTradingJournalStorage(db).List(ctx, 20, 0)
+		}, `ORDER BY "created_at" DESC, "id" DESC`},
+		{"oldest journal by name", func(db *bun.DB) {
+			_, _ = NewTradingJournalStorage(db).GetByName(ctx, userID, "Swing")
+		}, `ORDER BY "created_at" ASC, "id" ASC`},
+		{"journal templates", func(db *bun.DB) {
+			_, _ = NewJournalTemplateStorage(db).GetByUserID(ctx, userID, 20, 0)
+		}, `ORDER BY "created_at" DESC, "id" DESC`},
+		{"active sessions", func(db *bun.DB) {
+			_, _ = NewSessionStorage(db).GetActiveByUserID(ctx, userID)
+		}, `ORDER BY "last_used_at" DESC, "id" DESC`},
+		{"users", func(db *bun.DB) {
+			_, _ = NewUserStorage(db).List(ctx, 20, 0)
+		}, `ORDER BY "created_at" DESC, "id" DESC`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			log, db := newFakeDB()
+			tt.query(db)
+
+			queries := log.Queries()
+			if len(queries) == 0 {
+				t.Fatal("sent no queries")
+			}
+			if !strings.Contains(queries[0], tt.want) {
+				t.Errorf("query %q does not contain %q", queries[0], tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,29 @@
+package bun
+
+import (
+	"context"
+
+	"github.com/uptrace/bun"
+)
+
+type replicaReadsKey struct{}
+
+// WithReplicaReads lets list and statistics queries made with ctx go to the
+// read replica. Reads without it stay on the primary, so callers that
+// write what they read, or read what they just wrote, never see a lagging
+// replica.
+func WithReplicaReads(ctx context.Context) context.Context {
+	return context.WithValue(ctx, replicaReadsKey{}, true)
+}
+
+// readerFor returns replica if one is configured and ctx allows replica
+// reads, the primary otherwise.
+func readerFor(ctx context.Context, primary, replica *bun.DB) *bun.DB {
+	if replica == nil {
+		return primary
+	}
+	if allowed, _ := ctx.Value(replicaReadsKey{}).(bool); !allowed {
+		return primary
+	}
+	return replica
+}
@@ -0,0 +1,91 @@
+package bun
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/google/uuid"
+)
+
+func TestReaderForRoutesOnlyOptedInReadsToReplica(t *testing.T) {
+	_, primary := newFakeDB()
+	_, replica := newFakeDB()
+
+	tests := []struct {
+		name    string
+		ctx     context.Context
+		replica bool
+		want    string
+	}{
+		{"no replica", context.Background(), false, "primary"},
+		{"no replica, opted in", WithReplicaReads(context.Background()), false, "primary"},
+		{"replica, not opted in", context.Background(), true, "primary"},
+		{"replica, opted in", WithReplicaReads(context.Background()), true, "replica"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			configured := replica
+			if !tt.replica {
+				configured = nil
+			}
+
+			got := "primary"
+			if readerFor(tt.ctx, primary, configured) == replica {
+				got = "replica"
+			}
+			if got != tt.want {
+				t.Errorf("readerFor() = %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestEntryStorageKeepsLookupsOnPrimary(t *testing.T) {
+	primaryLog, primary := newFakeDB()
+	replicaLog, replica := newFakeDB()
+	s := NewTradingJournalEntryStorage(primary).WithReplica(replica)
+	ctx := WithReplicaReads(context.Background())
+
+	_, _ = s.Filter(ctx, FilterParams{JournalID: uuid.New(), Limit: 10})
+	if len(replicaLog.Queries()) != 1 || len(primaryLog.Queries()) != 0 {
+		t.Fatalf("Filter: primary got %d queries, replica %d; want the replica only",
+			len(primaryLog.Queries()), len(replicaLog.Queries()))
+	}
+
+	_, _ = s.GetByID(ctx, uuid.New())
+	if len(replicaLog.Queries()) != 1 || len(primaryLog.Queries()) != 1 {
+		t.Fatalf("GetByID: primary got %d queries, replica %d; want the primary only",
+			len(primaryLog.Queries()), len(replicaLog.Queries())-1)
+	}
+}
+
+func TestEntryStorageListsOnPrimaryWithoutOptIn(t *testing.T) {
+	primaryLog, primary := newFakeDB()
+	replicaLog, replica := newFakeDB()
+	s := NewTradingJournalEntryStorage(primary).WithReplica(replica)
+
+	_, _ = s.Filter(context.Background(), FilterParams{JournalID: uuid.New(), Limit: 10})
+
+	if len(primaryLog.Queries()) != 1 || len(replicaLog.Queries()) != 0 {
+		t.Fatalf("primary got %d queries, replica %d; want the primary only",
+			len(primaryLog.Queries()), len(replicaLog.Queries()))
+	}
+}
+
+func TestJournalLockCheckStaysOnPrimary(t *testing.T) {
+	primaryLog, primary := newFakeDB()
+	replicaLog, replica := newFakeDB()
+	s := NewTradingJournalStorage(primary).WithReplica(replica)
+
+	_, _ = s.IsLocked(WithReplicaReads(context.Background()), uuid.New())
+
+	if len(primaryLog.Queries()) != 1 || len(replicaLog.Queries()) != 0 {
+		t.Fatalf("primary got %d queries, replica %d; want the primary only",
+			len(primaryLog.Queries()), len(replicaLog.Queries()))
+	}
+	if !strings.Contains(primaryLog.Queries()[0], `"is_locked"`) {
+		t.Errorf("query %q does not read the locked flag", primaryLog.Queries()[0])
+	}
+}
@@ -0,0 +1,126 @@
+package bun
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/cockroachdb/errors"
+	"github.com/google/uuid"
+	"github.com/uptrace/bun"
+	"github.com/user/normark/internal/entity"
+)
+
+type SessionStorage struct {
+	db *bun.DB
+}
+
+func NewSessionStorage(db *bun.DB) *SessionStorage {
+	return &SessionStorage{
+		db: db,
+	}
+}
+
+func (s *SessionStorage) Create(ctx context.Context, session *entity.Session) error {
+	_, err := s.db.NewInsert().
+		Model(session).
+		Exec(ctx)
+
+	if err != nil {
+		return errors.Wrap(err, "failed to create session")
+	}
+
+	return nil
+}
+
+func (s *SessionStorage) GetByID(ctx context.Context, id uuid.UUID) (*entity.Session, error) {
+	session := new(entity.Session)
+
+	err := s.db.NewSelect().
+		Model(session).
+		Where("id = ?", id).
+		Scan(ctx)
+
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, errors.Wrap(entity.ErrNotFound, "session")
+		}
+		return nil, errors.Wrap(err, "failed to get session by id")
+	}
+
+	return session, nil
+}
+
+// GetActiveByUserID returns the user's unexpired sessions, most recently
+// used first.
+func (s *SessionStorage) GetActiveByUserID(ctx context.Context, userID uuid.UUID) ([]*entity.Session, error) {
+	var sessions []*entity.Session
+
+	err := s.db.NewSelect().
+		Model(&sessions).
+		Where("user_id = ?", userID).
+		Where("expires_at > ?", time.Now().UTC()).
+		Order("last_used_at DESC", "id DESC").
+		Scan(ctx)
+
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get sessions by user id")
+	}
+
+	return sessions, nil
+}
+
+// GetByUserID returns all of the user's sessions, expired ones included,
+// oldest first.
+func (s *SessionStorage) GetByUserID(ctx context.Context, userID uuid.UUID) ([]*entity.Session, error) {
+	var sessions []*entity.Session
+
+	err := s.db.NewSelect().
+		Model(&sessions).
+		Where("user_id = ?", userID).
+		Order("created_at ASC", "id ASC").
+		Scan(ctx)
+
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get all sessions by user id")
+	}
+
+	return sessions, nil
+}
+
+func (s *SessionStorage) Touch(ctx context.Context, id uuid.UUID, lastUsedAt time.Time) error {
+	_, err := s.db.NewUpdate().
+		Model((*entity.Session)(nil)).
+		Set("last_used_at = ?", lastUsedAt).
+		Where("id = ?", id).
+		Exec(ctx)
+
+	if err != nil {
+		return errors.Wrap(err, "failed to update session last used time")
+	}
+
+	return nil
+}
+
+// Delete removes the session only if it belongs to the user.
+func (s *SessionStorage) Delete(ctx context.Context, id uuid.UUID, userID uuid.UUID) error {
+	result, err := s.db.NewDelete().
+		Model((*entity.Session)(nil)).
+		Where("id = ? AND user_id = ?", id, userID).
+		Exec(ctx)
+
+	if err != nil {
+		return errors.Wrap(err, "failed to delete session")
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return errors.Wrap(err, "failed to get rows affected")
+	}
+
+	if rowsAffected == 0 {
+		return entity.ErrSessionNotFound
+	}
+
+	return nil
+}
@@ -0,0 +1,32 @@
+package bun
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/google/uuid"
+)
+
+func TestSessionsOfUserIncludeExpired(t *testing.T) {
+	log, db := newFakeDB()
+	userID := uuid.New()
+
+	_, _ = NewSessionStorage(db).GetByUserID(context.Background(), userID)
+
+	queries := log.Queries()
+	if len(queries) != 1 {
+		t.Fatalf("sent %d queries, want 1", len(queries))
+	}
+	if strings.Contains(queries[0], "expires_at >") {
+		t.Errorf("query %q filters out expired sessions", queries[0])
+	}
+	for _, want := range []string{
+		"user_id = '" + userID.String() + "'",
+		`ORDER BY "created_at" ASC, "id" ASC`,
+	} {
+		if !strings.Contains(queries[0], want) {
+			t.Errorf("query %q does not contain %q", queries[0], want)
+		}
+	}
+}
@@ -0,0 +1,332 @@
+package bun
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+var statisticsColumns = []string{
+	"AS total_trades",
+	"COUNT(*) FILTER (WHERE result = 'TP') AS wins",
+	"COUNT(*) FILTER (WHERE result = 'SL') AS losses",
+	"COUNT(*) FILTER (WHERE result = 'BE') AS break_even",
+	"AS total_realized",
+	"AS avg_risk_reward",
+	"FILTER (WHERE risk_percent > 0), 0) AS avg_risk_percent",
+	"FILTER (WHERE realized > 0), 0) AS avg_win",
+	"FILTER (WHERE realized < 0)), 0) AS avg_loss",
+	"AS avg_planned_rr",
+	"AS avg_achieved_rr",
+	"MIN(day) AS range_start",
+	"MAX(day) AS range_end",
+}
+
+func TestStatisticsUseOneAggregateQuery(t *testing.T) {
+	journalID := uuid.New()
+	start := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	end := time.Date(2026, 1, 31, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name  string
+		call  func(s *TradingJournalEntryStorage)
+		where []string
+	}{
+		{
+			name: "all time",
+			call: func(s *TradingJournalEntryStorage) {
+				_, _ = s.GetStatistics(context.Background(), journalID)
+			},
+			where: []string{"journal_id = '" + journalID.String() + "'"},
+		},
+		{
+			name: "date range",
+			call: func(s *TradingJournalEntryStorage) {
+				_, _ = s.GetStatisticsByDateRange(context.Background(), GetByDateRangeParams{
+					JournalID: journalID,
+					StartDate: start,
+					EndDate:   end,
+				})
+			},
+			where: []string{"journal_id = '" + journalID.String() + "'", "day >= '2026-01-01", "day <= '2026-01-31"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			log, db := newFakeDB()
+			tt.call(NewTradingJournalEntryStorage(db))
+
+			queries := log.Queries()
+			if len(queries) != 1 {
+				t.Fatalf("sent %d queries, want 1", len(queries))
+			}
+			for _, want := range append(statisticsColumns, tt.where...) {
+				if !strings.Contains(queries[0], want) {
+					t.Errorf("query %q does not contain %q", queries[0], want)
+				}
+			}
+		})
+	}
+}
+
+func TestUserJournalStatisticsUseOneQuery(t *testing.T) {
+	userID := uuid.New()
+	log, db := newFakeDB()
+
+	_, _ = NewTradingJournalEntryStorage(db).GetUserJournalStatistics(context.Background(), userID)
+
+	queries := log.Queries()
+	if len(queries) != 1 {
+		t.Fatalf("sent %d queries, want 1", len(queries))
+	}
+	for _, want := range []string{
+		"LEFT JOIN trading_journal_entries AS tje ON tje.journal_id = tj.id AND tje.deleted_at IS NULL",
+		"tj.user_id = '" + userID.String() + "'",
+		"GROUP BY tj.id",
+		"AS total_trades",
+		"AS total_realized",
+	} {
+		if !strings.Contains(queries[0], want) {
+			t.Errorf("query %q does not contain %q", queries[0], want)
+		}
+	}
+}
+
+func TestDailyStatisticsGroupByLocalDay(t *testing.T) {
+	journalID := uuid.New()
+	tokyo, err := time.LoadLocation("Asia/Tokyo")
+	if err != nil {
+		t.Fatalf("load location: %v", err)
+	}
+
+	tests := []struct {
+		name  string
+		loc   *time.Location
+		where []string
+	}{
+		{
+			name:  "utc",
+			loc:   time.UTC,
+			where: []string{"day >= '2026-01-01 00:00:00", "day < '2027-01-01 00:00:00"},
+		},
+		{
+			name:  "year bounds follow the time zone",
+			loc:   tokyo,
+			where: []string{"day >= '2025-12-31 15:00:00", "day < '2026-12-31 15:00:00"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			log, db := newFakeDB()
+			_, _ = NewTradingJournalEntryStorage(db).GetDailyStatistics(context.Background(), journalID, 2026, tt.loc)
+
+			queries := log.Queries()
+			if len(queries) != 1 {
+				t.Fatalf("sent %d queries, want 1", len(queries))
+			}
+			day := "(day AT TIME ZONE 'UTC' AT TIME ZONE '" + tt.loc.String() + "')::date"
+			for _, want := range append([]string{
+				day + " AS day",
+				"COUNT(*) AS count",
+				"COALESCE(SUM(realized), 0) AS net_realized",
+				"journal_id = '" + journalID.String() + "'",
+				"GROUP BY " + day,
+				"ORDER BY " + day,
+			}, tt.where...) {
+				if !strings.Contains(queries[0], want) {
+					t.Errorf("query %q does not contain %q", queries[0], want)
+				}
+			}
+		})
+	}
+}
+
+func TestDailyAssetStatisticsGroupByLocalDayAndAsset(t *testing.T) {
+	journalID := uuid.New()
+	log, db := newFakeDB()
+
+	_, _ = NewTradingJournalEntryStorage(db).GetDailyAssetStatistics(context.Background(), journalID, time.UTC)
+
+	queries := log.Queries()
+	if len(queries) != 1 {
+		t.Fatalf("sent %d queries, want 1", len(queries))
+	}
+	day := "(day AT TIME ZONE 'UTC' AT TIME ZONE 'UTC')::date"
+	for _, want := range []string{
+		day + " AS day",
+		"COUNT(*) AS total_trades",
+		"COUNT(*) FILTER (WHERE result = 'TP') AS wins",
+		"journal_id = '" + journalID.String() + "'",
+		"GROUP BY " + day + ", asset",
+	} {
+		if !strings.Contains(queries[0], want) {
+			t.Errorf("query %q does not contain %q", queries[0], want)
+		}
+	}
+}
+
+func TestReviewProgressCountsEachStatus(t *testing.T) {
+	journalID := uuid.New()
+	log, db := newFakeDB()
+
+	_, _ = NewTradingJournalEntryStorage(db).GetReviewProgress(context.Background(), journalID)
+
+	queries := log.Queries()
+	if len(queries) != 1 {
+		t.Fatalf("sent %d queries, want 1", len(queries))
+	}
+	for _, want := range []string{
+		"COUNT(*) AS total",
+		"COUNT(*) FILTER (WHERE review_status = 'unreviewed') AS unreviewed",
+		"COUNT(*) FILTER (WHERE review_status = 'reviewed') AS reviewed",
+		"COUNT(*) FILTER (WHERE review_status = 'flagged') AS flagged",
+		"journal_id = '" + journalID.String() + "'",
+		`"tje"."deleted_at" IS NULL`,
+	} {
+		if !strings.Contains(queries[0], want) {
+			t.Errorf("query %q does not contain %q", queries[0], want)
+		}
+	}
+}
+
+func TestPlanAdherenceGroupsByFollowedPlan(t *testing.T) {
+	journalID := uuid.New()
+	log, db := newFakeDB()
+
+	_, _ = NewTradingJournalEntryStorage(db).GetStatisticsByPlanAdherence(context.Background(), journalID)
+
+	queries := log.Queries()
+	if len(queries) != 1 {
+		t.Fatalf("sent %d queries, want 1", len(queries))
+	}
+	for _, want := range []string{
+		`SELECT "tje"."followed_plan", COUNT(*) AS total_trades`,
+		"COUNT(*) FILTER (WHERE result = 'TP') AS wins",
+		"COUNT(*) FILTER (WHERE result = 'SL') AS losses",
+		"COUNT(*) FILTER (WHERE result = 'BE') AS break_even",
+		"COALESCE(SUM(realized), 0) AS total_realized",
+		"journal_id = '" + journalID.String() + "'",
+		`"tje"."deleted_at" IS NULL`,
+		`GROUP BY "followed_plan"`,
+	} {
+		if !strings.Contains(queries[0], want) {
+			t.Errorf("query %q does not contain %q", queries[0], want)
+		}
+	}
+}
+
+func TestFacetsGroupByEachColumn(t *testing.T) {
+	journalID := uuid.New()
+	log, db := newEmptyDB()
+
+	facets, err := NewTradingJournalEntryStorage(db).GetFacets(context.Background(), journalID)
+	if err != nil {
+		t.Fatalf("GetFacets() error = %v", err)
+	}
+	if len(facets.Assets)+len(facets.Sessions)+len(facets.Results)+len(facets.TradeTypes) != 0 {
+		t.Errorf("facets of a journal without entries = %+v, want none", facets)
+	}
+
+	columns := []string{"asset", "session", "result", "trade_type"}
+	queries := log.Queries()
+	if len(queries) != len(columns) {
+		t.Fatalf("sent %d queries, want %d", len(queries), len(columns))
+	}
+	for i, column := range columns {
+		for _, want := range []string{
+			`SELECT "` + column + `" AS value, COUNT(*) AS count`,
+			"journal_id = '" + journalID.String() + "'",
+			`"tje"."deleted_at" IS NULL`,
+			`GROUP BY "` + column + `"`,
+			"ORDER BY count DESC, value ASC",
+		} {
+			if !strings.Contains(queries[i], want) {
+				t.Errorf("query %q does not contain %q", queries[i], want)
+			}
+		}
+	}
+}
+
+func TestStatisticsAchievedRR(t *testing.T) {
+	log, db := newFakeDB()
+
+	_, _ = NewTradingJournalEntryStorage(db).GetStatistics(context.Background(), uuid.New())
+
+	queries := log.Queries()
+	if len(queries) != 1 {
+		t.Fatalf("sent %d queries, want 1", len(queries))
+	}
+	for _, want := range []string{
+		// Both averages cover only entries with entry, stop-loss and take-profit prices.
+		"COALESCE(AVG(" + plannedRRExpr + "), 0) AS avg_planned_rr",
+		"FILTER (WHERE " + plannedRRExpr + " IS NOT NULL), 0) AS avg_achieved_rr",
+		// Exits, weighted by size, take precedence over the result.
+		"(SELECT SUM(ee.price * ee.size) / SUM(ee.size) FROM entry_exits AS ee WHERE ee.entry_id = tje.id)",
+		"CASE tje.result WHEN 'TP' THEN " + plannedRRExpr + " WHEN 'SL' THEN -1 ELSE 0 END",
+	} {
+		if !strings.Contains(queries[0], want) {
+			t.Errorf("query %q does not contain %q", queries[0], want)
+		}
+	}
+}
+
+func TestStatisticsByConfidenceQuery(t *testing.T) {
+	journalID := uuid.New()
+	log, db := newFakeDB()
+
+	_, _ = NewTradingJournalEntryStorage(db).GetStatisticsByConfidence(context.Background(), journalID)
+
+	queries := log.Queries()
+	if len(queries) != 1 {
+		t.Fatalf("sent %d queries, want 1", len(queries))
+	}
+	for _, want := range []string{
+		`SELECT "tje"."confidence", COUNT(*) AS total_trades`,
+		"COUNT(*) FILTER (WHERE result = 'TP') AS wins",
+		"COUNT(*) FILTER (WHERE result = 'SL') AS losses",
+		"COUNT(*) FILTER (WHERE result = 'BE') AS break_even",
+		"COALESCE(SUM(realized), 0) AS total_realized",
+		"journal_id = '" + journalID.String() + "'",
+		"confidence IS NOT NULL",
+		`"tje"."deleted_at" IS NULL`,
+		`GROUP BY "confidence" ORDER BY "confidence"`,
+	} {
+		if !strings.Contains(queries[0], want) {
+			t.Errorf("query %q does not contain %q", queries[0], want)
+		}
+	}
+}
+
+func TestChecklistStatisticsUnnestItems(t *testing.T) {
+	journalID := uuid.New()
+	log, db := newFakeDB()
+
+	_, _ = NewTradingJournalEntryStorage(db).GetChecklistStatistics(context.Background(), journalID)
+
+	queries := log.Queries()
+	if len(queries) != 1 {
+		t.Fatalf("sent %d queries, want 1", len(queries))
+	}
+	for _, want := range []string{
+		"jsonb_array_elements(tje.checklist) AS ci",
+		"ci.value->>'item' AS item",
+		"COUNT(*) AS total_trades",
+		"COUNT(*) FILTER (WHERE (ci.value->>'checked')::boolean) AS checked",
+		"COUNT(*) FILTER (WHERE (ci.value->>'checked')::boolean AND result = 'TP') AS checked_wins",
+		"COUNT(*) FILTER (WHERE NOT (ci.value->>'checked')::boolean AND result = 'TP') AS unchecked_wins",
+		"journal_id = '" + journalID.String() + "'",
+		"checklist IS NOT NULL",
+		`"tje"."deleted_at" IS NULL`,
+		"GROUP BY ci.value->>'item'",
+		"ORDER BY total_trades DESC, item ASC",
+	} {
+		if !strings.Contains(queries[0], want) {
+			t.Errorf("query %q does not contain %q", queries[0], want)
+		}
+	}
+}
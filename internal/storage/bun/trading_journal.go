@@ -3,15 +3,19 @@ package bun
 import (
 	"context"
 	"database/sql"
+	"strings"
 
 	"github.com/cockroachdb/errors"
 	"github.com/google/uuid"
 	"github.com/uptrace/bun"
 	"github.com/user/normark/internal/entity"
+	"github.com/user/normark/pkg/fieldcrypt"
 )
 
 type TradingJournalStorage struct {
-	db *bun.DB
+	db      *bun.DB
+	replica *bun.DB
+	cipher  *fieldcrypt.Cipher
 }
 
 func NewTradingJournalStorage(db *bun.DB) *TradingJournalStorage {
@@ -20,10 +24,36 @@ func NewTradingJournalStorage(db *bun.DB) *TradingJournalStorage {
 	}
 }
 
+// WithReplica routes list and statistics queries to the given connection
+// when their context comes from WithReplicaReads. Writes and all other
+// reads keep going to the primary passed to the constructor.
+func (s *TradingJournalStorage) WithReplica(replica *bun.DB) *TradingJournalStorage {
+	s.replica = replica
+	return s
+}
+
+// WithCipher encrypts the notes and setups of entries written through this
+// storage, as TradingJournalEntryStorage.WithCipher does.
+func (s *TradingJournalStorage) WithCipher(cipher *fieldcrypt.Cipher) *TradingJournalStorage {
+	s.cipher = cipher
+	return s
+}
+
+func (s *TradingJournalStorage) reader(ctx context.Context) *bun.DB {
+	return readerFor(ctx, s.db, s.replica)
+}
+
+// Create inserts the journal together with its empty summary.
 func (s *TradingJournalStorage) Create(ctx context.Context, journal *entity.TradingJournal) error {
-	_, err := s.db.NewInsert().
-		Model(journal).
-		Exec(ctx)
+	err := s.db.RunInTx(ctx, nil, func(ctx context.Context, tx bun.Tx) error {
+		if _, err := tx.NewInsert().Model(journal).Exec(ctx); err != nil {
+			return err
+		}
+
+		summary := &entity.JournalSummary{JournalID: journal.ID}
+		_, err := tx.NewInsert().Model(summary).Exec(ctx)
+		return err
+	})
 
 	if err != nil {
 		return errors.Wrap(err, "failed to create trading journal")
@@ -32,17 +62,125 @@ func (s *TradingJournalStorage) Create(ctx context.Context, journal *entity.Trad
 	return nil
 }
 
+// CreateUnlessNameExists inserts the journal unless its owner already has a
+// journal with the same name, ignoring case, in which case the oldest such
+// journal is returned instead. The boolean reports whether the journal was inserted.
+// Names are not unique in the schema, so concurrent calls for the same owner
+// and name are serialized with a transaction-scoped advisory lock; the second
+// caller then finds the first caller's journal.
+func (s *TradingJournalStorage) CreateUnlessNameExists(ctx context.Context, journal *entity.TradingJournal) (*entity.TradingJournal, bool, error) {
+	result, created := journal, false
+
+	err := s.db.RunInTx(ctx, nil, func(ctx context.Context, tx bun.Tx) error {
+		lockKey := "trading_journal_name:" + journal.UserID.String() + ":" + strings.ToLower(journal.Name)
+		if _, err := tx.NewRaw("SELECT pg_advisory_xact_lock(hashtext(?))", lockKey).Exec(ctx); err != nil {
+			return errors.Wrap(err, "failed to lock trading journal name")
+		}
+
+		existing, err := getByName(ctx, tx, journal.UserID, journal.Name)
+		if err == nil {
+			result = existing
+			return nil
+		}
+		if !errors.Is(err, entity.ErrNotFound) {
+			return err
+		}
+
+		if _, err := tx.NewInsert().Model(journal).Exec(ctx); err != nil {
+			return err
+		}
+
+		summary := &entity.JournalSummary{JournalID: journal.ID}
+		if _, err := tx.NewInsert().Model(summary).Exec(ctx); err != nil {
+			return err
+		}
+
+		created = true
+		return nil
+	})
+
+	if err != nil {
+		return nil, false, errors.Wrap(err, "failed to create trading journal")
+	}
+
+	return result, created, nil
+}
+
+// CreateWithEntries inserts the journal and its entries in a single
+// transaction, so a failed entry leaves no partial journal behind.
+func (s *TradingJournalStorage) CreateWithEntries(ctx context.Context, journal *entity.TradingJournal, entries []*entity.TradingJournalEntry) error {
+	defer sealEntries(s.cipher, entries...)()
+
+	err := s.db.RunInTx(ctx, nil, func(ctx context.Context, tx bun.Tx) error {
+		if _, err := tx.NewInsert().Model(journal).Exec(ctx); err != nil {
+			return errors.Wrap(err, "failed to create trading journal")
+		}
+
+		if len(entries) > 0 {
+			if _, err := tx.NewInsert().Model(&entries).Exec(ctx); err != nil {
+				return errors.Wrap(err, "failed to create trading journal entries")
+			}
+		}
+
+		if _, err := recomputeSummary(ctx, tx, journal.ID); err != nil {
+			return err
+		}
+
+		return nil
+	})
+
+	if err != nil {
+		return errors.Wrap(err, "failed to create trading journal with entries")
+	}
+
+	return nil
+}
+
+// AddEntries inserts entries into an existing journal in a single
+// transaction and brings its summary up to date.
+func (s *TradingJournalStorage) AddEntries(ctx context.Context, journalID uuid.UUID, entries []*entity.TradingJournalEntry) error {
+	defer sealEntries(s.cipher, entries...)()
+
+	err := s.db.RunInTx(ctx, nil, func(ctx context.Context, tx bun.Tx) error {
+		if _, err := tx.NewInsert().Model(&entries).Exec(ctx); err != nil {
+			return errors.Wrap(err, "failed to create trading journal entries")
+		}
+
+		if _, err := recomputeSummary(ctx, tx, journalID); err != nil {
+			return err
+		}
+
+		return nil
+	})
+
+	if err != nil {
+		return errors.Wrap(err, "failed to add trading journal entries")
+	}
+
+	return nil
+}
+
+// withEntrySpan selects the journal's columns plus the days of its first and
+// last live entry.
+func withEntrySpan(q *bun.SelectQuery) *bun.SelectQuery {
+	return q.
+		ColumnExpr("tj.*").
+		ColumnExpr("(SELECT MIN(e.day) FROM trading_journal_entries AS e WHERE e.journal_id = tj.id AND e.deleted_at IS NULL) AS first_entry_date").
+		ColumnExpr("(SELECT MAX(e.day) FROM trading_journal_entries AS e WHERE e.journal_id = tj.id AND e.deleted_at IS NULL) AS last_entry_date")
+}
+
 func (s *TradingJournalStorage) GetByID(ctx context.Context, id uuid.UUID) (*entity.TradingJournal, error) {
 	journal := new(entity.TradingJournal)
 
 	err := s.db.NewSelect().
 		Model(journal).
+		Apply(withEntrySpan).
 		Where("id = ?", id).
 		Scan(ctx)
 
 	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
-			return nil, errors.Wrap(err, "trading journal not found")
+			return nil, errors.Wrap(entity.ErrNotFound, "trading journal")
 		}
 		return nil, errors.Wrap(err, "failed to get trading journal by id")
 	}
@@ -55,29 +193,99 @@ func (s *TradingJournalStorage) GetByIDWithEntries(ctx context.Context, id uuid.
 
 	err := s.db.NewSelect().
 		Model(journal).
+		Apply(withEntrySpan).
 		Relation("Entries").
 		Where("tj.id = ?", id).
 		Scan(ctx)
 
 	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
-			return nil, errors.Wrap(err, "trading journal not found")
+			return nil, errors.Wrap(entity.ErrNotFound, "trading journal")
 		}
 		return nil, errors.Wrap(err, "failed to get trading journal by id with entries")
 	}
 
+	if err := openEntries(s.cipher, journal.Entries...); err != nil {
+		return nil, err
+	}
+
 	return journal, nil
 }
 
-func (s *TradingJournalStorage) GetByUserID(ctx context.Context, userID uuid.UUID, limit, offset int) ([]*entity.TradingJournal, error) {
+// GetEntries returns one page of a journal's entries, newest first.
+func (s *TradingJournalStorage) GetEntries(ctx context.Context, journalID uuid.UUID, limit, offset int) ([]*entity.TradingJournalEntry, error) {
+	var entries []*entity.TradingJournalEntry
+
+	err := s.reader(ctx).NewSelect().
+		Model(&entries).
+		Where("journal_id = ?", journalID).
+		Limit(limit).
+		Offset(offset).
+		Order("day DESC", "id DESC").
+		Scan(ctx)
+
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get trading journal entries")
+	}
+
+	if err := openEntries(s.cipher, entries...); err != nil {
+		return nil, err
+	}
+
+	return entries, nil
+}
+
+// GetByName returns the user's oldest journal whose name matches name,
+// ignoring case like NameTaken.
+func (s *TradingJournalStorage) GetByName(ctx context.Context, userID uuid.UUID, name string) (*entity.TradingJournal, error) {
+	// Read from the primary: this lookup guards an insert, so replica lag
+	// would let duplicates through.
+	return getByName(ctx, s.db, userID, name)
+}
+
+func getByName(ctx context.Context, db bun.IDB, userID uuid.UUID, name string) (*entity.TradingJournal, error) {
+	journal := new(entity.TradingJournal)
+
+	err := db.NewSelect().
+		Model(journal).
+		Apply(withEntrySpan).
+		Where("user_id = ?", userID).
+		Where("LOWER(name) = LOWER(?)", name).
+		Order("created_at ASC", "id ASC").
+		Limit(1).
+		Scan(ctx)
+
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, errors.Wrap(entity.ErrNotFound, "trading journal")
+		}
+		return nil, errors.Wrap(err, "failed to get trading journal by name")
+	}
+
+	return journal, nil
+}
+
+func (s *TradingJournalStorage) GetByUserID(ctx context.Context, userID uuid.UUID, limit, offset int, includeArchived bool, broker string) ([]*entity.TradingJournal, error) {
 	var journals []*entity.TradingJournal
 
-	err := s.db.NewSelect().
+	q := s.reader(ctx).NewSelect().
 		Model(&journals).
-		Where("user_id = ?", userID).
+		Apply(withEntrySpan).
+		Relation("Summary").
+		Where("user_id = ?", userID)
+
+	if !includeArchived {
+		q = q.Where("is_archived = FALSE")
+	}
+
+	if broker != "" {
+		q = q.Where("LOWER(broker) = LOWER(?)", broker)
+	}
+
+	err := q.
 		Limit(limit).
 		Offset(offset).
-		Order("created_at DESC").
+		Order("created_at DESC", "id DESC").
 		Scan(ctx)
 
 	if err != nil {
@@ -103,7 +311,30 @@ func (s *TradingJournalStorage) Update(ctx context.Context, journal *entity.Trad
 	}
 
 	if rowsAffected == 0 {
-		return errors.New("trading journal not found")
+		return errors.Wrap(entity.ErrNotFound, "trading journal")
+	}
+
+	return nil
+}
+
+func (s *TradingJournalStorage) SetArchived(ctx context.Context, id uuid.UUID, archived bool) error {
+	result, err := s.db.NewUpdate().
+		Model((*entity.TradingJournal)(nil)).
+		Set("is_archived = ?", archived).
+		Where("id = ?", id).
+		Exec(ctx)
+
+	if err != nil {
+		return errors.Wrap(err, "failed to set trading journal archived flag")
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return errors.Wrap(err, "failed to get rows affected")
+	}
+
+	if rowsAffected == 0 {
+		return errors.Wrap(entity.ErrNotFound, "trading journal")
 	}
 
 	return nil
@@ -125,7 +356,7 @@ func (s *TradingJournalStorage) Delete(ctx context.Context, id uuid.UUID) error
 	}
 
 	if rowsAffected == 0 {
-		return errors.New("trading journal not found")
+		return errors.Wrap(entity.ErrNotFound, "trading journal")
 	}
 
 	return nil
@@ -134,11 +365,11 @@ func (s *TradingJournalStorage) Delete(ctx context.Context, id uuid.UUID) error
 func (s *TradingJournalStorage) List(ctx context.Context, limit, offset int) ([]*entity.TradingJournal, error) {
 	var journals []*entity.TradingJournal
 
-	err := s.db.NewSelect().
+	err := s.reader(ctx).NewSelect().
 		Model(&journals).
 		Limit(limit).
 		Offset(offset).
-		Order("created_at DESC").
+		Order("created_at DESC", "id DESC").
 		Scan(ctx)
 
 	if err != nil {
@@ -149,7 +380,7 @@ func (s *TradingJournalStorage) List(ctx context.Context, limit, offset int) ([]
 }
 
 func (s *TradingJournalStorage) Count(ctx context.Context) (int, error) {
-	count, err := s.db.NewSelect().
+	count, err := s.reader(ctx).NewSelect().
 		Model((*entity.TradingJournal)(nil)).
 		Count(ctx)
 
@@ -160,11 +391,20 @@ func (s *TradingJournalStorage) Count(ctx context.Context) (int, error) {
 	return count, nil
 }
 
-func (s *TradingJournalStorage) CountByUserID(ctx context.Context, userID uuid.UUID) (int, error) {
-	count, err := s.db.NewSelect().
+func (s *TradingJournalStorage) CountByUserID(ctx context.Context, userID uuid.UUID, includeArchived bool, broker string) (int, error) {
+	q := s.reader(ctx).NewSelect().
 		Model((*entity.TradingJournal)(nil)).
-		Where("user_id = ?", userID).
-		Count(ctx)
+		Where("user_id = ?", userID)
+
+	if !includeArchived {
+		q = q.Where("is_archived = FALSE")
+	}
+
+	if broker != "" {
+		q = q.Where("LOWER(broker) = LOWER(?)", broker)
+	}
+
+	count, err := q.Count(ctx)
 
 	if err != nil {
 		return 0, errors.Wrap(err, "failed to count trading journals by user id")
@@ -173,6 +413,89 @@ func (s *TradingJournalStorage) CountByUserID(ctx context.Context, userID uuid.U
 	return count, nil
 }
 
+func (s *TradingJournalStorage) CountEntries(ctx context.Context, journalID uuid.UUID) (int, error) {
+	count, err := s.reader(ctx).NewSelect().
+		Model((*entity.TradingJournalEntry)(nil)).
+		Where("journal_id = ?", journalID).
+		Count(ctx)
+
+	if err != nil {
+		return 0, errors.Wrap(err, "failed to count trading journal entries")
+	}
+
+	return count, nil
+}
+
+// NameTaken reports whether the user owns a journal other than excludeID
+// whose name matches name, ignoring case.
+func (s *TradingJournalStorage) NameTaken(ctx context.Context, userID uuid.UUID, name string, excludeID uuid.UUID) (bool, error) {
+	count, err := s.db.NewSelect().
+		Model((*entity.TradingJournal)(nil)).
+		Where("user_id = ? AND LOWER(name) = LOWER(?) AND id <> ?", userID, name, excludeID).
+		Count(ctx)
+
+	if err != nil {
+		return false, errors.Wrap(err, "failed to check if trading journal name is taken")
+	}
+
+	return count > 0, nil
+}
+
+// RecomputeSummary rebuilds the journal's summary from scratch, discarding
+// whatever the incremental updates had accumulated.
+func (s *TradingJournalStorage) RecomputeSummary(ctx context.Context, id uuid.UUID) (*entity.JournalSummary, error) {
+	summary, err := recomputeSummary(ctx, s.db, id)
+	if err != nil {
+		return nil, err
+	}
+
+	return summary, nil
+}
+
+func (s *TradingJournalStorage) SetLocked(ctx context.Context, id uuid.UUID, locked bool) error {
+	result, err := s.db.NewUpdate().
+		Model((*entity.TradingJournal)(nil)).
+		Set("is_locked = ?", locked).
+		Where("id = ?", id).
+		Exec(ctx)
+
+	if err != nil {
+		return errors.Wrap(err, "failed to set trading journal locked flag")
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return errors.Wrap(err, "failed to get rows affected")
+	}
+
+	if rowsAffected == 0 {
+		return errors.Wrap(entity.ErrNotFound, "trading journal")
+	}
+
+	return nil
+}
+
+// IsLocked reads the flag from the primary so a write right after locking
+// can't slip through on a lagging replica.
+func (s *TradingJournalStorage) IsLocked(ctx context.Context, id uuid.UUID) (bool, error) {
+	var locked bool
+
+	err := s.db.NewSelect().
+		Model((*entity.TradingJournal)(nil)).
+		Column("is_locked").
+		Where("id = ?", id).
+		Scan(ctx, &locked)
+
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return false, errors.Wrap(entity.ErrNotFound, "trading journal")
+		}
+		return false, errors.Wrap(err, "failed to check if trading journal is locked")
+	}
+
+	return locked, nil
+}
+
 func (s *TradingJournalStorage) Exists(ctx context.Context, id uuid.UUID, userID uuid.UUID) (bool, error) {
 	count, err := s.db.NewSelect().
 		Model((*entity.TradingJournal)(nil)).
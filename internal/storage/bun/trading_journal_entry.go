@@ -3,17 +3,22 @@ package bun
 import (
 	"context"
 	"database/sql"
+	"slices"
 	"time"
 
 	"github.com/cockroachdb/errors"
 	"github.com/google/uuid"
 	"github.com/uptrace/bun"
+	"github.com/uptrace/bun/dialect/pgdialect"
 	"github.com/user/normark/internal/entity"
 	"github.com/user/normark/internal/types"
+	"github.com/user/normark/pkg/fieldcrypt"
 )
 
 type TradingJournalEntryStorage struct {
-	db *bun.DB
+	db      *bun.DB
+	replica *bun.DB
+	cipher  *fieldcrypt.Cipher
 }
 
 func NewTradingJournalEntryStorage(db *bun.DB) *TradingJournalEntryStorage {
@@ -22,6 +27,25 @@ func NewTradingJournalEntryStorage(db *bun.DB) *TradingJournalEntryStorage {
 	}
 }
 
+// WithReplica routes list and statistics queries to the given connection
+// when their context comes from WithReplicaReads. Writes and all other
+// reads keep going to the primary passed to the constructor.
+func (s *TradingJournalEntryStorage) WithReplica(replica *bun.DB) *TradingJournalEntryStorage {
+	s.replica = replica
+	return s
+}
+
+// WithCipher encrypts entry notes and setups at rest. Entries written
+// without it stay readable.
+func (s *TradingJournalEntryStorage) WithCipher(cipher *fieldcrypt.Cipher) *TradingJournalEntryStorage {
+	s.cipher = cipher
+	return s
+}
+
+func (s *TradingJournalEntryStorage) reader(ctx context.Context) *bun.DB {
+	return readerFor(ctx, s.db, s.replica)
+}
+
 type GetByJournalIDParams struct {
 	JournalID uuid.UUID
 	Limit     int
@@ -32,6 +56,8 @@ type GetByDateRangeParams struct {
 	JournalID uuid.UUID
 	StartDate time.Time
 	EndDate   time.Time
+	Limit     int
+	Offset    int
 }
 
 type GetByAssetParams struct {
@@ -55,10 +81,83 @@ type GetByResultParams struct {
 	Offset    int
 }
 
+type GetByTradeTypeParams struct {
+	JournalID uuid.UUID
+	TradeType types.TradeType
+	Limit     int
+	Offset    int
+}
+
+// FilterParams combines optional predicates over a journal's entries. Nil
+// fields are not applied.
+type FilterParams struct {
+	JournalID    uuid.UUID
+	Asset        *types.CurrencyPair
+	Session      *types.TradingSession
+	Result       *types.TradeResult
+	StartDate    *time.Time
+	EndDate      *time.Time
+	Pinned       *bool
+	ReviewStatus *types.ReviewStatus
+	FollowedPlan *bool
+	TradeType    *types.TradeType
+	EntryType    *types.EntryType
+	MinRR        *float64
+	MaxRR        *float64
+	MinRealized  *float64
+	MaxRealized  *float64
+	Limit        int
+	Offset       int
+}
+
+// GetModifiedSinceParams pages through entries changed after Since in
+// (updated_at, id) order. AfterUpdatedAt and AfterID are the cursor: the
+// position of the last entry of the previous page, if any.
+type GetModifiedSinceParams struct {
+	JournalID      uuid.UUID
+	Since          time.Time
+	AfterUpdatedAt *time.Time
+	AfterID        uuid.UUID
+	Limit          int
+}
+
+// BulkUpdateParams changes every live entry matching Filter, whose Limit and
+// Offset are ignored. Apply is called on each entry to change it and aborts
+// the update with its error. If more than Max entries match,
+// ErrBulkUpdateTooLarge is returned. With DryRun the changed entries are
+// returned but nothing is written.
+type BulkUpdateParams struct {
+	Filter FilterParams
+	Max    int
+	DryRun bool
+	Apply  func(entry *entity.TradingJournalEntry) error
+}
+
+// TransferEntriesParams moves live entries of SourceJournalID to
+// TargetJournalID, or copies them if Copy is set. Both journals must belong
+// to UserID and be unlocked. Check, if set, is called on every entry before anything is
+// written and aborts the transfer with its error.
+type TransferEntriesParams struct {
+	UserID          uuid.UUID
+	SourceJournalID uuid.UUID
+	TargetJournalID uuid.UUID
+	EntryIDs        []uuid.UUID
+	Copy            bool
+	Check           func(entry *entity.TradingJournalEntry) error
+}
+
+// Create inserts the entry and adds it to the journal summary in the same
+// transaction.
 func (s *TradingJournalEntryStorage) Create(ctx context.Context, entry *entity.TradingJournalEntry) error {
-	_, err := s.db.NewInsert().
-		Model(entry).
-		Exec(ctx)
+	defer sealEntries(s.cipher, entry)()
+
+	err := s.db.RunInTx(ctx, nil, func(ctx context.Context, tx bun.Tx) error {
+		if _, err := tx.NewInsert().Model(entry).Exec(ctx); err != nil {
+			return err
+		}
+
+		return applySummaryDelta(ctx, tx, entry.JournalID, entryContribution(entry.Result, entry.Realized))
+	})
 
 	if err != nil {
 		return errors.Wrap(err, "failed to create trading journal entry")
@@ -67,21 +166,34 @@ func (s *TradingJournalEntryStorage) Create(ctx context.Context, entry *entity.T
 	return nil
 }
 
+// withRealizedPips selects the entry's columns plus its realized profit in
+// pips of the journal's pip value.
+func withRealizedPips(q *bun.SelectQuery) *bun.SelectQuery {
+	return q.
+		ColumnExpr("tje.*").
+		ColumnExpr("tje.realized / (SELECT NULLIF(j.pip_value, 0) FROM trading_journals AS j WHERE j.id = tje.journal_id) AS realized_pips")
+}
+
 func (s *TradingJournalEntryStorage) GetByID(ctx context.Context, id uuid.UUID) (*entity.TradingJournalEntry, error) {
 	entry := new(entity.TradingJournalEntry)
 
 	err := s.db.NewSelect().
 		Model(entry).
+		Apply(withRealizedPips).
 		Where("id = ?", id).
 		Scan(ctx)
 
 	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
-			return nil, errors.Wrap(err, "trading journal entry not found")
+			return nil, errors.Wrap(entity.ErrNotFound, "trading journal entry")
 		}
 		return nil, errors.Wrap(err, "failed to get trading journal entry by id")
 	}
 
+	if err := openEntries(s.cipher, entry); err != nil {
+		return nil, err
+	}
+
 	return entry, nil
 }
 
@@ -90,121 +202,341 @@ func (s *TradingJournalEntryStorage) GetByIDWithJournal(ctx context.Context, id
 
 	err := s.db.NewSelect().
 		Model(entry).
+		Apply(withRealizedPips).
 		Relation("Journal").
 		Where("tje.id = ?", id).
 		Scan(ctx)
 
 	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
-			return nil, errors.Wrap(err, "trading journal entry not found")
+			return nil, errors.Wrap(entity.ErrNotFound, "trading journal entry")
 		}
 		return nil, errors.Wrap(err, "failed to get trading journal entry by id with journal")
 	}
 
+	if err := openEntries(s.cipher, entry); err != nil {
+		return nil, err
+	}
+
 	return entry, nil
 }
 
 func (s *TradingJournalEntryStorage) GetByJournalID(ctx context.Context, params GetByJournalIDParams) ([]*entity.TradingJournalEntry, error) {
 	var entries []*entity.TradingJournalEntry
 
-	err := s.db.NewSelect().
+	err := s.reader(ctx).NewSelect().
 		Model(&entries).
+		Apply(withRealizedPips).
 		Where("journal_id = ?", params.JournalID).
 		Limit(params.Limit).
 		Offset(params.Offset).
-		Order("day DESC").
+		Order("day DESC", "id DESC").
 		Scan(ctx)
 
 	if err != nil {
 		return nil, errors.Wrap(err, "failed to get trading journal entries by journal id")
 	}
 
+	if err := openEntries(s.cipher, entries...); err != nil {
+		return nil, err
+	}
+
 	return entries, nil
 }
 
 func (s *TradingJournalEntryStorage) GetByDateRange(ctx context.Context, params GetByDateRangeParams) ([]*entity.TradingJournalEntry, error) {
 	var entries []*entity.TradingJournalEntry
 
-	err := s.db.NewSelect().
+	err := s.reader(ctx).NewSelect().
 		Model(&entries).
+		Apply(withRealizedPips).
 		Where("journal_id = ?", params.JournalID).
 		Where("day >= ?", params.StartDate).
 		Where("day <= ?", params.EndDate).
-		Order("day DESC").
+		Limit(params.Limit).
+		Offset(params.Offset).
+		Order("day DESC", "id DESC").
 		Scan(ctx)
 
 	if err != nil {
 		return nil, errors.Wrap(err, "failed to get trading journal entries by date range")
 	}
 
+	if err := openEntries(s.cipher, entries...); err != nil {
+		return nil, err
+	}
+
 	return entries, nil
 }
 
+// CountByDateRange counts the entries GetByDateRange pages through; Limit and
+// Offset are ignored.
+func (s *TradingJournalEntryStorage) CountByDateRange(ctx context.Context, params GetByDateRangeParams) (int, error) {
+	count, err := s.reader(ctx).NewSelect().
+		Model((*entity.TradingJournalEntry)(nil)).
+		Where("journal_id = ?", params.JournalID).
+		Where("day >= ?", params.StartDate).
+		Where("day <= ?", params.EndDate).
+		Count(ctx)
+
+	if err != nil {
+		return 0, errors.Wrap(err, "failed to count trading journal entries by date range")
+	}
+
+	return count, nil
+}
+
 func (s *TradingJournalEntryStorage) GetByAsset(ctx context.Context, params GetByAssetParams) ([]*entity.TradingJournalEntry, error) {
 	var entries []*entity.TradingJournalEntry
 
-	err := s.db.NewSelect().
+	err := s.reader(ctx).NewSelect().
 		Model(&entries).
+		Apply(withRealizedPips).
 		Where("journal_id = ?", params.JournalID).
 		Where("asset = ?", params.Asset).
 		Limit(params.Limit).
 		Offset(params.Offset).
-		Order("day DESC").
+		Order("day DESC", "id DESC").
 		Scan(ctx)
 
 	if err != nil {
 		return nil, errors.Wrap(err, "failed to get trading journal entries by asset")
 	}
 
+	if err := openEntries(s.cipher, entries...); err != nil {
+		return nil, err
+	}
+
 	return entries, nil
 }
 
 func (s *TradingJournalEntryStorage) GetBySession(ctx context.Context, params GetBySessionParams) ([]*entity.TradingJournalEntry, error) {
 	var entries []*entity.TradingJournalEntry
 
-	err := s.db.NewSelect().
+	err := s.reader(ctx).NewSelect().
 		Model(&entries).
+		Apply(withRealizedPips).
 		Where("journal_id = ?", params.JournalID).
 		Where("session = ?", params.Session).
 		Limit(params.Limit).
 		Offset(params.Offset).
-		Order("day DESC").
+		Order("day DESC", "id DESC").
 		Scan(ctx)
 
 	if err != nil {
 		return nil, errors.Wrap(err, "failed to get trading journal entries by session")
 	}
 
+	if err := openEntries(s.cipher, entries...); err != nil {
+		return nil, err
+	}
+
 	return entries, nil
 }
 
 func (s *TradingJournalEntryStorage) GetByResult(ctx context.Context, params GetByResultParams) ([]*entity.TradingJournalEntry, error) {
 	var entries []*entity.TradingJournalEntry
 
-	err := s.db.NewSelect().
+	err := s.reader(ctx).NewSelect().
 		Model(&entries).
+		Apply(withRealizedPips).
 		Where("journal_id = ?", params.JournalID).
 		Where("result = ?", params.Result).
 		Limit(params.Limit).
 		Offset(params.Offset).
-		Order("day DESC").
+		Order("day DESC", "id DESC").
 		Scan(ctx)
 
 	if err != nil {
 		return nil, errors.Wrap(err, "failed to get trading journal entries by result")
 	}
 
+	if err := openEntries(s.cipher, entries...); err != nil {
+		return nil, err
+	}
+
+	return entries, nil
+}
+
+func (s *TradingJournalEntryStorage) GetByTradeType(ctx context.Context, params GetByTradeTypeParams) ([]*entity.TradingJournalEntry, error) {
+	var entries []*entity.TradingJournalEntry
+
+	err := s.reader(ctx).NewSelect().
+		Model(&entries).
+		Apply(withRealizedPips).
+		Where("journal_id = ?", params.JournalID).
+		Where("trade_type = ?", params.TradeType).
+		Limit(params.Limit).
+		Offset(params.Offset).
+		Order("day DESC", "id DESC").
+		Scan(ctx)
+
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get trading journal entries by trade type")
+	}
+
+	if err := openEntries(s.cipher, entries...); err != nil {
+		return nil, err
+	}
+
+	return entries, nil
+}
+
+func (s *TradingJournalEntryStorage) Filter(ctx context.Context, params FilterParams) ([]*entity.TradingJournalEntry, error) {
+	var entries []*entity.TradingJournalEntry
+
+	err := applyFilter(s.reader(ctx).NewSelect().Model(&entries).Apply(withRealizedPips), params).
+		Limit(params.Limit).
+		Offset(params.Offset).
+		Order("day DESC", "id DESC").
+		Scan(ctx)
+
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to filter trading journal entries")
+	}
+
+	if err := openEntries(s.cipher, entries...); err != nil {
+		return nil, err
+	}
+
 	return entries, nil
 }
 
+func (s *TradingJournalEntryStorage) CountFiltered(ctx context.Context, params FilterParams) (int, error) {
+	count, err := applyFilter(s.reader(ctx).NewSelect().Model((*entity.TradingJournalEntry)(nil)), params).
+		Count(ctx)
+
+	if err != nil {
+		return 0, errors.Wrap(err, "failed to count filtered trading journal entries")
+	}
+
+	return count, nil
+}
+
+func applyFilter(q *bun.SelectQuery, params FilterParams) *bun.SelectQuery {
+	q = q.Where("journal_id = ?", params.JournalID)
+
+	if params.Asset != nil {
+		q = q.Where("asset = ?", *params.Asset)
+	}
+
+	if params.Session != nil {
+		q = q.Where("session = ?", *params.Session)
+	}
+
+	if params.Result != nil {
+		q = q.Where("result = ?", *params.Result)
+	}
+
+	if params.StartDate != nil {
+		q = q.Where("day >= ?", *params.StartDate)
+	}
+
+	if params.EndDate != nil {
+		q = q.Where("day <= ?", *params.EndDate)
+	}
+
+	if params.Pinned != nil {
+		q = q.Where("is_pinned = ?", *params.Pinned)
+	}
+
+	if params.ReviewStatus != nil {
+		q = q.Where("review_status = ?", *params.ReviewStatus)
+	}
+
+	if params.FollowedPlan != nil {
+		q = q.Where("followed_plan = ?", *params.FollowedPlan)
+	}
+
+	if params.TradeType != nil {
+		q = q.Where("trade_type = ?", *params.TradeType)
+	}
+
+	if params.EntryType != nil {
+		q = q.Where("entry_type = ?", *params.EntryType)
+	}
+
+	if params.MinRR != nil {
+		q = q.Where("max_rr >= ?", *params.MinRR)
+	}
+
+	if params.MaxRR != nil {
+		q = q.Where("max_rr <= ?", *params.MaxRR)
+	}
+
+	if params.MinRealized != nil {
+		q = q.Where("realized >= ?", *params.MinRealized)
+	}
+
+	if params.MaxRealized != nil {
+		q = q.Where("realized <= ?", *params.MaxRealized)
+	}
+
+	return q
+}
+
+// Update saves the entry. An entry with exits keeps the sum of their realized
+// rather than the value on the model, which is refreshed from the database.
+// The journal summary swaps the old values for the new ones in the same
+// transaction.
 func (s *TradingJournalEntryStorage) Update(ctx context.Context, entry *entity.TradingJournalEntry) error {
+	defer sealEntries(s.cipher, entry)()
+
+	err := s.db.RunInTx(ctx, nil, func(ctx context.Context, tx bun.Tx) error {
+		old, err := lockSummaryEntry(ctx, tx, entry.ID)
+		if err != nil {
+			if errors.Is(err, sql.ErrNoRows) {
+				return errors.Wrap(entity.ErrNotFound, "trading journal entry")
+			}
+			return err
+		}
+
+		if !old.DeletedAt.IsZero() {
+			return errors.Wrap(entity.ErrNotFound, "trading journal entry")
+		}
+
+		// Links are owned by LinkEntries and UnlinkEntries, so a stale copy
+		// of the entry can't undo a concurrent link.
+		result, err := tx.NewUpdate().
+			Model(entry).
+			ExcludeColumn("related_entry_ids").
+			Value("realized", "COALESCE((SELECT SUM(ee.realized) FROM entry_exits AS ee WHERE ee.entry_id = tje.id), ?)", entry.Realized).
+			WherePK().
+			Returning("realized").
+			Exec(ctx)
+		if err != nil {
+			return err
+		}
+
+		rowsAffected, err := result.RowsAffected()
+		if err != nil {
+			return errors.Wrap(err, "failed to get rows affected")
+		}
+
+		if rowsAffected == 0 {
+			return errors.Wrap(entity.ErrNotFound, "trading journal entry")
+		}
+
+		delta := entryContribution(entry.Result, entry.Realized).minus(old.contribution())
+		return applySummaryDelta(ctx, tx, old.JournalID, delta)
+	})
+
+	if err != nil {
+		return errors.Wrap(err, "failed to update trading journal entry")
+	}
+
+	return nil
+}
+
+func (s *TradingJournalEntryStorage) SetPinned(ctx context.Context, id uuid.UUID, pinned bool) error {
 	result, err := s.db.NewUpdate().
-		Model(entry).
-		WherePK().
+		Model((*entity.TradingJournalEntry)(nil)).
+		Set("is_pinned = ?", pinned).
+		Where("id = ?", id).
 		Exec(ctx)
 
 	if err != nil {
-		return errors.Wrap(err, "failed to update trading journal entry")
+		return errors.Wrap(err, "failed to set trading journal entry pinned flag")
 	}
 
 	rowsAffected, err := result.RowsAffected()
@@ -213,20 +545,21 @@ func (s *TradingJournalEntryStorage) Update(ctx context.Context, entry *entity.T
 	}
 
 	if rowsAffected == 0 {
-		return errors.New("trading journal entry not found")
+		return errors.Wrap(entity.ErrNotFound, "trading journal entry")
 	}
 
 	return nil
 }
 
-func (s *TradingJournalEntryStorage) Delete(ctx context.Context, id uuid.UUID) error {
-	result, err := s.db.NewDelete().
+func (s *TradingJournalEntryStorage) SetReviewStatus(ctx context.Context, id uuid.UUID, status types.ReviewStatus) error {
+	result, err := s.db.NewUpdate().
 		Model((*entity.TradingJournalEntry)(nil)).
+		Set("review_status = ?", status).
 		Where("id = ?", id).
 		Exec(ctx)
 
 	if err != nil {
-		return errors.Wrap(err, "failed to delete trading journal entry")
+		return errors.Wrap(err, "failed to set trading journal entry review status")
 	}
 
 	rowsAffected, err := result.RowsAffected()
@@ -235,31 +568,498 @@ func (s *TradingJournalEntryStorage) Delete(ctx context.Context, id uuid.UUID) e
 	}
 
 	if rowsAffected == 0 {
-		return errors.New("trading journal entry not found")
+		return errors.Wrap(entity.ErrNotFound, "trading journal entry")
 	}
 
 	return nil
 }
 
-func (s *TradingJournalEntryStorage) List(ctx context.Context, limit, offset int) ([]*entity.TradingJournalEntry, error) {
+// bulkUpdateColumns are the columns BulkUpdate writes: the fields a bulk
+// update may change, plus the grade derived from them.
+var bulkUpdateColumns = []string{
+	"max_rr",
+	"result",
+	"session",
+	"trade_type",
+	"entry_type",
+	"followed_plan",
+	"review_status",
+	"grade",
+}
+
+// BulkUpdate applies params.Apply to the matching entries and saves them in
+// one transaction, adjusting the journal summary. As with Update, entries
+// with exits keep the sum of their realized.
+func (s *TradingJournalEntryStorage) BulkUpdate(ctx context.Context, params BulkUpdateParams) ([]*entity.TradingJournalEntry, error) {
 	var entries []*entity.TradingJournalEntry
 
+	err := s.db.RunInTx(ctx, nil, func(ctx context.Context, tx bun.Tx) error {
+		err := applyFilter(tx.NewSelect().Model(&entries), params.Filter).
+			Order("day DESC", "id DESC").
+			Limit(params.Max + 1).
+			For("UPDATE").
+			Scan(ctx)
+		if err != nil {
+			return err
+		}
+		if len(entries) > params.Max {
+			return entity.ErrBulkUpdateTooLarge
+		}
+		if err := openEntries(s.cipher, entries...); err != nil {
+			return err
+		}
+
+		var delta summaryDelta
+		for _, entry := range entries {
+			old := entryContribution(entry.Result, entry.Realized)
+			if err := params.Apply(entry); err != nil {
+				return err
+			}
+
+			if !params.DryRun {
+				_, err := tx.NewUpdate().
+					Model(entry).
+					Column(bulkUpdateColumns...).
+					Value("realized", "COALESCE((SELECT SUM(ee.realized) FROM entry_exits AS ee WHERE ee.entry_id = tje.id), ?)", entry.Realized).
+					WherePK().
+					Returning("realized").
+					Exec(ctx)
+				if err != nil {
+					return err
+				}
+			}
+
+			delta = delta.plus(entryContribution(entry.Result, entry.Realized).minus(old))
+		}
+
+		if params.DryRun {
+			return nil
+		}
+
+		return applySummaryDelta(ctx, tx, params.Filter.JournalID, delta)
+	})
+
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to bulk update trading journal entries")
+	}
+
+	return entries, nil
+}
+
+// LinkEntries links two entries of the journal to each other. Linking
+// entries that are already linked does nothing.
+func (s *TradingJournalEntryStorage) LinkEntries(ctx context.Context, journalID, entryID, relatedID uuid.UUID) error {
+	err := s.db.RunInTx(ctx, nil, func(ctx context.Context, tx bun.Tx) error {
+		var entries []*entity.TradingJournalEntry
+		err := tx.NewSelect().
+			Model(&entries).
+			Column("id", "related_entry_ids").
+			Where("id IN (?)", bun.In([]uuid.UUID{entryID, relatedID})).
+			Where("journal_id = ?", journalID).
+			For("UPDATE").
+			Scan(ctx)
+		if err != nil {
+			return err
+		}
+		if len(entries) != 2 {
+			return errors.Wrap(entity.ErrNotFound, "trading journal entry")
+		}
+
+		for _, entry := range entries {
+			other := relatedID
+			if entry.ID == relatedID {
+				other = entryID
+			}
+			if slices.Contains(entry.RelatedEntryIDs, other) {
+				continue
+			}
+			if len(entry.RelatedEntryIDs) >= entity.MaxRelatedEntries {
+				return entity.ErrTooManyLinks
+			}
+
+			_, err := tx.NewUpdate().
+				Model((*entity.TradingJournalEntry)(nil)).
+				Set("related_entry_ids = array_append(COALESCE(related_entry_ids, '{}'), ?)", other).
+				Where("id = ?", entry.ID).
+				Exec(ctx)
+			if err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+
+	if err != nil {
+		return errors.Wrap(err, "failed to link trading journal entries")
+	}
+
+	return nil
+}
+
+// UnlinkEntries removes the link between two entries of the journal, if
+// there is one.
+func (s *TradingJournalEntryStorage) UnlinkEntries(ctx context.Context, journalID, entryID, relatedID uuid.UUID) error {
+	_, err := s.db.NewUpdate().
+		Model((*entity.TradingJournalEntry)(nil)).
+		Set("related_entry_ids = array_remove(related_entry_ids, CASE WHEN id = ? THEN ?::uuid ELSE ?::uuid END)", entryID, relatedID, entryID).
+		Where("id IN (?)", bun.In([]uuid.UUID{entryID, relatedID})).
+		Where("journal_id = ?", journalID).
+		Exec(ctx)
+
+	if err != nil {
+		return errors.Wrap(err, "failed to unlink trading journal entries")
+	}
+
+	return nil
+}
+
+// GetByIDs returns the journal's entries among ids, newest first. IDs of
+// deleted entries or entries of other journals are skipped.
+func (s *TradingJournalEntryStorage) GetByIDs(ctx context.Context, journalID uuid.UUID, ids []uuid.UUID) ([]*entity.TradingJournalEntry, error) {
+	entries := []*entity.TradingJournalEntry{}
+	if len(ids) == 0 {
+		return entries, nil
+	}
+
 	err := s.db.NewSelect().
+		Model(&entries).
+		Apply(withRealizedPips).
+		Where("id IN (?)", bun.In(ids)).
+		Where("journal_id = ?", journalID).
+		Order("day DESC", "id DESC").
+		Scan(ctx)
+
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get trading journal entries by ids")
+	}
+
+	if err := openEntries(s.cipher, entries...); err != nil {
+		return nil, err
+	}
+
+	return entries, nil
+}
+
+// GetModifiedSince returns entries updated after params.Since, including
+// soft-deleted ones so sync clients can mirror deletions.
+func (s *TradingJournalEntryStorage) GetModifiedSince(ctx context.Context, params GetModifiedSinceParams) ([]*entity.TradingJournalEntry, error) {
+	var entries []*entity.TradingJournalEntry
+
+	q := s.db.NewSelect().
+		Model(&entries).
+		Apply(withRealizedPips).
+		WhereAllWithDeleted().
+		Where("journal_id = ?", params.JournalID).
+		Where("updated_at > ?", params.Since)
+
+	if params.AfterUpdatedAt != nil {
+		q = q.Where("(updated_at, id) > (?, ?)", *params.AfterUpdatedAt, params.AfterID)
+	}
+
+	err := q.
+		Order("updated_at ASC", "id ASC").
+		Limit(params.Limit).
+		Scan(ctx)
+
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get trading journal entries modified since")
+	}
+
+	if err := openEntries(s.cipher, entries...); err != nil {
+		return nil, err
+	}
+
+	return entries, nil
+}
+
+// Delete soft-deletes the entry by setting deleted_at. The row stays in the
+// table but is excluded from every model query, list and count.
+func (s *TradingJournalEntryStorage) Delete(ctx context.Context, id uuid.UUID) error {
+	err := s.db.RunInTx(ctx, nil, func(ctx context.Context, tx bun.Tx) error {
+		old, err := lockSummaryEntry(ctx, tx, id)
+		if err != nil {
+			if errors.Is(err, sql.ErrNoRows) {
+				return errors.Wrap(entity.ErrNotFound, "trading journal entry")
+			}
+			return err
+		}
+
+		if !old.DeletedAt.IsZero() {
+			return errors.Wrap(entity.ErrNotFound, "trading journal entry")
+		}
+
+		if _, err := tx.NewDelete().Model((*entity.TradingJournalEntry)(nil)).Where("id = ?", id).Exec(ctx); err != nil {
+			return err
+		}
+
+		return applySummaryDelta(ctx, tx, old.JournalID, summaryDelta{}.minus(old.contribution()))
+	})
+
+	if err != nil {
+		return errors.Wrap(err, "failed to delete trading journal entry")
+	}
+
+	return nil
+}
+
+// RestoreLastDeleted clears deleted_at on the journal's most recently
+// soft-deleted entry, provided it was deleted after deletedAfter.
+func (s *TradingJournalEntryStorage) RestoreLastDeleted(ctx context.Context, journalID uuid.UUID, deletedAfter time.Time) (*entity.TradingJournalEntry, error) {
+	latest := s.db.NewSelect().
+		Model((*entity.TradingJournalEntry)(nil)).
+		Column("id").
+		WhereDeleted().
+		Where("journal_id = ?", journalID).
+		Where("deleted_at > ?", deletedAfter).
+		OrderExpr("deleted_at DESC, id DESC").
+		Limit(1)
+
+	entry := new(entity.TradingJournalEntry)
+
+	err := s.db.RunInTx(ctx, nil, func(ctx context.Context, tx bun.Tx) error {
+		err := tx.NewUpdate().
+			Model(entry).
+			WhereAllWithDeleted().
+			Set("deleted_at = NULL").
+			Where("id = (?)", latest).
+			Returning("*").
+			Scan(ctx)
+		if err != nil {
+			return err
+		}
+
+		return applySummaryDelta(ctx, tx, entry.JournalID, entryContribution(entry.Result, entry.Realized))
+	})
+
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, errors.Wrap(entity.ErrNotFound, "recently deleted trading journal entry")
+		}
+		return nil, errors.Wrap(err, "failed to restore trading journal entry")
+	}
+
+	if err := openEntries(s.cipher, entry); err != nil {
+		return nil, err
+	}
+
+	return entry, nil
+}
+
+// HardDelete permanently removes the entry row, including one that was
+// already soft-deleted. This cannot be undone.
+func (s *TradingJournalEntryStorage) HardDelete(ctx context.Context, id uuid.UUID) error {
+	err := s.db.RunInTx(ctx, nil, func(ctx context.Context, tx bun.Tx) error {
+		old, err := lockSummaryEntry(ctx, tx, id)
+		if err != nil {
+			if errors.Is(err, sql.ErrNoRows) {
+				return errors.Wrap(entity.ErrNotFound, "trading journal entry")
+			}
+			return err
+		}
+
+		_, err = tx.NewDelete().
+			Model((*entity.TradingJournalEntry)(nil)).
+			Where("id = ?", id).
+			WhereAllWithDeleted().
+			ForceDelete().
+			Exec(ctx)
+		if err != nil {
+			return err
+		}
+
+		// A soft-deleted entry was already taken out of the summary.
+		if !old.DeletedAt.IsZero() {
+			return nil
+		}
+
+		return applySummaryDelta(ctx, tx, old.JournalID, summaryDelta{}.minus(old.contribution()))
+	})
+
+	if err != nil {
+		return errors.Wrap(err, "failed to hard delete trading journal entry")
+	}
+
+	return nil
+}
+
+// TransferEntries moves or copies entries between two journals and returns
+// them as they are in the target journal. Ownership of both journals and of
+// every entry is verified in the same transaction as the write; if any of
+// them fails, nothing is written and an ErrNotFound error is returned.
+// ErrJournalLocked is returned if either journal is locked.
+// Copies get new IDs and timestamps; partial exits and notes stay with the
+// source entry. Both journal summaries are adjusted.
+func (s *TradingJournalEntryStorage) TransferEntries(ctx context.Context, params TransferEntriesParams) ([]*entity.TradingJournalEntry, error) {
+	var entries []*entity.TradingJournalEntry
+
+	err := s.db.RunInTx(ctx, nil, func(ctx context.Context, tx bun.Tx) error {
+		var journals []*entity.TradingJournal
+		err := tx.NewSelect().
+			Model(&journals).
+			Column("id", "is_locked").
+			Where("id IN (?)", bun.In([]uuid.UUID{params.SourceJournalID, params.TargetJournalID})).
+			Where("user_id = ?", params.UserID).
+			For("SHARE").
+			Scan(ctx)
+		if err != nil {
+			return err
+		}
+		if len(journals) != 2 {
+			return errors.Wrap(entity.ErrNotFound, "trading journal")
+		}
+		for _, journal := range journals {
+			if journal.IsLocked {
+				return entity.ErrJournalLocked
+			}
+		}
+
+		err = tx.NewSelect().
+			Model(&entries).
+			Where("id IN (?)", bun.In(params.EntryIDs)).
+			Where("journal_id = ?", params.SourceJournalID).
+			OrderExpr("day DESC, id DESC").
+			For("UPDATE").
+			Scan(ctx)
+		if err != nil {
+			return err
+		}
+		if len(entries) != len(params.EntryIDs) {
+			return errors.Wrap(entity.ErrNotFound, "trading journal entry")
+		}
+		if err := openEntries(s.cipher, entries...); err != nil {
+			return err
+		}
+
+		var delta summaryDelta
+		for _, entry := range entries {
+			if params.Check != nil {
+				if err := params.Check(entry); err != nil {
+					return err
+				}
+			}
+
+			delta = delta.plus(entryContribution(entry.Result, entry.Realized))
+		}
+
+		if params.Copy {
+			copies := make([]*entity.TradingJournalEntry, len(entries))
+			for i, entry := range entries {
+				cp := *entry
+				cp.ID = uuid.Nil
+				cp.JournalID = params.TargetJournalID
+				cp.EntryCharts = append([]string(nil), entry.EntryCharts...)
+				cp.SetupCharts = append([]string(nil), entry.SetupCharts...)
+				cp.RelatedEntryIDs = nil
+				cp.CreatedAt = time.Time{}
+				cp.UpdatedAt = time.Time{}
+				copies[i] = &cp
+			}
+
+			restore := sealEntries(s.cipher, copies...)
+			_, err := tx.NewInsert().Model(&copies).Returning("*").Exec(ctx)
+			restore()
+			if err != nil {
+				return err
+			}
+
+			ids := make([]uuid.UUID, len(copies))
+			for i, cp := range copies {
+				ids[i] = cp.ID
+			}
+			entries, err = s.loadTransferred(ctx, tx, ids)
+			if err != nil {
+				return err
+			}
+
+			return applySummaryDelta(ctx, tx, params.TargetJournalID, delta)
+		}
+
+		// Links only hold within a journal, so moved entries lose theirs and
+		// are dropped from the entries left behind.
+		_, err = tx.NewUpdate().
+			Model((*entity.TradingJournalEntry)(nil)).
+			Set("journal_id = ?", params.TargetJournalID).
+			Set("related_entry_ids = '{}'").
+			Where("id IN (?)", bun.In(params.EntryIDs)).
+			Exec(ctx)
+		if err != nil {
+			return err
+		}
+
+		_, err = tx.NewUpdate().
+			Model((*entity.TradingJournalEntry)(nil)).
+			Set("related_entry_ids = ARRAY(SELECT r FROM unnest(related_entry_ids) AS r WHERE r <> ALL(?))", pgdialect.Array(params.EntryIDs)).
+			Where("journal_id = ?", params.SourceJournalID).
+			Where("related_entry_ids && ?", pgdialect.Array(params.EntryIDs)).
+			Exec(ctx)
+		if err != nil {
+			return err
+		}
+
+		entries, err = s.loadTransferred(ctx, tx, params.EntryIDs)
+		if err != nil {
+			return err
+		}
+
+		if err := applySummaryDelta(ctx, tx, params.SourceJournalID, summaryDelta{}.minus(delta)); err != nil {
+			return err
+		}
+
+		return applySummaryDelta(ctx, tx, params.TargetJournalID, delta)
+	})
+
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to transfer trading journal entries")
+	}
+
+	return entries, nil
+}
+
+// loadTransferred reloads entries after a transfer to pick up updated_at,
+// which a trigger sets, and realized_pips in the target journal's pip value.
+func (s *TradingJournalEntryStorage) loadTransferred(ctx context.Context, db bun.IDB, ids []uuid.UUID) ([]*entity.TradingJournalEntry, error) {
+	var entries []*entity.TradingJournalEntry
+	err := db.NewSelect().
+		Model(&entries).
+		Apply(withRealizedPips).
+		Where("tje.id IN (?)", bun.In(ids)).
+		OrderExpr("tje.day DESC, tje.id DESC").
+		Scan(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := openEntries(s.cipher, entries...); err != nil {
+		return nil, err
+	}
+
+	return entries, nil
+}
+
+func (s *TradingJournalEntryStorage) List(ctx context.Context, limit, offset int) ([]*entity.TradingJournalEntry, error) {
+	var entries []*entity.TradingJournalEntry
+
+	err := s.reader(ctx).NewSelect().
 		Model(&entries).
 		Limit(limit).
 		Offset(offset).
-		Order("day DESC").
+		Order("day DESC", "id DESC").
 		Scan(ctx)
 
 	if err != nil {
 		return nil, errors.Wrap(err, "failed to list trading journal entries")
 	}
 
+	if err := openEntries(s.cipher, entries...); err != nil {
+		return nil, err
+	}
+
 	return entries, nil
 }
 
 func (s *TradingJournalEntryStorage) Count(ctx context.Context) (int, error) {
-	count, err := s.db.NewSelect().
+	count, err := s.reader(ctx).NewSelect().
 		Model((*entity.TradingJournalEntry)(nil)).
 		Count(ctx)
 
@@ -271,7 +1071,7 @@ func (s *TradingJournalEntryStorage) Count(ctx context.Context) (int, error) {
 }
 
 func (s *TradingJournalEntryStorage) CountByJournalID(ctx context.Context, journalID uuid.UUID) (int, error) {
-	count, err := s.db.NewSelect().
+	count, err := s.reader(ctx).NewSelect().
 		Model((*entity.TradingJournalEntry)(nil)).
 		Where("journal_id = ?", journalID).
 		Count(ctx)
@@ -296,65 +1096,386 @@ func (s *TradingJournalEntryStorage) Exists(ctx context.Context, id uuid.UUID, j
 	return count > 0, nil
 }
 
-func (s *TradingJournalEntryStorage) GetStatistics(ctx context.Context, journalID uuid.UUID) (map[string]any, error) {
-	stats := make(map[string]any)
+// ExistsWithDeleted is like Exists but also matches soft-deleted entries.
+func (s *TradingJournalEntryStorage) ExistsWithDeleted(ctx context.Context, id uuid.UUID, journalID uuid.UUID) (bool, error) {
+	count, err := s.db.NewSelect().
+		Model((*entity.TradingJournalEntry)(nil)).
+		Where("id = ? AND journal_id = ?", id, journalID).
+		WhereAllWithDeleted().
+		Count(ctx)
 
-	totalTrades, err := s.CountByJournalID(ctx, journalID)
 	if err != nil {
-		return nil, errors.Wrap(err, "failed to count total trades")
+		return false, errors.Wrap(err, "failed to check if trading journal entry exists")
 	}
-	stats["total_trades"] = totalTrades
 
-	var resultStats []struct {
-		Result types.TradeResult
-		Count  int
+	return count > 0, nil
+}
+
+const (
+	// riskDistanceExpr is the price distance from entry to stop loss, NULL
+	// when either price is missing or they are equal.
+	riskDistanceExpr = "NULLIF(ABS(tje.entry_price - tje.stop_loss_price), 0)"
+
+	// plannedRRExpr matches entity.TradingJournalEntry.PlannedRR.
+	plannedRRExpr = "ABS(tje.take_profit_price - tje.entry_price) / " + riskDistanceExpr
+
+	// achievedRRExpr is the R multiple the trade actually made. An entry
+	// closed through exits uses their size-weighted average price; any other
+	// entry is assumed to have closed at its target on TP, at its stop on SL
+	// and at entry on BE. Its placeholders take the TP and SL results.
+	achievedRRExpr = "COALESCE(" +
+		"SIGN(tje.take_profit_price - tje.entry_price) * (" +
+		"(SELECT SUM(ee.price * ee.size) / SUM(ee.size) FROM entry_exits AS ee WHERE ee.entry_id = tje.id)" +
+		" - tje.entry_price) / " + riskDistanceExpr + ", " +
+		"CASE tje.result WHEN ? THEN " + plannedRRExpr + " WHEN ? THEN -1 ELSE 0 END)"
+)
+
+// GetStatistics computes every journal statistic in one aggregate query, so
+// the numbers come from a single consistent snapshot even while entries are
+// being written.
+func (s *TradingJournalEntryStorage) GetStatistics(ctx context.Context, journalID uuid.UUID) (*entity.EntryStatistics, error) {
+	stats := new(entity.EntryStatistics)
+
+	err := s.statisticsQuery(ctx).
+		Where("journal_id = ?", journalID).
+		Scan(ctx, stats)
+
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get journal statistics")
+	}
+
+	return stats, nil
+}
+
+// GetStatisticsByDateRange computes the same statistics as GetStatistics
+// over the entries between StartDate and EndDate; Limit and Offset are
+// ignored.
+func (s *TradingJournalEntryStorage) GetStatisticsByDateRange(ctx context.Context, params GetByDateRangeParams) (*entity.EntryStatistics, error) {
+	stats := new(entity.EntryStatistics)
+
+	err := s.statisticsQuery(ctx).
+		Where("journal_id = ?", params.JournalID).
+		Where("day >= ?", params.StartDate).
+		Where("day <= ?", params.EndDate).
+		Scan(ctx, stats)
+
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get journal statistics by date range")
+	}
+
+	return stats, nil
+}
+
+// statisticsQuery selects the EntryStatistics aggregates; callers add the
+// filters.
+func (s *TradingJournalEntryStorage) statisticsQuery(ctx context.Context) *bun.SelectQuery {
+	// The result counts use COUNT(*) FILTER, which yields 0 rather than no
+	// row when a result never occurs, so wins, losses and break_even are
+	// always populated (a losses-only journal reports 0 wins).
+	//
+	// Entries recorded before risk tracking default to 0, so only entries
+	// with a recorded risk contribute to avg_risk_percent. Likewise only
+	// entries with all three prices have a planned RR, and achieved RR is
+	// averaged over the same entries so the two are comparable.
+	return s.reader(ctx).NewSelect().
+		Model((*entity.TradingJournalEntry)(nil)).
+		ColumnExpr("COUNT(*) AS total_trades").
+		ColumnExpr("COUNT(*) FILTER (WHERE result = ?) AS wins", types.TradeResultTakeProfit).
+		ColumnExpr("COUNT(*) FILTER (WHERE result = ?) AS losses", types.TradeResultStopLoss).
+		ColumnExpr("COUNT(*) FILTER (WHERE result = ?) AS break_even", types.TradeResultBreakEven).
+		ColumnExpr("COALESCE(SUM(realized), 0) AS total_realized").
+		ColumnExpr("COALESCE(AVG(max_rr), 0) AS avg_risk_reward").
+		ColumnExpr("COALESCE(AVG(risk_percent) FILTER (WHERE risk_percent > 0), 0) AS avg_risk_percent").
+		ColumnExpr("COALESCE(AVG(realized) FILTER (WHERE realized > 0), 0) AS avg_win").
+		ColumnExpr("COALESCE(ABS(AVG(realized) FILTER (WHERE realized < 0)), 0) AS avg_loss").
+		ColumnExpr("COALESCE(AVG("+plannedRRExpr+"), 0) AS avg_planned_rr").
+		ColumnExpr(
+			"COALESCE(AVG("+achievedRRExpr+") FILTER (WHERE "+plannedRRExpr+" IS NOT NULL), 0) AS avg_achieved_rr",
+			types.TradeResultTakeProfit,
+			types.TradeResultStopLoss,
+		).
+		ColumnExpr("MIN(day) AS range_start").
+		ColumnExpr("MAX(day) AS range_end")
+}
+
+// GetStatisticsByConfidence returns one row per confidence level that the
+// journal has entries for. Entries without a confidence are excluded.
+func (s *TradingJournalEntryStorage) GetStatisticsByConfidence(ctx context.Context, journalID uuid.UUID) ([]*entity.ConfidenceStatistics, error) {
+	var stats []*entity.ConfidenceStatistics
+
+	err := s.reader(ctx).NewSelect().
+		Model((*entity.TradingJournalEntry)(nil)).
+		Column("confidence").
+		ColumnExpr("COUNT(*) AS total_trades").
+		ColumnExpr("COUNT(*) FILTER (WHERE result = ?) AS wins", types.TradeResultTakeProfit).
+		ColumnExpr("COUNT(*) FILTER (WHERE result = ?) AS losses", types.TradeResultStopLoss).
+		ColumnExpr("COUNT(*) FILTER (WHERE result = ?) AS break_even", types.TradeResultBreakEven).
+		ColumnExpr("COALESCE(SUM(realized), 0) AS total_realized").
+		Where("journal_id = ?", journalID).
+		Where("confidence IS NOT NULL").
+		Group("confidence").
+		Order("confidence").
+		Scan(ctx, &stats)
+
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get statistics by confidence")
 	}
-	err = s.db.NewSelect().
+
+	return stats, nil
+}
+
+// GetStatisticsByPlanAdherence returns one row per followed_plan value that
+// the journal has entries for.
+func (s *TradingJournalEntryStorage) GetStatisticsByPlanAdherence(ctx context.Context, journalID uuid.UUID) ([]*entity.PlanAdherenceStatistics, error) {
+	var stats []*entity.PlanAdherenceStatistics
+
+	err := s.reader(ctx).NewSelect().
 		Model((*entity.TradingJournalEntry)(nil)).
-		Column("result").
-		ColumnExpr("COUNT(*) as count").
+		Column("followed_plan").
+		ColumnExpr("COUNT(*) AS total_trades").
+		ColumnExpr("COUNT(*) FILTER (WHERE result = ?) AS wins", types.TradeResultTakeProfit).
+		ColumnExpr("COUNT(*) FILTER (WHERE result = ?) AS losses", types.TradeResultStopLoss).
+		ColumnExpr("COUNT(*) FILTER (WHERE result = ?) AS break_even", types.TradeResultBreakEven).
+		ColumnExpr("COALESCE(SUM(realized), 0) AS total_realized").
 		Where("journal_id = ?", journalID).
-		Group("result").
-		Scan(ctx, &resultStats)
+		Group("followed_plan").
+		Scan(ctx, &stats)
 
 	if err != nil {
-		return nil, errors.Wrap(err, "failed to get result statistics")
+		return nil, errors.Wrap(err, "failed to get statistics by plan adherence")
 	}
 
-	for _, stat := range resultStats {
-		switch stat.Result {
-		case types.TradeResultTakeProfit:
-			stats["wins"] = stat.Count
-		case types.TradeResultStopLoss:
-			stats["losses"] = stat.Count
-		case types.TradeResultBreakEven:
-			stats["break_even"] = stat.Count
+	return stats, nil
+}
+
+// GetChecklistStatistics returns one row per checklist item used in the
+// journal, most used first. Entries without a checklist are excluded.
+func (s *TradingJournalEntryStorage) GetChecklistStatistics(ctx context.Context, journalID uuid.UUID) ([]*entity.ChecklistItemStatistics, error) {
+	var stats []*entity.ChecklistItemStatistics
+
+	// Every element of the checklist array joins as its own row, so each
+	// entry counts once towards every item it lists.
+	err := s.reader(ctx).NewSelect().
+		Model((*entity.TradingJournalEntry)(nil)).
+		TableExpr("jsonb_array_elements(tje.checklist) AS ci").
+		ColumnExpr("ci.value->>'item' AS item").
+		ColumnExpr("COUNT(*) AS total_trades").
+		ColumnExpr("COUNT(*) FILTER (WHERE (ci.value->>'checked')::boolean) AS checked").
+		ColumnExpr("COUNT(*) FILTER (WHERE (ci.value->>'checked')::boolean AND result = ?) AS checked_wins", types.TradeResultTakeProfit).
+		ColumnExpr("COUNT(*) FILTER (WHERE NOT (ci.value->>'checked')::boolean AND result = ?) AS unchecked_wins", types.TradeResultTakeProfit).
+		Where("journal_id = ?", journalID).
+		Where("checklist IS NOT NULL").
+		GroupExpr("ci.value->>'item'").
+		OrderExpr("total_trades DESC, item ASC").
+		Scan(ctx, &stats)
+
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get checklist statistics")
+	}
+
+	return stats, nil
+}
+
+func (s *TradingJournalEntryStorage) GetFacets(ctx context.Context, journalID uuid.UUID) (*entity.EntryFacets, error) {
+	facets := new(entity.EntryFacets)
+
+	columns := []struct {
+		name   string
+		values *[]*entity.FacetValue
+	}{
+		{"asset", &facets.Assets},
+		{"session", &facets.Sessions},
+		{"result", &facets.Results},
+		{"trade_type", &facets.TradeTypes},
+	}
+
+	for _, column := range columns {
+		err := s.reader(ctx).NewSelect().
+			Model((*entity.TradingJournalEntry)(nil)).
+			ColumnExpr("? AS value", bun.Ident(column.name)).
+			ColumnExpr("COUNT(*) AS count").
+			Where("journal_id = ?", journalID).
+			GroupExpr("?", bun.Ident(column.name)).
+			OrderExpr("count DESC, value ASC").
+			Scan(ctx, column.values)
+
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to get %s facet", column.name)
 		}
 	}
 
-	var totalRealized float64
-	err = s.db.NewSelect().
+	return facets, nil
+}
+
+func (s *TradingJournalEntryStorage) GetReviewProgress(ctx context.Context, journalID uuid.UUID) (*entity.ReviewProgress, error) {
+	progress := new(entity.ReviewProgress)
+
+	err := s.reader(ctx).NewSelect().
+		Model((*entity.TradingJournalEntry)(nil)).
+		ColumnExpr("COUNT(*) AS total").
+		ColumnExpr("COUNT(*) FILTER (WHERE review_status = ?) AS unreviewed", types.ReviewStatusUnreviewed).
+		ColumnExpr("COUNT(*) FILTER (WHERE review_status = ?) AS reviewed", types.ReviewStatusReviewed).
+		ColumnExpr("COUNT(*) FILTER (WHERE review_status = ?) AS flagged", types.ReviewStatusFlagged).
+		Where("journal_id = ?", journalID).
+		Scan(ctx, progress)
+
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get review progress")
+	}
+
+	return progress, nil
+}
+
+// GetUserJournalStatistics returns per-journal totals for every journal owned
+// by the user in a single query. Journals without entries are included with
+// zero counts.
+func (s *TradingJournalEntryStorage) GetUserJournalStatistics(ctx context.Context, userID uuid.UUID) ([]*entity.JournalStatistics, error) {
+	var stats []*entity.JournalStatistics
+
+	err := s.reader(ctx).NewSelect().
+		Model((*entity.TradingJournal)(nil)).
+		ColumnExpr("tj.id AS journal_id").
+		ColumnExpr("tj.name AS journal_name").
+		ColumnExpr("COUNT(tje.id) AS total_trades").
+		ColumnExpr("COUNT(tje.id) FILTER (WHERE tje.result = ?) AS wins", types.TradeResultTakeProfit).
+		ColumnExpr("COUNT(tje.id) FILTER (WHERE tje.result = ?) AS losses", types.TradeResultStopLoss).
+		ColumnExpr("COUNT(tje.id) FILTER (WHERE tje.result = ?) AS break_even", types.TradeResultBreakEven).
+		ColumnExpr("COALESCE(SUM(tje.realized), 0) AS total_realized").
+		Join("LEFT JOIN trading_journal_entries AS tje ON tje.journal_id = tj.id AND tje.deleted_at IS NULL").
+		Where("tj.user_id = ?", userID).
+		GroupExpr("tj.id, tj.name, tj.created_at").
+		OrderExpr("tj.created_at DESC, tj.id DESC").
+		Scan(ctx, &stats)
+
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get user journal statistics")
+	}
+
+	return stats, nil
+}
+
+// localDay is the calendar day of an entry in the time zone bound to its
+// placeholder. Days are stored as UTC timestamps without a zone.
+const localDay = "(day AT TIME ZONE 'UTC' AT TIME ZONE ?)::date"
+
+// GetDailyStatistics returns one row per calendar day in the year that has at
+// least one entry, ordered by day. Days and the year are those of loc.
+func (s *TradingJournalEntryStorage) GetDailyStatistics(ctx context.Context, journalID uuid.UUID, year int, loc *time.Location) ([]*entity.DailyStatistics, error) {
+	var stats []*entity.DailyStatistics
+
+	start := time.Date(year, time.January, 1, 0, 0, 0, 0, loc)
+	end := start.AddDate(1, 0, 0)
+
+	err := s.reader(ctx).NewSelect().
+		Model((*entity.TradingJournalEntry)(nil)).
+		ColumnExpr(localDay+" AS day", loc.String()).
+		ColumnExpr("COUNT(*) AS count").
+		ColumnExpr("COALESCE(SUM(realized), 0) AS net_realized").
+		Where("journal_id = ?", journalID).
+		Where("day >= ?", start.UTC()).
+		Where("day < ?", end.UTC()).
+		GroupExpr(localDay, loc.String()).
+		OrderExpr(localDay, loc.String()).
+		Scan(ctx, &stats)
+
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get daily statistics")
+	}
+
+	return stats, nil
+}
+
+// GetDailyAssetStatistics returns one row per day and asset traded, with
+// days those of loc.
+func (s *TradingJournalEntryStorage) GetDailyAssetStatistics(ctx context.Context, journalID uuid.UUID, loc *time.Location) ([]*entity.DailyAssetStatistics, error) {
+	var stats []*entity.DailyAssetStatistics
+
+	err := s.reader(ctx).NewSelect().
+		Model((*entity.TradingJournalEntry)(nil)).
+		ColumnExpr(localDay+" AS day", loc.String()).
+		Column("asset").
+		ColumnExpr("COUNT(*) AS total_trades").
+		ColumnExpr("COUNT(*) FILTER (WHERE result = ?) AS wins", types.TradeResultTakeProfit).
+		Where("journal_id = ?", journalID).
+		GroupExpr(localDay+", asset", loc.String()).
+		OrderExpr(localDay+", asset", loc.String()).
+		Scan(ctx, &stats)
+
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get daily asset statistics")
+	}
+
+	return stats, nil
+}
+
+func (s *TradingJournalEntryStorage) GetStatisticsByAsset(ctx context.Context, journalID uuid.UUID) ([]*entity.AssetTradeStatistics, error) {
+	var stats []*entity.AssetTradeStatistics
+
+	err := s.reader(ctx).NewSelect().
 		Model((*entity.TradingJournalEntry)(nil)).
-		ColumnExpr("COALESCE(SUM(realized), 0) as total").
+		Column("asset").
+		ColumnExpr("COUNT(*) AS total_trades").
+		ColumnExpr("COUNT(*) FILTER (WHERE result = ?) AS wins", types.TradeResultTakeProfit).
+		ColumnExpr("COUNT(*) FILTER (WHERE result = ?) AS losses", types.TradeResultStopLoss).
+		ColumnExpr("COUNT(*) FILTER (WHERE result = ?) AS break_even", types.TradeResultBreakEven).
+		ColumnExpr("COALESCE(SUM(realized), 0) AS total_realized").
 		Where("journal_id = ?", journalID).
-		Scan(ctx, &totalRealized)
+		Group("asset").
+		Order("asset").
+		Scan(ctx, &stats)
 
 	if err != nil {
-		return nil, errors.Wrap(err, "failed to calculate total realized")
+		return nil, errors.Wrap(err, "failed to get statistics by asset")
 	}
-	stats["total_realized"] = totalRealized
 
-	var avgRR float64
-	err = s.db.NewSelect().
+	return stats, nil
+}
+
+// GetStatisticsByGrade groups the graded entries by grade, best first.
+func (s *TradingJournalEntryStorage) GetStatisticsByGrade(ctx context.Context, journalID uuid.UUID) ([]*entity.GradeStatistics, error) {
+	var stats []*entity.GradeStatistics
+
+	err := s.reader(ctx).NewSelect().
+		Model((*entity.TradingJournalEntry)(nil)).
+		Column("grade").
+		ColumnExpr("COUNT(*) AS total_trades").
+		ColumnExpr("COUNT(*) FILTER (WHERE result = ?) AS wins", types.TradeResultTakeProfit).
+		ColumnExpr("COUNT(*) FILTER (WHERE result = ?) AS losses", types.TradeResultStopLoss).
+		ColumnExpr("COUNT(*) FILTER (WHERE result = ?) AS break_even", types.TradeResultBreakEven).
+		ColumnExpr("COALESCE(SUM(realized), 0) AS total_realized").
+		Where("journal_id = ?", journalID).
+		Where("grade IS NOT NULL").
+		Group("grade").
+		Order("grade").
+		Scan(ctx, &stats)
+
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get statistics by grade")
+	}
+
+	return stats, nil
+}
+
+func (s *TradingJournalEntryStorage) GetStatisticsByEmotion(ctx context.Context, journalID uuid.UUID) ([]*entity.EmotionStatistics, error) {
+	var stats []*entity.EmotionStatistics
+
+	err := s.reader(ctx).NewSelect().
 		Model((*entity.TradingJournalEntry)(nil)).
-		ColumnExpr("COALESCE(AVG(max_rr), 0) as avg").
+		Column("emotion").
+		ColumnExpr("COUNT(*) AS total_trades").
+		ColumnExpr("COUNT(*) FILTER (WHERE result = ?) AS wins", types.TradeResultTakeProfit).
+		ColumnExpr("COUNT(*) FILTER (WHERE result = ?) AS losses", types.TradeResultStopLoss).
+		ColumnExpr("COUNT(*) FILTER (WHERE result = ?) AS break_even", types.TradeResultBreakEven).
+		ColumnExpr("COALESCE(SUM(realized), 0) AS total_realized").
 		Where("journal_id = ?", journalID).
-		Scan(ctx, &avgRR)
+		Where("emotion IS NOT NULL").
+		Group("emotion").
+		Order("emotion").
+		Scan(ctx, &stats)
 
 	if err != nil {
-		return nil, errors.Wrap(err, "failed to calculate average RR")
+		return nil, errors.Wrap(err, "failed to get statistics by emotion")
 	}
-	stats["avg_risk_reward"] = avgRR
 
 	return stats, nil
 }
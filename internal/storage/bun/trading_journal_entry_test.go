@@ -0,0 +1,656 @@
+package bun
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/cockroachdb/errors"
+	"github.com/google/uuid"
+	"github.com/user/normark/internal/entity"
+	"github.com/user/normark/internal/types"
+)
+
+func TestLoadTransferredComputesRealizedPips(t *testing.T) {
+	log, db := newFakeDB()
+	s := NewTradingJournalEntryStorage(db)
+	ids := []uuid.UUID{uuid.New(), uuid.New()}
+
+	if _, err := s.loadTransferred(context.Background(), db, ids); err == nil {
+		t.Fatal("loadTransferred() error = nil, want the fake db error")
+	}
+
+	queries := log.Queries()
+	if len(queries) != 1 {
+		t.Fatalf("loadTransferred() sent %d queries, want 1", len(queries))
+	}
+
+	// realized_pips must come from the journal the entries now belong to,
+	// which the subquery looks up by the entry's own journal_id.
+	query := queries[0]
+	for _, want := range []string{
+		"AS realized_pips",
+		"WHERE j.id = tje.journal_id",
+		ids[0].String(),
+		ids[1].String(),
+	} {
+		if !strings.Contains(query, want) {
+			t.Errorf("query %q does not contain %q", query, want)
+		}
+	}
+}
+
+func TestGetByTradeTypeQuery(t *testing.T) {
+	log, db := newFakeDB()
+	journalID := uuid.New()
+
+	_, _ = NewTradingJournalEntryStorage(db).GetByTradeType(context.Background(), GetByTradeTypeParams{
+		JournalID: journalID,
+		TradeType: types.TradeTypeSwing,
+		Limit:     20,
+		Offset:    40,
+	})
+
+	queries := log.Queries()
+	if len(queries) != 1 {
+		t.Fatalf("sent %d queries, want 1", len(queries))
+	}
+	for _, want := range []string{
+		"journal_id = '" + journalID.String() + "'",
+		"trade_type = 'swing'",
+		"LIMIT 20 OFFSET 40",
+	} {
+		if !strings.Contains(queries[0], want) {
+			t.Errorf("query %q does not contain %q", queries[0], want)
+		}
+	}
+}
+
+// TestFilterPredicates checks that both the page and its total apply each
+// filter, so pagination counts only matching entries.
+func TestFilterPredicates(t *testing.T) {
+	tests := []struct {
+		name    string
+		params  FilterParams
+		want    []string
+		notWant []string
+	}{
+		{
+			name:    "no filters",
+			notWant: []string{"trade_type =", "entry_type =", "max_rr >=", "max_rr <=", "review_status ="},
+		},
+		{
+			name:   "review status",
+			params: FilterParams{ReviewStatus: ptr(types.ReviewStatusFlagged)},
+			want:   []string{"review_status = 'flagged'"},
+		},
+		{
+			name:   "followed plan",
+			params: FilterParams{FollowedPlan: ptr(false)},
+			want:   []string{"followed_plan = FALSE"},
+		},
+		{
+			name:   "trade type",
+			params: FilterParams{TradeType: ptr(types.TradeTypeIntraday)},
+			want:   []string{"trade_type = 'intraday'"},
+		},
+		{
+			name:   "entry type",
+			params: FilterParams{EntryType: ptr(types.EntryTypeLimit)},
+			want:   []string{"entry_type = 'limit'"},
+		},
+		{
+			name:   "trade and entry type",
+			params: FilterParams{TradeType: ptr(types.TradeTypeSwing), EntryType: ptr(types.EntryTypeMarket)},
+			want:   []string{"trade_type = 'swing'", "entry_type = 'market'"},
+		},
+		{
+			name:   "rr band",
+			params: FilterParams{MinRR: ptr(2.0), MaxRR: ptr(4.5)},
+			want:   []string{"max_rr >= 2", "max_rr <= 4.5"},
+		},
+		{
+			name:    "rr floor only",
+			params:  FilterParams{MinRR: ptr(3.0), TradeType: ptr(types.TradeTypeSwing)},
+			want:    []string{"max_rr >= 3", "trade_type = 'swing'"},
+			notWant: []string{"max_rr <="},
+		},
+		{
+			name:   "losses band",
+			params: FilterParams{MinRealized: ptr(-500.0), MaxRealized: ptr(-100.0)},
+			want:   []string{"realized >= -500", "realized <= -100"},
+		},
+		{
+			name:   "wins band with rr",
+			params: FilterParams{MinRealized: ptr(50.0), MaxRealized: ptr(1000.0), MinRR: ptr(2.0)},
+			want:   []string{"realized >= 50", "realized <= 1000", "max_rr >= 2"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			log, db := newFakeDB()
+			s := NewTradingJournalEntryStorage(db)
+			params := tt.params
+			params.JournalID = uuid.New()
+
+			_, _ = s.Filter(context.Background(), params)
+			_, _ = s.CountFiltered(context.Background(), params)
+
+			queries := log.Queries()
+			if len(queries) != 2 {
+				t.Fatalf("sent %d queries, want 2", len(queries))
+			}
+			for _, query := range queries {
+				for _, want := range append(tt.want, "journal_id = '"+params.JournalID.String()+"'") {
+					if !strings.Contains(query, want) {
+						t.Errorf("query %q does not contain %q", query, want)
+					}
+				}
+				for _, notWant := range tt.notWant {
+					if strings.Contains(query, notWant) {
+						t.Errorf("query %q contains %q", query, notWant)
+					}
+				}
+			}
+		})
+	}
+}
+
+func ptr[T any](v T) *T {
+	return &v
+}
+
+// TestReadsExcludeSoftDeletedEntries checks that lists, counts and
+// statistics skip soft-deleted entries, while the ownership check of a hard
+// delete still finds them.
+func TestReadsExcludeSoftDeletedEntries(t *testing.T) {
+	const notDeleted = `"tje"."deleted_at" IS NULL`
+	journalID := uuid.New()
+	ctx := context.Background()
+
+	tests := []struct {
+		name        string
+		call        func(s *TradingJournalEntryStorage)
+		wantDeleted bool
+	}{
+		{"list", func(s *TradingJournalEntryStorage) {
+			_, _ = s.GetByJournalID(ctx, GetByJournalIDParams{JournalID: journalID, Limit: 20})
+		}, false},
+		{"filter", func(s *TradingJournalEntryStorage) {
+			_, _ = s.Filter(ctx, FilterParams{JournalID: journalID, Limit: 20})
+		}, false},
+		{"count filtered", func(s *TradingJournalEntryStorage) {
+			_, _ = s.CountFiltered(ctx, FilterParams{JournalID: journalID})
+		}, false},
+		{"count by journal", func(s *TradingJournalEntryStorage) {
+			_, _ = s.CountByJournalID(ctx, journalID)
+		}, false},
+		{"statistics", func(s *TradingJournalEntryStorage) {
+			_, _ = s.GetStatistics(ctx, journalID)
+		}, false},
+		{"exists", func(s *TradingJournalEntryStorage) {
+			_, _ = s.Exists(ctx, uuid.New(), journalID)
+		}, false},
+		{"exists with deleted", func(s *TradingJournalEntryStorage) {
+			_, _ = s.ExistsWithDeleted(ctx, uuid.New(), journalID)
+		}, true},
+		{"modified since", func(s *TradingJournalEntryStorage) {
+			_, _ = s.GetModifiedSince(ctx, GetModifiedSinceParams{JournalID: journalID, Limit: 21})
+		}, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			log, db := newFakeDB()
+			tt.call(NewTradingJournalEntryStorage(db))
+
+			queries := log.Queries()
+			if len(queries) != 1 {
+				t.Fatalf("sent %d queries, want 1", len(queries))
+			}
+			if excludes := strings.Contains(queries[0], notDeleted); excludes == tt.wantDeleted {
+				t.Errorf("query %q excludes soft-deleted entries: %v, want %v", queries[0], excludes, !tt.wantDeleted)
+			}
+		})
+	}
+}
+
+func TestGetModifiedSinceQuery(t *testing.T) {
+	journalID := uuid.New()
+	afterID := uuid.New()
+	since := time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+	after := since.Add(time.Minute)
+
+	tests := []struct {
+		name   string
+		params GetModifiedSinceParams
+		cursor bool
+	}{
+		{name: "first page", params: GetModifiedSinceParams{JournalID: journalID, Since: since, Limit: 21}},
+		{name: "after cursor", params: GetModifiedSinceParams{JournalID: journalID, Since: since, AfterUpdatedAt: &after, AfterID: afterID, Limit: 21}, cursor: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			log, db := newFakeDB()
+			_, _ = NewTradingJournalEntryStorage(db).GetModifiedSince(context.Background(), tt.params)
+
+			queries := log.Queries()
+			if len(queries) != 1 {
+				t.Fatalf("sent %d queries, want 1", len(queries))
+			}
+			for _, want := range []string{
+				"journal_id = '" + journalID.String() + "'",
+				"updated_at > '2026-03-01 12:00:00",
+				`ORDER BY "updated_at" ASC, "id" ASC`,
+				"LIMIT 21",
+			} {
+				if !strings.Contains(queries[0], want) {
+					t.Errorf("query %q does not contain %q", queries[0], want)
+				}
+			}
+			cursor := "(updated_at, id) > ('2026-03-01 12:01:00+00:00', '" + afterID.String() + "')"
+			if got := strings.Contains(queries[0], cursor); got != tt.cursor {
+				t.Errorf("query %q contains cursor predicate: %v, want %v", queries[0], got, tt.cursor)
+			}
+		})
+	}
+}
+
+func TestDateRangeQueries(t *testing.T) {
+	journalID := uuid.New()
+	params := GetByDateRangeParams{
+		JournalID: journalID,
+		StartDate: time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC),
+		EndDate:   time.Date(2025, 12, 31, 0, 0, 0, 0, time.UTC),
+		Limit:     20,
+		Offset:    40,
+	}
+
+	tests := []struct {
+		name      string
+		query     func(s *TradingJournalEntryStorage)
+		paginated bool
+	}{
+		{
+			name:      "get by date range",
+			query:     func(s *TradingJournalEntryStorage) { _, _ = s.GetByDateRange(context.Background(), params) },
+			paginated: true,
+		},
+		{
+			name:  "count by date range",
+			query: func(s *TradingJournalEntryStorage) { _, _ = s.CountByDateRange(context.Background(), params) },
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			log, db := newFakeDB()
+			tt.query(NewTradingJournalEntryStorage(db))
+
+			queries := log.Queries()
+			if len(queries) != 1 {
+				t.Fatalf("sent %d queries, want 1", len(queries))
+			}
+			for _, want := range []string{
+				"journal_id = '" + journalID.String() + "'",
+				"day >= '2025-01-01 00:00:00",
+				"day <= '2025-12-31 00:00:00",
+			} {
+				if !strings.Contains(queries[0], want) {
+					t.Errorf("query %q does not contain %q", queries[0], want)
+				}
+			}
+			for _, page := range []string{"LIMIT 20", "OFFSET 40", `ORDER BY "day" DESC, "id" DESC`} {
+				if got := strings.Contains(queries[0], page); got != tt.paginated {
+					t.Errorf("query %q contains %q: %v, want %v", queries[0], page, got, tt.paginated)
+				}
+			}
+		})
+	}
+}
+
+func TestRestoreLastDeletedQuery(t *testing.T) {
+	journalID := uuid.New()
+	deletedAfter := time.Date(2026, 3, 2, 9, 50, 0, 0, time.UTC)
+	log, db := newFakeDB()
+
+	if _, err := NewTradingJournalEntryStorage(db).RestoreLastDeleted(context.Background(), journalID, deletedAfter); err == nil {
+		t.Fatal("RestoreLastDeleted() error = nil, want the database error")
+	}
+
+	queries := log.Queries()
+	if len(queries) != 1 {
+		t.Fatalf("sent %d queries, want 1: %q", len(queries), queries)
+	}
+	for _, want := range []string{
+		`UPDATE "trading_journal_entries" AS "tje" SET deleted_at = NULL`,
+		`"tje"."deleted_at" IS NOT NULL`,
+		`journal_id = '` + journalID.String() + `'`,
+		`deleted_at > '2026-03-02 09:50:00`,
+		`ORDER BY deleted_at DESC, id DESC LIMIT 1`,
+		`RETURNING *`,
+	} {
+		if !strings.Contains(queries[0], want) {
+			t.Errorf("query %q does not contain %q", queries[0], want)
+		}
+	}
+}
+
+func TestGetStatisticsByAssetGroupsOnce(t *testing.T) {
+	journalID := uuid.New()
+	log, db := newFakeDB()
+
+	_, _ = NewTradingJournalEntryStorage(db).GetStatisticsByAsset(context.Background(), journalID)
+
+	queries := log.Queries()
+	if len(queries) != 1 {
+		t.Fatalf("sent %d queries, want 1", len(queries))
+	}
+	for _, want := range []string{
+		"journal_id = '" + journalID.String() + "'",
+		`GROUP BY "asset"`,
+		"COALESCE(SUM(realized), 0) AS total_realized",
+	} {
+		if !strings.Contains(queries[0], want) {
+			t.Errorf("query %q does not contain %q", queries[0], want)
+		}
+	}
+}
+
+func TestGetStatisticsByGradeSkipsUngradedEntries(t *testing.T) {
+	log, db := newFakeDB()
+
+	_, _ = NewTradingJournalEntryStorage(db).GetStatisticsByGrade(context.Background(), uuid.New())
+
+	queries := log.Queries()
+	if len(queries) != 1 {
+		t.Fatalf("sent %d queries, want 1", len(queries))
+	}
+	for _, want := range []string{"grade IS NOT NULL", `GROUP BY "grade"`, `ORDER BY "grade"`} {
+		if !strings.Contains(queries[0], want) {
+			t.Errorf("query %q does not contain %q", queries[0], want)
+		}
+	}
+}
+
+func TestStatisticsCoverEntryDateSpan(t *testing.T) {
+	log, db := newFakeDB()
+	s := NewTradingJournalEntryStorage(db)
+
+	_, _ = s.GetStatistics(context.Background(), uuid.New())
+	_, _ = s.GetStatisticsByDateRange(context.Background(), GetByDateRangeParams{
+		JournalID: uuid.New(),
+		StartDate: time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC),
+		EndDate:   time.Date(2026, 1, 31, 0, 0, 0, 0, time.UTC),
+	})
+
+	queries := log.Queries()
+	if len(queries) != 2 {
+		t.Fatalf("sent %d queries, want 2", len(queries))
+	}
+	for _, query := range queries {
+		for _, want := range []string{"MIN(day) AS range_start", "MAX(day) AS range_end"} {
+			if !strings.Contains(query, want) {
+				t.Errorf("query %q does not contain %q", query, want)
+			}
+		}
+	}
+}
+
+func TestTransferEntriesRequiresBothJournalsOfUser(t *testing.T) {
+	userID := uuid.New()
+	log, db := newEmptyDB()
+
+	_, err := NewTradingJournalEntryStorage(db).TransferEntries(context.Background(), TransferEntriesParams{
+		UserID:          userID,
+		SourceJournalID: uuid.New(),
+		TargetJournalID: uuid.New(),
+		EntryIDs:        []uuid.UUID{uuid.New()},
+	})
+	if !errors.Is(err, entity.ErrNotFound) {
+		t.Fatalf("TransferEntries() error = %v, want %v", err, entity.ErrNotFound)
+	}
+
+	queries := log.Queries()
+	if len(queries) != 1 {
+		t.Fatalf("sent %d queries, want only the journal lookup", len(queries))
+	}
+	for _, want := range []string{"user_id = '" + userID.String() + "'", "FOR SHARE"} {
+		if !strings.Contains(queries[0], want) {
+			t.Errorf("query %q does not contain %q", queries[0], want)
+		}
+	}
+}
+
+func TestLinkEntriesLocksBothEntriesOfJournal(t *testing.T) {
+	journalID := uuid.New()
+	entryID, relatedID := uuid.New(), uuid.New()
+	log, db := newEmptyDB()
+
+	err := NewTradingJournalEntryStorage(db).LinkEntries(context.Background(), journalID, entryID, relatedID)
+	if !errors.Is(err, entity.ErrNotFound) {
+		t.Fatalf("LinkEntries() error = %v, want %v", err, entity.ErrNotFound)
+	}
+
+	queries := log.Queries()
+	if len(queries) != 1 {
+		t.Fatalf("sent %d queries, want only the entry lookup", len(queries))
+	}
+	for _, want := range []string{
+		"id IN ('" + entryID.String() + "', '" + relatedID.String() + "')",
+		"journal_id = '" + journalID.String() + "'",
+		"FOR UPDATE",
+	} {
+		if !strings.Contains(queries[0], want) {
+			t.Errorf("query %q does not contain %q", queries[0], want)
+		}
+	}
+}
+
+func TestLinkQueries(t *testing.T) {
+	journalID := uuid.New()
+	entryID, relatedID := uuid.New(), uuid.New()
+
+	tests := []struct {
+		name  string
+		query func(s *TradingJournalEntryStorage)
+		want  []string
+	}{
+		{
+			name: "unlink removes the other id from each side",
+			query: func(s *TradingJournalEntryStorage) {
+				_ = s.UnlinkEntries(context.Background(), journalID, entryID, relatedID)
+			},
+			want: []string{
+				"related_entry_ids = array_remove(related_entry_ids, CASE WHEN id = '" + entryID.String() + "' THEN '" + relatedID.String() + "'::uuid ELSE '" + entryID.String() + "'::uuid END)",
+				"id IN ('" + entryID.String() + "', '" + relatedID.String() + "')",
+				"journal_id = '" + journalID.String() + "'",
+			},
+		},
+		{
+			name: "linked entries of the journal",
+			query: func(s *TradingJournalEntryStorage) {
+				_, _ = s.GetByIDs(context.Background(), journalID, []uuid.UUID{relatedID})
+			},
+			want: []string{
+				"id IN ('" + relatedID.String() + "')",
+				"journal_id = '" + journalID.String() + "'",
+				`"tje"."deleted_at" IS NULL`,
+				`ORDER BY "day" DESC, "id" DESC`,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			log, db := newFakeDB()
+			tt.query(NewTradingJournalEntryStorage(db))
+
+			queries := log.Queries()
+			if len(queries) != 1 {
+				t.Fatalf("sent %d queries, want 1", len(queries))
+			}
+			for _, want := range tt.want {
+				if !strings.Contains(queries[0], want) {
+					t.Errorf("query %q does not contain %q", queries[0], want)
+				}
+			}
+		})
+	}
+}
+
+func TestGetByIDsWithoutIDsSendsNoQuery(t *testing.T) {
+	log, db := newFakeDB()
+
+	entries, err := NewTradingJournalEntryStorage(db).GetByIDs(context.Background(), uuid.New(), nil)
+	if err != nil || entries == nil || len(entries) != 0 {
+		t.Fatalf("GetByIDs() = %v, %v, want an empty list", entries, err)
+	}
+	if queries := log.Queries(); len(queries) != 0 {
+		t.Errorf("sent %q, want no queries", queries)
+	}
+}
+
+// TestEntryReadsComputeRealizedPips checks that every entry read divides
+// realized by the journal's pip value, with a zero pip value read as unset.
+func TestEntryReadsComputeRealizedPips(t *testing.T) {
+	ctx := context.Background()
+	journalID := uuid.New()
+
+	tests := []struct {
+		name  string
+		query func(s *TradingJournalEntryStorage)
+	}{
+		{"by id", func(s *TradingJournalEntryStorage) { _, _ = s.GetByID(ctx, uuid.New()) }},
+		{"by id with journal", func(s *TradingJournalEntryStorage) { _, _ = s.GetByIDWithJournal(ctx, uuid.New()) }},
+		{"by ids", func(s *TradingJournalEntryStorage) { _, _ = s.GetByIDs(ctx, journalID, []uuid.UUID{uuid.New()}) }},
+		{"of journal", func(s *TradingJournalEntryStorage) {
+			_, _ = s.GetByJournalID(ctx, GetByJournalIDParams{JournalID: journalID, Limit: 20})
+		}},
+		{"by date range", func(s *TradingJournalEntryStorage) {
+			_, _ = s.GetByDateRange(ctx, GetByDateRangeParams{JournalID: journalID, Limit: 20})
+		}},
+		{"by asset", func(s *TradingJournalEntryStorage) {
+			_, _ = s.GetByAsset(ctx, GetByAssetParams{JournalID: journalID, Asset: types.CurrencyPairEURUSD, Limit: 20})
+		}},
+		{"by session", func(s *TradingJournalEntryStorage) {
+			_, _ = s.GetBySession(ctx, GetBySessionParams{JournalID: journalID, Session: types.TradingSessionLondon, Limit: 20})
+		}},
+		{"by result", func(s *TradingJournalEntryStorage) {
+			_, _ = s.GetByResult(ctx, GetByResultParams{JournalID: journalID, Result: types.TradeResultTakeProfit, Limit: 20})
+		}},
+		{"by trade type", func(s *TradingJournalEntryStorage) {
+			_, _ = s.GetByTradeType(ctx, GetByTradeTypeParams{JournalID: journalID, TradeType: types.TradeTypeIntraday, Limit: 20})
+		}},
+		{"filtered", func(s *TradingJournalEntryStorage) {
+			_, _ = s.Filter(ctx, FilterParams{JournalID: journalID, Limit: 20})
+		}},
+		{"modified since", func(s *TradingJournalEntryStorage) {
+			_, _ = s.GetModifiedSince(ctx, GetModifiedSinceParams{JournalID: journalID, Limit: 20})
+		}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			log, db := newFakeDB()
+			tt.query(NewTradingJournalEntryStorage(db))
+
+			queries := log.Queries()
+			if len(queries) == 0 {
+				t.Fatal("sent no queries")
+			}
+			for _, want := range []string{
+				"tje.realized / (SELECT NULLIF(j.pip_value, 0) FROM trading_journals AS j WHERE j.id = tje.journal_id) AS realized_pips",
+				"tje.*",
+			} {
+				if !strings.Contains(queries[0], want) {
+					t.Errorf("query %q does not contain %q", queries[0], want)
+				}
+			}
+		})
+	}
+}
+
+func TestFilterQueryConditions(t *testing.T) {
+	journalID := uuid.New()
+	asset := types.CurrencyPairEURUSD
+	session := types.TradingSessionLondon
+	result := types.TradeResultBreakEven
+	start := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	end := time.Date(2026, 1, 31, 0, 0, 0, 0, time.UTC)
+	log, db := newFakeDB()
+
+	_, _ = NewTradingJournalEntryStorage(db).Filter(context.Background(), FilterParams{
+		JournalID: journalID,
+		Asset:     &asset,
+		Session:   &session,
+		Result:    &result,
+		StartDate: &start,
+		EndDate:   &end,
+		Limit:     20,
+	})
+
+	queries := log.Queries()
+	if len(queries) != 1 {
+		t.Fatalf("sent %d queries, want 1", len(queries))
+	}
+	for _, want := range []string{
+		"journal_id = '" + journalID.String() + "'",
+		"asset = 'EURUSD'",
+		"session = 'london'",
+		"result = 'BE'",
+		"day >= '2026-01-01",
+		"day <= '2026-01-31",
+	} {
+		if !strings.Contains(queries[0], want) {
+			t.Errorf("query %q does not contain %q", queries[0], want)
+		}
+	}
+}
+
+func TestBulkUpdateLocksMatchingEntries(t *testing.T) {
+	journalID := uuid.New()
+	result := types.TradeResultBreakEven
+	log, db := newEmptyDB()
+	applied := 0
+
+	entries, err := NewTradingJournalEntryStorage(db).BulkUpdate(context.Background(), BulkUpdateParams{
+		Filter: FilterParams{JournalID: journalID, Result: &result, Limit: 5, Offset: 10},
+		Max:    1000,
+		DryRun: true,
+		Apply: func(*entity.TradingJournalEntry) error {
+			applied++
+			return nil
+		},
+	})
+	if err != nil {
+		t.Fatalf("BulkUpdate() error = %v", err)
+	}
+	if len(entries) != 0 || applied != 0 {
+		t.Errorf("BulkUpdate() = %d entries after %d applies, want none", len(entries), applied)
+	}
+
+	queries := log.Queries()
+	if len(queries) != 1 {
+		t.Fatalf("sent %d queries, want only the entry lookup of a dry run", len(queries))
+	}
+	// One row past the cap is read to detect an update that matches too
+	// many entries; the filter's own limit and offset are ignored.
+	for _, want := range []string{
+		"journal_id = '" + journalID.String() + "'",
+		"result = 'BE'",
+		`"tje"."deleted_at" IS NULL`,
+		"LIMIT 1001",
+		"FOR UPDATE",
+	} {
+		if !strings.Contains(queries[0], want) {
+			t.Errorf("query %q does not contain %q", queries[0], want)
+		}
+	}
+	if strings.Contains(queries[0], "OFFSET") {
+		t.Errorf("query %q applies the filter offset", queries[0])
+	}
+}
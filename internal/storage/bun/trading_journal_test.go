@@ -0,0 +1,288 @@
+package bun
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/cockroachdb/errors"
+	"github.com/google/uuid"
+	"github.com/uptrace/bun"
+	"github.com/user/normark/internal/entity"
+)
+
+func TestUserJournalsExcludeArchived(t *testing.T) {
+	const archivedPredicate = "is_archived = FALSE"
+	userID := uuid.New()
+
+	tests := []struct {
+		name            string
+		includeArchived bool
+	}{
+		{"default", false},
+		{"include archived", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			log, db := newFakeDB()
+			s := NewTradingJournalStorage(db)
+
+			_, _ = s.GetByUserID(context.Background(), userID, 20, 0, tt.includeArchived, "")
+			_, _ = s.CountByUserID(context.Background(), userID, tt.includeArchived, "")
+
+			queries := log.Queries()
+			if len(queries) != 2 {
+				t.Fatalf("sent %d queries, want 2", len(queries))
+			}
+			for _, query := range queries {
+				if got := strings.Contains(query, archivedPredicate); got == tt.includeArchived {
+					t.Errorf("query %q filters archived journals: %v, want %v", query, got, !tt.includeArchived)
+				}
+			}
+		})
+	}
+}
+
+func TestGetByIDReadsArchivedJournals(t *testing.T) {
+	log, db := newFakeDB()
+
+	_, _ = NewTradingJournalStorage(db).GetByID(context.Background(), uuid.New())
+
+	queries := log.Queries()
+	if len(queries) != 1 {
+		t.Fatalf("sent %d queries, want 1", len(queries))
+	}
+	if strings.Contains(queries[0], "is_archived") {
+		t.Errorf("query %q filters on the archived flag", queries[0])
+	}
+}
+
+func TestMissingRowsAreNotFound(t *testing.T) {
+	ctx := context.Background()
+	id := uuid.New()
+
+	tests := []struct {
+		name string
+		call func(db *bun.DB) error
+	}{
+		{"journal by id", func(db *bun.DB) error {
+			_, err := NewTradingJournalStorage(db).GetByID(ctx, id)
+			return err
+		}},
+		{"journal with entries", func(db *bun.DB) error {
+			_, err := NewTradingJournalStorage(db).GetByIDWithEntries(ctx, id)
+			return err
+		}},
+		{"journal by name", func(db *bun.DB) error {
+			_, err := NewTradingJournalStorage(db).GetByName(ctx, id, "Swing")
+			return err
+		}},
+		{"journal update", func(db *bun.DB) error {
+			journal := entity.NewTradingJournal(uuid.New(), "Swing", "")
+			journal.ID = id
+			return NewTradingJournalStorage(db).Update(ctx, journal)
+		}},
+		{"journal archive", func(db *bun.DB) error {
+			return NewTradingJournalStorage(db).SetArchived(ctx, id, true)
+		}},
+		{"journal lock", func(db *bun.DB) error {
+			return NewTradingJournalStorage(db).SetLocked(ctx, id, true)
+		}},
+		{"journal lock check", func(db *bun.DB) error {
+			_, err := NewTradingJournalStorage(db).IsLocked(ctx, id)
+			return err
+		}},
+		{"entry by id", func(db *bun.DB) error {
+			_, err := NewTradingJournalEntryStorage(db).GetByID(ctx, id)
+			return err
+		}},
+		{"entry undo", func(db *bun.DB) error {
+			_, err := NewTradingJournalEntryStorage(db).RestoreLastDeleted(ctx, id, time.Now())
+			return err
+		}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, empty := newEmptyDB()
+			if err := tt.call(empty); !errors.Is(err, entity.ErrNotFound) {
+				t.Errorf("missing row: error = %v, want ErrNotFound", err)
+			}
+
+			// A failing database is an internal error, not a missing row.
+			_, failing := newFakeDB()
+			err := tt.call(failing)
+			if err == nil || errors.Is(err, entity.ErrNotFound) {
+				t.Errorf("database failure: error = %v, want an error other than ErrNotFound", err)
+			}
+		})
+	}
+}
+
+func TestJournalReadsIncludeEntrySpan(t *testing.T) {
+	spanColumns := []string{
+		`(SELECT MIN(e.day) FROM trading_journal_entries AS e WHERE e.journal_id = tj.id AND e.deleted_at IS NULL) AS first_entry_date`,
+		`(SELECT MAX(e.day) FROM trading_journal_entries AS e WHERE e.journal_id = tj.id AND e.deleted_at IS NULL) AS last_entry_date`,
+	}
+
+	tests := []struct {
+		name  string
+		query func(s *TradingJournalStorage)
+	}{
+		{"by id", func(s *TradingJournalStorage) { _, _ = s.GetByID(context.Background(), uuid.New()) }},
+		{"by name", func(s *TradingJournalStorage) { _, _ = s.GetByName(context.Background(), uuid.New(), "Swing") }},
+		{"by user", func(s *TradingJournalStorage) {
+			_, _ = s.GetByUserID(context.Background(), uuid.New(), 20, 0, false, "")
+		}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			log, db := newFakeDB()
+			tt.query(NewTradingJournalStorage(db))
+
+			queries := log.Queries()
+			if len(queries) == 0 {
+				t.Fatal("sent no queries")
+			}
+			for _, want := range spanColumns {
+				if !strings.Contains(queries[0], want) {
+					t.Errorf("query %q does not contain %q", queries[0], want)
+				}
+			}
+		})
+	}
+}
+
+func TestNameTakenComparesCaseInsensitively(t *testing.T) {
+	userID := uuid.New()
+	excludeID := uuid.New()
+	log, db := newFakeDB()
+
+	if _, err := NewTradingJournalStorage(db).NameTaken(context.Background(), userID, "Swing", excludeID); err == nil {
+		t.Fatal("NameTaken() error = nil, want the database error")
+	}
+
+	queries := log.Queries()
+	if len(queries) != 1 {
+		t.Fatalf("sent %d queries, want 1", len(queries))
+	}
+	want := "user_id = '" + userID.String() + "' AND LOWER(name) = LOWER('Swing') AND id <> '" + excludeID.String() + "'"
+	if !strings.Contains(queries[0], want) {
+		t.Errorf("query %q does not contain %q", queries[0], want)
+	}
+}
+
+func TestAddEntriesInsertsAndRecomputesSummary(t *testing.T) {
+	journalID := uuid.New()
+	entries := []*entity.TradingJournalEntry{
+		{JournalID: journalID, Asset: "EURUSD", Realized: 125.40},
+		{JournalID: journalID, Asset: "GBPUSD", Realized: -40.10},
+	}
+
+	log, db := newEmptyDB()
+	_ = NewTradingJournalStorage(db).AddEntries(context.Background(), journalID, entries)
+
+	queries := log.Queries()
+	if len(queries) != 2 {
+		t.Fatalf("sent %d statements, want the insert and the recompute", len(queries))
+	}
+	if !strings.HasPrefix(queries[0], `INSERT INTO "trading_journal_entries"`) || !strings.Contains(queries[0], "125.4") || !strings.Contains(queries[0], "-40.1") {
+		t.Errorf("insert = %q, want both entries in one statement", queries[0])
+	}
+	if !strings.Contains(queries[1], "INSERT INTO journal_summaries") || !strings.Contains(queries[1], journalID.String()) {
+		t.Errorf("summary statement = %q, want a recompute of the journal", queries[1])
+	}
+}
+
+func TestGetEntriesFetchesOnePage(t *testing.T) {
+	journalID := uuid.New()
+	log, db := newFakeDB()
+
+	_, _ = NewTradingJournalStorage(db).GetEntries(context.Background(), journalID, 20, 40)
+
+	queries := log.Queries()
+	if len(queries) != 1 {
+		t.Fatalf("sent %d queries, want 1", len(queries))
+	}
+	for _, want := range []string{
+		"journal_id = '" + journalID.String() + "'",
+		`ORDER BY "day" DESC, "id" DESC`,
+		"LIMIT 20",
+		"OFFSET 40",
+	} {
+		if !strings.Contains(queries[0], want) {
+			t.Errorf("query %q does not contain %q", queries[0], want)
+		}
+	}
+}
+
+func TestUserJournalsFilterByBroker(t *testing.T) {
+	const brokerPredicate = "LOWER(broker) = LOWER('IC Markets')"
+	userID := uuid.New()
+
+	tests := []struct {
+		name   string
+		broker string
+		want   bool
+	}{
+		{"no filter", "", false},
+		{"broker", "IC Markets", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			log, db := newFakeDB()
+			s := NewTradingJournalStorage(db)
+
+			_, _ = s.GetByUserID(context.Background(), userID, 20, 0, false, tt.broker)
+			_, _ = s.CountByUserID(context.Background(), userID, false, tt.broker)
+
+			queries := log.Queries()
+			if len(queries) != 2 {
+				t.Fatalf("sent %d queries, want 2", len(queries))
+			}
+			for _, query := range queries {
+				if got := strings.Contains(query, brokerPredicate); got != tt.want {
+					t.Errorf("query %q filters by broker: %v, want %v", query, got, tt.want)
+				}
+			}
+		})
+	}
+}
+
+func TestCreateUnlessNameExistsLocksTheName(t *testing.T) {
+	log, db := newEmptyDB()
+	journal := entity.NewTradingJournal(uuid.New(), "Swing", "")
+
+	_, _, _ = NewTradingJournalStorage(db).CreateUnlessNameExists(context.Background(), journal)
+
+	queries := log.Queries()
+	if len(queries) < 3 {
+		t.Fatalf("sent %d queries, want the lock, the lookup and the insert", len(queries))
+	}
+	lockKey := "'trading_journal_name:" + journal.UserID.String() + ":swing'"
+	if !strings.Contains(queries[0], "pg_advisory_xact_lock(hashtext("+lockKey+"))") {
+		t.Errorf("first query %q does not lock the owner's name", queries[0])
+	}
+	if !strings.Contains(queries[1], "LOWER(name) = LOWER('Swing')") || !strings.Contains(queries[1], "user_id = '"+journal.UserID.String()+"'") {
+		t.Errorf("second query %q does not look the name up", queries[1])
+	}
+	if !strings.HasPrefix(queries[2], `INSERT INTO "trading_journals"`) {
+		t.Errorf("third query %q does not insert the journal", queries[2])
+	}
+}
+
+func TestCreateUnlessNameExistsFailure(t *testing.T) {
+	log, db := newFakeDB()
+
+	journal, created, err := NewTradingJournalStorage(db).CreateUnlessNameExists(context.Background(), entity.NewTradingJournal(uuid.New(), "Swing", ""))
+	if err == nil || journal != nil || created {
+		t.Fatalf("CreateUnlessNameExists() = %v, %v, %v, want an error", journal, created, err)
+	}
+	if queries := log.Queries(); len(queries) != 1 {
+		t.Errorf("sent %d queries after the lock failed, want 1", len(queries))
+	}
+}
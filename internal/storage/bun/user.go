@@ -26,6 +26,9 @@ func (s *UserStorage) Create(ctx context.Context, user *entity.User) error {
 		Exec(ctx)
 
 	if err != nil {
+		if isUniqueViolation(err) {
+			return entity.ErrUserAlreadyExists
+		}
 		return errors.Wrap(err, "failed to create user")
 	}
 
@@ -42,7 +45,7 @@ func (s *UserStorage) GetByID(ctx context.Context, id uuid.UUID) (*entity.User,
 
 	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
-			return nil, errors.Wrap(err, "user not found")
+			return nil, errors.Wrap(entity.ErrNotFound, "user")
 		}
 		return nil, errors.Wrap(err, "failed to get user by id")
 	}
@@ -60,7 +63,7 @@ func (s *UserStorage) GetByEmail(ctx context.Context, email string) (*entity.Use
 
 	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
-			return nil, errors.Wrap(err, "user not found")
+			return nil, errors.Wrap(entity.ErrNotFound, "user")
 		}
 		return nil, errors.Wrap(err, "failed to get user by email")
 	}
@@ -78,7 +81,7 @@ func (s *UserStorage) GetByUsername(ctx context.Context, username string) (*enti
 
 	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
-			return nil, errors.Wrap(err, "user not found")
+			return nil, errors.Wrap(entity.ErrNotFound, "user")
 		}
 		return nil, errors.Wrap(err, "failed to get user by username")
 	}
@@ -102,7 +105,7 @@ func (s *UserStorage) Update(ctx context.Context, user *entity.User) error {
 	}
 
 	if rowsAffected == 0 {
-		return errors.New("user not found")
+		return errors.Wrap(entity.ErrNotFound, "user")
 	}
 
 	return nil
@@ -124,7 +127,7 @@ func (s *UserStorage) Delete(ctx context.Context, id uuid.UUID) error {
 	}
 
 	if rowsAffected == 0 {
-		return errors.New("user not found")
+		return errors.Wrap(entity.ErrNotFound, "user")
 	}
 
 	return nil
@@ -137,7 +140,7 @@ func (s *UserStorage) List(ctx context.Context, limit, offset int) ([]*entity.Us
 		Model(&users).
 		Limit(limit).
 		Offset(offset).
-		Order("created_at DESC").
+		Order("created_at DESC", "id DESC").
 		Scan(ctx)
 
 	if err != nil {
@@ -0,0 +1,93 @@
+package bun
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/cockroachdb/errors"
+	"github.com/google/uuid"
+	"github.com/user/normark/internal/entity"
+)
+
+// fakePGError is a server error carrying only an SQLSTATE, like the
+// pgdriver.Error a failed statement returns.
+type fakePGError string
+
+func (e fakePGError) Error() string { return "ERROR #" + string(e) }
+
+func (e fakePGError) Field(k byte) string {
+	if k == 'C' {
+		return string(e)
+	}
+	return ""
+}
+
+func TestCreateUserMapsUniqueViolation(t *testing.T) {
+	tests := []struct {
+		name       string
+		err        error
+		wantExists bool
+	}{
+		{"unique violation", fakePGError(pgUniqueViolation), true},
+		{"wrapped unique violation", errors.Wrap(fakePGError(pgUniqueViolation), "insert"), true},
+		{"not null violation", fakePGError("23502"), false},
+		{"connection failure", errFakeDB, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, db := newFailingDB(tt.err)
+			err := NewUserStorage(db).Create(context.Background(), &entity.User{Email: "trader@example.com", Username: "trader"})
+
+			if err == nil {
+				t.Fatal("Create() error = nil, want an error")
+			}
+			if got := errors.Is(err, entity.ErrUserAlreadyExists); got != tt.wantExists {
+				t.Errorf("Create() error = %v, is ErrUserAlreadyExists: %v, want %v", err, got, tt.wantExists)
+			}
+		})
+	}
+}
+
+// TestUserLookupsSkipSoftDeletedUsers checks that sign-up and sign-in only
+// see live users, so a deleted user's email and username can be reused.
+func TestUserLookupsSkipSoftDeletedUsers(t *testing.T) {
+	tests := []struct {
+		name  string
+		query func(s *UserStorage)
+	}{
+		{"exists", func(s *UserStorage) { _, _ = s.Exists(context.Background(), "trader@example.com", "trader") }},
+		{"by email", func(s *UserStorage) { _, _ = s.GetByEmail(context.Background(), "trader@example.com") }},
+		{"by username", func(s *UserStorage) { _, _ = s.GetByUsername(context.Background(), "trader") }},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			log, db := newFakeDB()
+			tt.query(NewUserStorage(db))
+
+			queries := log.Queries()
+			if len(queries) != 1 {
+				t.Fatalf("sent %d queries, want 1", len(queries))
+			}
+			if !strings.Contains(queries[0], `"u"."deleted_at" IS NULL`) {
+				t.Errorf("query %q includes soft-deleted users", queries[0])
+			}
+		})
+	}
+}
+
+func TestDeleteUserIsSoft(t *testing.T) {
+	log, db := newFakeDB()
+
+	_ = NewUserStorage(db).Delete(context.Background(), uuid.New())
+
+	queries := log.Queries()
+	if len(queries) != 1 {
+		t.Fatalf("sent %d queries, want 1", len(queries))
+	}
+	if !strings.HasPrefix(queries[0], "UPDATE") || !strings.Contains(queries[0], `SET "deleted_at" = `) {
+		t.Errorf("query %q does not soft-delete the user", queries[0])
+	}
+}
@@ -0,0 +1,157 @@
+package cache
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/cockroachdb/errors"
+	"github.com/redis/go-redis/v9"
+	"go.uber.org/zap"
+)
+
+// ErrUnavailable is returned instead of calling Redis while the breaker is
+// open.
+var ErrUnavailable = errors.New("cache unavailable")
+
+// BreakerConfig configures a Breaker.
+type BreakerConfig struct {
+	// Threshold is the number of consecutive failures that opens the
+	// breaker.
+	Threshold int
+	// Cooldown is how long the breaker stays open before letting a probe
+	// call through.
+	Cooldown time.Duration
+}
+
+// store is the part of Redis the breaker guards.
+type store interface {
+	Get(ctx context.Context, key string) (string, error)
+	Set(ctx context.Context, key string, value any, expiration time.Duration) error
+	Delete(ctx context.Context, keys ...string) error
+}
+
+// Breaker stops calling Redis after repeated failures, so an outage mid-run
+// costs each request nothing instead of a timeout. While open, Get reports a
+// miss, Delete does nothing and Set returns ErrUnavailable, since a caller
+// that needs the value stored has to know it wasn't. After the cooldown the
+// next call goes through as a probe: success closes the breaker, failure
+// opens it for another cooldown. Invalidations skipped while open are not
+// replayed; cached values are left to expire.
+type Breaker struct {
+	store  store
+	cfg    BreakerConfig
+	logger *zap.Logger
+	now    func() time.Time
+
+	mu        sync.Mutex
+	failures  int
+	openUntil time.Time
+	probing   bool
+}
+
+func NewBreaker(store store, cfg BreakerConfig, logger *zap.Logger) *Breaker {
+	return &Breaker{
+		store:  store,
+		cfg:    cfg,
+		logger: logger,
+		now:    time.Now,
+	}
+}
+
+func (b *Breaker) Get(ctx context.Context, key string) (string, error) {
+	if !b.allow() {
+		return "", ErrUnavailable
+	}
+
+	val, err := b.store.Get(ctx, key)
+	// A missing key is an answer from Redis, not a failure.
+	if errors.Is(err, redis.Nil) {
+		b.record(ctx, nil)
+	} else {
+		b.record(ctx, err)
+	}
+	return val, err
+}
+
+func (b *Breaker) Set(ctx context.Context, key string, value any, expiration time.Duration) error {
+	if !b.allow() {
+		return ErrUnavailable
+	}
+
+	err := b.store.Set(ctx, key, value, expiration)
+	b.record(ctx, err)
+	return err
+}
+
+func (b *Breaker) Delete(ctx context.Context, keys ...string) error {
+	if !b.allow() {
+		return nil
+	}
+
+	err := b.store.Delete(ctx, keys...)
+	b.record(ctx, err)
+	return err
+}
+
+// Close closes the underlying store if it can be closed.
+func (b *Breaker) Close() error {
+	if closer, ok := b.store.(interface{ Close() error }); ok {
+		return closer.Close()
+	}
+	return nil
+}
+
+// allow reports whether a call may reach Redis. Once the cooldown is over it
+// lets a single probe through and keeps bypassing the rest until the probe
+// is recorded.
+func (b *Breaker) allow() bool {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if b.failures < b.cfg.Threshold {
+		return true
+	}
+
+	if b.probing || b.now().Before(b.openUntil) {
+		return false
+	}
+
+	b.probing = true
+	return true
+}
+
+// record counts a call's outcome. Calls cut short by their own context say
+// nothing about Redis and are ignored, but still end a probe.
+func (b *Breaker) record(ctx context.Context, err error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.probing = false
+
+	if err != nil && ctx.Err() != nil {
+		return
+	}
+
+	if err == nil {
+		if b.failures >= b.cfg.Threshold {
+			b.logger.Info("redis is reachable again, re-enabling cache")
+		}
+		b.failures = 0
+		return
+	}
+
+	b.failures++
+	if b.failures < b.cfg.Threshold {
+		return
+	}
+
+	b.openUntil = b.now().Add(b.cfg.Cooldown)
+	if b.failures == b.cfg.Threshold {
+		b.logger.Warn("redis keeps failing, bypassing cache",
+			zap.Error(err),
+			zap.Int("failures", b.failures),
+			zap.Duration("cooldown", b.cfg.Cooldown),
+		)
+	}
+}
@@ -0,0 +1,177 @@
+package cache
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/cockroachdb/errors"
+	"github.com/redis/go-redis/v9"
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+	"go.uber.org/zap/zaptest/observer"
+)
+
+var errRedisDown = errors.New("dial tcp: connection refused")
+
+// fakeStore fails every call with err and counts the calls that reach it.
+type fakeStore struct {
+	err   error
+	calls int
+}
+
+func (s *fakeStore) Get(context.Context, string) (string, error) {
+	s.calls++
+	if s.err != nil {
+		return "", s.err
+	}
+	return "cached", nil
+}
+
+func (s *fakeStore) Set(context.Context, string, any, time.Duration) error {
+	s.calls++
+	return s.err
+}
+
+func (s *fakeStore) Delete(context.Context, ...string) error {
+	s.calls++
+	return s.err
+}
+
+// newTestBreaker returns a breaker over store with a clock the test moves
+// forward by advancing *now.
+func newTestBreaker(store store, logger *zap.Logger) (*Breaker, *time.Time) {
+	now := time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC)
+	b := NewBreaker(store, BreakerConfig{Threshold: 3, Cooldown: 30 * time.Second}, logger)
+	b.now = func() time.Time { return now }
+	return b, &now
+}
+
+func TestBreakerTripsAndResets(t *testing.T) {
+	ctx := context.Background()
+	core, logs := observer.New(zapcore.InfoLevel)
+	store := &fakeStore{err: errRedisDown}
+	b, now := newTestBreaker(store, zap.New(core))
+
+	// Failures below the threshold still reach Redis.
+	for range 3 {
+		if _, err := b.Get(ctx, "journal:1"); !errors.Is(err, errRedisDown) {
+			t.Fatalf("Get() error = %v, want the Redis error", err)
+		}
+	}
+	if store.calls != 3 {
+		t.Fatalf("calls = %d, want 3", store.calls)
+	}
+	if logs.FilterMessage("redis keeps failing, bypassing cache").Len() != 1 {
+		t.Errorf("trip logged %d times, want once", logs.FilterMessage("redis keeps failing, bypassing cache").Len())
+	}
+
+	// Open: nothing reaches Redis.
+	if _, err := b.Get(ctx, "journal:1"); !errors.Is(err, ErrUnavailable) {
+		t.Errorf("open Get() error = %v, want ErrUnavailable", err)
+	}
+	if err := b.Set(ctx, "journal:1", "{}", time.Minute); !errors.Is(err, ErrUnavailable) {
+		t.Errorf("open Set() error = %v, want ErrUnavailable", err)
+	}
+	if err := b.Delete(ctx, "journal:1"); err != nil {
+		t.Errorf("open Delete() error = %v, want nil", err)
+	}
+	if store.calls != 3 {
+		t.Fatalf("calls while open = %d, want none", store.calls-3)
+	}
+
+	// A failed probe after the cooldown reopens it.
+	*now = now.Add(31 * time.Second)
+	if _, err := b.Get(ctx, "journal:1"); !errors.Is(err, errRedisDown) {
+		t.Fatalf("probe Get() error = %v, want the Redis error", err)
+	}
+	if _, err := b.Get(ctx, "journal:1"); !errors.Is(err, ErrUnavailable) {
+		t.Errorf("Get() after failed probe error = %v, want ErrUnavailable", err)
+	}
+	if store.calls != 4 {
+		t.Fatalf("calls = %d, want only the probe to get through", store.calls)
+	}
+
+	// A successful probe closes it.
+	store.err = nil
+	*now = now.Add(31 * time.Second)
+	if val, err := b.Get(ctx, "journal:1"); err != nil || val != "cached" {
+		t.Fatalf("probe Get() = %q, %v; want the cached value", val, err)
+	}
+	if err := b.Set(ctx, "journal:1", "{}", time.Minute); err != nil {
+		t.Errorf("Set() after recovery error = %v", err)
+	}
+	if store.calls != 6 {
+		t.Errorf("calls = %d, want calls to reach Redis again", store.calls)
+	}
+	if logs.FilterMessage("redis is reachable again, re-enabling cache").Len() != 1 {
+		t.Errorf("recovery not logged once")
+	}
+}
+
+func TestBreakerLetsOneProbeThrough(t *testing.T) {
+	ctx := context.Background()
+	store := &fakeStore{err: errRedisDown}
+	b, now := newTestBreaker(store, zap.NewNop())
+
+	for range 3 {
+		_ = b.Delete(ctx, "journal:1")
+	}
+	*now = now.Add(time.Minute)
+
+	// The probe is in flight until recorded; other calls keep bypassing.
+	if !b.allow() {
+		t.Fatal("allow() = false after the cooldown, want a probe")
+	}
+	if b.allow() {
+		t.Error("allow() = true while a probe is in flight")
+	}
+}
+
+func TestBreakerIgnoresMissesAndCanceledCalls(t *testing.T) {
+	tests := []struct {
+		name     string
+		err      error
+		canceled bool
+	}{
+		{"missing key", redis.Nil, false},
+		{"caller canceled", context.Canceled, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx, cancel := context.WithCancel(context.Background())
+			if tt.canceled {
+				cancel()
+			} else {
+				defer cancel()
+			}
+
+			store := &fakeStore{err: tt.err}
+			b, _ := newTestBreaker(store, zap.NewNop())
+
+			for range 10 {
+				_, _ = b.Get(ctx, "journal:1")
+			}
+			if store.calls != 10 {
+				t.Errorf("calls = %d, want every call to reach Redis", store.calls)
+			}
+		})
+	}
+}
+
+func TestBreakerSuccessResetsFailureCount(t *testing.T) {
+	ctx := context.Background()
+	store := &fakeStore{}
+	b, _ := newTestBreaker(store, zap.NewNop())
+
+	for _, err := range []error{errRedisDown, errRedisDown, nil, errRedisDown, errRedisDown} {
+		store.err = err
+		_ = b.Delete(ctx, "journal:1")
+	}
+
+	store.err = nil
+	if _, err := b.Get(ctx, "journal:1"); err != nil {
+		t.Errorf("Get() error = %v; two failures after a success must not open the breaker", err)
+	}
+}
@@ -8,10 +8,16 @@ import (
 	"github.com/redis/go-redis/v9"
 )
 
+// Config configures the Redis client. Zero pool and timeout values leave the
+// go-redis defaults in place.
 type Config struct {
-	Addr     string
-	Password string
-	DB       int
+	Addr         string
+	Password     string
+	DB           int
+	PoolSize     int
+	MinIdleConns int
+	DialTimeout  time.Duration
+	ReadTimeout  time.Duration
 }
 
 type SetOptions struct {
@@ -26,9 +32,13 @@ type Redis struct {
 
 func New(cfg Config) *Redis {
 	client := redis.NewClient(&redis.Options{
-		Addr:     cfg.Addr,
-		Password: cfg.Password,
-		DB:       cfg.DB,
+		Addr:         cfg.Addr,
+		Password:     cfg.Password,
+		DB:           cfg.DB,
+		PoolSize:     cfg.PoolSize,
+		MinIdleConns: cfg.MinIdleConns,
+		DialTimeout:  cfg.DialTimeout,
+		ReadTimeout:  cfg.ReadTimeout,
 	})
 
 	return &Redis{
@@ -0,0 +1,44 @@
+package cache
+
+import (
+	"testing"
+	"time"
+)
+
+func TestNewAppliesPoolOptions(t *testing.T) {
+	cfg := Config{
+		Addr:         "127.0.0.1:1",
+		DB:           2,
+		PoolSize:     50,
+		MinIdleConns: 10,
+		DialTimeout:  2 * time.Second,
+		ReadTimeout:  500 * time.Millisecond,
+	}
+
+	r := New(cfg)
+	t.Cleanup(func() { _ = r.Close() })
+
+	opts := r.Client().Options()
+	if opts.Addr != cfg.Addr || opts.DB != cfg.DB {
+		t.Errorf("addr, db = %s, %d, want %s, %d", opts.Addr, opts.DB, cfg.Addr, cfg.DB)
+	}
+	if opts.PoolSize != cfg.PoolSize || opts.MinIdleConns != cfg.MinIdleConns {
+		t.Errorf("pool size, min idle = %d, %d, want %d, %d", opts.PoolSize, opts.MinIdleConns, cfg.PoolSize, cfg.MinIdleConns)
+	}
+	if opts.DialTimeout != cfg.DialTimeout || opts.ReadTimeout != cfg.ReadTimeout {
+		t.Errorf("dial, read timeout = %v, %v, want %v, %v", opts.DialTimeout, opts.ReadTimeout, cfg.DialTimeout, cfg.ReadTimeout)
+	}
+}
+
+func TestNewKeepsClientDefaults(t *testing.T) {
+	r := New(Config{Addr: "127.0.0.1:1"})
+	t.Cleanup(func() { _ = r.Close() })
+
+	opts := r.Client().Options()
+	if opts.PoolSize <= 0 {
+		t.Errorf("pool size = %d, want the client default", opts.PoolSize)
+	}
+	if opts.DialTimeout <= 0 || opts.ReadTimeout <= 0 {
+		t.Errorf("dial, read timeout = %v, %v, want the client defaults", opts.DialTimeout, opts.ReadTimeout)
+	}
+}
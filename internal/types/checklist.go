@@ -0,0 +1,8 @@
+package types
+
+// ChecklistItem is one item of a pre-trade checklist and whether the trader
+// ticked it before taking the trade
+type ChecklistItem struct {
+	Item    string `json:"item" validate:"required,max=200"`
+	Checked bool   `json:"checked"`
+}
@@ -0,0 +1,114 @@
+package types
+
+import (
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"slices"
+	"strconv"
+	"testing"
+)
+
+// declaredConstants returns the values of the string constants declared in
+// trading_journal.go, by type name.
+func declaredConstants(t *testing.T) map[string][]string {
+	t.Helper()
+
+	file, err := parser.ParseFile(token.NewFileSet(), "trading_journal.go", nil, 0)
+	if err != nil {
+		t.Fatalf("parse trading_journal.go: %v", err)
+	}
+
+	constants := make(map[string][]string)
+	for _, decl := range file.Decls {
+		gen, ok := decl.(*ast.GenDecl)
+		if !ok || gen.Tok != token.CONST {
+			continue
+		}
+		for _, spec := range gen.Specs {
+			value := spec.(*ast.ValueSpec)
+			typ, ok := value.Type.(*ast.Ident)
+			if !ok {
+				continue
+			}
+			for _, v := range value.Values {
+				lit, ok := v.(*ast.BasicLit)
+				if !ok || lit.Kind != token.STRING {
+					continue
+				}
+				s, err := strconv.Unquote(lit.Value)
+				if err != nil {
+					t.Fatalf("unquote %s: %v", lit.Value, err)
+				}
+				constants[typ.Name] = append(constants[typ.Name], s)
+			}
+		}
+	}
+	return constants
+}
+
+// checkEnum checks that values lists every declared constant of the type
+// exactly once and that IsValid accepts exactly those.
+func checkEnum[T ~string](t *testing.T, declared []string, values []T, isValid func(T) bool) {
+	t.Helper()
+
+	if len(declared) == 0 {
+		t.Fatal("no constants declared")
+	}
+
+	got := make([]string, len(values))
+	for i, v := range values {
+		got[i] = string(v)
+		if !isValid(v) {
+			t.Errorf("listed value %q is not valid", v)
+		}
+	}
+	slices.Sort(got)
+	want := slices.Clone(declared)
+	slices.Sort(want)
+	if !slices.Equal(got, want) {
+		t.Errorf("listed %v, want the declared %v", got, want)
+	}
+
+	if isValid("not-a-value") {
+		t.Error(`IsValid("not-a-value") = true`)
+	}
+}
+
+func TestEnumListsMatchDeclaredConstants(t *testing.T) {
+	declared := declaredConstants(t)
+
+	t.Run("CurrencyPair", func(t *testing.T) {
+		checkEnum(t, declared["CurrencyPair"], CurrencyPairs, CurrencyPair.IsValid)
+	})
+	t.Run("TradingSession", func(t *testing.T) {
+		checkEnum(t, declared["TradingSession"], TradingSessions, TradingSession.IsValid)
+	})
+	t.Run("TradeType", func(t *testing.T) {
+		checkEnum(t, declared["TradeType"], TradeTypes, TradeType.IsValid)
+	})
+	t.Run("TradeDirection", func(t *testing.T) {
+		checkEnum(t, declared["TradeDirection"], TradeDirections, TradeDirection.IsValid)
+	})
+	t.Run("EntryType", func(t *testing.T) {
+		checkEnum(t, declared["EntryType"], EntryTypes, EntryType.IsValid)
+	})
+	t.Run("TradeResult", func(t *testing.T) {
+		checkEnum(t, declared["TradeResult"], TradeResults, TradeResult.IsValid)
+	})
+	t.Run("TimeFrame", func(t *testing.T) {
+		checkEnum(t, declared["TimeFrame"], TimeFrames, TimeFrame.IsValid)
+	})
+}
+
+func TestTimeFramesShortestFirst(t *testing.T) {
+	order := map[TimeFrame]int{}
+	for i, tf := range TimeFrames {
+		order[tf] = i
+	}
+	for _, pair := range [][2]TimeFrame{{TimeFrame1M, TimeFrame5M}, {TimeFrame4H, TimeFrame1D}, {TimeFrame1W, TimeFrame1MO}} {
+		if order[pair[0]] >= order[pair[1]] {
+			t.Errorf("%s is listed after %s", pair[0], pair[1])
+		}
+	}
+}
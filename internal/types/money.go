@@ -0,0 +1,20 @@
+package types
+
+import "math"
+
+// Cents is a money amount in integer minor units. Realized values are stored
+// as decimal(10,2), so adding them up as Cents is exact, whereas adding the
+// float64s drifts by a little with every term. Convert once on the way in
+// with CentsFromFloat and once on the way out with Float64.
+type Cents int64
+
+// CentsFromFloat converts a two-decimal amount to Cents, rounding to the
+// nearest cent.
+func CentsFromFloat(amount float64) Cents {
+	return Cents(math.Round(amount * 100))
+}
+
+// Float64 returns the amount in major units.
+func (c Cents) Float64() float64 {
+	return float64(c) / 100
+}
@@ -0,0 +1,47 @@
+package types
+
+import "testing"
+
+func TestCentsFromFloat(t *testing.T) {
+	tests := []struct {
+		amount float64
+		want   Cents
+	}{
+		{0, 0},
+		{0.10, 10},
+		{0.125, 13},
+		{-0.125, -13},
+		{19.99, 1999},
+		{-250.25, -25025},
+		{0.1 + 0.2, 30},
+		{12345678.91, 1234567891},
+	}
+
+	for _, tt := range tests {
+		got := CentsFromFloat(tt.amount)
+		if got != tt.want {
+			t.Errorf("CentsFromFloat(%v) = %d, want %d", tt.amount, got, tt.want)
+		}
+		if back := got.Float64(); back != float64(tt.want)/100 {
+			t.Errorf("Cents(%d).Float64() = %v, want %v", got, back, float64(tt.want)/100)
+		}
+	}
+}
+
+func TestCentsSumDoesNotDrift(t *testing.T) {
+	const n = 100000
+
+	var floatSum float64
+	var centsSum Cents
+	for range n {
+		floatSum += 0.10
+		centsSum += CentsFromFloat(0.10)
+	}
+
+	if floatSum == 10000 {
+		t.Fatalf("float64 sum = %v; expected it to drift from 10000", floatSum)
+	}
+	if got := centsSum.Float64(); got != 10000 {
+		t.Errorf("cents sum = %v, want exactly 10000", got)
+	}
+}
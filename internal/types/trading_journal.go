@@ -1,14 +1,22 @@
 package types
 
+import (
+	"encoding/json"
+	"strings"
+)
+
 // TradingSession represents the trading session time zones
 type TradingSession string
 
 const (
-	TradingSessionAsia   TradingSession = "asia"
-	TradingSessionLondon TradingSession = "london"
+	TradingSessionAsia    TradingSession = "asia"
+	TradingSessionLondon  TradingSession = "london"
 	TradingSessionNewYork TradingSession = "new_york"
 )
 
+// TradingSessions lists every trading session.
+var TradingSessions = []TradingSession{TradingSessionAsia, TradingSessionLondon, TradingSessionNewYork}
+
 // IsValid checks if the trading session is valid
 func (s TradingSession) IsValid() bool {
 	switch s {
@@ -26,6 +34,9 @@ const (
 	TradeTypeIntraday TradeType = "intraday"
 )
 
+// TradeTypes lists every trade type.
+var TradeTypes = []TradeType{TradeTypeSwing, TradeTypeIntraday}
+
 // IsValid checks if the trade type is valid
 func (t TradeType) IsValid() bool {
 	switch t {
@@ -43,6 +54,9 @@ const (
 	TradeDirectionSell TradeDirection = "sell"
 )
 
+// TradeDirections lists every trade direction.
+var TradeDirections = []TradeDirection{TradeDirectionBuy, TradeDirectionSell}
+
 // IsValid checks if the trade direction is valid
 func (d TradeDirection) IsValid() bool {
 	switch d {
@@ -60,6 +74,9 @@ const (
 	EntryTypeLimit  EntryType = "limit"
 )
 
+// EntryTypes lists every entry type.
+var EntryTypes = []EntryType{EntryTypeMarket, EntryTypeLimit}
+
 // IsValid checks if the entry type is valid
 func (e EntryType) IsValid() bool {
 	switch e {
@@ -73,11 +90,14 @@ func (e EntryType) IsValid() bool {
 type TradeResult string
 
 const (
-	TradeResultTakeProfit TradeResult = "TP"  // Take Profit
-	TradeResultStopLoss   TradeResult = "SL"  // Stop Loss
-	TradeResultBreakEven  TradeResult = "BE"  // Break Even
+	TradeResultTakeProfit TradeResult = "TP" // Take Profit
+	TradeResultStopLoss   TradeResult = "SL" // Stop Loss
+	TradeResultBreakEven  TradeResult = "BE" // Break Even
 )
 
+// TradeResults lists every trade result.
+var TradeResults = []TradeResult{TradeResultTakeProfit, TradeResultStopLoss, TradeResultBreakEven}
+
 // IsValid checks if the trade result is valid
 func (r TradeResult) IsValid() bool {
 	switch r {
@@ -87,6 +107,67 @@ func (r TradeResult) IsValid() bool {
 	return false
 }
 
+// Emotion represents the trader's emotional state when taking the trade
+type Emotion string
+
+const (
+	EmotionCalm        Emotion = "calm"
+	EmotionFearful     Emotion = "fearful"
+	EmotionGreedy      Emotion = "greedy"
+	EmotionFOMO        Emotion = "fomo"
+	EmotionDisciplined Emotion = "disciplined"
+)
+
+// IsValid checks if the emotion is valid
+func (e Emotion) IsValid() bool {
+	switch e {
+	case EmotionCalm, EmotionFearful, EmotionGreedy, EmotionFOMO, EmotionDisciplined:
+		return true
+	}
+	return false
+}
+
+// ReviewStatus tracks where an entry is in the post-trade review workflow
+type ReviewStatus string
+
+const (
+	ReviewStatusUnreviewed ReviewStatus = "unreviewed"
+	ReviewStatusReviewed   ReviewStatus = "reviewed"
+	ReviewStatusFlagged    ReviewStatus = "flagged"
+)
+
+// IsValid checks if the review status is valid
+func (r ReviewStatus) IsValid() bool {
+	switch r {
+	case ReviewStatusUnreviewed, ReviewStatusReviewed, ReviewStatusFlagged:
+		return true
+	}
+	return false
+}
+
+// TradeGrade is the letter grade computed for an entry, A being best
+type TradeGrade string
+
+const (
+	TradeGradeA TradeGrade = "A"
+	TradeGradeB TradeGrade = "B"
+	TradeGradeC TradeGrade = "C"
+	TradeGradeD TradeGrade = "D"
+	TradeGradeF TradeGrade = "F"
+)
+
+// TradeGrades lists the grades from best to worst.
+var TradeGrades = []TradeGrade{TradeGradeA, TradeGradeB, TradeGradeC, TradeGradeD, TradeGradeF}
+
+// IsValid checks if the trade grade is valid
+func (g TradeGrade) IsValid() bool {
+	switch g {
+	case TradeGradeA, TradeGradeB, TradeGradeC, TradeGradeD, TradeGradeF:
+		return true
+	}
+	return false
+}
+
 // TimeFrame represents common forex timeframes
 type TimeFrame string
 
@@ -102,6 +183,12 @@ const (
 	TimeFrame1MO TimeFrame = "1MO"
 )
 
+// TimeFrames lists every timeframe from shortest to longest.
+var TimeFrames = []TimeFrame{
+	TimeFrame1M, TimeFrame5M, TimeFrame15M, TimeFrame30M,
+	TimeFrame1H, TimeFrame4H, TimeFrame1D, TimeFrame1W, TimeFrame1MO,
+}
+
 // IsValid checks if the timeframe is valid
 func (tf TimeFrame) IsValid() bool {
 	switch tf {
@@ -144,6 +231,17 @@ const (
 	CurrencyPairUSDSEK CurrencyPair = "USDSEK"
 )
 
+// CurrencyPairs lists every currency pair, majors first. Aliases accepted by
+// NormalizeCurrencyPair are not included.
+var CurrencyPairs = []CurrencyPair{
+	CurrencyPairEURUSD, CurrencyPairGBPUSD, CurrencyPairUSDJPY, CurrencyPairUSDCHF,
+	CurrencyPairAUDUSD, CurrencyPairUSDCAD, CurrencyPairNZDUSD,
+	CurrencyPairEURGBP, CurrencyPairEURJPY, CurrencyPairGBPJPY, CurrencyPairEURCHF,
+	CurrencyPairEURAUD, CurrencyPairEURCAD, CurrencyPairGBPCHF, CurrencyPairGBPAUD,
+	CurrencyPairGBPCAD, CurrencyPairUSDTRY, CurrencyPairUSDMXN, CurrencyPairUSDZAR,
+	CurrencyPairUSDNOK, CurrencyPairUSDSEK,
+}
+
 // IsValid checks if the currency pair is valid
 func (cp CurrencyPair) IsValid() bool {
 	switch cp {
@@ -157,3 +255,101 @@ func (cp CurrencyPair) IsValid() bool {
 	}
 	return false
 }
+
+// AssetCategory groups currency pairs by liquidity.
+type AssetCategory string
+
+const (
+	AssetCategoryMajor  AssetCategory = "major"
+	AssetCategoryMinor  AssetCategory = "minor"
+	AssetCategoryExotic AssetCategory = "exotic"
+	// AssetCategoryOther covers pairs this version does not know, such as
+	// ones stored before a pair was dropped.
+	AssetCategoryOther AssetCategory = "other"
+)
+
+// AssetCategories lists the categories in display order.
+var AssetCategories = []AssetCategory{
+	AssetCategoryMajor,
+	AssetCategoryMinor,
+	AssetCategoryExotic,
+	AssetCategoryOther,
+}
+
+// Category returns the group the pair belongs to.
+func (cp CurrencyPair) Category() AssetCategory {
+	switch cp {
+	case CurrencyPairEURUSD, CurrencyPairGBPUSD, CurrencyPairUSDJPY, CurrencyPairUSDCHF,
+		CurrencyPairAUDUSD, CurrencyPairUSDCAD, CurrencyPairNZDUSD:
+		return AssetCategoryMajor
+	case CurrencyPairEURGBP, CurrencyPairEURJPY, CurrencyPairGBPJPY, CurrencyPairEURCHF,
+		CurrencyPairEURAUD, CurrencyPairEURCAD, CurrencyPairGBPCHF, CurrencyPairGBPAUD,
+		CurrencyPairGBPCAD:
+		return AssetCategoryMinor
+	case CurrencyPairUSDTRY, CurrencyPairUSDMXN, CurrencyPairUSDZAR, CurrencyPairUSDNOK,
+		CurrencyPairUSDSEK:
+		return AssetCategoryExotic
+	}
+	return AssetCategoryOther
+}
+
+// currencyPairAliases maps trader nicknames to the pairs they refer to.
+var currencyPairAliases = map[string]CurrencyPair{
+	"FIBER":  CurrencyPairEURUSD,
+	"CABLE":  CurrencyPairGBPUSD,
+	"SWISSY": CurrencyPairUSDCHF,
+	"AUSSIE": CurrencyPairAUDUSD,
+	"LOONIE": CurrencyPairUSDCAD,
+	"KIWI":   CurrencyPairNZDUSD,
+	"GUPPY":  CurrencyPairGBPJPY,
+}
+
+// NormalizeCurrencyPair converts user input such as "eur/usd", "EUR-USD" or
+// "cable" to its canonical form. The result is not guaranteed to be valid;
+// callers still check IsValid.
+func NormalizeCurrencyPair(s string) CurrencyPair {
+	normalized := strings.Map(func(r rune) rune {
+		switch r {
+		case '/', '-', '_', '.', ' ':
+			return -1
+		}
+		return r
+	}, strings.ToUpper(strings.TrimSpace(s)))
+
+	if alias, ok := currencyPairAliases[normalized]; ok {
+		return alias
+	}
+
+	return CurrencyPair(normalized)
+}
+
+// UnmarshalJSON normalizes the pair on binding, so every request field of
+// this type accepts the same input variants.
+func (cp *CurrencyPair) UnmarshalJSON(data []byte) error {
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return err
+	}
+
+	*cp = NormalizeCurrencyPair(s)
+	return nil
+}
+
+// ValidationProfile selects which consistency checks a journal enforces on
+// its entries, on top of the field validation every entry gets
+type ValidationProfile string
+
+const (
+	ValidationProfileLenient  ValidationProfile = "lenient"
+	ValidationProfileStandard ValidationProfile = "standard"
+	ValidationProfileStrict   ValidationProfile = "strict"
+)
+
+// IsValid checks if the validation profile is valid
+func (p ValidationProfile) IsValid() bool {
+	switch p {
+	case ValidationProfileLenient, ValidationProfileStandard, ValidationProfileStrict:
+		return true
+	}
+	return false
+}
@@ -0,0 +1,109 @@
+package types
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestNormalizeCurrencyPair(t *testing.T) {
+	tests := []struct {
+		input string
+		want  CurrencyPair
+		valid bool
+	}{
+		{"EURUSD", CurrencyPairEURUSD, true},
+		{"eurusd", CurrencyPairEURUSD, true},
+		{"EUR/USD", CurrencyPairEURUSD, true},
+		{"eur-usd", CurrencyPairEURUSD, true},
+		{" Eur_Usd ", CurrencyPairEURUSD, true},
+		{"eur.usd", CurrencyPairEURUSD, true},
+		{"fiber", CurrencyPairEURUSD, true},
+		{"Cable", CurrencyPairGBPUSD, true},
+		{"GBP / JPY", CurrencyPairGBPJPY, true},
+		{"guppy", CurrencyPairGBPJPY, true},
+		{"gold", CurrencyPair("GOLD"), false},
+		{"", CurrencyPair(""), false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			got := NormalizeCurrencyPair(tt.input)
+			if got != tt.want {
+				t.Errorf("NormalizeCurrencyPair(%q) = %q, want %q", tt.input, got, tt.want)
+			}
+			if got.IsValid() != tt.valid {
+				t.Errorf("NormalizeCurrencyPair(%q).IsValid() = %v, want %v", tt.input, got.IsValid(), tt.valid)
+			}
+		})
+	}
+}
+
+func TestCurrencyPairUnmarshalJSON(t *testing.T) {
+	var req struct {
+		Asset CurrencyPair `json:"asset"`
+	}
+
+	if err := json.Unmarshal([]byte(`{"asset":"gbp/usd"}`), &req); err != nil {
+		t.Fatalf("Unmarshal() error = %v", err)
+	}
+	if req.Asset != CurrencyPairGBPUSD {
+		t.Errorf("asset = %q, want %q", req.Asset, CurrencyPairGBPUSD)
+	}
+
+	if err := json.Unmarshal([]byte(`{"asset":42}`), &req); err == nil {
+		t.Error("Unmarshal() of a number succeeded, want an error")
+	}
+}
+
+func TestCurrencyPairCategory(t *testing.T) {
+	tests := []struct {
+		pair CurrencyPair
+		want AssetCategory
+	}{
+		{CurrencyPairEURUSD, AssetCategoryMajor},
+		{CurrencyPairUSDJPY, AssetCategoryMajor},
+		{CurrencyPairNZDUSD, AssetCategoryMajor},
+		{CurrencyPairEURGBP, AssetCategoryMinor},
+		{CurrencyPairGBPJPY, AssetCategoryMinor},
+		{CurrencyPairGBPCAD, AssetCategoryMinor},
+		{CurrencyPairUSDTRY, AssetCategoryExotic},
+		{CurrencyPairUSDSEK, AssetCategoryExotic},
+		{"BTCUSD", AssetCategoryOther},
+		{"", AssetCategoryOther},
+	}
+
+	for _, tt := range tests {
+		t.Run(string(tt.pair), func(t *testing.T) {
+			if got := tt.pair.Category(); got != tt.want {
+				t.Errorf("%q.Category() = %q, want %q", tt.pair, got, tt.want)
+			}
+		})
+	}
+
+	for _, pair := range CurrencyPairs {
+		if pair.Category() == AssetCategoryOther {
+			t.Errorf("supported pair %q has no category", pair)
+		}
+	}
+}
+
+func TestValidationProfileIsValid(t *testing.T) {
+	tests := []struct {
+		profile ValidationProfile
+		want    bool
+	}{
+		{ValidationProfileLenient, true},
+		{ValidationProfileStandard, true},
+		{ValidationProfileStrict, true},
+		{"Strict", false},
+		{"", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(string(tt.profile), func(t *testing.T) {
+			if got := tt.profile.IsValid(); got != tt.want {
+				t.Errorf("ValidationProfile(%q).IsValid() = %v, want %v", tt.profile, got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,4 @@
+DROP INDEX IF EXISTS idx_trading_journal_entries_journal_pinned;
+
+ALTER TABLE trading_journal_entries
+    DROP COLUMN IF EXISTS is_pinned;
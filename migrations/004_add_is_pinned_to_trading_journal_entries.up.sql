@@ -0,0 +1,4 @@
+ALTER TABLE trading_journal_entries
+    ADD COLUMN IF NOT EXISTS is_pinned BOOLEAN NOT NULL DEFAULT FALSE;
+
+CREATE INDEX IF NOT EXISTS idx_trading_journal_entries_journal_pinned ON trading_journal_entries(journal_id, day DESC) WHERE is_pinned AND deleted_at IS NULL;
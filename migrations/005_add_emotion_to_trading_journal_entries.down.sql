@@ -0,0 +1,7 @@
+DROP INDEX IF EXISTS idx_trading_journal_entries_journal_emotion;
+
+ALTER TABLE trading_journal_entries
+    DROP CONSTRAINT IF EXISTS check_emotion;
+
+ALTER TABLE trading_journal_entries
+    DROP COLUMN IF EXISTS emotion;
@@ -0,0 +1,7 @@
+ALTER TABLE trading_journal_entries
+    ADD COLUMN IF NOT EXISTS emotion VARCHAR(20) NULL;
+
+ALTER TABLE trading_journal_entries
+    ADD CONSTRAINT check_emotion CHECK (emotion IS NULL OR emotion IN ('calm', 'fearful', 'greedy', 'fomo', 'disciplined'));
+
+CREATE INDEX IF NOT EXISTS idx_trading_journal_entries_journal_emotion ON trading_journal_entries(journal_id, emotion) WHERE deleted_at IS NULL;
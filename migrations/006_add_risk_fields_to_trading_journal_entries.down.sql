@@ -0,0 +1,9 @@
+ALTER TABLE trading_journal_entries
+    DROP CONSTRAINT IF EXISTS check_position_size;
+
+ALTER TABLE trading_journal_entries
+    DROP CONSTRAINT IF EXISTS check_risk_percent;
+
+ALTER TABLE trading_journal_entries
+    DROP COLUMN IF EXISTS position_size,
+    DROP COLUMN IF EXISTS risk_percent;
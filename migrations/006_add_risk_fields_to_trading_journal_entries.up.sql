@@ -0,0 +1,9 @@
+ALTER TABLE trading_journal_entries
+    ADD COLUMN IF NOT EXISTS risk_percent DECIMAL(5,2) NOT NULL DEFAULT 0.00,
+    ADD COLUMN IF NOT EXISTS position_size DECIMAL(14,4) NOT NULL DEFAULT 0.0000;
+
+ALTER TABLE trading_journal_entries
+    ADD CONSTRAINT check_risk_percent CHECK (risk_percent >= 0 AND risk_percent <= 100);
+
+ALTER TABLE trading_journal_entries
+    ADD CONSTRAINT check_position_size CHECK (position_size >= 0);
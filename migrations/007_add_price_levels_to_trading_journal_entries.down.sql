@@ -0,0 +1,4 @@
+ALTER TABLE trading_journal_entries
+    DROP COLUMN IF EXISTS take_profit_price,
+    DROP COLUMN IF EXISTS stop_loss_price,
+    DROP COLUMN IF EXISTS entry_price;
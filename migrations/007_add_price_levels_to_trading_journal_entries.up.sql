@@ -0,0 +1,4 @@
+ALTER TABLE trading_journal_entries
+    ADD COLUMN IF NOT EXISTS entry_price DECIMAL(18,6) NULL,
+    ADD COLUMN IF NOT EXISTS stop_loss_price DECIMAL(18,6) NULL,
+    ADD COLUMN IF NOT EXISTS take_profit_price DECIMAL(18,6) NULL;
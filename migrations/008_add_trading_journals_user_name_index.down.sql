@@ -0,0 +1 @@
+DROP INDEX IF EXISTS idx_trading_journals_user_name;
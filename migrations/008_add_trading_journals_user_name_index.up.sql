@@ -0,0 +1,3 @@
+-- Supports the name lookup used by deduplicated journal creation. Not unique:
+-- duplicate names are still allowed unless the client opts into dedupe.
+CREATE INDEX IF NOT EXISTS idx_trading_journals_user_name ON trading_journals(user_id, name) WHERE deleted_at IS NULL;
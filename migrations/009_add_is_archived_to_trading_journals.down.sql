@@ -0,0 +1,4 @@
+DROP INDEX IF EXISTS idx_trading_journals_user_active;
+
+ALTER TABLE trading_journals
+    DROP COLUMN IF EXISTS is_archived;
@@ -0,0 +1,4 @@
+ALTER TABLE trading_journals
+    ADD COLUMN IF NOT EXISTS is_archived BOOLEAN NOT NULL DEFAULT FALSE;
+
+CREATE INDEX IF NOT EXISTS idx_trading_journals_user_active ON trading_journals(user_id, created_at DESC) WHERE NOT is_archived AND deleted_at IS NULL;
@@ -0,0 +1,10 @@
+DROP TRIGGER IF EXISTS update_journal_templates_updated_at ON journal_templates;
+
+DROP INDEX IF EXISTS idx_journal_templates_user_id;
+
+DROP TABLE IF EXISTS journal_templates;
+
+ALTER TABLE trading_journals
+    DROP COLUMN IF EXISTS tags,
+    DROP COLUMN IF EXISTS default_session,
+    DROP COLUMN IF EXISTS default_asset;
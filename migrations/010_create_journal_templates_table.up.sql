@@ -0,0 +1,29 @@
+ALTER TABLE trading_journals
+    ADD COLUMN IF NOT EXISTS default_asset VARCHAR(20) NULL,
+    ADD COLUMN IF NOT EXISTS default_session VARCHAR(20) NULL,
+    ADD COLUMN IF NOT EXISTS tags TEXT[] DEFAULT '{}';
+
+CREATE TABLE IF NOT EXISTS journal_templates (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    user_id UUID NOT NULL,
+    name VARCHAR(255) NOT NULL,
+    description TEXT DEFAULT '',
+    default_asset VARCHAR(20) NULL,
+    default_session VARCHAR(20) NULL,
+    tags TEXT[] DEFAULT '{}',
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    deleted_at TIMESTAMP NULL,
+
+    CONSTRAINT fk_journal_templates_user
+        FOREIGN KEY (user_id)
+        REFERENCES users(id)
+        ON DELETE CASCADE
+);
+
+CREATE INDEX IF NOT EXISTS idx_journal_templates_user_id ON journal_templates(user_id) WHERE deleted_at IS NULL;
+
+CREATE TRIGGER update_journal_templates_updated_at
+    BEFORE UPDATE ON journal_templates
+    FOR EACH ROW
+    EXECUTE FUNCTION update_updated_at_column();
@@ -0,0 +1,3 @@
+DROP INDEX IF EXISTS idx_entry_notes_entry_created;
+
+DROP TABLE IF EXISTS entry_notes;
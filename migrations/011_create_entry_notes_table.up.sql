@@ -0,0 +1,13 @@
+CREATE TABLE IF NOT EXISTS entry_notes (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    entry_id UUID NOT NULL,
+    body TEXT NOT NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+
+    CONSTRAINT fk_entry_notes_entry
+        FOREIGN KEY (entry_id)
+        REFERENCES trading_journal_entries(id)
+        ON DELETE CASCADE
+);
+
+CREATE INDEX IF NOT EXISTS idx_entry_notes_entry_created ON entry_notes(entry_id, created_at);
@@ -0,0 +1,3 @@
+DROP INDEX IF EXISTS idx_sessions_user_last_used;
+
+DROP TABLE IF EXISTS sessions;
@@ -0,0 +1,7 @@
+DROP INDEX IF EXISTS idx_trading_journal_entries_journal_review_status;
+
+ALTER TABLE trading_journal_entries
+    DROP CONSTRAINT IF EXISTS check_review_status;
+
+ALTER TABLE trading_journal_entries
+    DROP COLUMN IF EXISTS review_status;
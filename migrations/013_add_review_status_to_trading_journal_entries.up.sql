@@ -0,0 +1,7 @@
+ALTER TABLE trading_journal_entries
+    ADD COLUMN IF NOT EXISTS review_status VARCHAR(20) NOT NULL DEFAULT 'unreviewed';
+
+ALTER TABLE trading_journal_entries
+    ADD CONSTRAINT check_review_status CHECK (review_status IN ('unreviewed', 'reviewed', 'flagged'));
+
+CREATE INDEX IF NOT EXISTS idx_trading_journal_entries_journal_review_status ON trading_journal_entries(journal_id, review_status) WHERE deleted_at IS NULL;
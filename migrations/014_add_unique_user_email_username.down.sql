@@ -0,0 +1,5 @@
+DROP INDEX IF EXISTS idx_users_email_unique;
+DROP INDEX IF EXISTS idx_users_username_unique;
+
+CREATE INDEX IF NOT EXISTS idx_users_email ON users(email) WHERE deleted_at IS NULL;
+CREATE INDEX IF NOT EXISTS idx_users_username ON users(username) WHERE deleted_at IS NULL;
@@ -0,0 +1,3 @@
+DROP INDEX IF EXISTS idx_entry_exits_entry_exited;
+
+DROP TABLE IF EXISTS entry_exits;
@@ -0,0 +1,19 @@
+CREATE TABLE IF NOT EXISTS entry_exits (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    entry_id UUID NOT NULL,
+    size DECIMAL(14,4) NOT NULL,
+    price DECIMAL(18,6) NOT NULL,
+    realized DECIMAL(10,2) NOT NULL,
+    exited_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+
+    CONSTRAINT fk_entry_exits_entry
+        FOREIGN KEY (entry_id)
+        REFERENCES trading_journal_entries(id)
+        ON DELETE CASCADE,
+
+    CONSTRAINT check_entry_exits_size CHECK (size > 0),
+    CONSTRAINT check_entry_exits_price CHECK (price > 0)
+);
+
+CREATE INDEX IF NOT EXISTS idx_entry_exits_entry_exited ON entry_exits(entry_id, exited_at);
@@ -0,0 +1,2 @@
+ALTER TABLE trading_journal_entries
+    ADD COLUMN IF NOT EXISTS followed_plan BOOLEAN NOT NULL DEFAULT TRUE;
@@ -0,0 +1,5 @@
+ALTER TABLE trading_journal_entries
+    DROP CONSTRAINT IF EXISTS check_confidence;
+
+ALTER TABLE trading_journal_entries
+    DROP COLUMN IF EXISTS confidence;
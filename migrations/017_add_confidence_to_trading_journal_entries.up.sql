@@ -0,0 +1,5 @@
+ALTER TABLE trading_journal_entries
+    ADD COLUMN IF NOT EXISTS confidence SMALLINT NULL;
+
+ALTER TABLE trading_journal_entries
+    ADD CONSTRAINT check_confidence CHECK (confidence IS NULL OR confidence BETWEEN 1 AND 5);
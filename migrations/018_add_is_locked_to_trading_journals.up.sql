@@ -0,0 +1,2 @@
+ALTER TABLE trading_journals
+    ADD COLUMN IF NOT EXISTS is_locked BOOLEAN NOT NULL DEFAULT FALSE;
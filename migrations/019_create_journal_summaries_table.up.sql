@@ -0,0 +1,23 @@
+CREATE TABLE IF NOT EXISTS journal_summaries (
+    journal_id UUID PRIMARY KEY,
+    total_trades INTEGER NOT NULL DEFAULT 0,
+    wins INTEGER NOT NULL DEFAULT 0,
+    net_realized DECIMAL(14,2) NOT NULL DEFAULT 0,
+    computed_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+
+    CONSTRAINT fk_journal_summaries_journal
+        FOREIGN KEY (journal_id)
+        REFERENCES trading_journals(id)
+        ON DELETE CASCADE
+);
+
+INSERT INTO journal_summaries (journal_id, total_trades, wins, net_realized)
+SELECT
+    tj.id,
+    COUNT(tje.id),
+    COUNT(tje.id) FILTER (WHERE tje.result = 'TP'),
+    COALESCE(SUM(tje.realized), 0)
+FROM trading_journals AS tj
+LEFT JOIN trading_journal_entries AS tje ON tje.journal_id = tj.id AND tje.deleted_at IS NULL
+GROUP BY tj.id
+ON CONFLICT (journal_id) DO NOTHING;
@@ -0,0 +1,2 @@
+ALTER TABLE trading_journal_entries
+    ADD COLUMN IF NOT EXISTS setup_charts TEXT[] DEFAULT '{}';
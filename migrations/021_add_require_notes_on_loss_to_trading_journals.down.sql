@@ -0,0 +1,2 @@
+ALTER TABLE trading_journals
+    DROP COLUMN IF EXISTS require_notes_on_loss;
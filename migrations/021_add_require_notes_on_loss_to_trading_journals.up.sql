@@ -0,0 +1,2 @@
+ALTER TABLE trading_journals
+    ADD COLUMN IF NOT EXISTS require_notes_on_loss BOOLEAN NOT NULL DEFAULT FALSE;
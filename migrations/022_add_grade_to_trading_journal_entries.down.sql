@@ -0,0 +1,7 @@
+DROP INDEX IF EXISTS idx_trading_journal_entries_journal_grade;
+
+ALTER TABLE trading_journal_entries
+    DROP CONSTRAINT IF EXISTS check_grade;
+
+ALTER TABLE trading_journal_entries
+    DROP COLUMN IF EXISTS grade;
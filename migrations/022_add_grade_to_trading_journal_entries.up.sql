@@ -0,0 +1,33 @@
+ALTER TABLE trading_journal_entries
+    ADD COLUMN IF NOT EXISTS grade VARCHAR(1);
+
+ALTER TABLE trading_journal_entries
+    ADD CONSTRAINT check_grade CHECK (grade IN ('A', 'B', 'C', 'D', 'F'));
+
+-- Grade existing entries with the default rubric weights (plan 50, RR 25,
+-- outcome 25); entries are regraded with the configured weights when next
+-- written.
+WITH planned AS (
+    SELECT id, followed_plan, result, max_rr,
+           ABS(take_profit_price - entry_price) / NULLIF(ABS(entry_price - stop_loss_price), 0) AS planned_rr
+    FROM trading_journal_entries
+), scored AS (
+    SELECT id,
+           ((CASE WHEN followed_plan THEN 50 ELSE 0 END)
+               + (CASE result WHEN 'TP' THEN 25 WHEN 'BE' THEN 12.5 ELSE 0 END)
+               + (CASE WHEN planned_rr > 0 AND result = 'TP' THEN 25 * LEAST(max_rr / planned_rr, 1) ELSE 0 END))
+           * 100 / (CASE WHEN planned_rr > 0 THEN 100 ELSE 75 END) AS score
+    FROM planned
+)
+UPDATE trading_journal_entries AS tje
+SET grade = CASE
+    WHEN scored.score >= 90 THEN 'A'
+    WHEN scored.score >= 75 THEN 'B'
+    WHEN scored.score >= 50 THEN 'C'
+    WHEN scored.score >= 25 THEN 'D'
+    ELSE 'F'
+END
+FROM scored
+WHERE scored.id = tje.id;
+
+CREATE INDEX IF NOT EXISTS idx_trading_journal_entries_journal_grade ON trading_journal_entries(journal_id, grade) WHERE deleted_at IS NULL;
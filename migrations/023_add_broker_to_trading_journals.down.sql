@@ -0,0 +1,5 @@
+DROP INDEX IF EXISTS idx_trading_journals_user_broker;
+
+ALTER TABLE trading_journals
+    DROP COLUMN IF EXISTS account_number,
+    DROP COLUMN IF EXISTS broker;
@@ -0,0 +1,5 @@
+ALTER TABLE trading_journals
+    ADD COLUMN IF NOT EXISTS broker VARCHAR(100),
+    ADD COLUMN IF NOT EXISTS account_number VARCHAR(50);
+
+CREATE INDEX IF NOT EXISTS idx_trading_journals_user_broker ON trading_journals(user_id, LOWER(broker)) WHERE deleted_at IS NULL;
@@ -0,0 +1,5 @@
+ALTER TABLE users
+    ADD COLUMN IF NOT EXISTS provider VARCHAR(20) NOT NULL DEFAULT 'password';
+
+ALTER TABLE users
+    ADD CONSTRAINT check_provider CHECK (provider IN ('password', 'google'));
@@ -0,0 +1,2 @@
+ALTER TABLE trading_journals
+    DROP COLUMN IF EXISTS validation_profile;
@@ -0,0 +1,5 @@
+ALTER TABLE trading_journals
+    ADD COLUMN IF NOT EXISTS validation_profile VARCHAR(20) NOT NULL DEFAULT 'standard';
+
+ALTER TABLE trading_journals
+    ADD CONSTRAINT check_validation_profile CHECK (validation_profile IN ('lenient', 'standard', 'strict'));
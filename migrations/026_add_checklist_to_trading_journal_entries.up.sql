@@ -0,0 +1,5 @@
+ALTER TABLE trading_journal_entries
+    ADD COLUMN IF NOT EXISTS checklist JSONB;
+
+ALTER TABLE trading_journal_entries
+    ADD CONSTRAINT check_checklist_is_array CHECK (checklist IS NULL OR jsonb_typeof(checklist) = 'array');
@@ -0,0 +1,2 @@
+ALTER TABLE trading_journal_entries
+    DROP COLUMN IF EXISTS related_entry_ids;
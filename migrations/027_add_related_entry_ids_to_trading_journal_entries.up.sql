@@ -0,0 +1,2 @@
+ALTER TABLE trading_journal_entries
+    ADD COLUMN IF NOT EXISTS related_entry_ids UUID[] DEFAULT '{}';
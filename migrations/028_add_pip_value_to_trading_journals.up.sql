@@ -0,0 +1,5 @@
+ALTER TABLE trading_journals
+    ADD COLUMN IF NOT EXISTS pip_value DECIMAL(18, 6);
+
+ALTER TABLE trading_journals
+    ADD CONSTRAINT check_pip_value CHECK (pip_value IS NULL OR pip_value > 0);
@@ -0,0 +1,5 @@
+DROP TRIGGER IF EXISTS update_entry_templates_updated_at ON entry_templates;
+
+DROP INDEX IF EXISTS idx_entry_templates_journal_id;
+
+DROP TABLE IF EXISTS entry_templates;
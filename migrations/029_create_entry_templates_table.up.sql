@@ -0,0 +1,27 @@
+CREATE TABLE IF NOT EXISTS entry_templates (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    journal_id UUID NOT NULL,
+    name VARCHAR(255) NOT NULL,
+    asset VARCHAR(20) NULL,
+    session VARCHAR(20) NULL,
+    trade_type VARCHAR(20) NULL,
+    direction VARCHAR(10) NULL,
+    max_rr DECIMAL(10,2) NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    deleted_at TIMESTAMP NULL,
+
+    CONSTRAINT fk_entry_templates_journal
+        FOREIGN KEY (journal_id)
+        REFERENCES trading_journals(id)
+        ON DELETE CASCADE,
+
+    CONSTRAINT check_entry_templates_max_rr CHECK (max_rr IS NULL OR max_rr > 0)
+);
+
+CREATE INDEX IF NOT EXISTS idx_entry_templates_journal_id ON entry_templates(journal_id) WHERE deleted_at IS NULL;
+
+CREATE TRIGGER update_entry_templates_updated_at
+    BEFORE UPDATE ON entry_templates
+    FOR EACH ROW
+    EXECUTE FUNCTION update_updated_at_column();
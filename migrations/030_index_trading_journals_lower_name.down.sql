@@ -0,0 +1,3 @@
+DROP INDEX IF EXISTS idx_trading_journals_user_lower_name;
+
+CREATE INDEX IF NOT EXISTS idx_trading_journals_user_name ON trading_journals(user_id, name) WHERE deleted_at IS NULL;
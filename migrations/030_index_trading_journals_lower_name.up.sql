@@ -0,0 +1,5 @@
+-- Journal name lookups ignore case, which the plain (user_id, name) index
+-- cannot serve.
+DROP INDEX IF EXISTS idx_trading_journals_user_name;
+
+CREATE INDEX IF NOT EXISTS idx_trading_journals_user_lower_name ON trading_journals(user_id, LOWER(name)) WHERE deleted_at IS NULL;
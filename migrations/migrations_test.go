@@ -0,0 +1,94 @@
+package migrations
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+// TestQueryColumnIndexes checks that the up migrations index the columns the
+// entry and journal queries filter and sort on.
+func TestQueryColumnIndexes(t *testing.T) {
+	files, err := filepath.Glob("*.up.sql")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var schema strings.Builder
+	for _, file := range files {
+		data, err := os.ReadFile(file)
+		if err != nil {
+			t.Fatal(err)
+		}
+		schema.Write(data)
+	}
+
+	tests := []struct {
+		name  string
+		index string
+	}{
+		{"entries by journal and day", "ON trading_journal_entries(journal_id, day DESC)"},
+		{"entries by asset", "ON trading_journal_entries(asset)"},
+		{"entries by session", "ON trading_journal_entries(session)"},
+		{"entries by result", "ON trading_journal_entries(result)"},
+		{"journals by user", "ON trading_journals(user_id)"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			for _, line := range strings.Split(schema.String(), "\n") {
+				if strings.Contains(line, tt.index) {
+					if !strings.Contains(line, "CREATE INDEX IF NOT EXISTS") {
+						t.Errorf("index %q is not created with IF NOT EXISTS: %s", tt.index, line)
+					}
+					return
+				}
+			}
+			t.Errorf("no migration creates an index %s", tt.index)
+		})
+	}
+}
+
+// TestUniqueIndexesIgnoreSoftDeletedRows checks that uniqueness is only
+// enforced among live rows, so a soft-deleted user frees their email and
+// username for a new sign-up.
+func TestUniqueIndexesIgnoreSoftDeletedRows(t *testing.T) {
+	files, err := filepath.Glob("*.up.sql")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	indexed := map[string]bool{}
+	for _, file := range files {
+		data, err := os.ReadFile(file)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		for _, line := range strings.Split(string(data), "\n") {
+			upper := strings.ToUpper(line)
+			if strings.HasPrefix(strings.TrimSpace(upper), "--") || !strings.Contains(upper, "UNIQUE") {
+				continue
+			}
+			if !strings.Contains(upper, "CREATE UNIQUE INDEX") {
+				t.Errorf("%s: unique constraint is not a partial index: %s", file, line)
+				continue
+			}
+			if !strings.Contains(line, "WHERE deleted_at IS NULL") {
+				t.Errorf("%s: unique index covers soft-deleted rows: %s", file, line)
+			}
+			for _, column := range []string{"users(email)", "users(username)"} {
+				if strings.Contains(line, column) {
+					indexed[column] = true
+				}
+			}
+		}
+	}
+
+	for _, column := range []string{"users(email)", "users(username)"} {
+		if !indexed[column] {
+			t.Errorf("no migration creates a unique index ON %s", column)
+		}
+	}
+}
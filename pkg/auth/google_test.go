@@ -0,0 +1,119 @@
+package auth
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/cockroachdb/errors"
+)
+
+const testGoogleClientID = "normark.apps.googleusercontent.com"
+
+// newTestGoogleVerifier points a verifier at a tokeninfo server answering
+// status and body.
+func newTestGoogleVerifier(t *testing.T, status int, body string) *GoogleVerifier {
+	t.Helper()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if got := r.URL.Query().Get("id_token"); got != "id-token" {
+			t.Errorf("id_token = %q, want %q", got, "id-token")
+		}
+		w.WriteHeader(status)
+		_, _ = w.Write([]byte(body))
+	}))
+	t.Cleanup(server.Close)
+
+	verifier := NewGoogleVerifier(testGoogleClientID)
+	verifier.endpoint = server.URL
+	return verifier
+}
+
+func TestGoogleVerifierVerify(t *testing.T) {
+	tests := []struct {
+		name        string
+		status      int
+		body        string
+		want        *GoogleIdentity
+		wantInvalid bool
+		wantErr     bool
+	}{
+		{
+			name:   "valid token",
+			status: http.StatusOK,
+			body:   `{"iss":"https://accounts.google.com","aud":"` + testGoogleClientID + `","sub":"1234","email":"trader@example.com","email_verified":"true","name":"Trader"}`,
+			want:   &GoogleIdentity{Subject: "1234", Email: "trader@example.com", EmailVerified: true, Name: "Trader"},
+		},
+		{
+			name:   "issuer without scheme",
+			status: http.StatusOK,
+			body:   `{"iss":"accounts.google.com","aud":"` + testGoogleClientID + `","sub":"1234","email":"trader@example.com","email_verified":"true"}`,
+			want:   &GoogleIdentity{Subject: "1234", Email: "trader@example.com", EmailVerified: true},
+		},
+		{
+			name:   "unverified email",
+			status: http.StatusOK,
+			body:   `{"iss":"accounts.google.com","aud":"` + testGoogleClientID + `","sub":"1234","email":"trader@example.com","email_verified":"false"}`,
+			want:   &GoogleIdentity{Subject: "1234", Email: "trader@example.com"},
+		},
+		{
+			name:        "rejected by google",
+			status:      http.StatusBadRequest,
+			body:        `{"error":"invalid_token"}`,
+			wantInvalid: true,
+		},
+		{
+			name:        "issued to another client",
+			status:      http.StatusOK,
+			body:        `{"iss":"accounts.google.com","aud":"other.apps.googleusercontent.com","sub":"1234","email":"trader@example.com","email_verified":"true"}`,
+			wantInvalid: true,
+		},
+		{
+			name:        "foreign issuer",
+			status:      http.StatusOK,
+			body:        `{"iss":"https://evil.example.com","aud":"` + testGoogleClientID + `","sub":"1234","email":"trader@example.com","email_verified":"true"}`,
+			wantInvalid: true,
+		},
+		{
+			name:        "no email",
+			status:      http.StatusOK,
+			body:        `{"iss":"accounts.google.com","aud":"` + testGoogleClientID + `","sub":"1234"}`,
+			wantInvalid: true,
+		},
+		{
+			name:    "tokeninfo outage",
+			status:  http.StatusInternalServerError,
+			wantErr: true,
+		},
+		{
+			name:    "malformed response",
+			status:  http.StatusOK,
+			body:    `not json`,
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			verifier := newTestGoogleVerifier(t, tt.status, tt.body)
+
+			got, err := verifier.Verify(context.Background(), "id-token")
+			if tt.wantInvalid || tt.wantErr {
+				if err == nil {
+					t.Fatalf("Verify() = %+v, want error", got)
+				}
+				if invalid := errors.Is(err, ErrInvalidGoogleToken); invalid != tt.wantInvalid {
+					t.Errorf("errors.Is(%v, ErrInvalidGoogleToken) = %v, want %v", err, invalid, tt.wantInvalid)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Verify() error = %v", err)
+			}
+			if *got != *tt.want {
+				t.Errorf("Verify() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
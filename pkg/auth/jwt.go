@@ -9,22 +9,29 @@ import (
 )
 
 type Claims struct {
-	UserID   uuid.UUID `json:"user_id"`
-	Email    string    `json:"email"`
-	Username string    `json:"username"`
+	UserID    uuid.UUID `json:"user_id"`
+	SessionID uuid.UUID `json:"sid"`
+	Email     string    `json:"email"`
+	Username  string    `json:"username"`
 	jwt.RegisteredClaims
 }
 
 type TokenPair struct {
-	AccessToken  string    `json:"access_token"`
-	RefreshToken string    `json:"refresh_token"`
-	ExpiresAt    time.Time `json:"expires_at"`
+	AccessToken      string    `json:"access_token"`
+	RefreshToken     string    `json:"refresh_token"`
+	ExpiresAt        time.Time `json:"expires_at"`
+	RefreshExpiresAt time.Time `json:"refresh_expires_at"`
 }
 
+// DefaultLeeway is the clock skew tolerated when validating tokens unless
+// WithLeeway sets another.
+const DefaultLeeway = 30 * time.Second
+
 type JWTManager struct {
 	secretKey          string
 	accessTokenExpiry  time.Duration
 	refreshTokenExpiry time.Duration
+	leeway             time.Duration
 }
 
 func NewJWTManager(
@@ -43,15 +50,26 @@ func NewJWTManager(
 		secretKey:          secretKey,
 		accessTokenExpiry:  time.Duration(accessTokenExpiry) * time.Minute,
 		refreshTokenExpiry: time.Duration(refreshTokenExpiry) * time.Minute,
+		leeway:             DefaultLeeway,
 	}, nil
 }
 
+// WithLeeway sets how far token expiry and not-before times may be off, so
+// tokens issued by a node whose clock runs slightly ahead are not rejected.
+func (m *JWTManager) WithLeeway(leeway time.Duration) *JWTManager {
+	m.leeway = leeway
+	return m
+}
+
+// GenerateTokenPair issues tokens bound to the session, so revoking the
+// session invalidates the refresh token.
 func (m *JWTManager) GenerateTokenPair(
-	userID uuid.UUID,
+	userID, sessionID uuid.UUID,
 	email, username string,
 ) (*TokenPair, error) {
 	accessToken, expiresAt, err := m.generateToken(
 		userID,
+		sessionID,
 		email,
 		username,
 		m.accessTokenExpiry,
@@ -60,8 +78,9 @@ func (m *JWTManager) GenerateTokenPair(
 		return nil, errors.Wrap(err, "failed to generate access token")
 	}
 
-	refreshToken, _, err := m.generateToken(
+	refreshToken, refreshExpiresAt, err := m.generateToken(
 		userID,
+		sessionID,
 		email,
 		username,
 		m.refreshTokenExpiry,
@@ -71,14 +90,15 @@ func (m *JWTManager) GenerateTokenPair(
 	}
 
 	return &TokenPair{
-		AccessToken:  accessToken,
-		RefreshToken: refreshToken,
-		ExpiresAt:    expiresAt,
+		AccessToken:      accessToken,
+		RefreshToken:     refreshToken,
+		ExpiresAt:        expiresAt,
+		RefreshExpiresAt: refreshExpiresAt,
 	}, nil
 }
 
 func (m *JWTManager) generateToken(
-	userID uuid.UUID,
+	userID, sessionID uuid.UUID,
 	email, username string,
 	expiry time.Duration,
 ) (string, time.Time, error) {
@@ -86,9 +106,10 @@ func (m *JWTManager) generateToken(
 	expiresAt := now.Add(expiry)
 
 	claims := &Claims{
-		UserID:   userID,
-		Email:    email,
-		Username: username,
+		UserID:    userID,
+		SessionID: sessionID,
+		Email:     email,
+		Username:  username,
 		RegisteredClaims: jwt.RegisteredClaims{
 			ExpiresAt: jwt.NewNumericDate(expiresAt),
 			IssuedAt:  jwt.NewNumericDate(now),
@@ -117,6 +138,7 @@ func (m *JWTManager) ValidateToken(tokenString string) (*Claims, error) {
 			}
 			return []byte(m.secretKey), nil
 		},
+		jwt.WithLeeway(m.leeway),
 	)
 
 	if err != nil {
@@ -143,6 +165,7 @@ func (m *JWTManager) RefreshAccessToken(refreshToken string) (string, time.Time,
 
 	accessToken, expiresAt, err := m.generateToken(
 		claims.UserID,
+		claims.SessionID,
 		claims.Email,
 		claims.Username,
 		m.accessTokenExpiry,
@@ -0,0 +1,92 @@
+package auth
+
+import (
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/google/uuid"
+)
+
+const testSecret = "0123456789abcdef0123456789abcdef"
+
+// signToken signs claims valid from now+notBefore until now+expiresIn.
+func signToken(t *testing.T, notBefore, expiresIn time.Duration) string {
+	t.Helper()
+
+	now := time.Now()
+	claims := &Claims{
+		UserID: uuid.New(),
+		RegisteredClaims: jwt.RegisteredClaims{
+			IssuedAt:  jwt.NewNumericDate(now),
+			NotBefore: jwt.NewNumericDate(now.Add(notBefore)),
+			ExpiresAt: jwt.NewNumericDate(now.Add(expiresIn)),
+		},
+	}
+
+	token, err := jwt.NewWithClaims(jwt.SigningMethodHS256, claims).SignedString([]byte(testSecret))
+	if err != nil {
+		t.Fatalf("sign token: %v", err)
+	}
+	return token
+}
+
+func TestValidateTokenLeeway(t *testing.T) {
+	tests := []struct {
+		name      string
+		leeway    *time.Duration
+		notBefore time.Duration
+		expiresIn time.Duration
+		wantErr   bool
+	}{
+		{"valid now", nil, 0, time.Hour, false},
+		{"not before within default leeway", nil, 10 * time.Second, time.Hour, false},
+		{"not before beyond default leeway", nil, 2 * time.Minute, time.Hour, true},
+		{"expired within default leeway", nil, -time.Hour, -10 * time.Second, false},
+		{"expired beyond default leeway", nil, -time.Hour, -2 * time.Minute, true},
+		{"not before without leeway", ptr(time.Duration(0)), 10 * time.Second, time.Hour, true},
+		{"not before within wider leeway", ptr(3 * time.Minute), 2 * time.Minute, time.Hour, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			manager, err := NewJWTManager(testSecret, 15, 60)
+			if err != nil {
+				t.Fatalf("NewJWTManager() error = %v", err)
+			}
+			if tt.leeway != nil {
+				manager.WithLeeway(*tt.leeway)
+			}
+
+			_, err = manager.ValidateToken(signToken(t, tt.notBefore, tt.expiresIn))
+			if (err != nil) != tt.wantErr {
+				t.Errorf("ValidateToken() error = %v, want error %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestGenerateTokenPairValidates(t *testing.T) {
+	manager, err := NewJWTManager(testSecret, 15, 60)
+	if err != nil {
+		t.Fatalf("NewJWTManager() error = %v", err)
+	}
+
+	userID, sessionID := uuid.New(), uuid.New()
+	pair, err := manager.GenerateTokenPair(userID, sessionID, "trader@example.com", "trader")
+	if err != nil {
+		t.Fatalf("GenerateTokenPair() error = %v", err)
+	}
+
+	claims, err := manager.ValidateToken(pair.AccessToken)
+	if err != nil {
+		t.Fatalf("ValidateToken() error = %v", err)
+	}
+	if claims.UserID != userID || claims.SessionID != sessionID {
+		t.Errorf("claims user %s session %s, want %s and %s", claims.UserID, claims.SessionID, userID, sessionID)
+	}
+}
+
+func ptr[T any](v T) *T {
+	return &v
+}
@@ -3,6 +3,7 @@ package db
 import (
 	"context"
 	"database/sql"
+	"errors"
 	"fmt"
 	"time"
 
@@ -11,6 +12,7 @@ import (
 	"github.com/uptrace/bun/driver/pgdriver"
 	"github.com/uptrace/bun/extra/bundebug"
 	"github.com/user/normark/internal/config"
+	"go.uber.org/zap"
 )
 
 const (
@@ -29,9 +31,10 @@ const (
 
 type DB struct {
 	*bun.DB
+	replica *bun.DB
 }
 
-func NewPostgresConnection(ctx context.Context, cfg *config.Postgres) (*DB, error) {
+func NewPostgresConnection(ctx context.Context, cfg *config.Postgres, logger *zap.Logger) (*DB, error) {
 	dsn := fmt.Sprintf(
 		"postgres://%s:%s@%s:%d/%s?sslmode=%s",
 		cfg.User,
@@ -42,6 +45,31 @@ func NewPostgresConnection(ctx context.Context, cfg *config.Postgres) (*DB, erro
 		cfg.SSLMode,
 	)
 
+	var slowQueryHook *SlowQueryHook
+	if cfg.SlowQueryThreshold > 0 {
+		slowQueryHook = NewSlowQueryHook(logger, cfg.SlowQueryThreshold)
+	}
+
+	primary, err := open(ctx, dsn, slowQueryHook)
+	if err != nil {
+		return nil, fmt.Errorf("failed to connect to primary: %w", err)
+	}
+
+	db := &DB{DB: primary}
+
+	if cfg.ReplicaDSN != "" {
+		replica, err := open(ctx, cfg.ReplicaDSN, slowQueryHook)
+		if err != nil {
+			primary.Close()
+			return nil, fmt.Errorf("failed to connect to replica: %w", err)
+		}
+		db.replica = replica
+	}
+
+	return db, nil
+}
+
+func open(ctx context.Context, dsn string, slowQueryHook *SlowQueryHook) (*bun.DB, error) {
 	connector := pgdriver.NewConnector(
 		pgdriver.WithDSN(dsn),
 		pgdriver.WithTimeout(defaultConnectionTimeout),
@@ -63,18 +91,44 @@ func NewPostgresConnection(ctx context.Context, cfg *config.Postgres) (*DB, erro
 		bundebug.FromEnv("BUNDEBUG"),
 	))
 
+	if slowQueryHook != nil {
+		bunDB.AddQueryHook(slowQueryHook)
+	}
+
 	if err := bunDB.PingContext(ctx); err != nil {
 		sqlDB.Close()
 		return nil, fmt.Errorf("failed to ping database: %w", err)
 	}
 
-	db := &DB{DB: bunDB}
+	return bunDB, nil
+}
 
-	return db, nil
+// Reader returns the connection read-only queries should use: the replica
+// when one is configured, the primary otherwise.
+func (db *DB) Reader() *bun.DB {
+	if db.replica != nil {
+		return db.replica
+	}
+	return db.DB
 }
 
+func (db *DB) HasReplica() bool {
+	return db.replica != nil
+}
+
+// Close closes the primary and the replica, if any, even when closing one
+// of them fails.
 func (db *DB) Close() error {
-	return db.DB.Close()
+	var errs []error
+	if db.replica != nil {
+		if err := db.replica.Close(); err != nil {
+			errs = append(errs, fmt.Errorf("failed to close replica: %w", err))
+		}
+	}
+	if err := db.DB.Close(); err != nil {
+		errs = append(errs, fmt.Errorf("failed to close primary: %w", err))
+	}
+	return errors.Join(errs...)
 }
 
 func (db *DB) Ping(ctx context.Context) error {
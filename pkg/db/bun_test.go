@@ -0,0 +1,69 @@
+package db
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"testing"
+
+	"github.com/uptrace/bun"
+	"github.com/uptrace/bun/dialect/pgdialect"
+)
+
+var errCloseConn = errors.New("close failed")
+
+// fakeConnector hands out connections whose Close fails when failClose is
+// set, so closing a pool holding one fails.
+type fakeConnector struct{ failClose bool }
+
+func (c fakeConnector) Connect(context.Context) (driver.Conn, error) { return fakeConn(c), nil }
+func (c fakeConnector) Driver() driver.Driver                        { return nil }
+
+type fakeConn struct{ failClose bool }
+
+func (c fakeConn) Prepare(string) (driver.Stmt, error) { return nil, errors.New("not supported") }
+func (c fakeConn) Begin() (driver.Tx, error)           { return nil, errors.New("not supported") }
+func (c fakeConn) Close() error {
+	if c.failClose {
+		return errCloseConn
+	}
+	return nil
+}
+
+// newPool returns a pool holding one idle connection.
+func newPool(t *testing.T, failClose bool) *bun.DB {
+	t.Helper()
+
+	sqlDB := sql.OpenDB(fakeConnector{failClose: failClose})
+	if err := sqlDB.Ping(); err != nil {
+		t.Fatalf("failed to open fake connection: %v", err)
+	}
+	return bun.NewDB(sqlDB, pgdialect.New())
+}
+
+func TestReaderFallsBackToPrimary(t *testing.T) {
+	primary := newPool(t, false)
+	replica := newPool(t, false)
+
+	if got := (&DB{DB: primary}).Reader(); got != primary {
+		t.Error("Reader() without a replica did not return the primary")
+	}
+	if got := (&DB{DB: primary, replica: replica}).Reader(); got != replica {
+		t.Error("Reader() with a replica did not return the replica")
+	}
+}
+
+func TestCloseClosesPrimaryWhenReplicaFails(t *testing.T) {
+	primary := newPool(t, false)
+	db := &DB{DB: primary, replica: newPool(t, true)}
+
+	err := db.Close()
+	if !errors.Is(err, errCloseConn) {
+		t.Fatalf("Close() error = %v, want the replica's close error", err)
+	}
+
+	if err := primary.Ping(); err == nil || err.Error() != "sql: database is closed" {
+		t.Errorf("primary still open after Close(): ping error = %v", err)
+	}
+}
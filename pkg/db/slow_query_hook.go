@@ -0,0 +1,47 @@
+package db
+
+import (
+	"context"
+	"time"
+
+	"github.com/uptrace/bun"
+	"go.uber.org/zap"
+)
+
+// SlowQueryHook logs every query that takes at least threshold to run.
+type SlowQueryHook struct {
+	logger    *zap.Logger
+	threshold time.Duration
+}
+
+var _ bun.QueryHook = (*SlowQueryHook)(nil)
+
+func NewSlowQueryHook(logger *zap.Logger, threshold time.Duration) *SlowQueryHook {
+	return &SlowQueryHook{
+		logger:    logger,
+		threshold: threshold,
+	}
+}
+
+func (h *SlowQueryHook) BeforeQuery(ctx context.Context, _ *bun.QueryEvent) context.Context {
+	return ctx
+}
+
+func (h *SlowQueryHook) AfterQuery(_ context.Context, event *bun.QueryEvent) {
+	duration := time.Since(event.StartTime)
+	if duration < h.threshold {
+		return
+	}
+
+	fields := []zap.Field{
+		zap.String("operation", event.Operation()),
+		zap.Duration("duration", duration),
+		zap.Duration("threshold", h.threshold),
+		zap.String("query", event.Query),
+	}
+	if event.Err != nil {
+		fields = append(fields, zap.Error(event.Err))
+	}
+
+	h.logger.Warn("slow query", fields...)
+}
@@ -0,0 +1,72 @@
+package db
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/uptrace/bun"
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+	"go.uber.org/zap/zaptest/observer"
+)
+
+// delayHook makes every query take at least delay.
+type delayHook struct{ delay time.Duration }
+
+func (h delayHook) BeforeQuery(ctx context.Context, _ *bun.QueryEvent) context.Context {
+	time.Sleep(h.delay)
+	return ctx
+}
+
+func (delayHook) AfterQuery(context.Context, *bun.QueryEvent) {}
+
+func TestSlowQueryHook(t *testing.T) {
+	tests := []struct {
+		name      string
+		delay     time.Duration
+		threshold time.Duration
+		wantLog   bool
+	}{
+		{"slow query", 20 * time.Millisecond, 10 * time.Millisecond, true},
+		{"fast query", 0, time.Hour, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			core, logs := observer.New(zapcore.DebugLevel)
+			pool := newPool(t, false)
+			pool.AddQueryHook(delayHook{delay: tt.delay})
+			pool.AddQueryHook(NewSlowQueryHook(zap.New(core), tt.threshold))
+
+			// The fake driver fails every statement, which the hook logs too.
+			_, err := pool.NewRaw("SELECT pg_sleep(1)").Exec(context.Background())
+			if err == nil {
+				t.Fatal("Exec() error = nil, want the fake driver's error")
+			}
+
+			entries := logs.FilterMessage("slow query").All()
+			if (len(entries) == 1) != tt.wantLog {
+				t.Fatalf("logged %d slow queries, want logged %v", len(entries), tt.wantLog)
+			}
+			if !tt.wantLog {
+				return
+			}
+
+			entry := entries[0]
+			fields := entry.ContextMap()
+			if entry.Level != zapcore.WarnLevel {
+				t.Errorf("level = %v, want %v", entry.Level, zapcore.WarnLevel)
+			}
+			if fields["query"] != "SELECT pg_sleep(1)" {
+				t.Errorf("query = %v, want the query text", fields["query"])
+			}
+			if duration, _ := fields["duration"].(time.Duration); duration < tt.delay {
+				t.Errorf("duration = %v, want at least %v", fields["duration"], tt.delay)
+			}
+			if fields["threshold"] != tt.threshold || fields["error"] == nil {
+				t.Errorf("fields = %v, want the threshold and the query error", fields)
+			}
+		})
+	}
+}
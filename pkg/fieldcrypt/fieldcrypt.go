@@ -0,0 +1,82 @@
+// Package fieldcrypt encrypts single column values at rest with AES-GCM.
+package fieldcrypt
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/base64"
+	"strings"
+
+	"github.com/cockroachdb/errors"
+)
+
+// KeySize is the length of an AES-256 key in bytes.
+const KeySize = 32
+
+// prefix marks encrypted values, so values written before encryption was
+// enabled can still be read.
+const prefix = "enc:v1:"
+
+// ErrMalformed is returned for a value that carries the encryption prefix
+// but cannot be decrypted, e.g. because it was written under another key.
+var ErrMalformed = errors.New("malformed encrypted value")
+
+type Cipher struct {
+	aead cipher.AEAD
+}
+
+func New(key []byte) (*Cipher, error) {
+	if len(key) != KeySize {
+		return nil, errors.Newf("encryption key must be %d bytes", KeySize)
+	}
+
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to create aes cipher")
+	}
+
+	aead, err := cipher.NewGCM(block)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to create gcm")
+	}
+
+	return &Cipher{aead: aead}, nil
+}
+
+// Encrypt seals plaintext under a random nonce and returns it base64
+// encoded behind the encryption prefix. The empty string is returned as is,
+// so empty columns stay empty.
+func (c *Cipher) Encrypt(plaintext string) string {
+	if plaintext == "" {
+		return ""
+	}
+
+	nonce := make([]byte, c.aead.NonceSize())
+	rand.Read(nonce)
+
+	sealed := c.aead.Seal(nonce, nonce, []byte(plaintext), nil)
+	return prefix + base64.StdEncoding.EncodeToString(sealed)
+}
+
+// Decrypt reverses Encrypt. Values without the encryption prefix are
+// plaintext and returned unchanged.
+func (c *Cipher) Decrypt(value string) (string, error) {
+	encoded, ok := strings.CutPrefix(value, prefix)
+	if !ok {
+		return value, nil
+	}
+
+	sealed, err := base64.StdEncoding.DecodeString(encoded)
+	if err != nil || len(sealed) < c.aead.NonceSize() {
+		return "", ErrMalformed
+	}
+
+	nonce, ciphertext := sealed[:c.aead.NonceSize()], sealed[c.aead.NonceSize():]
+	plaintext, err := c.aead.Open(nil, nonce, ciphertext, nil)
+	if err != nil {
+		return "", ErrMalformed
+	}
+
+	return string(plaintext), nil
+}
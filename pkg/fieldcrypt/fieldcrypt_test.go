@@ -0,0 +1,78 @@
+package fieldcrypt
+
+import (
+	"bytes"
+	"errors"
+	"strings"
+	"testing"
+)
+
+func newTestCipher(t *testing.T, fill byte) *Cipher {
+	t.Helper()
+	c, err := New(bytes.Repeat([]byte{fill}, KeySize))
+	if err != nil {
+		t.Fatalf("New() error = %v", err)
+	}
+	return c
+}
+
+func TestNewRejectsWrongKeySize(t *testing.T) {
+	if _, err := New(make([]byte, 16)); err == nil {
+		t.Error("New() with a 16-byte key error = nil, want an error")
+	}
+}
+
+func TestRoundTrip(t *testing.T) {
+	c := newTestCipher(t, 1)
+
+	for _, plaintext := range []string{"", "moved stop to break even", "ünïcödé ✓"} {
+		sealed := c.Encrypt(plaintext)
+		if plaintext != "" && (sealed == plaintext || !strings.HasPrefix(sealed, prefix)) {
+			t.Errorf("Encrypt(%q) = %q, want a prefixed ciphertext", plaintext, sealed)
+		}
+
+		got, err := c.Decrypt(sealed)
+		if err != nil {
+			t.Fatalf("Decrypt(Encrypt(%q)) error = %v", plaintext, err)
+		}
+		if got != plaintext {
+			t.Errorf("Decrypt(Encrypt(%q)) = %q", plaintext, got)
+		}
+	}
+}
+
+func TestEncryptUsesFreshNonce(t *testing.T) {
+	c := newTestCipher(t, 1)
+	if c.Encrypt("same") == c.Encrypt("same") {
+		t.Error("Encrypt() returned the same ciphertext twice")
+	}
+}
+
+func TestDecrypt(t *testing.T) {
+	c := newTestCipher(t, 1)
+	other := newTestCipher(t, 2)
+
+	tests := []struct {
+		name    string
+		value   string
+		want    string
+		wantErr error
+	}{
+		{"plaintext kept", "written before encryption", "written before encryption", nil},
+		{"other key", other.Encrypt("secret"), "", ErrMalformed},
+		{"bad base64", prefix + "!!!", "", ErrMalformed},
+		{"too short", prefix + "AAAA", "", ErrMalformed},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := c.Decrypt(tt.value)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("Decrypt() error = %v, want %v", err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("Decrypt() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,34 @@
+package logger
+
+import (
+	"fmt"
+
+	"github.com/user/normark/internal/config"
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+)
+
+// New builds a zap logger from the log config. The json format uses zap's
+// production settings; console uses the development encoder with colored
+// levels. In both cases the configured level applies to every log call.
+func New(cfg *config.Log) (*zap.Logger, error) {
+	level, err := zapcore.ParseLevel(cfg.Level)
+	if err != nil {
+		return nil, fmt.Errorf("invalid log level %q: %w", cfg.Level, err)
+	}
+
+	var zapCfg zap.Config
+	switch cfg.Format {
+	case config.LogFormatConsole:
+		zapCfg = zap.NewDevelopmentConfig()
+		zapCfg.EncoderConfig.EncodeLevel = zapcore.CapitalColorLevelEncoder
+	case config.LogFormatJSON:
+		zapCfg = zap.NewProductionConfig()
+	default:
+		return nil, fmt.Errorf("invalid log format %q", cfg.Format)
+	}
+
+	zapCfg.Level = zap.NewAtomicLevelAt(level)
+
+	return zapCfg.Build()
+}
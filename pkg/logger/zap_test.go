@@ -0,0 +1,42 @@
+package logger
+
+import (
+	"testing"
+
+	"github.com/user/normark/internal/config"
+	"go.uber.org/zap/zapcore"
+)
+
+func TestNew(t *testing.T) {
+	tests := []struct {
+		name      string
+		cfg       config.Log
+		wantErr   bool
+		wantLevel zapcore.Level
+	}{
+		{"json info", config.Log{Level: "info", Format: config.LogFormatJSON}, false, zapcore.InfoLevel},
+		{"console debug", config.Log{Level: "debug", Format: config.LogFormatConsole}, false, zapcore.DebugLevel},
+		{"json error", config.Log{Level: "error", Format: config.LogFormatJSON}, false, zapcore.ErrorLevel},
+		{"invalid level", config.Log{Level: "verbose", Format: config.LogFormatJSON}, true, 0},
+		{"invalid format", config.Log{Level: "info", Format: "logfmt"}, true, 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			log, err := New(&tt.cfg)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("New() error = %v, want error %v", err, tt.wantErr)
+			}
+			if err != nil {
+				return
+			}
+
+			if got := log.Level(); got != tt.wantLevel {
+				t.Errorf("level = %v, want %v", got, tt.wantLevel)
+			}
+			if log.Core().Enabled(tt.wantLevel - 1) {
+				t.Errorf("level %v is enabled below the configured %v", tt.wantLevel-1, tt.wantLevel)
+			}
+		})
+	}
+}
@@ -0,0 +1,70 @@
+package metrics
+
+import (
+	"net/http"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/collectors"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+	"github.com/user/normark/internal/types"
+)
+
+const namespace = "normark"
+
+// Recorder counts business events as Prometheus metrics. It keeps its own
+// registry, so Handler exposes only these counters and the runtime
+// collectors.
+type Recorder struct {
+	registry *prometheus.Registry
+	signUps  prometheus.Counter
+	journals prometheus.Counter
+	entries  *prometheus.CounterVec
+}
+
+func New() *Recorder {
+	registry := prometheus.NewRegistry()
+	registry.MustRegister(
+		collectors.NewGoCollector(),
+		collectors.NewProcessCollector(collectors.ProcessCollectorOpts{}),
+	)
+
+	r := &Recorder{
+		registry: registry,
+		signUps: prometheus.NewCounter(prometheus.CounterOpts{
+			Namespace: namespace,
+			Name:      "user_signups_total",
+			Help:      "Number of users who signed up.",
+		}),
+		journals: prometheus.NewCounter(prometheus.CounterOpts{
+			Namespace: namespace,
+			Name:      "journals_created_total",
+			Help:      "Number of trading journals created.",
+		}),
+		// The asset label is bounded by the supported currency pairs.
+		entries: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: namespace,
+			Name:      "entries_created_total",
+			Help:      "Number of trading journal entries created, by asset.",
+		}, []string{"asset"}),
+	}
+	registry.MustRegister(r.signUps, r.journals, r.entries)
+
+	return r
+}
+
+func (r *Recorder) UserSignedUp() {
+	r.signUps.Inc()
+}
+
+func (r *Recorder) JournalCreated() {
+	r.journals.Inc()
+}
+
+func (r *Recorder) EntryCreated(asset types.CurrencyPair) {
+	r.entries.WithLabelValues(string(asset)).Inc()
+}
+
+// Handler serves the registry in the Prometheus exposition format.
+func (r *Recorder) Handler() http.Handler {
+	return promhttp.HandlerFor(r.registry, promhttp.HandlerOpts{})
+}
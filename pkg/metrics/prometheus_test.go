@@ -0,0 +1,50 @@
+package metrics
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/user/normark/internal/types"
+)
+
+func TestRecorderCountsBusinessEvents(t *testing.T) {
+	r := New()
+	r.UserSignedUp()
+	r.JournalCreated()
+	r.JournalCreated()
+	r.EntryCreated(types.CurrencyPairEURUSD)
+	r.EntryCreated(types.CurrencyPairEURUSD)
+	r.EntryCreated(types.CurrencyPairGBPUSD)
+
+	rec := httptest.NewRecorder()
+	r.Handler().ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/metrics", nil))
+	if rec.Code != http.StatusOK {
+		t.Fatalf("status = %d, want %d", rec.Code, http.StatusOK)
+	}
+
+	body := rec.Body.String()
+	for _, want := range []string{
+		"normark_user_signups_total 1\n",
+		"normark_journals_created_total 2\n",
+		`normark_entries_created_total{asset="EURUSD"} 2` + "\n",
+		`normark_entries_created_total{asset="GBPUSD"} 1` + "\n",
+		"go_goroutines ",
+	} {
+		if !strings.Contains(body, want) {
+			t.Errorf("metrics do not contain %q:\n%s", want, body)
+		}
+	}
+}
+
+func TestRecordersDoNotShareCounts(t *testing.T) {
+	New().UserSignedUp()
+
+	rec := httptest.NewRecorder()
+	New().Handler().ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/metrics", nil))
+
+	if body := rec.Body.String(); !strings.Contains(body, "normark_user_signups_total 0\n") {
+		t.Errorf("a new recorder reports sign-ups from another:\n%s", body)
+	}
+}